@@ -18,19 +18,47 @@ import (
 	"time"
 
 	"go.thethings.network/lorawan-stack/pkg/applicationserver"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors/grpcservice"
 )
 
 // DefaultApplicationServerConfig is the default configuration for the Application Server.
 var DefaultApplicationServerConfig = applicationserver.Config{
 	LinkMode: "all",
 	MQTT: applicationserver.MQTTConfig{
-		Listen:    ":1883",
-		ListenTLS: ":8883",
+		Listen:            ":1883",
+		ListenTLS:         ":8883",
+		SessionExpiry:     time.Hour,
+		MaxQueuedMessages: 256,
 	},
 	Webhooks: applicationserver.WebhooksConfig{
 		Target:    "direct",
 		Timeout:   5 * time.Second,
 		QueueSize: 16,
 		Workers:   16,
+		Retry: applicationserver.WebhooksRetryConfig{
+			MaxAttempts:    8,
+			InitialBackoff: time.Second,
+			MaxBackoff:     10 * time.Minute,
+		},
+		SuspendThreshold: 50,
+		SuspendDuration:  time.Hour,
+		DeadLetterLimit:  1000,
+	},
+	Storage: applicationserver.StorageConfig{
+		Retention: 7 * 24 * time.Hour,
+	},
+	PubSub: applicationserver.PubSubConfig{
+		Enable: true,
+	},
+	Formatters: applicationserver.FormattersConfig{
+		GRPCService: applicationserver.GRPCServiceFormatterConfig{
+			Timeout:          5 * time.Second,
+			FailureThreshold: 10,
+			ResetTimeout:     30 * time.Second,
+			MaxServices:      grpcservice.DefaultMaxServices,
+		},
+	},
+	Downlinks: applicationserver.DownlinksConfig{
+		MaxConfirmedRetries: applicationserver.DefaultMaxConfirmedRetries,
 	},
 }
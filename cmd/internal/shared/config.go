@@ -18,6 +18,8 @@ import (
 	"time"
 
 	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/events/export"
 	"go.thethings.network/lorawan-stack/pkg/log"
 )
 
@@ -72,6 +74,23 @@ var DefaultRedisConfig = config.Redis{
 // DefaultEventsConfig is the default config for Events.
 var DefaultEventsConfig = config.Events{
 	Backend: "internal",
+	Export: config.EventsExport{
+		HTTP: config.EventsHTTPExport{
+			Timeout:       10 * time.Second,
+			Retries:       3,
+			RetryBackoff:  time.Second,
+			BufferSize:    export.DefaultBufferSize,
+			BatchSize:     export.DefaultBatchSize,
+			FlushInterval: export.DefaultFlushInterval,
+		},
+		File: config.EventsFileExport{
+			MaxSize:       100 << 20,
+			MaxFiles:      10,
+			BufferSize:    export.DefaultBufferSize,
+			BatchSize:     export.DefaultBatchSize,
+			FlushInterval: export.DefaultFlushInterval,
+		},
+	},
 }
 
 // DefaultBlobConfig is the default config for the blob store.
@@ -96,7 +115,12 @@ var DefaultRightsConfig = config.Rights{
 }
 
 // DefaultKeyVaultConfig is the default config for key vaults.
-var DefaultKeyVaultConfig = config.KeyVault{}
+var DefaultKeyVaultConfig = config.KeyVault{
+	Transit: config.KeyVaultTransit{
+		Mount:   "transit",
+		Timeout: cryptoutil.DefaultTransitTimeout,
+	},
+}
 
 // DefaultServiceBase is the default base config for a service.
 var DefaultServiceBase = config.ServiceBase{
@@ -15,26 +15,70 @@
 package shared
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 
 	"go.thethings.network/lorawan-stack/pkg/config"
 	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/events/export"
 	"go.thethings.network/lorawan-stack/pkg/events/redis"
+	"go.thethings.network/lorawan-stack/pkg/log"
 )
 
 // InitializeEvents initializes the event system.
 func InitializeEvents(config config.ServiceBase) error {
 	switch config.Events.Backend {
 	case "internal":
-		return nil // this is the default.
 	case "redis":
 		if !config.Events.Redis.IsZero() {
 			events.DefaultPubSub = redis.NewPubSub(config.Events.Redis)
 		} else {
 			events.DefaultPubSub = redis.NewPubSub(config.Redis)
 		}
-		return nil
 	default:
 		return fmt.Errorf("unknown events backend: %s", config.Events.Backend)
 	}
+	return initializeEventsExport(config.Events.Export)
+}
+
+func initializeEventsExport(config config.EventsExport) error {
+	var exporters []*export.Exporter
+	if conf := config.HTTP; conf.Target != "" {
+		exporters = append(exporters, export.NewExporter("http", &export.CloudEventsSink{
+			Client: &http.Client{
+				Timeout: conf.Timeout,
+			},
+			Target:       conf.Target,
+			Headers:      conf.Headers,
+			Source:       conf.Source,
+			Retries:      conf.Retries,
+			RetryBackoff: conf.RetryBackoff,
+		}, export.Config{
+			Filters:       conf.Filters,
+			BufferSize:    conf.BufferSize,
+			BatchSize:     conf.BatchSize,
+			FlushInterval: conf.FlushInterval,
+		}))
+	}
+	if conf := config.File; conf.Path != "" {
+		exporters = append(exporters, export.NewExporter("file", &export.FileSink{
+			Path:     conf.Path,
+			MaxSize:  conf.MaxSize,
+			MaxFiles: conf.MaxFiles,
+		}, export.Config{
+			Filters:       conf.Filters,
+			BufferSize:    conf.BufferSize,
+			BatchSize:     conf.BatchSize,
+			FlushInterval: conf.FlushInterval,
+		}))
+	}
+	ctx := log.NewContext(context.Background(), log.Default)
+	for _, exporter := range exporters {
+		if err := exporter.Subscribe(events.DefaultPubSub); err != nil {
+			return err
+		}
+		go exporter.Run(ctx)
+	}
+	return nil
 }
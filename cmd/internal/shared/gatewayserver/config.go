@@ -16,6 +16,7 @@ package shared
 
 import (
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/basicstation"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/udp"
 )
 
@@ -32,4 +33,9 @@ var DefaultGatewayServerConfig = gatewayserver.Config{
 		Listen:    ":1882",
 		ListenTLS: ":8882",
 	},
+	BasicStation: gatewayserver.BasicStationConfig{
+		Config:    basicstation.DefaultConfig,
+		Listen:    ":1887",
+		ListenTLS: ":8887",
+	},
 }
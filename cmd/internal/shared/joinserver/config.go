@@ -16,6 +16,7 @@ package joinserver
 
 import (
 	"go.thethings.network/lorawan-stack/pkg/joinserver"
+	"go.thethings.network/lorawan-stack/pkg/joinserver/provisioning"
 	"go.thethings.network/lorawan-stack/pkg/types"
 )
 
@@ -24,4 +25,10 @@ var DefaultJoinServerConfig = joinserver.Config{
 	JoinEUIPrefixes: []*types.EUI64Prefix{
 		{},
 	},
+	Provisioning: joinserver.ProvisioningConfig{
+		Manifest: joinserver.ManifestProvisioningConfig{
+			ID:               "manifest",
+			DeviceIDTemplate: provisioning.DefaultManifestDeviceIDTemplate,
+		},
+	},
 }
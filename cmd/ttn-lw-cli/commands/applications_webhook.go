@@ -29,8 +29,9 @@ import (
 )
 
 var (
-	selectApplicationWebhookFlags = util.FieldMaskFlags(&ttnpb.ApplicationWebhook{})
-	setApplicationWebhookFlags    = util.FieldFlags(&ttnpb.ApplicationWebhook{})
+	selectApplicationWebhookFlags         = util.FieldMaskFlags(&ttnpb.ApplicationWebhook{})
+	setApplicationWebhookFlags            = util.FieldFlags(&ttnpb.ApplicationWebhook{})
+	selectApplicationWebhookTemplateFlags = util.FieldMaskFlags(&ttnpb.ApplicationWebhookTemplate{})
 )
 
 func applicationWebhookIDFlags() *pflag.FlagSet {
@@ -69,6 +70,53 @@ func getApplicationWebhookID(flagSet *pflag.FlagSet, args []string) (*ttnpb.Appl
 	}, nil
 }
 
+func applicationWebhookTemplateIDFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("template-id", "", "")
+	return flagSet
+}
+
+var errNoWebhookTemplateID = errors.DefineInvalidArgument("no_webhook_template_id", "no webhook template ID set")
+
+func getApplicationWebhookTemplateID(flagSet *pflag.FlagSet, args []string) (*ttnpb.ApplicationWebhookTemplateIdentifiers, error) {
+	templateID, _ := flagSet.GetString("template-id")
+	switch len(args) {
+	case 0:
+	case 1:
+		templateID = args[0]
+	default:
+		logger.Warn("multiple IDs found in arguments, considering only the first")
+		templateID = args[0]
+	}
+	if templateID == "" {
+		return nil, errNoWebhookTemplateID
+	}
+	return &ttnpb.ApplicationWebhookTemplateIdentifiers{
+		TemplateID: templateID,
+	}, nil
+}
+
+func applicationWebhookTemplateFieldFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.StringArray("field", nil, "key=value")
+	return flagSet
+}
+
+var errInvalidWebhookTemplateField = errors.DefineInvalidArgument("invalid_webhook_template_field", "invalid webhook template field `{field}`")
+
+func getApplicationWebhookTemplateFields(flagSet *pflag.FlagSet) (map[string]string, error) {
+	kv, _ := flagSet.GetStringArray("field")
+	fields := make(map[string]string, len(kv))
+	for _, kv := range kv {
+		kv := strings.SplitN(kv, "=", 2)
+		if len(kv) != 2 {
+			return nil, errInvalidWebhookTemplateField.WithAttributes("field", kv[0])
+		}
+		fields[kv[0]] = kv[1]
+	}
+	return fields, nil
+}
+
 var (
 	applicationsWebhookCommand = &cobra.Command{
 		Use:   "webhook",
@@ -210,6 +258,106 @@ var (
 	}
 )
 
+var (
+	applicationsWebhookTemplatesCommand = &cobra.Command{
+		Use:     "templates",
+		Aliases: []string{"template"},
+		Short:   "Application webhook template commands",
+	}
+	applicationsWebhookTemplatesGetCommand = &cobra.Command{
+		Use:     "get",
+		Aliases: []string{"info"},
+		Short:   "Get the properties of an application webhook template",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			templateID, err := getApplicationWebhookTemplateID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			paths := util.SelectFieldMask(cmd.Flags(), selectApplicationWebhookTemplateFlags)
+			if len(paths) == 0 {
+				logger.Warn("No fields selected, will select everything")
+				selectApplicationWebhookTemplateFlags.VisitAll(func(flag *pflag.Flag) {
+					paths = append(paths, strings.Replace(flag.Name, "-", "_", -1))
+				})
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewApplicationWebhookRegistryClient(as).GetTemplate(ctx, &ttnpb.GetApplicationWebhookTemplateRequest{
+				ApplicationWebhookTemplateIdentifiers: *templateID,
+				FieldMask:                             types.FieldMask{Paths: paths},
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	applicationsWebhookTemplatesListCommand = &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List application webhook templates",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			paths := util.SelectFieldMask(cmd.Flags(), selectApplicationWebhookTemplateFlags)
+			if len(paths) == 0 {
+				logger.Warn("No fields selected, will select everything")
+				selectApplicationWebhookTemplateFlags.VisitAll(func(flag *pflag.Flag) {
+					paths = append(paths, strings.Replace(flag.Name, "-", "_", -1))
+				})
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewApplicationWebhookRegistryClient(as).ListTemplates(ctx, &ttnpb.ListApplicationWebhookTemplatesRequest{
+				FieldMask: types.FieldMask{Paths: paths},
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	applicationsWebhookTemplatesInstantiateCommand = &cobra.Command{
+		Use:   "instantiate [application-id] [webhook-id]",
+		Short: "Instantiate an application webhook template into an application webhook",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			webhookID, err := getApplicationWebhookID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			templateID, err := getApplicationWebhookTemplateID(cmd.Flags(), nil)
+			if err != nil {
+				return err
+			}
+			fields, err := getApplicationWebhookTemplateFields(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewApplicationWebhookRegistryClient(as).InstantiateTemplate(ctx, &ttnpb.InstantiateApplicationWebhookTemplateRequest{
+				WebhookIDs:  *webhookID,
+				TemplateIDs: *templateID,
+				Fields:      fields,
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+)
+
 func init() {
 	applicationsWebhookCommand.AddCommand(applicationsWebhookGetFormatsCommand)
 	applicationsWebhookGetCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
@@ -223,5 +371,15 @@ func init() {
 	applicationsWebhookCommand.AddCommand(applicationsWebhookSetCommand)
 	applicationsWebhookDeleteCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
 	applicationsWebhookCommand.AddCommand(applicationsWebhookDeleteCommand)
+	applicationsWebhookTemplatesGetCommand.Flags().AddFlagSet(applicationWebhookTemplateIDFlags())
+	applicationsWebhookTemplatesGetCommand.Flags().AddFlagSet(selectApplicationWebhookTemplateFlags)
+	applicationsWebhookTemplatesCommand.AddCommand(applicationsWebhookTemplatesGetCommand)
+	applicationsWebhookTemplatesListCommand.Flags().AddFlagSet(selectApplicationWebhookTemplateFlags)
+	applicationsWebhookTemplatesCommand.AddCommand(applicationsWebhookTemplatesListCommand)
+	applicationsWebhookTemplatesInstantiateCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
+	applicationsWebhookTemplatesInstantiateCommand.Flags().AddFlagSet(applicationWebhookTemplateIDFlags())
+	applicationsWebhookTemplatesInstantiateCommand.Flags().AddFlagSet(applicationWebhookTemplateFieldFlags())
+	applicationsWebhookTemplatesCommand.AddCommand(applicationsWebhookTemplatesInstantiateCommand)
+	applicationsWebhookCommand.AddCommand(applicationsWebhookTemplatesCommand)
 	applicationsCommand.AddCommand(applicationsWebhookCommand)
 }
@@ -32,6 +32,8 @@ type Config struct {
 	InputFormat              string `name:"input-format" description:"Input format"`
 	OutputFormat             string `name:"output-format" description:"Output format"`
 	OAuthServerAddress       string `name:"oauth-server-address" description:"OAuth Server Address"`
+	OAuthClientID            string `name:"oauth-client-id" description:"OAuth client ID"`
+	OAuthClientSecret        string `name:"oauth-client-secret" description:"OAuth client secret"`
 	IdentityServerAddress    string `name:"identity-server-address" description:"Identity Server Address"`
 	GatewayServerAddress     string `name:"gateway-server-address" description:"Gateway Server Address"`
 	NetworkServerAddress     string `name:"network-server-address" description:"Network Server Address"`
@@ -51,6 +53,7 @@ var DefaultConfig = Config{
 	InputFormat:              "json",
 	OutputFormat:             "json",
 	OAuthServerAddress:       clusterHTTPAddress,
+	OAuthClientID:            "cli",
 	IdentityServerAddress:    clusterGRPCAddress,
 	GatewayServerAddress:     clusterGRPCAddress,
 	NetworkServerAddress:     clusterGRPCAddress,
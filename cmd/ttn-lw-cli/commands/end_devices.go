@@ -594,6 +594,44 @@ var (
 			return deleteEndDevice(devID)
 		},
 	}
+	endDevicesClearDevNoncesCommand = &cobra.Command{
+		Use:   "clear-dev-nonces",
+		Short: "Clear the DevNonce history of an end device in the Join Server",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			if devID.JoinEUI == nil || devID.DevEUI == nil {
+				is, err := api.Dial(ctx, config.IdentityServerAddress)
+				if err != nil {
+					return err
+				}
+				existingDevice, err := ttnpb.NewEndDeviceRegistryClient(is).Get(ctx, &ttnpb.GetEndDeviceRequest{
+					EndDeviceIdentifiers: *devID,
+					FieldMask: pbtypes.FieldMask{Paths: []string{
+						"join_server_address",
+					}},
+				})
+				if err != nil {
+					return err
+				}
+				devID.JoinEUI, devID.DevEUI = existingDevice.JoinEUI, existingDevice.DevEUI
+				compareServerAddresses(existingDevice, config)
+			}
+			if devID.JoinEUI == nil || devID.DevEUI == nil {
+				return errNoEndDeviceEUI
+			}
+
+			js, err := api.Dial(ctx, config.JoinServerAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewJsEndDeviceRegistryClient(js).ClearDevNonces(ctx, devID)
+			return err
+		},
+	}
 )
 
 func init() {
@@ -640,12 +678,14 @@ func init() {
 	endDevicesCommand.AddCommand(endDevicesUpdateCommand)
 	endDevicesProvisionCommand.Flags().AddFlagSet(applicationIDFlags())
 	endDevicesProvisionCommand.Flags().AddFlagSet(dataFlags())
-	endDevicesProvisionCommand.Flags().String("provisioner-id", "", "provisioner service")
+	endDevicesProvisionCommand.Flags().String("provisioner-id", "", "provisioner service (e.g. microchip, manifest)")
 	endDevicesProvisionCommand.Flags().String("join-eui", "", "(hex)")
 	endDevicesProvisionCommand.Flags().String("start-dev-eui", "", "starting DevEUI to provision (hex)")
 	endDevicesCommand.AddCommand(endDevicesProvisionCommand)
 	endDevicesDeleteCommand.Flags().AddFlagSet(endDeviceIDFlags())
 	endDevicesCommand.AddCommand(endDevicesDeleteCommand)
+	endDevicesClearDevNoncesCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesCommand.AddCommand(endDevicesClearDevNoncesCommand)
 
 	endDevicesCommand.AddCommand(applicationsDownlinkCommand)
 
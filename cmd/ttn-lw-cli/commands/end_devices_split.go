@@ -78,6 +78,7 @@ func getEndDevicePathFromNS(pathParts ...string) bool {
 		"mac_state",
 		"max_frequency",
 		"min_frequency",
+		"multicast",
 		"power_state",
 		"recent_downlinks",
 		"recent_uplinks",
@@ -129,6 +130,7 @@ func setEndDevicePathToNS(pathParts ...string) bool {
 		"mac_settings",
 		"max_frequency",
 		"min_frequency",
+		"multicast",
 		"resets_f_cnt",
 		"resets_join_nonces",
 		"supports_class_b",
@@ -169,6 +171,7 @@ func setEndDevicePathToNS(pathParts ...string) bool {
 func getEndDevicePathFromAS(pathParts ...string) bool {
 	switch pathParts[0] {
 	case
+		"downlink_policy",
 		"formatters",
 		"queued_application_downlinks":
 		return true
@@ -198,7 +201,9 @@ func getEndDevicePathFromAS(pathParts ...string) bool {
 // set in the Application Server.
 func setEndDevicePathToAS(pathParts ...string) bool {
 	switch pathParts[0] {
-	case "formatters":
+	case
+		"downlink_policy",
+		"formatters":
 		return true
 	case "session":
 		if len(pathParts) == 1 {
@@ -228,6 +233,9 @@ func setEndDevicePathToAS(pathParts ...string) bool {
 func getEndDevicePathFromJS(pathParts ...string) bool {
 	switch pathParts[0] {
 	case
+		"application_server_id",
+		"application_server_kek_label",
+		"dev_nonce_policy",
 		"last_dev_nonce",
 		"last_join_nonce",
 		"last_rj_count_0",
@@ -249,6 +257,9 @@ func setEndDevicePathToJS(pathParts ...string) bool {
 	switch pathParts[0] {
 	case
 		"application_server_address",
+		"application_server_id",
+		"application_server_kek_label",
+		"dev_nonce_policy",
 		"last_dev_nonce",
 		"last_join_nonce",
 		"last_rj_count_0",
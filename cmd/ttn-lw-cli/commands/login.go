@@ -15,22 +15,167 @@
 package commands
 
 import (
+	"encoding/json"
+	"fmt"
 	"net"
 	"net/http"
+	"net/url"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
 )
 
+const deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+var (
+	errOAuth              = errors.DefineUnauthenticated("oauth", "OAuth error `{error}`: {description}")
+	errDeviceCodeExpired  = errors.DefineUnauthenticated("device_code_expired", "device code expired before authorization")
+	errLoginFlagsConflict = errors.DefineInvalidArgument("login_flags_conflict", "only one of `client-credentials` and `device-code` can be set")
+)
+
+// clientCredentialsConfig returns the configuration for the OAuth client credentials grant.
+func clientCredentialsConfig() *clientcredentials.Config {
+	return &clientcredentials.Config{
+		ClientID:     oauth2Config.ClientID,
+		ClientSecret: oauth2Config.ClientSecret,
+		TokenURL:     oauth2Config.Endpoint.TokenURL,
+	}
+}
+
+type deviceAuthorizationResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int64  `json:"expires_in"`
+	Interval                int64  `json:"interval"`
+}
+
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	RefreshToken     string `json:"refresh_token"`
+	ExpiresIn        int64  `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func postOAuthForm(endpoint string, values url.Values, res interface{}) error {
+	req, err := http.NewRequest(http.MethodPost, endpoint, strings.NewReader(values.Encode()))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	httpRes, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer httpRes.Body.Close()
+	return json.NewDecoder(httpRes.Body).Decode(res)
+}
+
+// deviceCodeLogin gets an OAuth access token with the device authorization grant (RFC 8628).
+func deviceCodeLogin() (*oauth2.Token, error) {
+	values := make(url.Values)
+	values.Set("client_id", oauth2Config.ClientID)
+	if oauth2Config.ClientSecret != "" {
+		values.Set("client_secret", oauth2Config.ClientSecret)
+	}
+
+	var authorization struct {
+		deviceAuthorizationResponse
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := postOAuthForm(fmt.Sprintf("%s/oauth/device_authorization", config.OAuthServerAddress), values, &authorization); err != nil {
+		return nil, err
+	}
+	if authorization.Error != "" {
+		return nil, errOAuth.WithAttributes("error", authorization.Error, "description", authorization.ErrorDescription)
+	}
+
+	logger.Infof("Please go to %s and enter the code %s", authorization.VerificationURI, authorization.UserCode)
+	if authorization.VerificationURIComplete != "" {
+		logger.Infof("Or go to %s", authorization.VerificationURIComplete)
+	}
+	logger.Info("Waiting for your authorization...")
+
+	values.Set("grant_type", deviceCodeGrantType)
+	values.Set("device_code", authorization.DeviceCode)
+	interval := time.Duration(authorization.Interval) * time.Second
+	if interval == 0 {
+		interval = 5 * time.Second
+	}
+	expiry := time.Now().Add(time.Duration(authorization.ExpiresIn) * time.Second)
+	for time.Now().Before(expiry) {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+		var token tokenResponse
+		if err := postOAuthForm(oauth2Config.Endpoint.TokenURL, values, &token); err != nil {
+			return nil, err
+		}
+		switch token.Error {
+		case "":
+			return &oauth2.Token{
+				AccessToken:  token.AccessToken,
+				TokenType:    token.TokenType,
+				RefreshToken: token.RefreshToken,
+				Expiry:       time.Now().Add(time.Duration(token.ExpiresIn) * time.Second),
+			}, nil
+		case "authorization_pending":
+		case "slow_down":
+			interval += 5 * time.Second
+		case "expired_token":
+			return nil, errDeviceCodeExpired
+		default:
+			return nil, errOAuth.WithAttributes("error", token.Error, "description", token.ErrorDescription)
+		}
+	}
+	return nil, errDeviceCodeExpired
+}
+
 var (
 	loginCommand = &cobra.Command{
 		Use:   "login",
 		Short: "Login",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCredentials, _ := cmd.Flags().GetBool("client-credentials")
+			deviceCode, _ := cmd.Flags().GetBool("device-code")
+			switch {
+			case clientCredentials && deviceCode:
+				return errLoginFlagsConflict
+			case clientCredentials:
+				token, err := clientCredentialsConfig().Token(ctx)
+				if err != nil {
+					return err
+				}
+				cache.Set("oauth_token", token)
+				cache.Set("oauth_client_credentials", true)
+				logger.Info("Got OAuth access token")
+				return nil
+			case deviceCode:
+				token, err := deviceCodeLogin()
+				if err != nil {
+					return err
+				}
+				cache.Set("oauth_token", token)
+				cache.Set("oauth_client_credentials", false)
+				logger.Info("Got OAuth access token")
+				return nil
+			}
+
 			lis, err := net.Listen("tcp", ":11885")
 			if err != nil {
 				return err
@@ -67,6 +212,7 @@ var (
 			lis.Close()
 
 			cache.Set("oauth_token", token)
+			cache.Set("oauth_client_credentials", false)
 
 			return nil
 		},
@@ -102,6 +248,7 @@ var (
 				}
 
 				cache.Set("oauth_token", (*oauth2.Token)(nil))
+				cache.Set("oauth_client_credentials", false)
 
 				logger.Info("Logged out")
 			}
@@ -116,6 +263,8 @@ var (
 )
 
 func init() {
+	loginCommand.Flags().Bool("client-credentials", false, "login with the client credentials of the OAuth client")
+	loginCommand.Flags().Bool("device-code", false, "login with a device code that is entered in the browser")
 	Root.AddCommand(loginCommand)
 	Root.AddCommand(logoutCommand)
 }
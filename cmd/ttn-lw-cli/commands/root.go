@@ -116,7 +116,8 @@ var (
 
 			// OAuth
 			oauth2Config = &oauth2.Config{
-				ClientID: "cli",
+				ClientID:     config.OAuthClientID,
+				ClientSecret: config.OAuthClientSecret,
 				Endpoint: oauth2.Endpoint{
 					AuthURL:  fmt.Sprintf("%s/oauth/authorize", config.OAuthServerAddress),
 					TokenURL: fmt.Sprintf("%s/oauth/token", config.OAuthServerAddress),
@@ -128,7 +129,12 @@ var (
 				logger.Debug("Using API key")
 				api.SetAuth("bearer", apiKey)
 			} else if token, ok := cache.Get("oauth_token").(*oauth2.Token); ok && token != nil {
-				freshToken, err := oauth2Config.TokenSource(ctx, token).Token()
+				tokenSource := oauth2Config.TokenSource(ctx, token)
+				if clientCredentials, _ := cache.Get("oauth_client_credentials").(bool); clientCredentials {
+					// Tokens of the client credentials grant can not be refreshed, so we request a new one.
+					tokenSource = oauth2.ReuseTokenSource(token, clientCredentialsConfig().TokenSource(ctx))
+				}
+				freshToken, err := tokenSource.Token()
 				if freshToken != token {
 					cache.Set("oauth_token", freshToken)
 					err := util.SaveCache(cache)
@@ -16,16 +16,23 @@ package commands
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/jinzhu/gorm"
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
+var (
+	errInvalidGrant                   = errors.DefineInvalidArgument("invalid_grant", "invalid grant `{grant}`")
+	errClientCredentialsWithoutSecret = errors.DefineInvalidArgument("client_credentials_without_secret", "client credentials grant requires a secret")
+)
+
 var (
 	createOAuthClient = &cobra.Command{
 		Use:   "create-oauth-client",
@@ -89,6 +96,21 @@ var (
 			if err != nil {
 				return err
 			}
+			grantNames, err := cmd.Flags().GetStringSlice("grant")
+			if err != nil {
+				return err
+			}
+			grants := make([]ttnpb.GrantType, len(grantNames))
+			for i, grantName := range grantNames {
+				grant, ok := ttnpb.GrantType_value["GRANT_"+strings.ToUpper(grantName)]
+				if !ok {
+					return errInvalidGrant.WithAttributes("grant", grantName)
+				}
+				grants[i] = ttnpb.GrantType(grant)
+				if grants[i] == ttnpb.GRANT_CLIENT_CREDENTIALS && secret == "" {
+					return errClientCredentialsWithoutSecret
+				}
+			}
 
 			logger.Info("Creating OAuth client...")
 			err = store.Transact(ctx, db, func(db *gorm.DB) error {
@@ -101,7 +123,7 @@ var (
 					State:             ttnpb.STATE_APPROVED,
 					SkipAuthorization: authorized,
 					Endorsed:          endorsed,
-					Grants:            []ttnpb.GrantType{ttnpb.GRANT_AUTHORIZATION_CODE, ttnpb.GRANT_REFRESH_TOKEN},
+					Grants:            grants,
 					Rights:            []ttnpb.Right{ttnpb.RIGHT_ALL},
 				})
 				if err != nil {
@@ -141,5 +163,6 @@ func init() {
 	createOAuthClient.Flags().StringSlice("redirect-uri", []string{}, "Redirect URIs of the OAuth client")
 	createOAuthClient.Flags().Bool("authorized", true, "Mark OAuth client as pre-authorized")
 	createOAuthClient.Flags().Bool("endorsed", true, "Mark OAuth client as endorsed ")
+	createOAuthClient.Flags().StringSlice("grant", []string{"authorization_code", "refresh_token"}, "Grants of the OAuth client (authorization_code, refresh_token, password, client_credentials, device_code)")
 	isDBCommand.AddCommand(createOAuthClient)
 }
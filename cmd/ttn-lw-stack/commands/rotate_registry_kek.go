@@ -0,0 +1,171 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+	asredis "go.thethings.network/lorawan-stack/pkg/applicationserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	jsredis "go.thethings.network/lorawan-stack/pkg/joinserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	nsredis "go.thethings.network/lorawan-stack/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/redis"
+)
+
+// keyRewrapper is a registry of which the stored keys can be re-wrapped with a new KEK.
+type keyRewrapper interface {
+	RewrapKeys(oldKEKLabel, newKEKLabel string) (int, error)
+}
+
+type namedKeyRewrapper struct {
+	Name     string
+	Registry keyRewrapper
+}
+
+// registryKeyRewrappers returns the registries of the given components, of which the stored keys can be re-wrapped.
+// newRedis returns a Redis client for the given namespace.
+func registryKeyRewrappers(components []string, keyVault crypto.KeyVault, newRedis func(namespace ...string) *redis.Client) ([]namedKeyRewrapper, error) {
+	var rotate struct {
+		NetworkServer     bool
+		ApplicationServer bool
+		JoinServer        bool
+	}
+	if len(components) == 0 {
+		components = []string{"all"}
+	}
+	for _, component := range components {
+		switch strings.ToLower(component) {
+		case "ns", "networkserver":
+			rotate.NetworkServer = true
+		case "as", "applicationserver":
+			rotate.ApplicationServer = true
+		case "js", "joinserver":
+			rotate.JoinServer = true
+		case "all":
+			rotate.NetworkServer = true
+			rotate.ApplicationServer = true
+			rotate.JoinServer = true
+		default:
+			return nil, errUnknownComponent.WithAttributes("component", component)
+		}
+	}
+	var rewrappers []namedKeyRewrapper
+	if rotate.NetworkServer {
+		rewrappers = append(rewrappers, namedKeyRewrapper{
+			Name: "Network Server devices",
+			Registry: &nsredis.DeviceRegistry{
+				Redis:    newRedis("ns", "devices"),
+				KeyVault: keyVault,
+			},
+		})
+	}
+	if rotate.ApplicationServer {
+		rewrappers = append(rewrappers, namedKeyRewrapper{
+			Name: "Application Server devices",
+			Registry: &asredis.DeviceRegistry{
+				Redis:    newRedis("as", "devices"),
+				KeyVault: keyVault,
+			},
+		})
+	}
+	if rotate.JoinServer {
+		rewrappers = append(rewrappers,
+			namedKeyRewrapper{
+				Name: "Join Server devices",
+				Registry: &jsredis.DeviceRegistry{
+					Redis:    newRedis("js", "devices"),
+					KeyVault: keyVault,
+				},
+			},
+			namedKeyRewrapper{
+				Name: "Join Server session keys",
+				Registry: &jsredis.KeyRegistry{
+					Redis:    newRedis("js", "keys"),
+					KeyVault: keyVault,
+				},
+			},
+		)
+	}
+	return rewrappers, nil
+}
+
+// rewrapRegistryKeys re-wraps the keys in the given registries from the old KEK label to the new KEK label.
+func rewrapRegistryKeys(logger log.Interface, rewrappers []namedKeyRewrapper, oldKEKLabel, newKEKLabel string) error {
+	for _, r := range rewrappers {
+		logger.Infof("Re-wrapping keys in %s...", r.Name)
+		n, err := r.Registry.RewrapKeys(oldKEKLabel, newKEKLabel)
+		if err != nil {
+			return err
+		}
+		logger.Infof("Re-wrapped keys of %d entries in %s", n, r.Name)
+	}
+	return nil
+}
+
+var rotateRegistryKEKCommand = &cobra.Command{
+	Use:   "rotate-registry-kek [ns|as|js|all]... [flags]",
+	Short: "Re-wrap the keys stored in the device registries with a new KEK",
+	Long: `Re-wrap the keys stored in the device registries with a new KEK.
+
+Keys wrapped with the old KEK label are unwrapped and wrapped again with the
+new KEK label. If the old KEK label is empty, plaintext keys are wrapped.
+After rotation, the registry KEK label in the configuration should be set to
+the new KEK label.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		oldKEKLabel := config.KeyVault.RegistryKEKLabel
+		if cmd.Flags().Changed("old-kek-label") {
+			var err error
+			oldKEKLabel, err = cmd.Flags().GetString("old-kek-label")
+			if err != nil {
+				return err
+			}
+		}
+		newKEKLabel, err := cmd.Flags().GetString("new-kek-label")
+		if err != nil {
+			return err
+		}
+		if !cmd.Flags().Changed("new-kek-label") {
+			return errMissingFlag.WithAttributes("flag", "new-kek-label")
+		}
+
+		keyVault, err := config.KeyVault.KeyVault()
+		if err != nil {
+			return err
+		}
+		rewrappers, err := registryKeyRewrappers(args, keyVault, func(namespace ...string) *redis.Client {
+			return redis.New(&redis.Config{
+				Redis:     config.Redis,
+				Namespace: namespace,
+			})
+		})
+		if err != nil {
+			return err
+		}
+		if err := rewrapRegistryKeys(logger, rewrappers, oldKEKLabel, newKEKLabel); err != nil {
+			return err
+		}
+
+		logger.Info("Successfully rotated KEK")
+		return nil
+	},
+}
+
+func init() {
+	rotateRegistryKEKCommand.Flags().String("old-kek-label", "", "KEK label the keys are currently wrapped with (defaults to the configured registry KEK label)")
+	rotateRegistryKEKCommand.Flags().String("new-kek-label", "", "KEK label to wrap the keys with")
+	Root.AddCommand(rotateRegistryKEKCommand)
+}
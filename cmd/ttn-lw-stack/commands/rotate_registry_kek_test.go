@@ -0,0 +1,124 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestRegistryKeyRewrappers(t *testing.T) {
+	keyVault := cryptoutil.NewMemKeyVault(map[string][]byte{})
+
+	for _, tc := range []struct {
+		Components []string
+		Names      []string
+		Namespaces []string
+		Error      bool
+	}{
+		{
+			Names:      []string{"Network Server devices", "Application Server devices", "Join Server devices", "Join Server session keys"},
+			Namespaces: []string{"ns:devices", "as:devices", "js:devices", "js:keys"},
+		},
+		{
+			Components: []string{"all"},
+			Names:      []string{"Network Server devices", "Application Server devices", "Join Server devices", "Join Server session keys"},
+			Namespaces: []string{"ns:devices", "as:devices", "js:devices", "js:keys"},
+		},
+		{
+			Components: []string{"ns"},
+			Names:      []string{"Network Server devices"},
+			Namespaces: []string{"ns:devices"},
+		},
+		{
+			Components: []string{"JS", "applicationserver"},
+			Names:      []string{"Application Server devices", "Join Server devices", "Join Server session keys"},
+			Namespaces: []string{"as:devices", "js:devices", "js:keys"},
+		},
+		{
+			Components: []string{"ns", "gs"},
+			Error:      true,
+		},
+	} {
+		t.Run(strings.Join(tc.Components, ","), func(t *testing.T) {
+			a := assertions.New(t)
+
+			var namespaces []string
+			rewrappers, err := registryKeyRewrappers(tc.Components, keyVault, func(namespace ...string) *redis.Client {
+				namespaces = append(namespaces, strings.Join(namespace, ":"))
+				return redis.New(&redis.Config{
+					Namespace: namespace,
+				})
+			})
+			if tc.Error {
+				a.So(errors.IsInvalidArgument(err), should.BeTrue)
+				a.So(rewrappers, should.BeNil)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			var names []string
+			for _, r := range rewrappers {
+				names = append(names, r.Name)
+			}
+			a.So(names, should.Resemble, tc.Names)
+			a.So(namespaces, should.Resemble, tc.Namespaces)
+		})
+	}
+}
+
+type mockKeyRewrapper struct {
+	n     int
+	err   error
+	calls [][2]string
+}
+
+func (r *mockKeyRewrapper) RewrapKeys(oldKEKLabel, newKEKLabel string) (int, error) {
+	r.calls = append(r.calls, [2]string{oldKEKLabel, newKEKLabel})
+	return r.n, r.err
+}
+
+func TestRewrapRegistryKeys(t *testing.T) {
+	a := assertions.New(t)
+	logger := test.GetLogger(t)
+
+	first := &mockKeyRewrapper{n: 2}
+	second := &mockKeyRewrapper{n: 1}
+	err := rewrapRegistryKeys(logger, []namedKeyRewrapper{
+		{Name: "first", Registry: first},
+		{Name: "second", Registry: second},
+	}, "old", "new")
+	a.So(err, should.BeNil)
+	a.So(first.calls, should.Resemble, [][2]string{{"old", "new"}})
+	a.So(second.calls, should.Resemble, [][2]string{{"old", "new"}})
+
+	errRewrap := errors.New("rewrap failed")
+	first = &mockKeyRewrapper{err: errRewrap}
+	second = &mockKeyRewrapper{}
+	err = rewrapRegistryKeys(logger, []namedKeyRewrapper{
+		{Name: "first", Registry: first},
+		{Name: "second", Registry: second},
+	}, "old", "new")
+	a.So(err, should.Resemble, errRewrap)
+	a.So(second.calls, should.BeNil)
+}
@@ -23,6 +23,8 @@ import (
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/cmd/internal/shared"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver"
+	asiopubsubredis "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/redis"
+	asiostorageredis "go.thethings.network/lorawan-stack/pkg/applicationserver/io/storage/redis"
 	asiowebredis "go.thethings.network/lorawan-stack/pkg/applicationserver/io/web/redis"
 	asredis "go.thethings.network/lorawan-stack/pkg/applicationserver/redis"
 	"go.thethings.network/lorawan-stack/pkg/component"
@@ -118,10 +120,14 @@ var (
 
 			if start.NetworkServer || startDefault {
 				logger.Info("Setting up Network Server")
-				config.NS.Devices = &nsredis.DeviceRegistry{Redis: redis.New(&redis.Config{
-					Redis:     config.Redis,
-					Namespace: []string{"ns", "devices"},
-				})}
+				config.NS.Devices = &nsredis.DeviceRegistry{
+					Redis: redis.New(&redis.Config{
+						Redis:     config.Redis,
+						Namespace: []string{"ns", "devices"},
+					}),
+					KeyVault: c.KeyVault,
+					KEKLabel: config.KeyVault.RegistryKEKLabel,
+				}
 				nsDownlinkTasks := nsredis.NewDownlinkTaskQueue(redis.New(&redis.Config{
 					Redis:     config.Redis,
 					Namespace: []string{"ns", "tasks"},
@@ -143,33 +149,75 @@ var (
 					Redis:     config.Redis,
 					Namespace: []string{"as", "links"},
 				})}
-				config.AS.Devices = &asredis.DeviceRegistry{Redis: redis.New(&redis.Config{
+				config.AS.Devices = &asredis.DeviceRegistry{
+					Redis: redis.New(&redis.Config{
+						Redis:     config.Redis,
+						Namespace: []string{"as", "devices"},
+					}),
+					KeyVault: c.KeyVault,
+					KEKLabel: config.KeyVault.RegistryKEKLabel,
+				}
+				config.AS.Packages.Fragmentation = &asredis.FragmentationSessionRegistry{Redis: redis.New(&redis.Config{
 					Redis:     config.Redis,
-					Namespace: []string{"as", "devices"},
+					Namespace: []string{"as", "packages", "fragmentation"},
 				})}
+				var asWebhookQueue *asiowebredis.DeliveryQueue
 				if config.AS.Webhooks.Target != "" {
 					config.AS.Webhooks.Registry = &asiowebredis.WebhookRegistry{Redis: redis.New(&redis.Config{
 						Redis:     config.Redis,
 						Namespace: []string{"as", "io", "webhooks"},
 					})}
+					asWebhookQueue = asiowebredis.NewDeliveryQueue(redis.New(&redis.Config{
+						Redis:     config.Redis,
+						Namespace: []string{"as", "io", "webhooks", "queue"},
+					}), 100000, "as", redis.Key(host, strconv.Itoa(os.Getpid())), int64(config.AS.Webhooks.DeadLetterLimit))
+					if err := asWebhookQueue.Init(); err != nil {
+						return shared.ErrInitializeApplicationServer.WithCause(err)
+					}
+					config.AS.Webhooks.Queue = asWebhookQueue
+				}
+				if config.AS.Storage.Enable {
+					config.AS.Storage.Registry = &asiostorageredis.Storage{
+						Redis: redis.New(&redis.Config{
+							Redis:     config.Redis,
+							Namespace: []string{"as", "io", "storage"},
+						}),
+						Retention: config.AS.Storage.Retention,
+					}
+				}
+				if config.AS.PubSub.Enable {
+					config.AS.PubSub.Registry = &asiopubsubredis.PubSubRegistry{Redis: redis.New(&redis.Config{
+						Redis:     config.Redis,
+						Namespace: []string{"as", "io", "pubsub"},
+					})}
 				}
 				as, err := applicationserver.New(c, &config.AS)
 				if err != nil {
 					return shared.ErrInitializeApplicationServer.WithCause(err)
 				}
-				_ = as
+				if asWebhookQueue != nil {
+					as.Component.RegisterTask("queue_webhooks", asWebhookQueue.Run, component.TaskRestartOnFailure)
+				}
 			}
 
 			if start.JoinServer || startDefault {
 				logger.Info("Setting up Join Server")
-				config.JS.Devices = &jsredis.DeviceRegistry{Redis: redis.New(&redis.Config{
-					Redis:     config.Redis,
-					Namespace: []string{"js", "devices"},
-				})}
-				config.JS.Keys = &jsredis.KeyRegistry{Redis: redis.New(&redis.Config{
-					Redis:     config.Redis,
-					Namespace: []string{"js", "keys"},
-				})}
+				config.JS.Devices = &jsredis.DeviceRegistry{
+					Redis: redis.New(&redis.Config{
+						Redis:     config.Redis,
+						Namespace: []string{"js", "devices"},
+					}),
+					KeyVault: c.KeyVault,
+					KEKLabel: config.KeyVault.RegistryKEKLabel,
+				}
+				config.JS.Keys = &jsredis.KeyRegistry{
+					Redis: redis.New(&redis.Config{
+						Redis:     config.Redis,
+						Namespace: []string{"js", "keys"},
+					}),
+					KeyVault: c.KeyVault,
+					KEKLabel: config.KeyVault.RegistryKEKLabel,
+				}
 				js, err := joinserver.New(c, &config.JS)
 				if err != nil {
 					return shared.ErrInitializeJoinServer.WithCause(err)
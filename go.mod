@@ -37,6 +37,7 @@ require (
 	github.com/google/wire v0.2.1 // indirect
 	github.com/gordonklaus/ineffassign v0.0.0-20180909121442-1003c8bd00dc // indirect
 	github.com/gorilla/securecookie v1.1.1
+	github.com/gorilla/websocket v1.4.1
 	github.com/gotnospirit/makeplural v0.0.0-20180622080156-a5f48d94d976 // indirect
 	github.com/gotnospirit/messageformat v0.0.0-20180622080451-0eab1176a3fb
 	github.com/gregjones/httpcache v0.0.0-20181110185634-c63ab54fda8f
@@ -68,6 +69,7 @@ require (
 	github.com/mdempsky/maligned v0.0.0-20180708014732-6e39bd26a8c8 // indirect
 	github.com/mdempsky/unconvert v0.0.0-20190117010209-2db5a8ead8e7 // indirect
 	github.com/mibk/dupl v1.0.0 // indirect
+	github.com/miekg/pkcs11 v1.1.1
 	github.com/mitchellh/mapstructure v1.1.2
 	github.com/mohae/deepcopy v0.0.0-20170929034955-c48cc78d4826
 	github.com/mozilla/tls-observatory v0.0.0-20181217184626-169b7da23694 // indirect
@@ -27,19 +27,33 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
 	iogrpc "go.thethings.network/lorawan-stack/pkg/applicationserver/io/grpc"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/mqtt"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub"
+	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/mqtt" // MQTT pub/sub provider
+	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/nats" // NATS pub/sub provider
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/storage"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/packages"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/packages/clocksync"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/packages/fragmentation"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/packages/multicastsetup"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	ttnblob "go.thethings.network/lorawan-stack/pkg/blob"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	"go.thethings.network/lorawan-stack/pkg/crypto"
 	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/locationsolver"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/messageprocessors"
 	"go.thethings.network/lorawan-stack/pkg/messageprocessors/cayennelpp"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors/grpcservice"
 	"go.thethings.network/lorawan-stack/pkg/messageprocessors/javascript"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors/schema"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/unique"
+	"gocloud.dev/blob"
 	"google.golang.org/grpc"
 )
 
@@ -49,11 +63,22 @@ import (
 type ApplicationServer struct {
 	*component.Component
 
-	linkMode       LinkMode
-	linkRegistry   LinkRegistry
-	deviceRegistry DeviceRegistry
-	formatter      payloadFormatter
-	webhooks       web.Webhooks
+	linkMode         LinkMode
+	linkRegistry     LinkRegistry
+	deviceRegistry   DeviceRegistry
+	formatter        payloadFormatter
+	webhooks         web.Webhooks
+	webhookTemplates web.TemplateStore
+	storage          *storage.Integration
+	pubsub           *pubsub.PubSub
+	packages         packages.Handlers
+	clockSync        *clocksync.Handler
+	multicastSetup   *multicastsetup.Handler
+	fragmentation    *fragmentation.Handler
+
+	defaultDownlinkPolicy downlinkPolicy
+
+	locationCh chan locationUpdate
 
 	links              sync.Map
 	defaultSubscribers []*io.Subscription
@@ -72,24 +97,58 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 	if err != nil {
 		return nil, err
 	}
+	schemaFormatter := schema.New()
+	grpcServiceFormatter := grpcservice.New(c.Context(), grpcservice.Config{
+		Timeout:          conf.Formatters.GRPCService.Timeout,
+		TLS:              conf.Formatters.GRPCService.TLS,
+		FailureThreshold: conf.Formatters.GRPCService.FailureThreshold,
+		ResetTimeout:     conf.Formatters.GRPCService.ResetTimeout,
+		MaxServices:      conf.Formatters.GRPCService.MaxServices,
+		AllowedHosts:     conf.Formatters.GRPCService.AllowedHosts,
+	})
+	maxConfirmedRetries := conf.Downlinks.MaxConfirmedRetries
+	if maxConfirmedRetries == 0 {
+		maxConfirmedRetries = DefaultMaxConfirmedRetries
+	}
 	as = &ApplicationServer{
 		Component:      c,
 		linkMode:       linkMode,
 		linkRegistry:   conf.Links,
 		deviceRegistry: conf.Devices,
+		defaultDownlinkPolicy: downlinkPolicy{
+			maxConfirmedRetries: maxConfirmedRetries,
+			expiry:              conf.Downlinks.Expiry,
+		},
+		locationCh: make(chan locationUpdate, locationQueueSize),
 		formatter: payloadFormatter{
 			repository: c.GetBaseConfig(c.Context()).DeviceRepository.Client(),
 			upFormatters: map[ttnpb.PayloadFormatter]messageprocessors.PayloadDecoder{
-				ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT: javascript.New(),
-				ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP: cayennelpp.New(),
+				ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT:   javascript.New(),
+				ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP:   cayennelpp.New(),
+				ttnpb.PayloadFormatter_FORMATTER_SCHEMA:       schemaFormatter,
+				ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE: grpcServiceFormatter,
 			},
 			downFormatters: map[ttnpb.PayloadFormatter]messageprocessors.PayloadEncoder{
-				ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT: javascript.New(),
-				ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP: cayennelpp.New(),
+				ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT:   javascript.New(),
+				ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP:   cayennelpp.New(),
+				ttnpb.PayloadFormatter_FORMATTER_SCHEMA:       schemaFormatter,
+				ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE: grpcServiceFormatter,
 			},
 		},
 	}
 
+	as.clockSync = clocksync.New(as)
+	as.multicastSetup = multicastsetup.New(as)
+	packageHandlers := []packages.Handler{
+		as.clockSync,
+		as.multicastSetup,
+	}
+	if conf.Packages.Fragmentation != nil {
+		as.fragmentation = fragmentation.New(as, conf.Packages.Fragmentation, as.getBucket)
+		packageHandlers = append(packageHandlers, as.fragmentation)
+	}
+	as.packages = packages.NewHandlers(packageHandlers...)
+
 	ctx, cancel := context.WithCancel(c.Context())
 	defer func() {
 		if err != nil {
@@ -137,7 +196,9 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 					"address", lis.Listen,
 				)
 			}
-			mqtt.Start(ctx, as, netLis, version.Format, lis.Protocol)
+			mqtt.Start(ctx, as, netLis, version.Format, lis.Protocol,
+				mqtt.WithPersistentSessions(version.Config.SessionExpiry, version.Config.MaxQueuedMessages),
+			)
 		}
 	}
 
@@ -145,27 +206,57 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		return nil, err
 	} else if webhooks != nil {
 		as.webhooks = webhooks
+		as.webhookTemplates = conf.Webhooks.Templates.NewTemplateStore()
 		as.defaultSubscribers = append(as.defaultSubscribers, webhooks.NewSubscription())
 		c.RegisterWeb(webhooks)
 	}
 
+	if conf.Storage.Registry != nil {
+		as.storage = storage.New(as.FillContext(as.Context()), conf.Storage.Registry)
+		as.defaultSubscribers = append(as.defaultSubscribers, as.storage.NewSubscription())
+	}
+
+	if conf.PubSub.Registry != nil {
+		if as.pubsub, err = pubsub.New(as.FillContext(as.Context()), as, conf.PubSub.Registry); err != nil {
+			return nil, err
+		}
+		as.defaultSubscribers = append(as.defaultSubscribers, as.pubsub.NewSubscription())
+	}
+
 	c.RegisterGRPC(as)
+	c.RegisterTask("store_locations", as.storeLocations, component.TaskRestartOnFailure)
 	if as.linkMode == LinkAll {
 		c.RegisterTask("link_all", as.linkAll, component.TaskRestartOnFailure)
 	}
 	return as, nil
 }
 
+func (as *ApplicationServer) getBucket(ctx context.Context, name string) (*blob.Bucket, error) {
+	return ttnblob.Config(as.GetBaseConfig(ctx).Blob).GetBucket(ctx, name)
+}
+
 // RegisterServices registers services provided by as at s.
 func (as *ApplicationServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterAsServer(s, as)
 	ttnpb.RegisterAsEndDeviceRegistryServer(s, &deviceRegistryRPC{
-		registry: as.deviceRegistry,
+		registry:  as.deviceRegistry,
+		formatter: as.formatter,
 	})
 	ttnpb.RegisterAppAsServer(s, iogrpc.New(as))
 	if as.webhooks != nil {
-		ttnpb.RegisterApplicationWebhookRegistryServer(s, web.NewWebhookRegistryRPC(as.webhooks.Registry()))
+		ttnpb.RegisterApplicationWebhookRegistryServer(s, web.NewWebhookRegistryRPC(as.webhooks.Registry(), as.webhooks.DeliveryQueue(), as.webhookTemplates))
+	}
+	if as.storage != nil {
+		ttnpb.RegisterApplicationUpStorageServer(s, storage.NewApplicationUpStorageRPC(as.storage.Storage()))
+	}
+	if as.pubsub != nil {
+		ttnpb.RegisterApplicationPubSubRegistryServer(s, pubsub.NewPubSubRegistryRPC(as.pubsub))
 	}
+	ttnpb.RegisterApplicationPackagesServer(s, &packagesRPC{
+		clockSync:      as.clockSync,
+		multicastSetup: as.multicastSetup,
+		fragmentation:  as.fragmentation,
+	})
 }
 
 // RegisterHandlers registers gRPC handlers.
@@ -175,6 +266,13 @@ func (as *ApplicationServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.Cl
 	if as.webhooks != nil {
 		ttnpb.RegisterApplicationWebhookRegistryHandler(as.Context(), s, conn)
 	}
+	if as.storage != nil {
+		ttnpb.RegisterApplicationUpStorageHandler(as.Context(), s, conn)
+	}
+	if as.pubsub != nil {
+		ttnpb.RegisterApplicationPubSubRegistryHandler(as.Context(), s, conn)
+	}
+	ttnpb.RegisterApplicationPackagesHandler(as.Context(), s, conn)
 }
 
 // Roles returns the roles that the Application Server fulfills.
@@ -216,9 +314,81 @@ func (as *ApplicationServer) Subscribe(ctx context.Context, protocol string, ids
 var (
 	errDeviceNotFound  = errors.DefineNotFound("device_not_found", "device `{device_uid}` not found")
 	errNoDeviceSession = errors.DefineFailedPrecondition("no_device_session", "no device session; check device activation")
+
+	errDownlinkExpired           = errors.DefineAborted("downlink_expired", "downlink expired")
+	errExpiresAtPassed           = errors.DefineInvalidArgument("expires_at_passed", "expiry time `{expires_at}` has passed")
+	errAbsoluteTimePassed        = errors.DefineInvalidArgument("absolute_time_passed", "absolute time `{absolute_time}` has passed")
+	errExpiresBeforeAbsoluteTime = errors.DefineInvalidArgument("expires_before_absolute_time", "expiry time `{expires_at}` is before absolute time `{absolute_time}`")
+	errConfirmedRetriesExceeded  = errors.DefineResourceExhausted("confirmed_retries_exceeded", "confirmed downlink retries exceeded after `{retries}` retries")
 )
 
+// downlinkPolicy is the downlink policy that applies to an end device.
+type downlinkPolicy struct {
+	maxConfirmedRetries uint32
+	expiry              time.Duration
+}
+
+// apply overrides the fields of the policy that are set in the given policy.
+func (p *downlinkPolicy) apply(policy *ttnpb.ApplicationDownlinkPolicy) {
+	if policy == nil {
+		return
+	}
+	if policy.MaxConfirmedRetries != nil {
+		p.maxConfirmedRetries = policy.MaxConfirmedRetries.Value
+	}
+	if policy.Expiry != nil {
+		p.expiry = *policy.Expiry
+	}
+}
+
+// getDownlinkPolicy returns the downlink policy of the given end device.
+// The fields that are set in the downlink policy of the end device take precedence over the fields that are set in the
+// downlink policy of the link, which take precedence over the default downlink policy of the Application Server.
+func (as *ApplicationServer) getDownlinkPolicy(dev *ttnpb.EndDevice, link *link) downlinkPolicy {
+	policy := as.defaultDownlinkPolicy
+	policy.apply(link.DownlinkPolicy)
+	if dev != nil {
+		policy.apply(dev.DownlinkPolicy)
+	}
+	return policy
+}
+
+// downlinkExpired returns whether the given downlink message is expired at the given time.
+func downlinkExpired(msg *ttnpb.ApplicationDownlink, now time.Time) bool {
+	return msg.ExpiresAt != nil && !msg.ExpiresAt.After(now)
+}
+
+// validateDownlinkTimes validates the expiry time and absolute time of the given downlink messages.
+func validateDownlinkTimes(items []*ttnpb.ApplicationDownlink, now time.Time) error {
+	for _, item := range items {
+		var absoluteTime *time.Time
+		if item.ClassBC != nil {
+			absoluteTime = item.ClassBC.AbsoluteTime
+		}
+		if absoluteTime != nil && !absoluteTime.After(now) {
+			return errAbsoluteTimePassed.WithAttributes("absolute_time", *absoluteTime)
+		}
+		if item.ExpiresAt == nil {
+			continue
+		}
+		if downlinkExpired(item, now) {
+			return errExpiresAtPassed.WithAttributes("expires_at", *item.ExpiresAt)
+		}
+		if absoluteTime != nil && item.ExpiresAt.Before(*absoluteTime) {
+			return errExpiresBeforeAbsoluteTime.WithAttributes(
+				"expires_at", *item.ExpiresAt,
+				"absolute_time", *absoluteTime,
+			)
+		}
+	}
+	return nil
+}
+
 func (as *ApplicationServer) downlinkQueueOp(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink, op func(ttnpb.AsNsClient, context.Context, *ttnpb.DownlinkQueueRequest, ...grpc.CallOption) (*pbtypes.Empty, error)) error {
+	now := time.Now()
+	if err := validateDownlinkTimes(items, now); err != nil {
+		return err
+	}
 	ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("as:downlink:%s", events.NewCorrelationID()))
 	for _, item := range items {
 		item.CorrelationIDs = append(item.CorrelationIDs, events.CorrelationIDsFromContext(ctx)...)
@@ -234,6 +404,7 @@ func (as *ApplicationServer) downlinkQueueOp(ctx context.Context, ids ttnpb.EndD
 			"session",
 			"formatters",
 			"version_ids",
+			"downlink_policy",
 		},
 		func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 			if dev == nil {
@@ -242,8 +413,18 @@ func (as *ApplicationServer) downlinkQueueOp(ctx context.Context, ids ttnpb.EndD
 			if dev.Session == nil {
 				return nil, nil, errNoDeviceSession
 			}
+			policy := as.getDownlinkPolicy(dev, link)
 			for _, item := range items {
 				registerReceiveDownlink(ctx, ids, item)
+				item.ConfirmedRetries = 0
+				if item.ExpiresAt == nil && policy.expiry > 0 {
+					// Downlink messages scheduled at an absolute time expire relative to that time.
+					expiresAt := now.Add(policy.expiry)
+					if item.ClassBC != nil && item.ClassBC.AbsoluteTime != nil {
+						expiresAt = item.ClassBC.AbsoluteTime.Add(policy.expiry)
+					}
+					item.ExpiresAt = &expiresAt
+				}
 				item.SessionKeyID = dev.Session.SessionKeyID
 				item.FCnt = dev.Session.LastAFCntDown + 1
 				if err := as.encodeAndEncrypt(ctx, dev, item, link.DefaultFormatters); err != nil {
@@ -370,6 +551,8 @@ func (as *ApplicationServer) handleUp(ctx context.Context, up *ttnpb.Application
 		return as.decryptDownlinkMessage(ctx, up.EndDeviceIdentifiers, p.DownlinkAck)
 	case *ttnpb.ApplicationUp_DownlinkNack:
 		return as.handleDownlinkNack(ctx, up.EndDeviceIdentifiers, p.DownlinkNack, link)
+	case *ttnpb.ApplicationUp_LocationSolved:
+		return as.handleLocationSolved(ctx, up.EndDeviceIdentifiers, p.LocationSolved, link)
 	default:
 		return nil
 	}
@@ -543,12 +726,28 @@ func (as *ApplicationServer) handleDownlinkNack(ctx context.Context, ids ttnpb.E
 		log.WithError(err).Warn("Failed to list the downlink queue for inserting nacked downlink message")
 		registerDropDownlink(ctx, ids, msg, err)
 	} else {
-		queue := append([]*ttnpb.ApplicationDownlink{msg}, res.Downlinks...)
 		_, err := as.deviceRegistry.Set(ctx, ids,
 			[]string{
 				"session",
+				"downlink_policy",
 			},
 			func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+				if dev == nil {
+					return nil, nil, errDeviceNotFound.WithAttributes("device_uid", unique.ID(ctx, ids))
+				}
+				policy := as.getDownlinkPolicy(dev, link)
+				switch {
+				case downlinkExpired(msg, time.Now()):
+					registerExpireDownlink(ctx, ids, msg)
+					return dev, nil, nil
+				case msg.ConfirmedRetries >= policy.maxConfirmedRetries:
+					registerDropDownlink(ctx, ids, msg, errConfirmedRetriesExceeded.WithAttributes("retries", msg.ConfirmedRetries))
+					return dev, nil, nil
+				}
+				// Retry a copy of the message, as the nacked message is sent upstream.
+				retry := *msg
+				retry.ConfirmedRetries++
+				queue := append([]*ttnpb.ApplicationDownlink{&retry}, res.Downlinks...)
 				if err := as.recalculateDownlinkQueue(ctx, dev, nil, queue, msg.FCnt+1, link); err != nil {
 					return nil, nil, err
 				}
@@ -567,6 +766,117 @@ func (as *ApplicationServer) handleDownlinkNack(ctx context.Context, ids ttnpb.E
 	return nil
 }
 
+var errEntityRegistryNotFound = errors.DefineNotFound("entity_registry_not_found", "Entity Registry not found")
+
+const (
+	// locationQueueSize is the number of solved end device locations that can be queued for storing.
+	locationQueueSize = 64
+	// locationChangeThreshold is the distance in meters that a solved end device location needs to move from the
+	// stored location to be stored again.
+	locationChangeThreshold = 100
+)
+
+// locationUpdate is a solved end device location that is to be stored in the Entity Registry.
+type locationUpdate struct {
+	ctx  context.Context
+	ids  ttnpb.EndDeviceIdentifiers
+	loc  *ttnpb.ApplicationLocation
+	link *link
+}
+
+// locationChanged returns whether the solved location moved beyond the threshold or the accuracy of the solved
+// location from the stored location.
+func locationChanged(stored, solved ttnpb.Location) bool {
+	threshold := float64(locationChangeThreshold)
+	if accuracy := float64(solved.Accuracy); accuracy > threshold {
+		threshold = accuracy
+	}
+	return stored.Source != solved.Source || locationsolver.Distance(stored, solved) > threshold
+}
+
+// handleLocationSolved queues the solved location to be stored in the end device locations in the Entity Registry.
+// The location is only stored if it changed from the location that was last stored for the service that solved it.
+// Failure to queue the location is logged, so that the location is still forwarded to the application.
+func (as *ApplicationServer) handleLocationSolved(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, loc *ttnpb.ApplicationLocation, link *link) error {
+	logger := log.FromContext(ctx)
+	dev, err := as.deviceRegistry.Get(ctx, ids, []string{"locations"})
+	if err != nil {
+		logger.WithError(err).Warn("Failed to get stored end device location")
+		return nil
+	}
+	if stored, ok := dev.Locations[loc.Service]; ok && stored != nil && !locationChanged(*stored, loc.Location) {
+		return nil
+	}
+	select {
+	case as.locationCh <- locationUpdate{ctx: ctx, ids: ids, loc: loc, link: link}:
+	default:
+		logger.Warn("Location queue full, drop end device location")
+	}
+	return nil
+}
+
+// storeLocations stores the queued end device locations until the context is done.
+func (as *ApplicationServer) storeLocations(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case update := <-as.locationCh:
+			if err := as.storeLocation(update.ctx, update.ids, update.loc, update.link); err != nil {
+				log.FromContext(update.ctx).WithError(err).Warn("Failed to store end device location")
+			}
+		}
+	}
+}
+
+// storeLocation stores the location in the end device locations in the Entity Registry, keyed by the service that
+// solved the location, and records the stored location in the device registry.
+// The location is stored with the API key of the link, which needs the RIGHT_APPLICATION_DEVICES_READ and
+// RIGHT_APPLICATION_DEVICES_WRITE rights.
+func (as *ApplicationServer) storeLocation(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, loc *ttnpb.ApplicationLocation, link *link) error {
+	er := as.GetPeer(ctx, ttnpb.PeerInfo_ENTITY_REGISTRY, nil)
+	if er == nil {
+		return errEntityRegistryNotFound
+	}
+	callOpt := grpc.PerRPCCredentials(rpcmetadata.MD{
+		ID:            ids.ApplicationID,
+		AuthType:      "Bearer",
+		AuthValue:     link.APIKey,
+		AllowInsecure: !as.ClusterTLS(),
+	})
+	client := ttnpb.NewEndDeviceRegistryClient(er.Conn())
+	dev, err := client.Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: ids,
+		FieldMask:            pbtypes.FieldMask{Paths: []string{"locations"}},
+	}, callOpt)
+	if err != nil {
+		return err
+	}
+	if dev.Locations == nil {
+		dev.Locations = make(map[string]*ttnpb.Location, 1)
+	}
+	location := loc.Location
+	dev.Locations[loc.Service] = &location
+	_, err = client.Update(ctx, &ttnpb.UpdateEndDeviceRequest{
+		EndDevice: *dev,
+		FieldMask: pbtypes.FieldMask{Paths: []string{"locations"}},
+	}, callOpt)
+	if err != nil {
+		return err
+	}
+	_, err = as.deviceRegistry.Set(ctx, ids, []string{"locations"}, func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+		if dev == nil {
+			return nil, nil, errDeviceNotFound.WithAttributes("device_uid", unique.ID(ctx, ids))
+		}
+		if dev.Locations == nil {
+			dev.Locations = make(map[string]*ttnpb.Location, 1)
+		}
+		dev.Locations[loc.Service] = &location
+		return dev, []string{"locations"}, nil
+	})
+	return err
+}
+
 func (as *ApplicationServer) decryptDownlinkMessage(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, msg *ttnpb.ApplicationDownlink) error {
 	dev, err := as.deviceRegistry.Get(ctx, ids, []string{"session"})
 	if err != nil {
@@ -624,12 +934,18 @@ func (as *ApplicationServer) recalculateDownlinkQueue(ctx context.Context, dev *
 	}
 	dev.Session.LastAFCntDown = nextAFCntDown - 1
 	valid := make([]*ttnpb.ApplicationDownlink, 0, len(invalid))
+	now := time.Now()
 	for _, oldItem := range invalid {
 		logger := logger.WithFields(log.Fields(
 			"f_port", oldItem.FPort,
 			"f_cnt", oldItem.FCnt,
 			"session_key_id", oldItem.SessionKeyID,
 		))
+		if downlinkExpired(oldItem, now) {
+			logger.Debug("Dropping downlink message; expired")
+			registerExpireDownlink(ctx, dev.EndDeviceIdentifiers, oldItem)
+			continue
+		}
 		var oldSession *ttnpb.Session
 		for _, s := range []*ttnpb.Session{previousSession, dev.Session} {
 			if s != nil && bytes.Equal(s.SessionKeyID, oldItem.SessionKeyID) {
@@ -656,12 +972,15 @@ func (as *ApplicationServer) recalculateDownlinkQueue(ctx context.Context, dev *
 			continue
 		}
 		newItem := &ttnpb.ApplicationDownlink{
-			SessionKeyID:   dev.Session.SessionKeyID,
-			FPort:          oldItem.FPort,
-			FCnt:           dev.Session.LastAFCntDown + 1,
-			Confirmed:      oldItem.Confirmed,
-			ClassBC:        oldItem.ClassBC,
-			CorrelationIDs: oldItem.CorrelationIDs,
+			SessionKeyID:     dev.Session.SessionKeyID,
+			FPort:            oldItem.FPort,
+			FCnt:             dev.Session.LastAFCntDown + 1,
+			Confirmed:        oldItem.Confirmed,
+			ClassBC:          oldItem.ClassBC,
+			Priority:         oldItem.Priority,
+			CorrelationIDs:   oldItem.CorrelationIDs,
+			ConfirmedRetries: oldItem.ConfirmedRetries,
+			ExpiresAt:        oldItem.ExpiresAt,
 		}
 		newItem.FRMPayload, err = crypto.EncryptDownlink(newAppSKey, dev.Session.DevAddr, newItem.FCnt, frmPayload)
 		if err != nil {
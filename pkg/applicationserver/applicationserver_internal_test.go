@@ -0,0 +1,156 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestGetDownlinkPolicy(t *testing.T) {
+	as := &ApplicationServer{
+		defaultDownlinkPolicy: downlinkPolicy{
+			maxConfirmedRetries: DefaultMaxConfirmedRetries,
+			expiry:              time.Hour,
+		},
+	}
+	expiry := func(d time.Duration) *time.Duration { return &d }
+
+	for _, tc := range []struct {
+		Name         string
+		LinkPolicy   *ttnpb.ApplicationDownlinkPolicy
+		DevicePolicy *ttnpb.ApplicationDownlinkPolicy
+		Expected     downlinkPolicy
+	}{
+		{
+			Name: "Default",
+			Expected: downlinkPolicy{
+				maxConfirmedRetries: DefaultMaxConfirmedRetries,
+				expiry:              time.Hour,
+			},
+		},
+		{
+			Name: "LinkExpiry",
+			LinkPolicy: &ttnpb.ApplicationDownlinkPolicy{
+				Expiry: expiry(time.Minute),
+			},
+			Expected: downlinkPolicy{
+				maxConfirmedRetries: DefaultMaxConfirmedRetries,
+				expiry:              time.Minute,
+			},
+		},
+		{
+			Name: "LinkNoRetries",
+			LinkPolicy: &ttnpb.ApplicationDownlinkPolicy{
+				MaxConfirmedRetries: &pbtypes.UInt32Value{Value: 0},
+			},
+			Expected: downlinkPolicy{
+				maxConfirmedRetries: 0,
+				expiry:              time.Hour,
+			},
+		},
+		{
+			Name: "DeviceOverridesLink",
+			LinkPolicy: &ttnpb.ApplicationDownlinkPolicy{
+				MaxConfirmedRetries: &pbtypes.UInt32Value{Value: 2},
+				Expiry:              expiry(time.Minute),
+			},
+			DevicePolicy: &ttnpb.ApplicationDownlinkPolicy{
+				Expiry: expiry(0),
+			},
+			Expected: downlinkPolicy{
+				maxConfirmedRetries: 2,
+				expiry:              0,
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			l := &link{
+				ApplicationLink: ttnpb.ApplicationLink{
+					DownlinkPolicy: tc.LinkPolicy,
+				},
+			}
+			dev := &ttnpb.EndDevice{
+				DownlinkPolicy: tc.DevicePolicy,
+			}
+			a.So(as.getDownlinkPolicy(dev, l), should.Resemble, tc.Expected)
+		})
+	}
+}
+
+func TestLocationChanged(t *testing.T) {
+	stored := ttnpb.Location{
+		Latitude:  52.37,
+		Longitude: 4.89,
+		Accuracy:  50,
+		Source:    ttnpb.SOURCE_LORA_RSSI_GEOLOCATION,
+	}
+	for _, tc := range []struct {
+		Name    string
+		Solved  ttnpb.Location
+		Changed bool
+	}{
+		{
+			Name:   "Same",
+			Solved: stored,
+		},
+		{
+			Name: "WithinThreshold",
+			Solved: ttnpb.Location{
+				Latitude:  52.3705,
+				Longitude: 4.89,
+				Source:    ttnpb.SOURCE_LORA_RSSI_GEOLOCATION,
+			},
+		},
+		{
+			Name: "BeyondThreshold",
+			Solved: ttnpb.Location{
+				Latitude:  52.372,
+				Longitude: 4.89,
+				Source:    ttnpb.SOURCE_LORA_RSSI_GEOLOCATION,
+			},
+			Changed: true,
+		},
+		{
+			Name: "WithinAccuracy",
+			Solved: ttnpb.Location{
+				Latitude:  52.372,
+				Longitude: 4.89,
+				Accuracy:  500,
+				Source:    ttnpb.SOURCE_LORA_RSSI_GEOLOCATION,
+			},
+		},
+		{
+			Name: "OtherSource",
+			Solved: ttnpb.Location{
+				Latitude:  52.37,
+				Longitude: 4.89,
+				Source:    ttnpb.SOURCE_LORA_TDOA_GEOLOCATION,
+			},
+			Changed: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			a.So(locationChanged(stored, tc.Solved), should.Equal, tc.Changed)
+		})
+	}
+}
@@ -152,6 +152,9 @@ func TestApplicationServer(t *testing.T) {
 			Timeout:   timeout,
 			QueueSize: 1,
 		},
+		Downlinks: applicationserver.DownlinksConfig{
+			MaxConfirmedRetries: 1,
+		},
 	}
 	as, err := applicationserver.New(c, config)
 	if !a.So(err, should.BeNil) {
@@ -681,6 +684,58 @@ func TestApplicationServer(t *testing.T) {
 							})
 						},
 					},
+					{
+						Name: "RegisteredDevice/LocationSolved",
+						IDs:  registeredDevice.EndDeviceIdentifiers,
+						Message: &ttnpb.ApplicationUp{
+							EndDeviceIdentifiers: withDevAddr(registeredDevice.EndDeviceIdentifiers, types.DevAddr{0x33, 0x33, 0x33, 0x33}),
+							Up: &ttnpb.ApplicationUp_LocationSolved{
+								LocationSolved: &ttnpb.ApplicationLocation{
+									Service: "test",
+									Location: ttnpb.Location{
+										Latitude:  52.37,
+										Longitude: 4.89,
+										Source:    ttnpb.SOURCE_LORA_RSSI_GEOLOCATION,
+									},
+								},
+							},
+						},
+						AssertUp: func(t *testing.T, up *ttnpb.ApplicationUp) {
+							a := assertions.New(t)
+							a.So(up, should.Resemble, &ttnpb.ApplicationUp{
+								EndDeviceIdentifiers: withDevAddr(registeredDevice.EndDeviceIdentifiers, types.DevAddr{0x33, 0x33, 0x33, 0x33}),
+								Up: &ttnpb.ApplicationUp_LocationSolved{
+									LocationSolved: &ttnpb.ApplicationLocation{
+										Service: "test",
+										Location: ttnpb.Location{
+											Latitude:  52.37,
+											Longitude: 4.89,
+											Source:    ttnpb.SOURCE_LORA_RSSI_GEOLOCATION,
+										},
+									},
+								},
+								CorrelationIDs: up.CorrelationIDs,
+							})
+							// The location is stored asynchronously.
+							var dev *ttnpb.EndDevice
+							for i := 0; i < 10; i++ {
+								var ok bool
+								if dev, ok = is.endDevices.get(ctx, registeredDevice.EndDeviceIdentifiers); ok {
+									break
+								}
+								time.Sleep(timeout / 10)
+							}
+							if a.So(dev, should.NotBeNil) {
+								a.So(dev.Locations, should.Resemble, map[string]*ttnpb.Location{
+									"test": {
+										Latitude:  52.37,
+										Longitude: 4.89,
+										Source:    ttnpb.SOURCE_LORA_RSSI_GEOLOCATION,
+									},
+								})
+							}
+						},
+					},
 					{
 						Name: "RegisteredDevice/DownlinkMessage/Queued",
 						IDs:  registeredDevice.EndDeviceIdentifiers,
@@ -852,10 +907,11 @@ func TestApplicationServer(t *testing.T) {
 							a := assertions.New(t)
 							a.So(queue, should.Resemble, []*ttnpb.ApplicationDownlink{
 								{ // The nacked item is inserted first.
-									SessionKeyID: []byte{0x33},
-									FPort:        11,
-									FCnt:         2,
-									FRMPayload:   []byte{0x1, 0x1, 0x1, 0x1},
+									SessionKeyID:     []byte{0x33},
+									FPort:            11,
+									FCnt:             2,
+									FRMPayload:       []byte{0x1, 0x1, 0x1, 0x1},
+									ConfirmedRetries: 1,
 								},
 								{
 									SessionKeyID: []byte{0x33},
@@ -866,6 +922,45 @@ func TestApplicationServer(t *testing.T) {
 							})
 						},
 					},
+					{
+						Name: "RegisteredDevice/DownlinkMessage/Nack/RetriesExceeded",
+						IDs:  registeredDevice.EndDeviceIdentifiers,
+						ResetQueue: []*ttnpb.ApplicationDownlink{
+							{
+								SessionKeyID: []byte{0x33},
+								FPort:        22,
+								FCnt:         2,
+								FRMPayload:   []byte{0x92, 0xfe, 0x93, 0xf5},
+							},
+						},
+						Message: &ttnpb.ApplicationUp{
+							EndDeviceIdentifiers: withDevAddr(registeredDevice.EndDeviceIdentifiers, types.DevAddr{0x33, 0x33, 0x33, 0x33}),
+							Up: &ttnpb.ApplicationUp_DownlinkNack{
+								DownlinkNack: &ttnpb.ApplicationDownlink{
+									SessionKeyID:     []byte{0x33},
+									FPort:            11,
+									FCnt:             1,
+									FRMPayload:       []byte{0x5f, 0x38, 0x7c, 0xb0},
+									ConfirmedRetries: 1,
+								},
+							},
+						},
+						AssertUp: func(t *testing.T, up *ttnpb.ApplicationUp) {
+							a := assertions.New(t)
+							a.So(up.GetDownlinkNack().GetConfirmedRetries(), should.Equal, 1)
+						},
+						AssertDevice: func(t *testing.T, dev *ttnpb.EndDevice, queue []*ttnpb.ApplicationDownlink) {
+							a := assertions.New(t)
+							a.So(queue, should.Resemble, []*ttnpb.ApplicationDownlink{
+								{ // The nacked item is dropped.
+									SessionKeyID: []byte{0x33},
+									FPort:        22,
+									FCnt:         2,
+									FRMPayload:   []byte{0x2, 0x2, 0x2, 0x2},
+								},
+							})
+						},
+					},
 					{
 						Name: "RegisteredDevice/JoinAccept/WithAppSKey/WithQueue/WithPendingSession",
 						IDs:  registeredDevice.EndDeviceIdentifiers,
@@ -1354,6 +1449,37 @@ func TestApplicationServer(t *testing.T) {
 						},
 					})
 				})
+				t.Run("RegisteredDevice/Push/Passed", func(t *testing.T) {
+					past := time.Now().Add(-time.Minute)
+					for _, item := range []*ttnpb.ApplicationDownlink{
+						{
+							FPort:      11,
+							FRMPayload: []byte{0x1, 0x1, 0x1},
+							ExpiresAt:  &past,
+						},
+						{
+							FPort:      11,
+							FRMPayload: []byte{0x1, 0x1, 0x1},
+							ClassBC: &ttnpb.ApplicationDownlink_ClassBC{
+								AbsoluteTime: &past,
+							},
+						},
+					} {
+						a := assertions.New(t)
+						chs.downPush <- &ttnpb.DownlinkQueueRequest{
+							EndDeviceIdentifiers: registeredDevice.EndDeviceIdentifiers,
+							Downlinks:            []*ttnpb.ApplicationDownlink{item},
+						}
+						select {
+						case err := <-chs.downErr:
+							if !ptc.SkipCheckDownErr && a.So(err, should.NotBeNil) {
+								a.So(errors.IsInvalidArgument(err), should.BeTrue)
+							}
+						case <-time.After(timeout):
+							t.Fatal("Expected downlink error timeout")
+						}
+					}
+				})
 				t.Run("RegisteredDevice/Replace", func(t *testing.T) {
 					a := assertions.New(t)
 					chs.downReplace <- &ttnpb.DownlinkQueueRequest{
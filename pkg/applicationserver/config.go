@@ -20,8 +20,12 @@ import (
 	"time"
 
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/storage"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/packages/fragmentation"
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/fetch"
 	"go.thethings.network/lorawan-stack/pkg/log"
 )
 
@@ -37,11 +41,16 @@ const (
 
 // Config represents the ApplicationServer configuration.
 type Config struct {
-	LinkMode string         `name:"link-mode" description:"Mode to link applications to their Network Server (all, explicit)"`
-	Devices  DeviceRegistry `name:"-"`
-	Links    LinkRegistry   `name:"-"`
-	MQTT     MQTTConfig     `name:"mqtt" description:"MQTT configuration"`
-	Webhooks WebhooksConfig `name:"webhooks" description:"Webhooks configuration"`
+	LinkMode   string           `name:"link-mode" description:"Mode to link applications to their Network Server (all, explicit)"`
+	Devices    DeviceRegistry   `name:"-"`
+	Links      LinkRegistry     `name:"-"`
+	MQTT       MQTTConfig       `name:"mqtt" description:"MQTT configuration"`
+	Webhooks   WebhooksConfig   `name:"webhooks" description:"Webhooks configuration"`
+	Storage    StorageConfig    `name:"storage" description:"Storage integration configuration"`
+	PubSub     PubSubConfig     `name:"pubsub" description:"Pub/sub integrations configuration"`
+	Formatters FormattersConfig `name:"formatters" description:"Payload formatters configuration"`
+	Packages   PackagesConfig   `name:"packages" description:"Application layer packages configuration"`
+	Downlinks  DownlinksConfig  `name:"downlinks" description:"Downlink configuration"`
 }
 
 var errLinkMode = errors.DefineInvalidArgument("link_mode", "invalid link mode `{value}`")
@@ -58,10 +67,23 @@ func (c Config) GetLinkMode() (LinkMode, error) {
 	}
 }
 
+// DefaultMaxConfirmedRetries is the default maximum number of retries of a confirmed downlink message that is not
+// acknowledged.
+const DefaultMaxConfirmedRetries = 8
+
+// DownlinksConfig contains the default downlink policy of the Application Server.
+// The default policy applies to the fields that are not set in the downlink policy of the link and the end device.
+type DownlinksConfig struct {
+	MaxConfirmedRetries uint32        `name:"max-confirmed-retries" description:"Maximum number of retries of a confirmed downlink message that is not acknowledged (0 is the default)"`
+	Expiry              time.Duration `name:"expiry" description:"Time after which queued downlink messages expire (0 is no expiry)"`
+}
+
 // MQTTConfig contains MQTT configuration of the Application Server.
 type MQTTConfig struct {
-	Listen    string `name:"listen" description:"Address for the MQTT frontend to listen on"`
-	ListenTLS string `name:"listen-tls" description:"Address for the MQTTS frontend to listen on"`
+	Listen            string        `name:"listen" description:"Address for the MQTT frontend to listen on"`
+	ListenTLS         string        `name:"listen-tls" description:"Address for the MQTTS frontend to listen on"`
+	SessionExpiry     time.Duration `name:"session-expiry" description:"Time after which the persistent session of a disconnected client expires (0 is no persistent sessions)"`
+	MaxQueuedMessages int           `name:"max-queued-messages" description:"Maximum number of QoS 1 messages to queue per disconnected client with persistent session"`
 }
 
 var (
@@ -71,11 +93,49 @@ var (
 
 // WebhooksConfig defines the configuration of the webhooks integration.
 type WebhooksConfig struct {
-	Registry  web.WebhookRegistry `name:"-"`
-	Target    string              `name:"target" description:"Target of the integration (direct)"`
-	Timeout   time.Duration       `name:"timeout" description:"Wait timeout of the target to process the request"`
-	QueueSize int                 `name:"queue-size" description:"Number of requests to queue"`
-	Workers   int                 `name:"workers" description:"Number of workers to process requests"`
+	Registry         web.WebhookRegistry    `name:"-"`
+	Queue            web.DeliveryQueue      `name:"-"`
+	Target           string                 `name:"target" description:"Target of the integration (direct)"`
+	Timeout          time.Duration          `name:"timeout" description:"Wait timeout of the target to process the request"`
+	QueueSize        int                    `name:"queue-size" description:"Number of requests to queue"`
+	Workers          int                    `name:"workers" description:"Number of workers to process requests"`
+	Retry            WebhooksRetryConfig    `name:"retry" description:"Retry configuration of queued deliveries"`
+	SuspendThreshold int                    `name:"suspend-threshold" description:"Number of consecutive failed delivery attempts after which a webhook is suspended (0 is never)"`
+	SuspendDuration  time.Duration          `name:"suspend-duration" description:"Duration of the suspension of a webhook (0 is until the health is reset)"`
+	DeadLetterLimit  int                    `name:"dead-letter-limit" description:"Maximum number of dead letters to keep per webhook (0 is unlimited)"`
+	Templates        WebhookTemplatesConfig `name:"templates" description:"Source of the webhook templates"`
+}
+
+// WebhookTemplatesConfig defines the source of the webhook templates.
+type WebhookTemplatesConfig struct {
+	Static    map[string][]byte `name:"-"`
+	Directory string            `name:"directory" description:"Retrieve the webhook templates from the filesystem"`
+	URL       string            `name:"url" description:"Retrieve the webhook templates from a web server"`
+}
+
+// NewTemplateStore returns a web.TemplateStore with a fetcher based on the configuration.
+// The order of precedence is Static, Directory and URL.
+// If neither Static, Directory nor a URL is set, this method returns nil.
+func (c WebhookTemplatesConfig) NewTemplateStore() web.TemplateStore {
+	var fetcher fetch.Interface
+	switch {
+	case c.Static != nil:
+		fetcher = fetch.NewMemFetcher(c.Static)
+	case c.Directory != "":
+		fetcher = fetch.FromFilesystem(c.Directory)
+	case c.URL != "":
+		fetcher = fetch.FromHTTP(c.URL, true)
+	default:
+		return nil
+	}
+	return web.NewTemplateStore(fetcher)
+}
+
+// WebhooksRetryConfig defines the retry configuration of queued webhook deliveries.
+type WebhooksRetryConfig struct {
+	MaxAttempts    int           `name:"max-attempts" description:"Number of delivery attempts after which a message is dead-lettered"`
+	InitialBackoff time.Duration `name:"initial-backoff" description:"Time to wait before the first retry"`
+	MaxBackoff     time.Duration `name:"max-backoff" description:"Maximum time to wait between retries"`
 }
 
 // NewWebhooks returns a new web.Webhooks based on the configuration.
@@ -97,7 +157,17 @@ func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server) (web.
 	if c.Registry == nil {
 		return nil, errWebhooksRegistry
 	}
-	if c.QueueSize > 0 || c.Workers > 0 {
+	var opts []web.Option
+	if c.Queue != nil {
+		opts = append(opts, web.WithDeliveryQueue(c.Queue, web.DeliveryConfig{
+			Workers:          c.Workers,
+			MaxAttempts:      c.Retry.MaxAttempts,
+			InitialBackoff:   c.Retry.InitialBackoff,
+			MaxBackoff:       c.Retry.MaxBackoff,
+			SuspendThreshold: c.SuspendThreshold,
+			SuspendDuration:  c.SuspendDuration,
+		}))
+	} else if c.QueueSize > 0 || c.Workers > 0 {
 		target = &web.QueuedSink{
 			Target:  target,
 			Queue:   make(chan *http.Request, c.QueueSize),
@@ -111,5 +181,38 @@ func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server) (web.
 			}
 		}()
 	}
-	return web.NewWebhooks(ctx, server, c.Registry, target), nil
+	return web.NewWebhooks(ctx, server, c.Registry, target, opts...), nil
+}
+
+// StorageConfig defines the configuration of the storage integration.
+type StorageConfig struct {
+	Registry  storage.Storage `name:"-"`
+	Enable    bool            `name:"enable" description:"Enable storage of upstream messages"`
+	Retention time.Duration   `name:"retention" description:"Duration to retain upstream messages (0 is forever)"`
+}
+
+// PubSubConfig defines the configuration of the pub/sub integrations.
+type PubSubConfig struct {
+	Registry pubsub.Registry `name:"-"`
+	Enable   bool            `name:"enable" description:"Enable pub/sub integrations with NATS and MQTT brokers"`
+}
+
+// FormattersConfig contains the configuration of the payload formatters.
+type FormattersConfig struct {
+	GRPCService GRPCServiceFormatterConfig `name:"grpc-service" description:"gRPC service payload formatter configuration"`
+}
+
+// GRPCServiceFormatterConfig contains the configuration of the gRPC service payload formatter.
+type GRPCServiceFormatterConfig struct {
+	Timeout          time.Duration `name:"timeout" description:"Default timeout of requests to payload formatter services"`
+	TLS              bool          `name:"tls" description:"Use TLS for payload formatter services that are configured by host:port"`
+	FailureThreshold int           `name:"failure-threshold" description:"Number of consecutive failures after which requests to a service are rejected (0 is never)"`
+	ResetTimeout     time.Duration `name:"reset-timeout" description:"Time after which requests to a rejected service are tried again"`
+	MaxServices      int           `name:"max-services" description:"Maximum number of payload formatter services to keep connections to"`
+	AllowedHosts     []string      `name:"allowed-hosts" description:"Hosts of payload formatter services that may be used; a host starting with a dot also allows subdomains (empty is all hosts)"`
+}
+
+// PackagesConfig contains the configuration of the application layer packages.
+type PackagesConfig struct {
+	Fragmentation fragmentation.Registry `name:"-"`
 }
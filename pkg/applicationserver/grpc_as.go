@@ -39,6 +39,11 @@ func (as *ApplicationServer) SetLink(ctx context.Context, req *ttnpb.SetApplicat
 		return nil, err
 	}
 	// TODO: Validate field mask (https://github.com/TheThingsNetwork/lorawan-stack/issues/39)
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "default_formatters") {
+		if err := as.formatter.Validate(req.DefaultFormatters); err != nil {
+			return nil, err
+		}
+	}
 	// Get all the fields here for starting the link task.
 	link, err := as.linkRegistry.Set(ctx, req.ApplicationIdentifiers, ttnpb.ApplicationLinkFieldPathsTopLevel,
 		func(link *ttnpb.ApplicationLink) (*ttnpb.ApplicationLink, []string, error) {
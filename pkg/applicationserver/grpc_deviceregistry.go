@@ -23,7 +23,8 @@ import (
 )
 
 type deviceRegistryRPC struct {
-	registry DeviceRegistry
+	registry  DeviceRegistry
+	formatter payloadFormatter
 }
 
 // Get implements ttnpb.AsEndDeviceRegistryServer.
@@ -41,6 +42,11 @@ func (r *deviceRegistryRPC) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequ
 		return nil, err
 	}
 	// TODO: Validate field mask (https://github.com/TheThingsNetwork/lorawan-stack/issues/39)
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "formatters") {
+		if err := r.formatter.Validate(req.Device.Formatters); err != nil {
+			return nil, err
+		}
+	}
 	return r.registry.Set(ctx, req.Device.EndDeviceIdentifiers, req.FieldMask.Paths, func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 		return &req.Device, req.FieldMask.Paths, nil
 	})
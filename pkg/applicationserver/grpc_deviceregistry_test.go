@@ -132,6 +132,23 @@ func TestDeviceRegistry(t *testing.T) {
 		a.So(errors.IsPermissionDenied(err), should.BeTrue)
 	}
 
+	// Invalid payload formatter parameter.
+	{
+		_, err := client.Set(ctx, &ttnpb.SetEndDeviceRequest{
+			Device: ttnpb.EndDevice{
+				EndDeviceIdentifiers: registeredDevice.EndDeviceIdentifiers,
+				Formatters: &ttnpb.MessagePayloadFormatters{
+					UpFormatter:          ttnpb.PayloadFormatter_FORMATTER_SCHEMA,
+					UpFormatterParameter: `{"fields":[{"name":"temperature","length":65}]}`,
+				},
+			},
+			FieldMask: pbtypes.FieldMask{
+				Paths: []string{"formatters"},
+			},
+		}, creds)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	}
+
 	// Happy flow: create, update and delete.
 	{
 		// Assert the device doesn't exist yet.
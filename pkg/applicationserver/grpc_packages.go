@@ -0,0 +1,134 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/packages/clocksync"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/packages/fragmentation"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/packages/multicastsetup"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var errPackageNotEnabled = errors.DefineFailedPrecondition("package_not_enabled", "application layer package `{package}` is not enabled")
+
+type packagesRPC struct {
+	clockSync      *clocksync.Handler
+	multicastSetup *multicastsetup.Handler
+	fragmentation  *fragmentation.Handler
+}
+
+// StartFragmentationSession implements ttnpb.ApplicationPackagesServer.
+func (r *packagesRPC) StartFragmentationSession(ctx context.Context, req *ttnpb.FragmentationSession) (*ttnpb.FragmentationSession, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err != nil {
+		return nil, err
+	}
+	if r.fragmentation == nil {
+		return nil, errPackageNotEnabled.WithAttributes("package", "fragmentation")
+	}
+	if err := r.fragmentation.StartSession(ctx, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// DeleteFragmentationSession implements ttnpb.ApplicationPackagesServer.
+func (r *packagesRPC) DeleteFragmentationSession(ctx context.Context, req *ttnpb.DeleteFragmentationSessionRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err != nil {
+		return nil, err
+	}
+	if r.fragmentation == nil {
+		return nil, errPackageNotEnabled.WithAttributes("package", "fragmentation")
+	}
+	if err := r.fragmentation.DeleteSession(ctx, req.EndDeviceIdentifiers, req.FragIndex); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// RequestFragmentationSessionStatus implements ttnpb.ApplicationPackagesServer.
+func (r *packagesRPC) RequestFragmentationSessionStatus(ctx context.Context, req *ttnpb.RequestFragmentationSessionStatusRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err != nil {
+		return nil, err
+	}
+	if r.fragmentation == nil {
+		return nil, errPackageNotEnabled.WithAttributes("package", "fragmentation")
+	}
+	if err := r.fragmentation.RequestStatus(ctx, req.EndDeviceIdentifiers, req.FragIndex, req.AllParticipants); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// ForceDeviceClockResync implements ttnpb.ApplicationPackagesServer.
+func (r *packagesRPC) ForceDeviceClockResync(ctx context.Context, req *ttnpb.ForceDeviceClockResyncRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err != nil {
+		return nil, err
+	}
+	if err := r.clockSync.ForceDeviceResync(ctx, req.EndDeviceIdentifiers, byte(req.NbTransmissions)); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// SetupMulticastGroup implements ttnpb.ApplicationPackagesServer.
+func (r *packagesRPC) SetupMulticastGroup(ctx context.Context, req *ttnpb.SetupMulticastGroupRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err != nil {
+		return nil, err
+	}
+	if err := r.multicastSetup.SetupGroup(ctx, req.EndDeviceIdentifiers, multicastsetup.McGroupSetupReq{
+		McGroupID:      byte(req.McGroupID),
+		McAddr:         req.McAddr,
+		McKeyEncrypted: req.McKeyEncrypted,
+		MinMcFCount:    req.MinMcFCount,
+		MaxMcFCount:    req.MaxMcFCount,
+	}); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// DeleteMulticastGroup implements ttnpb.ApplicationPackagesServer.
+func (r *packagesRPC) DeleteMulticastGroup(ctx context.Context, req *ttnpb.DeleteMulticastGroupRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err != nil {
+		return nil, err
+	}
+	if err := r.multicastSetup.DeleteGroup(ctx, req.EndDeviceIdentifiers, byte(req.McGroupID)); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// StartMulticastClassCSession implements ttnpb.ApplicationPackagesServer.
+func (r *packagesRPC) StartMulticastClassCSession(ctx context.Context, req *ttnpb.StartMulticastClassCSessionRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err != nil {
+		return nil, err
+	}
+	if err := r.multicastSetup.StartClassCSession(ctx, req.EndDeviceIdentifiers, multicastsetup.McClassCSessionReq{
+		McGroupID:      byte(req.McGroupID),
+		SessionTime:    uint32(gpstime.ToGPS(req.SessionTime)),
+		SessionTimeOut: byte(req.SessionTimeOut),
+		DLFrequency:    req.Frequency,
+		DataRate:       byte(req.DataRateIndex),
+	}); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
@@ -0,0 +1,207 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/packages/clocksync"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/packages/fragmentation"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/packages/multicastsetup"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+type mockPackagesServer struct {
+	pushed []*ttnpb.ApplicationDownlink
+}
+
+func (s *mockPackagesServer) DownlinkQueuePush(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) error {
+	s.pushed = append(s.pushed, items...)
+	return nil
+}
+
+func (s *mockPackagesServer) take() []*ttnpb.ApplicationDownlink {
+	pushed := s.pushed
+	s.pushed = nil
+	return pushed
+}
+
+func TestPackagesRPC(t *testing.T) {
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		DeviceID:               "foo-device",
+	}
+	ctx := test.Context()
+	authorizedCtx := rights.NewContext(ctx, rights.Rights{
+		ApplicationRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids.ApplicationIdentifiers): ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE),
+		},
+	})
+	unauthorizedCtx := rights.NewContext(ctx, rights.Rights{
+		ApplicationRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids.ApplicationIdentifiers): ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		},
+	})
+
+	server := &mockPackagesServer{}
+	rpc := &packagesRPC{
+		clockSync:      clocksync.New(server),
+		multicastSetup: multicastsetup.New(server),
+	}
+
+	t.Run("FragmentationNotEnabled", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := rpc.StartFragmentationSession(authorizedCtx, &ttnpb.FragmentationSession{
+			EndDeviceIdentifiers: ids,
+		})
+		a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+		_, err = rpc.DeleteFragmentationSession(authorizedCtx, &ttnpb.DeleteFragmentationSessionRequest{
+			EndDeviceIdentifiers: ids,
+		})
+		a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+		_, err = rpc.RequestFragmentationSessionStatus(authorizedCtx, &ttnpb.RequestFragmentationSessionStatusRequest{
+			EndDeviceIdentifiers: ids,
+		})
+		a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+		a.So(server.take(), should.BeEmpty)
+	})
+
+	rpc.fragmentation = fragmentation.New(server, nil, nil)
+
+	mcKey := types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10}
+	sessionTime := time.Unix(1262304000, 0).UTC()
+
+	for _, tc := range []struct {
+		Name    string
+		Call    func(context.Context) error
+		FPort   uint32
+		Payload []byte
+	}{
+		{
+			Name: "RequestFragmentationSessionStatus",
+			Call: func(ctx context.Context) error {
+				_, err := rpc.RequestFragmentationSessionStatus(ctx, &ttnpb.RequestFragmentationSessionStatusRequest{
+					EndDeviceIdentifiers: ids,
+					FragIndex:            1,
+					AllParticipants:      true,
+				})
+				return err
+			},
+			FPort:   fragmentation.FPort,
+			Payload: fragmentation.FragSessionStatusReq(1, true),
+		},
+		{
+			Name: "ForceDeviceClockResync",
+			Call: func(ctx context.Context) error {
+				_, err := rpc.ForceDeviceClockResync(ctx, &ttnpb.ForceDeviceClockResyncRequest{
+					EndDeviceIdentifiers: ids,
+					NbTransmissions:      3,
+				})
+				return err
+			},
+			FPort:   clocksync.FPort,
+			Payload: clocksync.ForceDeviceResyncReq(3),
+		},
+		{
+			Name: "SetupMulticastGroup",
+			Call: func(ctx context.Context) error {
+				_, err := rpc.SetupMulticastGroup(ctx, &ttnpb.SetupMulticastGroupRequest{
+					EndDeviceIdentifiers: ids,
+					McGroupID:            1,
+					McAddr:               types.DevAddr{0x01, 0x02, 0x03, 0x04},
+					McKeyEncrypted:       mcKey,
+					MinMcFCount:          0,
+					MaxMcFCount:          1000,
+				})
+				return err
+			},
+			FPort: multicastsetup.FPort,
+			Payload: func() []byte {
+				b, _ := multicastsetup.McGroupSetupReq{
+					McGroupID:      1,
+					McAddr:         types.DevAddr{0x01, 0x02, 0x03, 0x04},
+					McKeyEncrypted: mcKey,
+					MinMcFCount:    0,
+					MaxMcFCount:    1000,
+				}.MarshalBinary()
+				return b
+			}(),
+		},
+		{
+			Name: "DeleteMulticastGroup",
+			Call: func(ctx context.Context) error {
+				_, err := rpc.DeleteMulticastGroup(ctx, &ttnpb.DeleteMulticastGroupRequest{
+					EndDeviceIdentifiers: ids,
+					McGroupID:            2,
+				})
+				return err
+			},
+			FPort:   multicastsetup.FPort,
+			Payload: multicastsetup.McGroupDeleteReq(2),
+		},
+		{
+			Name: "StartMulticastClassCSession",
+			Call: func(ctx context.Context) error {
+				_, err := rpc.StartMulticastClassCSession(ctx, &ttnpb.StartMulticastClassCSessionRequest{
+					EndDeviceIdentifiers: ids,
+					McGroupID:            1,
+					SessionTime:          sessionTime,
+					SessionTimeOut:       8,
+					Frequency:            869525000,
+					DataRateIndex:        ttnpb.DATA_RATE_0,
+				})
+				return err
+			},
+			FPort: multicastsetup.FPort,
+			Payload: func() []byte {
+				b, _ := multicastsetup.McClassCSessionReq{
+					McGroupID:      1,
+					SessionTime:    uint32(gpstime.ToGPS(sessionTime)),
+					SessionTimeOut: 8,
+					DLFrequency:    869525000,
+					DataRate:       0,
+				}.MarshalBinary()
+				return b
+			}(),
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			err := tc.Call(unauthorizedCtx)
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+			a.So(server.take(), should.BeEmpty)
+
+			err = tc.Call(authorizedCtx)
+			a.So(err, should.BeNil)
+			a.So(server.take(), should.Resemble, []*ttnpb.ApplicationDownlink{
+				{
+					FPort:      tc.FPort,
+					FRMPayload: tc.Payload,
+				},
+			})
+		})
+	}
+}
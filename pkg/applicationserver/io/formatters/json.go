@@ -34,5 +34,9 @@ func (json) ToDownlinks(data []byte) (*ttnpb.ApplicationDownlinks, error) {
 	return res, nil
 }
 
+func (json) FromDownlinkQueueResult(msg *ttnpb.ApplicationDownlinkQueueResult) ([]byte, error) {
+	return jsonpb.TTN().Marshal(msg)
+}
+
 // JSON is a formatter that uses JSON marshaling.
 var JSON Formatter = &json{}
@@ -30,5 +30,9 @@ func (protobuf) ToDownlinks(buf []byte) (*ttnpb.ApplicationDownlinks, error) {
 	return res, nil
 }
 
+func (protobuf) FromDownlinkQueueResult(msg *ttnpb.ApplicationDownlinkQueueResult) ([]byte, error) {
+	return msg.Marshal()
+}
+
 // Protobuf is a formatter that uses proto marshaling.
 var Protobuf Formatter = &protobuf{}
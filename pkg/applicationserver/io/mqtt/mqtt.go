@@ -20,6 +20,7 @@ import (
 	"fmt"
 	stdio "io"
 	"net"
+	"time"
 
 	"github.com/TheThingsIndustries/mystique/pkg/auth"
 	mqttlog "github.com/TheThingsIndustries/mystique/pkg/log"
@@ -37,20 +38,48 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
-const qosUpstream byte = 0
+// qosUpstream is the maximum QoS of upstream messages. The QoS of a delivered message is the minimum of qosUpstream
+// and the QoS of the subscription.
+const qosUpstream byte = 1
 
 type srv struct {
-	ctx    context.Context
-	server io.Server
-	format Format
-	lis    mqttnet.Listener
+	ctx      context.Context
+	server   io.Server
+	format   Format
+	lis      mqttnet.Listener
+	sessions *sessionStore
+}
+
+// Option configures the MQTT frontend.
+type Option func(*srv)
+
+// WithPersistentSessions enables persistent sessions for clients that connect with clean session disabled.
+// The session of a disconnected client expires after the given duration. While disconnected, at most maxQueued QoS 1
+// messages are queued per client; the oldest messages are dropped first.
+func WithPersistentSessions(expiry time.Duration, maxQueued int) Option {
+	return func(s *srv) {
+		s.sessions.expiry = expiry
+		s.sessions.maxQueued = maxQueued
+	}
 }
 
 // Start starts the MQTT frontend.
-func Start(ctx context.Context, server io.Server, listener net.Listener, format Format, protocol string) {
+func Start(ctx context.Context, server io.Server, listener net.Listener, format Format, protocol string, opts ...Option) {
 	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/mqtt")
 	ctx = mqttlog.NewContext(ctx, mqtt.Logger(log.FromContext(ctx)))
-	s := &srv{ctx, server, format, mqttnet.NewListener(listener, protocol)}
+	s := &srv{
+		ctx:    ctx,
+		server: server,
+		format: format,
+		lis:    mqttnet.NewListener(listener, protocol),
+		sessions: &sessionStore{
+			format:   format,
+			sessions: make(map[string]*persistentSession),
+		},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
 	go s.accept()
 	go func() {
 		<-ctx.Done()
@@ -70,22 +99,59 @@ func (s *srv) accept() {
 
 		go func() {
 			ctx := log.NewContextWithFields(s.ctx, log.Fields("remote_addr", mqttConn.RemoteAddr().String()))
-			conn := &connection{server: s.server, mqtt: mqttConn, format: s.format}
-			if conn.setup(ctx); err != nil {
+			conn := &connection{
+				server:   s.server,
+				mqtt:     &connectConn{Conn: mqttConn},
+				format:   s.format,
+				sessions: s.sessions,
+				done:     make(chan struct{}),
+			}
+			if err := conn.setup(ctx); err != nil {
 				log.FromContext(ctx).WithError(err).Warn("Failed to setup connection")
 				mqttConn.Close()
+				if conn.persistent != nil {
+					conn.persistent.detach(conn)
+				} else if conn.io != nil {
+					conn.io.Disconnect(err)
+				}
 				return
 			}
 		}()
 	}
 }
 
+// connectConn is a connection that keeps the clean session flag of the CONNECT packet, and that sets the session
+// present flag of the CONNACK packet.
+type connectConn struct {
+	mqttnet.Conn
+	cleanSession   bool
+	sessionPresent bool
+}
+
+func (c *connectConn) Receive() (packet.ControlPacket, error) {
+	pkt, err := c.Conn.Receive()
+	if connect, ok := pkt.(*packet.ConnectPacket); ok {
+		c.cleanSession = connect.CleanStart
+	}
+	return pkt, err
+}
+
+func (c *connectConn) Send(pkt packet.ControlPacket) error {
+	if connack, ok := pkt.(*packet.ConnackPacket); ok && connack.ReturnCode == packet.ConnectAccepted {
+		connack.SessionPresent = c.sessionPresent
+	}
+	return c.Conn.Send(pkt)
+}
+
 type connection struct {
-	format  Format
-	server  io.Server
-	mqtt    mqttnet.Conn
-	session session.Session
-	io      *io.Subscription
+	format     Format
+	server     io.Server
+	mqtt       *connectConn
+	session    session.Session
+	io         *io.Subscription
+	sessions   *sessionStore
+	persistent *persistentSession
+	done       chan struct{}
 }
 
 func (c *connection) setup(ctx context.Context) error {
@@ -118,51 +184,29 @@ func (c *connection) setup(ctx context.Context) error {
 		}
 	}()
 
-	// Publish upstream
-	go func() {
-		for {
-			select {
-			case <-c.io.Context().Done():
-				logger.WithError(c.io.Context().Err()).Debug("Done sending upstream messages")
-				return
-			case up := <-c.io.Up():
-				var topicParts []string
-				switch up.Up.(type) {
-				case *ttnpb.ApplicationUp_UplinkMessage:
-					topicParts = c.format.UplinkTopic(unique.ID(c.io.Context(), c.io.ApplicationIDs()), up.DeviceID)
-				case *ttnpb.ApplicationUp_JoinAccept:
-					topicParts = c.format.JoinAcceptTopic(unique.ID(c.io.Context(), c.io.ApplicationIDs()), up.DeviceID)
-				case *ttnpb.ApplicationUp_DownlinkAck:
-					topicParts = c.format.DownlinkAckTopic(unique.ID(c.io.Context(), c.io.ApplicationIDs()), up.DeviceID)
-				case *ttnpb.ApplicationUp_DownlinkNack:
-					topicParts = c.format.DownlinkNackTopic(unique.ID(c.io.Context(), c.io.ApplicationIDs()), up.DeviceID)
-				case *ttnpb.ApplicationUp_DownlinkSent:
-					topicParts = c.format.DownlinkSentTopic(unique.ID(c.io.Context(), c.io.ApplicationIDs()), up.DeviceID)
-				case *ttnpb.ApplicationUp_DownlinkFailed:
-					topicParts = c.format.DownlinkFailedTopic(unique.ID(c.io.Context(), c.io.ApplicationIDs()), up.DeviceID)
-				case *ttnpb.ApplicationUp_DownlinkQueued:
-					topicParts = c.format.DownlinkQueuedTopic(unique.ID(c.io.Context(), c.io.ApplicationIDs()), up.DeviceID)
-				case *ttnpb.ApplicationUp_LocationSolved:
-					topicParts = c.format.LocationSolvedTopic(unique.ID(c.io.Context(), c.io.ApplicationIDs()), up.DeviceID)
-				}
-				if topicParts == nil {
-					continue
-				}
-				buf, err := c.format.FromUp(up)
-				if err != nil {
-					logger.WithError(err).Warn("Failed to marshal upstream message")
-					continue
+	// Publish upstream. Upstream messages of persistent sessions are published by the session.
+	if c.persistent == nil {
+		go func() {
+			for {
+				select {
+				case <-c.io.Context().Done():
+					logger.WithError(c.io.Context().Err()).Debug("Done sending upstream messages")
+					return
+				case up := <-c.io.Up():
+					pkt, err := upstreamPacket(c.io, c.format, up)
+					if err != nil {
+						logger.WithError(err).Warn("Failed to marshal upstream message")
+						continue
+					}
+					if pkt == nil {
+						continue
+					}
+					logger.Info("Publishing upstream message")
+					c.session.Publish(pkt)
 				}
-				logger.Info("Publishing upstream message")
-				c.session.Publish(&packet.PublishPacket{
-					TopicName:  topic.Join(topicParts),
-					TopicParts: topicParts,
-					QoS:        qosUpstream,
-					Message:    buf,
-				})
 			}
-		}
-	}()
+		}()
+	}
 
 	// Write packets
 	go func() {
@@ -189,23 +233,109 @@ func (c *connection) setup(ctx context.Context) error {
 				} else {
 					logger.Info("Disconnected")
 				}
+				close(c.done)
+				if c.persistent != nil {
+					c.persistent.detach(c)
+				} else {
+					c.io.Disconnect(err)
+				}
 				c.session.Close()
-				c.io.Disconnect(err)
 				return
 			}
 		}
 	}()
 
+	if c.persistent != nil {
+		c.persistent.attach(c)
+	}
 	logger.Info("Connected")
 	return nil
 }
 
+// publishWaitInterval is the interval to wait for capacity in the publish channel of a session.
+var publishWaitInterval = 10 * time.Millisecond
+
+// waitPublish waits until the publish channel of the session has capacity.
+// This method returns false if the connection is closed.
+func (c *connection) waitPublish() bool {
+	ch := c.session.PublishChan()
+	for len(ch) >= cap(ch) {
+		select {
+		case <-c.done:
+			return false
+		case <-time.After(publishWaitInterval):
+		}
+	}
+	select {
+	case <-c.done:
+		return false
+	default:
+		return true
+	}
+}
+
+// upstreamPacket returns the publish packet for the upstream message.
+// This function returns nil if the upstream message is not published.
+func upstreamPacket(sub *io.Subscription, format Format, up *ttnpb.ApplicationUp) (*packet.PublishPacket, error) {
+	uid := unique.ID(sub.Context(), sub.ApplicationIDs())
+	var topicParts []string
+	switch up.Up.(type) {
+	case *ttnpb.ApplicationUp_UplinkMessage:
+		topicParts = format.UplinkTopic(uid, up.DeviceID)
+	case *ttnpb.ApplicationUp_JoinAccept:
+		topicParts = format.JoinAcceptTopic(uid, up.DeviceID)
+	case *ttnpb.ApplicationUp_DownlinkAck:
+		topicParts = format.DownlinkAckTopic(uid, up.DeviceID)
+	case *ttnpb.ApplicationUp_DownlinkNack:
+		topicParts = format.DownlinkNackTopic(uid, up.DeviceID)
+	case *ttnpb.ApplicationUp_DownlinkSent:
+		topicParts = format.DownlinkSentTopic(uid, up.DeviceID)
+	case *ttnpb.ApplicationUp_DownlinkFailed:
+		topicParts = format.DownlinkFailedTopic(uid, up.DeviceID)
+	case *ttnpb.ApplicationUp_DownlinkQueued:
+		topicParts = format.DownlinkQueuedTopic(uid, up.DeviceID)
+	case *ttnpb.ApplicationUp_LocationSolved:
+		topicParts = format.LocationSolvedTopic(uid, up.DeviceID)
+	}
+	if topicParts == nil {
+		return nil, nil
+	}
+	buf, err := format.FromUp(up)
+	if err != nil {
+		return nil, err
+	}
+	return &packet.PublishPacket{
+		TopicName:  topic.Join(topicParts),
+		TopicParts: topicParts,
+		QoS:        qosUpstream,
+		Message:    buf,
+	}, nil
+}
+
 type topicAccess struct {
 	appUID string
 	reads  [][]string
 	writes [][]string
 }
 
+func (a topicAccess) canRead(topicParts []string) bool {
+	for _, reads := range a.reads {
+		if topic.MatchPath(topicParts, reads) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a topicAccess) canWrite(topicParts []string) bool {
+	for _, writes := range a.writes {
+		if topic.MatchPath(topicParts, writes) {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Context, error) {
 	ids, err := unique.ToApplicationID(info.Username)
 	if err != nil {
@@ -232,11 +362,22 @@ func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Cont
 	uid := unique.ID(ctx, ids)
 	ctx = log.NewContextWithField(ctx, "application_uid", uid)
 
-	c.io, err = c.server.Subscribe(ctx, "mqtt", ids)
+	subscribe := func() (*io.Subscription, error) {
+		return c.server.Subscribe(ctx, "mqtt", ids)
+	}
+	key := sessionKey(uid, info.ClientID)
+	if c.mqtt.cleanSession || !c.sessions.enabled() {
+		c.sessions.remove(key, nil, errSessionDiscarded)
+		c.io, err = subscribe()
+	} else {
+		c.persistent, c.mqtt.sessionPresent, err = c.sessions.getOrCreate(key, subscribe)
+		if err == nil {
+			c.io = c.persistent.io
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
-	ctx = c.io.Context()
 	access := topicAccess{
 		appUID: uid,
 	}
@@ -253,6 +394,9 @@ func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Cont
 		)
 	}
 	if err := rights.RequireApplication(ctx, ids, ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err == nil {
+		access.reads = append(access.reads,
+			c.format.DownlinkQueueResultTopic(uid, topic.PartWildcard),
+		)
 		access.writes = append(access.writes,
 			c.format.DownlinkPushTopic(uid, topic.PartWildcard),
 			c.format.DownlinkReplaceTopic(uid, topic.PartWildcard),
@@ -260,7 +404,7 @@ func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Cont
 	}
 	info.Metadata = access
 	info.Interface = c
-	return ctx, nil
+	return c.io.Context(), nil
 }
 
 var errNotAuthorized = errors.DefinePermissionDenied("not_authorized", "not authorized")
@@ -277,54 +421,80 @@ func (c *connection) Subscribe(info *auth.Info, requestedTopic string, requested
 }
 
 func (c *connection) CanRead(info *auth.Info, topicParts ...string) bool {
-	access := info.Metadata.(topicAccess)
-	for _, reads := range access.reads {
-		if topic.MatchPath(topicParts, reads) {
-			return true
-		}
-	}
-	return false
+	return info.Metadata.(topicAccess).canRead(topicParts)
 }
 
 func (c *connection) CanWrite(info *auth.Info, topicParts ...string) bool {
-	access := info.Metadata.(topicAccess)
-	for _, writes := range access.writes {
-		if topic.MatchPath(topicParts, writes) {
-			return true
-		}
-	}
-	return false
+	return info.Metadata.(topicAccess).canWrite(topicParts)
 }
 
+var errDecodeDownlinks = errors.DefineInvalidArgument("decode_downlinks", "failed to decode downlink messages")
+
 func (c *connection) deliver(pkt *packet.PublishPacket) {
 	logger := log.FromContext(c.io.Context()).WithField("topic", pkt.TopicName)
 	var deviceID string
 	var op func(io.Server, context.Context, ttnpb.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink) error
+	var operation ttnpb.ApplicationDownlinkQueueResult_Operation
 	switch {
 	case c.format.IsDownlinkPushTopic(pkt.TopicParts):
 		deviceID = c.format.ParseDownlinkPushTopic(pkt.TopicParts)
 		op = io.Server.DownlinkQueuePush
+		operation = ttnpb.ApplicationDownlinkQueueResult_PUSH
 	case c.format.IsDownlinkReplaceTopic(pkt.TopicParts):
 		deviceID = c.format.ParseDownlinkReplaceTopic(pkt.TopicParts)
 		op = io.Server.DownlinkQueueReplace
+		operation = ttnpb.ApplicationDownlinkQueueResult_REPLACE
 	default:
 		logger.Error("Invalid topic path")
 		return
 	}
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: *c.io.ApplicationIDs(),
+		DeviceID:               deviceID,
+	}
+	res := &ttnpb.ApplicationDownlinkQueueResult{
+		EndDeviceIdentifiers: ids,
+		Operation:            operation,
+	}
 	items, err := c.format.ToDownlinks(pkt.Message)
 	if err != nil {
 		logger.WithError(err).Warn("Failed to decode downlink messages")
-		return
+		err = errDecodeDownlinks.WithCause(err)
+	} else {
+		logger.WithFields(log.Fields(
+			"device_uid", unique.ID(c.io.Context(), ids),
+			"count", len(items.Downlinks),
+		)).Debug("Handling downlink messages")
+		if err = op(c.server, c.io.Context(), ids, items.Downlinks); err != nil {
+			logger.WithError(err).Warn("Failed to handle downlink messages")
+		}
+		for _, item := range items.Downlinks {
+			res.CorrelationIDs = append(res.CorrelationIDs, item.CorrelationIDs...)
+		}
 	}
-	ids := ttnpb.EndDeviceIdentifiers{
-		ApplicationIdentifiers: *c.io.ApplicationIDs(),
-		DeviceID:               deviceID,
+	if err != nil {
+		if ttnErr, ok := errors.From(err); ok {
+			res.Error = ttnpb.ErrorDetailsToProto(ttnErr)
+		} else {
+			res.Error = &ttnpb.ErrorDetails{
+				MessageFormat: err.Error(),
+			}
+		}
 	}
-	logger.WithFields(log.Fields(
-		"device_uid", unique.ID(c.io.Context(), ids),
-		"count", len(items.Downlinks),
-	)).Debug("Handling downlink messages")
-	if err := op(c.server, c.io.Context(), ids, items.Downlinks); err != nil {
-		logger.WithError(err).Warn("Failed to handle downlink messages")
+	c.publishDownlinkQueueResult(res)
+}
+
+func (c *connection) publishDownlinkQueueResult(res *ttnpb.ApplicationDownlinkQueueResult) {
+	buf, err := c.format.FromDownlinkQueueResult(res)
+	if err != nil {
+		log.FromContext(c.io.Context()).WithError(err).Warn("Failed to marshal downlink queue result")
+		return
 	}
+	topicParts := c.format.DownlinkQueueResultTopic(unique.ID(c.io.Context(), c.io.ApplicationIDs()), res.DeviceID)
+	c.session.Publish(&packet.PublishPacket{
+		TopicName:  topic.Join(topicParts),
+		TopicParts: topicParts,
+		QoS:        qosUpstream,
+		Message:    buf,
+	})
 }
@@ -316,3 +316,196 @@ func TestTraffic(t *testing.T) {
 		}
 	})
 }
+
+func TestDownlinkQueueResult(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx = newContextWithRightsFetcher(ctx)
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer func() {
+		cancelCtx()
+		// Wait for the connections and sessions to be torn down before the test logger goes out of scope.
+		time.Sleep(timeout)
+	}()
+
+	as := mock.NewServer()
+	lis, err := net.Listen("tcp", ":0")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	Start(ctx, as, lis, JSON, "tcp")
+
+	clientOpts := mqtt.NewClientOptions()
+	clientOpts.AddBroker(fmt.Sprintf("tcp://%v", lis.Addr()))
+	clientOpts.SetUsername(registeredApplicationUID)
+	clientOpts.SetPassword(registeredApplicationKey)
+	client := mqtt.NewClient(clientOpts)
+	if token := client.Connect(); !a.So(token.WaitTimeout(timeout), should.BeTrue) {
+		t.FailNow()
+	}
+	defer client.Disconnect(100)
+
+	uid := unique.ID(ctx, registeredDeviceID.ApplicationIdentifiers)
+	resultCh := make(chan *ttnpb.ApplicationDownlinkQueueResult, 1)
+	resultTopic := fmt.Sprintf("v3/%v/devices/%v/down/result", uid, registeredDeviceID.DeviceID)
+	handler := func(_ mqtt.Client, msg mqtt.Message) {
+		res := &ttnpb.ApplicationDownlinkQueueResult{}
+		if err := jsonpb.TTN().Unmarshal(msg.Payload(), res); err != nil {
+			t.Errorf("Failed to unmarshal downlink queue result: %v", err)
+			return
+		}
+		resultCh <- res
+	}
+	if token := client.Subscribe(resultTopic, 1, handler); !a.So(token.WaitTimeout(timeout), should.BeTrue) {
+		t.FailNow()
+	}
+
+	for _, tc := range []struct {
+		Name      string
+		Topic     string
+		Payload   []byte
+		Operation ttnpb.ApplicationDownlinkQueueResult_Operation
+		Error     bool
+	}{
+		{
+			Name:  "Push",
+			Topic: fmt.Sprintf("v3/%v/devices/%v/down/push", uid, registeredDeviceID.DeviceID),
+			Payload: func() []byte {
+				buf, err := jsonpb.TTN().Marshal(&ttnpb.ApplicationDownlinks{
+					Downlinks: []*ttnpb.ApplicationDownlink{
+						{
+							FPort:          42,
+							FRMPayload:     []byte{0x1, 0x1, 0x1},
+							CorrelationIDs: []string{"test:push"},
+						},
+					},
+				})
+				if err != nil {
+					panic(err)
+				}
+				return buf
+			}(),
+			Operation: ttnpb.ApplicationDownlinkQueueResult_PUSH,
+		},
+		{
+			Name:      "ReplaceInvalidPayload",
+			Topic:     fmt.Sprintf("v3/%v/devices/%v/down/replace", uid, registeredDeviceID.DeviceID),
+			Payload:   []byte("invalid"),
+			Operation: ttnpb.ApplicationDownlinkQueueResult_REPLACE,
+			Error:     true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			if token := client.Publish(tc.Topic, 1, false, tc.Payload); !a.So(token.WaitTimeout(timeout), should.BeTrue) {
+				t.FailNow()
+			}
+			select {
+			case res := <-resultCh:
+				a.So(res.EndDeviceIdentifiers, should.Resemble, registeredDeviceID)
+				a.So(res.Operation, should.Equal, tc.Operation)
+				if tc.Error {
+					a.So(res.Error, should.NotBeNil)
+				} else {
+					a.So(res.Error, should.BeNil)
+					a.So(res.CorrelationIDs, should.Contain, "test:push")
+				}
+			case <-time.After(timeout):
+				t.Fatal("Receive expected downlink queue result timeout")
+			}
+		})
+	}
+}
+
+func TestPersistentSession(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx = newContextWithRightsFetcher(ctx)
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer func() {
+		cancelCtx()
+		// Wait for the connections and sessions to be torn down before the test logger goes out of scope.
+		time.Sleep(timeout)
+	}()
+
+	as := mock.NewServer()
+	lis, err := net.Listen("tcp", ":0")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	Start(ctx, as, lis, JSON, "tcp", WithPersistentSessions(time.Minute, 2))
+
+	upCh := make(chan *ttnpb.ApplicationUp, 4)
+	handler := func(_ mqtt.Client, msg mqtt.Message) {
+		up := &ttnpb.ApplicationUp{}
+		if err := jsonpb.TTN().Unmarshal(msg.Payload(), up); err != nil {
+			t.Errorf("Failed to unmarshal upstream message: %v", err)
+			return
+		}
+		upCh <- up
+	}
+	connect := func() mqtt.Client {
+		clientOpts := mqtt.NewClientOptions()
+		clientOpts.AddBroker(fmt.Sprintf("tcp://%v", lis.Addr()))
+		clientOpts.SetUsername(registeredApplicationUID)
+		clientOpts.SetPassword(registeredApplicationKey)
+		clientOpts.SetClientID("test-client")
+		clientOpts.SetCleanSession(false)
+		clientOpts.SetDefaultPublishHandler(handler)
+		client := mqtt.NewClient(clientOpts)
+		token := client.Connect()
+		if !a.So(token.WaitTimeout(timeout), should.BeTrue) || !a.So(token.Error(), should.BeNil) {
+			t.FailNow()
+		}
+		return client
+	}
+
+	client := connect()
+
+	var sub *io.Subscription
+	select {
+	case sub = <-as.Subscriptions():
+	case <-time.After(timeout):
+		t.Fatal("Connection timeout")
+	}
+
+	topic := fmt.Sprintf("v3/%v/devices/%v/up", unique.ID(ctx, registeredDeviceID.ApplicationIdentifiers), registeredDeviceID.DeviceID)
+	if token := client.Subscribe(topic, 1, handler); !a.So(token.WaitTimeout(timeout), should.BeTrue) {
+		t.FailNow()
+	}
+	client.Disconnect(100)
+	time.Sleep(timeout / 2)
+
+	// Send more messages than the session queues; the oldest message is dropped.
+	for i := byte(1); i <= 3; i++ {
+		err := sub.SendUp(&ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: registeredDeviceID,
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{FRMPayload: []byte{i, i, i}},
+			},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+	time.Sleep(timeout / 2)
+
+	client = connect()
+	defer client.Disconnect(100)
+
+	for _, expected := range []byte{2, 3} {
+		select {
+		case up := <-upCh:
+			a.So(up.GetUplinkMessage().GetFRMPayload(), should.Resemble, []byte{expected, expected, expected})
+		case <-time.After(timeout):
+			t.Fatalf("Receive expected queued upstream message %d timeout", expected)
+		}
+	}
+	select {
+	case up := <-upCh:
+		t.Fatalf("Expected no more upstream messages but have %v", up)
+	case <-time.After(timeout / 2):
+	}
+}
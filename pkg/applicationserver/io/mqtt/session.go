@@ -0,0 +1,259 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"sync"
+	"time"
+
+	"github.com/TheThingsIndustries/mystique/pkg/packet"
+	"github.com/TheThingsIndustries/mystique/pkg/subscription"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+)
+
+var (
+	errSessionExpired   = errors.DefineAborted("session_expired", "session expired")
+	errSessionDiscarded = errors.DefineAborted("session_discarded", "session discarded by clean session")
+)
+
+func sessionKey(appUID, clientID string) string {
+	return appUID + "/" + clientID
+}
+
+// sessionStore contains the persistent sessions by application and client ID.
+type sessionStore struct {
+	format    Format
+	expiry    time.Duration
+	maxQueued int
+
+	mu       sync.Mutex
+	sessions map[string]*persistentSession
+}
+
+func (s *sessionStore) enabled() bool {
+	return s.expiry > 0
+}
+
+// getOrCreate returns the persistent session with the given key, and whether the session was present.
+// If the session is not present, a new session is created with an upstream subscription from subscribe.
+func (s *sessionStore) getOrCreate(key string, subscribe func() (*io.Subscription, error)) (*persistentSession, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if session, ok := s.sessions[key]; ok && session.io.Context().Err() == nil {
+		session.claim()
+		return session, true, nil
+	}
+	sub, err := subscribe()
+	if err != nil {
+		return nil, false, err
+	}
+	session := &persistentSession{
+		key:   key,
+		store: s,
+		io:    sub,
+	}
+	s.sessions[key] = session
+	go session.run()
+	return session, false, nil
+}
+
+// remove removes the persistent session with the given key. If session is not nil, the session is only removed if it
+// is the stored session. The removed session is closed with the given cause.
+func (s *sessionStore) remove(key string, session *persistentSession, cause error) {
+	s.mu.Lock()
+	stored, ok := s.sessions[key]
+	if !ok || session != nil && stored != session {
+		s.mu.Unlock()
+		return
+	}
+	delete(s.sessions, key)
+	s.mu.Unlock()
+	stored.close(cause)
+}
+
+// persistentSession is the session of a client that connects with clean session disabled.
+// The session keeps the subscriptions and the QoS 1 messages of the client while it is disconnected.
+type persistentSession struct {
+	key   string
+	store *sessionStore
+	io    *io.Subscription
+
+	mu            sync.Mutex
+	conn          *connection
+	access        topicAccess
+	subscriptions subscription.List
+	queue         []*packet.PublishPacket
+	generation    uint64
+	expiry        *time.Timer
+}
+
+// run publishes upstream messages until the upstream subscription is done.
+func (s *persistentSession) run() {
+	ctx := s.io.Context()
+	logger := log.FromContext(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			logger.WithError(ctx.Err()).Debug("Done sending upstream messages")
+			return
+		case up := <-s.io.Up():
+			pkt, err := upstreamPacket(s.io, s.store.format, up)
+			if err != nil {
+				logger.WithError(err).Warn("Failed to marshal upstream message")
+				continue
+			}
+			if pkt == nil {
+				continue
+			}
+			s.publish(pkt)
+		}
+	}
+}
+
+// publish publishes the packet to the connected client, or queues the packet if the client is disconnected and
+// subscribed to the topic with QoS 1 or higher.
+func (s *persistentSession) publish(pkt *packet.PublishPacket) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn != nil {
+		log.FromContext(s.io.Context()).Info("Publishing upstream message")
+		s.conn.session.Publish(pkt)
+		return
+	}
+	if !s.access.canRead(pkt.TopicParts) {
+		return
+	}
+	if qos, ok := s.subscriptions.Match(pkt.TopicParts...); !ok || qos == 0 {
+		return
+	}
+	log.FromContext(s.io.Context()).Debug("Queue upstream message")
+	s.enqueue(pkt)
+}
+
+// enqueue appends the packets to the queue. If the queue exceeds the maximum size, the oldest packets are dropped.
+// The caller must hold the lock.
+func (s *persistentSession) enqueue(pkts ...*packet.PublishPacket) {
+	s.queue = append(s.queue, pkts...)
+	if max := s.store.maxQueued; max > 0 && len(s.queue) > max {
+		dropped := len(s.queue) - max
+		log.FromContext(s.io.Context()).WithField("count", dropped).Warn("Session queue full, drop oldest messages")
+		s.queue = append(s.queue[:0:0], s.queue[dropped:]...)
+	}
+}
+
+// claim stops the expiry of the session. The caller must hold the store lock.
+func (s *persistentSession) claim() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.generation++
+	if s.expiry != nil {
+		s.expiry.Stop()
+		s.expiry = nil
+	}
+}
+
+// capture takes the subscriptions and the unacknowledged messages of the connection.
+// The caller must hold the lock.
+func (s *persistentSession) capture(c *connection) {
+	s.subscriptions.Clear()
+	for filter, qos := range c.session.Subscriptions() {
+		s.subscriptions.Add(filter, qos)
+	}
+	s.access = c.session.AuthInfo().Metadata.(topicAccess)
+	var pending []*packet.PublishPacket
+	for _, pkt := range c.session.Pending() {
+		if pub, ok := pkt.(*packet.PublishPacket); ok {
+			pending = append(pending, pub)
+		}
+	}
+	queue := s.queue
+	s.queue = pending
+	s.enqueue(queue...)
+}
+
+// attach attaches the connection to the session. Another connection of the session is closed.
+// The subscriptions of the session are restored and the queued messages are published to the connection.
+func (s *persistentSession) attach(c *connection) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	select {
+	case <-c.done:
+		// The connection is closed and detaches from the session.
+		return
+	default:
+	}
+	if old := s.conn; old != nil && old != c {
+		s.capture(old)
+		old.mqtt.Close()
+	}
+	s.conn = nil
+	if subs := s.subscriptions.Subscriptions(); len(subs) > 0 {
+		pkt := &packet.SubscribePacket{}
+		for filter, qos := range subs {
+			pkt.Topics = append(pkt.Topics, filter)
+			pkt.QoSs = append(pkt.QoSs, qos)
+		}
+		c.session.HandleSubscribe(pkt)
+	}
+	queue := s.queue
+	s.queue = nil
+	for i, pkt := range queue {
+		if !c.waitPublish() {
+			s.queue = queue[i:]
+			return
+		}
+		c.session.Publish(pkt)
+	}
+	s.conn = c
+}
+
+// detach detaches the connection from the session, keeping its subscriptions and unacknowledged messages.
+// If no connection is attached, the session expires after the configured expiry.
+func (s *persistentSession) detach(c *connection) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn == c {
+		s.capture(c)
+		s.conn = nil
+	}
+	if s.conn != nil || s.expiry != nil {
+		return
+	}
+	generation := s.generation
+	s.expiry = time.AfterFunc(s.store.expiry, func() {
+		s.mu.Lock()
+		expired := s.generation == generation && s.conn == nil
+		s.mu.Unlock()
+		if expired {
+			s.store.remove(s.key, s, errSessionExpired)
+		}
+	})
+}
+
+// close closes the attached connection and the upstream subscription.
+func (s *persistentSession) close(cause error) {
+	s.mu.Lock()
+	if s.conn != nil {
+		s.conn.mqtt.Close()
+	}
+	if s.expiry != nil {
+		s.expiry.Stop()
+		s.expiry = nil
+	}
+	s.mu.Unlock()
+	s.io.Disconnect(cause)
+}
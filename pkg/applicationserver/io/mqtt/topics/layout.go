@@ -33,4 +33,5 @@ type Layout interface {
 	DownlinkReplaceTopic(applicationUID, deviceID string) []string
 	IsDownlinkReplaceTopic(parts []string) bool
 	ParseDownlinkReplaceTopic(parts []string) (deviceID string)
+	DownlinkQueueResultTopic(applicationUID, deviceID string) []string
 }
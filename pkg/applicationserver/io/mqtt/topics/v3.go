@@ -100,5 +100,9 @@ func (v3) ParseDownlinkReplaceTopic(parts []string) (deviceID string) {
 	return parts[3]
 }
 
+func (v3) DownlinkQueueResultTopic(applicationUID, deviceID string) []string {
+	return []string{topicV3, applicationUID, "devices", deviceID, "down", "result"}
+}
+
 // Default is the default topic layout.
 var Default Layout = &v3{}
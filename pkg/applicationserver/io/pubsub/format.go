@@ -0,0 +1,41 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub
+
+import (
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/formatters"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+// Format is a format to use for pub/sub integrations.
+type Format struct {
+	formatters.Formatter
+	Name string
+}
+
+var (
+	formats = map[string]Format{
+		"json": {
+			Formatter: formatters.JSON,
+			Name:      "JSON",
+		},
+		"protobuf": {
+			Formatter: formatters.Protobuf,
+			Name:      "Protocol Buffers",
+		},
+	}
+
+	errFormatNotFound = errors.DefineNotFound("format_not_found", "format `{format}` not found")
+)
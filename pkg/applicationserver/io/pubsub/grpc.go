@@ -0,0 +1,126 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub
+
+import (
+	"context"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+type pubsubRegistryRPC struct {
+	pubsub *PubSub
+}
+
+// NewPubSubRegistryRPC returns a new pub/sub registry gRPC server.
+// Changes made through the registry server are applied to the running integrations.
+func NewPubSubRegistryRPC(pubsub *PubSub) ttnpb.ApplicationPubSubRegistryServer {
+	return &pubsubRegistryRPC{
+		pubsub: pubsub,
+	}
+}
+
+func (s pubsubRegistryRPC) GetFormats(ctx context.Context, _ *pbtypes.Empty) (*ttnpb.ApplicationPubSubFormats, error) {
+	fs := make(map[string]string, len(formats))
+	for key, val := range formats {
+		fs[key] = val.Name
+	}
+	return &ttnpb.ApplicationPubSubFormats{
+		Formats: fs,
+	}, nil
+}
+
+func (s pubsubRegistryRPC) Get(ctx context.Context, req *ttnpb.GetApplicationPubSubRequest) (*ttnpb.ApplicationPubSub, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	return s.pubsub.registry.Get(ctx, req.ApplicationPubSubIdentifiers, req.FieldMask.Paths)
+}
+
+func (s pubsubRegistryRPC) List(ctx context.Context, req *ttnpb.ListApplicationPubSubsRequest) (*ttnpb.ApplicationPubSubs, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	pubsubs, err := s.pubsub.registry.List(ctx, req.ApplicationIdentifiers, req.FieldMask.Paths)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.ApplicationPubSubs{
+		Pubsubs: pubsubs,
+	}, nil
+}
+
+func (s pubsubRegistryRPC) Set(ctx context.Context, req *ttnpb.SetApplicationPubSubRequest) (*ttnpb.ApplicationPubSub, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+	); err != nil {
+		return nil, err
+	}
+	gets := append(append(make([]string, 0, len(req.FieldMask.Paths)+len(pubsubPaths)), req.FieldMask.Paths...), pubsubPaths...)
+	stored, err := s.pubsub.registry.Set(ctx, req.ApplicationPubSubIdentifiers, gets,
+		func(stored *ttnpb.ApplicationPubSub) (*ttnpb.ApplicationPubSub, []string, error) {
+			merged := &ttnpb.ApplicationPubSub{}
+			if stored != nil {
+				if err := merged.SetFields(stored, pubsubPaths...); err != nil {
+					return nil, nil, err
+				}
+			}
+			if err := merged.SetFields(&req.ApplicationPubSub, req.FieldMask.Paths...); err != nil {
+				return nil, nil, err
+			}
+			if _, _, err := validate(merged); err != nil {
+				return nil, nil, err
+			}
+			return &req.ApplicationPubSub, req.FieldMask.Paths, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	started := &ttnpb.ApplicationPubSub{}
+	if err := started.SetFields(stored, append(pubsubPaths, "ids")...); err != nil {
+		return nil, err
+	}
+	if err := s.pubsub.start(started); err != nil {
+		return nil, err
+	}
+	pb := &ttnpb.ApplicationPubSub{}
+	if err := pb.SetFields(stored, append(req.FieldMask.Paths, "ids")...); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+func (s pubsubRegistryRPC) Delete(ctx context.Context, req *ttnpb.ApplicationPubSubIdentifiers) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+	); err != nil {
+		return nil, err
+	}
+	_, err := s.pubsub.registry.Set(ctx, *req, nil,
+		func(pubsub *ttnpb.ApplicationPubSub) (*ttnpb.ApplicationPubSub, []string, error) {
+			return nil, nil, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	s.pubsub.stop(*req)
+	return ttnpb.Empty, nil
+}
@@ -0,0 +1,124 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mqtt implements the MQTT provider for pub/sub integrations using the Paho MQTT client.
+package mqtt
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+const (
+	separator         = "/"
+	connectTimeout    = 10 * time.Second
+	disconnectTimeout = time.Second
+)
+
+type impl struct{}
+
+var errInvalidProvider = errors.DefineInvalidArgument("invalid_provider", "PubSub is not an MQTT PubSub")
+
+// OpenConnection implements provider.Provider.
+func (impl) OpenConnection(ctx context.Context, pb *ttnpb.ApplicationPubSub) (provider.Connection, error) {
+	settings, ok := pb.Provider.(*ttnpb.ApplicationPubSub_MQTT)
+	if !ok || settings.MQTT == nil {
+		return nil, errInvalidProvider
+	}
+	c := &connection{
+		baseTopic:    pb.BaseTopic,
+		publishQoS:   byte(settings.MQTT.PublishQoS),
+		subscribeQoS: byte(settings.MQTT.SubscribeQoS),
+		done:         make(chan struct{}),
+	}
+	opts := mqtt.NewClientOptions()
+	opts.AddBroker(settings.MQTT.ServerURL)
+	opts.SetClientID(settings.MQTT.ClientID)
+	opts.SetUsername(settings.MQTT.Username)
+	opts.SetPassword(settings.MQTT.Password)
+	opts.SetAutoReconnect(false)
+	opts.SetConnectTimeout(connectTimeout)
+	opts.SetConnectionLostHandler(func(mqtt.Client, error) {
+		c.closeDone()
+	})
+	c.client = mqtt.NewClient(opts)
+	if err := wait(ctx, c.client.Connect()); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+type connection struct {
+	client       mqtt.Client
+	baseTopic    string
+	publishQoS   byte
+	subscribeQoS byte
+	done         chan struct{}
+	doneOnce     sync.Once
+}
+
+func (c *connection) closeDone() {
+	c.doneOnce.Do(func() { close(c.done) })
+}
+
+// Publish implements provider.Connection.
+func (c *connection) Publish(ctx context.Context, topic []string, payload []byte) error {
+	return wait(ctx, c.client.Publish(provider.JoinTopic(c.baseTopic, topic, separator), c.publishQoS, false, payload))
+}
+
+// Subscribe implements provider.Connection.
+func (c *connection) Subscribe(ctx context.Context, topic []string, handler provider.MessageHandler) error {
+	return wait(ctx, c.client.Subscribe(provider.JoinTopic(c.baseTopic, topic, separator), c.subscribeQoS, func(_ mqtt.Client, msg mqtt.Message) {
+		parts, ok := provider.SplitTopic(c.baseTopic, msg.Topic(), separator)
+		if !ok {
+			return
+		}
+		handler(parts, msg.Payload())
+	}))
+}
+
+// Done implements provider.Connection.
+func (c *connection) Done() <-chan struct{} { return c.done }
+
+// Close implements provider.Connection.
+func (c *connection) Close() error {
+	c.client.Disconnect(uint(disconnectTimeout / time.Millisecond))
+	c.closeDone()
+	return nil
+}
+
+// wait waits for the token to complete or the context to be done.
+func wait(ctx context.Context, token mqtt.Token) error {
+	done := make(chan struct{})
+	go func() {
+		token.Wait()
+		close(done)
+	}()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-done:
+		return token.Error()
+	}
+}
+
+func init() {
+	provider.RegisterProvider(&ttnpb.ApplicationPubSub_MQTT{}, impl{})
+}
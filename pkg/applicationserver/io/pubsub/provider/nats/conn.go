@@ -0,0 +1,295 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/version"
+)
+
+const (
+	defaultPort  = "4222"
+	dialTimeout  = 10 * time.Second
+	writeTimeout = 10 * time.Second
+)
+
+var (
+	errServerURL = errors.DefineInvalidArgument("server_url", "invalid server URL `{url}`")
+	errScheme    = errors.DefineInvalidArgument("scheme", "unsupported scheme `{scheme}`")
+	errProtocol  = errors.DefineDataLoss("protocol", "unexpected protocol message `{message}`")
+	errServer    = errors.DefineUnavailable("server", "server error `{message}`")
+	errClosed    = errors.DefineUnavailable("closed", "connection closed")
+)
+
+type serverInfo struct {
+	TLSRequired bool `json:"tls_required"`
+}
+
+type connectInfo struct {
+	Verbose   bool   `json:"verbose"`
+	Pedantic  bool   `json:"pedantic"`
+	User      string `json:"user,omitempty"`
+	Pass      string `json:"pass,omitempty"`
+	AuthToken string `json:"auth_token,omitempty"`
+	Name      string `json:"name"`
+	Lang      string `json:"lang"`
+	Version   string `json:"version"`
+	Protocol  int    `json:"protocol"`
+}
+
+type msgHandler func(subject string, payload []byte)
+
+// conn is a connection to a NATS server.
+type conn struct {
+	netConn net.Conn
+	reader  *bufio.Reader
+
+	writeMu sync.Mutex
+
+	handlersMu sync.RWMutex
+	handlers   map[string]msgHandler
+	nextSID    int
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// dial connects to the NATS server at the given URL and performs the handshake.
+// Supported schemes are nats and tls.
+func dial(ctx context.Context, serverURL string) (*conn, error) {
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return nil, errServerURL.WithCause(err).WithAttributes("url", serverURL)
+	}
+	var useTLS bool
+	switch u.Scheme {
+	case "nats":
+	case "tls":
+		useTLS = true
+	default:
+		return nil, errScheme.WithAttributes("scheme", u.Scheme)
+	}
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), defaultPort)
+	}
+
+	dialer := &net.Dialer{Timeout: dialTimeout}
+	netConn, err := dialer.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		netConn.SetDeadline(deadline)
+	} else {
+		netConn.SetDeadline(time.Now().Add(dialTimeout))
+	}
+	c := &conn{
+		netConn:  netConn,
+		reader:   bufio.NewReader(netConn),
+		handlers: make(map[string]msgHandler),
+		done:     make(chan struct{}),
+	}
+	if err := c.handshake(u, useTLS); err != nil {
+		netConn.Close()
+		return nil, err
+	}
+	c.netConn.SetDeadline(time.Time{})
+	go c.readLoop()
+	return c, nil
+}
+
+func (c *conn) readLine() (string, error) {
+	line, err := c.reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func splitOp(line string) (op, args string) {
+	parts := strings.SplitN(line, " ", 2)
+	op = strings.ToUpper(parts[0])
+	if len(parts) > 1 {
+		args = strings.TrimSpace(parts[1])
+	}
+	return
+}
+
+func (c *conn) handshake(u *url.URL, useTLS bool) error {
+	line, err := c.readLine()
+	if err != nil {
+		return err
+	}
+	op, args := splitOp(line)
+	if op != "INFO" {
+		return errProtocol.WithAttributes("message", line)
+	}
+	var info serverInfo
+	if err := json.Unmarshal([]byte(args), &info); err != nil {
+		return errProtocol.WithCause(err).WithAttributes("message", line)
+	}
+	if useTLS || info.TLSRequired {
+		tlsConn := tls.Client(c.netConn, &tls.Config{ServerName: u.Hostname()})
+		if err := tlsConn.Handshake(); err != nil {
+			return err
+		}
+		c.netConn = tlsConn
+		c.reader = bufio.NewReader(tlsConn)
+	}
+
+	connect := connectInfo{
+		Name:    "ttn-lw-application-server",
+		Lang:    "go",
+		Version: version.TTN,
+	}
+	if u.User != nil {
+		if pass, ok := u.User.Password(); ok {
+			connect.User, connect.Pass = u.User.Username(), pass
+		} else {
+			connect.AuthToken = u.User.Username()
+		}
+	}
+	buf, err := json.Marshal(connect)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(c.netConn, "CONNECT %s\r\nPING\r\n", buf); err != nil {
+		return err
+	}
+	line, err = c.readLine()
+	if err != nil {
+		return err
+	}
+	switch op, args := splitOp(line); op {
+	case "PONG":
+		return nil
+	case "-ERR":
+		return errServer.WithAttributes("message", strings.Trim(args, "'"))
+	default:
+		return errProtocol.WithAttributes("message", line)
+	}
+}
+
+func (c *conn) readLoop() {
+	c.read()
+	c.close()
+}
+
+func (c *conn) read() error {
+	for {
+		line, err := c.readLine()
+		if err != nil {
+			return err
+		}
+		op, args := splitOp(line)
+		switch op {
+		case "MSG":
+			// MSG <subject> <sid> [reply-to] <#bytes>
+			fields := strings.Fields(args)
+			if len(fields) < 3 || len(fields) > 4 {
+				return errProtocol.WithAttributes("message", line)
+			}
+			size, err := strconv.Atoi(fields[len(fields)-1])
+			if err != nil || size < 0 {
+				return errProtocol.WithAttributes("message", line)
+			}
+			payload := make([]byte, size+2)
+			if _, err := io.ReadFull(c.reader, payload); err != nil {
+				return err
+			}
+			c.handlersMu.RLock()
+			handler, ok := c.handlers[fields[1]]
+			c.handlersMu.RUnlock()
+			if ok {
+				handler(fields[0], payload[:size])
+			}
+		case "PING":
+			if err := c.write(context.Background(), []byte("PONG\r\n")); err != nil {
+				return err
+			}
+		case "PONG", "+OK", "INFO":
+		case "-ERR":
+			return errServer.WithAttributes("message", strings.Trim(args, "'"))
+		default:
+			return errProtocol.WithAttributes("message", line)
+		}
+	}
+}
+
+func (c *conn) write(ctx context.Context, buf []byte) error {
+	select {
+	case <-c.done:
+		return errClosed
+	default:
+	}
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(writeTimeout)
+	}
+	c.netConn.SetWriteDeadline(deadline)
+	_, err := c.netConn.Write(buf)
+	return err
+}
+
+// publish publishes the payload on the subject.
+func (c *conn) publish(ctx context.Context, subject string, payload []byte) error {
+	buf := make([]byte, 0, len(subject)+len(payload)+32)
+	buf = append(buf, fmt.Sprintf("PUB %s %d\r\n", subject, len(payload))...)
+	buf = append(buf, payload...)
+	buf = append(buf, "\r\n"...)
+	return c.write(ctx, buf)
+}
+
+// subscribe subscribes the handler to the subject.
+func (c *conn) subscribe(ctx context.Context, subject string, handler msgHandler) error {
+	c.handlersMu.Lock()
+	c.nextSID++
+	sid := strconv.Itoa(c.nextSID)
+	c.handlers[sid] = handler
+	c.handlersMu.Unlock()
+	if err := c.write(ctx, []byte(fmt.Sprintf("SUB %s %s\r\n", subject, sid))); err != nil {
+		c.handlersMu.Lock()
+		delete(c.handlers, sid)
+		c.handlersMu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// close closes the connection. The done channel is closed when the connection is closed.
+func (c *conn) close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		err = c.netConn.Close()
+		close(c.done)
+	})
+	return err
+}
@@ -0,0 +1,89 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package nats implements the NATS provider for pub/sub integrations.
+// The provider implements the subset of the NATS client protocol that is needed to publish and subscribe.
+package nats
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+const separator = "."
+
+type impl struct{}
+
+var errInvalidProvider = errors.DefineInvalidArgument("invalid_provider", "PubSub is not a NATS PubSub")
+
+// OpenConnection implements provider.Provider.
+func (impl) OpenConnection(ctx context.Context, pb *ttnpb.ApplicationPubSub) (provider.Connection, error) {
+	settings, ok := pb.Provider.(*ttnpb.ApplicationPubSub_NATS)
+	if !ok || settings.NATS == nil {
+		return nil, errInvalidProvider
+	}
+	conn, err := dial(ctx, settings.NATS.ServerURL)
+	if err != nil {
+		return nil, err
+	}
+	return &connection{
+		conn:      conn,
+		baseTopic: pb.BaseTopic,
+	}, nil
+}
+
+type connection struct {
+	conn      *conn
+	baseTopic string
+}
+
+func subject(base string, topic []string) string {
+	parts := make([]string, len(topic))
+	for i, part := range topic {
+		if part == provider.Wildcard {
+			part = "*"
+		}
+		parts[i] = part
+	}
+	return provider.JoinTopic(base, parts, separator)
+}
+
+// Publish implements provider.Connection.
+func (c *connection) Publish(ctx context.Context, topic []string, payload []byte) error {
+	return c.conn.publish(ctx, subject(c.baseTopic, topic), payload)
+}
+
+// Subscribe implements provider.Connection.
+func (c *connection) Subscribe(ctx context.Context, topic []string, handler provider.MessageHandler) error {
+	return c.conn.subscribe(ctx, subject(c.baseTopic, topic), func(subject string, payload []byte) {
+		parts, ok := provider.SplitTopic(c.baseTopic, subject, separator)
+		if !ok {
+			return
+		}
+		handler(parts, payload)
+	})
+}
+
+// Done implements provider.Connection.
+func (c *connection) Done() <-chan struct{} { return c.conn.done }
+
+// Close implements provider.Connection.
+func (c *connection) Close() error { return c.conn.close() }
+
+func init() {
+	provider.RegisterProvider(&ttnpb.ApplicationPubSub_NATS{}, impl{})
+}
@@ -0,0 +1,235 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider"
+	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/nats"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+var timeout = (1 << 6) * test.Delay
+
+type subscription struct {
+	conn    net.Conn
+	subject []string
+	sid     string
+}
+
+func (s subscription) matches(subject []string) bool {
+	if len(subject) != len(s.subject) {
+		return false
+	}
+	for i, part := range s.subject {
+		if part != "*" && part != subject[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// server is a minimal NATS server that supports authentication with a username and password, and routes published
+// messages to subscriptions.
+type server struct {
+	net.Listener
+	user, pass string
+
+	mu   sync.Mutex
+	subs []subscription
+}
+
+func newServer(t *testing.T, user, pass string) *server {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	s := &server{Listener: lis, user: user, pass: pass}
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go s.handle(conn)
+		}
+	}()
+	return s
+}
+
+func (s *server) handle(conn net.Conn) {
+	defer conn.Close()
+	fmt.Fprint(conn, "INFO {\"server_id\":\"test\",\"auth_required\":true}\r\n")
+	r := bufio.NewReader(conn)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		switch fields[0] {
+		case "CONNECT":
+			var info struct {
+				User string `json:"user"`
+				Pass string `json:"pass"`
+			}
+			if err := json.Unmarshal([]byte(strings.TrimPrefix(strings.TrimSpace(line), "CONNECT ")), &info); err != nil ||
+				info.User != s.user || info.Pass != s.pass {
+				fmt.Fprint(conn, "-ERR 'Authorization Violation'\r\n")
+				return
+			}
+		case "PING":
+			fmt.Fprint(conn, "PONG\r\n")
+		case "SUB":
+			s.mu.Lock()
+			s.subs = append(s.subs, subscription{conn: conn, subject: strings.Split(fields[1], "."), sid: fields[2]})
+			s.mu.Unlock()
+		case "PUB":
+			size, _ := strconv.Atoi(fields[2])
+			payload := make([]byte, size+2)
+			if _, err := io.ReadFull(r, payload); err != nil {
+				return
+			}
+			s.publish(fields[1], payload[:size])
+		}
+	}
+}
+
+func (s *server) publish(subject string, payload []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sub := range s.subs {
+		if sub.matches(strings.Split(subject, ".")) {
+			fmt.Fprintf(sub.conn, "MSG %s %s %d\r\n%s\r\n", subject, sub.sid, len(payload), payload)
+		}
+	}
+}
+
+func (s *server) subscriptions() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.subs)
+}
+
+type message struct {
+	topic   []string
+	payload []byte
+}
+
+func TestNATS(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	srv := newServer(t, "foo", "secret")
+	defer srv.Close()
+
+	newPubSub := func(serverURL string) *ttnpb.ApplicationPubSub {
+		return &ttnpb.ApplicationPubSub{
+			ApplicationPubSubIdentifiers: ttnpb.ApplicationPubSubIdentifiers{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+				PubSubID:               "foo-pubsub",
+			},
+			BaseTopic: "foo.bar",
+			Provider: &ttnpb.ApplicationPubSub_NATS{
+				NATS: &ttnpb.ApplicationPubSub_NATSProvider{
+					ServerURL: serverURL,
+				},
+			},
+		}
+	}
+
+	p, err := provider.GetProvider(newPubSub(""))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := p.OpenConnection(ctx, newPubSub(fmt.Sprintf("nats://foo:wrong@%s", srv.Addr())))
+		a.So(err, should.NotBeNil)
+	})
+
+	t.Run("InvalidScheme", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := p.OpenConnection(ctx, newPubSub(fmt.Sprintf("http://%s", srv.Addr())))
+		a.So(err, should.NotBeNil)
+	})
+
+	conn, err := p.OpenConnection(ctx, newPubSub(fmt.Sprintf("nats://foo:secret@%s", srv.Addr())))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	msgCh := make(chan message, 1)
+	err = conn.Subscribe(ctx, []string{"v3", "foo-app", "devices", provider.Wildcard, "down", "push"}, func(topic []string, payload []byte) {
+		msgCh <- message{topic: topic, payload: payload}
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	for srv.subscriptions() == 0 {
+		time.Sleep(test.Delay)
+	}
+
+	t.Run("Receive", func(t *testing.T) {
+		a := assertions.New(t)
+		srv.publish("foo.bar.v3.foo-app.devices.foo-device.down.push", []byte("hello"))
+		srv.publish("foo.bar.v3.foo-app.devices.foo-device.down.replace", []byte("ignored"))
+		select {
+		case msg := <-msgCh:
+			a.So(msg.topic, should.Resemble, []string{"v3", "foo-app", "devices", "foo-device", "down", "push"})
+			a.So(msg.payload, should.Resemble, []byte("hello"))
+		case <-time.After(timeout):
+			t.Fatal("Expected message")
+		}
+	})
+
+	t.Run("Publish", func(t *testing.T) {
+		a := assertions.New(t)
+		err := conn.Publish(ctx, []string{"v3", "foo-app", "devices", "bar-device", "down", "push"}, []byte("world"))
+		a.So(err, should.BeNil)
+		select {
+		case msg := <-msgCh:
+			a.So(msg.topic, should.Resemble, []string{"v3", "foo-app", "devices", "bar-device", "down", "push"})
+			a.So(msg.payload, should.Resemble, []byte("world"))
+		case <-time.After(timeout):
+			t.Fatal("Expected message")
+		}
+	})
+
+	a.So(conn.Close(), should.BeNil)
+	select {
+	case <-conn.Done():
+	case <-time.After(timeout):
+		t.Fatal("Expected connection to be done")
+	}
+	a.So(conn.Publish(ctx, []string{"foo"}, nil), should.NotBeNil)
+}
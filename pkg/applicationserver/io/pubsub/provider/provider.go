@@ -0,0 +1,105 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package provider implements the pub/sub provider interfaces and the registry of available providers.
+package provider
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// Wildcard is the single-level wildcard used in subscription topics.
+// Providers translate it to their own wildcard syntax.
+const Wildcard = "+"
+
+// Provider opens connections to pub/sub brokers.
+type Provider interface {
+	// OpenConnection opens a connection to the broker configured in the given PubSub.
+	OpenConnection(ctx context.Context, pb *ttnpb.ApplicationPubSub) (Connection, error)
+}
+
+// MessageHandler handles a message received on the given topic.
+// The topic is relative to the base topic of the PubSub.
+type MessageHandler func(topic []string, payload []byte)
+
+// Connection is an open connection to a pub/sub broker.
+// The topics are relative to the base topic of the PubSub.
+type Connection interface {
+	// Publish publishes the payload on the topic.
+	Publish(ctx context.Context, topic []string, payload []byte) error
+	// Subscribe subscribes the handler to the topic. The topic may contain single-level wildcards.
+	Subscribe(ctx context.Context, topic []string, handler MessageHandler) error
+	// Done returns a channel that is closed when the connection is lost or closed.
+	Done() <-chan struct{}
+	// Close closes the connection.
+	Close() error
+}
+
+var providers = map[reflect.Type]Provider{}
+
+// RegisterProvider registers the implementation for the given provider type of ttnpb.ApplicationPubSub.
+// The provider type is the oneof value, i.e. *ttnpb.ApplicationPubSub_NATS.
+// This function is not safe for concurrent use and should only be called on initialization.
+func RegisterProvider(p interface{}, implementation Provider) {
+	t := reflect.TypeOf(p)
+	if _, ok := providers[t]; ok {
+		panic(errAlreadyRegistered.WithAttributes("provider", t.String()))
+	}
+	providers[t] = implementation
+}
+
+var (
+	errAlreadyRegistered = errors.DefineAlreadyExists("already_registered", "provider `{provider}` already registered")
+	errNoProvider        = errors.DefineInvalidArgument("no_provider", "no provider set")
+	errNotImplemented    = errors.DefineInvalidArgument("provider_not_implemented", "provider `{provider}` is not implemented")
+)
+
+// GetProvider returns the implementation of the provider of the given PubSub.
+func GetProvider(pb *ttnpb.ApplicationPubSub) (Provider, error) {
+	if pb.Provider == nil {
+		return nil, errNoProvider
+	}
+	t := reflect.TypeOf(pb.Provider)
+	implementation, ok := providers[t]
+	if !ok {
+		return nil, errNotImplemented.WithAttributes("provider", t.String())
+	}
+	return implementation, nil
+}
+
+// JoinTopic joins the base topic and the topic parts with the separator.
+func JoinTopic(base string, topic []string, separator string) string {
+	if base == "" {
+		return strings.Join(topic, separator)
+	}
+	return strings.TrimSuffix(base, separator) + separator + strings.Join(topic, separator)
+}
+
+// SplitTopic strips the base topic from the topic and splits the remainder by the separator.
+// The returned boolean is false if the topic does not start with the base topic.
+func SplitTopic(base, topic, separator string) ([]string, bool) {
+	if base != "" {
+		prefix := strings.TrimSuffix(base, separator) + separator
+		if !strings.HasPrefix(topic, prefix) {
+			return nil, false
+		}
+		topic = strings.TrimPrefix(topic, prefix)
+	}
+	return strings.Split(topic, separator), true
+}
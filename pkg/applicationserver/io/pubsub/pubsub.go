@@ -0,0 +1,362 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pubsub implements the pub/sub integrations of the Application Server. A pub/sub integration connects to an
+// external NATS or MQTT broker, publishes upstream messages and subscribes to downlink queue operations on the topics
+// of the MQTT frontend, prefixed with a configurable base topic.
+package pubsub
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/mqtt/topics"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// RetryInterval is the interval after which a failed or lost connection to a broker is retried.
+var RetryInterval = 10 * time.Second
+
+// pubsubPaths are the paths that are needed to start a pub/sub integration.
+var pubsubPaths = []string{
+	"base_topic",
+	"format",
+	"provider",
+}
+
+// PubSub is the pub/sub integration. It maintains a connection to the broker of each registered pub/sub.
+type PubSub struct {
+	ctx      context.Context
+	server   io.Server
+	registry Registry
+
+	integrationsMu sync.RWMutex
+	integrations   map[string]map[string]*integration // Application UID to pub/sub ID.
+}
+
+// New returns a new PubSub and starts the integrations of all registered pub/subs.
+// Integrations that fail to start are logged and retried in the background.
+// The integrations stop when the given context is done.
+func New(ctx context.Context, server io.Server, registry Registry) (*PubSub, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/pubsub")
+	ps := &PubSub{
+		ctx:          ctx,
+		server:       server,
+		registry:     registry,
+		integrations: make(map[string]map[string]*integration),
+	}
+	var failed []ttnpb.ApplicationPubSubIdentifiers
+	err := registry.Range(ctx, pubsubPaths, func(ctx context.Context, _ ttnpb.ApplicationIdentifiers, pb *ttnpb.ApplicationPubSub) bool {
+		if err := ps.start(pb); err != nil {
+			log.FromContext(ctx).WithError(err).WithFields(log.Fields(
+				"application_id", pb.ApplicationID,
+				"pub_sub_id", pb.PubSubID,
+			)).Warn("Failed to start integration")
+			failed = append(failed, pb.ApplicationPubSubIdentifiers)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(failed) > 0 {
+		go ps.retryStart(failed)
+	}
+	return ps, nil
+}
+
+// retryStart retries starting the integrations of the given pub/subs every RetryInterval, until they are started or
+// deleted, or until the context is done.
+func (ps *PubSub) retryStart(ids []ttnpb.ApplicationPubSubIdentifiers) {
+	for len(ids) > 0 {
+		select {
+		case <-ps.ctx.Done():
+			return
+		case <-time.After(RetryInterval):
+		}
+		var failed []ttnpb.ApplicationPubSubIdentifiers
+		for _, id := range ids {
+			if ps.running(id) {
+				continue
+			}
+			pb, err := ps.registry.Get(ps.ctx, id, pubsubPaths)
+			if errors.IsNotFound(err) {
+				continue
+			}
+			if err == nil {
+				err = ps.start(pb)
+			}
+			if err != nil {
+				log.FromContext(ps.ctx).WithError(err).WithFields(log.Fields(
+					"application_id", id.ApplicationID,
+					"pub_sub_id", id.PubSubID,
+				)).Debug("Failed to start integration")
+				failed = append(failed, id)
+			}
+		}
+		ids = failed
+	}
+}
+
+// Registry returns the pub/sub registry.
+func (ps *PubSub) Registry() Registry { return ps.registry }
+
+type integration struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	done     chan struct{}
+	pb       *ttnpb.ApplicationPubSub
+	format   Format
+	provider provider.Provider
+
+	connMu sync.RWMutex
+	conn   provider.Connection
+}
+
+func (i *integration) connection() provider.Connection {
+	i.connMu.RLock()
+	defer i.connMu.RUnlock()
+	return i.conn
+}
+
+func (i *integration) setConnection(conn provider.Connection) {
+	i.connMu.Lock()
+	i.conn = conn
+	i.connMu.Unlock()
+}
+
+// validate returns the format and provider implementation of the given pub/sub.
+func validate(pb *ttnpb.ApplicationPubSub) (Format, provider.Provider, error) {
+	format, ok := formats[pb.Format]
+	if !ok {
+		return Format{}, nil, errFormatNotFound.WithAttributes("format", pb.Format)
+	}
+	p, err := provider.GetProvider(pb)
+	if err != nil {
+		return Format{}, nil, err
+	}
+	return format, p, nil
+}
+
+// start starts the integration of the given pub/sub. If the pub/sub is already running, it is restarted.
+func (ps *PubSub) start(pb *ttnpb.ApplicationPubSub) error {
+	format, p, err := validate(pb)
+	if err != nil {
+		return err
+	}
+	ps.stop(pb.ApplicationPubSubIdentifiers)
+
+	ctx := log.NewContextWithFields(ps.ctx, log.Fields(
+		"application_id", pb.ApplicationID,
+		"pub_sub_id", pb.PubSubID,
+	))
+	ctx, cancel := context.WithCancel(ctx)
+	i := &integration{
+		ctx:      ctx,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+		pb:       pb,
+		format:   format,
+		provider: p,
+	}
+	uid := unique.ID(ctx, pb.ApplicationIdentifiers)
+	ps.integrationsMu.Lock()
+	if ps.integrations[uid] == nil {
+		ps.integrations[uid] = make(map[string]*integration)
+	}
+	ps.integrations[uid][pb.PubSubID] = i
+	ps.integrationsMu.Unlock()
+	go ps.run(i)
+	return nil
+}
+
+// running returns whether the integration of the given pub/sub is running.
+func (ps *PubSub) running(ids ttnpb.ApplicationPubSubIdentifiers) bool {
+	uid := unique.ID(ps.ctx, ids.ApplicationIdentifiers)
+	ps.integrationsMu.RLock()
+	_, ok := ps.integrations[uid][ids.PubSubID]
+	ps.integrationsMu.RUnlock()
+	return ok
+}
+
+// stop stops the integration of the given pub/sub, if it is running.
+func (ps *PubSub) stop(ids ttnpb.ApplicationPubSubIdentifiers) {
+	uid := unique.ID(ps.ctx, ids.ApplicationIdentifiers)
+	ps.integrationsMu.Lock()
+	i, ok := ps.integrations[uid][ids.PubSubID]
+	if ok {
+		delete(ps.integrations[uid], ids.PubSubID)
+		if len(ps.integrations[uid]) == 0 {
+			delete(ps.integrations, uid)
+		}
+	}
+	ps.integrationsMu.Unlock()
+	if ok {
+		i.cancel()
+		<-i.done
+	}
+}
+
+func (ps *PubSub) run(i *integration) {
+	defer close(i.done)
+	logger := log.FromContext(i.ctx)
+	for {
+		conn, err := ps.connect(i)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to connect")
+		} else {
+			logger.Debug("Connected")
+			i.setConnection(conn)
+			select {
+			case <-i.ctx.Done():
+				i.setConnection(nil)
+				conn.Close()
+				return
+			case <-conn.Done():
+				i.setConnection(nil)
+				logger.Warn("Connection lost")
+			}
+		}
+		select {
+		case <-i.ctx.Done():
+			return
+		case <-time.After(RetryInterval):
+		}
+	}
+}
+
+func (ps *PubSub) connect(i *integration) (provider.Connection, error) {
+	conn, err := i.provider.OpenConnection(i.ctx, i.pb)
+	if err != nil {
+		return nil, err
+	}
+	uid := unique.ID(i.ctx, i.pb.ApplicationIdentifiers)
+	for _, topic := range [][]string{
+		topics.Default.DownlinkPushTopic(uid, provider.Wildcard),
+		topics.Default.DownlinkReplaceTopic(uid, provider.Wildcard),
+	} {
+		if err := conn.Subscribe(i.ctx, topic, func(topic []string, payload []byte) {
+			if err := ps.handleDown(i, topic, payload); err != nil {
+				log.FromContext(i.ctx).WithError(err).Warn("Failed to handle downlink message")
+			}
+		}); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+func (ps *PubSub) handleDown(i *integration, topic []string, payload []byte) error {
+	var (
+		op       func(io.Server, context.Context, ttnpb.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink) error
+		deviceID string
+	)
+	switch {
+	case topics.Default.IsDownlinkPushTopic(topic):
+		op, deviceID = io.Server.DownlinkQueuePush, topics.Default.ParseDownlinkPushTopic(topic)
+	case topics.Default.IsDownlinkReplaceTopic(topic):
+		op, deviceID = io.Server.DownlinkQueueReplace, topics.Default.ParseDownlinkReplaceTopic(topic)
+	default:
+		return nil
+	}
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: i.pb.ApplicationIdentifiers,
+		DeviceID:               deviceID,
+	}
+	if err := ids.ValidateContext(i.ctx); err != nil {
+		return err
+	}
+	items, err := i.format.ToDownlinks(payload)
+	if err != nil {
+		return err
+	}
+	log.FromContext(i.ctx).WithField("device_id", deviceID).Debug("Performing downlink queue operation")
+	return op(ps.server, i.ctx, ids, items.Downlinks)
+}
+
+// NewSubscription returns a new pub/sub integration subscription.
+func (ps *PubSub) NewSubscription() *io.Subscription {
+	sub := io.NewSubscription(ps.ctx, "pubsub", nil)
+	go func() {
+		for {
+			select {
+			case <-ps.ctx.Done():
+				return
+			case msg := <-sub.Up():
+				ps.handleUp(ps.ctx, msg)
+			}
+		}
+	}()
+	return sub
+}
+
+func upTopic(uid, deviceID string, msg *ttnpb.ApplicationUp) []string {
+	switch msg.Up.(type) {
+	case *ttnpb.ApplicationUp_UplinkMessage:
+		return topics.Default.UplinkTopic(uid, deviceID)
+	case *ttnpb.ApplicationUp_JoinAccept:
+		return topics.Default.JoinAcceptTopic(uid, deviceID)
+	case *ttnpb.ApplicationUp_DownlinkAck:
+		return topics.Default.DownlinkAckTopic(uid, deviceID)
+	case *ttnpb.ApplicationUp_DownlinkNack:
+		return topics.Default.DownlinkNackTopic(uid, deviceID)
+	case *ttnpb.ApplicationUp_DownlinkSent:
+		return topics.Default.DownlinkSentTopic(uid, deviceID)
+	case *ttnpb.ApplicationUp_DownlinkFailed:
+		return topics.Default.DownlinkFailedTopic(uid, deviceID)
+	case *ttnpb.ApplicationUp_DownlinkQueued:
+		return topics.Default.DownlinkQueuedTopic(uid, deviceID)
+	case *ttnpb.ApplicationUp_LocationSolved:
+		return topics.Default.LocationSolvedTopic(uid, deviceID)
+	default:
+		return nil
+	}
+}
+
+func (ps *PubSub) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) {
+	uid := unique.ID(ctx, msg.ApplicationIdentifiers)
+	topic := upTopic(uid, msg.DeviceID, msg)
+	if topic == nil {
+		return
+	}
+	ps.integrationsMu.RLock()
+	integrations := make([]*integration, 0, len(ps.integrations[uid]))
+	for _, i := range ps.integrations[uid] {
+		integrations = append(integrations, i)
+	}
+	ps.integrationsMu.RUnlock()
+	for _, i := range integrations {
+		logger := log.FromContext(i.ctx)
+		conn := i.connection()
+		if conn == nil {
+			logger.Debug("Not connected, drop message")
+			continue
+		}
+		buf, err := i.format.FromUp(msg)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to format message")
+			continue
+		}
+		if err := conn.Publish(i.ctx, topic, buf); err != nil {
+			logger.WithError(err).Warn("Failed to publish message")
+		}
+	}
+}
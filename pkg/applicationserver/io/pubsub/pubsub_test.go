@@ -0,0 +1,505 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/mock"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+var (
+	registeredApplicationID = ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"}
+	registeredDeviceID      = ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		DeviceID:               "foo-device",
+	}
+
+	timeout = (1 << 6) * test.Delay
+)
+
+type message struct {
+	topic   []string
+	payload []byte
+}
+
+type mockConnection struct {
+	pb          *ttnpb.ApplicationPubSub
+	publishedCh chan message
+	done        chan struct{}
+	closeOnce   sync.Once
+
+	handlersMu sync.Mutex
+	handlers   map[string]provider.MessageHandler
+}
+
+func (c *mockConnection) Publish(ctx context.Context, topic []string, payload []byte) error {
+	c.publishedCh <- message{topic: topic, payload: payload}
+	return nil
+}
+
+func (c *mockConnection) Subscribe(ctx context.Context, topic []string, handler provider.MessageHandler) error {
+	c.handlersMu.Lock()
+	c.handlers[fmt.Sprint(topic)] = handler
+	c.handlersMu.Unlock()
+	return nil
+}
+
+func (c *mockConnection) handler(topic []string) provider.MessageHandler {
+	c.handlersMu.Lock()
+	defer c.handlersMu.Unlock()
+	return c.handlers[fmt.Sprint(topic)]
+}
+
+func (c *mockConnection) Done() <-chan struct{} { return c.done }
+
+func (c *mockConnection) Close() error {
+	c.closeOnce.Do(func() { close(c.done) })
+	return nil
+}
+
+type mockProvider struct {
+	connCh chan *mockConnection
+}
+
+func (p *mockProvider) OpenConnection(ctx context.Context, pb *ttnpb.ApplicationPubSub) (provider.Connection, error) {
+	conn := &mockConnection{
+		pb:          pb,
+		publishedCh: make(chan message, 10),
+		done:        make(chan struct{}),
+		handlers:    make(map[string]provider.MessageHandler),
+	}
+	p.connCh <- conn
+	return conn, nil
+}
+
+var testProvider = &mockProvider{
+	connCh: make(chan *mockConnection, 10),
+}
+
+func init() {
+	provider.RegisterProvider(&ttnpb.ApplicationPubSub_MQTT{}, testProvider)
+}
+
+var errNotFound = errors.DefineNotFound("not_found", "not found")
+
+// memRegistry is an in-memory pub/sub registry.
+type memRegistry struct {
+	mu      sync.Mutex
+	pubsubs map[string]*ttnpb.ApplicationPubSub
+}
+
+func (r *memRegistry) key(ctx context.Context, ids ttnpb.ApplicationPubSubIdentifiers) string {
+	return fmt.Sprintf("%s.%s", unique.ID(ctx, ids.ApplicationIdentifiers), ids.PubSubID)
+}
+
+func (r *memRegistry) Get(ctx context.Context, ids ttnpb.ApplicationPubSubIdentifiers, paths []string) (*ttnpb.ApplicationPubSub, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.pubsubs[r.key(ctx, ids)]
+	if !ok {
+		return nil, errNotFound
+	}
+	pb := &ttnpb.ApplicationPubSub{}
+	return pb, pb.SetFields(stored, append(paths, "ids")...)
+}
+
+func (r *memRegistry) Range(ctx context.Context, paths []string, f func(context.Context, ttnpb.ApplicationIdentifiers, *ttnpb.ApplicationPubSub) bool) error {
+	r.mu.Lock()
+	var pbs []*ttnpb.ApplicationPubSub
+	for _, stored := range r.pubsubs {
+		pb := &ttnpb.ApplicationPubSub{}
+		if err := pb.SetFields(stored, append(paths, "ids")...); err != nil {
+			r.mu.Unlock()
+			return err
+		}
+		pbs = append(pbs, pb)
+	}
+	r.mu.Unlock()
+	for _, pb := range pbs {
+		if !f(ctx, pb.ApplicationIdentifiers, pb) {
+			break
+		}
+	}
+	return nil
+}
+
+func (r *memRegistry) List(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string) ([]*ttnpb.ApplicationPubSub, error) {
+	var pbs []*ttnpb.ApplicationPubSub
+	err := r.Range(ctx, paths, func(_ context.Context, appIDs ttnpb.ApplicationIdentifiers, pb *ttnpb.ApplicationPubSub) bool {
+		if appIDs == ids {
+			pbs = append(pbs, pb)
+		}
+		return true
+	})
+	return pbs, err
+}
+
+func (r *memRegistry) Set(ctx context.Context, ids ttnpb.ApplicationPubSubIdentifiers, paths []string, f func(*ttnpb.ApplicationPubSub) (*ttnpb.ApplicationPubSub, []string, error)) (*ttnpb.ApplicationPubSub, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	k := r.key(ctx, ids)
+	stored := r.pubsubs[k]
+	pb, sets, err := f(stored)
+	if err != nil {
+		return nil, err
+	}
+	if pb == nil {
+		delete(r.pubsubs, k)
+		return nil, nil
+	}
+	if stored == nil {
+		stored = &ttnpb.ApplicationPubSub{}
+	}
+	if err := stored.SetFields(pb, sets...); err != nil {
+		return nil, err
+	}
+	stored.ApplicationPubSubIdentifiers = ids
+	r.pubsubs[k] = stored
+	return stored, nil
+}
+
+func TestPubSub(t *testing.T) {
+	a := assertions.New(t)
+	ctx, cancelCtx := context.WithCancel(log.NewContext(test.Context(), test.GetLogger(t)))
+	defer cancelCtx()
+
+	pubsub.RetryInterval = test.Delay
+
+	registry := &memRegistry{
+		pubsubs: make(map[string]*ttnpb.ApplicationPubSub),
+	}
+	fooIDs := ttnpb.ApplicationPubSubIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		PubSubID:               "foo-pubsub",
+	}
+	registry.Set(ctx, fooIDs, nil, func(_ *ttnpb.ApplicationPubSub) (*ttnpb.ApplicationPubSub, []string, error) {
+		return &ttnpb.ApplicationPubSub{
+			Format:    "json",
+			BaseTopic: "foo/bar",
+			Provider: &ttnpb.ApplicationPubSub_MQTT{
+				MQTT: &ttnpb.ApplicationPubSub_MQTTProvider{
+					ServerURL: "tcp://localhost:1883",
+				},
+			},
+		}, []string{"format", "base_topic", "provider"}, nil
+	})
+
+	// A pub/sub that fails to start does not prevent the other integrations from starting.
+	brokenIDs := ttnpb.ApplicationPubSubIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "broken-app"},
+		PubSubID:               "broken-pubsub",
+	}
+	registry.Set(ctx, brokenIDs, nil, func(_ *ttnpb.ApplicationPubSub) (*ttnpb.ApplicationPubSub, []string, error) {
+		return &ttnpb.ApplicationPubSub{
+			Format: "unknown",
+			Provider: &ttnpb.ApplicationPubSub_MQTT{
+				MQTT: &ttnpb.ApplicationPubSub_MQTTProvider{
+					ServerURL: "tcp://localhost:1883",
+				},
+			},
+		}, []string{"format", "provider"}, nil
+	})
+
+	server := mock.NewServer()
+	ps, err := pubsub.New(ctx, server, registry)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	sub := ps.NewSubscription()
+
+	expectConnection := func(t *testing.T) *mockConnection {
+		select {
+		case conn := <-testProvider.connCh:
+			return conn
+		case <-time.After(timeout):
+			t.Fatal("Expected connection")
+			return nil
+		}
+	}
+
+	var conn *mockConnection
+	t.Run("Connect", func(t *testing.T) {
+		a := assertions.New(t)
+		conn = expectConnection(t)
+		a.So(conn.pb.PubSubID, should.Equal, "foo-pubsub")
+		a.So(conn.pb.BaseTopic, should.Equal, "foo/bar")
+	})
+	if conn == nil {
+		t.FailNow()
+	}
+
+	t.Run("Retry", func(t *testing.T) {
+		a := assertions.New(t)
+		select {
+		case conn := <-testProvider.connCh:
+			t.Fatalf("Expected no connection, got %s", conn.pb.PubSubID)
+		case <-time.After(timeout):
+		}
+		registry.Set(ctx, brokenIDs, nil, func(_ *ttnpb.ApplicationPubSub) (*ttnpb.ApplicationPubSub, []string, error) {
+			return &ttnpb.ApplicationPubSub{
+				Format: "json",
+			}, []string{"format"}, nil
+		})
+		brokenConn := expectConnection(t)
+		a.So(brokenConn.pb.PubSubID, should.Equal, "broken-pubsub")
+	})
+
+	t.Run("Upstream", func(t *testing.T) {
+		for _, tc := range []struct {
+			Name  string
+			Up    *ttnpb.ApplicationUp
+			Topic []string
+		}{
+			{
+				Name: "UplinkMessage",
+				Up: &ttnpb.ApplicationUp{
+					EndDeviceIdentifiers: registeredDeviceID,
+					Up: &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &ttnpb.ApplicationUplink{
+						FPort:      42,
+						FRMPayload: []byte{0x1, 0x2, 0x3},
+					}},
+				},
+				Topic: []string{"v3", "foo-app", "devices", "foo-device", "up"},
+			},
+			{
+				Name: "JoinAccept",
+				Up: &ttnpb.ApplicationUp{
+					EndDeviceIdentifiers: registeredDeviceID,
+					Up: &ttnpb.ApplicationUp_JoinAccept{JoinAccept: &ttnpb.ApplicationJoinAccept{
+						SessionKeyID: []byte{0x22},
+					}},
+				},
+				Topic: []string{"v3", "foo-app", "devices", "foo-device", "join"},
+			},
+			{
+				Name: "DownlinkQueued",
+				Up: &ttnpb.ApplicationUp{
+					EndDeviceIdentifiers: registeredDeviceID,
+					Up: &ttnpb.ApplicationUp_DownlinkQueued{DownlinkQueued: &ttnpb.ApplicationDownlink{
+						FPort: 42,
+					}},
+				},
+				Topic: []string{"v3", "foo-app", "devices", "foo-device", "down", "queued"},
+			},
+		} {
+			t.Run(tc.Name, func(t *testing.T) {
+				a := assertions.New(t)
+				if !a.So(sub.SendUp(tc.Up), should.BeNil) {
+					t.FailNow()
+				}
+				select {
+				case msg := <-conn.publishedCh:
+					a.So(msg.topic, should.Resemble, tc.Topic)
+					a.So(msg.payload, should.NotBeEmpty)
+				case <-time.After(timeout):
+					t.Fatal("Expected published message")
+				}
+			})
+		}
+	})
+
+	t.Run("OtherApplication", func(t *testing.T) {
+		a := assertions.New(t)
+		a.So(sub.SendUp(&ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "bar-app"},
+				DeviceID:               "bar-device",
+			},
+			Up: &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &ttnpb.ApplicationUplink{}},
+		}), should.BeNil)
+		select {
+		case <-conn.publishedCh:
+			t.Fatal("Expected no published message")
+		case <-time.After(timeout):
+		}
+	})
+
+	t.Run("Downstream", func(t *testing.T) {
+		a := assertions.New(t)
+		push := conn.handler([]string{"v3", "foo-app", "devices", provider.Wildcard, "down", "push"})
+		replace := conn.handler([]string{"v3", "foo-app", "devices", provider.Wildcard, "down", "replace"})
+		if !a.So(push, should.NotBeNil) || !a.So(replace, should.NotBeNil) {
+			t.FailNow()
+		}
+		push([]string{"v3", "foo-app", "devices", "foo-device", "down", "push"}, []byte(`{"downlinks":[{"f_port":42,"frm_payload":"AQID"}]}`))
+		push([]string{"v3", "foo-app", "devices", "foo-device", "down", "push"}, []byte(`{"downlinks":[{"f_port":43,"frm_payload":"BAU="}]}`))
+		res, err := server.DownlinkQueueList(ctx, registeredDeviceID)
+		a.So(err, should.BeNil)
+		a.So(res, should.Resemble, []*ttnpb.ApplicationDownlink{
+			{FPort: 42, FRMPayload: []byte{0x1, 0x2, 0x3}},
+			{FPort: 43, FRMPayload: []byte{0x4, 0x5}},
+		})
+
+		replace([]string{"v3", "foo-app", "devices", "foo-device", "down", "replace"}, []byte(`{"downlinks":[{"f_port":44,"frm_payload":"Bg=="}]}`))
+		res, err = server.DownlinkQueueList(ctx, registeredDeviceID)
+		a.So(err, should.BeNil)
+		a.So(res, should.Resemble, []*ttnpb.ApplicationDownlink{
+			{FPort: 44, FRMPayload: []byte{0x6}},
+		})
+
+		// Invalid device identifiers and payloads are ignored.
+		push([]string{"v3", "foo-app", "devices", "+", "down", "push"}, []byte(`{"downlinks":[{"f_port":45}]}`))
+		push([]string{"v3", "foo-app", "devices", "foo-device", "down", "push"}, []byte(`invalid`))
+		res, err = server.DownlinkQueueList(ctx, registeredDeviceID)
+		a.So(err, should.BeNil)
+		a.So(res, should.HaveLength, 1)
+	})
+
+	t.Run("Reconnect", func(t *testing.T) {
+		a := assertions.New(t)
+		conn.Close()
+		conn = expectConnection(t)
+		a.So(conn.pb.PubSubID, should.Equal, "foo-pubsub")
+	})
+
+	t.Run("RegistryRPC", func(t *testing.T) {
+		a := assertions.New(t)
+		rpc := pubsub.NewPubSubRegistryRPC(ps)
+		barIDs := ttnpb.ApplicationPubSubIdentifiers{
+			ApplicationIdentifiers: registeredApplicationID,
+			PubSubID:               "bar-pubsub",
+		}
+
+		// Without rights.
+		_, err := rpc.Set(rights.NewContext(ctx, rights.Rights{}), &ttnpb.SetApplicationPubSubRequest{
+			ApplicationPubSub: ttnpb.ApplicationPubSub{
+				ApplicationPubSubIdentifiers: barIDs,
+			},
+		})
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+		rightsCtx := rights.NewContext(ctx, rights.Rights{
+			ApplicationRights: map[string]*ttnpb.Rights{
+				unique.ID(ctx, registeredApplicationID): ttnpb.RightsFrom(
+					ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+					ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+				),
+			},
+		})
+
+		formats, err := rpc.GetFormats(rightsCtx, ttnpb.Empty)
+		a.So(err, should.BeNil)
+		a.So(formats.Formats, should.ContainKey, "json")
+		a.So(formats.Formats, should.ContainKey, "protobuf")
+
+		// Unknown format.
+		_, err = rpc.Set(rightsCtx, &ttnpb.SetApplicationPubSubRequest{
+			ApplicationPubSub: ttnpb.ApplicationPubSub{
+				ApplicationPubSubIdentifiers: barIDs,
+				Format:                       "unknown",
+			},
+			FieldMask: pbtypes.FieldMask{Paths: []string{"format"}},
+		})
+		a.So(errors.IsNotFound(err), should.BeTrue)
+
+		// Unknown provider.
+		_, err = rpc.Set(rightsCtx, &ttnpb.SetApplicationPubSubRequest{
+			ApplicationPubSub: ttnpb.ApplicationPubSub{
+				ApplicationPubSubIdentifiers: barIDs,
+				Format:                       "json",
+				Provider: &ttnpb.ApplicationPubSub_NATS{
+					NATS: &ttnpb.ApplicationPubSub_NATSProvider{},
+				},
+			},
+			FieldMask: pbtypes.FieldMask{Paths: []string{"format", "provider"}},
+		})
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+		// The stored pub/sub is validated even if the format and provider are not in the field mask.
+		_, err = rpc.Set(rightsCtx, &ttnpb.SetApplicationPubSubRequest{
+			ApplicationPubSub: ttnpb.ApplicationPubSub{
+				ApplicationPubSubIdentifiers: barIDs,
+				BaseTopic:                    "bar",
+			},
+			FieldMask: pbtypes.FieldMask{Paths: []string{"base_topic"}},
+		})
+		a.So(errors.IsNotFound(err), should.BeTrue)
+		_, err = rpc.Get(rightsCtx, &ttnpb.GetApplicationPubSubRequest{
+			ApplicationPubSubIdentifiers: barIDs,
+		})
+		a.So(errors.IsNotFound(err), should.BeTrue)
+
+		_, err = rpc.Set(rightsCtx, &ttnpb.SetApplicationPubSubRequest{
+			ApplicationPubSub: ttnpb.ApplicationPubSub{
+				ApplicationPubSubIdentifiers: barIDs,
+				Format:                       "protobuf",
+				Provider: &ttnpb.ApplicationPubSub_MQTT{
+					MQTT: &ttnpb.ApplicationPubSub_MQTTProvider{
+						ServerURL: "tcp://localhost:1883",
+					},
+				},
+			},
+			FieldMask: pbtypes.FieldMask{Paths: []string{"format", "provider"}},
+		})
+		a.So(err, should.BeNil)
+		barConn := expectConnection(t)
+		a.So(barConn.pb.PubSubID, should.Equal, "bar-pubsub")
+
+		list, err := rpc.List(rightsCtx, &ttnpb.ListApplicationPubSubsRequest{
+			ApplicationIdentifiers: registeredApplicationID,
+			FieldMask:              pbtypes.FieldMask{Paths: []string{"format"}},
+		})
+		a.So(err, should.BeNil)
+		a.So(list.Pubsubs, should.HaveLength, 2)
+
+		// Both integrations publish upstream messages.
+		a.So(sub.SendUp(&ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: registeredDeviceID,
+			Up:                   &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &ttnpb.ApplicationUplink{}},
+		}), should.BeNil)
+		for _, c := range []*mockConnection{conn, barConn} {
+			select {
+			case <-c.publishedCh:
+			case <-time.After(timeout):
+				t.Fatalf("Expected published message on %s", c.pb.PubSubID)
+			}
+		}
+
+		_, err = rpc.Delete(rightsCtx, &barIDs)
+		a.So(err, should.BeNil)
+		select {
+		case <-barConn.Done():
+		case <-time.After(timeout):
+			t.Fatal("Expected connection to be closed")
+		}
+		_, err = rpc.Get(rightsCtx, &ttnpb.GetApplicationPubSubRequest{
+			ApplicationPubSubIdentifiers: barIDs,
+		})
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	})
+
+	cancelCtx()
+	select {
+	case <-conn.Done():
+	case <-time.After(timeout):
+		t.Fatal("Expected connection to be closed")
+	}
+}
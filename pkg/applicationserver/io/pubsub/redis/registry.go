@@ -0,0 +1,192 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+const (
+	allKey    = "all"
+	pubsubKey = "pubsub"
+)
+
+func applyPubSubFieldMask(dst, src *ttnpb.ApplicationPubSub, paths ...string) (*ttnpb.ApplicationPubSub, error) {
+	if dst == nil {
+		dst = &ttnpb.ApplicationPubSub{}
+	}
+	return dst, dst.SetFields(src, append(paths, "ids")...)
+}
+
+// PubSubRegistry is a Redis pub/sub registry.
+type PubSubRegistry struct {
+	Redis *ttnredis.Client
+}
+
+// Get implements pubsub.Registry.
+func (r PubSubRegistry) Get(ctx context.Context, ids ttnpb.ApplicationPubSubIdentifiers, paths []string) (*ttnpb.ApplicationPubSub, error) {
+	k := r.Redis.Key(pubsubKey, unique.ID(ctx, ids.ApplicationIdentifiers), ids.PubSubID)
+	pb := &ttnpb.ApplicationPubSub{}
+	if err := ttnredis.GetProto(r.Redis, k).ScanProto(pb); err != nil {
+		return nil, err
+	}
+	return applyPubSubFieldMask(nil, pb, paths...)
+}
+
+var errApplicationUID = errors.DefineCorruption("application_uid", "invalid application UID `{application_uid}`")
+
+// Range implements pubsub.Registry.
+func (r PubSubRegistry) Range(ctx context.Context, paths []string, f func(context.Context, ttnpb.ApplicationIdentifiers, *ttnpb.ApplicationPubSub) bool) error {
+	uids, err := r.Redis.SMembers(r.Redis.Key(allKey)).Result()
+	if err != nil {
+		return err
+	}
+	for _, uid := range uids {
+		ctx, err := unique.WithContext(ctx, uid)
+		if err != nil {
+			return errApplicationUID.WithCause(err).WithAttributes("application_uid", uid)
+		}
+		ids, err := unique.ToApplicationID(uid)
+		if err != nil {
+			return errApplicationUID.WithCause(err).WithAttributes("application_uid", uid)
+		}
+		pbs, err := r.List(ctx, ids, paths)
+		if err != nil {
+			return err
+		}
+		for _, pb := range pbs {
+			if !f(ctx, ids, pb) {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// List implements pubsub.Registry.
+func (r PubSubRegistry) List(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string) ([]*ttnpb.ApplicationPubSub, error) {
+	var pbs []*ttnpb.ApplicationPubSub
+	k := r.Redis.Key(pubsubKey, unique.ID(ctx, ids))
+	keyCmd := func(ks ...string) string {
+		return r.Redis.Key(append([]string{pubsubKey, unique.ID(ctx, ids)}, ks...)...)
+	}
+	err := ttnredis.FindProtos(r.Redis, k, keyCmd).Range(func() (proto.Message, func() (bool, error)) {
+		pb := &ttnpb.ApplicationPubSub{}
+		return pb, func() (bool, error) {
+			pb, err := applyPubSubFieldMask(nil, pb, paths...)
+			if err != nil {
+				return false, err
+			}
+			pbs = append(pbs, pb)
+			return true, nil
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pbs, nil
+}
+
+// Set implements pubsub.Registry.
+func (r PubSubRegistry) Set(ctx context.Context, ids ttnpb.ApplicationPubSubIdentifiers, gets []string, f func(*ttnpb.ApplicationPubSub) (*ttnpb.ApplicationPubSub, []string, error)) (*ttnpb.ApplicationPubSub, error) {
+	uid := unique.ID(ctx, ids.ApplicationIdentifiers)
+	k := r.Redis.Key(pubsubKey, uid, ids.PubSubID)
+	appKey := r.Redis.Key(pubsubKey, uid)
+	var pb *ttnpb.ApplicationPubSub
+	err := r.Redis.Watch(func(tx *redis.Tx) error {
+		var create bool
+		cmd := ttnredis.GetProto(tx, k)
+		stored := &ttnpb.ApplicationPubSub{}
+		if err := cmd.ScanProto(stored); errors.IsNotFound(err) {
+			create = true
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+
+		var err error
+		if stored != nil {
+			pb, err = applyPubSubFieldMask(nil, stored, gets...)
+			if err != nil {
+				return err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(pb)
+		if err != nil {
+			return err
+		}
+
+		var f func(redis.Pipeliner) error
+		if pb == nil {
+			members, err := tx.SMembers(appKey).Result()
+			if err != nil {
+				return err
+			}
+			f = func(p redis.Pipeliner) error {
+				p.Del(k)
+				p.SRem(appKey, ids.PubSubID)
+				if len(members) == 0 || len(members) == 1 && members[0] == ids.PubSubID {
+					p.SRem(r.Redis.Key(allKey), uid)
+				}
+				return nil
+			}
+		} else {
+			pb.ApplicationPubSubIdentifiers = ids
+			pb.UpdatedAt = time.Now().UTC()
+			sets = append(sets, "updated_at")
+			if create {
+				pb.CreatedAt = pb.UpdatedAt
+				sets = append(sets, "created_at")
+			}
+			stored = &ttnpb.ApplicationPubSub{}
+			if err := cmd.ScanProto(stored); err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+			stored, err = applyPubSubFieldMask(stored, pb, sets...)
+			if err != nil {
+				return err
+			}
+			pb, err = applyPubSubFieldMask(nil, stored, gets...)
+			if err != nil {
+				return err
+			}
+			f = func(p redis.Pipeliner) error {
+				_, err := ttnredis.SetProto(p, k, stored, 0)
+				if err != nil {
+					return err
+				}
+				p.SAdd(appKey, ids.PubSubID)
+				p.SAdd(r.Redis.Key(allKey), uid)
+				return nil
+			}
+		}
+		_, err = tx.Pipelined(f)
+		return err
+	}, k, appKey)
+	if err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
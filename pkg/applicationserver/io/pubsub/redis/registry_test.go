@@ -0,0 +1,101 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/redis"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestPubSubRegistry(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	cl, flush := test.NewRedis(t, "applicationserver_test")
+	defer flush()
+	defer cl.Close()
+	registry := &redis.PubSubRegistry{
+		Redis: cl,
+	}
+
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"}
+	ids := []ttnpb.ApplicationPubSubIdentifiers{
+		{ApplicationIdentifiers: appIDs, PubSubID: "foo-pubsub"},
+		{ApplicationIdentifiers: appIDs, PubSubID: "bar-pubsub"},
+	}
+	paths := []string{"format", "base_topic", "provider"}
+
+	for _, id := range ids {
+		pb, err := registry.Set(ctx, id, paths, func(pb *ttnpb.ApplicationPubSub) (*ttnpb.ApplicationPubSub, []string, error) {
+			a.So(pb, should.BeNil)
+			return &ttnpb.ApplicationPubSub{
+				Format:    "json",
+				BaseTopic: "foo",
+				Provider: &ttnpb.ApplicationPubSub_NATS{
+					NATS: &ttnpb.ApplicationPubSub_NATSProvider{
+						ServerURL: "nats://localhost",
+					},
+				},
+			}, paths, nil
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(pb.ApplicationPubSubIdentifiers, should.Resemble, id)
+		a.So(pb.Format, should.Equal, "json")
+	}
+
+	pb, err := registry.Get(ctx, ids[0], paths)
+	a.So(err, should.BeNil)
+	a.So(pb.BaseTopic, should.Equal, "foo")
+	a.So(pb.GetNATS().GetServerURL(), should.Equal, "nats://localhost")
+
+	pbs, err := registry.List(ctx, appIDs, paths)
+	a.So(err, should.BeNil)
+	a.So(pbs, should.HaveLength, 2)
+
+	var ranged []string
+	err = registry.Range(ctx, paths, func(_ context.Context, appIDs ttnpb.ApplicationIdentifiers, pb *ttnpb.ApplicationPubSub) bool {
+		a.So(pb.ApplicationIdentifiers, should.Resemble, appIDs)
+		ranged = append(ranged, pb.PubSubID)
+		return true
+	})
+	a.So(err, should.BeNil)
+	a.So(ranged, should.HaveLength, 2)
+
+	for _, id := range ids {
+		_, err := registry.Set(ctx, id, nil, func(pb *ttnpb.ApplicationPubSub) (*ttnpb.ApplicationPubSub, []string, error) {
+			return nil, nil, nil
+		})
+		a.So(err, should.BeNil)
+	}
+	_, err = registry.Get(ctx, ids[0], paths)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	ranged = nil
+	err = registry.Range(ctx, paths, func(_ context.Context, _ ttnpb.ApplicationIdentifiers, pb *ttnpb.ApplicationPubSub) bool {
+		ranged = append(ranged, pb.PubSubID)
+		return true
+	})
+	a.So(err, should.BeNil)
+	a.So(ranged, should.BeEmpty)
+}
@@ -0,0 +1,75 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+const (
+	// DefaultLimit is the maximum number of stored upstream messages that are returned if the request has no limit.
+	DefaultLimit = 100
+	// MaxLimit is the maximum number of stored upstream messages that are returned in a single request.
+	MaxLimit = 1000
+)
+
+type applicationUpStorageRPC struct {
+	storage Storage
+}
+
+// NewApplicationUpStorageRPC returns a new gRPC server to retrieve stored upstream messages.
+func NewApplicationUpStorageRPC(storage Storage) ttnpb.ApplicationUpStorageServer {
+	return &applicationUpStorageRPC{
+		storage: storage,
+	}
+}
+
+func (s applicationUpStorageRPC) GetStoredApplicationUp(ctx context.Context, req *ttnpb.GetStoredApplicationUpRequest) (*ttnpb.ApplicationUps, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	var after, before time.Time
+	if req.After != nil {
+		after = *req.After
+	}
+	if req.Before != nil {
+		before = *req.Before
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = DefaultLimit
+	} else if limit > MaxLimit {
+		limit = MaxLimit
+	}
+	res := &ttnpb.ApplicationUps{}
+	err := s.storage.Range(ctx, req.ApplicationIdentifiers, req.DeviceID, after, before, func(up *ttnpb.ApplicationUp) bool {
+		if req.Type != "" && MessageType(up) != req.Type {
+			return true
+		}
+		if req.FPort != 0 && up.GetUplinkMessage().GetFPort() != req.FPort {
+			return true
+		}
+		res.Ups = append(res.Ups, up)
+		return uint32(len(res.Ups)) < limit
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
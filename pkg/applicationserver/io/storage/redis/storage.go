@@ -0,0 +1,128 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis implements the storage of the storage integration in Redis.
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+const upKey = "up"
+
+// Storage is a Redis storage of upstream messages.
+// The messages are stored in sorted sets per application and per end device, scored by the receive time.
+type Storage struct {
+	Redis *ttnredis.Client
+	// Retention is the duration for which messages are stored. If zero, messages are stored indefinitely.
+	Retention time.Duration
+}
+
+func (s Storage) applicationKey(ctx context.Context, ids ttnpb.ApplicationIdentifiers) string {
+	return s.Redis.Key(upKey, unique.ID(ctx, ids))
+}
+
+func (s Storage) deviceKey(ctx context.Context, ids ttnpb.ApplicationIdentifiers, deviceID string) string {
+	return s.Redis.Key(upKey, unique.ID(ctx, ids), deviceID)
+}
+
+func score(t time.Time) float64 {
+	return float64(t.UnixNano())
+}
+
+// Store implements storage.Storage.
+func (s Storage) Store(ctx context.Context, up *ttnpb.ApplicationUp) error {
+	receivedAt := time.Now().UTC()
+	if up.ReceivedAt != nil {
+		receivedAt = *up.ReceivedAt
+	} else {
+		up.ReceivedAt = &receivedAt
+	}
+	v, err := ttnredis.MarshalProto(up)
+	if err != nil {
+		return err
+	}
+	ks := []string{
+		s.applicationKey(ctx, up.ApplicationIdentifiers),
+		s.deviceKey(ctx, up.ApplicationIdentifiers, up.DeviceID),
+	}
+	_, err = s.Redis.Pipelined(func(p redis.Pipeliner) error {
+		for _, k := range ks {
+			p.ZAdd(k, redis.Z{
+				Score:  score(receivedAt),
+				Member: v,
+			})
+			if s.Retention > 0 {
+				p.ZRemRangeByScore(k, "-inf", "("+strconv.FormatFloat(score(time.Now().Add(-s.Retention)), 'f', -1, 64))
+				p.Expire(k, s.Retention)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Range implements storage.Storage.
+func (s Storage) Range(ctx context.Context, ids ttnpb.ApplicationIdentifiers, deviceID string, after, before time.Time, f func(*ttnpb.ApplicationUp) bool) error {
+	k := s.applicationKey(ctx, ids)
+	if deviceID != "" {
+		k = s.deviceKey(ctx, ids, deviceID)
+	}
+	min, max := "-inf", "+inf"
+	if s.Retention > 0 {
+		if retained := time.Now().Add(-s.Retention); after.IsZero() || after.Before(retained) {
+			after = retained
+		}
+	}
+	if !after.IsZero() {
+		min = "(" + strconv.FormatFloat(score(after), 'f', -1, 64)
+	}
+	if !before.IsZero() {
+		max = "(" + strconv.FormatFloat(score(before), 'f', -1, 64)
+	}
+	const batchSize = 100
+	for offset := int64(0); ; offset += batchSize {
+		vs, err := s.Redis.ZRangeByScore(k, redis.ZRangeBy{
+			Min:    min,
+			Max:    max,
+			Offset: offset,
+			Count:  batchSize,
+		}).Result()
+		if err != nil {
+			return ttnredis.ConvertError(err)
+		}
+		for _, v := range vs {
+			up := &ttnpb.ApplicationUp{}
+			if err := ttnredis.UnmarshalProto(v, up); err != nil {
+				return err
+			}
+			if !f(up) {
+				return nil
+			}
+		}
+		if len(vs) < batchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/storage/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestStorage(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	cl, flush := test.NewRedis(t, "applicationserver_test")
+	defer flush()
+	defer cl.Close()
+	st := &redis.Storage{
+		Redis:     cl,
+		Retention: time.Hour,
+	}
+
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"}
+	now := time.Now().UTC()
+	var ups []*ttnpb.ApplicationUp
+	for i, tc := range []struct {
+		DeviceID   string
+		ReceivedAt time.Time
+	}{
+		{DeviceID: "foo-device-1", ReceivedAt: now.Add(-2 * time.Hour)},
+		{DeviceID: "foo-device-1", ReceivedAt: now.Add(-3 * time.Minute)},
+		{DeviceID: "foo-device-2", ReceivedAt: now.Add(-2 * time.Minute)},
+		{DeviceID: "foo-device-1", ReceivedAt: now.Add(-1 * time.Minute)},
+	} {
+		receivedAt := tc.ReceivedAt
+		up := &ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: appIDs,
+				DeviceID:               tc.DeviceID,
+			},
+			Up: &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &ttnpb.ApplicationUplink{
+				FPort:      uint32(i + 1),
+				FRMPayload: []byte{byte(i)},
+			}},
+			ReceivedAt: &receivedAt,
+		}
+		if !a.So(st.Store(ctx, up), should.BeNil) {
+			t.FailNow()
+		}
+		ups = append(ups, up)
+	}
+
+	rangeAll := func(deviceID string, after, before time.Time) []*ttnpb.ApplicationUp {
+		var res []*ttnpb.ApplicationUp
+		err := st.Range(ctx, appIDs, deviceID, after, before, func(up *ttnpb.ApplicationUp) bool {
+			res = append(res, up)
+			return true
+		})
+		a.So(err, should.BeNil)
+		return res
+	}
+
+	// The first message is beyond retention.
+	a.So(rangeAll("", time.Time{}, time.Time{}), should.HaveEmptyDiff, ups[1:])
+	a.So(rangeAll("foo-device-1", time.Time{}, time.Time{}), should.HaveEmptyDiff, []*ttnpb.ApplicationUp{ups[1], ups[3]})
+	a.So(rangeAll("foo-device-2", time.Time{}, time.Time{}), should.HaveEmptyDiff, []*ttnpb.ApplicationUp{ups[2]})
+	a.So(rangeAll("", now.Add(-150*time.Second), now.Add(-90*time.Second)), should.HaveEmptyDiff, []*ttnpb.ApplicationUp{ups[2]})
+	a.So(rangeAll("foo-device-3", time.Time{}, time.Time{}), should.BeEmpty)
+
+	var n int
+	err := st.Range(ctx, appIDs, "", time.Time{}, time.Time{}, func(*ttnpb.ApplicationUp) bool {
+		n++
+		return false
+	})
+	a.So(err, should.BeNil)
+	a.So(n, should.Equal, 1)
+}
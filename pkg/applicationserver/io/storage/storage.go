@@ -0,0 +1,102 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package storage implements the storage integration of the Application Server. The storage integration persists
+// upstream messages, so that clients can retrieve messages that were sent while they were not connected.
+package storage
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// Storage is a store for upstream messages.
+type Storage interface {
+	// Store stores the upstream message. The caller sets ReceivedAt of the message.
+	Store(ctx context.Context, up *ttnpb.ApplicationUp) error
+	// Range calls f for the stored upstream messages of the application in chronological order, until f returns false.
+	// If deviceID is not empty, only messages of that end device are considered.
+	// If after or before are not zero, only messages received after or before the given times are considered.
+	Range(ctx context.Context, ids ttnpb.ApplicationIdentifiers, deviceID string, after, before time.Time, f func(*ttnpb.ApplicationUp) bool) error
+}
+
+// MessageType returns the type of the upstream message, as used in GetStoredApplicationUpRequest.
+func MessageType(up *ttnpb.ApplicationUp) string {
+	switch up.Up.(type) {
+	case *ttnpb.ApplicationUp_UplinkMessage:
+		return "uplink_message"
+	case *ttnpb.ApplicationUp_JoinAccept:
+		return "join_accept"
+	case *ttnpb.ApplicationUp_DownlinkAck:
+		return "downlink_ack"
+	case *ttnpb.ApplicationUp_DownlinkNack:
+		return "downlink_nack"
+	case *ttnpb.ApplicationUp_DownlinkSent:
+		return "downlink_sent"
+	case *ttnpb.ApplicationUp_DownlinkFailed:
+		return "downlink_failed"
+	case *ttnpb.ApplicationUp_DownlinkQueued:
+		return "downlink_queued"
+	case *ttnpb.ApplicationUp_DownlinkQueueInvalidated:
+		return "downlink_queue_invalidated"
+	case *ttnpb.ApplicationUp_LocationSolved:
+		return "location_solved"
+	default:
+		return ""
+	}
+}
+
+// Integration is the storage integration.
+type Integration struct {
+	ctx     context.Context
+	storage Storage
+}
+
+// New returns a new storage integration that persists upstream messages to the given storage.
+func New(ctx context.Context, storage Storage) *Integration {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/storage")
+	return &Integration{
+		ctx:     ctx,
+		storage: storage,
+	}
+}
+
+// Storage returns the storage of the integration.
+func (i *Integration) Storage() Storage { return i.storage }
+
+// NewSubscription returns a new storage integration subscription.
+func (i *Integration) NewSubscription() *io.Subscription {
+	sub := io.NewSubscription(i.ctx, "storage", nil)
+	go func() {
+		for {
+			select {
+			case <-i.ctx.Done():
+				return
+			case msg := <-sub.Up():
+				// The message is shared with other subscribers; store a copy with the receive time.
+				up := *msg
+				now := time.Now().UTC()
+				up.ReceivedAt = &now
+				if err := i.storage.Store(i.ctx, &up); err != nil {
+					log.FromContext(i.ctx).WithError(err).Warn("Failed to store message")
+				}
+			}
+		}
+	}()
+	return sub
+}
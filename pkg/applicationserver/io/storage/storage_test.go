@@ -0,0 +1,243 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/storage"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+type memStorage struct {
+	mu  sync.Mutex
+	ups []*ttnpb.ApplicationUp
+}
+
+func (s *memStorage) Store(ctx context.Context, up *ttnpb.ApplicationUp) error {
+	s.mu.Lock()
+	s.ups = append(s.ups, up)
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *memStorage) Range(ctx context.Context, ids ttnpb.ApplicationIdentifiers, deviceID string, after, before time.Time, f func(*ttnpb.ApplicationUp) bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, up := range s.ups {
+		if up.ApplicationIdentifiers != ids || deviceID != "" && up.DeviceID != deviceID {
+			continue
+		}
+		if !after.IsZero() && !up.ReceivedAt.After(after) || !before.IsZero() && !up.ReceivedAt.Before(before) {
+			continue
+		}
+		if !f(up) {
+			return nil
+		}
+	}
+	return nil
+}
+
+var (
+	appIDs = ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"}
+	dev1   = ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: appIDs, DeviceID: "foo-device-1"}
+	dev2   = ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: appIDs, DeviceID: "foo-device-2"}
+)
+
+func uplink(ids ttnpb.EndDeviceIdentifiers, fPort uint32) *ttnpb.ApplicationUp {
+	return &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: ids,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &ttnpb.ApplicationUplink{
+			FPort:      fPort,
+			FRMPayload: []byte{0x01},
+		}},
+	}
+}
+
+func TestIntegration(t *testing.T) {
+	a := assertions.New(t)
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	st := &memStorage{}
+	sub := storage.New(ctx, st).NewSubscription()
+
+	ups := []*ttnpb.ApplicationUp{
+		uplink(dev1, 1),
+		uplink(dev2, 2),
+		{
+			EndDeviceIdentifiers: dev1,
+			Up: &ttnpb.ApplicationUp_JoinAccept{JoinAccept: &ttnpb.ApplicationJoinAccept{
+				SessionKeyID: []byte{0x11},
+			}},
+		},
+		uplink(dev1, 2),
+	}
+	var timestamps []time.Time
+	for _, up := range ups {
+		timestamps = append(timestamps, time.Now())
+		time.Sleep(test.Delay)
+		if !a.So(sub.SendUp(up), should.BeNil) {
+			t.FailNow()
+		}
+		time.Sleep(test.Delay)
+		// The live message is not modified.
+		a.So(up.ReceivedAt, should.BeNil)
+	}
+
+	st.mu.Lock()
+	stored := len(st.ups)
+	st.mu.Unlock()
+	if !a.So(stored, should.Equal, len(ups)) {
+		t.FailNow()
+	}
+
+	rpc := storage.NewApplicationUpStorageRPC(st)
+
+	_, err := rpc.GetStoredApplicationUp(rights.NewContext(ctx, rights.Rights{}), &ttnpb.GetStoredApplicationUpRequest{
+		ApplicationIdentifiers: appIDs,
+	})
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+	ctx = rights.NewContext(ctx, rights.Rights{
+		ApplicationRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, appIDs): ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		},
+	})
+
+	for _, tc := range []struct {
+		Name     string
+		Request  *ttnpb.GetStoredApplicationUpRequest
+		Expected []*ttnpb.ApplicationUp
+	}{
+		{
+			Name:     "All",
+			Request:  &ttnpb.GetStoredApplicationUpRequest{},
+			Expected: ups,
+		},
+		{
+			Name: "Device",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				DeviceID: dev1.DeviceID,
+			},
+			Expected: []*ttnpb.ApplicationUp{ups[0], ups[2], ups[3]},
+		},
+		{
+			Name: "Type",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				Type: "join_accept",
+			},
+			Expected: []*ttnpb.ApplicationUp{ups[2]},
+		},
+		{
+			Name: "FPort",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				FPort: 2,
+			},
+			Expected: []*ttnpb.ApplicationUp{ups[1], ups[3]},
+		},
+		{
+			Name: "TimeRange",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				After:  &timestamps[1],
+				Before: &timestamps[3],
+			},
+			Expected: []*ttnpb.ApplicationUp{ups[1], ups[2]},
+		},
+		{
+			Name: "Limit",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				DeviceID: dev1.DeviceID,
+				Limit:    2,
+			},
+			Expected: []*ttnpb.ApplicationUp{ups[0], ups[2]},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			req := *tc.Request
+			req.ApplicationIdentifiers = appIDs
+			res, err := rpc.GetStoredApplicationUp(ctx, &req)
+			if !a.So(err, should.BeNil) || !a.So(res.Ups, should.HaveLength, len(tc.Expected)) {
+				t.FailNow()
+			}
+			for i, up := range res.Ups {
+				a.So(up.ReceivedAt, should.NotBeNil)
+				expected := *tc.Expected[i]
+				expected.ReceivedAt = up.ReceivedAt
+				a.So(up, should.Resemble, &expected)
+			}
+		})
+	}
+}
+
+func TestLimit(t *testing.T) {
+	ctx := test.Context()
+	st := &memStorage{}
+	for i := 0; i < storage.MaxLimit+1; i++ {
+		up := uplink(dev1, 1)
+		receivedAt := time.Now()
+		up.ReceivedAt = &receivedAt
+		st.Store(ctx, up)
+	}
+	rpc := storage.NewApplicationUpStorageRPC(st)
+	ctx = rights.NewContext(ctx, rights.Rights{
+		ApplicationRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, appIDs): ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		},
+	})
+
+	for _, tc := range []struct {
+		Name     string
+		Limit    uint32
+		Expected int
+	}{
+		{
+			Name:     "Default",
+			Expected: storage.DefaultLimit,
+		},
+		{
+			Name:     "Custom",
+			Limit:    storage.DefaultLimit + 1,
+			Expected: storage.DefaultLimit + 1,
+		},
+		{
+			Name:     "Maximum",
+			Limit:    storage.MaxLimit + 1,
+			Expected: storage.MaxLimit,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			res, err := rpc.GetStoredApplicationUp(ctx, &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIdentifiers: appIDs,
+				Limit:                  tc.Limit,
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(res.Ups, should.HaveLength, tc.Expected)
+		})
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/lru"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	// SignatureHeader is the HTTP header that contains the signature of the request body.
+	SignatureHeader = "X-TTN-Signature"
+	// SignatureTimestampHeader is the HTTP header that contains the Unix time in seconds at which the request is signed.
+	SignatureTimestampHeader = "X-TTN-Signature-Timestamp"
+)
+
+// Signature returns the HMAC-SHA256 signature of the timestamp and the body with the secret.
+// The signature is the value of the SignatureHeader.
+func Signature(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func sign(req *http.Request, signing *ttnpb.ApplicationWebhookSigning, body []byte, now time.Time) {
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	req.Header.Set(SignatureTimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, Signature(signing.Secret, timestamp, body))
+}
+
+// tokenTimeout is the timeout of OAuth 2.0 token requests.
+var tokenTimeout = 10 * time.Second
+
+// tokenSourcesSize is the maximum number of cached OAuth 2.0 token sources.
+const tokenSourcesSize = 1024
+
+// tokenSources caches the OAuth 2.0 token sources by client credentials.
+// The least recently used token source is evicted when the cache is full.
+type tokenSources struct {
+	mu      sync.Mutex
+	sources *lru.Cache
+}
+
+func tokenSourceKey(credentials *ttnpb.ApplicationWebhookOAuth2ClientCredentials) string {
+	return strings.Join([]string{
+		credentials.TokenURL,
+		credentials.ClientID,
+		credentials.ClientSecret,
+		strings.Join(credentials.Scopes, " "),
+	}, "\n")
+}
+
+func (s *tokenSources) get(ctx context.Context, credentials *ttnpb.ApplicationWebhookOAuth2ClientCredentials) oauth2.TokenSource {
+	key := tokenSourceKey(credentials)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.sources == nil {
+		s.sources = lru.New(tokenSourcesSize, nil)
+	}
+	if source, ok := s.sources.Get(key); ok {
+		return source.(oauth2.TokenSource)
+	}
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{
+		Timeout: tokenTimeout,
+	})
+	config := &clientcredentials.Config{
+		ClientID:     credentials.ClientID,
+		ClientSecret: credentials.ClientSecret,
+		TokenURL:     credentials.TokenURL,
+		Scopes:       credentials.Scopes,
+	}
+	source := config.TokenSource(ctx)
+	s.sources.Add(key, source)
+	return source
+}
+
+// remove drops the token source of the client credentials, if any.
+func (s *tokenSources) remove(credentials *ttnpb.ApplicationWebhookOAuth2ClientCredentials) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.sources != nil {
+		s.sources.Remove(tokenSourceKey(credentials))
+	}
+}
+
+var errToken = errors.DefineUnavailable("token", "failed to acquire OAuth 2.0 token")
+
+// authorize sets the Authorization header of the request with a bearer token acquired with the OAuth 2.0 client
+// credentials of the webhook, if configured. Tokens are cached until they expire.
+func (w *webhooks) authorize(hook *ttnpb.ApplicationWebhook, req *http.Request) error {
+	if hook.OAuth2ClientCredentials == nil {
+		return nil
+	}
+	token, err := w.tokens.get(w.ctx, hook.OAuth2ClientCredentials).Token()
+	if err != nil {
+		return errToken.WithCause(err)
+	}
+	token.SetAuthHeader(req)
+	return nil
+}
+
+// process processes the request with the target.
+// When the webhook rejects the request as unauthenticated, the token source is dropped so that the next request
+// acquires a new token.
+func (w *webhooks) process(hook *ttnpb.ApplicationWebhook, req *http.Request) error {
+	err := w.target.Process(req)
+	if err != nil && hook.OAuth2ClientCredentials != nil && errors.IsUnauthenticated(err) {
+		w.tokens.remove(hook.OAuth2ClientCredentials)
+	}
+	return err
+}
+
+// tokenRegistry is a WebhookRegistry that drops the token source of a webhook when the webhook is deleted or when
+// its OAuth 2.0 client credentials are updated.
+type tokenRegistry struct {
+	WebhookRegistry
+	tokens *tokenSources
+}
+
+// Set implements WebhookRegistry.
+func (r tokenRegistry) Set(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, paths []string, f func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error)) (*ttnpb.ApplicationWebhook, error) {
+	var stale *ttnpb.ApplicationWebhookOAuth2ClientCredentials
+	hook, err := r.WebhookRegistry.Set(ctx, ids, append(paths[:len(paths):len(paths)], "oauth2_client_credentials"), func(stored *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+		updated, sets, err := f(stored)
+		stale = nil
+		if err != nil || stored == nil || stored.OAuth2ClientCredentials == nil {
+			return updated, sets, err
+		}
+		if updated == nil {
+			stale = stored.OAuth2ClientCredentials
+		}
+		for _, path := range sets {
+			if path == "oauth2_client_credentials" || strings.HasPrefix(path, "oauth2_client_credentials.") {
+				stale = stored.OAuth2ClientCredentials
+			}
+		}
+		return updated, sets, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if stale != nil {
+		r.tokens.remove(stale)
+	}
+	if hook != nil && !ttnpb.HasAnyField(paths, "oauth2_client_credentials") {
+		res := *hook
+		res.OAuth2ClientCredentials = nil
+		hook = &res
+	}
+	return hook, nil
+}
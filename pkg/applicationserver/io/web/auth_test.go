@@ -0,0 +1,210 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestWebhookAuth(t *testing.T) {
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	var tokenRequests int32
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&tokenRequests, 1)
+		if err := r.ParseForm(); err != nil || r.Form.Get("grant_type") != "client_credentials" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if id, secret, ok := r.BasicAuth(); !ok || id != "foo-client" || secret != "foo-secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"foo-token","token_type":"bearer","expires_in":3600}`))
+	}))
+	defer tokenServer.Close()
+
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              registeredWebhookID,
+	}
+	registry := &memWebhookRegistry{
+		webhooks: map[ttnpb.ApplicationWebhookIdentifiers]*ttnpb.ApplicationWebhook{
+			ids: {
+				ApplicationWebhookIdentifiers: ids,
+				BaseURL:                       "https://myapp.com/api/ttn/v3",
+				Format:                        "json",
+				UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+					Path: "up",
+				},
+				Signing: &ttnpb.ApplicationWebhookSigning{
+					Secret: "0123456789abcdef",
+				},
+				OAuth2ClientCredentials: &ttnpb.ApplicationWebhookOAuth2ClientCredentials{
+					TokenURL:     tokenServer.URL,
+					ClientID:     "foo-client",
+					ClientSecret: "foo-secret",
+				},
+			},
+		},
+	}
+	testSink := &mockSink{
+		ch: make(chan *http.Request, 1),
+	}
+	w := web.NewWebhooks(ctx, nil, registry, testSink)
+	sub := w.NewSubscription()
+
+	for i := 0; i < 2; i++ {
+		a := assertions.New(t)
+		err := sub.SendUp(&ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: registeredDeviceID,
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					FRMPayload: []byte{0x01},
+				},
+			},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		var req *http.Request
+		select {
+		case req = <-testSink.ch:
+		case <-time.After(timeout):
+			t.Fatal("Expected message but nothing received")
+		}
+		a.So(req.Header.Get("Authorization"), should.Equal, "Bearer foo-token")
+
+		body, err := ioutil.ReadAll(req.Body)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		timestamp := req.Header.Get(web.SignatureTimestampHeader)
+		unix, err := strconv.ParseInt(timestamp, 10, 64)
+		a.So(err, should.BeNil)
+		a.So(time.Unix(unix, 0), should.HappenWithin, time.Minute, time.Now())
+		a.So(req.Header.Get(web.SignatureHeader), should.Equal, web.Signature("0123456789abcdef", timestamp, body))
+		a.So(req.Header.Get(web.SignatureHeader), should.NotEqual, web.Signature("fedcba9876543210", timestamp, body))
+	}
+
+	// The token is cached.
+	assertions.New(t).So(atomic.LoadInt32(&tokenRequests), should.Equal, 1)
+}
+
+func TestWebhookAuthRenewal(t *testing.T) {
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	var tokenRequests int32
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&tokenRequests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"token-` + strconv.Itoa(int(n)) + `","token_type":"bearer","expires_in":3600}`))
+	}))
+	defer tokenServer.Close()
+
+	// The webhook rejects the first token.
+	authorizations := make(chan string, 1)
+	hookServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization := r.Header.Get("Authorization")
+		if authorization == "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+		authorizations <- authorization
+	}))
+	defer hookServer.Close()
+
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              registeredWebhookID,
+	}
+	registry := &memWebhookRegistry{
+		webhooks: map[ttnpb.ApplicationWebhookIdentifiers]*ttnpb.ApplicationWebhook{
+			ids: {
+				ApplicationWebhookIdentifiers: ids,
+				BaseURL:                       hookServer.URL,
+				Format:                        "json",
+				UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+					Path: "/up",
+				},
+				OAuth2ClientCredentials: &ttnpb.ApplicationWebhookOAuth2ClientCredentials{
+					TokenURL:     tokenServer.URL,
+					ClientID:     "foo-client",
+					ClientSecret: "foo-secret",
+				},
+			},
+		},
+	}
+	w := web.NewWebhooks(ctx, nil, registry, &web.HTTPClientSink{Client: http.DefaultClient})
+	sub := w.NewSubscription()
+
+	send := func(t *testing.T) string {
+		err := sub.SendUp(&ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: registeredDeviceID,
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					FRMPayload: []byte{0x01},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatalf("Failed to send message: %v", err)
+		}
+		select {
+		case authorization := <-authorizations:
+			return authorization
+		case <-time.After(timeout):
+			t.Fatal("Expected message but nothing received")
+		}
+		return ""
+	}
+
+	a := assertions.New(t)
+
+	// The rejected token is dropped.
+	a.So(send(t), should.Equal, "Bearer token-1")
+	a.So(send(t), should.Equal, "Bearer token-2")
+	a.So(send(t), should.Equal, "Bearer token-2")
+
+	// Updating the client credentials drops the token.
+	hook, err := w.Registry().Set(ctx, ids, nil, func(stored *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+		return &ttnpb.ApplicationWebhook{
+			OAuth2ClientCredentials: &ttnpb.ApplicationWebhookOAuth2ClientCredentials{
+				TokenURL:     tokenServer.URL,
+				ClientID:     "foo-client",
+				ClientSecret: "foo-secret",
+			},
+		}, []string{"oauth2_client_credentials"}, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(hook.OAuth2ClientCredentials, should.BeNil)
+	a.So(send(t), should.Equal, "Bearer token-3")
+	a.So(atomic.LoadInt32(&tokenRequests), should.Equal, 3)
+}
@@ -16,20 +16,28 @@ package web
 
 import (
 	"context"
+	"time"
 
 	pbtypes "github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
 type webhookRegistryRPC struct {
-	webhooks WebhookRegistry
+	webhooks  WebhookRegistry
+	queue     DeliveryQueue
+	templates TemplateStore
 }
 
 // NewWebhookRegistryRPC returns a new webhook registry gRPC server.
-func NewWebhookRegistryRPC(webhooks WebhookRegistry) ttnpb.ApplicationWebhookRegistryServer {
+// The queue is used to list and replay dead letters. If the queue is nil, dead letters are not available.
+// The templates are used to list and instantiate webhook templates. If the templates are nil, no templates are available.
+func NewWebhookRegistryRPC(webhooks WebhookRegistry, queue DeliveryQueue, templates TemplateStore) ttnpb.ApplicationWebhookRegistryServer {
 	return &webhookRegistryRPC{
-		webhooks: webhooks,
+		webhooks:  webhooks,
+		queue:     queue,
+		templates: templates,
 	}
 }
 
@@ -43,6 +51,83 @@ func (s webhookRegistryRPC) GetFormats(ctx context.Context, _ *pbtypes.Empty) (*
 	}, nil
 }
 
+func (s webhookRegistryRPC) getTemplate(ctx context.Context, ids ttnpb.ApplicationWebhookTemplateIdentifiers) (*ttnpb.ApplicationWebhookTemplate, error) {
+	if s.templates == nil {
+		return nil, errTemplateNotFound.WithAttributes("template_id", ids.TemplateID)
+	}
+	return s.templates.GetTemplate(ctx, ids)
+}
+
+func (s webhookRegistryRPC) GetTemplate(ctx context.Context, req *ttnpb.GetApplicationWebhookTemplateRequest) (*ttnpb.ApplicationWebhookTemplate, error) {
+	template, err := s.getTemplate(ctx, req.ApplicationWebhookTemplateIdentifiers)
+	if err != nil {
+		return nil, err
+	}
+	res := &ttnpb.ApplicationWebhookTemplate{}
+	if err := res.SetFields(template, append(req.FieldMask.Paths, "ids")...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s webhookRegistryRPC) ListTemplates(ctx context.Context, req *ttnpb.ListApplicationWebhookTemplatesRequest) (*ttnpb.ApplicationWebhookTemplates, error) {
+	if s.templates == nil {
+		return &ttnpb.ApplicationWebhookTemplates{}, nil
+	}
+	templates, err := s.templates.ListTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := &ttnpb.ApplicationWebhookTemplates{
+		Templates: make([]*ttnpb.ApplicationWebhookTemplate, 0, len(templates)),
+	}
+	for _, template := range templates {
+		pb := &ttnpb.ApplicationWebhookTemplate{}
+		if err := pb.SetFields(template, append(req.FieldMask.Paths, "ids")...); err != nil {
+			return nil, err
+		}
+		res.Templates = append(res.Templates, pb)
+	}
+	return res, nil
+}
+
+// instantiatedWebhookPaths are the paths of a webhook that are set when instantiating a template.
+// The health is reset so that a suspended webhook is resumed.
+var instantiatedWebhookPaths = []string{
+	"base_url",
+	"downlink_ack",
+	"downlink_failed",
+	"downlink_nack",
+	"downlink_queued",
+	"downlink_sent",
+	"format",
+	"headers",
+	"health",
+	"join_accept",
+	"location_solved",
+	"template_ids",
+	"uplink_message",
+}
+
+func (s webhookRegistryRPC) InstantiateTemplate(ctx context.Context, req *ttnpb.InstantiateApplicationWebhookTemplateRequest) (*ttnpb.ApplicationWebhook, error) {
+	if err := rights.RequireApplication(ctx, req.WebhookIDs.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	template, err := s.getTemplate(ctx, req.TemplateIDs)
+	if err != nil {
+		return nil, err
+	}
+	webhook, err := InstantiateTemplate(template, req.WebhookIDs, req.Fields)
+	if err != nil {
+		return nil, err
+	}
+	return s.webhooks.Set(ctx, req.WebhookIDs, ttnpb.ApplicationWebhookFieldPathsTopLevel,
+		func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+			return webhook, instantiatedWebhookPaths, nil
+		},
+	)
+}
+
 func (s webhookRegistryRPC) Get(ctx context.Context, req *ttnpb.GetApplicationWebhookRequest) (*ttnpb.ApplicationWebhook, error) {
 	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
 		return nil, err
@@ -88,3 +173,47 @@ func (s webhookRegistryRPC) Delete(ctx context.Context, req *ttnpb.ApplicationWe
 	}
 	return ttnpb.Empty, nil
 }
+
+var errNoDeadLetters = errors.DefineFailedPrecondition("no_dead_letters", "dead letters are not available without delivery queue")
+
+func (s webhookRegistryRPC) ListDeadLetters(ctx context.Context, req *ttnpb.ListApplicationWebhookDeadLettersRequest) (*ttnpb.ApplicationWebhookDeliveries, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	if s.queue == nil {
+		return nil, errNoDeadLetters
+	}
+	deliveries, err := s.queue.ListDeadLetters(ctx, req.ApplicationWebhookIdentifiers, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.ApplicationWebhookDeliveries{
+		Deliveries: deliveries,
+	}, nil
+}
+
+func (s webhookRegistryRPC) ReplayDeadLetters(ctx context.Context, req *ttnpb.ReplayApplicationWebhookDeadLettersRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	if s.queue == nil {
+		return nil, errNoDeadLetters
+	}
+	deliveries, err := s.queue.RemoveDeadLetters(ctx, req.ApplicationWebhookIdentifiers, req.DeliveryIDs...)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for i, delivery := range deliveries {
+		delivery.Attempts = 0
+		delivery.LastError = ""
+		if err := s.queue.Add(ctx, delivery, now); err != nil {
+			// Put back the deliveries that are not replayed.
+			for _, delivery := range deliveries[i:] {
+				s.queue.AddDeadLetter(ctx, delivery)
+			}
+			return nil, err
+		}
+	}
+	return ttnpb.Empty, nil
+}
@@ -34,7 +34,7 @@ func TestWebhookRegistryRPC(t *testing.T) {
 	defer flush()
 	defer redisClient.Close()
 	webhookReg := &redis.WebhookRegistry{Redis: redisClient}
-	srv := web.NewWebhookRegistryRPC(webhookReg)
+	srv := web.NewWebhookRegistryRPC(webhookReg, nil, nil)
 	authorizedCtx := contextWithKey(ctx, registeredApplicationKey)
 
 	// Formats.
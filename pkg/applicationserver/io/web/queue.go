@@ -0,0 +1,313 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"crypto/rand"
+	"time"
+
+	"github.com/oklog/ulid"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	ttnrandom "go.thethings.network/lorawan-stack/pkg/random"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	evtWebhookSuspend    = events.Define("as.webhook.suspend", "suspend webhook")
+	evtWebhookResume     = events.Define("as.webhook.resume", "resume webhook")
+	evtWebhookDeadLetter = events.Define("as.webhook.dead_letter", "dead-letter webhook message")
+)
+
+// DeliveryQueue is a durable queue of webhook deliveries.
+type DeliveryQueue interface {
+	// Add adds the delivery to the queue, to be attempted at or after startAt.
+	Add(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery, startAt time.Time) error
+	// Pop calls f on the next delivery that is due. If f returns a non-zero time, the delivery, as modified by f, is
+	// scheduled again at that time. Otherwise, the delivery is removed from the queue. The error returned by f is
+	// returned by Pop.
+	// Pop blocks until a delivery is due or the context is done.
+	Pop(ctx context.Context, f func(context.Context, *ttnpb.ApplicationWebhookDelivery) (time.Time, error)) error
+	// AddDeadLetter adds the delivery to the dead letters of the webhook.
+	AddDeadLetter(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery) error
+	// ListDeadLetters returns the most recent dead letters of the webhook. If limit is zero, all dead letters are returned.
+	ListDeadLetters(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, limit int) ([]*ttnpb.ApplicationWebhookDelivery, error)
+	// RemoveDeadLetters removes and returns the dead letters of the webhook with the given delivery identifiers.
+	// If no delivery identifiers are given, all dead letters of the webhook are removed.
+	RemoveDeadLetters(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, deliveryIDs ...string) ([]*ttnpb.ApplicationWebhookDelivery, error)
+}
+
+// DeliveryConfig configures the delivery of queued webhook messages.
+type DeliveryConfig struct {
+	// Workers is the number of concurrent workers that deliver messages.
+	Workers int
+	// MaxAttempts is the number of delivery attempts after which a message is dead-lettered.
+	MaxAttempts int
+	// InitialBackoff is the time to wait before the first retry. The backoff doubles with every retry.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum time to wait between retries.
+	MaxBackoff time.Duration
+	// SuspendThreshold is the number of consecutive failed delivery attempts after which the webhook is suspended.
+	// If zero, webhooks are never suspended.
+	SuspendThreshold int
+	// SuspendDuration is the duration of the suspension of a webhook, after which delivery is attempted again.
+	// If zero, webhooks stay suspended until their health is reset.
+	// Queued deliveries to a suspended webhook are held until the suspension ends.
+	SuspendDuration time.Duration
+}
+
+// backoff returns the time to wait after the given number of attempts.
+func (c DeliveryConfig) backoff(attempts uint32) time.Duration {
+	d := c.InitialBackoff
+	for i := uint32(1); i < attempts && i < 32; i++ {
+		d *= 2
+		if c.MaxBackoff > 0 && d >= c.MaxBackoff {
+			break
+		}
+	}
+	if c.MaxBackoff > 0 && d > c.MaxBackoff {
+		d = c.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	return ttnrandom.Jitter(d, 0.1)
+}
+
+// isSuspended returns whether the health indicates that the webhook is suspended at the given time.
+func (c DeliveryConfig) isSuspended(health *ttnpb.ApplicationWebhookHealth, now time.Time) bool {
+	if health == nil || health.SuspendedAt == nil {
+		return false
+	}
+	return c.SuspendDuration == 0 || now.Before(health.SuspendedAt.Add(c.SuspendDuration))
+}
+
+// resumeAt returns the time at which delivery to the suspended webhook is attempted again.
+// If the webhook is suspended until its health is reset, delivery is attempted again after the maximum backoff.
+func (c DeliveryConfig) resumeAt(health *ttnpb.ApplicationWebhookHealth, now time.Time) time.Time {
+	if c.SuspendDuration > 0 {
+		return health.SuspendedAt.Add(c.SuspendDuration)
+	}
+	d := c.MaxBackoff
+	if d <= 0 {
+		d = c.InitialBackoff
+	}
+	return now.Add(d)
+}
+
+// Option configures Webhooks.
+type Option func(*webhooks)
+
+// WithDeliveryQueue configures Webhooks to add upstream messages to the given queue. Workers deliver the queued
+// messages with retries, keep track of the health of the webhooks and dead-letter the messages that cannot be delivered.
+func WithDeliveryQueue(queue DeliveryQueue, conf DeliveryConfig) Option {
+	return func(w *webhooks) {
+		w.queue = queue
+		w.delivery = conf
+	}
+}
+
+func newDelivery(ids ttnpb.ApplicationWebhookIdentifiers, msg *ttnpb.ApplicationUp) (*ttnpb.ApplicationWebhookDelivery, error) {
+	now := time.Now().UTC()
+	id, err := ulid.New(ulid.Timestamp(now), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.ApplicationWebhookDelivery{
+		ID:         id.String(),
+		WebhookIDs: ids,
+		Message:    msg,
+		CreatedAt:  now,
+	}, nil
+}
+
+// runDeliveries runs the delivery workers until the context is done.
+func (w *webhooks) runDeliveries(ctx context.Context) {
+	workers := w.delivery.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	for i := 0; i < workers; i++ {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				default:
+				}
+				if err := w.queue.Pop(ctx, w.deliver); err != nil && !errors.IsCanceled(err) {
+					log.FromContext(ctx).WithError(err).Warn("Failed to pop delivery")
+					select {
+					case <-ctx.Done():
+						return
+					case <-time.After(w.delivery.InitialBackoff):
+					}
+				}
+			}
+		}()
+	}
+}
+
+var deliveryPaths = []string{
+	"base_url",
+	"headers",
+	"format",
+	"uplink_message",
+	"join_accept",
+	"downlink_ack",
+	"downlink_nack",
+	"downlink_sent",
+	"downlink_failed",
+	"downlink_queued",
+	"location_solved",
+	"health",
+	"signing",
+	"oauth2_client_credentials",
+}
+
+// deliver attempts to deliver the message of the delivery to the webhook.
+// It returns the time at which the delivery is attempted again, which is zero when the delivery is done.
+// Failed deliveries are retried with backoff, or dead-lettered when all attempts failed. Deliveries to a suspended
+// webhook are held until the suspension ends.
+func (w *webhooks) deliver(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery) (time.Time, error) {
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"application_id", delivery.WebhookIDs.ApplicationID,
+		"webhook_id", delivery.WebhookIDs.WebhookID,
+		"delivery_id", delivery.ID,
+	))
+	now := time.Now().UTC()
+	hook, err := w.registry.Get(ctx, delivery.WebhookIDs, deliveryPaths)
+	if errors.IsNotFound(err) {
+		logger.Debug("Webhook not found, drop delivery")
+		return time.Time{}, nil
+	} else if err != nil {
+		return now.Add(w.delivery.backoff(1)), err
+	}
+	if w.delivery.isSuspended(hook.Health, now) {
+		logger.Debug("Webhook is suspended, hold delivery")
+		return w.delivery.resumeAt(hook.Health, now), nil
+	}
+
+	req, err := w.newRequest(ctx, delivery.Message, hook)
+	if err != nil {
+		delivery.LastError = err.Error()
+		return w.deadLetter(ctx, delivery, now)
+	}
+	if req == nil {
+		return time.Time{}, nil
+	}
+	delivery.Attempts++
+	logger = logger.WithFields(log.Fields(
+		"url", req.URL,
+		"attempt", delivery.Attempts,
+	))
+	logger.Debug("Deliver message")
+	err = w.authorize(hook, req)
+	if err == nil {
+		err = w.process(hook, req)
+	}
+	if err != nil {
+		logger.WithError(err).Warn("Failed to deliver message")
+		delivery.LastError = err.Error()
+		if err := w.recordFailure(ctx, hook, err, now); err != nil {
+			logger.WithError(err).Warn("Failed to update webhook health")
+		}
+		if int(delivery.Attempts) >= w.delivery.MaxAttempts {
+			return w.deadLetter(ctx, delivery, now)
+		}
+		return now.Add(w.delivery.backoff(delivery.Attempts)), nil
+	}
+	if hook.Health != nil {
+		if err := w.recordSuccess(ctx, hook); err != nil {
+			logger.WithError(err).Warn("Failed to update webhook health")
+		}
+	}
+	return time.Time{}, nil
+}
+
+// deadLetter adds the delivery to the dead letters of the webhook.
+// If that fails, the delivery is attempted again with backoff.
+func (w *webhooks) deadLetter(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery, now time.Time) (time.Time, error) {
+	log.FromContext(ctx).WithFields(log.Fields(
+		"application_id", delivery.WebhookIDs.ApplicationID,
+		"webhook_id", delivery.WebhookIDs.WebhookID,
+		"delivery_id", delivery.ID,
+		"attempts", delivery.Attempts,
+	)).Debug("Dead-letter delivery")
+	if err := w.queue.AddDeadLetter(ctx, delivery); err != nil {
+		return now.Add(w.delivery.backoff(1)), err
+	}
+	events.Publish(evtWebhookDeadLetter(ctx, delivery.WebhookIDs.ApplicationIdentifiers, delivery.WebhookIDs))
+	return time.Time{}, nil
+}
+
+// recordFailure increments the failed attempts of the webhook and suspends the webhook when the threshold is reached.
+func (w *webhooks) recordFailure(ctx context.Context, hook *ttnpb.ApplicationWebhook, cause error, now time.Time) error {
+	var suspended bool
+	_, err := w.registry.Set(ctx, hook.ApplicationWebhookIdentifiers, []string{"health"},
+		func(stored *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+			if stored == nil {
+				return nil, nil, errWebhookNotFound
+			}
+			health := stored.Health
+			if health == nil {
+				health = &ttnpb.ApplicationWebhookHealth{}
+			}
+			health.FailedAttempts++
+			health.LastFailedAttemptAt = &now
+			health.LastFailedAttemptError = cause.Error()
+			if w.delivery.SuspendThreshold > 0 && int(health.FailedAttempts) >= w.delivery.SuspendThreshold &&
+				!w.delivery.isSuspended(health, now) {
+				health.SuspendedAt = &now
+				suspended = true
+			}
+			stored.Health = health
+			return stored, []string{"health"}, nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if suspended {
+		log.FromContext(ctx).WithFields(log.Fields(
+			"application_id", hook.ApplicationID,
+			"webhook_id", hook.WebhookID,
+		)).Warn("Suspend webhook")
+		events.Publish(evtWebhookSuspend(ctx, hook.ApplicationIdentifiers, hook.ApplicationWebhookIdentifiers))
+	}
+	return nil
+}
+
+// recordSuccess resets the health of the webhook.
+func (w *webhooks) recordSuccess(ctx context.Context, hook *ttnpb.ApplicationWebhook) error {
+	_, err := w.registry.Set(ctx, hook.ApplicationWebhookIdentifiers, []string{"health"},
+		func(stored *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+			if stored == nil {
+				return nil, nil, errWebhookNotFound
+			}
+			stored.Health = nil
+			return stored, []string{"health"}, nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if hook.Health.SuspendedAt != nil {
+		events.Publish(evtWebhookResume(ctx, hook.ApplicationIdentifiers, hook.ApplicationWebhookIdentifiers))
+	}
+	return nil
+}
@@ -0,0 +1,414 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+var errNotFound = errors.DefineNotFound("not_found", "not found")
+
+// memWebhookRegistry is an in-memory webhook registry.
+type memWebhookRegistry struct {
+	mu       sync.Mutex
+	webhooks map[ttnpb.ApplicationWebhookIdentifiers]*ttnpb.ApplicationWebhook
+}
+
+func (r *memWebhookRegistry) Get(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, paths []string) (*ttnpb.ApplicationWebhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.webhooks[ids]
+	if !ok {
+		return nil, errNotFound
+	}
+	pb := &ttnpb.ApplicationWebhook{}
+	return pb, pb.SetFields(stored, append(paths, "ids")...)
+}
+
+func (r *memWebhookRegistry) List(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string) ([]*ttnpb.ApplicationWebhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var pbs []*ttnpb.ApplicationWebhook
+	for _, stored := range r.webhooks {
+		if stored.ApplicationIdentifiers != ids {
+			continue
+		}
+		pb := &ttnpb.ApplicationWebhook{}
+		if err := pb.SetFields(stored, append(paths, "ids")...); err != nil {
+			return nil, err
+		}
+		pbs = append(pbs, pb)
+	}
+	return pbs, nil
+}
+
+func (r *memWebhookRegistry) Set(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, paths []string, f func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error)) (*ttnpb.ApplicationWebhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var current *ttnpb.ApplicationWebhook
+	if stored, ok := r.webhooks[ids]; ok {
+		current = &ttnpb.ApplicationWebhook{}
+		if err := current.SetFields(stored, append(paths, "ids")...); err != nil {
+			return nil, err
+		}
+	}
+	pb, sets, err := f(current)
+	if err != nil {
+		return nil, err
+	}
+	if pb == nil {
+		delete(r.webhooks, ids)
+		return nil, nil
+	}
+	stored, ok := r.webhooks[ids]
+	if !ok {
+		stored = &ttnpb.ApplicationWebhook{}
+	}
+	if err := stored.SetFields(pb, sets...); err != nil {
+		return nil, err
+	}
+	stored.ApplicationWebhookIdentifiers = ids
+	r.webhooks[ids] = stored
+	return stored, nil
+}
+
+type scheduledDelivery struct {
+	delivery *ttnpb.ApplicationWebhookDelivery
+	startAt  time.Time
+}
+
+// memDeliveryQueue is an in-memory delivery queue.
+type memDeliveryQueue struct {
+	mu          sync.Mutex
+	scheduled   []scheduledDelivery
+	deadLetters []*ttnpb.ApplicationWebhookDelivery
+}
+
+func (q *memDeliveryQueue) Add(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery, startAt time.Time) error {
+	q.mu.Lock()
+	q.scheduled = append(q.scheduled, scheduledDelivery{delivery: delivery, startAt: startAt})
+	q.mu.Unlock()
+	return nil
+}
+
+func (q *memDeliveryQueue) pop() *ttnpb.ApplicationWebhookDelivery {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	sort.SliceStable(q.scheduled, func(i, j int) bool { return q.scheduled[i].startAt.Before(q.scheduled[j].startAt) })
+	if len(q.scheduled) == 0 || q.scheduled[0].startAt.After(time.Now()) {
+		return nil
+	}
+	delivery := q.scheduled[0].delivery
+	q.scheduled = q.scheduled[1:]
+	return delivery
+}
+
+func (q *memDeliveryQueue) Pop(ctx context.Context, f func(context.Context, *ttnpb.ApplicationWebhookDelivery) (time.Time, error)) error {
+	for {
+		if delivery := q.pop(); delivery != nil {
+			retryAt, err := f(ctx, delivery)
+			if !retryAt.IsZero() {
+				q.Add(ctx, delivery, retryAt)
+			}
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(test.Delay):
+		}
+	}
+}
+
+func (q *memDeliveryQueue) AddDeadLetter(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery) error {
+	q.mu.Lock()
+	q.deadLetters = append([]*ttnpb.ApplicationWebhookDelivery{delivery}, q.deadLetters...)
+	q.mu.Unlock()
+	return nil
+}
+
+func (q *memDeliveryQueue) ListDeadLetters(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, limit int) ([]*ttnpb.ApplicationWebhookDelivery, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var res []*ttnpb.ApplicationWebhookDelivery
+	for _, delivery := range q.deadLetters {
+		if delivery.WebhookIDs == ids && (limit == 0 || len(res) < limit) {
+			res = append(res, delivery)
+		}
+	}
+	return res, nil
+}
+
+func (q *memDeliveryQueue) RemoveDeadLetters(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, deliveryIDs ...string) ([]*ttnpb.ApplicationWebhookDelivery, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var removed, kept []*ttnpb.ApplicationWebhookDelivery
+	for _, delivery := range q.deadLetters {
+		match := delivery.WebhookIDs == ids
+		if match && len(deliveryIDs) > 0 {
+			match = false
+			for _, id := range deliveryIDs {
+				match = match || id == delivery.ID
+			}
+		}
+		if match {
+			removed = append(removed, delivery)
+		} else {
+			kept = append(kept, delivery)
+		}
+	}
+	q.deadLetters = kept
+	return removed, nil
+}
+
+var errDeliveryFailed = errors.DefineUnavailable("delivery_failed", "delivery failed")
+
+// failingSink fails to process requests while failing is set.
+type failingSink struct {
+	mu      sync.Mutex
+	failing bool
+	ch      chan *http.Request
+}
+
+func (s *failingSink) setFailing(failing bool) {
+	s.mu.Lock()
+	s.failing = failing
+	s.mu.Unlock()
+}
+
+func (s *failingSink) Process(req *http.Request) error {
+	s.ch <- req
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failing {
+		return errDeliveryFailed
+	}
+	return nil
+}
+
+func TestDeliveryQueue(t *testing.T) {
+	a := assertions.New(t)
+	ctx, cancel := context.WithCancel(log.NewContext(newContextWithRightsFetcher(test.Context()), test.GetLogger(t)))
+	defer cancel()
+
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              registeredWebhookID,
+	}
+	registry := &memWebhookRegistry{
+		webhooks: make(map[ttnpb.ApplicationWebhookIdentifiers]*ttnpb.ApplicationWebhook),
+	}
+	registry.Set(ctx, ids, nil, func(_ *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+		return &ttnpb.ApplicationWebhook{
+			BaseURL: "https://myapp.com/api/ttn/v3",
+			Format:  "json",
+			UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+				Path: "up",
+			},
+		}, []string{"base_url", "format", "uplink_message"}, nil
+	})
+	queue := &memDeliveryQueue{}
+	sink := &failingSink{
+		ch: make(chan *http.Request, 10),
+	}
+	w := web.NewWebhooks(ctx, nil, registry, sink, web.WithDeliveryQueue(queue, web.DeliveryConfig{
+		Workers:          1,
+		MaxAttempts:      3,
+		InitialBackoff:   test.Delay,
+		MaxBackoff:       2 * test.Delay,
+		SuspendThreshold: 4,
+	}))
+	sub := w.NewSubscription()
+
+	up := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: registeredDeviceID,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &ttnpb.ApplicationUplink{
+			FPort: 42,
+		}},
+	}
+	expectRequests := func(t *testing.T, n int) {
+		for i := 0; i < n; i++ {
+			select {
+			case req := <-sink.ch:
+				assertions.New(t).So(req.URL.String(), should.Equal, "https://myapp.com/api/ttn/v3/up")
+			case <-time.After(timeout):
+				t.Fatalf("Expected request %d", i+1)
+			}
+		}
+	}
+	expectNoRequest := func(t *testing.T) {
+		select {
+		case <-sink.ch:
+			t.Fatal("Expected no request")
+		case <-time.After(timeout):
+		}
+	}
+	health := func() *ttnpb.ApplicationWebhookHealth {
+		hook, err := registry.Get(ctx, ids, []string{"health"})
+		if err != nil {
+			t.Fatalf("Failed to get webhook: %v", err)
+		}
+		return hook.Health
+	}
+
+	t.Run("Retry", func(t *testing.T) {
+		a := assertions.New(t)
+		sink.setFailing(true)
+		a.So(sub.SendUp(up), should.BeNil)
+		expectRequests(t, 2)
+		sink.setFailing(false)
+		expectRequests(t, 1)
+		expectNoRequest(t)
+		a.So(health(), should.BeNil)
+	})
+
+	t.Run("DeadLetter", func(t *testing.T) {
+		a := assertions.New(t)
+		sink.setFailing(true)
+		a.So(sub.SendUp(up), should.BeNil)
+		expectRequests(t, 3)
+		expectNoRequest(t)
+		deadLetters, err := queue.ListDeadLetters(ctx, ids, 0)
+		a.So(err, should.BeNil)
+		if !a.So(deadLetters, should.HaveLength, 1) {
+			t.FailNow()
+		}
+		a.So(deadLetters[0].Attempts, should.Equal, 3)
+		a.So(deadLetters[0].LastError, should.NotBeEmpty)
+		a.So(deadLetters[0].Message, should.Resemble, up)
+		h := health()
+		if a.So(h, should.NotBeNil) {
+			a.So(h.FailedAttempts, should.Equal, 3)
+			a.So(h.SuspendedAt, should.BeNil)
+		}
+	})
+
+	t.Run("Suspend", func(t *testing.T) {
+		a := assertions.New(t)
+		a.So(sub.SendUp(up), should.BeNil)
+		expectRequests(t, 1)
+		// The fourth consecutive failed attempt suspends the webhook.
+		// The pending retry is held without attempt.
+		expectNoRequest(t)
+		h := health()
+		if !a.So(h, should.NotBeNil) {
+			t.FailNow()
+		}
+		a.So(h.FailedAttempts, should.Equal, 4)
+		a.So(h.SuspendedAt, should.NotBeNil)
+		deadLetters, err := queue.ListDeadLetters(ctx, ids, 0)
+		a.So(err, should.BeNil)
+		a.So(deadLetters, should.HaveLength, 1)
+
+		// Messages are not delivered to suspended webhooks.
+		a.So(sub.SendUp(up), should.BeNil)
+		expectNoRequest(t)
+	})
+
+	t.Run("Resume", func(t *testing.T) {
+		a := assertions.New(t)
+		// Resume the webhook by resetting its health. The held delivery is attempted again.
+		_, err := registry.Set(ctx, ids, []string{"health"}, func(hook *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+			hook.Health = nil
+			return hook, []string{"health"}, nil
+		})
+		a.So(err, should.BeNil)
+		sink.setFailing(false)
+		expectRequests(t, 1)
+		expectNoRequest(t)
+		a.So(health(), should.BeNil)
+	})
+
+	t.Run("Replay", func(t *testing.T) {
+		a := assertions.New(t)
+		rpc := web.NewWebhookRegistryRPC(registry, queue, nil)
+		authorizedCtx := contextWithKey(ctx, registeredApplicationKey)
+
+		_, err := rpc.ListDeadLetters(ctx, &ttnpb.ListApplicationWebhookDeadLettersRequest{
+			ApplicationWebhookIdentifiers: ids,
+		})
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+		// Add another dead letter.
+		sink.setFailing(true)
+		a.So(sub.SendUp(up), should.BeNil)
+		expectRequests(t, 3)
+		expectNoRequest(t)
+		sink.setFailing(false)
+		_, err = registry.Set(ctx, ids, []string{"health"}, func(hook *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+			hook.Health = nil
+			return hook, []string{"health"}, nil
+		})
+		a.So(err, should.BeNil)
+
+		res, err := rpc.ListDeadLetters(authorizedCtx, &ttnpb.ListApplicationWebhookDeadLettersRequest{
+			ApplicationWebhookIdentifiers: ids,
+		})
+		a.So(err, should.BeNil)
+		if !a.So(res.Deliveries, should.HaveLength, 2) {
+			t.FailNow()
+		}
+		res, err = rpc.ListDeadLetters(authorizedCtx, &ttnpb.ListApplicationWebhookDeadLettersRequest{
+			ApplicationWebhookIdentifiers: ids,
+			Limit:                         1,
+		})
+		a.So(err, should.BeNil)
+		if !a.So(res.Deliveries, should.HaveLength, 1) {
+			t.FailNow()
+		}
+
+		_, err = rpc.ReplayDeadLetters(authorizedCtx, &ttnpb.ReplayApplicationWebhookDeadLettersRequest{
+			ApplicationWebhookIdentifiers: ids,
+			DeliveryIDs:                   []string{res.Deliveries[0].ID},
+		})
+		a.So(err, should.BeNil)
+		expectRequests(t, 1)
+		expectNoRequest(t)
+
+		res, err = rpc.ListDeadLetters(authorizedCtx, &ttnpb.ListApplicationWebhookDeadLettersRequest{
+			ApplicationWebhookIdentifiers: ids,
+		})
+		a.So(err, should.BeNil)
+		a.So(res.Deliveries, should.HaveLength, 1)
+
+		_, err = rpc.ReplayDeadLetters(authorizedCtx, &ttnpb.ReplayApplicationWebhookDeadLettersRequest{
+			ApplicationWebhookIdentifiers: ids,
+		})
+		a.So(err, should.BeNil)
+		expectRequests(t, 1)
+		expectNoRequest(t)
+
+		res, err = rpc.ListDeadLetters(authorizedCtx, &ttnpb.ListApplicationWebhookDeadLettersRequest{
+			ApplicationWebhookIdentifiers: ids,
+		})
+		a.So(err, should.BeNil)
+		a.So(res.Deliveries, should.BeEmpty)
+	})
+
+	a.So(health(), should.BeNil)
+}
@@ -0,0 +1,192 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+const (
+	deliveryKey   = "delivery"
+	deadLetterKey = "dead-letter"
+	taskKey       = "task"
+)
+
+// DeliveryQueue is a Redis webhook delivery queue.
+// Deliveries are stored by their identifier and scheduled in a task queue.
+// Dead letters are stored in a capped list per webhook.
+type DeliveryQueue struct {
+	Redis           *ttnredis.Client
+	TaskQueue       *ttnredis.TaskQueue
+	DeadLetterLimit int64
+}
+
+// NewDeliveryQueue returns a new delivery queue.
+// The task queue is consumed by the consumer with the given identifier in the given group.
+// At most deadLetterLimit dead letters are kept per webhook. If deadLetterLimit is zero, dead letters are not capped.
+func NewDeliveryQueue(cl *ttnredis.Client, maxLen int64, group, id string, deadLetterLimit int64) *DeliveryQueue {
+	return &DeliveryQueue{
+		Redis: cl,
+		TaskQueue: &ttnredis.TaskQueue{
+			Redis:  cl,
+			MaxLen: maxLen,
+			Group:  group,
+			ID:     id,
+			Key:    cl.Key(taskKey),
+		},
+		DeadLetterLimit: deadLetterLimit,
+	}
+}
+
+// Init initializes the delivery queue.
+// It must be called at least once before using the queue.
+func (q *DeliveryQueue) Init() error {
+	return q.TaskQueue.Init()
+}
+
+// Run dispatches the deliveries that are due until the context is done.
+func (q *DeliveryQueue) Run(ctx context.Context) error {
+	return q.TaskQueue.Run(ctx)
+}
+
+// Add implements web.DeliveryQueue.
+func (q *DeliveryQueue) Add(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery, startAt time.Time) error {
+	if _, err := ttnredis.SetProto(q.Redis, q.Redis.Key(deliveryKey, delivery.ID), delivery, 0); err != nil {
+		return err
+	}
+	return q.TaskQueue.Add(delivery.ID, startAt)
+}
+
+// Pop implements web.DeliveryQueue.
+// The delivery is only removed after f is done with it, so that it is not lost when f fails.
+func (q *DeliveryQueue) Pop(ctx context.Context, f func(context.Context, *ttnpb.ApplicationWebhookDelivery) (time.Time, error)) error {
+	var ferr error
+	err := q.TaskQueue.Pop(ctx, func(id string, _ time.Time) error {
+		k := q.Redis.Key(deliveryKey, id)
+		delivery := &ttnpb.ApplicationWebhookDelivery{}
+		if err := ttnredis.GetProto(q.Redis, k).ScanProto(delivery); errors.IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		var retryAt time.Time
+		retryAt, ferr = f(ctx, delivery)
+		if !retryAt.IsZero() {
+			return q.Add(ctx, delivery, retryAt)
+		}
+		return ttnredis.ConvertError(q.Redis.Del(k).Err())
+	})
+	if err != nil {
+		return err
+	}
+	return ferr
+}
+
+func (q *DeliveryQueue) deadLetterKey(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) string {
+	return q.Redis.Key(deadLetterKey, unique.ID(ctx, ids.ApplicationIdentifiers), ids.WebhookID)
+}
+
+// AddDeadLetter implements web.DeliveryQueue.
+func (q *DeliveryQueue) AddDeadLetter(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery) error {
+	s, err := ttnredis.MarshalProto(delivery)
+	if err != nil {
+		return err
+	}
+	k := q.deadLetterKey(ctx, delivery.WebhookIDs)
+	_, err = q.Redis.Pipelined(func(p redis.Pipeliner) error {
+		p.LPush(k, s)
+		if q.DeadLetterLimit > 0 {
+			p.LTrim(k, 0, q.DeadLetterLimit-1)
+		}
+		return nil
+	})
+	return ttnredis.ConvertError(err)
+}
+
+func unmarshalDeliveries(ss []string) ([]*ttnpb.ApplicationWebhookDelivery, error) {
+	deliveries := make([]*ttnpb.ApplicationWebhookDelivery, 0, len(ss))
+	for _, s := range ss {
+		delivery := &ttnpb.ApplicationWebhookDelivery{}
+		if err := ttnredis.UnmarshalProto(s, delivery); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, nil
+}
+
+// ListDeadLetters implements web.DeliveryQueue.
+func (q *DeliveryQueue) ListDeadLetters(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, limit int) ([]*ttnpb.ApplicationWebhookDelivery, error) {
+	ss, err := q.Redis.LRange(q.deadLetterKey(ctx, ids), 0, int64(limit)-1).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return unmarshalDeliveries(ss)
+}
+
+// RemoveDeadLetters implements web.DeliveryQueue.
+func (q *DeliveryQueue) RemoveDeadLetters(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, deliveryIDs ...string) ([]*ttnpb.ApplicationWebhookDelivery, error) {
+	k := q.deadLetterKey(ctx, ids)
+	var removed []*ttnpb.ApplicationWebhookDelivery
+	err := q.Redis.Watch(func(tx *redis.Tx) error {
+		ss, err := tx.LRange(k, 0, -1).Result()
+		if err != nil {
+			return err
+		}
+		deliveries, err := unmarshalDeliveries(ss)
+		if err != nil {
+			return err
+		}
+		var toRemove []string
+		removed = removed[:0]
+		for i, delivery := range deliveries {
+			if len(deliveryIDs) > 0 && !containsString(deliveryIDs, delivery.ID) {
+				continue
+			}
+			toRemove = append(toRemove, ss[i])
+			removed = append(removed, delivery)
+		}
+		if len(toRemove) == 0 {
+			return nil
+		}
+		_, err = tx.Pipelined(func(p redis.Pipeliner) error {
+			for _, s := range toRemove {
+				p.LRem(k, 1, s)
+			}
+			return nil
+		})
+		return err
+	}, k)
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return removed, nil
+}
+
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
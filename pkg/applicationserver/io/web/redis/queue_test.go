@@ -0,0 +1,127 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestDeliveryQueue(t *testing.T) {
+	a := assertions.New(t)
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	cl, flush := test.NewRedis(t, "applicationserver_test")
+	defer flush()
+	defer cl.Close()
+	q := redis.NewDeliveryQueue(cl, 100, "test", "test-id", 2)
+	if !a.So(q.Init(), should.BeNil) {
+		t.FailNow()
+	}
+	go q.Run(ctx)
+
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		WebhookID:              "foo-hook",
+	}
+	newDelivery := func(id string) *ttnpb.ApplicationWebhookDelivery {
+		return &ttnpb.ApplicationWebhookDelivery{
+			ID:         id,
+			WebhookIDs: ids,
+			Message: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ids.ApplicationIdentifiers,
+					DeviceID:               "foo-device",
+				},
+				Up: &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &ttnpb.ApplicationUplink{FPort: 1}},
+			},
+			CreatedAt: time.Now().UTC(),
+		}
+	}
+
+	pop := func() *ttnpb.ApplicationWebhookDelivery {
+		ctx, cancel := context.WithTimeout(ctx, (1<<8)*test.Delay)
+		defer cancel()
+		var res *ttnpb.ApplicationWebhookDelivery
+		err := q.Pop(ctx, func(_ context.Context, delivery *ttnpb.ApplicationWebhookDelivery) (time.Time, error) {
+			res = delivery
+			return time.Time{}, nil
+		})
+		a.So(err, should.BeNil)
+		return res
+	}
+
+	// Deliveries are popped when due.
+	a.So(q.Add(ctx, newDelivery("1"), time.Now().Add((1<<5)*test.Delay)), should.BeNil)
+	a.So(q.Add(ctx, newDelivery("2"), time.Now()), should.BeNil)
+	if delivery := pop(); a.So(delivery, should.NotBeNil) {
+		a.So(delivery.ID, should.Equal, "2")
+	}
+	if delivery := pop(); a.So(delivery, should.NotBeNil) {
+		a.So(delivery.ID, should.Equal, "1")
+		a.So(delivery.Message.GetUplinkMessage().GetFPort(), should.Equal, 1)
+	}
+
+	// Deliveries that are scheduled again by f are kept with their modifications.
+	a.So(q.Add(ctx, newDelivery("6"), time.Now()), should.BeNil)
+	{
+		ctx, cancel := context.WithTimeout(ctx, (1<<8)*test.Delay)
+		err := q.Pop(ctx, func(_ context.Context, delivery *ttnpb.ApplicationWebhookDelivery) (time.Time, error) {
+			delivery.Attempts++
+			delivery.LastError = "failed"
+			return time.Now().Add((1 << 3) * test.Delay), nil
+		})
+		cancel()
+		a.So(err, should.BeNil)
+	}
+	if delivery := pop(); a.So(delivery, should.NotBeNil) {
+		a.So(delivery.ID, should.Equal, "6")
+		a.So(delivery.Attempts, should.Equal, 1)
+		a.So(delivery.LastError, should.Equal, "failed")
+	}
+
+	// Dead letters are capped.
+	for _, id := range []string{"3", "4", "5"} {
+		a.So(q.AddDeadLetter(ctx, newDelivery(id)), should.BeNil)
+	}
+	deliveries, err := q.ListDeadLetters(ctx, ids, 0)
+	a.So(err, should.BeNil)
+	if a.So(deliveries, should.HaveLength, 2) {
+		a.So(deliveries[0].ID, should.Equal, "5")
+		a.So(deliveries[1].ID, should.Equal, "4")
+	}
+
+	deliveries, err = q.RemoveDeadLetters(ctx, ids, "4")
+	a.So(err, should.BeNil)
+	if a.So(deliveries, should.HaveLength, 1) {
+		a.So(deliveries[0].ID, should.Equal, "4")
+	}
+	deliveries, err = q.RemoveDeadLetters(ctx, ids)
+	a.So(err, should.BeNil)
+	if a.So(deliveries, should.HaveLength, 1) {
+		a.So(deliveries[0].ID, should.Equal, "5")
+	}
+	deliveries, err = q.ListDeadLetters(ctx, ids, 0)
+	a.So(err, should.BeNil)
+	a.So(deliveries, should.BeEmpty)
+}
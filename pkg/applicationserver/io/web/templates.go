@@ -0,0 +1,255 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/fetch"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// TemplateStore contains the webhook templates.
+type TemplateStore interface {
+	// GetTemplate returns the template with the given identifiers.
+	GetTemplate(ctx context.Context, ids ttnpb.ApplicationWebhookTemplateIdentifiers) (*ttnpb.ApplicationWebhookTemplate, error)
+	// ListTemplates returns all templates.
+	ListTemplates(ctx context.Context) ([]*ttnpb.ApplicationWebhookTemplate, error)
+}
+
+const templatesFile = "templates.yml"
+
+var (
+	errFetchTemplate    = errors.Define("fetch_template", "failed to fetch file `{filename}`")
+	errParseTemplate    = errors.DefineCorruption("parse_template", "failed to parse template `{template_id}`")
+	errTemplateNotFound = errors.DefineNotFound("template_not_found", "template `{template_id}` not found")
+)
+
+type templateMessage struct {
+	Path string `yaml:"path"`
+}
+
+func (m *templateMessage) toPB() *ttnpb.ApplicationWebhook_Message {
+	if m == nil {
+		return nil
+	}
+	return &ttnpb.ApplicationWebhook_Message{
+		Path: m.Path,
+	}
+}
+
+type templateField struct {
+	ID           string `yaml:"id"`
+	Name         string `yaml:"name"`
+	Description  string `yaml:"description,omitempty"`
+	Secret       bool   `yaml:"secret,omitempty"`
+	DefaultValue string `yaml:"default_value,omitempty"`
+	Optional     bool   `yaml:"optional,omitempty"`
+}
+
+type webhookTemplate struct {
+	Name             string            `yaml:"name"`
+	Description      string            `yaml:"description,omitempty"`
+	LogoURL          string            `yaml:"logo_url,omitempty"`
+	InfoURL          string            `yaml:"info_url,omitempty"`
+	DocumentationURL string            `yaml:"documentation_url,omitempty"`
+	BaseURL          string            `yaml:"base_url"`
+	Headers          map[string]string `yaml:"headers,omitempty"`
+	Format           string            `yaml:"format"`
+	Fields           []templateField   `yaml:"fields,omitempty"`
+	Paths            struct {
+		UplinkMessage  *templateMessage `yaml:"uplink_message,omitempty"`
+		JoinAccept     *templateMessage `yaml:"join_accept,omitempty"`
+		DownlinkAck    *templateMessage `yaml:"downlink_ack,omitempty"`
+		DownlinkNack   *templateMessage `yaml:"downlink_nack,omitempty"`
+		DownlinkSent   *templateMessage `yaml:"downlink_sent,omitempty"`
+		DownlinkFailed *templateMessage `yaml:"downlink_failed,omitempty"`
+		DownlinkQueued *templateMessage `yaml:"downlink_queued,omitempty"`
+		LocationSolved *templateMessage `yaml:"location_solved,omitempty"`
+	} `yaml:"paths"`
+}
+
+func (t webhookTemplate) toPB(ids ttnpb.ApplicationWebhookTemplateIdentifiers) *ttnpb.ApplicationWebhookTemplate {
+	fields := make([]*ttnpb.ApplicationWebhookTemplateField, 0, len(t.Fields))
+	for _, f := range t.Fields {
+		fields = append(fields, &ttnpb.ApplicationWebhookTemplateField{
+			ID:           f.ID,
+			Name:         f.Name,
+			Description:  f.Description,
+			Secret:       f.Secret,
+			DefaultValue: f.DefaultValue,
+			Optional:     f.Optional,
+		})
+	}
+	return &ttnpb.ApplicationWebhookTemplate{
+		ApplicationWebhookTemplateIdentifiers: ids,
+		Name:                                  t.Name,
+		Description:                           t.Description,
+		LogoURL:                               t.LogoURL,
+		InfoURL:                               t.InfoURL,
+		DocumentationURL:                      t.DocumentationURL,
+		BaseURL:                               t.BaseURL,
+		Headers:                               t.Headers,
+		Format:                                t.Format,
+		Fields:                                fields,
+		UplinkMessage:                         t.Paths.UplinkMessage.toPB(),
+		JoinAccept:                            t.Paths.JoinAccept.toPB(),
+		DownlinkAck:                           t.Paths.DownlinkAck.toPB(),
+		DownlinkNack:                          t.Paths.DownlinkNack.toPB(),
+		DownlinkSent:                          t.Paths.DownlinkSent.toPB(),
+		DownlinkFailed:                        t.Paths.DownlinkFailed.toPB(),
+		DownlinkQueued:                        t.Paths.DownlinkQueued.toPB(),
+		LocationSolved:                        t.Paths.LocationSolved.toPB(),
+	}
+}
+
+type templateStore struct {
+	fetcher fetch.Interface
+}
+
+// NewTemplateStore returns a TemplateStore that fetches the templates using the given fetcher.
+// The fetcher provides a templates.yml file that lists the template IDs, and a <template_id>.yml file per template.
+func NewTemplateStore(fetcher fetch.Interface) TemplateStore {
+	return &templateStore{
+		fetcher: fetcher,
+	}
+}
+
+// GetTemplate implements TemplateStore.
+func (s *templateStore) GetTemplate(ctx context.Context, ids ttnpb.ApplicationWebhookTemplateIdentifiers) (*ttnpb.ApplicationWebhookTemplate, error) {
+	if err := ids.Validate(); err != nil {
+		return nil, err
+	}
+	templateIDs, err := s.templateIDs()
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range templateIDs {
+		if id == ids.TemplateID {
+			return s.template(ids)
+		}
+	}
+	return nil, errTemplateNotFound.WithAttributes("template_id", ids.TemplateID)
+}
+
+// ListTemplates implements TemplateStore.
+func (s *templateStore) ListTemplates(ctx context.Context) ([]*ttnpb.ApplicationWebhookTemplate, error) {
+	templateIDs, err := s.templateIDs()
+	if err != nil {
+		return nil, err
+	}
+	templates := make([]*ttnpb.ApplicationWebhookTemplate, 0, len(templateIDs))
+	for _, id := range templateIDs {
+		template, err := s.template(ttnpb.ApplicationWebhookTemplateIdentifiers{TemplateID: id})
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
+func (s *templateStore) templateIDs() ([]string, error) {
+	content, err := s.fetcher.File(templatesFile)
+	if err != nil {
+		return nil, errFetchTemplate.WithCause(err).WithAttributes("filename", templatesFile)
+	}
+	var ids []string
+	if err := yaml.Unmarshal(content, &ids); err != nil {
+		return nil, errParseTemplate.WithCause(err).WithAttributes("template_id", "")
+	}
+	return ids, nil
+}
+
+func (s *templateStore) template(ids ttnpb.ApplicationWebhookTemplateIdentifiers) (*ttnpb.ApplicationWebhookTemplate, error) {
+	filename := ids.TemplateID + ".yml"
+	content, err := s.fetcher.File(filename)
+	if err != nil {
+		return nil, errFetchTemplate.WithCause(err).WithAttributes("filename", filename)
+	}
+	var t webhookTemplate
+	if err := yaml.Unmarshal(content, &t); err != nil {
+		return nil, errParseTemplate.WithCause(err).WithAttributes("template_id", ids.TemplateID)
+	}
+	pb := t.toPB(ids)
+	if err := pb.Validate(); err != nil {
+		return nil, errParseTemplate.WithCause(err).WithAttributes("template_id", ids.TemplateID)
+	}
+	return pb, nil
+}
+
+var (
+	errTemplateFieldMissing = errors.DefineInvalidArgument("template_field_missing", "template field `{field_id}` is missing")
+	errTemplateFieldUnknown = errors.DefineInvalidArgument("template_field_unknown", "template field `{field_id}` is unknown")
+)
+
+// InstantiateTemplate expands the template into a webhook with the given identifiers.
+// The field values replace the {field_id} placeholders in the base URL, header values and paths of the template.
+// Fields that are not set take their default value. Fields without default value that are not optional must be set.
+func InstantiateTemplate(template *ttnpb.ApplicationWebhookTemplate, ids ttnpb.ApplicationWebhookIdentifiers, fields map[string]string) (*ttnpb.ApplicationWebhook, error) {
+	known := make(map[string]bool, len(template.Fields))
+	oldnew := make([]string, 0, 2*len(template.Fields))
+	for _, f := range template.Fields {
+		known[f.ID] = true
+		value, ok := fields[f.ID]
+		if !ok || value == "" {
+			value = f.DefaultValue
+		}
+		if value == "" && !f.Optional {
+			return nil, errTemplateFieldMissing.WithAttributes("field_id", f.ID)
+		}
+		oldnew = append(oldnew, "{"+f.ID+"}", value)
+	}
+	for id := range fields {
+		if !known[id] {
+			return nil, errTemplateFieldUnknown.WithAttributes("field_id", id)
+		}
+	}
+	replacer := strings.NewReplacer(oldnew...)
+	message := func(m *ttnpb.ApplicationWebhook_Message) *ttnpb.ApplicationWebhook_Message {
+		if m == nil {
+			return nil
+		}
+		return &ttnpb.ApplicationWebhook_Message{
+			Path: replacer.Replace(m.Path),
+		}
+	}
+	var headers map[string]string
+	if len(template.Headers) > 0 {
+		headers = make(map[string]string, len(template.Headers))
+		for key, val := range template.Headers {
+			headers[key] = replacer.Replace(val)
+		}
+	}
+	templateIDs := template.ApplicationWebhookTemplateIdentifiers
+	return &ttnpb.ApplicationWebhook{
+		ApplicationWebhookIdentifiers: ids,
+		TemplateIDs:                   &templateIDs,
+		BaseURL:                       replacer.Replace(template.BaseURL),
+		Headers:                       headers,
+		Format:                        template.Format,
+		UplinkMessage:                 message(template.UplinkMessage),
+		JoinAccept:                    message(template.JoinAccept),
+		DownlinkAck:                   message(template.DownlinkAck),
+		DownlinkNack:                  message(template.DownlinkNack),
+		DownlinkSent:                  message(template.DownlinkSent),
+		DownlinkFailed:                message(template.DownlinkFailed),
+		DownlinkQueued:                message(template.DownlinkQueued),
+		LocationSolved:                message(template.LocationSolved),
+	}, nil
+}
@@ -0,0 +1,258 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/fetch"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+var testTemplates = map[string][]byte{
+	"templates.yml": []byte(`- foo-integration
+- broken-integration
+`),
+	"foo-integration.yml": []byte(`name: Foo Integration
+description: Send messages to Foo
+logo_url: https://example.com/foo.svg
+base_url: https://api.example.com/{region}/apps/{app_key}
+headers:
+  Authorization: Bearer {token}
+format: json
+fields:
+  - id: region
+    name: Region
+    default_value: eu
+  - id: app_key
+    name: Application key
+  - id: token
+    name: Access token
+    secret: true
+  - id: tag
+    name: Tag
+    optional: true
+paths:
+  uplink_message:
+    path: /uplink?tag={tag}
+  join_accept:
+    path: /join
+`),
+}
+
+func TestTemplateStore(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	store := web.NewTemplateStore(fetch.NewMemFetcher(testTemplates))
+
+	template, err := store.GetTemplate(ctx, ttnpb.ApplicationWebhookTemplateIdentifiers{TemplateID: "foo-integration"})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(template.Name, should.Equal, "Foo Integration")
+	a.So(template.LogoURL, should.Equal, "https://example.com/foo.svg")
+	a.So(template.Fields, should.HaveLength, 4)
+	a.So(template.Fields[2].Secret, should.BeTrue)
+	a.So(template.UplinkMessage, should.Resemble, &ttnpb.ApplicationWebhook_Message{Path: "/uplink?tag={tag}"})
+	a.So(template.DownlinkAck, should.BeNil)
+
+	_, err = store.GetTemplate(ctx, ttnpb.ApplicationWebhookTemplateIdentifiers{TemplateID: "bar-integration"})
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	// The listed broken template has no file.
+	_, err = store.ListTemplates(ctx)
+	a.So(err, should.NotBeNil)
+
+	store = web.NewTemplateStore(fetch.NewMemFetcher(map[string][]byte{
+		"templates.yml":       []byte("- foo-integration\n"),
+		"foo-integration.yml": testTemplates["foo-integration.yml"],
+	}))
+	templates, err := store.ListTemplates(ctx)
+	a.So(err, should.BeNil)
+	if a.So(templates, should.HaveLength, 1) {
+		a.So(templates[0].TemplateID, should.Equal, "foo-integration")
+	}
+}
+
+func TestInstantiateTemplate(t *testing.T) {
+	store := web.NewTemplateStore(fetch.NewMemFetcher(testTemplates))
+	template, err := store.GetTemplate(test.Context(), ttnpb.ApplicationWebhookTemplateIdentifiers{TemplateID: "foo-integration"})
+	if err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              registeredWebhookID,
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		Fields         map[string]string
+		Expected       *ttnpb.ApplicationWebhook
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name: "Defaults",
+			Fields: map[string]string{
+				"app_key": "foo",
+				"token":   "secret",
+			},
+			Expected: &ttnpb.ApplicationWebhook{
+				ApplicationWebhookIdentifiers: ids,
+				TemplateIDs:                   &ttnpb.ApplicationWebhookTemplateIdentifiers{TemplateID: "foo-integration"},
+				BaseURL:                       "https://api.example.com/eu/apps/foo",
+				Headers: map[string]string{
+					"Authorization": "Bearer secret",
+				},
+				Format:        "json",
+				UplinkMessage: &ttnpb.ApplicationWebhook_Message{Path: "/uplink?tag="},
+				JoinAccept:    &ttnpb.ApplicationWebhook_Message{Path: "/join"},
+			},
+		},
+		{
+			Name: "All",
+			Fields: map[string]string{
+				"region":  "us",
+				"app_key": "foo",
+				"token":   "secret",
+				"tag":     "bar",
+			},
+			Expected: &ttnpb.ApplicationWebhook{
+				ApplicationWebhookIdentifiers: ids,
+				TemplateIDs:                   &ttnpb.ApplicationWebhookTemplateIdentifiers{TemplateID: "foo-integration"},
+				BaseURL:                       "https://api.example.com/us/apps/foo",
+				Headers: map[string]string{
+					"Authorization": "Bearer secret",
+				},
+				Format:        "json",
+				UplinkMessage: &ttnpb.ApplicationWebhook_Message{Path: "/uplink?tag=bar"},
+				JoinAccept:    &ttnpb.ApplicationWebhook_Message{Path: "/join"},
+			},
+		},
+		{
+			Name: "Missing",
+			Fields: map[string]string{
+				"app_key": "foo",
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "Unknown",
+			Fields: map[string]string{
+				"app_key": "foo",
+				"token":   "secret",
+				"other":   "value",
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			webhook, err := web.InstantiateTemplate(template, ids, tc.Fields)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(webhook, should.Resemble, tc.Expected)
+		})
+	}
+}
+
+func TestWebhookTemplatesRPC(t *testing.T) {
+	a := assertions.New(t)
+	ctx := newContextWithRightsFetcher(test.Context())
+	authorizedCtx := contextWithKey(ctx, registeredApplicationKey)
+
+	registry := &memWebhookRegistry{
+		webhooks: make(map[ttnpb.ApplicationWebhookIdentifiers]*ttnpb.ApplicationWebhook),
+	}
+	srv := web.NewWebhookRegistryRPC(registry, nil, web.NewTemplateStore(fetch.NewMemFetcher(testTemplates)))
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              registeredWebhookID,
+	}
+
+	// Get template.
+	{
+		res, err := srv.GetTemplate(ctx, &ttnpb.GetApplicationWebhookTemplateRequest{
+			ApplicationWebhookTemplateIdentifiers: ttnpb.ApplicationWebhookTemplateIdentifiers{TemplateID: "foo-integration"},
+			FieldMask: pbtypes.FieldMask{
+				Paths: []string{"name"},
+			},
+		})
+		a.So(err, should.BeNil)
+		a.So(res, should.Resemble, &ttnpb.ApplicationWebhookTemplate{
+			ApplicationWebhookTemplateIdentifiers: ttnpb.ApplicationWebhookTemplateIdentifiers{TemplateID: "foo-integration"},
+			Name:                                  "Foo Integration",
+		})
+	}
+
+	// Instantiate without rights.
+	{
+		_, err := srv.InstantiateTemplate(ctx, &ttnpb.InstantiateApplicationWebhookTemplateRequest{
+			WebhookIDs:  ids,
+			TemplateIDs: ttnpb.ApplicationWebhookTemplateIdentifiers{TemplateID: "foo-integration"},
+			Fields: map[string]string{
+				"app_key": "foo",
+				"token":   "secret",
+			},
+		})
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	}
+
+	// Instantiate over a suspended webhook.
+	{
+		registry.webhooks[ids] = &ttnpb.ApplicationWebhook{
+			ApplicationWebhookIdentifiers: ids,
+			BaseURL:                       "http://localhost/test",
+			Health: &ttnpb.ApplicationWebhookHealth{
+				FailedAttempts: 42,
+			},
+		}
+		res, err := srv.InstantiateTemplate(authorizedCtx, &ttnpb.InstantiateApplicationWebhookTemplateRequest{
+			WebhookIDs:  ids,
+			TemplateIDs: ttnpb.ApplicationWebhookTemplateIdentifiers{TemplateID: "foo-integration"},
+			Fields: map[string]string{
+				"app_key": "foo",
+				"token":   "secret",
+			},
+		})
+		if a.So(err, should.BeNil) {
+			a.So(res.BaseURL, should.Equal, "https://api.example.com/eu/apps/foo")
+			a.So(res.TemplateIDs, should.Resemble, &ttnpb.ApplicationWebhookTemplateIdentifiers{TemplateID: "foo-integration"})
+			a.So(res.Health, should.BeNil)
+		}
+		stored, err := registry.Get(ctx, ids, []string{"base_url", "headers"})
+		a.So(err, should.BeNil)
+		a.So(stored.BaseURL, should.Equal, "https://api.example.com/eu/apps/foo")
+		a.So(stored.Headers, should.Resemble, map[string]string{"Authorization": "Bearer secret"})
+	}
+
+	// Instantiate unknown template.
+	{
+		_, err := srv.InstantiateTemplate(authorizedCtx, &ttnpb.InstantiateApplicationWebhookTemplateRequest{
+			WebhookIDs:  ids,
+			TemplateIDs: ttnpb.ApplicationWebhookTemplateIdentifiers{TemplateID: "bar-integration"},
+		})
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	}
+}
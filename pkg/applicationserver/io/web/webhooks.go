@@ -24,6 +24,7 @@ import (
 	"path"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/labstack/echo"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
@@ -55,7 +56,10 @@ type HTTPClientSink struct {
 	*http.Client
 }
 
-var errRequest = errors.DefineUnavailable("request", "request failed with status `{code}`")
+var (
+	errRequest                = errors.DefineUnavailable("request", "request failed with status `{code}`")
+	errRequestUnauthenticated = errors.DefineUnauthenticated("request_unauthenticated", "request failed with status `{code}`")
+)
 
 // Process uses the HTTP client to perform the request.
 func (s *HTTPClientSink) Process(req *http.Request) error {
@@ -70,6 +74,9 @@ func (s *HTTPClientSink) Process(req *http.Request) error {
 	if res.StatusCode >= 200 && res.StatusCode <= 299 {
 		return nil
 	}
+	if res.StatusCode == http.StatusUnauthorized {
+		return errRequestUnauthenticated.WithAttributes("code", res.StatusCode)
+	}
 	return errRequest.WithAttributes("code", res.StatusCode)
 }
 
@@ -136,6 +143,8 @@ func (s *QueuedSink) Process(req *http.Request) error {
 type Webhooks interface {
 	ttnweb.Registerer
 	Registry() WebhookRegistry
+	// DeliveryQueue returns the delivery queue, if configured.
+	DeliveryQueue() DeliveryQueue
 	// NewSubscription returns a new webhooks integration subscription.
 	NewSubscription() *io.Subscription
 }
@@ -145,20 +154,33 @@ type webhooks struct {
 	server   io.Server
 	registry WebhookRegistry
 	target   Sink
+	queue    DeliveryQueue
+	delivery DeliveryConfig
+	tokens   tokenSources
 }
 
 // NewWebhooks returns a new Webhooks.
-func NewWebhooks(ctx context.Context, server io.Server, registry WebhookRegistry, target Sink) Webhooks {
+// If a delivery queue is configured, the delivery workers run until the context is done.
+func NewWebhooks(ctx context.Context, server io.Server, registry WebhookRegistry, target Sink, opts ...Option) Webhooks {
 	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/web")
-	return &webhooks{
+	w := &webhooks{
 		ctx:      ctx,
 		server:   server,
 		registry: registry,
 		target:   target,
 	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	if w.queue != nil {
+		w.runDeliveries(ctx)
+	}
+	return w
 }
 
-func (w *webhooks) Registry() WebhookRegistry { return w.registry }
+func (w *webhooks) Registry() WebhookRegistry { return tokenRegistry{w.registry, &w.tokens} }
+
+func (w *webhooks) DeliveryQueue() DeliveryQueue { return w.queue }
 
 // RegisterRoutes registers the webhooks to the web server to handle downlink requests.
 func (w *webhooks) RegisterRoutes(server *ttnweb.Server) {
@@ -287,15 +309,36 @@ func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error
 			"downlink_failed",
 			"downlink_queued",
 			"location_solved",
+			"health",
+			"signing",
+			"oauth2_client_credentials",
 		},
 	)
 	if err != nil {
 		return err
 	}
+	now := time.Now()
 	wg := sync.WaitGroup{}
 	for i := range hooks {
 		hook := hooks[i]
 		logger := log.FromContext(ctx).WithField("hook", hook.WebhookID)
+		if w.delivery.isSuspended(hook.Health, now) {
+			logger.Debug("Webhook is suspended, drop message")
+			continue
+		}
+		if w.queue != nil {
+			if messageConfig(hook, msg) == nil {
+				continue
+			}
+			delivery, err := newDelivery(hook.ApplicationWebhookIdentifiers, msg)
+			if err == nil {
+				err = w.queue.Add(ctx, delivery, time.Time{})
+			}
+			if err != nil {
+				logger.WithError(err).Warn("Failed to queue message")
+			}
+			continue
+		}
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
@@ -307,8 +350,12 @@ func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error
 			if req == nil {
 				return
 			}
+			if err := w.authorize(hook, req); err != nil {
+				logger.WithError(err).Warn("Failed to authorize request")
+				return
+			}
 			logger.WithField("url", req.URL).Debug("Processing message")
-			if err := w.target.Process(req); err != nil {
+			if err := w.process(hook, req); err != nil {
 				logger.WithError(err).Warn("Failed to process message")
 			}
 		}()
@@ -317,26 +364,32 @@ func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error
 	return nil
 }
 
-func (w *webhooks) newRequest(ctx context.Context, msg *ttnpb.ApplicationUp, hook *ttnpb.ApplicationWebhook) (*http.Request, error) {
-	var cfg *ttnpb.ApplicationWebhook_Message
+// messageConfig returns the configuration of the webhook for the type of the message.
+// This function returns nil if the webhook is not configured for the type of the message.
+func messageConfig(hook *ttnpb.ApplicationWebhook, msg *ttnpb.ApplicationUp) *ttnpb.ApplicationWebhook_Message {
 	switch msg.Up.(type) {
 	case *ttnpb.ApplicationUp_UplinkMessage:
-		cfg = hook.UplinkMessage
+		return hook.UplinkMessage
 	case *ttnpb.ApplicationUp_JoinAccept:
-		cfg = hook.JoinAccept
+		return hook.JoinAccept
 	case *ttnpb.ApplicationUp_DownlinkAck:
-		cfg = hook.DownlinkAck
+		return hook.DownlinkAck
 	case *ttnpb.ApplicationUp_DownlinkNack:
-		cfg = hook.DownlinkNack
+		return hook.DownlinkNack
 	case *ttnpb.ApplicationUp_DownlinkSent:
-		cfg = hook.DownlinkSent
+		return hook.DownlinkSent
 	case *ttnpb.ApplicationUp_DownlinkFailed:
-		cfg = hook.DownlinkFailed
+		return hook.DownlinkFailed
 	case *ttnpb.ApplicationUp_DownlinkQueued:
-		cfg = hook.DownlinkQueued
+		return hook.DownlinkQueued
 	case *ttnpb.ApplicationUp_LocationSolved:
-		cfg = hook.LocationSolved
+		return hook.LocationSolved
 	}
+	return nil
+}
+
+func (w *webhooks) newRequest(ctx context.Context, msg *ttnpb.ApplicationUp, hook *ttnpb.ApplicationWebhook) (*http.Request, error) {
+	cfg := messageConfig(hook, msg)
 	if cfg == nil {
 		return nil, nil
 	}
@@ -362,6 +415,9 @@ func (w *webhooks) newRequest(ctx context.Context, msg *ttnpb.ApplicationUp, hoo
 	}
 	req.Header.Set("Content-Type", format.ContentType)
 	req.Header.Set("User-Agent", userAgent)
+	if hook.Signing != nil {
+		sign(req, hook.Signing, buf, time.Now())
+	}
 	return req, nil
 }
 
@@ -80,6 +80,7 @@ type link struct {
 	subscribeCh   chan *io.Subscription
 	unsubscribeCh chan *io.Subscription
 	upCh          chan *ttnpb.ApplicationUp
+	packagesCh    chan *ttnpb.ApplicationUp
 }
 
 const linkBufferSize = 10
@@ -139,6 +140,7 @@ func (as *ApplicationServer) link(ctx context.Context, ids ttnpb.ApplicationIden
 		subscribeCh:     make(chan *io.Subscription, 1),
 		unsubscribeCh:   make(chan *io.Subscription, 1),
 		upCh:            make(chan *ttnpb.ApplicationUp, linkBufferSize),
+		packagesCh:      make(chan *ttnpb.ApplicationUp, linkBufferSize),
 	}
 	if _, loaded := as.links.LoadOrStore(uid, l); loaded {
 		cancel()
@@ -162,6 +164,7 @@ func (as *ApplicationServer) link(ctx context.Context, ids ttnpb.ApplicationIden
 	logger.Info("Linked")
 
 	go l.run()
+	go as.runPackages(l)
 	for _, sub := range as.defaultSubscribers {
 		sub := sub
 		l.subscribeCh <- sub
@@ -199,6 +202,13 @@ func (as *ApplicationServer) link(ctx context.Context, ids ttnpb.ApplicationIden
 			registerDropUp(ctx, up, err)
 			continue
 		}
+		if _, ok := as.packages.Handler(up); ok {
+			select {
+			case l.packagesCh <- up:
+			default:
+				logger.Warn("Application layer package queue full, drop message")
+			}
+		}
 
 		switch p := up.Up.(type) {
 		case *ttnpb.ApplicationUp_JoinAccept:
@@ -235,6 +245,22 @@ func (as *ApplicationServer) getLink(ctx context.Context, ids ttnpb.ApplicationI
 	return val.(*link), nil
 }
 
+// runPackages passes the queued upstream messages of the link to the application layer package handlers.
+// The handlers may push downlink messages to the Network Server, so they do not block receiving upstream messages.
+func (as *ApplicationServer) runPackages(l *link) {
+	for {
+		select {
+		case <-l.ctx.Done():
+			return
+		case up := <-l.packagesCh:
+			ctx := events.ContextWithCorrelationID(l.ctx, up.CorrelationIDs...)
+			if err := as.packages.HandleUp(ctx, up); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to handle application layer package message")
+			}
+		}
+	}
+}
+
 func (l *link) run() {
 	subscribers := make(map[*io.Subscription]string)
 	for {
@@ -41,6 +41,7 @@ var (
 	evtReceiveDataDown      = events.Define("as.down.data.receive", "receive downlink data message")
 	evtDropDataDown         = events.Define("as.down.data.drop", "drop downlink data message")
 	evtForwardDataDown      = events.Define("as.down.data.forward", "forward downlink data message")
+	evtExpireDataDown       = events.Define("as.down.data.expire", "expire downlink data message")
 	evtLostQueueDataDown    = events.Define("as.down.data.queue.lost", "lost downlink data queue")
 	evtInvalidQueueDataDown = events.Define("as.down.data.queue.invalid", "invalid downlink data queue")
 )
@@ -227,3 +228,8 @@ func registerDropDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, m
 		asMetrics.downlinkDropped.WithLabelValues(ctx, unknown).Inc()
 	}
 }
+
+func registerExpireDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, msg *ttnpb.ApplicationDownlink) {
+	events.Publish(evtExpireDataDown(ctx, ids, nil))
+	asMetrics.downlinkDropped.WithLabelValues(ctx, errDownlinkExpired.String()).Inc()
+}
@@ -0,0 +1,161 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package clocksync implements the LoRa Alliance Application Layer Clock Synchronization package.
+package clocksync
+
+import (
+	"context"
+	"encoding/binary"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/packages"
+	"go.thethings.network/lorawan-stack/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+const (
+	// FPort is the FPort of the package.
+	FPort = 202
+	// PackageIdentifier is the identifier of the package.
+	PackageIdentifier = 1
+	// PackageVersion is the version of the package.
+	PackageVersion = 1
+)
+
+// Command identifiers.
+const (
+	AppTimeCID                  = 0x01
+	DeviceAppTimePeriodicityCID = 0x02
+	ForceDeviceResyncCID        = 0x03
+)
+
+var uplinkLengths = packages.FixedLengths(map[byte]int{
+	packages.PackageVersionCID:  2,
+	AppTimeCID:                  5,
+	DeviceAppTimePeriodicityCID: 5,
+})
+
+// Handler handles the Clock Synchronization package.
+// It answers AppTimeReq commands of end devices automatically.
+type Handler struct {
+	server packages.Server
+}
+
+// New returns a new Handler that queues downlink messages on server.
+func New(server packages.Server) *Handler {
+	return &Handler{
+		server: server,
+	}
+}
+
+// FPort implements packages.Handler.
+func (h *Handler) FPort() uint32 { return FPort }
+
+// receivedAt returns the earliest gateway time at which up was received.
+// If no gateway provided the time, the current time is returned.
+func receivedAt(up *ttnpb.ApplicationUplink) time.Time {
+	var t *time.Time
+	for _, md := range up.RxMetadata {
+		if md.Time != nil && (t == nil || md.Time.Before(*t)) {
+			t = md.Time
+		}
+	}
+	if t == nil {
+		return time.Now()
+	}
+	return *t
+}
+
+// AppTimeAns returns the AppTimeAns command that answers an AppTimeReq with deviceTime and token,
+// received at the given time. It also returns the time correction in seconds.
+func AppTimeAns(deviceTime uint32, token byte, receivedAt time.Time) ([]byte, int32) {
+	correction := int32(uint32(gpstime.ToGPS(receivedAt)) - deviceTime)
+	b := make([]byte, 6)
+	b[0] = AppTimeCID
+	binary.LittleEndian.PutUint32(b[1:], uint32(correction))
+	b[5] = token & 0x0f
+	return b, correction
+}
+
+// DeviceAppTimePeriodicityReq returns the DeviceAppTimePeriodicityReq command that requests the end device to
+// synchronize its clock every 128*2^period seconds.
+func DeviceAppTimePeriodicityReq(period byte) []byte {
+	return []byte{DeviceAppTimePeriodicityCID, period & 0x0f}
+}
+
+// ForceDeviceResyncReq returns the ForceDeviceResyncReq command that requests the end device to synchronize its clock
+// with nbTransmissions AppTimeReq commands.
+func ForceDeviceResyncReq(nbTransmissions byte) []byte {
+	return []byte{ForceDeviceResyncCID, nbTransmissions & 0x07}
+}
+
+// HandleUp implements packages.Handler.
+func (h *Handler) HandleUp(ctx context.Context, up *ttnpb.ApplicationUp) error {
+	uplink, ok := packages.Uplink(up)
+	if !ok {
+		return nil
+	}
+	logger := log.FromContext(ctx).WithField("package", "clocksync")
+	cmds, err := packages.ReadCommands(uplink.FRMPayload, uplinkLengths)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to read commands")
+	}
+	var ans []byte
+	for _, cmd := range cmds {
+		switch cmd.CID {
+		case packages.PackageVersionCID:
+			logger.WithFields(log.Fields(
+				"package_identifier", cmd.Payload[0],
+				"package_version", cmd.Payload[1],
+			)).Debug("Received PackageVersionAns")
+		case AppTimeCID:
+			deviceTime := binary.LittleEndian.Uint32(cmd.Payload)
+			ansRequired := cmd.Payload[4]&0x10 != 0
+			b, correction := AppTimeAns(deviceTime, cmd.Payload[4]&0x0f, receivedAt(uplink))
+			logger.WithFields(log.Fields(
+				"device_time", deviceTime,
+				"correction", correction,
+			)).Debug("Received AppTimeReq")
+			if correction != 0 || ansRequired {
+				ans = append(ans, b...)
+			}
+		case DeviceAppTimePeriodicityCID:
+			logger.WithFields(log.Fields(
+				"not_supported", cmd.Payload[0]&0x01 != 0,
+				"device_time", binary.LittleEndian.Uint32(cmd.Payload[1:]),
+			)).Debug("Received DeviceAppTimePeriodicityAns")
+		}
+	}
+	if len(ans) == 0 {
+		return nil
+	}
+	return h.server.DownlinkQueuePush(ctx, up.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink{
+		{
+			FPort:      FPort,
+			FRMPayload: ans,
+		},
+	})
+}
+
+// ForceDeviceResync requests the end device to synchronize its clock with nbTransmissions AppTimeReq commands.
+func (h *Handler) ForceDeviceResync(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, nbTransmissions byte) error {
+	return h.server.DownlinkQueuePush(ctx, ids, []*ttnpb.ApplicationDownlink{
+		{
+			FPort:      FPort,
+			FRMPayload: ForceDeviceResyncReq(nbTransmissions),
+		},
+	})
+}
@@ -0,0 +1,116 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clocksync_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/packages/clocksync"
+	"go.thethings.network/lorawan-stack/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+type mockServer struct {
+	pushed []*ttnpb.ApplicationDownlink
+}
+
+func (s *mockServer) DownlinkQueuePush(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) error {
+	s.pushed = append(s.pushed, items...)
+	return nil
+}
+
+func TestAppTimeAns(t *testing.T) {
+	a := assertions.New(t)
+	receivedAt := gpstime.Parse(1234567890)
+
+	b, correction := clocksync.AppTimeAns(1234567880, 0x13, receivedAt)
+	a.So(correction, should.Equal, 10)
+	a.So(b, should.Resemble, []byte{0x01, 0x0a, 0x00, 0x00, 0x00, 0x03})
+
+	b, correction = clocksync.AppTimeAns(1234567900, 0x02, receivedAt)
+	a.So(correction, should.Equal, -10)
+	a.So(b, should.Resemble, []byte{0x01, 0xf6, 0xff, 0xff, 0xff, 0x02})
+}
+
+func TestHandleUp(t *testing.T) {
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	receivedAt := gpstime.Parse(1234567890)
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		DeviceID:               "foo-device",
+	}
+	up := func(payload []byte) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: ids,
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					FPort:      clocksync.FPort,
+					FRMPayload: payload,
+					RxMetadata: []*ttnpb.RxMetadata{
+						{Time: &receivedAt},
+					},
+				},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		Name    string
+		Payload []byte
+		Pushed  []*ttnpb.ApplicationDownlink
+	}{
+		{
+			Name:    "InSync",
+			Payload: []byte{0x01, 0xd2, 0x02, 0x96, 0x49, 0x01},
+		},
+		{
+			Name:    "InSyncAnsRequired",
+			Payload: []byte{0x01, 0xd2, 0x02, 0x96, 0x49, 0x11},
+			Pushed: []*ttnpb.ApplicationDownlink{
+				{
+					FPort:      clocksync.FPort,
+					FRMPayload: []byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x01},
+				},
+			},
+		},
+		{
+			Name:    "OutOfSync",
+			Payload: []byte{0x01, 0xc8, 0x02, 0x96, 0x49, 0x02},
+			Pushed: []*ttnpb.ApplicationDownlink{
+				{
+					FPort:      clocksync.FPort,
+					FRMPayload: []byte{0x01, 0x0a, 0x00, 0x00, 0x00, 0x02},
+				},
+			},
+		},
+		{
+			Name:    "PackageVersionAns",
+			Payload: []byte{0x00, 0x01, 0x01},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			server := &mockServer{}
+			err := clocksync.New(server).HandleUp(ctx, up(tc.Payload))
+			a.So(err, should.BeNil)
+			a.So(server.pushed, should.Resemble, tc.Pushed)
+		})
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentation
+
+import (
+	"encoding/binary"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+// maxFragments is the maximum number of fragments, as the fragment number is encoded in 14 bits.
+const maxFragments = 1<<14 - 1
+
+var (
+	errFragSize     = errors.DefineInvalidArgument("frag_size", "invalid fragment size `{frag_size}`")
+	errNoData       = errors.DefineInvalidArgument("no_data", "no data")
+	errTooManyFrags = errors.DefineInvalidArgument("too_many_fragments", "too many fragments `{count}`")
+)
+
+// prbs23 returns the next value of the 23-bit pseudo-random binary sequence after x.
+func prbs23(x uint32) uint32 {
+	b0 := x & 1
+	b1 := (x & 32) >> 5
+	return (x >> 1) + ((b0 ^ b1) << 22)
+}
+
+// matrixLine returns the line of the parity check matrix of coded fragment n (starting at 1),
+// which indicates the uncoded fragments that are XOR-ed in the coded fragment.
+func matrixLine(n, m int) []bool {
+	line := make([]bool, m)
+	var pow2 int
+	if m&(m-1) == 0 {
+		pow2 = 1
+	}
+	x := uint32(1 + 1001*n)
+	for nbCoeff := 0; nbCoeff < m/2; nbCoeff++ {
+		r := 1 << 16
+		for r >= m {
+			x = prbs23(x)
+			r = int(x % uint32(m+pow2))
+		}
+		line[r] = true
+	}
+	return line
+}
+
+// fragmentCount returns the number of uncoded fragments of fragSize bytes of a data block of the given size, and the
+// number of padding bytes in the last uncoded fragment.
+func fragmentCount(size, fragSize, redundancy int) (m, padding int, err error) {
+	if fragSize <= 0 || fragSize > 255 {
+		return 0, 0, errFragSize.WithAttributes("frag_size", fragSize)
+	}
+	if size <= 0 {
+		return 0, 0, errNoData
+	}
+	m = (size + fragSize - 1) / fragSize
+	if m+redundancy > maxFragments {
+		return 0, 0, errTooManyFrags.WithAttributes("count", m+redundancy)
+	}
+	return m, m*fragSize - size, nil
+}
+
+// splitFragments splits the padded data in uncoded fragments of fragSize bytes.
+func splitFragments(padded []byte, fragSize int) [][]byte {
+	frags := make([][]byte, 0, len(padded)/fragSize)
+	for i := 0; i+fragSize <= len(padded); i += fragSize {
+		frags = append(frags, padded[i:i+fragSize])
+	}
+	return frags
+}
+
+// CodedFragment returns coded fragment n (starting at 1) of the uncoded fragments frags.
+func CodedFragment(frags [][]byte, n int) []byte {
+	if len(frags) == 0 {
+		return nil
+	}
+	frag := make([]byte, len(frags[0]))
+	for i, set := range matrixLine(n, len(frags)) {
+		if !set {
+			continue
+		}
+		for j := range frag {
+			frag[j] ^= frags[i][j]
+		}
+	}
+	return frag
+}
+
+// Fragment splits data in uncoded fragments of fragSize bytes, followed by redundancy coded fragments.
+// The last uncoded fragment is padded with zeros; the number of padding bytes is returned.
+func Fragment(data []byte, fragSize, redundancy int) (frags [][]byte, padding int, err error) {
+	m, padding, err := fragmentCount(len(data), fragSize, redundancy)
+	if err != nil {
+		return nil, 0, err
+	}
+	padded := make([]byte, m*fragSize)
+	copy(padded, data)
+	frags = splitFragments(padded, fragSize)
+	for n := 1; n <= redundancy; n++ {
+		frags = append(frags, CodedFragment(frags[:m], n))
+	}
+	return frags, padding, nil
+}
+
+// FragSessionSetupReq is the request to set up a fragmentation session in an end device.
+type FragSessionSetupReq struct {
+	FragIndex      uint8
+	McGroupBitMask uint8
+	NbFrag         uint16
+	FragSize       uint8
+	FragAlgo       uint8
+	BlockAckDelay  uint8
+	Padding        uint8
+	Descriptor     uint32
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (req FragSessionSetupReq) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 11)
+	b[0] = FragSessionSetupCID
+	b[1] = (req.FragIndex&0x03)<<4 | req.McGroupBitMask&0x0f
+	binary.LittleEndian.PutUint16(b[2:4], req.NbFrag)
+	b[4] = req.FragSize
+	b[5] = (req.FragAlgo&0x07)<<3 | req.BlockAckDelay&0x07
+	b[6] = req.Padding
+	binary.LittleEndian.PutUint32(b[7:11], req.Descriptor)
+	return b, nil
+}
+
+// FragSessionStatusReq returns the FragSessionStatusReq command that requests the status of the session with the
+// given index. If allParticipants is false, only end devices that miss fragments answer.
+func FragSessionStatusReq(fragIndex uint8, allParticipants bool) []byte {
+	b := []byte{FragSessionStatusCID, (fragIndex & 0x03) << 1}
+	if allParticipants {
+		b[1] |= 0x01
+	}
+	return b
+}
+
+// FragSessionDeleteReq returns the FragSessionDeleteReq command that deletes the session with the given index.
+func FragSessionDeleteReq(fragIndex uint8) []byte {
+	return []byte{FragSessionDeleteCID, fragIndex & 0x03}
+}
+
+// DataFragment returns the DataFragment command that carries fragment n (starting at 1) of the session with the
+// given index.
+func DataFragment(fragIndex uint8, n uint16, frag []byte) []byte {
+	b := make([]byte, 3, 3+len(frag))
+	b[0] = DataFragmentCID
+	binary.LittleEndian.PutUint16(b[1:3], uint16(fragIndex&0x03)<<14|n&maxFragments)
+	return append(b, frag...)
+}
+
+// parseDataFragment returns the session index and the fragment number of the DataFragment command in b.
+func parseDataFragment(b []byte) (fragIndex uint8, n uint16, ok bool) {
+	if len(b) < 3 || b[0] != DataFragmentCID {
+		return 0, 0, false
+	}
+	indexAndN := binary.LittleEndian.Uint16(b[1:3])
+	return uint8(indexAndN >> 14), indexAndN & maxFragments, true
+}
@@ -0,0 +1,359 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fragmentation implements the LoRa Alliance Fragmented Data Block Transport package.
+//
+// The Application Server drives a fragmentation session from a data block that is stored in a blob bucket. Once the
+// end device accepts the session setup, the fragments are queued in a window of downlink messages; every sent
+// fragment queues the next one, until all uncoded and coded fragments are sent.
+package fragmentation
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/packages"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"gocloud.dev/blob"
+)
+
+const (
+	// FPort is the FPort of the package.
+	FPort = 201
+	// PackageIdentifier is the identifier of the package.
+	PackageIdentifier = 3
+	// PackageVersion is the version of the package.
+	PackageVersion = 1
+)
+
+// Command identifiers.
+const (
+	FragSessionStatusCID = 0x01
+	FragSessionSetupCID  = 0x02
+	FragSessionDeleteCID = 0x03
+	DataFragmentCID      = 0x08
+)
+
+// window is the number of fragments that are queued at the same time.
+const window = 4
+
+var uplinkLengths = packages.FixedLengths(map[byte]int{
+	packages.PackageVersionCID: 2,
+	FragSessionStatusCID:       4,
+	FragSessionSetupCID:        1,
+	FragSessionDeleteCID:       1,
+})
+
+var (
+	errSessionNotFound = errors.DefineNotFound("session_not_found", "fragmentation session `{frag_index}` not found")
+	errSessionExists   = errors.DefineAlreadyExists("session_exists", "fragmentation session `{frag_index}` already exists")
+)
+
+// Registry is a store for fragmentation sessions.
+type Registry interface {
+	// Get returns the fragmentation session by the end device identifiers and the session index.
+	Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, fragIndex uint32, paths []string) (*ttnpb.FragmentationSession, error)
+	// Set creates, updates or deletes the fragmentation session by the end device identifiers and the session index.
+	Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, fragIndex uint32, paths []string, f func(*ttnpb.FragmentationSession) (*ttnpb.FragmentationSession, []string, error)) (*ttnpb.FragmentationSession, error)
+}
+
+// BucketFunc returns the blob bucket by its name.
+type BucketFunc func(ctx context.Context, name string) (*blob.Bucket, error)
+
+// Handler handles the Fragmented Data Block Transport package.
+type Handler struct {
+	server   packages.Server
+	registry Registry
+	bucket   BucketFunc
+}
+
+// New returns a new Handler that queues downlink messages on server, stores the sessions in registry and reads the
+// data blocks from the buckets returned by bucket.
+func New(server packages.Server, registry Registry, bucket BucketFunc) *Handler {
+	return &Handler{
+		server:   server,
+		registry: registry,
+		bucket:   bucket,
+	}
+}
+
+// FPort implements packages.Handler.
+func (h *Handler) FPort() uint32 { return FPort }
+
+// readFragments returns at most n fragments of the session, starting at fragment i (starting at 0).
+// Uncoded fragments are read from the part of the data block that they cover. Coded fragments are computed from the
+// uncoded fragments of the whole data block.
+func (h *Handler) readFragments(ctx context.Context, session *ttnpb.FragmentationSession, i, n int) ([][]byte, error) {
+	m, fragSize := int(session.NbFrag), int(session.FragSize)
+	if total := m + int(session.Redundancy); i+n > total {
+		n = total - i
+	}
+	if n <= 0 {
+		return nil, nil
+	}
+	bucket, err := h.bucket(ctx, session.Bucket)
+	if err != nil {
+		return nil, err
+	}
+	if i+n <= m {
+		r, err := bucket.NewRangeReader(ctx, session.Key, int64(i*fragSize), int64(n*fragSize), nil)
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		padded := make([]byte, n*fragSize)
+		if _, err := io.ReadFull(r, padded); err != nil && err != io.ErrUnexpectedEOF {
+			return nil, err
+		}
+		return splitFragments(padded, fragSize), nil
+	}
+	data, err := bucket.ReadAll(ctx, session.Key)
+	if err != nil {
+		return nil, err
+	}
+	uncoded, _, err := Fragment(data, fragSize, 0)
+	if err != nil {
+		return nil, err
+	}
+	frags := make([][]byte, 0, n)
+	for j := i; j < i+n; j++ {
+		if j < len(uncoded) {
+			frags = append(frags, uncoded[j])
+		} else {
+			frags = append(frags, CodedFragment(uncoded, j-len(uncoded)+1))
+		}
+	}
+	return frags, nil
+}
+
+func (h *Handler) push(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, payloads ...[]byte) error {
+	items := make([]*ttnpb.ApplicationDownlink, 0, len(payloads))
+	for _, b := range payloads {
+		items = append(items, &ttnpb.ApplicationDownlink{
+			FPort:      FPort,
+			FRMPayload: b,
+		})
+	}
+	return h.server.DownlinkQueuePush(ctx, ids, items)
+}
+
+var sessionSetPaths = []string{
+	"bucket",
+	"key",
+	"frag_size",
+	"nb_frag",
+	"padding",
+	"redundancy",
+	"mc_group_bit_mask",
+	"block_ack_delay",
+	"data_descriptor",
+	"state",
+	"last_fragment",
+}
+
+// StartSession stores the fragmentation session and requests the end device to set up the session.
+// The number of fragments and the padding are computed from the data block.
+func (h *Handler) StartSession(ctx context.Context, session *ttnpb.FragmentationSession) error {
+	if err := session.Validate(); err != nil {
+		return err
+	}
+	bucket, err := h.bucket(ctx, session.Bucket)
+	if err != nil {
+		return err
+	}
+	attrs, err := bucket.Attributes(ctx, session.Key)
+	if err != nil {
+		return err
+	}
+	m, padding, err := fragmentCount(int(attrs.Size), int(session.FragSize), int(session.Redundancy))
+	if err != nil {
+		return err
+	}
+	session.NbFrag = uint32(m)
+	session.Padding = uint32(padding)
+	session.State = ttnpb.FragmentationSession_SETUP
+	session.LastFragment = 0
+	if _, err := h.registry.Set(ctx, session.EndDeviceIdentifiers, session.FragIndex, sessionSetPaths,
+		func(stored *ttnpb.FragmentationSession) (*ttnpb.FragmentationSession, []string, error) {
+			if stored != nil && stored.State != ttnpb.FragmentationSession_DONE && stored.State != ttnpb.FragmentationSession_FAILED {
+				return nil, nil, errSessionExists.WithAttributes("frag_index", session.FragIndex)
+			}
+			return session, sessionSetPaths, nil
+		},
+	); err != nil {
+		return err
+	}
+	req, err := FragSessionSetupReq{
+		FragIndex:      uint8(session.FragIndex),
+		McGroupBitMask: uint8(session.McGroupBitMask),
+		NbFrag:         uint16(session.NbFrag),
+		FragSize:       uint8(session.FragSize),
+		BlockAckDelay:  uint8(session.BlockAckDelay),
+		Padding:        uint8(session.Padding),
+		Descriptor:     session.DataDescriptor,
+	}.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return h.push(ctx, session.EndDeviceIdentifiers, req)
+}
+
+// DeleteSession requests the end device to delete the fragmentation session and deletes it from the registry.
+func (h *Handler) DeleteSession(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, fragIndex uint32) error {
+	if _, err := h.registry.Set(ctx, ids, fragIndex, nil,
+		func(stored *ttnpb.FragmentationSession) (*ttnpb.FragmentationSession, []string, error) {
+			if stored == nil {
+				return nil, nil, errSessionNotFound.WithAttributes("frag_index", fragIndex)
+			}
+			return nil, nil, nil
+		},
+	); err != nil {
+		return err
+	}
+	return h.push(ctx, ids, FragSessionDeleteReq(uint8(fragIndex)))
+}
+
+// RequestStatus requests the end devices to report the status of the fragmentation session.
+func (h *Handler) RequestStatus(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, fragIndex uint32, allParticipants bool) error {
+	return h.push(ctx, ids, FragSessionStatusReq(uint8(fragIndex), allParticipants))
+}
+
+// queueFragments queues at most n fragments after the last queued fragment of the session.
+// Only the queued fragments are read or computed.
+func (h *Handler) queueFragments(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, fragIndex uint32, n int) error {
+	var payloads [][]byte
+	_, err := h.registry.Set(ctx, ids, fragIndex, []string{"bucket", "key", "frag_size", "nb_frag", "redundancy", "state", "last_fragment"},
+		func(stored *ttnpb.FragmentationSession) (*ttnpb.FragmentationSession, []string, error) {
+			if stored == nil {
+				return nil, nil, errSessionNotFound.WithAttributes("frag_index", fragIndex)
+			}
+			if stored.State != ttnpb.FragmentationSession_TRANSMIT {
+				return stored, nil, nil
+			}
+			frags, err := h.readFragments(ctx, stored, int(stored.LastFragment), n)
+			if err != nil {
+				return nil, nil, err
+			}
+			payloads = payloads[:0]
+			for i, frag := range frags {
+				payloads = append(payloads, DataFragment(uint8(fragIndex), uint16(int(stored.LastFragment)+i+1), frag))
+			}
+			stored.LastFragment += uint32(len(payloads))
+			return stored, []string{"last_fragment"}, nil
+		},
+	)
+	if err != nil || len(payloads) == 0 {
+		return err
+	}
+	return h.push(ctx, ids, payloads...)
+}
+
+// setState sets the state of the fragmentation session.
+func (h *Handler) setState(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, fragIndex uint32, state ttnpb.FragmentationSession_SessionState) error {
+	_, err := h.registry.Set(ctx, ids, fragIndex, []string{"state"},
+		func(stored *ttnpb.FragmentationSession) (*ttnpb.FragmentationSession, []string, error) {
+			if stored == nil {
+				return nil, nil, errSessionNotFound.WithAttributes("frag_index", fragIndex)
+			}
+			stored.State = state
+			return stored, []string{"state"}, nil
+		},
+	)
+	return err
+}
+
+// HandleUp implements packages.Handler.
+func (h *Handler) HandleUp(ctx context.Context, up *ttnpb.ApplicationUp) error {
+	logger := log.FromContext(ctx).WithField("package", "fragmentation")
+	switch p := up.Up.(type) {
+	case *ttnpb.ApplicationUp_DownlinkSent:
+		return h.handleDownlinkSent(ctx, up.EndDeviceIdentifiers, p.DownlinkSent)
+	case *ttnpb.ApplicationUp_UplinkMessage:
+		cmds, err := packages.ReadCommands(p.UplinkMessage.FRMPayload, uplinkLengths)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to read commands")
+		}
+		for _, cmd := range cmds {
+			switch cmd.CID {
+			case packages.PackageVersionCID:
+				logger.WithFields(log.Fields(
+					"package_identifier", cmd.Payload[0],
+					"package_version", cmd.Payload[1],
+				)).Debug("Received PackageVersionAns")
+			case FragSessionSetupCID:
+				fragIndex := uint32(cmd.Payload[0] >> 6)
+				status := cmd.Payload[0] & 0x0f
+				logger := logger.WithFields(log.Fields(
+					"frag_index", fragIndex,
+					"status", status,
+				))
+				if status != 0 {
+					logger.Warn("Fragmentation session setup rejected")
+					if err := h.setState(ctx, up.EndDeviceIdentifiers, fragIndex, ttnpb.FragmentationSession_FAILED); err != nil {
+						return err
+					}
+					continue
+				}
+				logger.Debug("Fragmentation session setup accepted")
+				if err := h.setState(ctx, up.EndDeviceIdentifiers, fragIndex, ttnpb.FragmentationSession_TRANSMIT); err != nil {
+					return err
+				}
+				if err := h.queueFragments(ctx, up.EndDeviceIdentifiers, fragIndex, window); err != nil {
+					return err
+				}
+			case FragSessionStatusCID:
+				received := binary.LittleEndian.Uint16(cmd.Payload[0:2])
+				logger.WithFields(log.Fields(
+					"frag_index", received>>14,
+					"nb_frag_received", received&maxFragments,
+					"missing_frag", cmd.Payload[2],
+					"not_enough_matrix_memory", cmd.Payload[3]&0x01 != 0,
+				)).Debug("Received FragSessionStatusAns")
+			case FragSessionDeleteCID:
+				logger.WithFields(log.Fields(
+					"frag_index", cmd.Payload[0]&0x03,
+					"session_does_not_exist", cmd.Payload[0]&0x04 != 0,
+				)).Debug("Received FragSessionDeleteAns")
+			}
+		}
+	}
+	return nil
+}
+
+// handleDownlinkSent queues the next fragment when a fragment is sent, and completes the session when the last
+// fragment is sent.
+func (h *Handler) handleDownlinkSent(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, msg *ttnpb.ApplicationDownlink) error {
+	fragIndex, n, ok := parseDataFragment(msg.FRMPayload)
+	if !ok {
+		return nil
+	}
+	session, err := h.registry.Get(ctx, ids, uint32(fragIndex), []string{"nb_frag", "redundancy", "state"})
+	if err != nil {
+		return err
+	}
+	if session.State != ttnpb.FragmentationSession_TRANSMIT {
+		return nil
+	}
+	if uint32(n) >= session.NbFrag+session.Redundancy {
+		log.FromContext(ctx).WithFields(log.Fields(
+			"package", "fragmentation",
+			"frag_index", fragIndex,
+		)).Info("Fragmentation session done")
+		return h.setState(ctx, ids, uint32(fragIndex), ttnpb.FragmentationSession_DONE)
+	}
+	return h.queueFragments(ctx, ids, uint32(fragIndex), 1)
+}
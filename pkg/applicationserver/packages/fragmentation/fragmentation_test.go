@@ -0,0 +1,285 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentation_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/packages/fragmentation"
+	ttnblob "go.thethings.network/lorawan-stack/pkg/blob"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"gocloud.dev/blob"
+)
+
+type mockServer struct {
+	pushed [][]byte
+}
+
+func (s *mockServer) DownlinkQueuePush(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) error {
+	for _, item := range items {
+		if item.FPort != fragmentation.FPort {
+			return fmt.Errorf("unexpected FPort %d", item.FPort)
+		}
+		s.pushed = append(s.pushed, item.FRMPayload)
+	}
+	return nil
+}
+
+func (s *mockServer) take() [][]byte {
+	pushed := s.pushed
+	s.pushed = nil
+	return pushed
+}
+
+type mockRegistry map[uint32]*ttnpb.FragmentationSession
+
+func (r mockRegistry) Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, fragIndex uint32, paths []string) (*ttnpb.FragmentationSession, error) {
+	session, ok := r[fragIndex]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return deepcopy(session), nil
+}
+
+func (r mockRegistry) Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, fragIndex uint32, paths []string, f func(*ttnpb.FragmentationSession) (*ttnpb.FragmentationSession, []string, error)) (*ttnpb.FragmentationSession, error) {
+	var stored *ttnpb.FragmentationSession
+	if session, ok := r[fragIndex]; ok {
+		stored = deepcopy(session)
+	}
+	session, _, err := f(stored)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		delete(r, fragIndex)
+		return nil, nil
+	}
+	session.EndDeviceIdentifiers = ids
+	session.FragIndex = fragIndex
+	r[fragIndex] = deepcopy(session)
+	return session, nil
+}
+
+func deepcopy(session *ttnpb.FragmentationSession) *ttnpb.FragmentationSession {
+	res := *session
+	return &res
+}
+
+func TestFragment(t *testing.T) {
+	a := assertions.New(t)
+
+	data := make([]byte, 50)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	frags, padding, err := fragmentation.Fragment(data, 16, 3)
+	a.So(err, should.BeNil)
+	a.So(padding, should.Equal, 14)
+	if !a.So(frags, should.HaveLength, 7) {
+		t.FailNow()
+	}
+	a.So(frags[0], should.Resemble, data[0:16])
+	a.So(frags[3], should.Resemble, append(append([]byte{}, data[48:]...), make([]byte, 14)...))
+	for i, frag := range frags[4:] {
+		a.So(frag, should.HaveLength, 16)
+		a.So(fragmentation.CodedFragment(frags[:4], i+1), should.Resemble, frag)
+	}
+
+	_, _, err = fragmentation.Fragment(data, 0, 0)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	_, _, err = fragmentation.Fragment(nil, 16, 0)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	_, _, err = fragmentation.Fragment(make([]byte, 1<<14), 1, 0)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
+
+func TestFragSessionSetupReq(t *testing.T) {
+	a := assertions.New(t)
+	b, err := fragmentation.FragSessionSetupReq{
+		FragIndex:      1,
+		McGroupBitMask: 0x03,
+		NbFrag:         0x0102,
+		FragSize:       50,
+		BlockAckDelay:  2,
+		Padding:        7,
+		Descriptor:     0x01020304,
+	}.MarshalBinary()
+	a.So(err, should.BeNil)
+	a.So(b, should.Resemble, []byte{0x02, 0x13, 0x02, 0x01, 0x32, 0x02, 0x07, 0x04, 0x03, 0x02, 0x01})
+	a.So(fragmentation.DataFragment(2, 3, []byte{0x42}), should.Resemble, []byte{0x08, 0x03, 0x80, 0x42})
+	a.So(fragmentation.FragSessionStatusReq(1, true), should.Resemble, []byte{0x01, 0x03})
+	a.So(fragmentation.FragSessionDeleteReq(3), should.Resemble, []byte{0x03, 0x03})
+}
+
+func TestHandler(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	dir, err := ioutil.TempDir("", "fragmentation")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer os.RemoveAll(dir)
+	blobConfig := ttnblob.Config{Provider: "local"}
+	blobConfig.Local.Directory = dir
+	bucketFunc := func(ctx context.Context, name string) (*blob.Bucket, error) {
+		return blobConfig.GetBucket(ctx, name)
+	}
+	bucket, err := bucketFunc(ctx, "firmware")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	data := make([]byte, 50)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	if !a.So(bucket.WriteAll(ctx, "image.bin", data, nil), should.BeNil) {
+		t.FailNow()
+	}
+	frags, _, err := fragmentation.Fragment(data, 16, 2)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		DeviceID:               "foo-device",
+	}
+	uplink := func(payload []byte) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: ids,
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					FPort:      fragmentation.FPort,
+					FRMPayload: payload,
+				},
+			},
+		}
+	}
+	sent := func(payload []byte) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: ids,
+			Up: &ttnpb.ApplicationUp_DownlinkSent{
+				DownlinkSent: &ttnpb.ApplicationDownlink{
+					FPort:      fragmentation.FPort,
+					FRMPayload: payload,
+				},
+			},
+		}
+	}
+	newSession := func(fragIndex uint32) *ttnpb.FragmentationSession {
+		return &ttnpb.FragmentationSession{
+			EndDeviceIdentifiers: ids,
+			FragIndex:            fragIndex,
+			Bucket:               "firmware",
+			Key:                  "image.bin",
+			FragSize:             16,
+			Redundancy:           2,
+			McGroupBitMask:       0x01,
+			BlockAckDelay:        2,
+			DataDescriptor:       0x01020304,
+		}
+	}
+
+	t.Run("Transmit", func(t *testing.T) {
+		a := assertions.New(t)
+		server, registry := &mockServer{}, mockRegistry{}
+		h := fragmentation.New(server, registry, bucketFunc)
+
+		a.So(h.StartSession(ctx, newSession(1)), should.BeNil)
+		a.So(server.take(), should.Resemble, [][]byte{
+			{0x02, 0x11, 0x04, 0x00, 0x10, 0x02, 0x0e, 0x04, 0x03, 0x02, 0x01},
+		})
+		a.So(registry[1].State, should.Equal, ttnpb.FragmentationSession_SETUP)
+		a.So(registry[1].NbFrag, should.Equal, 4)
+		a.So(registry[1].Padding, should.Equal, 14)
+
+		err := h.StartSession(ctx, newSession(1))
+		a.So(errors.IsAlreadyExists(err), should.BeTrue)
+
+		// The end device accepts the session setup.
+		a.So(h.HandleUp(ctx, uplink([]byte{0x02, 0x40})), should.BeNil)
+		a.So(registry[1].State, should.Equal, ttnpb.FragmentationSession_TRANSMIT)
+		pushed := server.take()
+		a.So(pushed, should.Resemble, [][]byte{
+			fragmentation.DataFragment(1, 1, frags[0]),
+			fragmentation.DataFragment(1, 2, frags[1]),
+			fragmentation.DataFragment(1, 3, frags[2]),
+			fragmentation.DataFragment(1, 4, frags[3]),
+		})
+
+		for _, expected := range [][][]byte{
+			{fragmentation.DataFragment(1, 5, frags[4])},
+			{fragmentation.DataFragment(1, 6, frags[5])},
+			nil,
+			nil,
+			nil,
+		} {
+			a.So(h.HandleUp(ctx, sent(pushed[0])), should.BeNil)
+			next := server.take()
+			a.So(next, should.Resemble, expected)
+			pushed = append(pushed[1:], next...)
+			a.So(registry[1].State, should.Equal, ttnpb.FragmentationSession_TRANSMIT)
+		}
+		a.So(registry[1].LastFragment, should.Equal, 6)
+		a.So(pushed, should.HaveLength, 1)
+		a.So(h.HandleUp(ctx, sent(pushed[0])), should.BeNil)
+		a.So(server.take(), should.BeEmpty)
+		a.So(registry[1].State, should.Equal, ttnpb.FragmentationSession_DONE)
+
+		// A new session can be started when the previous session is done.
+		a.So(h.StartSession(ctx, newSession(1)), should.BeNil)
+		a.So(registry[1].State, should.Equal, ttnpb.FragmentationSession_SETUP)
+		a.So(registry[1].LastFragment, should.Equal, 0)
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		a := assertions.New(t)
+		server, registry := &mockServer{}, mockRegistry{}
+		h := fragmentation.New(server, registry, bucketFunc)
+
+		a.So(h.StartSession(ctx, newSession(2)), should.BeNil)
+		server.take()
+
+		// The end device does not have enough memory.
+		a.So(h.HandleUp(ctx, uplink([]byte{0x02, 0x82})), should.BeNil)
+		a.So(server.take(), should.BeEmpty)
+		a.So(registry[2].State, should.Equal, ttnpb.FragmentationSession_FAILED)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		a := assertions.New(t)
+		server, registry := &mockServer{}, mockRegistry{}
+		h := fragmentation.New(server, registry, bucketFunc)
+
+		err := h.DeleteSession(ctx, ids, 3)
+		a.So(errors.IsNotFound(err), should.BeTrue)
+
+		a.So(h.StartSession(ctx, newSession(3)), should.BeNil)
+		server.take()
+		a.So(h.DeleteSession(ctx, ids, 3), should.BeNil)
+		a.So(server.take(), should.Resemble, [][]byte{{0x03, 0x03}})
+		a.So(registry, should.BeEmpty)
+	})
+}
@@ -0,0 +1,65 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetup
+
+import (
+	"crypto/aes"
+
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+func encrypt(key types.AES128Key, b [16]byte) (res types.AES128Key) {
+	block, _ := aes.NewCipher(key[:])
+	block.Encrypt(res[:], b[:])
+	return
+}
+
+// DeriveMcRootKey derives the McRootKey from the GenAppKey of a LoRaWAN 1.0.x end device.
+func DeriveMcRootKey(genAppKey types.AES128Key) types.AES128Key {
+	return encrypt(genAppKey, [16]byte{0x00})
+}
+
+// DeriveMcRootKey11 derives the McRootKey from the AppKey of a LoRaWAN 1.1 end device.
+func DeriveMcRootKey11(appKey types.AES128Key) types.AES128Key {
+	return encrypt(appKey, [16]byte{0x20})
+}
+
+// DeriveMcKEKey derives the McKEKey, which encrypts multicast group keys, from the McRootKey.
+func DeriveMcKEKey(mcRootKey types.AES128Key) types.AES128Key {
+	return encrypt(mcRootKey, [16]byte{0x00})
+}
+
+// EncryptMcKey encrypts the multicast group key with the McKEKey, as in McGroupSetupReq.
+func EncryptMcKey(mcKEKey, mcKey types.AES128Key) (res types.AES128Key) {
+	block, _ := aes.NewCipher(mcKEKey[:])
+	block.Decrypt(res[:], mcKey[:])
+	return
+}
+
+func deriveMcSessionKey(typ byte, mcKey types.AES128Key, mcAddr types.DevAddr) types.AES128Key {
+	b := [16]byte{typ}
+	copy(b[1:5], reverse(mcAddr[:]))
+	return encrypt(mcKey, b)
+}
+
+// DeriveMcAppSKey derives the McAppSKey of the multicast group with the given key and address.
+func DeriveMcAppSKey(mcKey types.AES128Key, mcAddr types.DevAddr) types.AES128Key {
+	return deriveMcSessionKey(0x01, mcKey, mcAddr)
+}
+
+// DeriveMcNwkSKey derives the McNwkSKey of the multicast group with the given key and address.
+func DeriveMcNwkSKey(mcKey types.AES128Key, mcAddr types.DevAddr) types.AES128Key {
+	return deriveMcSessionKey(0x02, mcKey, mcAddr)
+}
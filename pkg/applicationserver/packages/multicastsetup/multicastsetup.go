@@ -0,0 +1,239 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package multicastsetup implements the LoRa Alliance Remote Multicast Setup package.
+package multicastsetup
+
+import (
+	"context"
+	"encoding/binary"
+
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/packages"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+const (
+	// FPort is the FPort of the package.
+	FPort = 200
+	// PackageIdentifier is the identifier of the package.
+	PackageIdentifier = 2
+	// PackageVersion is the version of the package.
+	PackageVersion = 1
+)
+
+// Command identifiers.
+const (
+	McGroupStatusCID   = 0x01
+	McGroupSetupCID    = 0x02
+	McGroupDeleteCID   = 0x03
+	McClassCSessionCID = 0x04
+	McClassBSessionCID = 0x05
+)
+
+const (
+	sessionAnsErrorMask   = 0x1c
+	groupStatusGroupsMask = 0x0f
+)
+
+// uplinkLength returns the length of the payload of the uplink command with the given identifier.
+func uplinkLength(cid byte, b []byte) (int, bool) {
+	switch cid {
+	case packages.PackageVersionCID:
+		return 2, true
+	case McGroupStatusCID:
+		if len(b) == 0 {
+			return 1, true
+		}
+		n := 1
+		for mask := b[0] & groupStatusGroupsMask; mask != 0; mask &= mask - 1 {
+			n += 5
+		}
+		return n, true
+	case McGroupSetupCID, McGroupDeleteCID:
+		return 1, true
+	case McClassCSessionCID, McClassBSessionCID:
+		if len(b) > 0 && b[0]&sessionAnsErrorMask != 0 {
+			return 1, true
+		}
+		return 4, true
+	default:
+		return 0, false
+	}
+}
+
+// McGroupSetupReq is the request to set up a multicast group in an end device.
+type McGroupSetupReq struct {
+	McGroupID byte
+	McAddr    types.DevAddr
+	// McKeyEncrypted is the multicast group key, encrypted with the McKEKey of the end device. See EncryptMcKey.
+	McKeyEncrypted types.AES128Key
+	MinMcFCount    uint32
+	MaxMcFCount    uint32
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (req McGroupSetupReq) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 30)
+	b[0] = McGroupSetupCID
+	b[1] = req.McGroupID & 0x03
+	copy(b[2:6], reverse(req.McAddr[:]))
+	copy(b[6:22], req.McKeyEncrypted[:])
+	binary.LittleEndian.PutUint32(b[22:26], req.MinMcFCount)
+	binary.LittleEndian.PutUint32(b[26:30], req.MaxMcFCount)
+	return b, nil
+}
+
+// McGroupDeleteReq returns the McGroupDeleteReq command that deletes the multicast group with the given ID.
+func McGroupDeleteReq(mcGroupID byte) []byte {
+	return []byte{McGroupDeleteCID, mcGroupID & 0x03}
+}
+
+// McGroupStatusReq returns the McGroupStatusReq command that requests the status of the multicast groups in mask.
+func McGroupStatusReq(mask byte) []byte {
+	return []byte{McGroupStatusCID, mask & 0x0f}
+}
+
+// McClassCSessionReq is the request to start a class C multicast session in an end device.
+type McClassCSessionReq struct {
+	McGroupID byte
+	// SessionTime is the start of the session in GPS seconds.
+	SessionTime uint32
+	// SessionTimeOut is the maximum duration of the session as 2^SessionTimeOut seconds.
+	SessionTimeOut byte
+	// DLFrequency is the frequency of the session in Hz.
+	DLFrequency uint64
+	DataRate    byte
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (req McClassCSessionReq) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 11)
+	b[0] = McClassCSessionCID
+	b[1] = req.McGroupID & 0x03
+	binary.LittleEndian.PutUint32(b[2:6], req.SessionTime)
+	b[6] = req.SessionTimeOut & 0x0f
+	freq := uint32(req.DLFrequency / 100)
+	b[7], b[8], b[9] = byte(freq), byte(freq>>8), byte(freq>>16)
+	b[10] = req.DataRate
+	return b, nil
+}
+
+func reverse(b []byte) []byte {
+	r := make([]byte, len(b))
+	for i := range b {
+		r[len(b)-1-i] = b[i]
+	}
+	return r
+}
+
+// Handler handles the Remote Multicast Setup package.
+type Handler struct {
+	server packages.Server
+}
+
+// New returns a new Handler that queues downlink messages on server.
+func New(server packages.Server) *Handler {
+	return &Handler{
+		server: server,
+	}
+}
+
+// FPort implements packages.Handler.
+func (h *Handler) FPort() uint32 { return FPort }
+
+// HandleUp implements packages.Handler.
+func (h *Handler) HandleUp(ctx context.Context, up *ttnpb.ApplicationUp) error {
+	uplink, ok := packages.Uplink(up)
+	if !ok {
+		return nil
+	}
+	logger := log.FromContext(ctx).WithField("package", "multicastsetup")
+	cmds, err := packages.ReadCommands(uplink.FRMPayload, uplinkLength)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to read commands")
+	}
+	for _, cmd := range cmds {
+		switch cmd.CID {
+		case packages.PackageVersionCID:
+			logger.WithFields(log.Fields(
+				"package_identifier", cmd.Payload[0],
+				"package_version", cmd.Payload[1],
+			)).Debug("Received PackageVersionAns")
+		case McGroupStatusCID:
+			logger.WithFields(log.Fields(
+				"nb_total_groups", cmd.Payload[0]>>4&0x07,
+				"ans_group_mask", cmd.Payload[0]&groupStatusGroupsMask,
+			)).Debug("Received McGroupStatusAns")
+		case McGroupSetupCID:
+			logger := logger.WithField("mc_group_id", cmd.Payload[0]&0x03)
+			if cmd.Payload[0]&0x04 != 0 {
+				logger.Warn("End device rejected multicast group setup")
+				continue
+			}
+			logger.Info("End device set up multicast group")
+		case McGroupDeleteCID:
+			logger.WithFields(log.Fields(
+				"mc_group_id", cmd.Payload[0]&0x03,
+				"mc_group_undefined", cmd.Payload[0]&0x04 != 0,
+			)).Info("End device deleted multicast group")
+		case McClassCSessionCID, McClassBSessionCID:
+			logger := logger.WithField("mc_group_id", cmd.Payload[0]&0x03)
+			if cmd.Payload[0]&sessionAnsErrorMask != 0 {
+				logger.WithFields(log.Fields(
+					"mc_group_undefined", cmd.Payload[0]&0x10 != 0,
+					"frequency_error", cmd.Payload[0]&0x08 != 0,
+					"data_rate_error", cmd.Payload[0]&0x04 != 0,
+				)).Warn("End device rejected multicast session")
+				continue
+			}
+			timeToStart := uint32(cmd.Payload[1]) | uint32(cmd.Payload[2])<<8 | uint32(cmd.Payload[3])<<16
+			logger.WithField("time_to_start", timeToStart).Info("End device accepted multicast session")
+		}
+	}
+	return nil
+}
+
+func (h *Handler) push(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, b []byte) error {
+	return h.server.DownlinkQueuePush(ctx, ids, []*ttnpb.ApplicationDownlink{
+		{
+			FPort:      FPort,
+			FRMPayload: b,
+		},
+	})
+}
+
+// SetupGroup requests the end device to set up the multicast group.
+func (h *Handler) SetupGroup(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, req McGroupSetupReq) error {
+	b, err := req.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return h.push(ctx, ids, b)
+}
+
+// DeleteGroup requests the end device to delete the multicast group with the given ID.
+func (h *Handler) DeleteGroup(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, mcGroupID byte) error {
+	return h.push(ctx, ids, McGroupDeleteReq(mcGroupID))
+}
+
+// StartClassCSession requests the end device to start a class C multicast session.
+func (h *Handler) StartClassCSession(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, req McClassCSessionReq) error {
+	b, err := req.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return h.push(ctx, ids, b)
+}
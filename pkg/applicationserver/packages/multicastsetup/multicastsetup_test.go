@@ -0,0 +1,77 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetup_test
+
+import (
+	"crypto/aes"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/packages/multicastsetup"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestMcGroupSetupReq(t *testing.T) {
+	a := assertions.New(t)
+	b, err := multicastsetup.McGroupSetupReq{
+		McGroupID:      1,
+		McAddr:         types.DevAddr{0x01, 0x02, 0x03, 0x04},
+		McKeyEncrypted: types.AES128Key{0x0f, 0x0e, 0x0d, 0x0c, 0x0b, 0x0a, 0x09, 0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01, 0x00},
+		MinMcFCount:    0x10,
+		MaxMcFCount:    0x01020304,
+	}.MarshalBinary()
+	a.So(err, should.BeNil)
+	a.So(b, should.Resemble, []byte{
+		0x02, 0x01,
+		0x04, 0x03, 0x02, 0x01,
+		0x0f, 0x0e, 0x0d, 0x0c, 0x0b, 0x0a, 0x09, 0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01, 0x00,
+		0x10, 0x00, 0x00, 0x00,
+		0x04, 0x03, 0x02, 0x01,
+	})
+}
+
+func TestMcClassCSessionReq(t *testing.T) {
+	a := assertions.New(t)
+	b, err := multicastsetup.McClassCSessionReq{
+		McGroupID:      2,
+		SessionTime:    0x01020304,
+		SessionTimeOut: 8,
+		DLFrequency:    869525000,
+		DataRate:       3,
+	}.MarshalBinary()
+	a.So(err, should.BeNil)
+	a.So(b, should.Resemble, []byte{0x04, 0x02, 0x04, 0x03, 0x02, 0x01, 0x08, 0xd2, 0xad, 0x84, 0x03})
+}
+
+func TestEncryptMcKey(t *testing.T) {
+	a := assertions.New(t)
+	mcRootKey := multicastsetup.DeriveMcRootKey(types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10})
+	mcKEKey := multicastsetup.DeriveMcKEKey(mcRootKey)
+	mcKey := types.AES128Key{0x10, 0x0f, 0x0e, 0x0d, 0x0c, 0x0b, 0x0a, 0x09, 0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01}
+	encrypted := multicastsetup.EncryptMcKey(mcKEKey, mcKey)
+	a.So(encrypted, should.NotResemble, mcKey)
+
+	// The end device obtains the McKey by encrypting McKeyEncrypted with its McKEKey.
+	block, err := aes.NewCipher(mcKEKey[:])
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	var decrypted types.AES128Key
+	block.Encrypt(decrypted[:], encrypted[:])
+	a.So(decrypted, should.Resemble, mcKey)
+
+	a.So(multicastsetup.DeriveMcAppSKey(mcKey, types.DevAddr{0x01, 0x02, 0x03, 0x04}), should.NotResemble, multicastsetup.DeriveMcNwkSKey(mcKey, types.DevAddr{0x01, 0x02, 0x03, 0x04}))
+}
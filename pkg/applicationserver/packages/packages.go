@@ -0,0 +1,143 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package packages implements the framework for the LoRa Alliance application layer packages in the Application Server.
+//
+// Application layer packages exchange commands with end devices on reserved FPorts. Each command starts with a
+// command identifier (CID), and multiple commands may be concatenated in a single frame.
+package packages
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// PackageVersionCID is the command identifier of PackageVersionReq and PackageVersionAns, which is common to all packages.
+const PackageVersionCID = 0x00
+
+// Server represents the Application Server to application layer packages.
+type Server interface {
+	// DownlinkQueuePush pushes the given downlink messages to the end device's application downlink queue.
+	DownlinkQueuePush(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) error
+}
+
+// Handler handles the messages of an application layer package.
+type Handler interface {
+	// FPort returns the FPort that is reserved for the package.
+	FPort() uint32
+	// HandleUp handles an upstream message of the end device on the FPort of the package.
+	// Only decrypted uplink messages and sent downlink messages are passed.
+	HandleUp(ctx context.Context, up *ttnpb.ApplicationUp) error
+}
+
+// Handlers is a set of application layer package handlers by their FPort.
+type Handlers map[uint32]Handler
+
+// NewHandlers returns the handlers by their FPort.
+func NewHandlers(handlers ...Handler) Handlers {
+	hs := make(Handlers, len(handlers))
+	for _, h := range handlers {
+		hs[h.FPort()] = h
+	}
+	return hs
+}
+
+// Handler returns the handler of the FPort of the message, if any.
+func (hs Handlers) Handler(up *ttnpb.ApplicationUp) (Handler, bool) {
+	var fPort uint32
+	switch p := up.Up.(type) {
+	case *ttnpb.ApplicationUp_UplinkMessage:
+		fPort = p.UplinkMessage.FPort
+	case *ttnpb.ApplicationUp_DownlinkSent:
+		fPort = p.DownlinkSent.FPort
+	default:
+		return nil, false
+	}
+	h, ok := hs[fPort]
+	return h, ok
+}
+
+// HandleUp passes up to the handler of the FPort of the message, if any.
+func (hs Handlers) HandleUp(ctx context.Context, up *ttnpb.ApplicationUp) error {
+	h, ok := hs.Handler(up)
+	if !ok {
+		return nil
+	}
+	return h.HandleUp(ctx, up)
+}
+
+// Command is an application layer package command.
+type Command struct {
+	CID     byte
+	Payload []byte
+}
+
+var (
+	errUnknownCommand = errors.DefineInvalidArgument("unknown_command", "unknown command `{cid}`")
+	errCommandLength  = errors.DefineInvalidArgument("command_length", "expected `{expected}` bytes for command `{cid}`, got `{actual}`")
+)
+
+// CommandLength returns the length of the payload of the command with the given identifier, which starts b.
+// It returns false if the command is unknown.
+type CommandLength func(cid byte, b []byte) (int, bool)
+
+// FixedLengths returns a CommandLength, which looks up the fixed payload lengths by command identifier.
+func FixedLengths(lengths map[byte]int) CommandLength {
+	return func(cid byte, _ []byte) (int, bool) {
+		n, ok := lengths[cid]
+		return n, ok
+	}
+}
+
+// ReadCommands splits b in commands. The payload length of each command is determined by length.
+// If a command is unknown or too short, the preceding commands are returned with an error.
+func ReadCommands(b []byte, length CommandLength) ([]Command, error) {
+	var cmds []Command
+	for len(b) > 0 {
+		cid := b[0]
+		n, ok := length(cid, b[1:])
+		if !ok {
+			return cmds, errUnknownCommand.WithAttributes("cid", cid)
+		}
+		if len(b)-1 < n {
+			return cmds, errCommandLength.WithAttributes(
+				"cid", cid,
+				"expected", n,
+				"actual", len(b)-1,
+			)
+		}
+		cmds = append(cmds, Command{
+			CID:     cid,
+			Payload: b[1 : 1+n],
+		})
+		b = b[1+n:]
+	}
+	return cmds, nil
+}
+
+// Uplink returns the uplink message of up, if any.
+func Uplink(up *ttnpb.ApplicationUp) (*ttnpb.ApplicationUplink, bool) {
+	p, ok := up.Up.(*ttnpb.ApplicationUp_UplinkMessage)
+	if !ok {
+		return nil, false
+	}
+	return p.UplinkMessage, true
+}
+
+// PackageVersionReq returns the PackageVersionReq command.
+func PackageVersionReq() []byte {
+	return []byte{PackageVersionCID}
+}
@@ -0,0 +1,123 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packages_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/packages"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestReadCommands(t *testing.T) {
+	length := packages.FixedLengths(map[byte]int{
+		0x00: 2,
+		0x01: 1,
+		0x02: 0,
+	})
+	for _, tc := range []struct {
+		Name           string
+		Bytes          []byte
+		Commands       []packages.Command
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name: "Empty",
+		},
+		{
+			Name:  "Multiple",
+			Bytes: []byte{0x00, 0x01, 0x02, 0x02, 0x01, 0x42},
+			Commands: []packages.Command{
+				{CID: 0x00, Payload: []byte{0x01, 0x02}},
+				{CID: 0x02, Payload: []byte{}},
+				{CID: 0x01, Payload: []byte{0x42}},
+			},
+		},
+		{
+			Name:  "Unknown",
+			Bytes: []byte{0x01, 0x42, 0x03, 0x01},
+			Commands: []packages.Command{
+				{CID: 0x01, Payload: []byte{0x42}},
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:           "TooShort",
+			Bytes:          []byte{0x00, 0x01},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			cmds, err := packages.ReadCommands(tc.Bytes, length)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+			} else {
+				a.So(err, should.BeNil)
+			}
+			a.So(cmds, should.Resemble, tc.Commands)
+		})
+	}
+}
+
+type mockHandler struct {
+	fPort uint32
+	ups   []*ttnpb.ApplicationUp
+}
+
+func (h *mockHandler) FPort() uint32 { return h.fPort }
+
+func (h *mockHandler) HandleUp(ctx context.Context, up *ttnpb.ApplicationUp) error {
+	h.ups = append(h.ups, up)
+	return nil
+}
+
+func TestHandlers(t *testing.T) {
+	a := assertions.New(t)
+	h := &mockHandler{fPort: 201}
+	hs := packages.NewHandlers(h)
+
+	uplink := &ttnpb.ApplicationUp{
+		Up: &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &ttnpb.ApplicationUplink{FPort: 201}},
+	}
+	sent := &ttnpb.ApplicationUp{
+		Up: &ttnpb.ApplicationUp_DownlinkSent{DownlinkSent: &ttnpb.ApplicationDownlink{FPort: 201}},
+	}
+	otherFPort := &ttnpb.ApplicationUp{
+		Up: &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &ttnpb.ApplicationUplink{FPort: 1}},
+	}
+	joinAccept := &ttnpb.ApplicationUp{
+		Up: &ttnpb.ApplicationUp_JoinAccept{JoinAccept: &ttnpb.ApplicationJoinAccept{}},
+	}
+
+	for _, up := range []*ttnpb.ApplicationUp{uplink, sent} {
+		handler, ok := hs.Handler(up)
+		a.So(ok, should.BeTrue)
+		a.So(handler, should.Equal, h)
+	}
+	for _, up := range []*ttnpb.ApplicationUp{otherFPort, joinAccept} {
+		_, ok := hs.Handler(up)
+		a.So(ok, should.BeFalse)
+	}
+
+	for _, up := range []*ttnpb.ApplicationUp{uplink, sent, otherFPort, joinAccept} {
+		a.So(hs.HandleUp(context.Background(), up), should.BeNil)
+	}
+	a.So(h.ups, should.Resemble, []*ttnpb.ApplicationUp{uplink, sent})
+}
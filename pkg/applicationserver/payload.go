@@ -77,6 +77,10 @@ func (as *ApplicationServer) decryptAndDecode(ctx context.Context, dev *ttnpb.En
 	} else if defaultFormatters != nil {
 		formatter, parameter = defaultFormatters.UpFormatter, defaultFormatters.UpFormatterParameter
 	}
+	if _, ok := as.packages[uplink.FPort]; ok {
+		// Application layer package messages are handled by the package and are not decoded.
+		formatter = ttnpb.PayloadFormatter_FORMATTER_NONE
+	}
 	if formatter != ttnpb.PayloadFormatter_FORMATTER_NONE {
 		if err := as.formatter.Decode(ctx, dev.EndDeviceIdentifiers, dev.VersionIDs, uplink, formatter, parameter); err != nil {
 			logger.WithError(err).Warn("Payload decoding failed")
@@ -150,3 +154,21 @@ func (p payloadFormatter) Decode(ctx context.Context, ids ttnpb.EndDeviceIdentif
 	}
 	return nil
 }
+
+// Validate validates the parameters of the formatters that support validation.
+func (p payloadFormatter) Validate(formatters *ttnpb.MessagePayloadFormatters) error {
+	if formatters == nil {
+		return nil
+	}
+	if v, ok := p.upFormatters[formatters.UpFormatter].(messageprocessors.ParameterValidator); ok {
+		if err := v.ValidateParameter(formatters.UpFormatterParameter); err != nil {
+			return err
+		}
+	}
+	if v, ok := p.downFormatters[formatters.DownFormatter].(messageprocessors.ParameterValidator); ok {
+		if err := v.ValidateParameter(formatters.DownFormatterParameter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+func applyFragmentationSessionFieldMask(dst, src *ttnpb.FragmentationSession, paths ...string) (*ttnpb.FragmentationSession, error) {
+	if dst == nil {
+		dst = &ttnpb.FragmentationSession{}
+	}
+	return dst, dst.SetFields(src, append(paths, "end_device_ids", "frag_index")...)
+}
+
+// FragmentationSessionRegistry is a Redis fragmentation session registry.
+type FragmentationSessionRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *FragmentationSessionRegistry) key(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, fragIndex uint32) string {
+	return r.Redis.Key(unique.ID(ctx, ids), strconv.FormatUint(uint64(fragIndex), 10))
+}
+
+// Get returns the fragmentation session by the end device identifiers and the session index.
+func (r *FragmentationSessionRegistry) Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, fragIndex uint32, paths []string) (*ttnpb.FragmentationSession, error) {
+	pb := &ttnpb.FragmentationSession{}
+	if err := ttnredis.GetProto(r.Redis, r.key(ctx, ids, fragIndex)).ScanProto(pb); err != nil {
+		return nil, err
+	}
+	return applyFragmentationSessionFieldMask(nil, pb, paths...)
+}
+
+// Set creates, updates or deletes the fragmentation session by the end device identifiers and the session index.
+func (r *FragmentationSessionRegistry) Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, fragIndex uint32, gets []string, f func(*ttnpb.FragmentationSession) (*ttnpb.FragmentationSession, []string, error)) (*ttnpb.FragmentationSession, error) {
+	k := r.key(ctx, ids, fragIndex)
+
+	var pb *ttnpb.FragmentationSession
+	err := r.Redis.Watch(func(tx *redis.Tx) error {
+		var create bool
+		cmd := ttnredis.GetProto(tx, k)
+		stored := &ttnpb.FragmentationSession{}
+		if err := cmd.ScanProto(stored); errors.IsNotFound(err) {
+			create = true
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+
+		var err error
+		if stored != nil {
+			pb, err = applyFragmentationSessionFieldMask(nil, stored, gets...)
+			if err != nil {
+				return err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(pb)
+		if err != nil {
+			return err
+		}
+
+		var f func(redis.Pipeliner) error
+		if pb == nil {
+			f = func(p redis.Pipeliner) error {
+				p.Del(k)
+				return nil
+			}
+		} else {
+			pb.EndDeviceIdentifiers = ids
+			pb.FragIndex = fragIndex
+			pb.UpdatedAt = time.Now().UTC()
+			sets = append(sets, "updated_at")
+			if create {
+				pb.CreatedAt = pb.UpdatedAt
+				sets = append(sets, "created_at")
+			}
+			stored = &ttnpb.FragmentationSession{}
+			if err := cmd.ScanProto(stored); err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+			stored, err = applyFragmentationSessionFieldMask(stored, pb, sets...)
+			if err != nil {
+				return err
+			}
+			pb, err = applyFragmentationSessionFieldMask(nil, stored, gets...)
+			if err != nil {
+				return err
+			}
+			f = func(p redis.Pipeliner) error {
+				_, err := ttnredis.SetProto(p, k, stored, 0)
+				return err
+			}
+		}
+
+		cmds, err := tx.Pipelined(f)
+		if err != nil {
+			return err
+		}
+		for _, cmd := range cmds {
+			if err := cmd.Err(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, k)
+	if err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
@@ -19,6 +19,10 @@ import (
 	"time"
 
 	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+	"github.com/mohae/deepcopy"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -39,8 +43,26 @@ func applyDeviceFieldMask(dst, src *ttnpb.EndDevice, paths ...string) (*ttnpb.En
 }
 
 // DeviceRegistry is a Redis device registry.
+// If KEKLabel is set, the keys of the end devices are wrapped with the KEK label using KeyVault at rest.
 type DeviceRegistry struct {
-	Redis *ttnredis.Client
+	Redis    *ttnredis.Client
+	KeyVault crypto.KeyVault
+	KEKLabel string
+}
+
+func (r *DeviceRegistry) wrapKeys(pb *ttnpb.EndDevice) (*ttnpb.EndDevice, error) {
+	if r.KEKLabel == "" {
+		return pb, nil
+	}
+	pb = deepcopy.Copy(pb).(*ttnpb.EndDevice)
+	if err := cryptoutil.WrapKeyEnvelopes(cryptoutil.EndDeviceKeyEnvelopes(pb), r.KEKLabel, r.KeyVault); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+func (r *DeviceRegistry) unwrapKeys(pb *ttnpb.EndDevice) error {
+	return cryptoutil.UnwrapKeyEnvelopes(cryptoutil.EndDeviceKeyEnvelopes(pb), r.KEKLabel, r.KeyVault)
 }
 
 // Get returns the end device by its identifiers.
@@ -50,6 +72,9 @@ func (r *DeviceRegistry) Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers
 	if err := ttnredis.GetProto(r.Redis, k).ScanProto(pb); err != nil {
 		return nil, err
 	}
+	if err := r.unwrapKeys(pb); err != nil {
+		return nil, err
+	}
 	return applyDeviceFieldMask(nil, pb, paths...)
 }
 
@@ -67,6 +92,8 @@ func (r *DeviceRegistry) Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers
 			stored = nil
 		} else if err != nil {
 			return err
+		} else if err := r.unwrapKeys(stored); err != nil {
+			return err
 		}
 
 		var err error
@@ -100,6 +127,8 @@ func (r *DeviceRegistry) Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers
 			stored = &ttnpb.EndDevice{}
 			if err := cmd.ScanProto(stored); err != nil && !errors.IsNotFound(err) {
 				return err
+			} else if err := r.unwrapKeys(stored); err != nil {
+				return err
 			}
 			stored, err = applyDeviceFieldMask(stored, pb, sets...)
 			if err != nil {
@@ -109,6 +138,10 @@ func (r *DeviceRegistry) Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers
 			if err != nil {
 				return err
 			}
+			stored, err = r.wrapKeys(stored)
+			if err != nil {
+				return err
+			}
 			f = func(p redis.Pipeliner) error {
 				_, err := ttnredis.SetProto(p, k, stored, 0)
 				return err
@@ -132,6 +165,18 @@ func (r *DeviceRegistry) Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers
 	return pb, nil
 }
 
+// RewrapKeys re-wraps the keys of all end devices from the old KEK label to the new KEK label.
+// RewrapKeys returns the number of updated end devices.
+func (r *DeviceRegistry) RewrapKeys(oldKEKLabel, newKEKLabel string) (int, error) {
+	return ttnredis.UpdateProtos(r.Redis, r.Redis.Key("*"), func(string) (proto.Message, func() (bool, error)) {
+		pb := &ttnpb.EndDevice{}
+		return pb, func() (bool, error) {
+			n, err := cryptoutil.RewrapKeyEnvelopes(cryptoutil.EndDeviceKeyEnvelopes(pb), oldKEKLabel, newKEKLabel, r.KeyVault)
+			return n > 0, err
+		}
+	})
+}
+
 func applyLinkFieldMask(dst, src *ttnpb.ApplicationLink, paths ...string) (*ttnpb.ApplicationLink, error) {
 	if dst == nil {
 		dst = &ttnpb.ApplicationLink{}
@@ -21,8 +21,10 @@ import (
 
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
 	"go.thethings.network/lorawan-stack/pkg/unique"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
@@ -138,3 +140,91 @@ func TestLinkRegistry(t *testing.T) {
 		}
 	}
 }
+
+func TestWrappedDeviceRegistry(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	cl, flush := test.NewRedis(t, "applicationserver_test")
+	defer func() {
+		flush()
+		cl.Close()
+	}()
+
+	keyVault := cryptoutil.NewMemKeyVault(map[string][]byte{
+		"old": {0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+		"new": {0x0f, 0x0e, 0x0d, 0x0c, 0x0b, 0x0a, 0x09, 0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01, 0x00},
+	})
+	reg := &redis.DeviceRegistry{
+		Redis:    cl,
+		KeyVault: keyVault,
+		KEKLabel: "old",
+	}
+	raw := &redis.DeviceRegistry{
+		Redis: cl,
+	}
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "foo-app",
+		},
+		DeviceID: "foo-device",
+		DevEUI:   &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+		JoinEUI:  &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+	}
+	key := []byte{0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11}
+	paths := []string{"session"}
+
+	_, err := reg.Set(ctx, ids, nil, func(pb *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+		if pb != nil {
+			t.Fatal("Device already exists")
+		}
+		return &ttnpb.EndDevice{
+			EndDeviceIdentifiers: ids,
+			Session: &ttnpb.Session{
+				DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+				SessionKeys: ttnpb.SessionKeys{
+					AppSKey: &ttnpb.KeyEnvelope{Key: key},
+				},
+			},
+		}, paths, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	pb, err := reg.Get(ctx, ids, paths)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(pb.Session.AppSKey, should.Resemble, &ttnpb.KeyEnvelope{Key: key})
+
+	pb, err = raw.Get(ctx, ids, paths)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(pb.Session.AppSKey.KEKLabel, should.Equal, "old")
+	a.So(pb.Session.AppSKey.Key, should.NotResemble, key)
+
+	n, err := reg.RewrapKeys("old", "new")
+	a.So(err, should.BeNil)
+	a.So(n, should.Equal, 1)
+
+	pb, err = raw.Get(ctx, ids, paths)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(pb.Session.AppSKey.KEKLabel, should.Equal, "new")
+	a.So(pb.Session.AppSKey.Key, should.NotResemble, key)
+
+	n, err = reg.RewrapKeys("old", "new")
+	a.So(err, should.BeNil)
+	a.So(n, should.Equal, 0)
+
+	reg.KEKLabel = "new"
+	pb, err = reg.Get(ctx, ids, paths)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(pb.Session.AppSKey, should.Resemble, &ttnpb.KeyEnvelope{Key: key})
+}
@@ -236,16 +236,27 @@ type mockIS struct {
 	ttnpb.ApplicationAccessServer
 	applications     map[string]*ttnpb.Application
 	applicationAuths map[string][]string
+	endDevices       *mockISEndDeviceRegistry
+}
+
+type mockISEndDeviceRegistry struct {
+	ttnpb.EndDeviceRegistryServer
+	endDevicesMu sync.RWMutex
+	endDevices   map[string]*ttnpb.EndDevice
 }
 
 func startMockIS(ctx context.Context) (*mockIS, string) {
 	is := &mockIS{
 		applications:     make(map[string]*ttnpb.Application),
 		applicationAuths: make(map[string][]string),
+		endDevices: &mockISEndDeviceRegistry{
+			endDevices: make(map[string]*ttnpb.EndDevice),
+		},
 	}
 	srv := rpcserver.New(ctx)
 	ttnpb.RegisterApplicationRegistryServer(srv.Server, is)
 	ttnpb.RegisterApplicationAccessServer(srv.Server, is)
+	ttnpb.RegisterEndDeviceRegistryServer(srv.Server, is.endDevices)
 	lis, err := net.Listen("tcp", ":0")
 	if err != nil {
 		panic(err)
@@ -334,3 +345,26 @@ func (js *mockJS) GetAppSKey(ctx context.Context, req *ttnpb.SessionKeyRequest)
 		AppSKey: key,
 	}, nil
 }
+
+func (r *mockISEndDeviceRegistry) get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) (*ttnpb.EndDevice, bool) {
+	r.endDevicesMu.RLock()
+	defer r.endDevicesMu.RUnlock()
+	dev, ok := r.endDevices[unique.ID(ctx, ids)]
+	return dev, ok
+}
+
+func (r *mockISEndDeviceRegistry) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	dev, ok := r.get(ctx, req.EndDeviceIdentifiers)
+	if !ok {
+		return &ttnpb.EndDevice{EndDeviceIdentifiers: req.EndDeviceIdentifiers}, nil
+	}
+	return dev, nil
+}
+
+func (r *mockISEndDeviceRegistry) Update(ctx context.Context, req *ttnpb.UpdateEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	r.endDevicesMu.Lock()
+	defer r.endDevicesMu.Unlock()
+	dev := req.EndDevice
+	r.endDevices[unique.ID(ctx, req.EndDeviceIdentifiers)] = &dev
+	return &dev, nil
+}
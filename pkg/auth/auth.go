@@ -44,6 +44,8 @@ var (
 	RefreshToken = TokenType(enc.EncodeToString([]byte("ref")))
 	// AuthorizationCode is used by OAuth clients to exchange AccessTokens.
 	AuthorizationCode = TokenType(enc.EncodeToString([]byte("aut")))
+	// DeviceCode is used by OAuth clients on devices without browser to exchange AccessTokens.
+	DeviceCode = TokenType(enc.EncodeToString([]byte("dev")))
 )
 
 // TokenType indicates the type of a token.
@@ -74,7 +76,7 @@ func SplitToken(token string) (tokenType TokenType, id, key string, err error) {
 		return "", "", "", errInvalidToken
 	}
 	switch TokenType(parts[0]) {
-	case APIKey, AccessToken, RefreshToken, AuthorizationCode:
+	case APIKey, AccessToken, RefreshToken, AuthorizationCode, DeviceCode:
 		return TokenType(parts[0]), parts[1], parts[2], nil
 	default:
 		return "", "", "", errInvalidToken
@@ -117,7 +117,11 @@ func New(logger log.Stack, config *Config, opts ...Option) (*Component, error) {
 		tcpListeners: make(map[string]*listener),
 
 		FrequencyPlans: config.FrequencyPlans.Store(),
-		KeyVault:       config.KeyVault.KeyVault(),
+	}
+
+	c.KeyVault, err = config.KeyVault.KeyVault()
+	if err != nil {
+		return nil, err
 	}
 
 	if config.Sentry.DSN != "" {
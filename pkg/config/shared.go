@@ -113,8 +113,40 @@ func (r Redis) IsZero() bool { return r.Address == "" && r.Database == 0 && len(
 
 // Events represents configuration for the events system.
 type Events struct {
-	Backend string `name:"backend" description:"Backend to use for events (internal, redis)"`
-	Redis   Redis  `name:"redis"`
+	Backend string       `name:"backend" description:"Backend to use for events (internal, redis)"`
+	Redis   Redis        `name:"redis"`
+	Export  EventsExport `name:"export"`
+}
+
+// EventsExport represents configuration for exporting events to external systems.
+type EventsExport struct {
+	HTTP EventsHTTPExport `name:"http"`
+	File EventsFileExport `name:"file"`
+}
+
+// EventsHTTPExport represents configuration for exporting events as CloudEvents over HTTP.
+type EventsHTTPExport struct {
+	Target        string            `name:"target" description:"URL to post CloudEvents to (disabled if empty)"`
+	Headers       map[string]string `name:"headers" description:"Headers to add to each request"`
+	Source        string            `name:"source" description:"Source of the CloudEvents (origin of the event if empty)"`
+	Timeout       time.Duration     `name:"timeout" description:"Timeout of each request"`
+	Retries       int               `name:"retries" description:"Number of times a failed request is retried"`
+	RetryBackoff  time.Duration     `name:"retry-backoff" description:"Initial time to wait before retrying a failed request"`
+	Filters       []string          `name:"filters" description:"Names of events to export (glob)"`
+	BufferSize    int               `name:"buffer-size" description:"Number of events to buffer before events are dropped"`
+	BatchSize     int               `name:"batch-size" description:"Maximum number of events per request"`
+	FlushInterval time.Duration     `name:"flush-interval" description:"Maximum time to buffer events before they are exported"`
+}
+
+// EventsFileExport represents configuration for exporting events to NDJSON files.
+type EventsFileExport struct {
+	Path          string        `name:"path" description:"Path of the file to write events to (disabled if empty)"`
+	MaxSize       int64         `name:"max-size" description:"Size in bytes after which the file is rotated (0 is no rotation)"`
+	MaxFiles      int           `name:"max-files" description:"Number of rotated files to keep"`
+	Filters       []string      `name:"filters" description:"Names of events to export (glob)"`
+	BufferSize    int           `name:"buffer-size" description:"Number of events to buffer before events are dropped"`
+	BatchSize     int           `name:"batch-size" description:"Maximum number of events per write"`
+	FlushInterval time.Duration `name:"flush-interval" description:"Maximum time to buffer events before they are exported"`
 }
 
 // Rights represents the configuration to apply when fetching entity rights.
@@ -126,17 +158,49 @@ type Rights struct {
 
 // KeyVault represents configuration for key vaults.
 type KeyVault struct {
-	Static map[string][]byte `name:"static" description:"Static labeled key encryption keys"`
+	Static  map[string][]byte `name:"static" description:"Static labeled key encryption keys"`
+	PKCS11  KeyVaultPKCS11    `name:"pkcs11" description:"PKCS#11 key vault, where the KEK label is the label of the AES key on the token"`
+	Transit KeyVaultTransit   `name:"transit" description:"Transit encryption service key vault, where the KEK label is the name of the encryption key"`
+
+	RegistryKEKLabel string `name:"registry-kek-label" description:"KEK label to wrap keys stored in device registries with"`
+}
+
+// KeyVaultPKCS11 represents configuration for a PKCS#11 key vault.
+type KeyVaultPKCS11 struct {
+	Module     string `name:"module" description:"Path to the PKCS#11 module"`
+	TokenLabel string `name:"token-label" description:"Label of the token that holds the key encryption keys"`
+	PIN        string `name:"pin" description:"User PIN of the token"`
+}
+
+// KeyVaultTransit represents configuration for a key vault using a transit encryption service.
+type KeyVaultTransit struct {
+	Address string        `name:"address" description:"Address of the transit encryption service"`
+	Token   string        `name:"token" description:"Token to authenticate with the transit encryption service"`
+	Mount   string        `name:"mount" description:"Mount path of the transit secrets engine"`
+	Timeout time.Duration `name:"timeout" description:"Timeout of requests to the transit encryption service (0 is the default)"`
 }
 
 // KeyVault returns an initialized crypto.KeyVault based on the configuration.
-// The order of precedence is Static.
-func (v KeyVault) KeyVault() crypto.KeyVault {
+// The order of precedence is Static, PKCS11 and Transit.
+func (v KeyVault) KeyVault() (crypto.KeyVault, error) {
 	switch {
 	case v.Static != nil:
-		return cryptoutil.NewMemKeyVault(v.Static)
+		return cryptoutil.NewMemKeyVault(v.Static), nil
+	case v.PKCS11.Module != "":
+		return cryptoutil.NewPKCS11KeyVault(cryptoutil.PKCS11KeyVaultConfig{
+			Module:     v.PKCS11.Module,
+			TokenLabel: v.PKCS11.TokenLabel,
+			PIN:        v.PKCS11.PIN,
+		})
+	case v.Transit.Address != "":
+		return cryptoutil.NewTransitKeyVault(cryptoutil.TransitKeyVaultConfig{
+			Address: v.Transit.Address,
+			Token:   v.Transit.Token,
+			Mount:   v.Transit.Mount,
+			Timeout: v.Transit.Timeout,
+		}), nil
 	default:
-		return cryptoutil.NewMemKeyVault(map[string][]byte{})
+		return cryptoutil.NewMemKeyVault(map[string][]byte{}), nil
 	}
 }
 
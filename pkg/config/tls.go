@@ -33,6 +33,7 @@ type TLS struct {
 	RootCA      string `name:"root-ca" description:"Location of TLS root CA certificate (optional)"`
 	Certificate string `name:"certificate" description:"Location of TLS certificate"`
 	Key         string `name:"key" description:"Location of TLS private key"`
+	ClientCA    string `name:"client-ca" description:"Location of TLS CA certificate to verify client certificates (optional)"`
 }
 
 var errNoKeyPair = errors.DefineFailedPrecondition("no_key_pair", "no TLS key pair")
@@ -66,6 +67,15 @@ func (t TLS) Config(ctx context.Context) (*tls.Config, error) {
 		rootCAs = x509.NewCertPool()
 		rootCAs.AppendCertsFromPEM(pem)
 	}
+	var clientCAs *x509.CertPool
+	if t.ClientCA != "" {
+		pem, err := ioutil.ReadFile(t.ClientCA)
+		if err != nil {
+			return nil, err
+		}
+		clientCAs = x509.NewCertPool()
+		clientCAs.AppendCertsFromPEM(pem)
+	}
 
 	debounce := make(chan struct{}, 1)
 	fs.Watch(t.Certificate, events.HandlerFunc(func(evt events.Event) {
@@ -94,6 +104,10 @@ func (t TLS) Config(ctx context.Context) (*tls.Config, error) {
 				PreferServerCipherSuites: true,
 				MinVersion:               tls.VersionTLS12,
 			}
+			if clientCAs != nil {
+				tlsConfig.ClientCAs = clientCAs
+				tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+			}
 			for _, proto := range info.SupportedProtos {
 				if proto == "h2" {
 					tlsConfig.NextProtos = []string{"h2"}
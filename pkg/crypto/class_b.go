@@ -0,0 +1,42 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"crypto/aes"
+	"encoding/binary"
+
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+// ComputePingOffset computes the ping offset of the device with address addr
+// in the beacon period starting at beaconTime, which is the time of the beacon
+// in seconds since GPS epoch, and pingPeriod is the period of the ping slots in slots.
+// See LoRaWAN Specification 1.1, chapter 13.1.
+func ComputePingOffset(beaconTime uint32, addr types.DevAddr, pingPeriod uint16) uint16 {
+	if pingPeriod == 0 {
+		panic("ping period must be greater than 0")
+	}
+	var key [aes.BlockSize]byte
+	cipher, err := aes.NewCipher(key[:])
+	if err != nil {
+		panic(err) // types.AES128Key
+	}
+	var b [aes.BlockSize]byte
+	binary.LittleEndian.PutUint32(b[0:4], beaconTime)
+	copy(b[4:8], reverse(addr[:]))
+	cipher.Encrypt(b[:], b[:])
+	return (uint16(b[0]) + uint16(b[1])*256) % pingPeriod
+}
@@ -0,0 +1,34 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestComputePingOffset(t *testing.T) {
+	a := assertions.New(t)
+
+	var addr = types.DevAddr{1, 2, 3, 4}
+
+	a.So(ComputePingOffset(0x12345680, addr, 4096), should.Equal, 2234)
+	a.So(ComputePingOffset(0x12345680, addr, 32), should.Equal, 26)
+	a.So(ComputePingOffset(0x4ab4c400, addr, 4096), should.Equal, 626)
+	a.So(ComputePingOffset(0x4ab4c400, addr, 128), should.Equal, 114)
+}
@@ -0,0 +1,127 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoutil
+
+import (
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// WrapKeyEnvelope wraps the key in the given envelope in place using the given key vault and KEK label.
+// Envelopes that are nil, empty or already wrapped are left untouched. If the KEK label is empty, this is a no-op.
+func WrapKeyEnvelope(ke *ttnpb.KeyEnvelope, kekLabel string, v crypto.KeyVault) error {
+	if kekLabel == "" || ke == nil || len(ke.Key) == 0 || ke.KEKLabel != "" {
+		return nil
+	}
+	wrapped, err := v.Wrap(ke.Key, kekLabel)
+	if err != nil {
+		return err
+	}
+	ke.Key, ke.KEKLabel = wrapped, kekLabel
+	return nil
+}
+
+// UnwrapKeyEnvelope unwraps the key in the given envelope in place using the given key vault,
+// if the envelope is wrapped with the given KEK label. Envelopes wrapped with other KEK labels are left untouched.
+// If the KEK label is empty, this is a no-op.
+func UnwrapKeyEnvelope(ke *ttnpb.KeyEnvelope, kekLabel string, v crypto.KeyVault) error {
+	if kekLabel == "" || ke == nil || ke.KEKLabel != kekLabel {
+		return nil
+	}
+	key, err := v.Unwrap(ke.Key, kekLabel)
+	if err != nil {
+		return err
+	}
+	ke.Key, ke.KEKLabel = key, ""
+	return nil
+}
+
+// RewrapKeyEnvelope re-wraps the key in the given envelope in place from the old KEK label to the new KEK label.
+// If the old KEK label is empty, plaintext keys are wrapped. If the new KEK label is empty, keys are unwrapped.
+// RewrapKeyEnvelope returns whether the envelope changed.
+func RewrapKeyEnvelope(ke *ttnpb.KeyEnvelope, oldKEKLabel, newKEKLabel string, v crypto.KeyVault) (bool, error) {
+	if oldKEKLabel == newKEKLabel || ke == nil || len(ke.Key) == 0 || ke.KEKLabel != oldKEKLabel {
+		return false, nil
+	}
+	if err := UnwrapKeyEnvelope(ke, oldKEKLabel, v); err != nil {
+		return false, err
+	}
+	if err := WrapKeyEnvelope(ke, newKEKLabel, v); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// SessionKeyEnvelopes returns the key envelopes of the given session keys.
+func SessionKeyEnvelopes(sk *ttnpb.SessionKeys) []*ttnpb.KeyEnvelope {
+	if sk == nil {
+		return nil
+	}
+	return []*ttnpb.KeyEnvelope{sk.FNwkSIntKey, sk.SNwkSIntKey, sk.NwkSEncKey, sk.AppSKey}
+}
+
+// EndDeviceKeyEnvelopes returns the key envelopes of the root keys, the sessions and the queued join-accept of the given end device.
+func EndDeviceKeyEnvelopes(dev *ttnpb.EndDevice) []*ttnpb.KeyEnvelope {
+	var kes []*ttnpb.KeyEnvelope
+	if dev.RootKeys != nil {
+		kes = append(kes, dev.RootKeys.AppKey, dev.RootKeys.NwkKey)
+	}
+	if dev.Session != nil {
+		kes = append(kes, SessionKeyEnvelopes(&dev.Session.SessionKeys)...)
+	}
+	if dev.PendingSession != nil {
+		kes = append(kes, SessionKeyEnvelopes(&dev.PendingSession.SessionKeys)...)
+	}
+	if dev.MACState != nil && dev.MACState.QueuedJoinAccept != nil {
+		kes = append(kes, SessionKeyEnvelopes(&dev.MACState.QueuedJoinAccept.Keys)...)
+	}
+	return kes
+}
+
+// WrapKeyEnvelopes wraps the keys in the given envelopes in place. See WrapKeyEnvelope.
+func WrapKeyEnvelopes(kes []*ttnpb.KeyEnvelope, kekLabel string, v crypto.KeyVault) error {
+	for _, ke := range kes {
+		if err := WrapKeyEnvelope(ke, kekLabel, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnwrapKeyEnvelopes unwraps the keys in the given envelopes in place. See UnwrapKeyEnvelope.
+func UnwrapKeyEnvelopes(kes []*ttnpb.KeyEnvelope, kekLabel string, v crypto.KeyVault) error {
+	for _, ke := range kes {
+		if err := UnwrapKeyEnvelope(ke, kekLabel, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RewrapKeyEnvelopes re-wraps the keys in the given envelopes in place and returns the number of changed envelopes.
+// See RewrapKeyEnvelope.
+func RewrapKeyEnvelopes(kes []*ttnpb.KeyEnvelope, oldKEKLabel, newKEKLabel string, v crypto.KeyVault) (int, error) {
+	var n int
+	for _, ke := range kes {
+		changed, err := RewrapKeyEnvelope(ke, oldKEKLabel, newKEKLabel, v)
+		if err != nil {
+			return n, err
+		}
+		if changed {
+			n++
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,79 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoutil_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestEndDeviceKeyEnvelopes(t *testing.T) {
+	a := assertions.New(t)
+
+	key, _ := hex.DecodeString("00112233445566778899AABBCCDDEEFF")
+	kekSKey, _ := hex.DecodeString("000102030405060708090A0B0C0D0E0F")
+	cipherSKey, _ := hex.DecodeString("1FA68B0A8112B447AEF34BD8FB5A7B829D3E862371D2CFE5")
+	kekOther, _ := hex.DecodeString("000102030405060708090A0B0C0D0E0F1011121314151617")
+	cipherOther, _ := hex.DecodeString("96778B25AE6CA435F92B5B97C050AED2468AB8A17AD84E5D")
+
+	v := cryptoutil.NewMemKeyVault(map[string][]byte{
+		"skey":  kekSKey,
+		"other": kekOther,
+	})
+
+	dev := &ttnpb.EndDevice{
+		RootKeys: &ttnpb.RootKeys{
+			AppKey: &ttnpb.KeyEnvelope{Key: key},
+		},
+		Session: &ttnpb.Session{
+			SessionKeys: ttnpb.SessionKeys{
+				FNwkSIntKey: &ttnpb.KeyEnvelope{Key: key},
+				AppSKey:     &ttnpb.KeyEnvelope{Key: []byte{0x1, 0x2}, KEKLabel: "as"},
+			},
+		},
+	}
+	kes := cryptoutil.EndDeviceKeyEnvelopes(dev)
+	a.So(kes, should.HaveLength, 6)
+
+	a.So(cryptoutil.WrapKeyEnvelopes(kes, "skey", v), should.BeNil)
+	a.So(dev.RootKeys.AppKey, should.Resemble, &ttnpb.KeyEnvelope{Key: cipherSKey, KEKLabel: "skey"})
+	a.So(dev.RootKeys.NwkKey, should.BeNil)
+	a.So(dev.Session.FNwkSIntKey, should.Resemble, &ttnpb.KeyEnvelope{Key: cipherSKey, KEKLabel: "skey"})
+	a.So(dev.Session.AppSKey, should.Resemble, &ttnpb.KeyEnvelope{Key: []byte{0x1, 0x2}, KEKLabel: "as"})
+
+	n, err := cryptoutil.RewrapKeyEnvelopes(kes, "skey", "other", v)
+	a.So(err, should.BeNil)
+	a.So(n, should.Equal, 2)
+	a.So(dev.RootKeys.AppKey, should.Resemble, &ttnpb.KeyEnvelope{Key: cipherOther, KEKLabel: "other"})
+	a.So(dev.Session.FNwkSIntKey, should.Resemble, &ttnpb.KeyEnvelope{Key: cipherOther, KEKLabel: "other"})
+
+	a.So(cryptoutil.UnwrapKeyEnvelopes(kes, "skey", v), should.BeNil)
+	a.So(dev.RootKeys.AppKey, should.Resemble, &ttnpb.KeyEnvelope{Key: cipherOther, KEKLabel: "other"})
+
+	a.So(cryptoutil.UnwrapKeyEnvelopes(kes, "other", v), should.BeNil)
+	a.So(dev.RootKeys.AppKey, should.Resemble, &ttnpb.KeyEnvelope{Key: key})
+	a.So(dev.Session.FNwkSIntKey, should.Resemble, &ttnpb.KeyEnvelope{Key: key})
+	a.So(dev.Session.AppSKey, should.Resemble, &ttnpb.KeyEnvelope{Key: []byte{0x1, 0x2}, KEKLabel: "as"})
+
+	n, err = cryptoutil.RewrapKeyEnvelopes(kes, "", "skey", v)
+	a.So(err, should.BeNil)
+	a.So(n, should.Equal, 2)
+	a.So(dev.RootKeys.AppKey, should.Resemble, &ttnpb.KeyEnvelope{Key: cipherSKey, KEKLabel: "skey"})
+}
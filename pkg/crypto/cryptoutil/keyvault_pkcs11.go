@@ -0,0 +1,186 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+// +build cgo
+
+package cryptoutil
+
+import (
+	"sync"
+
+	"github.com/miekg/pkcs11"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+// PKCS11KeyVault is a KeyVault that wraps and unwraps keys with AES key wrap (RFC 3394) in a PKCS#11 token, for
+// example a hardware security module. The KEKs are AES secret keys on the token, identified by their label.
+// The KEKs never leave the token. The ciphertexts are compatible with MemKeyVault using the same KEKs.
+type PKCS11KeyVault struct {
+	ctx *pkcs11.Ctx
+
+	mu      sync.Mutex
+	session pkcs11.SessionHandle
+	keks    map[string]pkcs11.ObjectHandle
+}
+
+var (
+	errPKCS11Module        = errors.DefineFailedPrecondition("pkcs11_module", "failed to load PKCS#11 module `{module}`")
+	errPKCS11              = errors.DefineUnavailable("pkcs11", "PKCS#11 operation failed")
+	errPKCS11TokenNotFound = errors.DefineNotFound("pkcs11_token_not_found", "PKCS#11 token with label `{label}` not found")
+)
+
+// NewPKCS11KeyVault returns a PKCS11KeyVault that uses the token in the given PKCS#11 module.
+// The token is looked up by its label and a session is opened and logged in with the user PIN.
+func NewPKCS11KeyVault(conf PKCS11KeyVaultConfig) (v *PKCS11KeyVault, err error) {
+	ctx := pkcs11.New(conf.Module)
+	if ctx == nil {
+		return nil, errPKCS11Module.WithAttributes("module", conf.Module)
+	}
+	defer func() {
+		if err != nil {
+			ctx.Destroy()
+		}
+	}()
+	if err := ctx.Initialize(); err != nil {
+		return nil, errPKCS11.WithCause(err)
+	}
+	defer func() {
+		if err != nil {
+			ctx.Finalize()
+		}
+	}()
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return nil, errPKCS11.WithCause(err)
+	}
+	for _, slot := range slots {
+		info, err := ctx.GetTokenInfo(slot)
+		if err != nil {
+			return nil, errPKCS11.WithCause(err)
+		}
+		if info.Label != conf.TokenLabel {
+			continue
+		}
+		session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+		if err != nil {
+			return nil, errPKCS11.WithCause(err)
+		}
+		if err := ctx.Login(session, pkcs11.CKU_USER, conf.PIN); err != nil {
+			ctx.CloseSession(session)
+			return nil, errPKCS11.WithCause(err)
+		}
+		return &PKCS11KeyVault{
+			ctx:     ctx,
+			session: session,
+			keks:    make(map[string]pkcs11.ObjectHandle),
+		}, nil
+	}
+	return nil, errPKCS11TokenNotFound.WithAttributes("label", conf.TokenLabel)
+}
+
+// Close logs out, closes the session and unloads the PKCS#11 module.
+func (v *PKCS11KeyVault) Close() error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.ctx.Logout(v.session)
+	v.ctx.CloseSession(v.session)
+	err := v.ctx.Finalize()
+	v.ctx.Destroy()
+	return err
+}
+
+var keyWrapMechanism = []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_KEY_WRAP, nil)}
+
+// kek returns the handle of the KEK with the given label. This method requires the mutex to be held.
+func (v *PKCS11KeyVault) kek(label string) (pkcs11.ObjectHandle, error) {
+	if kek, ok := v.keks[label]; ok {
+		return kek, nil
+	}
+	if err := v.ctx.FindObjectsInit(v.session, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_SECRET_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_AES),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}); err != nil {
+		return 0, errPKCS11.WithCause(err)
+	}
+	objects, _, err := v.ctx.FindObjects(v.session, 1)
+	if finalErr := v.ctx.FindObjectsFinal(v.session); err == nil {
+		err = finalErr
+	}
+	if err != nil {
+		return 0, errPKCS11.WithCause(err)
+	}
+	if len(objects) == 0 {
+		return 0, errKEKNotFound.WithAttributes("label", label)
+	}
+	v.keks[label] = objects[0]
+	return objects[0], nil
+}
+
+// secretKeyTemplate returns the template of an extractable session secret key.
+func secretKeyTemplate(attrs ...*pkcs11.Attribute) []*pkcs11.Attribute {
+	return append([]*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_SECRET_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_GENERIC_SECRET),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, false),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, true),
+	}, attrs...)
+}
+
+// Wrap implements KeyVault.
+func (v *PKCS11KeyVault) Wrap(plaintext []byte, kekLabel string) ([]byte, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	kek, err := v.kek(kekLabel)
+	if err != nil {
+		return nil, err
+	}
+	// The plaintext is imported as session key, so that the token wraps it with the KEK.
+	key, err := v.ctx.CreateObject(v.session, secretKeyTemplate(
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE, plaintext),
+	))
+	if err != nil {
+		return nil, errPKCS11.WithCause(err)
+	}
+	defer v.ctx.DestroyObject(v.session, key)
+	ciphertext, err := v.ctx.WrapKey(v.session, keyWrapMechanism, kek, key)
+	if err != nil {
+		return nil, errPKCS11.WithCause(err)
+	}
+	return ciphertext, nil
+}
+
+// Unwrap implements KeyVault.
+func (v *PKCS11KeyVault) Unwrap(ciphertext []byte, kekLabel string) ([]byte, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	kek, err := v.kek(kekLabel)
+	if err != nil {
+		return nil, err
+	}
+	key, err := v.ctx.UnwrapKey(v.session, keyWrapMechanism, kek, ciphertext, secretKeyTemplate())
+	if err != nil {
+		return nil, errPKCS11.WithCause(err)
+	}
+	defer v.ctx.DestroyObject(v.session, key)
+	attrs, err := v.ctx.GetAttributeValue(v.session, key, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE, nil),
+	})
+	if err != nil {
+		return nil, errPKCS11.WithCause(err)
+	}
+	return attrs[0].Value, nil
+}
@@ -0,0 +1,89 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+// +build cgo
+
+package cryptoutil
+
+import (
+	"encoding/hex"
+	"os"
+	"testing"
+
+	"github.com/miekg/pkcs11"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+// TestPKCS11KeyVault tests the PKCS11KeyVault with a token, for example SoftHSM.
+// The token is configured by the TEST_PKCS11_MODULE, TEST_PKCS11_TOKEN_LABEL and TEST_PKCS11_PIN environment variables.
+func TestPKCS11KeyVault(t *testing.T) {
+	module := os.Getenv("TEST_PKCS11_MODULE")
+	if module == "" {
+		t.Skip("TEST_PKCS11_MODULE is not set, skipping PKCS#11 tests")
+	}
+	a := assertions.New(t)
+
+	plaintext, _ := hex.DecodeString("00112233445566778899AABBCCDDEEFF")
+	kek, _ := hex.DecodeString("000102030405060708090A0B0C0D0E0F")
+	ciphertext, _ := hex.DecodeString("1FA68B0A8112B447AEF34BD8FB5A7B829D3E862371D2CFE5")
+
+	v, err := NewPKCS11KeyVault(PKCS11KeyVaultConfig{
+		Module:     module,
+		TokenLabel: os.Getenv("TEST_PKCS11_TOKEN_LABEL"),
+		PIN:        os.Getenv("TEST_PKCS11_PIN"),
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer v.Close()
+
+	// Import the KEK as session object, so that it is removed from the token when the session closes.
+	_, err = v.ctx.CreateObject(v.session, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_SECRET_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_AES),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, false),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, "foo"),
+		pkcs11.NewAttribute(pkcs11.CKA_WRAP, true),
+		pkcs11.NewAttribute(pkcs11.CKA_UNWRAP, true),
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE, kek),
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// Non-existing KEK.
+	{
+		_, err := v.Wrap(plaintext, "bar")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	}
+	{
+		_, err := v.Unwrap(ciphertext, "bar")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	}
+
+	// Existing KEK; the ciphertext is compatible with MemKeyVault.
+	{
+		actual, err := v.Wrap(plaintext, "foo")
+		a.So(err, should.BeNil)
+		a.So(actual, should.Resemble, ciphertext)
+	}
+	{
+		actual, err := v.Unwrap(ciphertext, "foo")
+		a.So(err, should.BeNil)
+		a.So(actual, should.Resemble, plaintext)
+	}
+}
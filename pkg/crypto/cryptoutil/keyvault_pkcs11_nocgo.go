@@ -0,0 +1,46 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !cgo
+// +build !cgo
+
+package cryptoutil
+
+import (
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+// PKCS11KeyVault is a KeyVault that wraps and unwraps keys in a PKCS#11 token.
+// PKCS#11 requires cgo; this build does not support PKCS#11.
+type PKCS11KeyVault struct{}
+
+var errPKCS11Unsupported = errors.DefineFailedPrecondition("pkcs11_unsupported", "PKCS#11 is not supported in this build")
+
+// NewPKCS11KeyVault returns an error as PKCS#11 is not supported in this build.
+func NewPKCS11KeyVault(conf PKCS11KeyVaultConfig) (*PKCS11KeyVault, error) {
+	return nil, errPKCS11Unsupported
+}
+
+// Close implements io.Closer.
+func (v *PKCS11KeyVault) Close() error { return errPKCS11Unsupported }
+
+// Wrap implements KeyVault.
+func (v *PKCS11KeyVault) Wrap(plaintext []byte, kekLabel string) ([]byte, error) {
+	return nil, errPKCS11Unsupported
+}
+
+// Unwrap implements KeyVault.
+func (v *PKCS11KeyVault) Unwrap(ciphertext []byte, kekLabel string) ([]byte, error) {
+	return nil, errPKCS11Unsupported
+}
@@ -0,0 +1,155 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoutil
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+// DefaultTransitTimeout is the default timeout of requests to the transit encryption service.
+const DefaultTransitTimeout = 5 * time.Second
+
+// TransitKeyVaultConfig is the configuration of a TransitKeyVault.
+type TransitKeyVaultConfig struct {
+	// Address is the base URL of the transit encryption service.
+	Address string
+	// Token is the token to authenticate with the transit encryption service.
+	Token string
+	// Mount is the path where the transit secrets engine is mounted. If empty, transit is used.
+	Mount string
+	// Timeout is the timeout of requests to the transit encryption service. If zero, DefaultTransitTimeout is used.
+	Timeout time.Duration
+}
+
+// TransitKeyVault is a KeyVault that wraps and unwraps keys with a transit encryption service.
+// The service implements the HTTP API of the HashiCorp Vault transit secrets engine, where the KEK label is the name
+// of the encryption key. The KEKs never leave the service.
+type TransitKeyVault struct {
+	address string
+	token   string
+	mount   string
+	client  *http.Client
+}
+
+// NewTransitKeyVault returns a TransitKeyVault.
+func NewTransitKeyVault(conf TransitKeyVaultConfig) *TransitKeyVault {
+	mount := strings.Trim(conf.Mount, "/")
+	if mount == "" {
+		mount = "transit"
+	}
+	timeout := conf.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTransitTimeout
+	}
+	return &TransitKeyVault{
+		address: strings.TrimSuffix(conf.Address, "/"),
+		token:   conf.Token,
+		mount:   mount,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+var (
+	errTransitRequest  = errors.DefineUnavailable("transit_request", "transit request failed")
+	errTransitResponse = errors.Define("transit_response", "transit request failed with status `{code}`: `{message}`")
+	errTransitDecode   = errors.DefineCorruption("transit_decode", "failed to decode transit response")
+)
+
+type transitRequest struct {
+	Plaintext  string `json:"plaintext,omitempty"`
+	Ciphertext string `json:"ciphertext,omitempty"`
+}
+
+type transitResponse struct {
+	Data struct {
+		Plaintext  string `json:"plaintext"`
+		Ciphertext string `json:"ciphertext"`
+	} `json:"data"`
+	Errors []string `json:"errors"`
+}
+
+func (v *TransitKeyVault) do(operation, kekLabel string, req *transitRequest) (*transitResponse, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequest(http.MethodPost,
+		fmt.Sprintf("%s/v1/%s/%s/%s", v.address, v.mount, operation, url.PathEscape(kekLabel)),
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("X-Vault-Token", v.token)
+	httpRes, err := v.client.Do(httpReq)
+	if err != nil {
+		return nil, errTransitRequest.WithCause(err)
+	}
+	defer httpRes.Body.Close()
+	res := &transitResponse{}
+	decodeErr := json.NewDecoder(httpRes.Body).Decode(res)
+	if httpRes.StatusCode != http.StatusOK {
+		return nil, errTransitResponse.WithCause(errors.FromHTTPStatusCode(httpRes.StatusCode)).WithAttributes(
+			"code", httpRes.StatusCode,
+			"message", strings.Join(res.Errors, "; "),
+		)
+	}
+	if decodeErr != nil {
+		return nil, errTransitDecode.WithCause(decodeErr)
+	}
+	return res, nil
+}
+
+// Wrap implements KeyVault.
+// The returned ciphertext is the ciphertext of the transit encryption service, which includes the key version.
+func (v *TransitKeyVault) Wrap(plaintext []byte, kekLabel string) ([]byte, error) {
+	res, err := v.do("encrypt", kekLabel, &transitRequest{
+		Plaintext: base64.StdEncoding.EncodeToString(plaintext),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Data.Ciphertext == "" {
+		return nil, errTransitDecode
+	}
+	return []byte(res.Data.Ciphertext), nil
+}
+
+// Unwrap implements KeyVault.
+func (v *TransitKeyVault) Unwrap(ciphertext []byte, kekLabel string) ([]byte, error) {
+	res, err := v.do("decrypt", kekLabel, &transitRequest{
+		Ciphertext: string(ciphertext),
+	})
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := base64.StdEncoding.DecodeString(res.Data.Plaintext)
+	if err != nil {
+		return nil, errTransitDecode.WithCause(err)
+	}
+	return plaintext, nil
+}
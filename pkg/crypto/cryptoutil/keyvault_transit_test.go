@@ -0,0 +1,138 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoutil_test
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+// transitStub is a transit encryption service stub that wraps keys with KEKs in memory.
+type transitStub struct {
+	token string
+	keks  *cryptoutil.MemKeyVault
+}
+
+func (s *transitStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	writeError := func(code int, message string) {
+		w.WriteHeader(code)
+		json.NewEncoder(w).Encode(map[string][]string{"errors": {message}})
+	}
+	if r.Header.Get("X-Vault-Token") != s.token {
+		writeError(http.StatusForbidden, "permission denied")
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/v1/transit/"), "/")
+	if r.Method != http.MethodPost || len(parts) != 2 {
+		writeError(http.StatusNotFound, "not found")
+		return
+	}
+	var req struct {
+		Plaintext  string `json:"plaintext"`
+		Ciphertext string `json:"ciphertext"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(http.StatusBadRequest, err.Error())
+		return
+	}
+	res := make(map[string]string)
+	switch parts[0] {
+	case "encrypt":
+		plaintext, err := base64.StdEncoding.DecodeString(req.Plaintext)
+		if err != nil {
+			writeError(http.StatusBadRequest, err.Error())
+			return
+		}
+		ciphertext, err := s.keks.Wrap(plaintext, parts[1])
+		if err != nil {
+			writeError(http.StatusBadRequest, err.Error())
+			return
+		}
+		res["ciphertext"] = "vault:v1:" + base64.StdEncoding.EncodeToString(ciphertext)
+	case "decrypt":
+		ciphertext, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(req.Ciphertext, "vault:v1:"))
+		if err != nil {
+			writeError(http.StatusBadRequest, err.Error())
+			return
+		}
+		plaintext, err := s.keks.Unwrap(ciphertext, parts[1])
+		if err != nil {
+			writeError(http.StatusBadRequest, err.Error())
+			return
+		}
+		res["plaintext"] = base64.StdEncoding.EncodeToString(plaintext)
+	default:
+		writeError(http.StatusNotFound, "not found")
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{"data": res})
+}
+
+func TestTransitKeyVault(t *testing.T) {
+	a := assertions.New(t)
+
+	plaintext, _ := hex.DecodeString("00112233445566778899AABBCCDDEEFF")
+	kek, _ := hex.DecodeString("000102030405060708090A0B0C0D0E0F")
+	ciphertext, _ := hex.DecodeString("1FA68B0A8112B447AEF34BD8FB5A7B829D3E862371D2CFE5")
+
+	srv := httptest.NewServer(&transitStub{
+		token: "test-token",
+		keks: cryptoutil.NewMemKeyVault(map[string][]byte{
+			"foo": kek,
+		}),
+	})
+	defer srv.Close()
+
+	v := cryptoutil.NewTransitKeyVault(cryptoutil.TransitKeyVaultConfig{
+		Address: srv.URL,
+		Token:   "test-token",
+	})
+
+	// Non-existing KEK.
+	{
+		_, err := v.Wrap(plaintext, "bar")
+		a.So(err, should.NotBeNil)
+	}
+
+	// Existing KEK.
+	{
+		actual, err := v.Wrap(plaintext, "foo")
+		a.So(err, should.BeNil)
+		a.So(string(actual), should.Equal, "vault:v1:"+base64.StdEncoding.EncodeToString(ciphertext))
+
+		actual, err = v.Unwrap(actual, "foo")
+		a.So(err, should.BeNil)
+		a.So(actual, should.Resemble, plaintext)
+	}
+
+	// Invalid token.
+	{
+		v := cryptoutil.NewTransitKeyVault(cryptoutil.TransitKeyVaultConfig{
+			Address: srv.URL,
+			Token:   "invalid-token",
+		})
+		_, err := v.Wrap(plaintext, "foo")
+		a.So(err, should.NotBeNil)
+	}
+}
@@ -150,6 +150,12 @@ func (c Client) DeviceVersions(brandID, modelID string) ([]ttnpb.EndDeviceVersio
 						return 0, "", errFetchFailed.WithCause(err).WithAttributes("filename", pf.Parameter)
 					}
 					return ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT, string(content), nil
+				case "schema":
+					content, err = c.Fetcher.File(brandID, modelID, hwVersion, pf.Parameter)
+					if err != nil {
+						return 0, "", errFetchFailed.WithCause(err).WithAttributes("filename", pf.Parameter)
+					}
+					return ttnpb.PayloadFormatter_FORMATTER_SCHEMA, string(content), nil
 				default:
 					return 0, "", errInvalidPayloadFormatter.WithAttributes("formatter", pf.Type)
 				}
@@ -0,0 +1,148 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/oklog/ulid"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/version"
+)
+
+// CloudEventsContentType is the content type of batched CloudEvents in JSON format.
+const CloudEventsContentType = "application/cloudevents-batch+json"
+
+var userAgent = "ttn-lw-stack/" + version.TTN
+
+type cloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// CloudEventsSink is a Sink that delivers batches of events as CloudEvents in JSON format over HTTP.
+// Each CloudEvent has the event name as type and the event in JSON format as data.
+type CloudEventsSink struct {
+	Client *http.Client
+	// Target is the URL that the batches are posted to.
+	Target string
+	// Headers are added to each request.
+	Headers map[string]string
+	// Source is the source of the CloudEvents. If empty, the origin of the event is used.
+	Source string
+	// Retries is the number of times a failed request is retried.
+	Retries int
+	// RetryBackoff is the initial time to wait before retrying a failed request. It is doubled on every retry.
+	RetryBackoff time.Duration
+}
+
+func (s *CloudEventsSink) marshal(evts []events.Event) ([]byte, error) {
+	ces := make([]cloudEvent, 0, len(evts))
+	entropy := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for _, evt := range evts {
+		pb, err := events.Proto(evt)
+		if err != nil {
+			return nil, err
+		}
+		data, err := jsonpb.TTN().Marshal(pb)
+		if err != nil {
+			return nil, err
+		}
+		source := s.Source
+		if source == "" {
+			source = evt.Origin()
+		}
+		ces = append(ces, cloudEvent{
+			SpecVersion:     "1.0",
+			ID:              ulid.MustNew(ulid.Timestamp(evt.Time()), entropy).String(),
+			Source:          source,
+			Type:            evt.Name(),
+			Time:            evt.Time(),
+			DataContentType: "application/json",
+			Data:            data,
+		})
+	}
+	return json.Marshal(ces)
+}
+
+var errRequest = errors.DefineUnavailable("request", "request failed with status `{code}`")
+
+func (s *CloudEventsSink) post(ctx context.Context, body []byte) (retryable bool, err error) {
+	req, err := http.NewRequest(http.MethodPost, s.Target, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", CloudEventsContentType)
+	req.Header.Set("User-Agent", userAgent)
+	for key, value := range s.Headers {
+		req.Header.Set(key, value)
+	}
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer func() {
+		io.Copy(ioutil.Discard, res.Body)
+		res.Body.Close()
+	}()
+	if res.StatusCode >= 200 && res.StatusCode <= 299 {
+		return false, nil
+	}
+	retryable = res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500
+	return retryable, errRequest.WithAttributes("code", res.StatusCode)
+}
+
+// Export implements Sink.
+// Requests that fail because of network errors, server errors or rate limiting are retried.
+func (s *CloudEventsSink) Export(ctx context.Context, evts []events.Event) error {
+	body, err := s.marshal(evts)
+	if err != nil {
+		return err
+	}
+	backoff := s.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		retryable, err := s.post(ctx, body)
+		if err == nil || !retryable || attempt >= s.Retries {
+			return err
+		}
+		log.FromContext(ctx).WithError(err).WithField("attempt", attempt+1).Debug("Retry export of events")
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
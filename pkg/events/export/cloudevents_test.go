@@ -0,0 +1,106 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/events/export"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+)
+
+func TestCloudEventsSink(t *testing.T) {
+	a := assertions.New(t)
+
+	var (
+		requests int32
+		status   = int32(http.StatusServiceUnavailable)
+		body     []byte
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		a.So(r.Header.Get("Content-Type"), should.Equal, export.CloudEventsContentType)
+		a.So(r.Header.Get("Authorization"), should.Equal, "Bearer secret")
+		body, _ = ioutil.ReadAll(r.Body)
+		w.WriteHeader(int(atomic.LoadInt32(&status)))
+	}))
+	defer server.Close()
+
+	sink := &export.CloudEventsSink{
+		Client:       server.Client(),
+		Target:       server.URL,
+		Headers:      map[string]string{"Authorization": "Bearer secret"},
+		Source:       "test-cluster",
+		Retries:      2,
+		RetryBackoff: time.Millisecond,
+	}
+	evts := []events.Event{
+		events.New(test.Context(), "test.cloudevents.first", ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"}, nil),
+		events.New(test.Context(), "test.cloudevents.second", nil, "hello"),
+	}
+
+	// Server errors are retried.
+	err := sink.Export(test.Context(), evts)
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+	a.So(atomic.LoadInt32(&requests), should.Equal, 3)
+
+	// Client errors are not retried.
+	atomic.StoreInt32(&requests, 0)
+	atomic.StoreInt32(&status, http.StatusBadRequest)
+	err = sink.Export(test.Context(), evts)
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+	a.So(atomic.LoadInt32(&requests), should.Equal, 1)
+
+	atomic.StoreInt32(&requests, 0)
+	atomic.StoreInt32(&status, http.StatusAccepted)
+	err = sink.Export(test.Context(), evts)
+	a.So(err, should.BeNil)
+	a.So(atomic.LoadInt32(&requests), should.Equal, 1)
+
+	var ces []struct {
+		SpecVersion string          `json:"specversion"`
+		ID          string          `json:"id"`
+		Source      string          `json:"source"`
+		Type        string          `json:"type"`
+		Time        time.Time       `json:"time"`
+		Data        json.RawMessage `json:"data"`
+	}
+	if !a.So(json.Unmarshal(body, &ces), should.BeNil) || !a.So(ces, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	for i, ce := range ces {
+		a.So(ce.SpecVersion, should.Equal, "1.0")
+		a.So(ce.ID, should.NotBeEmpty)
+		a.So(ce.Source, should.Equal, "test-cluster")
+		a.So(ce.Type, should.Equal, evts[i].Name())
+		a.So(ce.Time.Equal(evts[i].Time()), should.BeTrue)
+		evt, err := events.UnmarshalJSON(ce.Data)
+		if a.So(err, should.BeNil) {
+			a.So(evt.Name(), should.Equal, evts[i].Name())
+		}
+	}
+	a.So(ces[0].ID, should.NotEqual, ces[1].ID)
+}
@@ -0,0 +1,201 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package export implements sinks that export events to external systems.
+package export
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/metrics"
+)
+
+const subsystem = "events_export"
+
+var exported = metrics.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: subsystem,
+		Name:      "exported_total",
+		Help:      "Number of events exported",
+	},
+	[]string{"sink"},
+)
+
+var exportFailed = metrics.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: subsystem,
+		Name:      "failed_total",
+		Help:      "Number of events that failed to export",
+	},
+	[]string{"sink"},
+)
+
+var dropped = metrics.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: subsystem,
+		Name:      "dropped_total",
+		Help:      "Number of events dropped because the export buffer was full",
+	},
+	[]string{"sink"},
+)
+
+func init() {
+	metrics.MustRegister(exported, exportFailed, dropped)
+}
+
+// Sink exports batches of events.
+type Sink interface {
+	Export(ctx context.Context, evts []events.Event) error
+}
+
+// Config is the configuration of an Exporter.
+type Config struct {
+	// Filters are the names of the events to export. The names can be globs.
+	// If empty, all events are exported.
+	Filters []string
+	// BufferSize is the number of events that are buffered before events are dropped.
+	BufferSize int
+	// BatchSize is the maximum number of events that are exported at once.
+	BatchSize int
+	// FlushInterval is the maximum time that events are buffered before they are exported.
+	FlushInterval time.Duration
+}
+
+const (
+	// DefaultBufferSize is the default number of events buffered by an Exporter.
+	DefaultBufferSize = 1024
+	// DefaultBatchSize is the default number of events exported at once.
+	DefaultBatchSize = 64
+	// DefaultFlushInterval is the default time that events are buffered before they are exported.
+	DefaultFlushInterval = time.Second
+)
+
+// Exporter is an events.Handler that buffers events and exports them in batches to a Sink.
+// When the buffer is full, events are dropped.
+type Exporter struct {
+	name  string
+	sink  Sink
+	conf  Config
+	queue chan events.Event
+}
+
+// NewExporter returns a new Exporter with the given name that exports events to the sink.
+// The name is used in logs and metrics.
+func NewExporter(name string, sink Sink, conf Config) *Exporter {
+	if conf.BufferSize <= 0 {
+		conf.BufferSize = DefaultBufferSize
+	}
+	if conf.BatchSize <= 0 {
+		conf.BatchSize = DefaultBatchSize
+	}
+	if conf.FlushInterval <= 0 {
+		conf.FlushInterval = DefaultFlushInterval
+	}
+	if len(conf.Filters) == 0 {
+		conf.Filters = []string{"**"}
+	}
+	return &Exporter{
+		name:  name,
+		sink:  sink,
+		conf:  conf,
+		queue: make(chan events.Event, conf.BufferSize),
+	}
+}
+
+// Notify implements events.Handler.
+// This method does not block. If the buffer is full, the event is dropped.
+func (e *Exporter) Notify(evt events.Event) {
+	select {
+	case e.queue <- evt:
+	default:
+		dropped.WithLabelValues(e.name).Inc()
+	}
+}
+
+// Subscribe subscribes the Exporter to the configured event names on the subscriber.
+func (e *Exporter) Subscribe(subscriber events.Subscriber) error {
+	for _, name := range e.conf.Filters {
+		if err := subscriber.Subscribe(name, e); err != nil {
+			e.Unsubscribe(subscriber)
+			return err
+		}
+	}
+	return nil
+}
+
+// Unsubscribe unsubscribes the Exporter from the configured event names on the subscriber.
+func (e *Exporter) Unsubscribe(subscriber events.Subscriber) {
+	for _, name := range e.conf.Filters {
+		subscriber.Unsubscribe(name, e)
+	}
+}
+
+func (e *Exporter) export(ctx context.Context, batch []events.Event) {
+	if err := e.sink.Export(ctx, batch); err != nil {
+		log.FromContext(ctx).WithError(err).WithField("count", len(batch)).Warn("Failed to export events")
+		exportFailed.WithLabelValues(e.name).Add(float64(len(batch)))
+		return
+	}
+	exported.WithLabelValues(e.name).Add(float64(len(batch)))
+}
+
+// Run exports buffered events in batches until the context is done.
+// Events that are buffered when the context is done are exported before this method returns.
+func (e *Exporter) Run(ctx context.Context) error {
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"namespace", "events/export",
+		"sink", e.name,
+	))
+	ticker := time.NewTicker(e.conf.FlushInterval)
+	defer ticker.Stop()
+	batch := make([]events.Event, 0, e.conf.BatchSize)
+	flush := func(ctx context.Context) {
+		if len(batch) == 0 {
+			return
+		}
+		e.export(ctx, batch)
+		batch = make([]events.Event, 0, e.conf.BatchSize)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx := log.NewContext(context.Background(), log.FromContext(ctx))
+		drain:
+			for {
+				select {
+				case evt := <-e.queue:
+					batch = append(batch, evt)
+					if len(batch) >= e.conf.BatchSize {
+						flush(flushCtx)
+					}
+				default:
+					break drain
+				}
+			}
+			flush(flushCtx)
+			return ctx.Err()
+		case evt := <-e.queue:
+			batch = append(batch, evt)
+			if len(batch) >= e.conf.BatchSize {
+				flush(ctx)
+			}
+		case <-ticker.C:
+			flush(ctx)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/events/export"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+)
+
+type mockSink struct {
+	mu      sync.Mutex
+	batches [][]events.Event
+}
+
+func (s *mockSink) Export(ctx context.Context, evts []events.Event) error {
+	s.mu.Lock()
+	s.batches = append(s.batches, evts)
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *mockSink) Batches() [][]events.Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.batches
+}
+
+func TestExporter(t *testing.T) {
+	a := assertions.New(t)
+
+	pubsub := events.NewPubSub(events.DefaultBufferSize)
+	sink := &mockSink{}
+	exporter := export.NewExporter("test", sink, export.Config{
+		Filters:       []string{"test.export.*"},
+		BufferSize:    4,
+		BatchSize:     2,
+		FlushInterval: test.Delay,
+	})
+	a.So(exporter.Subscribe(pubsub), should.BeNil)
+	defer exporter.Unsubscribe(pubsub)
+
+	// The exporter does not run yet, so only the first 4 events are buffered.
+	for i := 0; i < 6; i++ {
+		pubsub.Publish(events.New(test.Context(), "test.export.evt", nil, i))
+	}
+	pubsub.Publish(events.New(test.Context(), "test.other", nil, nil))
+	time.Sleep(test.Delay)
+
+	ctx, cancel := context.WithCancel(test.Context())
+	errCh := make(chan error)
+	go func() {
+		errCh <- exporter.Run(ctx)
+	}()
+	time.Sleep(2 * test.Delay)
+
+	batches := sink.Batches()
+	if a.So(batches, should.HaveLength, 2) {
+		for i, batch := range batches {
+			if a.So(batch, should.HaveLength, 2) {
+				a.So(batch[0].Data(), should.Equal, 2*i)
+				a.So(batch[1].Data(), should.Equal, 2*i+1)
+			}
+		}
+	}
+
+	// A single event is exported after the flush interval.
+	pubsub.Publish(events.New(test.Context(), "test.export.evt", nil, 42))
+	time.Sleep(2 * test.Delay)
+	batches = sink.Batches()
+	if a.So(batches, should.HaveLength, 3) && a.So(batches[2], should.HaveLength, 1) {
+		a.So(batches[2][0].Data(), should.Equal, 42)
+	}
+
+	// Buffered events are exported when the exporter stops.
+	cancel()
+	exporter.Notify(events.New(test.Context(), "test.export.evt", nil, 43))
+	select {
+	case err := <-errCh:
+		a.So(err, should.Equal, context.Canceled)
+	case <-time.After(test.Delay):
+		t.Fatal("Exporter did not stop")
+	}
+	a.So(len(sink.Batches()), should.BeBetweenOrEqual, 3, 4)
+}
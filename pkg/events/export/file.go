@@ -0,0 +1,163 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/events/fs"
+	"go.thethings.network/lorawan-stack/pkg/jsonpb"
+)
+
+// FileSink is a Sink that writes events as newline delimited JSON (NDJSON) to a file.
+// When the file exceeds MaxSize, it is rotated: the current file is renamed to Path.1, the previous Path.1 to Path.2,
+// and so on, keeping at most MaxFiles rotated files.
+// When the file is removed or renamed by another process (for example logrotate), the file is reopened.
+type FileSink struct {
+	// Path is the path of the file to write to.
+	Path string
+	// MaxSize is the size in bytes after which the file is rotated. If zero, the file is not rotated.
+	MaxSize int64
+	// MaxFiles is the number of rotated files to keep.
+	MaxFiles int
+
+	mu       sync.Mutex
+	file     *os.File
+	size     int64
+	watching bool
+	reopen   uint32
+}
+
+// Notify implements events.Handler.
+// This method is called by the filesystem watcher when the file changes.
+func (s *FileSink) Notify(evt events.Event) {
+	switch evt.Name() {
+	case "fs.remove", "fs.rename":
+		atomic.StoreUint32(&s.reopen, 1)
+	}
+}
+
+func (s *FileSink) open() error {
+	if s.file != nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(s.Path), 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(s.Path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	s.file, s.size = f, info.Size()
+	var handler events.Handler
+	if !s.watching {
+		handler = s
+	}
+	if err := fs.Watch(s.Path, handler); err == nil {
+		s.watching = true
+	}
+	return nil
+}
+
+func (s *FileSink) close() error {
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file, s.size = nil, 0
+	return err
+}
+
+func (s *FileSink) rotate() error {
+	if err := s.close(); err != nil {
+		return err
+	}
+	if s.MaxFiles <= 0 {
+		return os.Remove(s.Path)
+	}
+	for i := s.MaxFiles - 1; i > 0; i-- {
+		from := fmt.Sprintf("%s.%d", s.Path, i)
+		if _, err := os.Stat(from); err != nil {
+			continue
+		}
+		if err := os.Rename(from, fmt.Sprintf("%s.%d", s.Path, i+1)); err != nil {
+			return err
+		}
+	}
+	return os.Rename(s.Path, s.Path+".1")
+}
+
+// Export implements Sink.
+func (s *FileSink) Export(ctx context.Context, evts []events.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if atomic.CompareAndSwapUint32(&s.reopen, 1, 0) {
+		if err := s.close(); err != nil {
+			return err
+		}
+	}
+	if err := s.open(); err != nil {
+		return err
+	}
+	w := bufio.NewWriter(s.file)
+	for _, evt := range evts {
+		pb, err := events.Proto(evt)
+		if err != nil {
+			return err
+		}
+		line, err := jsonpb.TTN().Marshal(pb)
+		if err != nil {
+			return err
+		}
+		line = append(line, '\n')
+		if s.MaxSize > 0 && s.size > 0 && s.size+int64(len(line)) > s.MaxSize {
+			if err := w.Flush(); err != nil {
+				return err
+			}
+			if err := s.rotate(); err != nil {
+				return err
+			}
+			if err := s.open(); err != nil {
+				return err
+			}
+			w.Reset(s.file)
+		}
+		n, err := w.Write(line)
+		s.size += int64(n)
+		if err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// Close closes the file.
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.close()
+}
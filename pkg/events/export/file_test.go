@@ -0,0 +1,97 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export_test
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/events/export"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+)
+
+func readEvents(t *testing.T, name string) []string {
+	f, err := os.Open(name)
+	if err != nil {
+		t.Fatalf("Failed to open %s: %v", name, err)
+	}
+	defer f.Close()
+	var names []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		evt, err := events.UnmarshalJSON(scanner.Bytes())
+		if err != nil {
+			t.Fatalf("Failed to unmarshal event: %v", err)
+		}
+		names = append(names, evt.Name())
+	}
+	return names
+}
+
+func TestFileSink(t *testing.T) {
+	a := assertions.New(t)
+
+	dir, err := ioutil.TempDir("", "events_export")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "events.ndjson")
+	evt := func(i int) events.Event {
+		return events.New(test.Context(), fmt.Sprintf("test.file.evt%d", i), nil, nil)
+	}
+	pb, err := events.Proto(evt(0))
+	a.So(err, should.BeNil)
+	size := int64(pb.Size()) // Only used as a rough estimate of the size of a line.
+
+	sink := &export.FileSink{
+		Path:     path,
+		MaxSize:  3 * size,
+		MaxFiles: 2,
+	}
+	defer sink.Close()
+
+	for i := 0; i < 4; i++ {
+		a.So(sink.Export(test.Context(), []events.Event{evt(2 * i), evt(2*i + 1)}), should.BeNil)
+	}
+
+	var all []string
+	for _, name := range []string{path + ".2", path + ".1", path} {
+		lines := readEvents(t, name)
+		a.So(lines, should.NotBeEmpty)
+		all = append(all, lines...)
+	}
+	_, err = os.Stat(path + ".3")
+	a.So(os.IsNotExist(err), should.BeTrue)
+
+	// The oldest events are dropped on rotation.
+	a.So(len(all), should.BeLessThan, 8)
+	a.So(all[len(all)-1], should.Equal, "test.file.evt7")
+
+	// The file is reopened when it is removed by another process.
+	a.So(os.Remove(path), should.BeNil)
+	time.Sleep(test.Delay)
+	a.So(sink.Export(test.Context(), []events.Event{evt(8)}), should.BeNil)
+	a.So(readEvents(t, path), should.Resemble, []string{"test.file.evt8"})
+}
@@ -403,8 +403,9 @@ func (fp FrequencyPlan) Validate() error {
 	return nil
 }
 
-// RespectsDwellTime returns whether the transmission respects the frequency plan's dwell time restrictions.
-func (fp *FrequencyPlan) RespectsDwellTime(isDownlink bool, frequency uint64, duration time.Duration) bool {
+// FindDwellTime returns the dwell time that applies to transmissions on the given frequency.
+// The second return value is false if no dwell time restriction applies.
+func (fp *FrequencyPlan) FindDwellTime(isDownlink bool, frequency uint64) (time.Duration, bool) {
 	var chDwellTime *ChannelDwellTime
 	var channels []Channel
 	if isDownlink {
@@ -426,15 +427,20 @@ func (fp *FrequencyPlan) RespectsDwellTime(isDownlink bool, frequency uint64, du
 	}
 	fpdtEnabled := isDownlink && fp.DwellTime.GetDownlinks() || !isDownlink && fp.DwellTime.GetUplinks()
 	if fpdtEnabled && (chDwellTime == nil || chDwellTime.Enabled == nil) || chDwellTime.GetEnabled() {
-		var dwellTime time.Duration
 		if chDwellTime != nil && chDwellTime.Duration != nil {
-			dwellTime = *chDwellTime.Duration
-		} else {
-			dwellTime = *fp.DwellTime.Duration
+			return *chDwellTime.Duration, true
+		}
+		if fp.DwellTime.Duration != nil {
+			return *fp.DwellTime.Duration, true
 		}
-		return duration <= dwellTime
 	}
-	return true
+	return 0, false
+}
+
+// RespectsDwellTime returns whether the transmission respects the frequency plan's dwell time restrictions.
+func (fp *FrequencyPlan) RespectsDwellTime(isDownlink bool, frequency uint64, duration time.Duration) bool {
+	dwellTime, ok := fp.FindDwellTime(isDownlink, frequency)
+	return !ok || duration <= dwellTime
 }
 
 // ToConcentratorConfig returns the frequency plan in the protobuf format.
@@ -474,4 +474,22 @@ dwell-time:
 			a.So(fp.RespectsDwellTime(tc.IsDownlink, tc.Frequency, tc.Duration), should.Equal, tc.Expected)
 		})
 	}
+
+	for _, tc := range []struct {
+		IsDownlink bool
+		Frequency  uint64
+		Expected   time.Duration
+		OK         bool
+	}{
+		{IsDownlink: false, Frequency: 1},
+		{IsDownlink: false, Frequency: 2, Expected: 100 * time.Millisecond, OK: true},
+		{IsDownlink: false, Frequency: 3, Expected: 400 * time.Millisecond, OK: true},
+		{IsDownlink: true, Frequency: 1},
+		{IsDownlink: true, Frequency: 2, Expected: 100 * time.Millisecond, OK: true},
+		{IsDownlink: true, Frequency: 4, Expected: 400 * time.Millisecond, OK: true},
+	} {
+		dwellTime, ok := fp.FindDwellTime(tc.IsDownlink, tc.Frequency)
+		a.So(ok, should.Equal, tc.OK)
+		a.So(dwellTime, should.Equal, tc.Expected)
+	}
 }
@@ -14,7 +14,10 @@
 
 package gatewayserver
 
-import "go.thethings.network/lorawan-stack/pkg/gatewayserver/io/udp"
+import (
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/basicstation"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/udp"
+)
 
 // MQTTConfig contains MQTT configuration of the Gateway Server.
 type MQTTConfig struct {
@@ -28,11 +31,19 @@ type UDPConfig struct {
 	Listeners  map[string]string `name:"listeners" description:"Listen addresses with (optional) fallback frequency plan ID for non-registered gateways"`
 }
 
+// BasicStationConfig defines the LoRa Basics Station configuration of the Gateway Server.
+type BasicStationConfig struct {
+	basicstation.Config `name:",squash"`
+	Listen              string `name:"listen" description:"Address for the Basic Station frontend to listen on"`
+	ListenTLS           string `name:"listen-tls" description:"Address for the Basic Station frontend to listen on with TLS"`
+}
+
 // Config represents the Gateway Server configuration.
 type Config struct {
 	RequireRegisteredGateways bool `name:"require-registered-gateways" description:"Require the gateways to be registered in the Identity Server"`
 
-	MQTT   MQTTConfig `name:"mqtt"`
-	MQTTV2 MQTTConfig `name:"mqtt-v2"`
-	UDP    UDPConfig  `name:"udp"`
+	MQTT         MQTTConfig         `name:"mqtt"`
+	MQTTV2       MQTTConfig         `name:"mqtt-v2"`
+	UDP          UDPConfig          `name:"udp"`
+	BasicStation BasicStationConfig `name:"basic-station"`
 }
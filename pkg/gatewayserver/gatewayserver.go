@@ -21,6 +21,7 @@ import (
 	"net"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -32,6 +33,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/basicstation"
 	iogrpc "go.thethings.network/lorawan-stack/pkg/gatewayserver/io/grpc"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/mqtt"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/udp"
@@ -46,7 +48,7 @@ import (
 
 // GatewayServer implements the Gateway Server component.
 //
-// The Gateway Server exposes the Gs, GtwGs and NsGs services and MQTT and UDP frontends for gateways.
+// The Gateway Server exposes the Gs, GtwGs and NsGs services and MQTT, UDP and LoRa Basics Station frontends for gateways.
 type GatewayServer struct {
 	*component.Component
 	io.Server
@@ -142,6 +144,40 @@ func New(c *component.Component, conf *Config) (gs *GatewayServer, err error) {
 		}
 	}
 
+	for _, lis := range []struct {
+		Listen   string
+		Protocol string
+		Net      func(component.Listener) (net.Listener, error)
+	}{
+		{
+			Listen:   conf.BasicStation.Listen,
+			Protocol: "tcp",
+			Net:      component.Listener.TCP,
+		},
+		{
+			Listen:   conf.BasicStation.ListenTLS,
+			Protocol: "tls",
+			Net:      component.Listener.TLS,
+		},
+	} {
+		if lis.Listen == "" {
+			continue
+		}
+		var componentLis component.Listener
+		var netLis net.Listener
+		componentLis, err = gs.ListenTCP(lis.Listen)
+		if err == nil {
+			netLis, err = lis.Net(componentLis)
+		}
+		if err != nil {
+			return nil, errListenFrontend.WithCause(err).WithAttributes(
+				"protocol", lis.Protocol,
+				"address", lis.Listen,
+			)
+		}
+		basicstation.Start(ctx, gs, netLis, conf.BasicStation.Config)
+	}
+
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.NsGs", cluster.HookName, c.ClusterAuthUnaryHook())
 
 	c.RegisterGRPC(gs)
@@ -305,9 +341,49 @@ var (
 	errNoNetworkServer = errors.DefineNotFound("no_network_server", "no Network Server found to handle message")
 )
 
+const (
+	// txAckForwardBufferSize is the number of Tx acknowledgments per gateway connection that can be pending to be
+	// forwarded to the Network Server. Tx acknowledgments that do not fit in the buffer are dropped.
+	txAckForwardBufferSize = 10
+	// txAckForwardTimeout is the timeout for forwarding a Tx acknowledgment to the Network Server.
+	txAckForwardTimeout = 5 * time.Second
+)
+
+type txAckForward struct {
+	ctx context.Context
+	ack *ttnpb.TxAcknowledgment
+	ids ttnpb.EndDeviceIdentifiers
+}
+
+// forwardTxAcks forwards the Tx acknowledgments from the given channel to the Network Server until the context is done.
+// Forwarding is done outside of handleUpstream so that a slow Network Server does not block the gateway's upstream.
+func (gs *GatewayServer) forwardTxAcks(ctx context.Context, ch <-chan txAckForward) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case fwd := <-ch:
+			logger := log.FromContext(fwd.ctx)
+			ns := gs.GetPeer(fwd.ctx, ttnpb.PeerInfo_NETWORK_SERVER, fwd.ids)
+			if ns == nil {
+				logger.Debug("No Network Server found to handle Tx acknowledgment")
+				continue
+			}
+			ctx, cancel := context.WithTimeout(fwd.ctx, txAckForwardTimeout)
+			_, err := ttnpb.NewGsNsClient(ns.Conn()).HandleTxAcknowledgment(ctx, fwd.ack, gs.WithClusterAuth())
+			cancel()
+			if err != nil {
+				logger.WithError(err).Debug("Failed to forward Tx acknowledgment to Network Server")
+			}
+		}
+	}
+}
+
 func (gs *GatewayServer) handleUpstream(conn *io.Connection) {
 	ctx := conn.Context()
 	logger := log.FromContext(ctx)
+	txAckCh := make(chan txAckForward, txAckForwardBufferSize)
+	go gs.forwardTxAcks(ctx, txAckCh)
 	defer func() {
 		ids := conn.Gateway().GatewayIdentifiers
 		gs.connections.Delete(unique.ID(ctx, ids))
@@ -365,6 +441,13 @@ func (gs *GatewayServer) handleUpstream(conn *io.Connection) {
 			} else {
 				registerFailDownlink(ctx, conn.Gateway(), ack)
 			}
+			if down := ack.DownlinkMessage; down != nil && down.EndDeviceIDs != nil {
+				select {
+				case txAckCh <- txAckForward{ctx: ctx, ack: ack, ids: *down.EndDeviceIDs}:
+				default:
+					logger.Warn("Tx acknowledgment forward buffer full, dropping Tx acknowledgment")
+				}
+			}
 		}
 	}
 }
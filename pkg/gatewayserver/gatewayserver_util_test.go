@@ -45,12 +45,14 @@ func mustHavePeer(ctx context.Context, c *component.Component, role ttnpb.PeerIn
 }
 
 type mockNS struct {
-	upCh chan *ttnpb.UplinkMessage
+	upCh    chan *ttnpb.UplinkMessage
+	txAckCh chan *ttnpb.TxAcknowledgment
 }
 
 func startMockNS(ctx context.Context) (*mockNS, string) {
 	ns := &mockNS{
-		upCh: make(chan *ttnpb.UplinkMessage, 1),
+		upCh:    make(chan *ttnpb.UplinkMessage, 1),
+		txAckCh: make(chan *ttnpb.TxAcknowledgment, 1),
 	}
 	srv := rpcserver.New(ctx)
 	ttnpb.RegisterGsNsServer(srv.Server, ns)
@@ -67,6 +69,11 @@ func (ns *mockNS) HandleUplink(ctx context.Context, msg *ttnpb.UplinkMessage) (*
 	return &pbtypes.Empty{}, nil
 }
 
+func (ns *mockNS) HandleTxAcknowledgment(ctx context.Context, msg *ttnpb.TxAcknowledgment) (*pbtypes.Empty, error) {
+	ns.txAckCh <- msg
+	return &pbtypes.Empty{}, nil
+}
+
 type mockIS struct {
 	gateways     map[string]*ttnpb.Gateway
 	gatewayAuths map[string][]string
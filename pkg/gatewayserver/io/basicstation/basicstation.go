@@ -0,0 +1,398 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package basicstation implements the LoRa Basics Station LNS protocol frontend of the Gateway Server.
+package basicstation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"go.thethings.network/lorawan-stack/pkg/web"
+)
+
+const (
+	discoveryEndpoint = "/router-info"
+	trafficEndpoint   = "/traffic"
+
+	// correlationExpiration is the time after which the correlation of a downlink message that is not confirmed expires.
+	correlationExpiration = time.Minute
+)
+
+// Config contains configuration settings for the LoRa Basics Station frontend.
+// Use DefaultConfig for recommended settings.
+type Config struct {
+	// WSPingInterval defines the interval in which WebSocket ping messages are sent to the gateway.
+	WSPingInterval time.Duration `name:"ws-ping-interval" description:"Interval to send WebSocket ping messages"`
+}
+
+// DefaultConfig contains the default configuration.
+var DefaultConfig = Config{
+	WSPingInterval: 30 * time.Second,
+}
+
+type srv struct {
+	ctx      context.Context
+	config   Config
+	server   io.Server
+	upgrader *websocket.Upgrader
+}
+
+// Start starts the LoRa Basics Station frontend on the given listener.
+func Start(ctx context.Context, server io.Server, listener net.Listener, config Config) {
+	ctx = log.NewContextWithField(ctx, "namespace", "gatewayserver/io/basicstation")
+	s := &srv{
+		ctx:      ctx,
+		config:   config,
+		server:   server,
+		upgrader: &websocket.Upgrader{},
+	}
+
+	e := echo.New()
+	e.HideBanner = true
+	e.HTTPErrorHandler = web.ErrorHandler
+	e.GET(discoveryEndpoint, s.handleDiscover)
+	e.GET(trafficEndpoint+"/:id", s.handleTraffic)
+
+	httpSrv := &http.Server{Handler: e}
+	go func() {
+		if err := httpSrv.Serve(listener); err != nil && err != http.ErrServerClosed && ctx.Err() == nil {
+			log.FromContext(ctx).WithError(err).Warn("Serve failed")
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		httpSrv.Close()
+	}()
+}
+
+func (s *srv) handleDiscover(c echo.Context) error {
+	ctx := log.NewContextWithField(s.ctx, "remote_addr", c.Request().RemoteAddr)
+	logger := log.FromContext(ctx)
+	ws, err := s.upgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		logger.WithError(err).Debug("Failed to upgrade request to WebSocket connection")
+		return nil
+	}
+	defer ws.Close()
+
+	var req DiscoverQuery
+	if err := ws.ReadJSON(&req); err != nil {
+		logger.WithError(err).Debug("Failed to read discover query")
+		return nil
+	}
+
+	res := DiscoverResponse{
+		EUI: req.EUI,
+	}
+	if req.EUI.IsZero() {
+		res.Error = "empty router EUI"
+	} else {
+		scheme := "ws"
+		if c.Request().TLS != nil {
+			scheme = "wss"
+		}
+		res.Muxs = EUI{}
+		res.URI = fmt.Sprintf("%s://%s%s/eui-%s", scheme, c.Request().Host, trafficEndpoint, strings.ToLower(req.EUI.String()))
+	}
+	if err := ws.WriteJSON(res); err != nil {
+		logger.WithError(err).Debug("Failed to write discover response")
+	}
+	return nil
+}
+
+var errInvalidGatewayID = errors.DefineInvalidArgument("invalid_gateway_id", "invalid gateway ID `{id}`")
+
+type state struct {
+	io *io.Connection
+	ws *websocket.Conn
+
+	writeMu sync.Mutex
+
+	// Align for sync/atomic.
+	lastDIID int64
+
+	correlations sync.Map
+}
+
+type downlinkSent struct {
+	correlationIDs []string
+	sent           time.Time
+}
+
+func (st *state) writeJSON(v interface{}) error {
+	st.writeMu.Lock()
+	defer st.writeMu.Unlock()
+	return st.ws.WriteJSON(v)
+}
+
+func (st *state) writePing() error {
+	st.writeMu.Lock()
+	defer st.writeMu.Unlock()
+	return st.ws.WriteMessage(websocket.PingMessage, nil)
+}
+
+func (s *srv) handleTraffic(c echo.Context) error {
+	ctx := log.NewContextWithField(s.ctx, "remote_addr", c.Request().RemoteAddr)
+
+	id := c.Param("id")
+	var eui types.EUI64
+	if err := eui.UnmarshalText([]byte(strings.TrimPrefix(id, "eui-"))); err != nil {
+		return errInvalidGatewayID.WithAttributes("id", id).WithCause(err)
+	}
+	ctx = log.NewContextWithField(ctx, "gateway_eui", eui)
+
+	ids := ttnpb.GatewayIdentifiers{EUI: &eui}
+	ctx, ids, err := s.server.FillGatewayContext(ctx, ids)
+	if err != nil {
+		return err
+	}
+	uid := unique.ID(ctx, ids)
+	ctx = log.NewContextWithField(ctx, "gateway_uid", uid)
+	ctx = rights.NewContext(ctx, rights.Rights{
+		GatewayRights: map[string]*ttnpb.Rights{
+			uid: {
+				Rights: []ttnpb.Right{ttnpb.RIGHT_GATEWAY_LINK},
+			},
+		},
+	})
+	logger := log.FromContext(ctx)
+
+	conn, err := s.server.Connect(ctx, "basicstation", ids)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to connect")
+		return err
+	}
+	if err := s.server.ClaimDownlink(ctx, ids); err != nil {
+		logger.WithError(err).Error("Failed to claim downlink")
+		conn.Disconnect(err)
+		return err
+	}
+
+	ws, err := s.upgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		logger.WithError(err).Debug("Failed to upgrade request to WebSocket connection")
+		s.server.UnclaimDownlink(ctx, ids)
+		conn.Disconnect(err)
+		return nil
+	}
+	st := &state{
+		io: conn,
+		ws: ws,
+	}
+	defer func() {
+		ws.Close()
+		s.server.UnclaimDownlink(ctx, ids)
+	}()
+
+	go func() {
+		<-conn.Context().Done()
+		ws.Close()
+	}()
+	go s.handleDown(conn.Context(), st)
+
+	logger.Info("Connected")
+	err = s.handleUp(conn.Context(), st)
+	conn.Disconnect(err)
+	return nil
+}
+
+func (s *srv) handleUp(ctx context.Context, st *state) error {
+	logger := log.FromContext(ctx)
+	ids := st.io.Gateway().GatewayIdentifiers
+	for {
+		_, data, err := st.ws.ReadMessage()
+		if err != nil {
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				logger.Info("Disconnected")
+			} else if ctx.Err() == nil {
+				logger.WithError(err).Warn("Failed to read message")
+			}
+			return err
+		}
+		receivedAt := time.Now()
+
+		var msg struct {
+			MessageType string `json:"msgtype"`
+		}
+		if err := json.Unmarshal(data, &msg); err != nil {
+			logger.WithError(err).Warn("Failed to unmarshal message")
+			continue
+		}
+		logger := logger.WithField("message_type", msg.MessageType)
+
+		switch msg.MessageType {
+		case TypeUpstreamVersion:
+			var version Version
+			if err := json.Unmarshal(data, &version); err != nil {
+				logger.WithError(err).Warn("Failed to unmarshal version message")
+				continue
+			}
+			logger.WithFields(log.Fields(
+				"station", version.Station,
+				"firmware", version.Firmware,
+				"package", version.Package,
+				"model", version.Model,
+				"protocol", version.Protocol,
+			)).Debug("Received version message")
+			conf, err := GetRouterConfig(*st.io.FrequencyPlan())
+			if err != nil {
+				logger.WithError(err).Warn("Failed to derive router configuration")
+				return err
+			}
+			if err := st.writeJSON(conf); err != nil {
+				logger.WithError(err).Warn("Failed to write router configuration")
+				return err
+			}
+			if err := st.io.HandleStatus(&ttnpb.GatewayStatus{
+				Time: receivedAt,
+				Versions: map[string]string{
+					"station":  version.Station,
+					"firmware": version.Firmware,
+					"package":  version.Package,
+					"model":    version.Model,
+				},
+			}); err != nil {
+				logger.WithError(err).Warn("Failed to handle status message")
+			}
+
+		case TypeUpstreamJoinRequest:
+			var jreq JoinRequest
+			if err := json.Unmarshal(data, &jreq); err != nil {
+				logger.WithError(err).Warn("Failed to unmarshal join-request message")
+				continue
+			}
+			up, err := jreq.ToUplinkMessage(ids, st.io.FrequencyPlan().BandID, receivedAt)
+			if err != nil {
+				logger.WithError(err).Warn("Failed to convert join-request message")
+				continue
+			}
+			if err := st.io.HandleUp(up); err != nil {
+				logger.WithError(err).Warn("Failed to handle uplink message")
+			}
+
+		case TypeUpstreamUplinkDataFrame:
+			var updf UplinkDataFrame
+			if err := json.Unmarshal(data, &updf); err != nil {
+				logger.WithError(err).Warn("Failed to unmarshal uplink data frame")
+				continue
+			}
+			up, err := updf.ToUplinkMessage(ids, st.io.FrequencyPlan().BandID, receivedAt)
+			if err != nil {
+				logger.WithError(err).Warn("Failed to convert uplink data frame")
+				continue
+			}
+			if err := st.io.HandleUp(up); err != nil {
+				logger.WithError(err).Warn("Failed to handle uplink message")
+			}
+
+		case TypeUpstreamTxConfirmation:
+			var txConf TxConfirmation
+			if err := json.Unmarshal(data, &txConf); err != nil {
+				logger.WithError(err).Warn("Failed to unmarshal Tx confirmation")
+				continue
+			}
+			ack := &ttnpb.TxAcknowledgment{
+				Result: ttnpb.TxAcknowledgment_SUCCESS,
+			}
+			if v, ok := st.correlations.Load(txConf.DIID); ok {
+				ack.CorrelationIDs = v.(downlinkSent).correlationIDs
+				st.correlations.Delete(txConf.DIID)
+			}
+			if err := st.io.HandleTxAck(ack); err != nil {
+				logger.WithError(err).Warn("Failed to handle Tx acknowledgement")
+			}
+
+		case TypeUpstreamTimeSync:
+			var req TimeSyncRequest
+			if err := json.Unmarshal(data, &req); err != nil {
+				logger.WithError(err).Warn("Failed to unmarshal time synchronization request")
+				continue
+			}
+			if err := st.writeJSON(TimeSyncResponse{
+				MessageType: TypeDownstreamTimeSync,
+				TxTime:      req.TxTime,
+				GPSTime:     toGPSTime(time.Now()),
+			}); err != nil {
+				logger.WithError(err).Warn("Failed to write time synchronization response")
+				return err
+			}
+
+		default:
+			logger.Debug("Unknown message type, skipping")
+		}
+	}
+}
+
+func (s *srv) handleDown(ctx context.Context, st *state) {
+	logger := log.FromContext(ctx)
+	pingTicker := time.NewTicker(s.config.WSPingInterval)
+	defer pingTicker.Stop()
+	correlationsTicker := time.NewTicker(correlationExpiration)
+	defer correlationsTicker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-pingTicker.C:
+			if err := st.writePing(); err != nil {
+				logger.WithError(err).Warn("Failed to write ping message")
+				st.io.Disconnect(err)
+				return
+			}
+
+		case <-correlationsTicker.C:
+			st.correlations.Range(func(k, v interface{}) bool {
+				if time.Since(v.(downlinkSent).sent) > correlationExpiration {
+					st.correlations.Delete(k)
+				}
+				return true
+			})
+
+		case down := <-st.io.Down():
+			diid := atomic.AddInt64(&st.lastDIID, 1)
+			dlCtx, _ := st.io.DownlinkContext(down)
+			dnmsg, err := FromDownlinkMessage(down, diid, dlCtx)
+			if err != nil {
+				logger.WithError(err).Warn("Failed to marshal downlink message")
+				break
+			}
+			st.correlations.Store(diid, downlinkSent{
+				correlationIDs: down.CorrelationIDs,
+				sent:           time.Now(),
+			})
+			logger.Debug("Writing downlink message")
+			if err := st.writeJSON(dnmsg); err != nil {
+				logger.WithError(err).Warn("Failed to write downlink message")
+			}
+		}
+	}
+}
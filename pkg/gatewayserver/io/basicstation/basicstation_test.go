@@ -0,0 +1,260 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basicstation_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io"
+	. "go.thethings.network/lorawan-stack/pkg/gatewayserver/io/basicstation"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/mock"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+var (
+	gatewayEUI = types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+
+	timeout = 10 * test.Delay
+)
+
+func startServer(t *testing.T) (context.Context, mock.Server, net.Listener) {
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	gs := mock.NewServer()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	Start(ctx, gs, lis, DefaultConfig)
+	return ctx, gs, lis
+}
+
+func TestDiscover(t *testing.T) {
+	ctx, _, lis := startServer(t)
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+
+	for _, tc := range []struct {
+		Name     string
+		Query    DiscoverQuery
+		Expected DiscoverResponse
+	}{
+		{
+			Name:  "EUI",
+			Query: DiscoverQuery{EUI: EUI{gatewayEUI}},
+			Expected: DiscoverResponse{
+				EUI: EUI{gatewayEUI},
+				URI: fmt.Sprintf("ws://%v/traffic/eui-0102030405060708", lis.Addr()),
+			},
+		},
+		{
+			Name:  "EmptyEUI",
+			Query: DiscoverQuery{},
+			Expected: DiscoverResponse{
+				Error: "empty router EUI",
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			ws, _, err := websocket.DefaultDialer.DialContext(ctx, fmt.Sprintf("ws://%v/router-info", lis.Addr()), nil)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			defer ws.Close()
+
+			if !a.So(ws.WriteJSON(tc.Query), should.BeNil) {
+				t.FailNow()
+			}
+			var res DiscoverResponse
+			if !a.So(ws.ReadJSON(&res), should.BeNil) {
+				t.FailNow()
+			}
+			a.So(res, should.Resemble, tc.Expected)
+		})
+	}
+}
+
+func TestTraffic(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx, gs, lis := startServer(t)
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+
+	ids := ttnpb.GatewayIdentifiers{
+		GatewayID: "eui-0102030405060708",
+		EUI:       &gatewayEUI,
+	}
+	gs.RegisterGateway(ctx, ids, &ttnpb.Gateway{
+		GatewayIdentifiers: ids,
+		FrequencyPlanID:    test.ExampleFrequencyPlanID,
+	})
+
+	_, _, err := websocket.DefaultDialer.DialContext(ctx, fmt.Sprintf("ws://%v/traffic/invalid", lis.Addr()), nil)
+	a.So(err, should.NotBeNil)
+
+	ws, _, err := websocket.DefaultDialer.DialContext(ctx, fmt.Sprintf("ws://%v/traffic/eui-0102030405060708", lis.Addr()), nil)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer ws.Close()
+
+	var conn *io.Connection
+	select {
+	case conn = <-gs.Connections():
+	case <-time.After(timeout):
+		t.Fatal("Connection timeout")
+	}
+	a.So(conn.Gateway().GatewayIdentifiers, should.Resemble, ids)
+	a.So(gs.HasDownlinkClaim(ctx, ids), should.BeTrue)
+
+	t.Run("Version", func(t *testing.T) {
+		a := assertions.New(t)
+
+		if !a.So(ws.WriteJSON(Version{
+			MessageType: TypeUpstreamVersion,
+			Station:     "test",
+		}), should.BeNil) {
+			t.FailNow()
+		}
+		var conf RouterConfig
+		if !a.So(ws.ReadJSON(&conf), should.BeNil) {
+			t.FailNow()
+		}
+		a.So(conf.MessageType, should.Equal, TypeDownstreamRouterConfig)
+
+		select {
+		case status := <-conn.Status():
+			a.So(status.Versions["station"], should.Equal, "test")
+		case <-time.After(timeout):
+			t.Fatal("Status timeout")
+		}
+	})
+
+	var token []byte
+	t.Run("JoinRequest", func(t *testing.T) {
+		a := assertions.New(t)
+
+		if !a.So(ws.WriteJSON(JoinRequest{
+			MessageType: TypeUpstreamJoinRequest,
+			JoinEUI:     EUI{types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}},
+			DevEUI:      EUI{types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}},
+			DevNonce:    0x0102,
+			MIC:         0x01020304,
+			RadioMetadata: RadioMetadata{
+				DataRate:  5,
+				Frequency: 868100000,
+				UpInfo: UpInfo{
+					RCtx:  2,
+					XTime: 0x0100000012345678,
+				},
+			},
+		}), should.BeNil) {
+			t.FailNow()
+		}
+
+		select {
+		case up := <-conn.Up():
+			if !a.So(up.RxMetadata, should.HaveLength, 1) {
+				t.FailNow()
+			}
+			token = up.RxMetadata[0].UplinkToken
+			var uplinkToken ttnpb.UplinkToken
+			if !a.So(uplinkToken.Unmarshal(token), should.BeNil) {
+				t.FailNow()
+			}
+			a.So(uplinkToken, should.Resemble, ttnpb.UplinkToken{
+				GatewayAntennaIdentifiers: ttnpb.GatewayAntennaIdentifiers{
+					GatewayIdentifiers: ids,
+				},
+				Timestamp:        0x12345678,
+				ConcentratorTime: 0x0100000012345678,
+				RadioContext:     2,
+			})
+		case <-time.After(timeout):
+			t.Fatal("Uplink timeout")
+		}
+	})
+
+	t.Run("DownlinkMessage", func(t *testing.T) {
+		a := assertions.New(t)
+
+		down := &ttnpb.DownlinkMessage{
+			RawPayload: []byte{0x01, 0x02, 0x03},
+			Settings: &ttnpb.DownlinkMessage_Request{
+				Request: &ttnpb.TxRequest{
+					Class:            ttnpb.CLASS_A,
+					Priority:         ttnpb.TxSchedulePriority_NORMAL,
+					Rx1Delay:         ttnpb.RX_DELAY_5,
+					Rx1DataRateIndex: 5,
+					Rx1Frequency:     868100000,
+				},
+			},
+			CorrelationIDs: []string{"test"},
+		}
+		path := &ttnpb.DownlinkPath{
+			Path: &ttnpb.DownlinkPath_UplinkToken{
+				UplinkToken: token,
+			},
+		}
+		if !a.So(conn.SendDown(path, down), should.BeNil) {
+			t.FailNow()
+		}
+
+		var dnmsg DownlinkMessage
+		if !a.So(ws.ReadJSON(&dnmsg), should.BeNil) {
+			t.FailNow()
+		}
+		dr := 5
+		a.So(dnmsg, should.Resemble, DownlinkMessage{
+			MessageType: TypeDownstreamDownlinkMessage,
+			DIID:        1,
+			PDU:         "010203",
+			RxDelay:     5,
+			Rx1DR:       &dr,
+			Rx1Freq:     868100000,
+			XTime:       0x0100000012345678,
+			RCtx:        2,
+		})
+
+		if !a.So(ws.WriteJSON(TxConfirmation{
+			MessageType: TypeUpstreamTxConfirmation,
+			DIID:        dnmsg.DIID,
+			XTime:       dnmsg.XTime + 5000000,
+			RCtx:        2,
+		}), should.BeNil) {
+			t.FailNow()
+		}
+		select {
+		case ack := <-conn.TxAck():
+			a.So(ack.Result, should.Equal, ttnpb.TxAcknowledgment_SUCCESS)
+			a.So(ack.CorrelationIDs, should.Resemble, []string{"test"})
+			a.So(ack.DownlinkMessage, should.Equal, down)
+		case <-time.After(timeout):
+			t.Fatal("Tx acknowledgment timeout")
+		}
+	})
+}
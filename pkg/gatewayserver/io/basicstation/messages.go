@@ -0,0 +1,283 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basicstation
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+// Message types of the LoRa Basics Station LNS protocol.
+const (
+	TypeUpstreamVersion           = "version"
+	TypeUpstreamJoinRequest       = "jreq"
+	TypeUpstreamUplinkDataFrame   = "updf"
+	TypeUpstreamTxConfirmation    = "dntxed"
+	TypeUpstreamTimeSync          = "timesync"
+	TypeDownstreamRouterConfig    = "router_config"
+	TypeDownstreamDownlinkMessage = "dnmsg"
+	TypeDownstreamTimeSync        = "timesync"
+)
+
+// EUI is an EUI64 as encoded by LoRa Basics Station.
+// It unmarshals from an integer, from a string of hexadecimal digits separated by dashes or colons, or from the ID6 format.
+// It marshals to a string of hexadecimal digits separated by dashes.
+type EUI struct {
+	types.EUI64
+}
+
+var errEUI = errors.DefineInvalidArgument("eui", "invalid EUI `{eui}`")
+
+// MarshalJSON implements json.Marshaler.
+func (eui EUI) MarshalJSON() ([]byte, error) {
+	parts := make([]string, 0, len(eui.EUI64))
+	for _, b := range eui.EUI64 {
+		parts = append(parts, fmt.Sprintf("%02X", b))
+	}
+	return json.Marshal(strings.Join(parts, "-"))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (eui *EUI) UnmarshalJSON(data []byte) error {
+	var n uint64
+	if err := json.Unmarshal(data, &n); err == nil {
+		binary.BigEndian.PutUint64(eui.EUI64[:], n)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return errEUI.WithAttributes("eui", string(data)).WithCause(err)
+	}
+	if id6, err := parseID6(s); err == nil {
+		eui.EUI64 = id6
+		return nil
+	}
+	b, err := hex.DecodeString(strings.NewReplacer("-", "", ":", "").Replace(s))
+	if err != nil || len(b) != len(eui.EUI64) {
+		return errEUI.WithAttributes("eui", s)
+	}
+	copy(eui.EUI64[:], b)
+	return nil
+}
+
+// parseID6 parses the ID6 representation of an EUI64, i.e. four groups of 16 bits separated by colons,
+// where consecutive groups of zeros can be omitted by using a double colon.
+func parseID6(s string) (eui types.EUI64, err error) {
+	if strings.Count(s, ":") == 7 || !strings.Contains(s, ":") {
+		return eui, errEUI.WithAttributes("eui", s)
+	}
+	var groups []string
+	if i := strings.Index(s, "::"); i >= 0 {
+		var head, tail []string
+		if s[:i] != "" {
+			head = strings.Split(s[:i], ":")
+		}
+		if s[i+2:] != "" {
+			tail = strings.Split(s[i+2:], ":")
+		}
+		if len(head)+len(tail) > 3 {
+			return eui, errEUI.WithAttributes("eui", s)
+		}
+		groups = append(groups, head...)
+		for j := len(head) + len(tail); j < 4; j++ {
+			groups = append(groups, "0")
+		}
+		groups = append(groups, tail...)
+	} else {
+		groups = strings.Split(s, ":")
+	}
+	if len(groups) != 4 {
+		return eui, errEUI.WithAttributes("eui", s)
+	}
+	for i, g := range groups {
+		n, err := strconv.ParseUint(g, 16, 16)
+		if err != nil {
+			return eui, errEUI.WithAttributes("eui", s).WithCause(err)
+		}
+		binary.BigEndian.PutUint16(eui[2*i:], uint16(n))
+	}
+	return eui, nil
+}
+
+// DiscoverQuery is the query of a gateway to discover its traffic endpoint.
+type DiscoverQuery struct {
+	EUI EUI `json:"router"`
+}
+
+// DiscoverResponse is the response to a DiscoverQuery.
+type DiscoverResponse struct {
+	EUI   EUI    `json:"router"`
+	Muxs  EUI    `json:"muxs"`
+	URI   string `json:"uri,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// Version is the version message, which is sent by the gateway after connecting to the traffic endpoint.
+type Version struct {
+	MessageType string `json:"msgtype"`
+	Station     string `json:"station"`
+	Firmware    string `json:"firmware"`
+	Package     string `json:"package"`
+	Model       string `json:"model"`
+	Protocol    int    `json:"protocol"`
+	Features    string `json:"features,omitempty"`
+}
+
+// RadioConfig is the configuration of a radio of the SX1301 concentrator.
+type RadioConfig struct {
+	Enable    bool   `json:"enable"`
+	Frequency uint64 `json:"freq"`
+}
+
+// IFConfig is the configuration of an IF channel of the SX1301 concentrator.
+type IFConfig struct {
+	Enable          bool   `json:"enable"`
+	Radio           uint32 `json:"radio"`
+	IF              int64  `json:"if"`
+	Bandwidth       uint32 `json:"bandwidth,omitempty"`
+	SpreadingFactor uint32 `json:"spread_factor,omitempty"`
+}
+
+// SX1301Config is the configuration of the SX1301 concentrator.
+type SX1301Config struct {
+	Radios              []RadioConfig
+	Channels            []IFConfig
+	LoRaStandardChannel *IFConfig
+	FSKChannel          *IFConfig
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c SX1301Config) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, len(c.Radios)+len(c.Channels)+2)
+	for i, radio := range c.Radios {
+		m[fmt.Sprintf("radio_%d", i)] = radio
+	}
+	for i, ch := range c.Channels {
+		m[fmt.Sprintf("chan_multiSF_%d", i)] = ch
+	}
+	if c.LoRaStandardChannel != nil {
+		m["chan_Lora_std"] = c.LoRaStandardChannel
+	}
+	if c.FSKChannel != nil {
+		m["chan_FSK"] = c.FSKChannel
+	}
+	return json.Marshal(m)
+}
+
+// RouterConfig is the router configuration, which is sent to the gateway in response to the version message.
+type RouterConfig struct {
+	MessageType    string         `json:"msgtype"`
+	NetID          []int          `json:"NetID,omitempty"`
+	JoinEUI        [][2]uint64    `json:"JoinEui,omitempty"`
+	Region         string         `json:"region"`
+	HardwareSpec   string         `json:"hwspec"`
+	FrequencyRange [2]uint64      `json:"freq_range"`
+	DataRates      [16][3]int     `json:"DRs"`
+	SX1301Config   []SX1301Config `json:"sx1301_conf"`
+	NoCCA          bool           `json:"nocca"`
+	NoDutyCycle    bool           `json:"nodc"`
+	NoDwellTime    bool           `json:"nodwell"`
+}
+
+// UpInfo contains the radio metadata of an uplink message.
+type UpInfo struct {
+	RxTime  float64 `json:"rxtime"`
+	RCtx    int64   `json:"rctx"`
+	XTime   int64   `json:"xtime"`
+	GPSTime int64   `json:"gpstime"`
+	RSSI    float32 `json:"rssi"`
+	SNR     float32 `json:"snr"`
+}
+
+// RadioMetadata contains the data rate, frequency and radio metadata of an uplink message.
+type RadioMetadata struct {
+	DataRate  int    `json:"DR"`
+	Frequency uint64 `json:"Freq"`
+	UpInfo    UpInfo `json:"upinfo"`
+}
+
+// JoinRequest is a join-request message.
+type JoinRequest struct {
+	MessageType string `json:"msgtype"`
+	MHDR        uint   `json:"MHdr"`
+	JoinEUI     EUI    `json:"JoinEui"`
+	DevEUI      EUI    `json:"DevEui"`
+	DevNonce    uint   `json:"DevNonce"`
+	MIC         int32  `json:"MIC"`
+	RadioMetadata
+}
+
+// UplinkDataFrame is a data uplink message.
+type UplinkDataFrame struct {
+	MessageType string `json:"msgtype"`
+	MHDR        uint   `json:"MHdr"`
+	DevAddr     int32  `json:"DevAddr"`
+	FCtrl       uint   `json:"FCtrl"`
+	FCnt        uint   `json:"FCnt"`
+	FOpts       string `json:"FOpts"`
+	FPort       int    `json:"FPort"`
+	FRMPayload  string `json:"FRMPayload"`
+	MIC         int32  `json:"MIC"`
+	RadioMetadata
+}
+
+// DownlinkMessage is a downlink message.
+type DownlinkMessage struct {
+	MessageType string `json:"msgtype"`
+	DevEUI      EUI    `json:"DevEui"`
+	DeviceClass uint   `json:"dC"`
+	DIID        int64  `json:"diid"`
+	PDU         string `json:"pdu"`
+	RxDelay     int    `json:"RxDelay"`
+	Rx1DR       *int   `json:"RX1DR,omitempty"`
+	Rx1Freq     uint64 `json:"RX1Freq,omitempty"`
+	Rx2DR       *int   `json:"RX2DR,omitempty"`
+	Rx2Freq     uint64 `json:"RX2Freq,omitempty"`
+	Priority    int    `json:"priority"`
+	XTime       int64  `json:"xtime,omitempty"`
+	GPSTime     int64  `json:"gpstime,omitempty"`
+	RCtx        int64  `json:"rctx"`
+}
+
+// TxConfirmation is the confirmation of the gateway that a downlink message has been transmitted.
+type TxConfirmation struct {
+	MessageType string  `json:"msgtype"`
+	DIID        int64   `json:"diid"`
+	DevEUI      EUI     `json:"DevEui"`
+	RCtx        int64   `json:"rctx"`
+	XTime       int64   `json:"xtime"`
+	TxTime      float64 `json:"txtime"`
+	GPSTime     int64   `json:"gpstime"`
+}
+
+// TimeSyncRequest is a time synchronization request of the gateway.
+type TimeSyncRequest struct {
+	MessageType string  `json:"msgtype"`
+	TxTime      float64 `json:"txtime"`
+}
+
+// TimeSyncResponse is the response to a TimeSyncRequest.
+type TimeSyncResponse struct {
+	MessageType string  `json:"msgtype"`
+	TxTime      float64 `json:"txtime"`
+	GPSTime     int64   `json:"gpstime"`
+}
@@ -0,0 +1,152 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basicstation
+
+import (
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// regions maps band IDs to the region names of LoRa Basics Station.
+var regions = map[string]string{
+	band.AS_923:     "AS923",
+	band.AU_915_928: "AU915",
+	band.CN_470_510: "CN470",
+	band.CN_779_787: "CN779",
+	band.EU_433:     "EU433",
+	band.EU_863_870: "EU863",
+	band.IN_865_867: "IN865",
+	band.KR_920_923: "KR920",
+	band.RU_864_870: "RU864",
+	band.US_902_928: "US902",
+}
+
+var (
+	errBandNotSupported = errors.DefineInvalidArgument("band_not_supported", "band `{band_id}` is not supported")
+	errNoRadio          = errors.DefineInvalidArgument("no_radio", "radio `{radio}` is not defined in the frequency plan")
+)
+
+// GetRouterConfig returns the router configuration derived from the frequency plan.
+func GetRouterConfig(fp frequencyplans.FrequencyPlan) (RouterConfig, error) {
+	b, err := band.GetByID(fp.BandID)
+	if err != nil {
+		return RouterConfig{}, err
+	}
+	region, ok := regions[b.ID]
+	if !ok {
+		return RouterConfig{}, errBandNotSupported.WithAttributes("band_id", b.ID)
+	}
+	cc, err := fp.ToConcentratorConfig()
+	if err != nil {
+		return RouterConfig{}, err
+	}
+
+	conf := RouterConfig{
+		MessageType:  TypeDownstreamRouterConfig,
+		Region:       region,
+		HardwareSpec: "sx1301/1",
+		// NOTE: Duty-cycle, dwell time and listen-before-talk are enforced by the Gateway Server scheduler.
+		NoCCA:       true,
+		NoDutyCycle: true,
+		NoDwellTime: true,
+	}
+
+	for i, sb := range b.SubBands {
+		if i == 0 || sb.MinFrequency < conf.FrequencyRange[0] {
+			conf.FrequencyRange[0] = sb.MinFrequency
+		}
+		if sb.MaxFrequency > conf.FrequencyRange[1] {
+			conf.FrequencyRange[1] = sb.MaxFrequency
+		}
+	}
+
+	for i, dr := range b.DataRates {
+		switch {
+		case dr.Rate.GetLoRa() != nil:
+			lora := dr.Rate.GetLoRa()
+			conf.DataRates[i] = [3]int{int(lora.SpreadingFactor), int(lora.Bandwidth / 1000), 0}
+			if !isUplinkDataRate(b, i) {
+				conf.DataRates[i][2] = 1
+			}
+		case dr.Rate.GetFSK() != nil:
+			conf.DataRates[i] = [3]int{0, 0, 0}
+		default:
+			conf.DataRates[i] = [3]int{-1, 0, 0}
+		}
+	}
+
+	radioIF := func(radio uint32, frequency uint64) (int64, error) {
+		if int(radio) >= len(cc.Radios) {
+			return 0, errNoRadio.WithAttributes("radio", radio)
+		}
+		return int64(frequency) - int64(cc.Radios[radio].Frequency), nil
+	}
+	var sx1301 SX1301Config
+	for _, radio := range cc.Radios {
+		sx1301.Radios = append(sx1301.Radios, RadioConfig{
+			Enable:    radio.Enable,
+			Frequency: radio.Frequency,
+		})
+	}
+	for _, ch := range cc.Channels {
+		ifFreq, err := radioIF(ch.Radio, ch.Frequency)
+		if err != nil {
+			return RouterConfig{}, err
+		}
+		sx1301.Channels = append(sx1301.Channels, IFConfig{
+			Enable: true,
+			Radio:  ch.Radio,
+			IF:     ifFreq,
+		})
+	}
+	if ch := cc.LoRaStandardChannel; ch != nil {
+		ifFreq, err := radioIF(ch.Radio, ch.Frequency)
+		if err != nil {
+			return RouterConfig{}, err
+		}
+		sx1301.LoRaStandardChannel = &IFConfig{
+			Enable:          true,
+			Radio:           ch.Radio,
+			IF:              ifFreq,
+			Bandwidth:       ch.Bandwidth,
+			SpreadingFactor: ch.SpreadingFactor,
+		}
+	}
+	if ch := cc.FSKChannel; ch != nil {
+		ifFreq, err := radioIF(ch.Radio, ch.Frequency)
+		if err != nil {
+			return RouterConfig{}, err
+		}
+		sx1301.FSKChannel = &IFConfig{
+			Enable: true,
+			Radio:  ch.Radio,
+			IF:     ifFreq,
+		}
+	}
+	conf.SX1301Config = []SX1301Config{sx1301}
+	return conf, nil
+}
+
+// isUplinkDataRate returns whether the data rate with index i can be used by any of the default uplink channels of the band.
+func isUplinkDataRate(b band.Band, i int) bool {
+	for _, ch := range b.UplinkChannels {
+		if ch.MinDataRate <= ttnpb.DataRateIndex(i) && ttnpb.DataRateIndex(i) <= ch.MaxDataRate {
+			return true
+		}
+	}
+	return false
+}
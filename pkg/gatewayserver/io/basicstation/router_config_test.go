@@ -0,0 +1,102 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basicstation_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	. "go.thethings.network/lorawan-stack/pkg/gatewayserver/io/basicstation"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestGetRouterConfig(t *testing.T) {
+	a := assertions.New(t)
+	store := frequencyplans.NewStore(test.FrequencyPlansFetcher)
+
+	fp, err := store.GetByID(test.ExampleFrequencyPlanID)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	conf, err := GetRouterConfig(*fp)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(conf, should.Resemble, RouterConfig{
+		MessageType:    TypeDownstreamRouterConfig,
+		Region:         "EU863",
+		HardwareSpec:   "sx1301/1",
+		FrequencyRange: [2]uint64{863000000, 870000000},
+		DataRates: [16][3]int{
+			{12, 125, 0},
+			{11, 125, 0},
+			{10, 125, 0},
+			{9, 125, 0},
+			{8, 125, 0},
+			{7, 125, 0},
+			{7, 250, 1},
+			{0, 0, 0},
+			{-1, 0, 0},
+			{-1, 0, 0},
+			{-1, 0, 0},
+			{-1, 0, 0},
+			{-1, 0, 0},
+			{-1, 0, 0},
+			{-1, 0, 0},
+			{-1, 0, 0},
+		},
+		SX1301Config: []SX1301Config{
+			{
+				Radios: []RadioConfig{
+					{
+						Enable:    true,
+						Frequency: 867500000,
+					},
+				},
+				Channels: []IFConfig{
+					{
+						Enable: true,
+						Radio:  0,
+						IF:     600000,
+					},
+				},
+				LoRaStandardChannel: &IFConfig{
+					Enable:          true,
+					Radio:           0,
+					IF:              -4500000,
+					Bandwidth:       250000,
+					SpreadingFactor: 7,
+				},
+				FSKChannel: &IFConfig{
+					Enable: true,
+					Radio:  0,
+					IF:     1300000,
+				},
+			},
+		},
+		NoCCA:       true,
+		NoDutyCycle: true,
+		NoDwellTime: true,
+	})
+
+	fp, err = store.GetByID(test.EUFrequencyPlanID)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	_, err = GetRouterConfig(*fp)
+	a.So(err, should.NotBeNil)
+}
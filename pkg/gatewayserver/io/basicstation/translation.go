@@ -0,0 +1,190 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basicstation
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	errDataRate     = errors.DefineInvalidArgument("data_rate", "no data rate with index `{index}`")
+	errPayload      = errors.DefineInvalidArgument("payload", "invalid payload")
+	errNotScheduled = errors.DefineInvalidArgument("not_scheduled", "downlink message not scheduled")
+	errNoXTime      = errors.DefineFailedPrecondition("no_xtime", "no uplink xtime for class A downlink")
+	errRxWindow     = errors.DefineInvalidArgument("rx_window", "invalid Rx window `{window}`")
+)
+
+// gpsTime returns the time corresponding to us, the number of microseconds elapsed since GPS epoch.
+func gpsTime(us int64) time.Time {
+	return gpstime.Parse(us / int64(time.Second/time.Microsecond)).Add(time.Duration(us%int64(time.Second/time.Microsecond)) * time.Microsecond)
+}
+
+// toGPSTime returns the number of microseconds elapsed since GPS epoch at t.
+func toGPSTime(t time.Time) int64 {
+	return gpstime.ToGPS(t)*int64(time.Second/time.Microsecond) + int64(t.Nanosecond())/int64(time.Microsecond/time.Nanosecond)
+}
+
+func (md RadioMetadata) toUplinkMessage(ids ttnpb.GatewayIdentifiers, bandID string, receivedAt time.Time) (*ttnpb.UplinkMessage, error) {
+	b, err := band.GetByID(bandID)
+	if err != nil {
+		return nil, err
+	}
+	if md.DataRate < 0 || md.DataRate >= len(b.DataRates) || b.DataRates[md.DataRate].Rate == (ttnpb.DataRate{}) {
+		return nil, errDataRate.WithAttributes("index", md.DataRate)
+	}
+	up := &ttnpb.UplinkMessage{
+		Settings: ttnpb.TxSettings{
+			DataRate:      b.DataRates[md.DataRate].Rate,
+			DataRateIndex: ttnpb.DataRateIndex(md.DataRate),
+			Frequency:     md.Frequency,
+			Timestamp:     uint32(md.UpInfo.XTime),
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIdentifiers: ids,
+				Timestamp:          uint32(md.UpInfo.XTime),
+				RSSI:               md.UpInfo.RSSI,
+				SNR:                md.UpInfo.SNR,
+			},
+		},
+		ReceivedAt: receivedAt,
+	}
+	// LoRa Basics Station uses xtime, a 64-bit concentrator time which contains a session identifier in the upper bits,
+	// while the Gateway Server uses 32-bit timestamps. The uplink token carries the xtime and rctx so that downlink
+	// messages can be transmitted relative to this uplink message. The gateway connection completes the uplink token.
+	token, err := (&ttnpb.UplinkToken{
+		ConcentratorTime: md.UpInfo.XTime,
+		RadioContext:     md.UpInfo.RCtx,
+	}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	up.RxMetadata[0].UplinkToken = token
+	if up.Settings.DataRate.GetLoRa() != nil {
+		up.Settings.CodingRate = "4/5"
+	}
+	if md.UpInfo.GPSTime != 0 {
+		t := gpsTime(md.UpInfo.GPSTime)
+		up.Settings.Time = &t
+		up.RxMetadata[0].Time = &t
+	}
+	return up, nil
+}
+
+// ToUplinkMessage converts the join-request to an uplink message.
+func (req JoinRequest) ToUplinkMessage(ids ttnpb.GatewayIdentifiers, bandID string, receivedAt time.Time) (*ttnpb.UplinkMessage, error) {
+	up, err := req.RadioMetadata.toUplinkMessage(ids, bandID, receivedAt)
+	if err != nil {
+		return nil, err
+	}
+	pld := make([]byte, 23)
+	pld[0] = byte(req.MHDR)
+	copy(pld[1:9], reverse(req.JoinEUI.EUI64[:]))
+	copy(pld[9:17], reverse(req.DevEUI.EUI64[:]))
+	binary.LittleEndian.PutUint16(pld[17:19], uint16(req.DevNonce))
+	binary.LittleEndian.PutUint32(pld[19:23], uint32(req.MIC))
+	up.RawPayload = pld
+	return up, nil
+}
+
+// ToUplinkMessage converts the data frame to an uplink message.
+func (updf UplinkDataFrame) ToUplinkMessage(ids ttnpb.GatewayIdentifiers, bandID string, receivedAt time.Time) (*ttnpb.UplinkMessage, error) {
+	up, err := updf.RadioMetadata.toUplinkMessage(ids, bandID, receivedAt)
+	if err != nil {
+		return nil, err
+	}
+	fOpts, err := hex.DecodeString(updf.FOpts)
+	if err != nil {
+		return nil, errPayload.WithCause(err)
+	}
+	frmPayload, err := hex.DecodeString(updf.FRMPayload)
+	if err != nil {
+		return nil, errPayload.WithCause(err)
+	}
+	pld := make([]byte, 0, 12+len(fOpts)+len(frmPayload)+4)
+	pld = append(pld, byte(updf.MHDR))
+	pld = append(pld, make([]byte, 4)...)
+	binary.LittleEndian.PutUint32(pld[1:5], uint32(updf.DevAddr))
+	pld = append(pld, byte(updf.FCtrl), byte(updf.FCnt), byte(updf.FCnt>>8))
+	pld = append(pld, fOpts...)
+	if updf.FPort >= 0 {
+		pld = append(pld, byte(updf.FPort))
+		pld = append(pld, frmPayload...)
+	}
+	var mic [4]byte
+	binary.LittleEndian.PutUint32(mic[:], uint32(updf.MIC))
+	up.RawPayload = append(pld, mic[:]...)
+	return up, nil
+}
+
+// FromDownlinkMessage converts the scheduled downlink message to a downlink message with the given downlink identifier.
+// Class A downlink messages are transmitted relative to the xtime of the uplink message in the uplink token, in the
+// receive window in which they are scheduled. Class B and C downlink messages are transmitted in RX2, at the scheduled
+// GPS time if any, or otherwise immediately.
+func FromDownlinkMessage(msg *ttnpb.DownlinkMessage, diid int64, dlCtx io.DownlinkContext) (*DownlinkMessage, error) {
+	scheduled := msg.GetScheduled()
+	if scheduled == nil {
+		return nil, errNotScheduled
+	}
+	dr := int(scheduled.DataRateIndex)
+	dnmsg := &DownlinkMessage{
+		MessageType: TypeDownstreamDownlinkMessage,
+		DeviceClass: uint(dlCtx.Class), // LoRa Basics Station uses 0, 1 and 2 for class A, B and C respectively.
+		DIID:        diid,
+		PDU:         hex.EncodeToString(msg.RawPayload),
+		RCtx:        dlCtx.UplinkToken.GetRadioContext(),
+	}
+	switch dlCtx.Class {
+	case ttnpb.CLASS_A:
+		if dlCtx.UplinkToken.GetConcentratorTime() == 0 {
+			return nil, errNoXTime
+		}
+		dnmsg.XTime = dlCtx.UplinkToken.ConcentratorTime
+		dnmsg.RxDelay = int(dlCtx.Rx1Delay / time.Second)
+		switch dlCtx.RxWindow {
+		case 1:
+			dnmsg.Rx1DR, dnmsg.Rx1Freq = &dr, scheduled.Frequency
+		case 2:
+			dnmsg.Rx2DR, dnmsg.Rx2Freq = &dr, scheduled.Frequency
+		default:
+			return nil, errRxWindow.WithAttributes("window", dlCtx.RxWindow)
+		}
+	default:
+		dnmsg.Rx2DR, dnmsg.Rx2Freq = &dr, scheduled.Frequency
+		if scheduled.Time != nil {
+			dnmsg.GPSTime = toGPSTime(*scheduled.Time)
+		}
+	}
+	if ids := msg.EndDeviceIDs; ids != nil && ids.DevEUI != nil {
+		dnmsg.DevEUI = EUI{*ids.DevEUI}
+	}
+	return dnmsg, nil
+}
+
+func reverse(b []byte) []byte {
+	r := make([]byte, len(b))
+	for i := range b {
+		r[len(b)-1-i] = b[i]
+	}
+	return r
+}
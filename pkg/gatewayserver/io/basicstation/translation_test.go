@@ -0,0 +1,484 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basicstation_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io"
+	. "go.thethings.network/lorawan-stack/pkg/gatewayserver/io/basicstation"
+	"go.thethings.network/lorawan-stack/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestEUI(t *testing.T) {
+	for _, tc := range []struct {
+		JSON     string
+		Expected types.EUI64
+		OK       bool
+	}{
+		{
+			JSON:     `"b827:ebff:fe61:51ed"`,
+			Expected: types.EUI64{0xb8, 0x27, 0xeb, 0xff, 0xfe, 0x61, 0x51, 0xed},
+			OK:       true,
+		},
+		{
+			JSON:     `"::1"`,
+			Expected: types.EUI64{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01},
+			OK:       true,
+		},
+		{
+			JSON:     `"1::2"`,
+			Expected: types.EUI64{0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02},
+			OK:       true,
+		},
+		{
+			JSON:     `"01-02-03-04-05-06-07-08"`,
+			Expected: types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+			OK:       true,
+		},
+		{
+			JSON:     `"01:02:03:04:05:06:07:08"`,
+			Expected: types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+			OK:       true,
+		},
+		{
+			JSON:     `72623859790382856`,
+			Expected: types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+			OK:       true,
+		},
+		{
+			JSON: `"01-02-03"`,
+		},
+		{
+			JSON: `"1::2::3"`,
+		},
+		{
+			JSON: `true`,
+		},
+	} {
+		t.Run(tc.JSON, func(t *testing.T) {
+			a := assertions.New(t)
+
+			var eui EUI
+			err := json.Unmarshal([]byte(tc.JSON), &eui)
+			if !tc.OK {
+				a.So(err, should.NotBeNil)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(eui.EUI64, should.Equal, tc.Expected)
+
+			b, err := json.Marshal(eui)
+			a.So(err, should.BeNil)
+			var res EUI
+			a.So(json.Unmarshal(b, &res), should.BeNil)
+			a.So(res, should.Resemble, eui)
+		})
+	}
+}
+
+func TestJoinRequestToUplinkMessage(t *testing.T) {
+	a := assertions.New(t)
+
+	ids := ttnpb.GatewayIdentifiers{GatewayID: "test-gateway"}
+	receivedAt := time.Unix(42, 0)
+	jreq := JoinRequest{
+		MessageType: TypeUpstreamJoinRequest,
+		MHDR:        0x00,
+		JoinEUI:     EUI{types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}},
+		DevEUI:      EUI{types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}},
+		DevNonce:    0x0102,
+		MIC:         0x01020304,
+		RadioMetadata: RadioMetadata{
+			DataRate:  5,
+			Frequency: 868100000,
+			UpInfo: UpInfo{
+				RCtx:    2,
+				XTime:   0x0100000012345678,
+				GPSTime: 1234567890123456,
+				RSSI:    -42,
+				SNR:     7.5,
+			},
+		},
+	}
+
+	up, err := jreq.ToUplinkMessage(ids, band.EU_863_870, receivedAt)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	raw, err := lorawan.MarshalMessage(ttnpb.Message{
+		MHDR: ttnpb.MHDR{
+			MType: ttnpb.MType_JOIN_REQUEST,
+			Major: ttnpb.Major_LORAWAN_R1,
+		},
+		MIC: []byte{0x04, 0x03, 0x02, 0x01},
+		Payload: &ttnpb.Message_JoinRequestPayload{
+			JoinRequestPayload: &ttnpb.JoinRequestPayload{
+				JoinEUI:  types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+				DevEUI:   types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+				DevNonce: types.DevNonce{0x01, 0x02},
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	gpsTime := gpstime.Parse(1234567890).Add(123456 * time.Microsecond)
+	a.So(up, should.Resemble, &ttnpb.UplinkMessage{
+		RawPayload: raw,
+		Settings: ttnpb.TxSettings{
+			DataRate:      band.All[band.EU_863_870].DataRates[5].Rate,
+			DataRateIndex: 5,
+			CodingRate:    "4/5",
+			Frequency:     868100000,
+			Timestamp:     0x12345678,
+			Time:          &gpsTime,
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIdentifiers: ids,
+				Time:               &gpsTime,
+				Timestamp:          0x12345678,
+				RSSI:               -42,
+				SNR:                7.5,
+				UplinkToken: mustMarshalUplinkToken(ttnpb.UplinkToken{
+					ConcentratorTime: 0x0100000012345678,
+					RadioContext:     2,
+				}),
+			},
+		},
+		ReceivedAt: receivedAt,
+	})
+}
+
+func mustMarshalUplinkToken(token ttnpb.UplinkToken) []byte {
+	buf, err := token.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return buf
+}
+
+func TestUplinkDataFrameToUplinkMessage(t *testing.T) {
+	ids := ttnpb.GatewayIdentifiers{GatewayID: "test-gateway"}
+	receivedAt := time.Unix(42, 0)
+
+	for _, tc := range []struct {
+		Name       string
+		Frame      UplinkDataFrame
+		Expected   ttnpb.Message
+		ErrorCheck func(error) bool
+	}{
+		{
+			Name: "FRMPayload",
+			Frame: UplinkDataFrame{
+				MessageType: TypeUpstreamUplinkDataFrame,
+				MHDR:        0x40,
+				DevAddr:     0x01020304,
+				FCtrl:       0x80,
+				FCnt:        0x0102,
+				FOpts:       "",
+				FPort:       42,
+				FRMPayload:  "01020304",
+				MIC:         0x01020304,
+				RadioMetadata: RadioMetadata{
+					DataRate:  0,
+					Frequency: 868100000,
+				},
+			},
+			Expected: ttnpb.Message{
+				MHDR: ttnpb.MHDR{
+					MType: ttnpb.MType_UNCONFIRMED_UP,
+					Major: ttnpb.Major_LORAWAN_R1,
+				},
+				MIC: []byte{0x04, 0x03, 0x02, 0x01},
+				Payload: &ttnpb.Message_MACPayload{
+					MACPayload: &ttnpb.MACPayload{
+						FHDR: ttnpb.FHDR{
+							DevAddr: types.DevAddr{0x01, 0x02, 0x03, 0x04},
+							FCtrl: ttnpb.FCtrl{
+								ADR: true,
+							},
+							FCnt:  0x0102,
+							FOpts: []byte{},
+						},
+						FPort:      42,
+						FRMPayload: []byte{0x01, 0x02, 0x03, 0x04},
+					},
+				},
+			},
+		},
+		{
+			Name: "FOpts",
+			Frame: UplinkDataFrame{
+				MessageType: TypeUpstreamUplinkDataFrame,
+				MHDR:        0x80,
+				DevAddr:     0x01020304,
+				FCtrl:       0x03,
+				FCnt:        0x0001,
+				FOpts:       "020306",
+				FPort:       -1,
+				MIC:         0x01020304,
+				RadioMetadata: RadioMetadata{
+					DataRate:  0,
+					Frequency: 868100000,
+				},
+			},
+			Expected: ttnpb.Message{
+				MHDR: ttnpb.MHDR{
+					MType: ttnpb.MType_CONFIRMED_UP,
+					Major: ttnpb.Major_LORAWAN_R1,
+				},
+				MIC: []byte{0x04, 0x03, 0x02, 0x01},
+				Payload: &ttnpb.Message_MACPayload{
+					MACPayload: &ttnpb.MACPayload{
+						FHDR: ttnpb.FHDR{
+							DevAddr: types.DevAddr{0x01, 0x02, 0x03, 0x04},
+							FCnt:    0x0001,
+							FOpts:   []byte{0x02, 0x03, 0x06},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "InvalidFRMPayload",
+			Frame: UplinkDataFrame{
+				MessageType: TypeUpstreamUplinkDataFrame,
+				FPort:       1,
+				FRMPayload:  "invalid",
+			},
+			ErrorCheck: func(err error) bool { return err != nil },
+		},
+		{
+			Name: "InvalidDataRate",
+			Frame: UplinkDataFrame{
+				MessageType: TypeUpstreamUplinkDataFrame,
+				RadioMetadata: RadioMetadata{
+					DataRate: 15,
+				},
+			},
+			ErrorCheck: func(err error) bool { return err != nil },
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			up, err := tc.Frame.ToUplinkMessage(ids, band.EU_863_870, receivedAt)
+			if tc.ErrorCheck != nil {
+				a.So(tc.ErrorCheck(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+
+			var msg ttnpb.Message
+			if !a.So(lorawan.UnmarshalMessage(up.RawPayload, &msg), should.BeNil) {
+				t.FailNow()
+			}
+			a.So(msg, should.Resemble, tc.Expected)
+			a.So(up.Settings.Frequency, should.Equal, tc.Frame.Frequency)
+			a.So(up.ReceivedAt, should.Equal, receivedAt)
+		})
+	}
+}
+
+func TestFromDownlinkMessage(t *testing.T) {
+	dr := func(i int) *int { return &i }
+	gpsTime := gpstime.Parse(1234567890).Add(123456 * time.Microsecond)
+
+	for _, tc := range []struct {
+		Name      string
+		Message   *ttnpb.DownlinkMessage
+		Context   io.DownlinkContext
+		Expected  *DownlinkMessage
+		ErrorFail bool
+	}{
+		{
+			Name: "NotScheduled",
+			Message: &ttnpb.DownlinkMessage{
+				RawPayload: []byte{0x01, 0x02},
+				Settings: &ttnpb.DownlinkMessage_Request{
+					Request: &ttnpb.TxRequest{},
+				},
+			},
+			ErrorFail: true,
+		},
+		{
+			Name: "ClassA/RX1",
+			Message: &ttnpb.DownlinkMessage{
+				RawPayload: []byte{0x01, 0x02},
+				EndDeviceIDs: &ttnpb.EndDeviceIdentifiers{
+					DevEUI: &types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+				},
+				Settings: &ttnpb.DownlinkMessage_Scheduled{
+					Scheduled: &ttnpb.TxSettings{
+						DataRateIndex: 5,
+						Frequency:     868100000,
+						Timestamp:     0x12345678 + 5000000,
+					},
+				},
+			},
+			Context: io.DownlinkContext{
+				Class: ttnpb.CLASS_A,
+				UplinkToken: &ttnpb.UplinkToken{
+					Timestamp:        0x12345678,
+					ConcentratorTime: 0x0100000012345678,
+					RadioContext:     2,
+				},
+				RxWindow: 1,
+				Rx1Delay: 5 * time.Second,
+			},
+			Expected: &DownlinkMessage{
+				MessageType: TypeDownstreamDownlinkMessage,
+				DevEUI:      EUI{types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}},
+				DIID:        42,
+				PDU:         "0102",
+				RxDelay:     5,
+				Rx1DR:       dr(5),
+				Rx1Freq:     868100000,
+				XTime:       0x0100000012345678,
+				RCtx:        2,
+			},
+		},
+		{
+			Name: "ClassA/RX2",
+			Message: &ttnpb.DownlinkMessage{
+				RawPayload: []byte{0x01, 0x02},
+				Settings: &ttnpb.DownlinkMessage_Scheduled{
+					Scheduled: &ttnpb.TxSettings{
+						DataRateIndex: 0,
+						Frequency:     869525000,
+						Timestamp:     0x00100000,
+					},
+				},
+			},
+			Context: io.DownlinkContext{
+				Class: ttnpb.CLASS_A,
+				UplinkToken: &ttnpb.UplinkToken{
+					Timestamp:        0xFFF00000,
+					ConcentratorTime: 0x01000000FFF00000,
+				},
+				RxWindow: 2,
+				Rx1Delay: time.Second,
+			},
+			Expected: &DownlinkMessage{
+				MessageType: TypeDownstreamDownlinkMessage,
+				DIID:        42,
+				PDU:         "0102",
+				RxDelay:     1,
+				Rx2DR:       dr(0),
+				Rx2Freq:     869525000,
+				XTime:       0x01000000FFF00000,
+			},
+		},
+		{
+			Name: "ClassA/NoUplinkToken",
+			Message: &ttnpb.DownlinkMessage{
+				RawPayload: []byte{0x01, 0x02},
+				Settings: &ttnpb.DownlinkMessage_Scheduled{
+					Scheduled: &ttnpb.TxSettings{
+						DataRateIndex: 5,
+						Frequency:     868100000,
+					},
+				},
+			},
+			Context: io.DownlinkContext{
+				Class:    ttnpb.CLASS_A,
+				RxWindow: 1,
+				Rx1Delay: time.Second,
+			},
+			ErrorFail: true,
+		},
+		{
+			Name: "ClassC/Immediate",
+			Message: &ttnpb.DownlinkMessage{
+				RawPayload: []byte{0x01, 0x02},
+				Settings: &ttnpb.DownlinkMessage_Scheduled{
+					Scheduled: &ttnpb.TxSettings{
+						DataRateIndex: 0,
+						Frequency:     869525000,
+						Timestamp:     0x00100000,
+					},
+				},
+			},
+			Context: io.DownlinkContext{
+				Class:    ttnpb.CLASS_C,
+				RxWindow: 2,
+			},
+			Expected: &DownlinkMessage{
+				MessageType: TypeDownstreamDownlinkMessage,
+				DeviceClass: 2,
+				DIID:        42,
+				PDU:         "0102",
+				Rx2DR:       dr(0),
+				Rx2Freq:     869525000,
+			},
+		},
+		{
+			Name: "ClassB/AbsoluteTime",
+			Message: &ttnpb.DownlinkMessage{
+				RawPayload: []byte{0x01, 0x02},
+				Settings: &ttnpb.DownlinkMessage_Scheduled{
+					Scheduled: &ttnpb.TxSettings{
+						DataRateIndex: 3,
+						Frequency:     869525000,
+						Time:          &gpsTime,
+					},
+				},
+			},
+			Context: io.DownlinkContext{
+				Class:    ttnpb.CLASS_B,
+				RxWindow: 1,
+			},
+			Expected: &DownlinkMessage{
+				MessageType: TypeDownstreamDownlinkMessage,
+				DeviceClass: 1,
+				DIID:        42,
+				PDU:         "0102",
+				Rx2DR:       dr(3),
+				Rx2Freq:     869525000,
+				GPSTime:     1234567890123456,
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			dnmsg, err := FromDownlinkMessage(tc.Message, 42, tc.Context)
+			if tc.ErrorFail {
+				a.So(err, should.NotBeNil)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(dnmsg, should.Resemble, tc.Expected)
+		})
+	}
+}
@@ -17,6 +17,7 @@ package io
 import (
 	"context"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -69,6 +70,26 @@ type Connection struct {
 	downCh   chan *ttnpb.DownlinkMessage
 	statusCh chan *ttnpb.GatewayStatus
 	txAckCh  chan *ttnpb.TxAcknowledgment
+
+	pendingDownlinksMu sync.Mutex
+	pendingDownlinks   []pendingDownlink
+}
+
+// DownlinkContext contains the context in which a downlink message is scheduled.
+type DownlinkContext struct {
+	// Class is the device class of the downlink message.
+	Class ttnpb.Class
+	// UplinkToken is the uplink token of the uplink message that the downlink message responds to, if any.
+	UplinkToken *ttnpb.UplinkToken
+	// RxWindow is the receive window in which the downlink message is scheduled, i.e. 1 or 2.
+	RxWindow int
+	// Rx1Delay is the delay of the first receive window after the uplink message.
+	Rx1Delay time.Duration
+}
+
+type pendingDownlink struct {
+	msg *ttnpb.DownlinkMessage
+	ctx DownlinkContext
 }
 
 // NewConnection instantiates a new gateway connection.
@@ -97,7 +118,7 @@ func (c *Connection) Disconnect(err error) {
 	c.cancelCtx(err)
 }
 
-// Protocol returns the protocol used for the connection, i.e. grpc, mqtt or udp.
+// Protocol returns the protocol used for the connection, i.e. grpc, mqtt, udp or basicstation.
 func (c *Connection) Protocol() string { return c.protocol }
 
 // HasScheduler returns whether the connection has a scheduler.
@@ -106,6 +127,9 @@ func (c *Connection) HasScheduler() bool { return c.scheduler != nil }
 // Gateway returns the gateway entity.
 func (c *Connection) Gateway() *ttnpb.Gateway { return c.gateway }
 
+// FrequencyPlan returns the frequency plan of the gateway.
+func (c *Connection) FrequencyPlan() *frequencyplans.FrequencyPlan { return c.fp }
+
 var errBufferFull = errors.DefineInternal("buffer_full", "buffer is full")
 
 // HandleUp updates the uplink stats and sends the message to the upstream channel.
@@ -121,10 +145,19 @@ func (c *Connection) HandleUp(up *ttnpb.UplinkMessage) error {
 			md.DownlinkPathConstraint = ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER
 			continue
 		}
-		buf, err := UplinkToken(ttnpb.GatewayAntennaIdentifiers{
+		var token ttnpb.UplinkToken
+		if md.UplinkToken != nil {
+			// The frontend may set an uplink token with the gateway specific concentrator time and radio context.
+			if err := token.Unmarshal(md.UplinkToken); err != nil {
+				token = ttnpb.UplinkToken{}
+			}
+		}
+		token.GatewayAntennaIdentifiers = ttnpb.GatewayAntennaIdentifiers{
 			GatewayIdentifiers: c.gateway.GatewayIdentifiers,
 			AntennaIndex:       md.AntennaIndex,
-		}, md.Timestamp)
+		}
+		token.Timestamp = md.Timestamp
+		buf, err := token.Marshal()
 		if err != nil {
 			return err
 		}
@@ -158,8 +191,80 @@ func (c *Connection) HandleStatus(status *ttnpb.GatewayStatus) error {
 	return nil
 }
 
+// addPendingDownlink adds the downlink message that awaits a Tx acknowledgment.
+// Only the most recent downlink messages are kept.
+func (c *Connection) addPendingDownlink(msg *ttnpb.DownlinkMessage, ctx DownlinkContext) {
+	c.pendingDownlinksMu.Lock()
+	c.pendingDownlinks = append(c.pendingDownlinks, pendingDownlink{msg: msg, ctx: ctx})
+	if len(c.pendingDownlinks) > bufferSize {
+		c.pendingDownlinks = append(c.pendingDownlinks[:0], c.pendingDownlinks[len(c.pendingDownlinks)-bufferSize:]...)
+	}
+	c.pendingDownlinksMu.Unlock()
+}
+
+// popPendingDownlink returns and removes the pending downlink message that shares a correlation ID with the given
+// correlation IDs. If no correlation IDs are given, no pending downlink message is returned.
+func (c *Connection) popPendingDownlink(correlationIDs []string) (*ttnpb.DownlinkMessage, bool) {
+	if len(correlationIDs) == 0 {
+		return nil, false
+	}
+	c.pendingDownlinksMu.Lock()
+	defer c.pendingDownlinksMu.Unlock()
+	for i, pending := range c.pendingDownlinks {
+		if !sharesCorrelationID(pending.msg.CorrelationIDs, correlationIDs) {
+			continue
+		}
+		c.pendingDownlinks = append(c.pendingDownlinks[:i], c.pendingDownlinks[i+1:]...)
+		return pending.msg, true
+	}
+	return nil, false
+}
+
+// removePendingDownlink removes the given downlink message from the pending downlink messages.
+func (c *Connection) removePendingDownlink(msg *ttnpb.DownlinkMessage) {
+	c.pendingDownlinksMu.Lock()
+	defer c.pendingDownlinksMu.Unlock()
+	for i, pending := range c.pendingDownlinks {
+		if pending.msg == msg {
+			c.pendingDownlinks = append(c.pendingDownlinks[:i], c.pendingDownlinks[i+1:]...)
+			return
+		}
+	}
+}
+
+// DownlinkContext returns the context in which the given downlink message from the downstream channel is scheduled.
+// The context is only available for downlink messages that are scheduled by the Gateway Server and that await a Tx
+// acknowledgment.
+func (c *Connection) DownlinkContext(msg *ttnpb.DownlinkMessage) (DownlinkContext, bool) {
+	c.pendingDownlinksMu.Lock()
+	defer c.pendingDownlinksMu.Unlock()
+	for _, pending := range c.pendingDownlinks {
+		if pending.msg == msg {
+			return pending.ctx, true
+		}
+	}
+	return DownlinkContext{}, false
+}
+
+func sharesCorrelationID(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // HandleTxAck sends the acknowledgment to the status channel.
+// The acknowledged downlink message is set if the acknowledgment can be matched to a sent downlink message.
 func (c *Connection) HandleTxAck(ack *ttnpb.TxAcknowledgment) error {
+	if ack.DownlinkMessage == nil {
+		if msg, ok := c.popPendingDownlink(ack.CorrelationIDs); ok {
+			ack.DownlinkMessage = msg
+		}
+	}
 	select {
 	case <-c.ctx.Done():
 		return c.ctx.Err()
@@ -182,21 +287,25 @@ var (
 	errTxSchedule       = errors.DefineAborted("tx_schedule", "failed to schedule")
 )
 
-func getDownlinkPath(path *ttnpb.DownlinkPath, class ttnpb.Class) (ids ttnpb.GatewayAntennaIdentifiers, uplinkTimestamp uint32, err error) {
+func getDownlinkPath(path *ttnpb.DownlinkPath, class ttnpb.Class) (ids ttnpb.GatewayAntennaIdentifiers, token *ttnpb.UplinkToken, err error) {
 	buf := path.GetUplinkToken()
 	if buf == nil && class == ttnpb.CLASS_A {
 		err = errNoUplinkToken
 		return
 	}
 	if buf != nil {
-		return ParseUplinkToken(buf)
+		token = &ttnpb.UplinkToken{}
+		if err = token.Unmarshal(buf); err != nil {
+			return
+		}
+		return token.GatewayAntennaIdentifiers, token, nil
 	}
 	fixed := path.GetFixed()
 	if fixed == nil {
 		err = errDownlinkPath
 		return
 	}
-	return *fixed, 0, nil
+	return *fixed, nil, nil
 }
 
 // SendDown schedules and sends a downlink message by using the given path and updates the downlink stats.
@@ -209,15 +318,19 @@ func (c *Connection) SendDown(path *ttnpb.DownlinkPath, msg *ttnpb.DownlinkMessa
 	if request == nil {
 		return errNotTxRequest
 	}
+	dlCtx := DownlinkContext{
+		Class: request.Class,
+	}
 	// If the connection has no scheduler, scheduling is done by the gateway scheduler.
 	// Otherwise, scheduling is done by the Gateway Server scheduler. This converts TxRequest to TxSettings.
 	if c.scheduler != nil {
 		logger := log.FromContext(c.ctx).WithField("class", request.Class)
 		logger.Debug("Scheduling downlink")
-		ids, uplinkTimestamp, err := getDownlinkPath(path, request.Class)
+		ids, token, err := getDownlinkPath(path, request.Class)
 		if err != nil {
 			return err
 		}
+		dlCtx.UplinkToken = token
 		band, err := band.GetByID(c.fp.BandID)
 		if err != nil {
 			return err
@@ -284,10 +397,8 @@ func (c *Connection) SendDown(path *ttnpb.DownlinkPath, msg *ttnpb.DownlinkMessa
 			switch request.Class {
 			case ttnpb.CLASS_A:
 				f = c.scheduler.ScheduleAt
-				settings.Timestamp = uplinkTimestamp + uint32(rxDelay/time.Microsecond)
-			case ttnpb.CLASS_B:
-				f = c.scheduler.ScheduleAnytime
-			case ttnpb.CLASS_C:
+				settings.Timestamp = token.Timestamp + uint32(rxDelay/time.Microsecond)
+			case ttnpb.CLASS_B, ttnpb.CLASS_C:
 				if request.AbsoluteTime != nil {
 					f = c.scheduler.ScheduleAt
 					abs := *request.AbsoluteTime
@@ -306,6 +417,8 @@ func (c *Connection) SendDown(path *ttnpb.DownlinkPath, msg *ttnpb.DownlinkMessa
 			msg.Settings = &ttnpb.DownlinkMessage_Scheduled{
 				Scheduled: &settings,
 			}
+			dlCtx.RxWindow = i + 1
+			dlCtx.Rx1Delay = rx1Delay
 			logger.WithFields(log.Fields(
 				"rx_window", i+1,
 				"frequency", rx.frequency,
@@ -320,13 +433,17 @@ func (c *Connection) SendDown(path *ttnpb.DownlinkPath, msg *ttnpb.DownlinkMessa
 			return errTxSchedule.WithDetails(errRxDetails...)
 		}
 	}
+	// Add the pending downlink message before sending it downstream, so that the frontend can get its context.
+	c.addPendingDownlink(msg, dlCtx)
 	select {
 	case <-c.ctx.Done():
+		c.removePendingDownlink(msg)
 		return c.ctx.Err()
 	case c.downCh <- msg:
 		atomic.AddUint64(&c.downlinks, 1)
 		atomic.StoreInt64(&c.lastDownlinkTime, time.Now().UnixNano())
 	default:
+		c.removePendingDownlink(msg)
 		return errBufferFull
 	}
 	return nil
@@ -378,4 +378,66 @@ func Test(t *testing.T) {
 			a.So(time.Since(last), should.BeLessThan, timeout)
 		})
 	}
+
+	t.Run("TxAckDownlink", func(t *testing.T) {
+		a := assertions.New(t)
+		msg := &ttnpb.DownlinkMessage{
+			RawPayload:     []byte{0x01},
+			CorrelationIDs: []string{"test:downlink"},
+			Settings: &ttnpb.DownlinkMessage_Request{
+				Request: &ttnpb.TxRequest{
+					Class:            ttnpb.CLASS_C,
+					Priority:         ttnpb.TxSchedulePriority_NORMAL,
+					Rx2DataRateIndex: 5,
+					Rx2Frequency:     869525000,
+				},
+			},
+		}
+		err := conn.SendDown(&ttnpb.DownlinkPath{
+			Path: &ttnpb.DownlinkPath_Fixed{
+				Fixed: &ttnpb.GatewayAntennaIdentifiers{
+					GatewayIdentifiers: ids,
+				},
+			},
+		}, msg)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		select {
+		case <-frontend.Down:
+		case <-time.After(timeout):
+			t.Fatalf("Expected downlink message timeout")
+		}
+
+		// Tx acknowledgments without correlation IDs do not match any downlink message.
+		frontend.TxAck <- &ttnpb.TxAcknowledgment{}
+		select {
+		case ack := <-conn.TxAck():
+			a.So(ack.DownlinkMessage, should.BeNil)
+		case <-time.After(timeout):
+			t.Fatalf("Expected Tx acknowledgement time-out")
+		}
+
+		frontend.TxAck <- &ttnpb.TxAcknowledgment{
+			CorrelationIDs: []string{"test:downlink"},
+			Result:         ttnpb.TxAcknowledgment_TOO_LATE,
+		}
+		select {
+		case ack := <-conn.TxAck():
+			a.So(ack.DownlinkMessage, should.Equal, msg)
+		case <-time.After(timeout):
+			t.Fatalf("Expected Tx acknowledgement time-out")
+		}
+
+		// The downlink message is acknowledged only once.
+		frontend.TxAck <- &ttnpb.TxAcknowledgment{
+			CorrelationIDs: []string{"test:downlink"},
+		}
+		select {
+		case ack := <-conn.TxAck():
+			a.So(ack.DownlinkMessage, should.BeNil)
+		case <-time.After(timeout):
+			t.Fatalf("Expected Tx acknowledgement time-out")
+		}
+	})
 }
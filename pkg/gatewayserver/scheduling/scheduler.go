@@ -47,9 +47,12 @@ var (
 // NewScheduler instantiates a new Scheduler for the given frequency plan.
 func NewScheduler(ctx context.Context, fp *frequencyplans.FrequencyPlan, enforceDutyCycle bool) (*Scheduler, error) {
 	s := &Scheduler{
-		clock:             &RolloverClock{},
-		respectsDwellTime: fp.RespectsDwellTime,
-		timeOffAir:        fp.TimeOffAir,
+		clock:         &RolloverClock{},
+		findDwellTime: fp.FindDwellTime,
+		timeOffAir:    fp.TimeOffAir,
+	}
+	if fp.LBT != nil {
+		s.lbtScanTime = fp.LBT.ScanTime
 	}
 	if enforceDutyCycle {
 		band, err := band.GetByID(fp.BandID)
@@ -73,12 +76,20 @@ func NewScheduler(ctx context.Context, fp *frequencyplans.FrequencyPlan, enforce
 
 // Scheduler is a packet scheduler that takes time conflicts and sub-band restrictions into account.
 type Scheduler struct {
-	clock             *RolloverClock
-	respectsDwellTime func(isDownlink bool, frequency uint64, duration time.Duration) bool
-	timeOffAir        frequencyplans.TimeOffAir
-	subBands          []*SubBand
-	mu                sync.Mutex
-	emissions         Emissions
+	clock         *RolloverClock
+	findDwellTime func(isDownlink bool, frequency uint64) (time.Duration, bool)
+	timeOffAir    frequencyplans.TimeOffAir
+	lbtScanTime   time.Duration
+	subBands      []*SubBand
+	mu            sync.Mutex
+	emissions     Emissions
+}
+
+// minGap returns the minimum time between the end of an emission, including its time-off-air, and the start of the
+// next emission. This is the queue delay plus, when Listen-Before-Talk is required, the time the gateway needs to scan
+// the channel before it can transmit.
+func (s *Scheduler) minGap() time.Duration {
+	return QueueDelay + s.lbtScanTime
 }
 
 var errSubBandNotFound = errors.DefineFailedPrecondition("sub_band_not_found", "sub-band not found for frequency `{frequency}` Hz")
@@ -93,7 +104,7 @@ func (s *Scheduler) findSubBand(frequency uint64) (*SubBand, error) {
 }
 
 var (
-	errDwellTime = errors.DefineFailedPrecondition("dwell_time", "packet exceeds dwell time restriction")
+	errDwellTime = errors.DefineFailedPrecondition("dwell_time", "time-on-air `{duration}` on frequency `{frequency}` Hz exceeds dwell time restriction of `{dwell_time}`")
 )
 
 func (s *Scheduler) newEmission(payloadSize int, settings ttnpb.TxSettings) (Emission, error) {
@@ -101,8 +112,12 @@ func (s *Scheduler) newEmission(payloadSize int, settings ttnpb.TxSettings) (Emi
 	if err != nil {
 		return Emission{}, err
 	}
-	if !s.respectsDwellTime(true, settings.Frequency, d) {
-		return Emission{}, errDwellTime
+	if dwellTime, ok := s.findDwellTime(true, settings.Frequency); ok && d > dwellTime {
+		return Emission{}, errDwellTime.WithAttributes(
+			"duration", d,
+			"frequency", settings.Frequency,
+			"dwell_time", dwellTime,
+		)
 	}
 	var relative ConcentratorTime
 	if settings.Time != nil {
@@ -124,11 +139,13 @@ func (s *Scheduler) ScheduleAt(ctx context.Context, payloadSize int, settings tt
 	defer s.mu.Unlock()
 	if s.clock.IsSynced() {
 		now := s.clock.ServerTime(time.Now())
+		// With Listen-Before-Talk, the gateway needs to have the downlink before it starts scanning the channel.
+		minDelta := ScheduleTimeShort + s.lbtScanTime
 		if settings.Time != nil {
-			if delta := time.Duration(s.clock.GatewayTime(*settings.Time) - now); delta < ScheduleTimeShort {
+			if delta := time.Duration(s.clock.GatewayTime(*settings.Time) - now); delta < minDelta {
 				return Emission{}, errTooLate.WithAttributes("delta", delta)
 			}
-		} else if delta := time.Duration(s.clock.TimestampTime(settings.Timestamp) - s.clock.ServerTime(time.Now())); delta < ScheduleTimeShort {
+		} else if delta := time.Duration(s.clock.TimestampTime(settings.Timestamp) - now); delta < minDelta {
 			return Emission{}, errTooLate.WithAttributes("delta", delta)
 		}
 	}
@@ -140,8 +157,9 @@ func (s *Scheduler) ScheduleAt(ctx context.Context, payloadSize int, settings tt
 	if err != nil {
 		return Emission{}, err
 	}
+	minGap := s.minGap()
 	for _, other := range s.emissions {
-		if em.AfterWithOffAir(other, s.timeOffAir)-QueueDelay < 0 && em.BeforeWithOffAir(other, s.timeOffAir)-QueueDelay < 0 {
+		if em.AfterWithOffAir(other, s.timeOffAir)-minGap < 0 && em.BeforeWithOffAir(other, s.timeOffAir)-minGap < 0 {
 			return Emission{}, errConflict
 		}
 	}
@@ -164,15 +182,16 @@ func (s *Scheduler) ScheduleAnytime(ctx context.Context, payloadSize int, settin
 	defer s.mu.Unlock()
 	if s.clock.IsSynced() {
 		now := s.clock.ServerTime(time.Now())
+		minDelta := ScheduleTimeShort + s.lbtScanTime
 		if settings.Timestamp == 0 && settings.Time == nil {
-			settings.Timestamp = uint32((time.Duration(now) + ScheduleTimeLong) / time.Microsecond)
+			settings.Timestamp = uint32((time.Duration(now) + ScheduleTimeLong + s.lbtScanTime) / time.Microsecond)
 		} else if settings.Time != nil {
-			if delta := time.Duration(s.clock.GatewayTime(*settings.Time) - now); delta < ScheduleTimeShort {
-				t := settings.Time.Add(ScheduleTimeShort - delta)
+			if delta := time.Duration(s.clock.GatewayTime(*settings.Time) - now); delta < minDelta {
+				t := settings.Time.Add(minDelta - delta)
 				settings.Time = &t
 			}
-		} else if delta := time.Duration(s.clock.TimestampTime(settings.Timestamp) - now); delta < ScheduleTimeShort {
-			settings.Timestamp += uint32((ScheduleTimeShort - delta) / time.Microsecond)
+		} else if delta := time.Duration(s.clock.TimestampTime(settings.Timestamp) - now); delta < minDelta {
+			settings.Timestamp += uint32((minDelta - delta) / time.Microsecond)
 		}
 	}
 	sb, err := s.findSubBand(settings.Frequency)
@@ -183,6 +202,7 @@ func (s *Scheduler) ScheduleAnytime(ctx context.Context, payloadSize int, settin
 	if err != nil {
 		return Emission{}, err
 	}
+	minGap := s.minGap()
 	i := 0
 	next := func() ConcentratorTime {
 		if len(s.emissions) == 0 {
@@ -191,15 +211,15 @@ func (s *Scheduler) ScheduleAnytime(ctx context.Context, payloadSize int, settin
 		}
 		for i < len(s.emissions)-1 {
 			// Find a window between two emissions that does not conflict with either side.
-			prevConflicts := s.emissions[i].AfterWithOffAir(em, s.timeOffAir)-QueueDelay < 0
+			prevConflicts := s.emissions[i].AfterWithOffAir(em, s.timeOffAir)-minGap < 0
 			if prevConflicts {
 				// Schedule right after previous to resolve conflict.
-				em.t = s.emissions[i].EndsWithOffAir(s.timeOffAir) + ConcentratorTime(QueueDelay)
+				em.t = s.emissions[i].EndsWithOffAir(s.timeOffAir) + ConcentratorTime(minGap)
 			}
-			nextConflicts := em.BeforeWithOffAir(s.emissions[i+1], s.timeOffAir)-QueueDelay < 0
+			nextConflicts := em.BeforeWithOffAir(s.emissions[i+1], s.timeOffAir)-minGap < 0
 			if nextConflicts {
 				// If it conflicts with the next, try the next window.
-				em.t = s.emissions[i+1].EndsWithOffAir(s.timeOffAir) + ConcentratorTime(QueueDelay)
+				em.t = s.emissions[i+1].EndsWithOffAir(s.timeOffAir) + ConcentratorTime(minGap)
 				i++
 				continue
 			}
@@ -209,7 +229,7 @@ func (s *Scheduler) ScheduleAnytime(ctx context.Context, payloadSize int, settin
 			return em.t
 		}
 		// No emissions to schedule in between; schedule after last emission.
-		return s.emissions[len(s.emissions)-1].EndsWithOffAir(s.timeOffAir) + ConcentratorTime(QueueDelay)
+		return s.emissions[len(s.emissions)-1].EndsWithOffAir(s.timeOffAir) + ConcentratorTime(minGap)
 	}
 	em, err = sb.ScheduleAnytime(em.d, next, priority)
 	if err != nil {
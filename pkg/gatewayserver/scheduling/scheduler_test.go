@@ -387,3 +387,72 @@ func TestScheduleAnytimeShort(t *testing.T) {
 		a.So(time.Duration(em.Starts()), should.AlmostEqual, scheduling.ScheduleTimeShort, test.Delay/1000)
 	}
 }
+
+func TestScheduleDwellTimeAndLBT(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	fp := &frequencyplans.FrequencyPlan{
+		BandID: band.AS_923,
+		DwellTime: frequencyplans.DwellTime{
+			Downlinks: boolPtr(true),
+			Duration:  durationPtr(400 * time.Millisecond),
+		},
+		LBT: &frequencyplans.LBT{
+			RSSITarget: -80,
+			ScanTime:   5 * time.Millisecond,
+		},
+	}
+	scheduler, err := scheduling.NewScheduler(ctx, fp, false)
+	a.So(err, should.BeNil)
+	scheduler.SyncWithGateway(0, time.Now(), time.Unix(0, 0))
+
+	settingsAt := func(sf, t uint32) ttnpb.TxSettings {
+		return ttnpb.TxSettings{
+			DataRate: ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_LoRa{
+					LoRa: &ttnpb.LoRaDataRate{
+						Bandwidth:       125000,
+						SpreadingFactor: sf,
+					},
+				},
+			},
+			CodingRate: "4/5",
+			Frequency:  923200000,
+			Timestamp:  t,
+		}
+	}
+
+	// Time-on-air is 2465792 us, which exceeds the dwell time of 400 ms.
+	_, err = scheduler.ScheduleAt(ctx, 51, settingsAt(12, 1000000), ttnpb.TxSchedulePriority_NORMAL)
+	if a.So(err, should.HaveSameErrorDefinitionAs, scheduling.ErrDwellTime) {
+		attributes := errors.Attributes(err)
+		a.So(attributes["duration"], should.Equal, "2.465792s")
+		a.So(attributes["frequency"], should.Equal, "923200000")
+		a.So(attributes["dwell_time"], should.Equal, "400ms")
+	}
+
+	// The scan time is added to the minimum schedule time.
+	_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(7, uint32((scheduling.ScheduleTimeShort+2*time.Millisecond)/time.Microsecond)), ttnpb.TxSchedulePriority_NORMAL)
+	a.So(err, should.HaveSameErrorDefinitionAs, scheduling.ErrTooLate)
+
+	// Time-on-air is 41216 us, queue delay is 30000 us and scan time is 5000 us.
+	// 1: [1000000, 1041216]
+	_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(7, 1000000), ttnpb.TxSchedulePriority_NORMAL)
+	a.So(err, should.BeNil)
+
+	// The queue delay alone is not sufficient to scan the channel before transmission.
+	_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(7, 1071216), ttnpb.TxSchedulePriority_NORMAL)
+	a.So(err, should.HaveSameErrorDefinitionAs, scheduling.ErrConflict)
+
+	// 1: [1000000, 1041216]
+	// 2: [1076216, 1117432]
+	_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(7, 1076216), ttnpb.TxSchedulePriority_NORMAL)
+	a.So(err, should.BeNil)
+
+	// 1: [1000000, 1041216]
+	// 2: [1076216, 1117432]
+	// 3: [1152432, 1193648]
+	em, err := scheduler.ScheduleAnytime(ctx, 10, settingsAt(7, 1000000), ttnpb.TxSchedulePriority_NORMAL)
+	a.So(err, should.BeNil)
+	a.So(em.Starts(), should.Equal, 1152432*time.Microsecond)
+}
@@ -119,11 +119,13 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 		store.UserSessionStore
 		store.ClientStore
 		store.OAuthStore
+		store.MembershipStore
 	}{
 		UserStore:        store.GetUserStore(is.db),
 		UserSessionStore: store.GetUserSessionStore(is.db),
 		ClientStore:      store.GetClientStore(is.db),
 		OAuthStore:       store.GetOAuthStore(is.db),
+		MembershipStore:  store.GetMembershipStore(is.db),
 	}, is.config.OAuth)
 
 	c.AddContextFiller(func(ctx context.Context) context.Context {
@@ -108,10 +108,47 @@ func (a AccessToken) toPB() *ttnpb.OAuthAccessToken {
 	return pb
 }
 
+// DeviceAuthorization model.
+type DeviceAuthorization struct {
+	Model
+
+	Client   *Client
+	ClientID string `gorm:"type:UUID;index;not null"`
+
+	User   *User
+	UserID *string `gorm:"type:UUID;index"`
+
+	Rights Rights `gorm:"type:INT ARRAY"`
+
+	DeviceCode string `gorm:"type:VARCHAR;unique_index;not null"`
+	UserCode   string `gorm:"type:VARCHAR;unique_index;not null"`
+	Authorized bool
+	ExpiresAt  time.Time
+}
+
+func (a DeviceAuthorization) toPB() *ttnpb.OAuthDeviceAuthorization {
+	pb := &ttnpb.OAuthDeviceAuthorization{
+		Rights:     a.Rights.Rights,
+		DeviceCode: a.DeviceCode,
+		UserCode:   a.UserCode,
+		Authorized: a.Authorized,
+		CreatedAt:  cleanTime(a.CreatedAt),
+		ExpiresAt:  cleanTime(a.ExpiresAt),
+	}
+	if a.Client != nil {
+		pb.ClientIDs.ClientID = a.Client.ClientID
+	}
+	if a.User != nil {
+		pb.UserIDs.UserID = a.User.Account.UID
+	}
+	return pb
+}
+
 func init() {
 	registerModel(
 		&ClientAuthorization{},
 		&AuthorizationCode{},
 		&AccessToken{},
+		&DeviceAuthorization{},
 	)
 }
@@ -16,8 +16,10 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -228,3 +230,98 @@ func (s *oauthStore) DeleteAccessToken(ctx context.Context, id string) error {
 	}
 	return nil
 }
+
+func (s *oauthStore) CreateDeviceAuthorization(ctx context.Context, authorization *ttnpb.OAuthDeviceAuthorization) error {
+	client, err := findEntity(ctx, s.db, authorization.ClientIDs.EntityIdentifiers(), "id")
+	if err != nil {
+		return err
+	}
+	authModel := DeviceAuthorization{
+		ClientID:   client.PrimaryKey(),
+		Rights:     Rights{Rights: authorization.Rights},
+		DeviceCode: authorization.DeviceCode,
+		UserCode:   authorization.UserCode,
+		ExpiresAt:  authorization.ExpiresAt,
+	}
+	authModel.SetContext(ctx)
+	authModel.CreatedAt = cleanTime(authorization.CreatedAt)
+	// Device authorizations that are never exchanged are not deleted otherwise, so expired device authorizations are
+	// purged whenever a new one is created.
+	err = s.db.Scopes(withContext(ctx)).Where("expires_at < ?", time.Now()).Delete(&DeviceAuthorization{}).Error
+	if err != nil {
+		return err
+	}
+	if err := s.db.Create(&authModel).Error; err != nil {
+		return convertError(err)
+	}
+	return nil
+}
+
+func (s *oauthStore) getDeviceAuthorization(ctx context.Context, where DeviceAuthorization) (*ttnpb.OAuthDeviceAuthorization, error) {
+	var authModel DeviceAuthorization
+	err := s.db.Scopes(withContext(ctx)).Where(where).Preload("Client").Preload("User.Account").First(&authModel).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errDeviceAuthorizationNotFound
+		}
+		return nil, err
+	}
+	return authModel.toPB(), nil
+}
+
+func (s *oauthStore) GetDeviceAuthorization(ctx context.Context, deviceCode string) (*ttnpb.OAuthDeviceAuthorization, error) {
+	if deviceCode == "" {
+		return nil, errDeviceAuthorizationNotFound
+	}
+	return s.getDeviceAuthorization(ctx, DeviceAuthorization{DeviceCode: deviceCode})
+}
+
+func (s *oauthStore) GetDeviceAuthorizationByUserCode(ctx context.Context, userCode string) (*ttnpb.OAuthDeviceAuthorization, error) {
+	if userCode == "" {
+		return nil, errDeviceAuthorizationNotFound
+	}
+	return s.getDeviceAuthorization(ctx, DeviceAuthorization{UserCode: userCode})
+}
+
+var errDeviceAuthorizationDecided = errors.DefineAlreadyExists("device_authorization_decided", "device authorization already approved or denied")
+
+func (s *oauthStore) SetDeviceAuthorizationDecision(ctx context.Context, userCode string, userIDs *ttnpb.UserIdentifiers, authorized bool) error {
+	if userCode == "" {
+		return errDeviceAuthorizationNotFound
+	}
+	var authModel DeviceAuthorization
+	err := s.db.Scopes(withContext(ctx)).Where(DeviceAuthorization{UserCode: userCode}).First(&authModel).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return errDeviceAuthorizationNotFound
+		}
+		return err
+	}
+	if authModel.UserID != nil {
+		return errDeviceAuthorizationDecided
+	}
+	user, err := findEntity(ctx, s.db, userIDs.EntityIdentifiers(), "id")
+	if err != nil {
+		return err
+	}
+	id := user.PrimaryKey()
+	authModel.UserID = &id
+	authModel.Authorized = authorized
+	return s.db.Save(&authModel).Error
+}
+
+func (s *oauthStore) DeleteDeviceAuthorization(ctx context.Context, deviceCode string) error {
+	if deviceCode == "" {
+		return errDeviceAuthorizationNotFound
+	}
+	err := s.db.Scopes(withContext(ctx)).Where(DeviceAuthorization{
+		DeviceCode: deviceCode,
+	}).Delete(&DeviceAuthorization{}).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return errDeviceAuthorizationNotFound
+		}
+		return err
+	}
+	return nil
+}
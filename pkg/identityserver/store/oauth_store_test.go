@@ -34,6 +34,7 @@ func TestOauthStore(t *testing.T) {
 			&ClientAuthorization{},
 			&AuthorizationCode{},
 			&AccessToken{},
+			&DeviceAuthorization{},
 			&User{},
 			&Client{},
 			&Account{},
@@ -220,5 +221,110 @@ func TestOauthStore(t *testing.T) {
 			a.So(err, should.NotBeNil)
 			a.So(errors.IsNotFound(err), should.BeTrue)
 		})
+
+		t.Run("Device Authorization", func(t *testing.T) {
+			a := assertions.New(t)
+
+			deviceCode := "test-device-code"
+			userCode := "BCDFGHJK"
+
+			empty, err := store.GetDeviceAuthorization(ctx, deviceCode)
+
+			a.So(empty, should.BeNil)
+			a.So(err, should.NotBeNil)
+			a.So(errors.IsNotFound(err), should.BeTrue)
+
+			err = store.SetDeviceAuthorizationDecision(ctx, userCode, userIDs, true)
+
+			a.So(err, should.NotBeNil)
+			a.So(errors.IsNotFound(err), should.BeTrue)
+
+			start := time.Now()
+
+			err = store.CreateDeviceAuthorization(ctx, &ttnpb.OAuthDeviceAuthorization{
+				ClientIDs:  *clientIDs,
+				Rights:     rights,
+				DeviceCode: deviceCode,
+				UserCode:   userCode,
+				ExpiresAt:  start.Add(time.Minute),
+			})
+
+			a.So(err, should.BeNil)
+
+			got, err := store.GetDeviceAuthorization(ctx, deviceCode)
+
+			a.So(got, should.NotBeNil)
+			a.So(err, should.BeNil)
+			a.So(got.UserIDs.UserID, should.BeEmpty)
+			a.So(got.ClientIDs.ClientID, should.Equal, clientIDs.ClientID)
+			a.So(got.DeviceCode, should.Equal, deviceCode)
+			a.So(got.UserCode, should.Equal, userCode)
+			a.So(got.Authorized, should.BeFalse)
+			a.So(got.CreatedAt, should.HappenAfter, start)
+			a.So(got.Rights, should.HaveLength, len(rights))
+
+			err = store.SetDeviceAuthorizationDecision(ctx, userCode, userIDs, true)
+
+			a.So(err, should.BeNil)
+
+			got, err = store.GetDeviceAuthorizationByUserCode(ctx, userCode)
+
+			a.So(got, should.NotBeNil)
+			a.So(err, should.BeNil)
+			a.So(got.UserIDs.UserID, should.Equal, userIDs.UserID)
+			a.So(got.DeviceCode, should.Equal, deviceCode)
+			a.So(got.Authorized, should.BeTrue)
+
+			err = store.SetDeviceAuthorizationDecision(ctx, userCode, userIDs, false)
+
+			a.So(err, should.NotBeNil)
+			a.So(errors.IsAlreadyExists(err), should.BeTrue)
+
+			err = store.DeleteDeviceAuthorization(ctx, deviceCode)
+
+			a.So(err, should.BeNil)
+
+			deleted, err := store.GetDeviceAuthorization(ctx, deviceCode)
+
+			a.So(deleted, should.BeNil)
+			a.So(err, should.NotBeNil)
+			a.So(errors.IsNotFound(err), should.BeTrue)
+
+			err = store.CreateDeviceAuthorization(ctx, &ttnpb.OAuthDeviceAuthorization{
+				ClientIDs:  *clientIDs,
+				Rights:     rights,
+				DeviceCode: "expired-device-code",
+				UserCode:   "CDFGHJKL",
+				ExpiresAt:  start.Add(-time.Minute),
+			})
+
+			a.So(err, should.BeNil)
+
+			err = store.CreateDeviceAuthorization(ctx, &ttnpb.OAuthDeviceAuthorization{
+				ClientIDs:  *clientIDs,
+				Rights:     rights,
+				DeviceCode: deviceCode,
+				UserCode:   "CDFGHJKL",
+				ExpiresAt:  start.Add(time.Minute),
+			})
+
+			a.So(err, should.BeNil)
+
+			expired, err := store.GetDeviceAuthorization(ctx, "expired-device-code")
+
+			a.So(expired, should.BeNil)
+			a.So(errors.IsNotFound(err), should.BeTrue)
+
+			err = store.CreateDeviceAuthorization(ctx, &ttnpb.OAuthDeviceAuthorization{
+				ClientIDs:  *clientIDs,
+				Rights:     rights,
+				DeviceCode: "other-device-code",
+				UserCode:   "CDFGHJKL",
+				ExpiresAt:  start.Add(time.Minute),
+			})
+
+			a.So(err, should.NotBeNil)
+			a.So(errors.IsAlreadyExists(err), should.BeTrue)
+		})
 	})
 }
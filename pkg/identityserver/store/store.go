@@ -151,9 +151,10 @@ var (
 	errUserNotFound         = errors.DefineNotFound("user_not_found", "user `{user_id}` not found")
 	errSessionNotFound      = errors.DefineNotFound("session_not_found", "session `{session_id}` for user `{user_id}` not found")
 
-	errAuthorizationNotFound     = errors.DefineNotFound("authorization_not_found", "authorization of `{user_id}` for `{client_id}` not found")
-	errAuthorizationCodeNotFound = errors.DefineNotFound("authorization_code_not_found", "authorization code not found")
-	errAccessTokenNotFound       = errors.DefineNotFound("access_token_not_found", "access token not found")
+	errAuthorizationNotFound       = errors.DefineNotFound("authorization_not_found", "authorization of `{user_id}` for `{client_id}` not found")
+	errAuthorizationCodeNotFound   = errors.DefineNotFound("authorization_code_not_found", "authorization code not found")
+	errAccessTokenNotFound         = errors.DefineNotFound("access_token_not_found", "access token not found")
+	errDeviceAuthorizationNotFound = errors.DefineNotFound("device_authorization_not_found", "device authorization not found")
 
 	errAPIKeyNotFound = errors.DefineNotFound("api_key_not_found", "API key not found")
 )
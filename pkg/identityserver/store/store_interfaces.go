@@ -153,6 +153,15 @@ type OAuthStore interface {
 	CreateAccessToken(ctx context.Context, token *ttnpb.OAuthAccessToken, previousID string) error
 	GetAccessToken(ctx context.Context, id string) (*ttnpb.OAuthAccessToken, error)
 	DeleteAccessToken(ctx context.Context, id string) error
+
+	// Create the device authorization and delete the expired device authorizations.
+	// An AlreadyExists error is returned if the device code or the user code is already taken.
+	CreateDeviceAuthorization(ctx context.Context, authorization *ttnpb.OAuthDeviceAuthorization) error
+	GetDeviceAuthorization(ctx context.Context, deviceCode string) (*ttnpb.OAuthDeviceAuthorization, error)
+	GetDeviceAuthorizationByUserCode(ctx context.Context, userCode string) (*ttnpb.OAuthDeviceAuthorization, error)
+	// Set the user that approved (authorized is true) or denied the device authorization with the given user code.
+	SetDeviceAuthorizationDecision(ctx context.Context, userCode string, userIDs *ttnpb.UserIdentifiers, authorized bool) error
+	DeleteDeviceAuthorization(ctx context.Context, deviceCode string) error
 }
 
 // InvitationStore interface for storing user invitations.
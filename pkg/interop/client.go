@@ -0,0 +1,144 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interop
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"go.thethings.network/lorawan-stack/pkg/version"
+)
+
+var userAgent = "ttn-lw-stack/" + version.TTN
+
+// Client is a LoRaWAN Backend Interfaces HTTP client.
+type Client struct {
+	httpClient *http.Client
+}
+
+// NewClient returns a new Client, which uses httpClient to perform requests.
+// If httpClient is nil, http.DefaultClient is used.
+func NewClient(httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{
+		httpClient: httpClient,
+	}
+}
+
+func (c *Client) do(ctx context.Context, url string, req, ans interface{}) error {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	httpReq, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", userAgent)
+	res, err := c.httpClient.Do(httpReq.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		io.Copy(ioutil.Discard, res.Body)
+		res.Body.Close()
+	}()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return errRequest.WithAttributes("code", res.StatusCode)
+	}
+	if err := json.NewDecoder(res.Body).Decode(ans); err != nil {
+		return errDecode.WithCause(err)
+	}
+	return nil
+}
+
+func (c *Client) fillHeader(h *MessageHeader, typ MessageType) {
+	if h.ProtocolVersion == "" {
+		h.ProtocolVersion = ProtocolVersion
+	}
+	h.MessageType = typ
+}
+
+// SendPRStartReq sends the PRStartReq to the peer at url and returns the answer.
+func (c *Client) SendPRStartReq(ctx context.Context, url string, req *PRStartReq) (*PRStartAns, error) {
+	c.fillHeader(&req.MessageHeader, MessageTypePRStartReq)
+	ans := &PRStartAns{}
+	if err := c.do(ctx, url, req, ans); err != nil {
+		return nil, err
+	}
+	if ans.MessageType != MessageTypePRStartAns {
+		return nil, errMessageType.WithAttributes("type", ans.MessageType)
+	}
+	return ans, nil
+}
+
+// SendXmitDataReq sends the XmitDataReq to the peer at url and returns the answer.
+func (c *Client) SendXmitDataReq(ctx context.Context, url string, req *XmitDataReq) (*XmitDataAns, error) {
+	c.fillHeader(&req.MessageHeader, MessageTypeXmitDataReq)
+	ans := &XmitDataAns{}
+	if err := c.do(ctx, url, req, ans); err != nil {
+		return nil, err
+	}
+	if ans.MessageType != MessageTypeXmitDataAns {
+		return nil, errMessageType.WithAttributes("type", ans.MessageType)
+	}
+	return ans, nil
+}
+
+// SendJoinReq sends the JoinReq to the Join Server at url and returns the answer.
+func (c *Client) SendJoinReq(ctx context.Context, url string, req *JoinReq) (*JoinAns, error) {
+	c.fillHeader(&req.MessageHeader, MessageTypeJoinReq)
+	ans := &JoinAns{}
+	if err := c.do(ctx, url, req, ans); err != nil {
+		return nil, err
+	}
+	if ans.MessageType != MessageTypeJoinAns {
+		return nil, errMessageType.WithAttributes("type", ans.MessageType)
+	}
+	return ans, nil
+}
+
+// SendAppSKeyReq sends the AppSKeyReq to the Join Server at url and returns the answer.
+func (c *Client) SendAppSKeyReq(ctx context.Context, url string, req *AppSKeyReq) (*AppSKeyAns, error) {
+	c.fillHeader(&req.MessageHeader, MessageTypeAppSKeyReq)
+	ans := &AppSKeyAns{}
+	if err := c.do(ctx, url, req, ans); err != nil {
+		return nil, err
+	}
+	if ans.MessageType != MessageTypeAppSKeyAns {
+		return nil, errMessageType.WithAttributes("type", ans.MessageType)
+	}
+	return ans, nil
+}
+
+// SendHomeNSReq sends the HomeNSReq to the Join Server at url and returns the answer.
+func (c *Client) SendHomeNSReq(ctx context.Context, url string, req *HomeNSReq) (*HomeNSAns, error) {
+	c.fillHeader(&req.MessageHeader, MessageTypeHomeNSReq)
+	ans := &HomeNSAns{}
+	if err := c.do(ctx, url, req, ans); err != nil {
+		return nil, err
+	}
+	if ans.MessageType != MessageTypeHomeNSAns {
+		return nil, errMessageType.WithAttributes("type", ans.MessageType)
+	}
+	return ans, nil
+}
@@ -0,0 +1,144 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package interop implements the LoRaWAN Backend Interfaces specification.
+package interop
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/pkg/band"
+)
+
+// ProtocolVersion is the LoRaWAN Backend Interfaces protocol version.
+const ProtocolVersion = "1.0"
+
+// MessageType is the type of a LoRaWAN Backend Interfaces message.
+type MessageType string
+
+// LoRaWAN Backend Interfaces message types.
+const (
+	MessageTypePRStartReq  MessageType = "PRStartReq"
+	MessageTypePRStartAns  MessageType = "PRStartAns"
+	MessageTypeXmitDataReq MessageType = "XmitDataReq"
+	MessageTypeXmitDataAns MessageType = "XmitDataAns"
+	MessageTypeJoinReq     MessageType = "JoinReq"
+	MessageTypeJoinAns     MessageType = "JoinAns"
+	MessageTypeAppSKeyReq  MessageType = "AppSKeyReq"
+	MessageTypeAppSKeyAns  MessageType = "AppSKeyAns"
+	MessageTypeHomeNSReq   MessageType = "HomeNSReq"
+	MessageTypeHomeNSAns   MessageType = "HomeNSAns"
+)
+
+// ResultCode is the result of a LoRaWAN Backend Interfaces request.
+type ResultCode string
+
+// LoRaWAN Backend Interfaces result codes.
+const (
+	ResultSuccess              ResultCode = "Success"
+	ResultMICFailed            ResultCode = "MICFailed"
+	ResultFrameReplayed        ResultCode = "FrameReplayed"
+	ResultUnknownDevAddr       ResultCode = "UnknownDevAddr"
+	ResultUnknownDevEUI        ResultCode = "UnknownDevEUI"
+	ResultUnknownSender        ResultCode = "UnknownSender"
+	ResultUnknownReceiver      ResultCode = "UnknownReceiver"
+	ResultMalformedRequest     ResultCode = "MalformedRequest"
+	ResultNoRoamingAgreement   ResultCode = "NoRoamingAgreement"
+	ResultDevRoamingDisallowed ResultCode = "DevRoamingDisallowed"
+	ResultXmitFailed           ResultCode = "XmitFailed"
+	ResultJoinReqFailed        ResultCode = "JoinReqFailed"
+	ResultOther                ResultCode = "Other"
+)
+
+// MessageHeader is the common header of LoRaWAN Backend Interfaces messages.
+type MessageHeader struct {
+	ProtocolVersion string
+	SenderID        string
+	ReceiverID      string
+	TransactionID   uint32
+	MessageType     MessageType
+	SenderToken     Buffer `json:",omitempty"`
+	ReceiverToken   Buffer `json:",omitempty"`
+}
+
+// AnswerHeader returns the header of the answer to a request with header h.
+func (h MessageHeader) AnswerHeader(typ MessageType) MessageHeader {
+	return MessageHeader{
+		ProtocolVersion: h.ProtocolVersion,
+		SenderID:        h.ReceiverID,
+		ReceiverID:      h.SenderID,
+		TransactionID:   h.TransactionID,
+		MessageType:     typ,
+		ReceiverToken:   h.SenderToken,
+	}
+}
+
+// Result is the result of a LoRaWAN Backend Interfaces request.
+type Result struct {
+	ResultCode  ResultCode
+	Description string `json:",omitempty"`
+}
+
+// Buffer is a byte slice, which is encoded as hexadecimal string in JSON.
+type Buffer []byte
+
+// MarshalJSON implements json.Marshaler.
+func (b Buffer) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hex.EncodeToString(b))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *Buffer) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	buf, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return err
+	}
+	*b = buf
+	return nil
+}
+
+var rfRegions = map[string]string{
+	band.AS_923:     "AS923",
+	band.AU_915_928: "Australia915",
+	band.CN_470_510: "China470",
+	band.CN_779_787: "China779",
+	band.EU_433:     "EU433",
+	band.EU_863_870: "EU868",
+	band.IN_865_867: "India865",
+	band.KR_920_923: "SouthKorea920",
+	band.RU_864_870: "RU864",
+	band.US_902_928: "US902",
+}
+
+// RFRegion returns the LoRaWAN Backend Interfaces RFRegion of the band with the given ID.
+func RFRegion(bandID string) (string, bool) {
+	rfRegion, ok := rfRegions[bandID]
+	return rfRegion, ok
+}
+
+// BandID returns the ID of the band with the given LoRaWAN Backend Interfaces RFRegion.
+func BandID(rfRegion string) (string, bool) {
+	for bandID, r := range rfRegions {
+		if r == rfRegion {
+			return bandID, true
+		}
+	}
+	return "", false
+}
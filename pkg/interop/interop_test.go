@@ -0,0 +1,62 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interop_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	. "go.thethings.network/lorawan-stack/pkg/interop"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestBuffer(t *testing.T) {
+	a := assertions.New(t)
+
+	b, err := json.Marshal(Buffer{0x01, 0x02, 0xab})
+	a.So(err, should.BeNil)
+	a.So(string(b), should.Equal, `"0102ab"`)
+
+	var buf Buffer
+	a.So(json.Unmarshal([]byte(`"0102AB"`), &buf), should.BeNil)
+	a.So(buf, should.Resemble, Buffer{0x01, 0x02, 0xab})
+	a.So(json.Unmarshal([]byte(`"0x0102"`), &buf), should.BeNil)
+	a.So(buf, should.Resemble, Buffer{0x01, 0x02})
+	a.So(json.Unmarshal([]byte(`"xyz"`), &buf), should.NotBeNil)
+}
+
+func TestRFRegion(t *testing.T) {
+	a := assertions.New(t)
+
+	rfRegion, ok := RFRegion(band.EU_863_870)
+	a.So(ok, should.BeTrue)
+	a.So(rfRegion, should.Equal, "EU868")
+
+	bandID, ok := BandID("US902")
+	a.So(ok, should.BeTrue)
+	a.So(bandID, should.Equal, band.US_902_928)
+
+	_, ok = BandID("Unknown")
+	a.So(ok, should.BeFalse)
+}
+
+func TestFrequency(t *testing.T) {
+	a := assertions.New(t)
+	a.So(FrequencyMHz(868100000), should.Equal, 868.1)
+	a.So(FrequencyHz(868.1), should.Equal, 868100000)
+	a.So(FrequencyHz(FrequencyMHz(923300000)), should.Equal, 923300000)
+}
@@ -0,0 +1,157 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interop
+
+import (
+	"math"
+	"time"
+)
+
+// FrequencyMHz returns the frequency in Hz as MHz, as used in LoRaWAN Backend Interfaces messages.
+func FrequencyMHz(hz uint64) float64 {
+	return float64(hz) / 1e6
+}
+
+// FrequencyHz returns the frequency in MHz as Hz.
+func FrequencyHz(mhz float64) uint64 {
+	return uint64(math.Round(mhz * 1e6))
+}
+
+// GWInfo contains the information of a gateway that received an uplink.
+type GWInfo struct {
+	ID        string   `json:",omitempty"`
+	RFRegion  string   `json:",omitempty"`
+	RSSI      *int32   `json:",omitempty"`
+	SNR       *float32 `json:",omitempty"`
+	Lat       *float64 `json:",omitempty"`
+	Lon       *float64 `json:",omitempty"`
+	ULToken   Buffer   `json:",omitempty"`
+	DLAllowed bool     `json:",omitempty"`
+}
+
+// ULMetaData contains the metadata of an uplink.
+type ULMetaData struct {
+	DevAddr  Buffer `json:",omitempty"`
+	DataRate *uint32
+	ULFreq   float64
+	RFRegion string
+	RecvTime time.Time
+	GWCnt    int
+	GWInfo   []GWInfo
+}
+
+// DLMetaData contains the metadata of a downlink.
+type DLMetaData struct {
+	DevAddr        Buffer   `json:",omitempty"`
+	DLFreq1        *float64 `json:",omitempty"`
+	DLFreq2        *float64 `json:",omitempty"`
+	RXDelay1       uint32
+	ClassMode      string
+	DataRate1      *uint32 `json:",omitempty"`
+	DataRate2      *uint32 `json:",omitempty"`
+	GWInfo         []GWInfo
+	HiPriorityFlag bool `json:",omitempty"`
+}
+
+// PRStartReq is the request sent by the serving network to start passive roaming of an uplink.
+type PRStartReq struct {
+	MessageHeader
+	PHYPayload Buffer
+	ULMetaData ULMetaData
+}
+
+// PRStartAns is the answer to a PRStartReq.
+type PRStartAns struct {
+	MessageHeader
+	Result   Result
+	Lifetime *uint32 `json:",omitempty"`
+}
+
+// XmitDataReq is the request to transmit data between networks.
+// In passive roaming, it is sent by the home network to transmit a downlink through the serving network.
+type XmitDataReq struct {
+	MessageHeader
+	PHYPayload Buffer
+	ULMetaData *ULMetaData `json:",omitempty"`
+	DLMetaData *DLMetaData `json:",omitempty"`
+}
+
+// XmitDataAns is the answer to a XmitDataReq.
+type XmitDataAns struct {
+	MessageHeader
+	Result Result
+}
+
+// KeyEnvelope contains a session key, which is wrapped with the KEK identified by KEKLabel.
+// If KEKLabel is empty, AESKey contains the key in the clear.
+type KeyEnvelope struct {
+	KEKLabel string
+	AESKey   Buffer
+}
+
+// JoinReq is the request sent by a Network Server to the Join Server to handle a join-request.
+type JoinReq struct {
+	MessageHeader
+	MACVersion string
+	PHYPayload Buffer
+	DevEUI     Buffer
+	DevAddr    Buffer
+	DLSettings Buffer
+	RxDelay    uint8
+	CFList     Buffer `json:",omitempty"`
+}
+
+// JoinAns is the answer to a JoinReq.
+type JoinAns struct {
+	MessageHeader
+	PHYPayload   Buffer `json:",omitempty"`
+	Result       Result
+	Lifetime     *uint32      `json:",omitempty"`
+	SNwkSIntKey  *KeyEnvelope `json:",omitempty"`
+	FNwkSIntKey  *KeyEnvelope `json:",omitempty"`
+	NwkSEncKey   *KeyEnvelope `json:",omitempty"`
+	NwkSKey      *KeyEnvelope `json:",omitempty"`
+	AppSKey      *KeyEnvelope `json:",omitempty"`
+	SessionKeyID Buffer       `json:",omitempty"`
+}
+
+// AppSKeyReq is the request sent by an Application Server to the Join Server to retrieve an AppSKey.
+type AppSKeyReq struct {
+	MessageHeader
+	DevEUI       Buffer
+	SessionKeyID Buffer
+}
+
+// AppSKeyAns is the answer to an AppSKeyReq.
+type AppSKeyAns struct {
+	MessageHeader
+	Result       Result
+	DevEUI       Buffer       `json:",omitempty"`
+	AppSKey      *KeyEnvelope `json:",omitempty"`
+	SessionKeyID Buffer       `json:",omitempty"`
+}
+
+// HomeNSReq is the request sent by a Network Server to the Join Server to retrieve the NetID of the home network of a device.
+type HomeNSReq struct {
+	MessageHeader
+	DevEUI Buffer
+}
+
+// HomeNSAns is the answer to a HomeNSReq.
+type HomeNSAns struct {
+	MessageHeader
+	Result Result
+	HNetID string `json:",omitempty"`
+}
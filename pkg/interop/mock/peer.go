@@ -0,0 +1,102 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mock provides a mock LoRaWAN Backend Interfaces peer network.
+package mock
+
+import (
+	"context"
+	"net/http/httptest"
+
+	"go.thethings.network/lorawan-stack/pkg/interop"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+// Peer is a mock roaming peer network, which serves LoRaWAN Backend Interfaces over local HTTP.
+// Received requests are sent to the PRStartReq and XmitDataReq channels and answered with ResultSuccess.
+type Peer struct {
+	NetID types.NetID
+
+	PRStartReq  chan *interop.PRStartReq
+	XmitDataReq chan *interop.XmitDataReq
+
+	ctx    context.Context
+	server *httptest.Server
+	client *interop.Client
+}
+
+// NewPeer starts a new mock peer network with the given NetID.
+// The peer is closed when ctx is done.
+func NewPeer(ctx context.Context, netID types.NetID) *Peer {
+	p := &Peer{
+		NetID:       netID,
+		PRStartReq:  make(chan *interop.PRStartReq),
+		XmitDataReq: make(chan *interop.XmitDataReq),
+		ctx:         ctx,
+		client:      interop.NewClient(nil),
+	}
+	p.server = httptest.NewServer(interop.NewServer(ctx, p, nil))
+	go func() {
+		<-ctx.Done()
+		p.server.Close()
+	}()
+	return p
+}
+
+// URL returns the URL of the peer.
+func (p *Peer) URL() string {
+	return p.server.URL
+}
+
+// HandlePRStartReq implements interop.NetworkServer.
+func (p *Peer) HandlePRStartReq(ctx context.Context, req *interop.PRStartReq) (*interop.PRStartAns, error) {
+	select {
+	case <-p.ctx.Done():
+		return nil, p.ctx.Err()
+	case p.PRStartReq <- req:
+	}
+	return &interop.PRStartAns{
+		MessageHeader: req.AnswerHeader(interop.MessageTypePRStartAns),
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}, nil
+}
+
+// HandleXmitDataReq implements interop.NetworkServer.
+func (p *Peer) HandleXmitDataReq(ctx context.Context, req *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+	select {
+	case <-p.ctx.Done():
+		return nil, p.ctx.Err()
+	case p.XmitDataReq <- req:
+	}
+	return &interop.XmitDataAns{
+		MessageHeader: req.AnswerHeader(interop.MessageTypeXmitDataAns),
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}, nil
+}
+
+// SendPRStartReq sends the PRStartReq to the network at url on behalf of the peer.
+func (p *Peer) SendPRStartReq(ctx context.Context, url string, req *interop.PRStartReq) (*interop.PRStartAns, error) {
+	req.SenderID = p.NetID.String()
+	return p.client.SendPRStartReq(ctx, url, req)
+}
+
+// SendXmitDataReq sends the XmitDataReq to the network at url on behalf of the peer.
+func (p *Peer) SendXmitDataReq(ctx context.Context, url string, req *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+	req.SenderID = p.NetID.String()
+	return p.client.SendXmitDataReq(ctx, url, req)
+}
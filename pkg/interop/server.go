@@ -0,0 +1,246 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interop
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/web"
+)
+
+// maxRequestSize is the maximum size of a request body in bytes.
+const maxRequestSize = 1 << 16
+
+// NetworkServer handles passive roaming messages from peer networks.
+type NetworkServer interface {
+	// HandlePRStartReq handles an uplink forwarded by a serving network.
+	HandlePRStartReq(context.Context, *PRStartReq) (*PRStartAns, error)
+	// HandleXmitDataReq handles a downlink transmitted by a home network.
+	HandleXmitDataReq(context.Context, *XmitDataReq) (*XmitDataAns, error)
+}
+
+// JoinServer handles join and key requests from Network Servers and Application Servers.
+type JoinServer interface {
+	// HandleJoinReq handles a join-request forwarded by a Network Server.
+	HandleJoinReq(context.Context, *JoinReq) (*JoinAns, error)
+	// HandleAppSKeyReq handles an AppSKey request of an Application Server.
+	HandleAppSKeyReq(context.Context, *AppSKeyReq) (*AppSKeyAns, error)
+	// HandleHomeNSReq handles a home network request of a Network Server.
+	HandleHomeNSReq(context.Context, *HomeNSReq) (*HomeNSAns, error)
+}
+
+// SenderAuthorizer returns whether the sender with the given ID is allowed to send messages.
+type SenderAuthorizer func(ctx context.Context, senderID string) bool
+
+type clientCertificateKeyType struct{}
+
+var clientCertificateKey clientCertificateKeyType
+
+// ClientCertificateMatches returns whether the verified TLS client certificate of the request in ctx is issued to id.
+// The common name and the DNS names of the certificate are matched case-insensitively.
+func ClientCertificateMatches(ctx context.Context, id string) bool {
+	cert, ok := ctx.Value(clientCertificateKey).(*x509.Certificate)
+	if !ok || id == "" {
+		return false
+	}
+	if strings.EqualFold(cert.Subject.CommonName, id) {
+		return true
+	}
+	for _, name := range cert.DNSNames {
+		if strings.EqualFold(name, id) {
+			return true
+		}
+	}
+	return false
+}
+
+type handler struct {
+	ansType MessageType
+	handle  func(ctx context.Context, b []byte) (interface{}, error)
+}
+
+// Server is a LoRaWAN Backend Interfaces HTTP server.
+type Server struct {
+	ctx       context.Context
+	path      string
+	handlers  map[MessageType]handler
+	authorize SenderAuthorizer
+}
+
+func newServer(ctx context.Context, path string, authorize SenderAuthorizer, handlers map[MessageType]handler) *Server {
+	return &Server{
+		ctx:       log.NewContextWithField(ctx, "namespace", "interop"),
+		path:      path,
+		handlers:  handlers,
+		authorize: authorize,
+	}
+}
+
+// NewServer returns a new Server, which dispatches passive roaming requests to ns.
+// Requests from senders that are not authorized are answered with ResultUnknownSender.
+func NewServer(ctx context.Context, ns NetworkServer, authorize SenderAuthorizer) *Server {
+	return newServer(ctx, "/interop", authorize, map[MessageType]handler{
+		MessageTypePRStartReq: {
+			ansType: MessageTypePRStartAns,
+			handle: func(ctx context.Context, b []byte) (interface{}, error) {
+				req := &PRStartReq{}
+				if err := json.Unmarshal(b, req); err != nil {
+					return nil, errDecode.WithCause(err)
+				}
+				return ns.HandlePRStartReq(ctx, req)
+			},
+		},
+		MessageTypeXmitDataReq: {
+			ansType: MessageTypeXmitDataAns,
+			handle: func(ctx context.Context, b []byte) (interface{}, error) {
+				req := &XmitDataReq{}
+				if err := json.Unmarshal(b, req); err != nil {
+					return nil, errDecode.WithCause(err)
+				}
+				return ns.HandleXmitDataReq(ctx, req)
+			},
+		},
+	})
+}
+
+// NewJSServer returns a new Server, which dispatches join and key requests to js.
+// Requests from senders that are not authorized are answered with ResultUnknownSender.
+func NewJSServer(ctx context.Context, js JoinServer, authorize SenderAuthorizer) *Server {
+	return newServer(ctx, "/interop/js", authorize, map[MessageType]handler{
+		MessageTypeJoinReq: {
+			ansType: MessageTypeJoinAns,
+			handle: func(ctx context.Context, b []byte) (interface{}, error) {
+				req := &JoinReq{}
+				if err := json.Unmarshal(b, req); err != nil {
+					return nil, errDecode.WithCause(err)
+				}
+				return js.HandleJoinReq(ctx, req)
+			},
+		},
+		MessageTypeAppSKeyReq: {
+			ansType: MessageTypeAppSKeyAns,
+			handle: func(ctx context.Context, b []byte) (interface{}, error) {
+				req := &AppSKeyReq{}
+				if err := json.Unmarshal(b, req); err != nil {
+					return nil, errDecode.WithCause(err)
+				}
+				return js.HandleAppSKeyReq(ctx, req)
+			},
+		},
+		MessageTypeHomeNSReq: {
+			ansType: MessageTypeHomeNSAns,
+			handle: func(ctx context.Context, b []byte) (interface{}, error) {
+				req := &HomeNSReq{}
+				if err := json.Unmarshal(b, req); err != nil {
+					return nil, errDecode.WithCause(err)
+				}
+				return js.HandleHomeNSReq(ctx, req)
+			},
+		},
+	})
+}
+
+// RegisterRoutes registers the server at its path of the web server.
+// Passive roaming requests are served at /interop, join and key requests at /interop/js.
+func (s *Server) RegisterRoutes(server *web.Server) {
+	server.RootGroup(s.path).POST("", echo.WrapHandler(s))
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.FromContext(s.ctx)
+
+	b, err := ioutil.ReadAll(io.LimitReader(r.Body, maxRequestSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var header MessageHeader
+	if err := json.Unmarshal(b, &header); err != nil {
+		http.Error(w, errDecode.WithCause(err).Error(), http.StatusBadRequest)
+		return
+	}
+	logger = logger.WithFields(log.Fields(
+		"message_type", header.MessageType,
+		"sender_id", header.SenderID,
+		"transaction_id", header.TransactionID,
+	))
+	ctx = log.NewContext(ctx, logger)
+	if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 && len(r.TLS.VerifiedChains[0]) > 0 {
+		ctx = context.WithValue(ctx, clientCertificateKey, r.TLS.VerifiedChains[0][0])
+	}
+
+	h, ok := s.handlers[header.MessageType]
+	if !ok {
+		http.Error(w, errMessageType.WithAttributes("type", header.MessageType).Error(), http.StatusBadRequest)
+		return
+	}
+
+	var ans interface{}
+	switch {
+	case header.ProtocolVersion != ProtocolVersion:
+		err = errProtocol.WithAttributes("version", header.ProtocolVersion)
+	case s.authorize != nil && !s.authorize(ctx, header.SenderID):
+		err = errUnknownSender.WithAttributes("sender_id", header.SenderID)
+	default:
+		ans, err = h.handle(ctx, b)
+	}
+	if err != nil {
+		logger.WithError(err).Debug("Failed to handle request")
+		ans = errorAnswer(header.AnswerHeader(h.ansType), err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(ans); err != nil {
+		logger.WithError(err).Warn("Failed to write answer")
+	}
+}
+
+// errorAnswer returns the answer with header h, that reports err.
+func errorAnswer(h MessageHeader, err error) interface{} {
+	res := Result{
+		ResultCode:  ResultOther,
+		Description: err.Error(),
+	}
+	switch {
+	case errors.Resemble(err, errDecode), errors.Resemble(err, errProtocol):
+		res.ResultCode = ResultMalformedRequest
+	case errors.Resemble(err, errUnknownSender):
+		res.ResultCode = ResultUnknownSender
+	}
+	switch h.MessageType {
+	case MessageTypePRStartAns:
+		return &PRStartAns{MessageHeader: h, Result: res}
+	case MessageTypeJoinAns:
+		return &JoinAns{MessageHeader: h, Result: res}
+	case MessageTypeAppSKeyAns:
+		return &AppSKeyAns{MessageHeader: h, Result: res}
+	case MessageTypeHomeNSAns:
+		return &HomeNSAns{MessageHeader: h, Result: res}
+	default:
+		return &XmitDataAns{MessageHeader: h, Result: res}
+	}
+}
@@ -0,0 +1,280 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interop_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/pkg/interop"
+	"go.thethings.network/lorawan-stack/pkg/interop/mock"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+var timeout = (1 << 5) * test.Delay
+
+func TestClientServer(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	home := mock.NewPeer(ctx, types.NetID{0x00, 0x00, 0x13})
+	serving := mock.NewPeer(ctx, types.NetID{0x00, 0x00, 0x42})
+
+	dataRate := uint32(5)
+	recvTime := time.Unix(42, 0).UTC()
+
+	ansCh := make(chan *PRStartAns, 1)
+	errCh := make(chan error, 1)
+	go func() {
+		ans, err := serving.SendPRStartReq(ctx, home.URL(), &PRStartReq{
+			MessageHeader: MessageHeader{
+				ReceiverID:    "000013",
+				TransactionID: 42,
+				SenderToken:   Buffer{0x42},
+			},
+			PHYPayload: Buffer{0x40, 0x01, 0x02, 0x03, 0x04},
+			ULMetaData: ULMetaData{
+				DataRate: &dataRate,
+				ULFreq:   868.1,
+				RFRegion: "EU868",
+				RecvTime: recvTime,
+				GWCnt:    1,
+				GWInfo: []GWInfo{
+					{
+						ID:        "test-gateway",
+						ULToken:   Buffer{0x01},
+						DLAllowed: true,
+					},
+				},
+			},
+		})
+		ansCh <- ans
+		errCh <- err
+	}()
+
+	select {
+	case req := <-home.PRStartReq:
+		a.So(req.ProtocolVersion, should.Equal, ProtocolVersion)
+		a.So(req.MessageType, should.Equal, MessageTypePRStartReq)
+		a.So(req.SenderID, should.Equal, "000042")
+		a.So(req.ReceiverID, should.Equal, "000013")
+		a.So(req.PHYPayload, should.Resemble, Buffer{0x40, 0x01, 0x02, 0x03, 0x04})
+		a.So(req.ULMetaData, should.Resemble, ULMetaData{
+			DataRate: &dataRate,
+			ULFreq:   868.1,
+			RFRegion: "EU868",
+			RecvTime: recvTime,
+			GWCnt:    1,
+			GWInfo: []GWInfo{
+				{
+					ID:        "test-gateway",
+					ULToken:   Buffer{0x01},
+					DLAllowed: true,
+				},
+			},
+		})
+	case <-time.After(timeout):
+		t.Fatal("Timed out waiting for PRStartReq")
+	}
+
+	ans, err := <-ansCh, <-errCh
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(ans, should.Resemble, &PRStartAns{
+		MessageHeader: MessageHeader{
+			ProtocolVersion: ProtocolVersion,
+			SenderID:        "000013",
+			ReceiverID:      "000042",
+			TransactionID:   42,
+			MessageType:     MessageTypePRStartAns,
+			ReceiverToken:   Buffer{0x42},
+		},
+		Result: Result{
+			ResultCode: ResultSuccess,
+		},
+	})
+}
+
+func TestServerErrors(t *testing.T) {
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	peer := mock.NewPeer(ctx, types.NetID{0x00, 0x00, 0x13})
+	srv := httptest.NewServer(NewServer(ctx, peer, func(_ context.Context, senderID string) bool {
+		return senderID == "000013"
+	}))
+	defer srv.Close()
+
+	client := NewClient(nil)
+
+	t.Run("UnknownSender", func(t *testing.T) {
+		a := assertions.New(t)
+		ans, err := client.SendXmitDataReq(ctx, srv.URL, &XmitDataReq{
+			MessageHeader: MessageHeader{
+				SenderID: "000042",
+			},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(ans.ReceiverID, should.Equal, "000042")
+		a.So(ans.Result.ResultCode, should.Equal, ResultUnknownSender)
+	})
+
+	t.Run("ProtocolVersion", func(t *testing.T) {
+		a := assertions.New(t)
+		ans, err := client.SendPRStartReq(ctx, srv.URL, &PRStartReq{
+			MessageHeader: MessageHeader{
+				ProtocolVersion: "0.1",
+				SenderID:        "000013",
+			},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(ans.Result.ResultCode, should.Equal, ResultMalformedRequest)
+	})
+}
+
+type mockJoinServer struct{}
+
+func (mockJoinServer) HandleJoinReq(_ context.Context, req *JoinReq) (*JoinAns, error) {
+	return &JoinAns{MessageHeader: req.AnswerHeader(MessageTypeJoinAns), Result: Result{ResultCode: ResultSuccess}}, nil
+}
+
+func (mockJoinServer) HandleAppSKeyReq(_ context.Context, req *AppSKeyReq) (*AppSKeyAns, error) {
+	return &AppSKeyAns{MessageHeader: req.AnswerHeader(MessageTypeAppSKeyAns), Result: Result{ResultCode: ResultSuccess}}, nil
+}
+
+func (mockJoinServer) HandleHomeNSReq(_ context.Context, req *HomeNSReq) (*HomeNSAns, error) {
+	return &HomeNSAns{MessageHeader: req.AnswerHeader(MessageTypeHomeNSAns), Result: Result{ResultCode: ResultSuccess}, HNetID: "000013"}, nil
+}
+
+func mustCertificate(t *testing.T, commonName string, isCA bool, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if parent == nil {
+		parent, parentKey = tmpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Failed to parse certificate: %v", err)
+	}
+	return cert, key
+}
+
+func TestJSServerClientCertificate(t *testing.T) {
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	caCert, caKey := mustCertificate(t, "Test CA", true, nil, nil)
+	clientCert, clientKey := mustCertificate(t, "000042", false, caCert, caKey)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(caCert)
+
+	srv := httptest.NewUnstartedServer(NewJSServer(ctx, mockJoinServer{}, ClientCertificateMatches))
+	srv.TLS = &tls.Config{
+		ClientCAs:  clientCAs,
+		ClientAuth: tls.VerifyClientCertIfGiven,
+	}
+	srv.StartTLS()
+	defer srv.Close()
+
+	withCert := srv.Client()
+	withCert.Transport.(*http.Transport).TLSClientConfig.Certificates = []tls.Certificate{
+		{
+			Certificate: [][]byte{clientCert.Raw},
+			PrivateKey:  clientKey,
+		},
+	}
+
+	for _, tc := range []struct {
+		Name       string
+		Client     *http.Client
+		SenderID   string
+		ResultCode ResultCode
+	}{
+		{
+			Name:       "Matching certificate",
+			Client:     withCert,
+			SenderID:   "000042",
+			ResultCode: ResultSuccess,
+		},
+		{
+			Name:       "Other sender",
+			Client:     withCert,
+			SenderID:   "000013",
+			ResultCode: ResultUnknownSender,
+		},
+		{
+			Name: "No certificate",
+			Client: &http.Client{
+				Transport: &http.Transport{
+					TLSClientConfig: &tls.Config{RootCAs: srv.Client().Transport.(*http.Transport).TLSClientConfig.RootCAs},
+				},
+			},
+			SenderID:   "000042",
+			ResultCode: ResultUnknownSender,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ans, err := NewClient(tc.Client).SendHomeNSReq(ctx, srv.URL, &HomeNSReq{
+				MessageHeader: MessageHeader{
+					SenderID:   tc.SenderID,
+					ReceiverID: "42FFFFFFFFFFFFFF",
+				},
+				DevEUI: Buffer{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(ans.MessageType, should.Equal, MessageTypeHomeNSAns)
+			a.So(ans.Result.ResultCode, should.Equal, tc.ResultCode)
+		})
+	}
+}
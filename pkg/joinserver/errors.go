@@ -32,8 +32,12 @@ var (
 	errGenerateSessionKeyID      = errors.Define("generate_session_key_id", "failed to generate session key ID")
 	errDeviceNotFound            = errors.DefineNotFound("device_not_found", "device not found")
 	errInvalidIdentifiers        = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errInteropField              = errors.DefineInvalidArgument("interop_field", "invalid LoRaWAN Backend Interfaces field `{field}`")
+	errInteropNetID              = errors.DefineInvalidArgument("interop_net_id", "invalid LoRaWAN Backend Interfaces NetID `{value}`")
 	errJoinNonceTooHigh          = errors.Define("join_nonce_too_high", "JoinNonce is too high")
 	errMICMismatch               = errors.DefineInvalidArgument("mic_mismatch", "MIC mismatch")
+	errNetIDMismatch             = errors.DefinePermissionDenied("net_id_mismatch", "NetID `{net_id}` does not match the NetID of the device")
+	errApplicationServerMismatch = errors.DefinePermissionDenied("application_server_mismatch", "AS-ID `{as_id}` does not match the AS-ID of the device")
 	errNoAppKey                  = errors.DefineCorruption("no_app_key", "no AppKey specified")
 	errNoAppSKey                 = errors.DefineCorruption("no_app_s_key", "no AppSKey specified")
 	errNoDevAddr                 = errors.DefineCorruption("no_dev_addr", "no DevAddr specified")
@@ -41,6 +45,8 @@ var (
 	errNoFNwkSIntKey             = errors.DefineCorruption("no_f_nwk_s_int_key", "no FNwkSIntKey specified")
 	errNoJoinEUI                 = errors.DefineInvalidArgument("no_join_eui", "no JoinEUI specified")
 	errNoJoinRequest             = errors.DefineInvalidArgument("no_join_request", "no JoinRequest specified")
+	errNoKEKLabel                = errors.DefineFailedPrecondition("no_kek_label", "no KEK label configured for `{sender_id}`")
+	errNoNetID                   = errors.DefineNotFound("no_net_id", "no NetID specified")
 	errNoNwkKey                  = errors.DefineCorruption("no_nwk_key", "no NwkKey specified")
 	errNoNwkSEncKey              = errors.DefineCorruption("no_nwk_s_enc_key", "no NwkSEncKey specified")
 	errNoPayload                 = errors.DefineInvalidArgument("no_payload", "no message payload specified")
@@ -54,6 +60,8 @@ var (
 	errRegistryOperation         = errors.DefineInternal("registry_operation", "registry operation failed")
 	errReuseDevNonce             = errors.DefineInvalidArgument("reuse_dev_nonce", "DevNonce has already been used")
 	errUnknownAppEUI             = errors.Define("unknown_app_eui", "AppEUI specified is not known")
+	errUnknownDevNonceMode       = errors.DefineInvalidArgument("unknown_dev_nonce_mode", "unknown DevNonce mode `{mode}`")
 	errUnsupportedLoRaWANVersion = errors.DefineInvalidArgument("lorawan_version", "unsupported LoRaWAN version: {version}", "version")
+	errWrapKey                   = errors.Define("wrap_key", "failed to wrap key")
 	errWrongPayloadType          = errors.DefineInvalidArgument("payload_type", "wrong payload type: {type}")
 )
@@ -31,8 +31,12 @@ func (srv asJsServer) GetAppSKey(ctx context.Context, req *ttnpb.SessionKeyReque
 	if err := clusterauth.Authorized(ctx); err != nil {
 		return nil, err
 	}
+	return srv.JS.getAppSKey(ctx, req)
+}
 
-	ks, err := srv.JS.keys.GetByID(ctx, req.DevEUI, req.SessionKeyID,
+// getAppSKey returns the AppSKey associated with session keys identified by req.
+func (js *JoinServer) getAppSKey(ctx context.Context, req *ttnpb.SessionKeyRequest) (*ttnpb.AppSKeyResponse, error) {
+	ks, err := js.keys.GetByID(ctx, req.DevEUI, req.SessionKeyID,
 		[]string{
 			"app_s_key",
 		},
@@ -129,6 +129,11 @@ func (srv jsEndDeviceRegistryServer) Set(ctx context.Context, req *ttnpb.SetEndD
 			return nil, err
 		}
 	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "dev_nonce_policy.mode") {
+		if err := req.Device.DevNoncePolicy.ValidateContext(ctx); err != nil {
+			return nil, err
+		}
+	}
 	// TODO: Validate field mask (https://github.com/TheThingsNetwork/lorawan-stack/issues/39)
 	return srv.JS.devices.SetByEUI(ctx, *req.Device.JoinEUI, *req.Device.DevEUI, req.FieldMask.Paths, func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 		if dev != nil && !dev.ApplicationIdentifiers.Equal(req.Device.ApplicationIdentifiers) {
@@ -143,7 +148,7 @@ func (srv jsEndDeviceRegistryServer) Provision(req *ttnpb.ProvisionEndDevicesReq
 		return err
 	}
 
-	provisioner := provisioning.Get(req.ProvisionerID)
+	provisioner := srv.JS.provisioner(req.ProvisionerID)
 	if provisioner == nil {
 		return errProvisionerNotFound.WithAttributes("id", req.ProvisionerID)
 	}
@@ -251,6 +256,11 @@ func (srv jsEndDeviceRegistryServer) Provision(req *ttnpb.ProvisionEndDevicesReq
 		}
 		dev.ProvisionerID = req.ProvisionerID
 		dev.ProvisioningData = entry
+		if rkp, ok := provisioner.(provisioning.RootKeysProvisioner); ok {
+			if dev.RootKeys, err = rkp.RootKeys(entry); err != nil {
+				return err
+			}
+		}
 		if err := stream.Send(dev); err != nil {
 			return err
 		}
@@ -280,3 +290,34 @@ func (srv jsEndDeviceRegistryServer) Delete(ctx context.Context, ids *ttnpb.EndD
 	}
 	return ttnpb.Empty, err
 }
+
+// ClearDevNonces implements ttnpb.JsEndDeviceRegistryServer.
+func (srv jsEndDeviceRegistryServer) ClearDevNonces(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*pbtypes.Empty, error) {
+	if ids.JoinEUI == nil || ids.JoinEUI.IsZero() {
+		return nil, errNoJoinEUI
+	}
+	if ids.DevEUI == nil || ids.DevEUI.IsZero() {
+		return nil, errNoDevEUI
+	}
+	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	_, err := srv.JS.devices.SetByEUI(ctx, *ids.JoinEUI, *ids.DevEUI, []string{
+		"last_dev_nonce",
+		"used_dev_nonces",
+	}, func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+		if dev == nil || !dev.ApplicationIdentifiers.Equal(ids.ApplicationIdentifiers) {
+			return nil, nil, errDeviceNotFound
+		}
+		dev.LastDevNonce = 0
+		dev.UsedDevNonces = nil
+		return dev, []string{
+			"last_dev_nonce",
+			"used_dev_nonces",
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
@@ -587,6 +587,42 @@ func TestDeviceRegistrySet(t *testing.T) {
 				return a.So(test.MustCounterFromContext(ctx, setByEUIFuncKey{}), should.Equal, 0)
 			},
 		},
+		{
+			Name: "Invalid DevNonce mode",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(ctx, deepcopy.Copy(registeredDevice.EndDeviceIdentifiers.ApplicationIdentifiers).(ttnpb.ApplicationIdentifiers)): ttnpb.RightsFrom(
+							ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+						),
+					},
+				})
+			},
+			DeviceRequest: &ttnpb.SetEndDeviceRequest{
+				Device: ttnpb.EndDevice{
+					EndDeviceIdentifiers: deepcopy.Copy(registeredDevice.EndDeviceIdentifiers).(ttnpb.EndDeviceIdentifiers),
+					DevNoncePolicy: &ttnpb.DevNoncePolicy{
+						Mode: ttnpb.DevNonceMode(42),
+					},
+				},
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{"dev_nonce_policy.mode"},
+				},
+			},
+			SetByEUIFunc: func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				defer test.MustIncrementContextCounter(ctx, setByEUIFuncKey{}, 1)
+				dev, _, err := cb(deepcopy.Copy(registeredDevice).(*ttnpb.EndDevice))
+				return dev, err
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				a := assertions.New(t)
+				return a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			},
+			ContextAssertion: func(ctx context.Context) bool {
+				a := assertions.New(test.MustTFromContext(ctx))
+				return a.So(test.MustCounterFromContext(ctx, setByEUIFuncKey{}), should.Equal, 0)
+			},
+		},
 		{
 			Name: "Create",
 			ContextFunc: func(ctx context.Context) context.Context {
@@ -1072,6 +1108,182 @@ func TestDeviceRegistryDelete(t *testing.T) {
 	}
 }
 
+func TestDeviceRegistryClearDevNonces(t *testing.T) {
+	registeredDevice := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationID: registeredApplicationID,
+			},
+			DeviceID: registeredDeviceID,
+			JoinEUI:  registeredJoinEUI,
+			DevEUI:   registeredDevEUI,
+		},
+		LastDevNonce:  0x2442,
+		LastJoinNonce: 0x42fffd,
+		UsedDevNonces: []uint32{23, 41, 42},
+	}
+	for _, tc := range []struct {
+		Name             string
+		ContextFunc      func(context.Context) context.Context
+		SetByEUIFunc     func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error)
+		Device           *ttnpb.EndDeviceIdentifiers
+		ErrorAssertion   func(*testing.T, error) bool
+		ContextAssertion func(context.Context) bool
+	}{
+		{
+			Name: "Permission denied",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(ctx, ttnpb.ApplicationIdentifiers{
+							ApplicationID: registeredApplicationID,
+						}): ttnpb.RightsFrom(
+							ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+						),
+					},
+				})
+			},
+			Device: deepcopy.Copy(&registeredDevice.EndDeviceIdentifiers).(*ttnpb.EndDeviceIdentifiers),
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				a := assertions.New(t)
+				return a.So(errors.IsPermissionDenied(err), should.BeTrue)
+			},
+			ContextAssertion: func(ctx context.Context) bool {
+				a := assertions.New(test.MustTFromContext(ctx))
+				return a.So(test.MustCounterFromContext(ctx, setByEUIFuncKey{}), should.Equal, 0)
+			},
+		},
+		{
+			Name: "No JoinEUI",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(ctx, deepcopy.Copy(registeredDevice.EndDeviceIdentifiers.ApplicationIdentifiers).(ttnpb.ApplicationIdentifiers)): ttnpb.RightsFrom(
+							ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+						),
+					},
+				})
+			},
+			Device: &ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+					ApplicationID: registeredApplicationID,
+				},
+				DeviceID: registeredDeviceID,
+				DevEUI:   registeredDevEUI,
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				a := assertions.New(t)
+				return a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			},
+			ContextAssertion: func(ctx context.Context) bool {
+				a := assertions.New(test.MustTFromContext(ctx))
+				return a.So(test.MustCounterFromContext(ctx, setByEUIFuncKey{}), should.Equal, 0)
+			},
+		},
+		{
+			Name: "Invalid application ID",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(ctx, ttnpb.ApplicationIdentifiers{ApplicationID: "other-app"}): ttnpb.RightsFrom(
+							ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+						),
+					},
+				})
+			},
+			Device: &ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+					ApplicationID: "other-app",
+				},
+				DeviceID: "other-device",
+				JoinEUI:  registeredJoinEUI,
+				DevEUI:   registeredDevEUI,
+			},
+			SetByEUIFunc: func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				defer test.MustIncrementContextCounter(ctx, setByEUIFuncKey{}, 1)
+				dev, _, err := cb(deepcopy.Copy(registeredDevice).(*ttnpb.EndDevice))
+				return dev, err
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				a := assertions.New(t)
+				return a.So(errors.IsNotFound(err), should.BeTrue)
+			},
+			ContextAssertion: func(ctx context.Context) bool {
+				a := assertions.New(test.MustTFromContext(ctx))
+				return a.So(test.MustCounterFromContext(ctx, setByEUIFuncKey{}), should.Equal, 1)
+			},
+		},
+		{
+			Name: "Clear",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(ctx, deepcopy.Copy(registeredDevice.EndDeviceIdentifiers.ApplicationIdentifiers).(ttnpb.ApplicationIdentifiers)): ttnpb.RightsFrom(
+							ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+						),
+					},
+				})
+			},
+			Device: deepcopy.Copy(&registeredDevice.EndDeviceIdentifiers).(*ttnpb.EndDeviceIdentifiers),
+			SetByEUIFunc: func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				a := assertions.New(test.MustTFromContext(ctx))
+				defer test.MustIncrementContextCounter(ctx, setByEUIFuncKey{}, 1)
+				a.So(joinEUI, should.Equal, *registeredJoinEUI)
+				a.So(devEUI, should.Equal, *registeredDevEUI)
+				a.So(paths, should.HaveSameElementsDeep, []string{
+					"last_dev_nonce",
+					"used_dev_nonces",
+				})
+				dev, sets, err := cb(deepcopy.Copy(registeredDevice).(*ttnpb.EndDevice))
+				if !a.So(err, should.BeNil) {
+					return nil, err
+				}
+				a.So(sets, should.HaveSameElementsDeep, []string{
+					"last_dev_nonce",
+					"used_dev_nonces",
+				})
+				a.So(dev.LastDevNonce, should.BeZeroValue)
+				a.So(dev.UsedDevNonces, should.BeEmpty)
+				a.So(dev.LastJoinNonce, should.Equal, registeredDevice.LastJoinNonce)
+				return dev, nil
+			},
+			ContextAssertion: func(ctx context.Context) bool {
+				a := assertions.New(test.MustTFromContext(ctx))
+				return a.So(test.MustCounterFromContext(ctx, setByEUIFuncKey{}), should.Equal, 1)
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := test.ContextWithCounter(tc.ContextFunc(test.ContextWithT(test.Context(), t)), setByEUIFuncKey{})
+			reg := &MockDeviceRegistry{
+				SetByEUIFunc: tc.SetByEUIFunc,
+			}
+			js := test.Must(New(
+				component.MustNew(test.GetLogger(t), &component.Config{}),
+				&Config{
+					Devices: reg,
+				},
+			)).(*JoinServer)
+			js.KeyVault = keyVault
+			test.Must(nil, js.Start())
+			defer js.Close()
+			srv := &JsDeviceServer{
+				JS: js,
+			}
+			res, err := srv.ClearDevNonces(ctx, tc.Device)
+			a.So(tc.ContextAssertion(ctx), should.BeTrue)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(t, err), should.BeTrue)
+				a.So(res, should.BeNil)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(res, should.Resemble, ttnpb.Empty)
+		})
+	}
+}
+
 func TestDeviceRegistryProvision(t *testing.T) {
 	for _, tc := range []struct {
 		Name              string
@@ -48,12 +48,63 @@ func keyToBytes(k types.AES128Key) []byte {
 }
 
 // HandleJoin is called by the Network Server to join a device.
-func (srv nsJsServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (res *ttnpb.JoinResponse, err error) {
+func (srv nsJsServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error) {
 	// TODO: Authorize using client TLS and application rights (https://github.com/TheThingsNetwork/lorawan-stack/issues/4)
 	if err := clusterauth.Authorized(ctx); err != nil {
 		return nil, err
 	}
+	return srv.JS.handleJoin(ctx, req, nil)
+}
+
+// devNonceMode returns the DevNonce mode that applies to dev joining with MAC version ver.
+func devNonceMode(dev *ttnpb.EndDevice, ver ttnpb.MACVersion) ttnpb.DevNonceMode {
+	if mode := dev.DevNoncePolicy.GetMode(); mode != ttnpb.DevNonceMode_DEV_NONCE_DEFAULT {
+		return mode
+	}
+	switch ver {
+	case ttnpb.MAC_V1_1:
+		return ttnpb.DevNonceMode_DEV_NONCE_MONOTONIC
+	case ttnpb.MAC_V1_0, ttnpb.MAC_V1_0_1, ttnpb.MAC_V1_0_2:
+		return ttnpb.DevNonceMode_DEV_NONCE_USED_LIST
+	default:
+		return ttnpb.DevNonceMode_DEV_NONCE_DEFAULT
+	}
+}
+
+// checkDevNonce checks whether dn is not replayed by dev using DevNonce mode mode and MAC version ver.
+func checkDevNonce(dev *ttnpb.EndDevice, mode ttnpb.DevNonceMode, ver ttnpb.MACVersion, dn uint32) error {
+	switch mode {
+	case ttnpb.DevNonceMode_DEV_NONCE_MONOTONIC:
+		if (dn != 0 || dev.LastDevNonce != 0 || dev.LastJoinNonce != 0) && !dev.ResetsJoinNonces && dn <= dev.LastDevNonce {
+			return errDevNonceTooSmall.WithAttributes(
+				"dev_nonce", dn,
+				"last_dev_nonce", dev.LastDevNonce,
+				"mode", mode.String(),
+				"mac_version", ver.String(),
+			)
+		}
+	case ttnpb.DevNonceMode_DEV_NONCE_USED_LIST:
+		for _, used := range dev.UsedDevNonces {
+			if used == dn {
+				return errReuseDevNonce.WithAttributes(
+					"dev_nonce", dn,
+					"mode", mode.String(),
+					"mac_version", ver.String(),
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// devNonceResetAllowed returns whether the DevNonce policy of dev allows a DevNonce reset at time now.
+func devNonceResetAllowed(dev *ttnpb.EndDevice, now time.Time) bool {
+	return dev.DevNoncePolicy != nil && dev.DevNoncePolicy.ResetAllowedUntil != nil && now.Before(*dev.DevNoncePolicy.ResetAllowedUntil)
+}
 
+// handleJoin handles the join-request req of an authorized Network Server.
+// If authorizeDevice is not nil, the join-request is only accepted if authorizeDevice returns no error for the device.
+func (js *JoinServer) handleJoin(ctx context.Context, req *ttnpb.JoinRequest, authorizeDevice func(*ttnpb.EndDevice) error) (res *ttnpb.JoinResponse, err error) {
 	logger := log.FromContext(ctx)
 	defer func() {
 		if err != nil {
@@ -102,7 +153,7 @@ func (srv nsJsServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 	}
 
 	match := false
-	for _, p := range srv.JS.euiPrefixes {
+	for _, p := range js.euiPrefixes {
 		if p.Matches(pld.JoinEUI) {
 			match = true
 			break
@@ -117,10 +168,12 @@ func (srv nsJsServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 		return nil, errForwardJoinRequest
 	}
 
-	dev, err := srv.JS.devices.SetByEUI(ctx, pld.JoinEUI, pld.DevEUI,
+	dev, err := js.devices.SetByEUI(ctx, pld.JoinEUI, pld.DevEUI,
 		[]string{
+			"dev_nonce_policy",
 			"last_dev_nonce",
 			"last_join_nonce",
+			"net_id",
 			"resets_join_nonces",
 			"root_keys",
 			"used_dev_nonces",
@@ -128,32 +181,58 @@ func (srv nsJsServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 			"provisioning_data",
 		},
 		func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if dev == nil {
+				return nil, nil, errDeviceNotFound
+			}
+			if authorizeDevice != nil {
+				if err := authorizeDevice(dev); err != nil {
+					return nil, nil, err
+				}
+			}
+
 			paths := make([]string, 0, 3)
 
 			dn := uint32(binary.BigEndian.Uint16(pld.DevNonce[:]))
-			switch req.SelectedMACVersion {
-			case ttnpb.MAC_V1_1:
-				if (dn != 0 || dev.LastDevNonce != 0 || dev.LastJoinNonce != 0) && !dev.ResetsJoinNonces {
-					if dn <= dev.LastDevNonce {
-						return nil, nil, errDevNonceTooSmall
-					}
-					if dn == math.MaxUint32 {
-						return nil, nil, errDevNonceTooHigh
-					}
+			mode := devNonceMode(dev, req.SelectedMACVersion)
+			reset := false
+			if err := checkDevNonce(dev, mode, req.SelectedMACVersion, dn); err != nil {
+				if !devNonceResetAllowed(dev, time.Now()) {
+					registerRejectReplay(ctx, dev, err)
+					return nil, nil, err
+				}
+				// The DevNonce history is reset until the reset window expires; the JoinNonce is never reset.
+				logger.WithFields(log.Fields(
+					"join_eui", pld.JoinEUI,
+					"dev_eui", pld.DevEUI,
+					"dev_nonce", dn,
+				)).Info("Reset DevNonce history")
+				reset = true
+			}
+			switch mode {
+			case ttnpb.DevNonceMode_DEV_NONCE_MONOTONIC:
+				if dn == math.MaxUint32 {
+					return nil, nil, errDevNonceTooHigh
 				}
 				dev.LastDevNonce = dn
 				paths = append(paths, "last_dev_nonce")
-			case ttnpb.MAC_V1_0, ttnpb.MAC_V1_0_1, ttnpb.MAC_V1_0_2:
-				i := sort.Search(len(dev.UsedDevNonces), func(i int) bool { return dev.UsedDevNonces[i] >= dn })
-				if i < len(dev.UsedDevNonces) && dev.UsedDevNonces[i] == dn {
-					return nil, nil, errReuseDevNonce
+			case ttnpb.DevNonceMode_DEV_NONCE_USED_LIST:
+				if reset {
+					dev.UsedDevNonces = nil
+				}
+				if dev.DevNoncePolicy.GetMode() == ttnpb.DevNonceMode_DEV_NONCE_USED_LIST {
+					dev.UsedDevNonces = append(dev.UsedDevNonces, dn)
+					if w := int(dev.DevNoncePolicy.UsedListWindow); w > 0 && len(dev.UsedDevNonces) > w {
+						dev.UsedDevNonces = dev.UsedDevNonces[len(dev.UsedDevNonces)-w:]
+					}
+				} else {
+					i := sort.Search(len(dev.UsedDevNonces), func(i int) bool { return dev.UsedDevNonces[i] >= dn })
+					dev.UsedDevNonces = append(dev.UsedDevNonces, 0)
+					copy(dev.UsedDevNonces[i+1:], dev.UsedDevNonces[i:])
+					dev.UsedDevNonces[i] = dn
 				}
-				dev.UsedDevNonces = append(dev.UsedDevNonces, 0)
-				copy(dev.UsedDevNonces[i+1:], dev.UsedDevNonces[i:])
-				dev.UsedDevNonces[i] = dn
 				paths = append(paths, "used_dev_nonces")
 			default:
-				panic("This statement is unreachable. Fix version check.")
+				return nil, nil, errUnknownDevNonceMode.WithAttributes("mode", mode.String())
 			}
 
 			var b []byte
@@ -193,30 +272,30 @@ func (srv nsJsServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 				return nil, nil, errEncodePayload.WithCause(err)
 			}
 
-			srv.JS.entropyMu.Lock()
-			skID, err := ulid.New(ulid.Timestamp(time.Now()), srv.JS.entropy)
-			srv.JS.entropyMu.Unlock()
+			js.entropyMu.Lock()
+			skID, err := ulid.New(ulid.Timestamp(time.Now()), js.entropy)
+			js.entropyMu.Unlock()
 			if err != nil {
 				return nil, nil, errGenerateSessionKeyID
 			}
 
-			cs := srv.JS.GetPeer(ctx, ttnpb.PeerInfo_CRYPTO_SERVER, dev.EndDeviceIdentifiers)
+			cs := js.GetPeer(ctx, ttnpb.PeerInfo_CRYPTO_SERVER, dev.EndDeviceIdentifiers)
 
 			var networkCryptoService cryptoservices.Network
 			if req.SelectedMACVersion.Compare(ttnpb.MAC_V1_1) >= 0 && dev.RootKeys != nil && dev.RootKeys.NwkKey != nil {
 				// LoRaWAN 1.1 and higher use a NwkKey.
-				nwkKey, err := cryptoutil.UnwrapAES128Key(*dev.RootKeys.NwkKey, srv.JS.KeyVault)
+				nwkKey, err := cryptoutil.UnwrapAES128Key(*dev.RootKeys.NwkKey, js.KeyVault)
 				if err != nil {
 					return nil, nil, err
 				}
 				networkCryptoService = cryptoservices.NewMemory(&nwkKey, nil)
 			} else if cs != nil {
-				networkCryptoService = cryptoservices.NewNetworkRPCClient(cs.Conn(), srv.JS.KeyVault, srv.JS.WithClusterAuth())
+				networkCryptoService = cryptoservices.NewNetworkRPCClient(cs.Conn(), js.KeyVault, js.WithClusterAuth())
 			}
 
 			var applicationCryptoService cryptoservices.Application
 			if dev.RootKeys != nil && dev.RootKeys.AppKey != nil {
-				appKey, err := cryptoutil.UnwrapAES128Key(*dev.RootKeys.AppKey, srv.JS.KeyVault)
+				appKey, err := cryptoutil.UnwrapAES128Key(*dev.RootKeys.AppKey, js.KeyVault)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -226,7 +305,7 @@ func (srv nsJsServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 					networkCryptoService = cryptoservices.NewMemory(nil, &appKey)
 				}
 			} else if cs != nil {
-				applicationCryptoService = cryptoservices.NewApplicationRPCClient(cs.Conn(), srv.JS.KeyVault, srv.JS.WithClusterAuth())
+				applicationCryptoService = cryptoservices.NewApplicationRPCClient(cs.Conn(), js.KeyVault, js.WithClusterAuth())
 			}
 			if networkCryptoService == nil {
 				return nil, nil, errNoNwkKey
@@ -292,7 +371,7 @@ func (srv nsJsServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 				RawPayload:  append(b[:1], enc...),
 				SessionKeys: sessionKeys,
 			}
-			_, err = CreateKeys(ctx, srv.JS.keys, *dev.EndDeviceIdentifiers.DevEUI, &res.SessionKeys)
+			_, err = CreateKeys(ctx, js.keys, *dev.EndDeviceIdentifiers.DevEUI, &res.SessionKeys)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -55,6 +55,8 @@ func mustEncryptJoinAccept(key types.AES128Key, pld []byte) []byte {
 	return b
 }
 
+func timePtr(t time.Time) *time.Time { return &t }
+
 func TestHandleJoin(t *testing.T) {
 	a := assertions.New(t)
 
@@ -119,14 +121,17 @@ func TestHandleJoin(t *testing.T) {
 	a.So(err, should.NotBeNil)
 	a.So(res, should.BeNil)
 
+	resetAllowedUntil := time.Now().Add(time.Hour).UTC()
+
 	for _, tc := range []struct {
 		Name string
 
 		Device *ttnpb.EndDevice
 
-		NextLastDevNonce  uint32
-		NextLastJoinNonce uint32
-		NextUsedDevNonces []uint32
+		NextLastDevNonce   uint32
+		NextLastJoinNonce  uint32
+		NextUsedDevNonces  []uint32
+		NextDevNoncePolicy *ttnpb.DevNoncePolicy
 
 		JoinRequest  *ttnpb.JoinRequest
 		JoinResponse *ttnpb.JoinResponse
@@ -400,6 +405,235 @@ func TestHandleJoin(t *testing.T) {
 			JoinResponse: nil,
 			ValidError:   errors.IsInvalidArgument,
 		},
+		{
+			Name: "1.1.0/DevNonce reset allowed",
+			Device: &ttnpb.EndDevice{
+				LastDevNonce:  0x2443,
+				LastJoinNonce: 0x42fffd,
+				DevNoncePolicy: &ttnpb.DevNoncePolicy{
+					ResetAllowedUntil: timePtr(resetAllowedUntil),
+				},
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					DevEUI:  &types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+					JoinEUI: &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+				},
+				RootKeys: &ttnpb.RootKeys{
+					AppKey: &ttnpb.KeyEnvelope{
+						Key:      appKey[:],
+						KEKLabel: "",
+					},
+					NwkKey: &ttnpb.KeyEnvelope{
+						Key:      nwkKey[:],
+						KEKLabel: "",
+					},
+				},
+				LoRaWANVersion:       ttnpb.MAC_V1_1,
+				NetworkServerAddress: nsAddr,
+			},
+			NextLastDevNonce:  0x2442,
+			NextLastJoinNonce: 0x42fffe,
+			NextDevNoncePolicy: &ttnpb.DevNoncePolicy{
+				ResetAllowedUntil: timePtr(resetAllowedUntil),
+			},
+			JoinRequest: &ttnpb.JoinRequest{
+				SelectedMACVersion: ttnpb.MAC_V1_1,
+				RawPayload: []byte{
+					/* MHDR */
+					0x00,
+
+					/* MACPayload */
+					/** JoinEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42,
+					/** DevEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+					/** DevNonce **/
+					0x42, 0x24,
+
+					/* MIC */
+					0x6e, 0x54, 0x1b, 0x37,
+				},
+				DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+				NetID:   types.NetID{0x42, 0xff, 0xff},
+				DownlinkSettings: ttnpb.DLSettings{
+					OptNeg:      true,
+					Rx1DROffset: 0x7,
+					Rx2DR:       0xf,
+				},
+				RxDelay: 0x42,
+				CFList:  nil,
+			},
+			JoinResponse: &ttnpb.JoinResponse{
+				RawPayload: append([]byte{
+					/* MHDR */
+					0x20},
+					mustEncryptJoinAccept(nwkKey, []byte{
+						/* JoinNonce */
+						0xfe, 0xff, 0x42,
+						/* NetID */
+						0xff, 0xff, 0x42,
+						/* DevAddr */
+						0xff, 0xff, 0xff, 0x42,
+						/* DLSettings */
+						0xff,
+						/* RxDelay */
+						0x42,
+
+						/* MIC */
+						0xc8, 0xf7, 0x62, 0xf4,
+					})...),
+				SessionKeys: ttnpb.SessionKeys{
+					AppSKey: &ttnpb.KeyEnvelope{
+						Key: KeyToBytes(crypto.DeriveAppSKey(
+							appKey,
+							types.JoinNonce{0x42, 0xff, 0xfe},
+							types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+							types.DevNonce{0x24, 0x42})),
+						KEKLabel: "",
+					},
+					SNwkSIntKey: &ttnpb.KeyEnvelope{
+						Key: KeyToBytes(crypto.DeriveSNwkSIntKey(
+							nwkKey,
+							types.JoinNonce{0x42, 0xff, 0xfe},
+							types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+							types.DevNonce{0x24, 0x42})),
+						KEKLabel: "",
+					},
+					FNwkSIntKey: &ttnpb.KeyEnvelope{
+						Key: KeyToBytes(crypto.DeriveFNwkSIntKey(
+							nwkKey,
+							types.JoinNonce{0x42, 0xff, 0xfe},
+							types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+							types.DevNonce{0x24, 0x42})),
+						KEKLabel: "",
+					},
+					NwkSEncKey: &ttnpb.KeyEnvelope{
+						Key: KeyToBytes(crypto.DeriveNwkSEncKey(
+							nwkKey,
+							types.JoinNonce{0x42, 0xff, 0xfe},
+							types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+							types.DevNonce{0x24, 0x42})),
+						KEKLabel: "",
+					},
+				},
+				Lifetime: 0,
+			},
+			ValidError: nil,
+		},
+		{
+			Name: "1.1.0/DevNonce reset expired",
+			Device: &ttnpb.EndDevice{
+				LastDevNonce:  0x2443,
+				LastJoinNonce: 0x42fffd,
+				DevNoncePolicy: &ttnpb.DevNoncePolicy{
+					ResetAllowedUntil: timePtr(time.Now().Add(-time.Hour).UTC()),
+				},
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					DevEUI:  &types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+					JoinEUI: &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+				},
+				RootKeys: &ttnpb.RootKeys{
+					AppKey: &ttnpb.KeyEnvelope{
+						Key:      appKey[:],
+						KEKLabel: "",
+					},
+					NwkKey: &ttnpb.KeyEnvelope{
+						Key:      nwkKey[:],
+						KEKLabel: "",
+					},
+				},
+				LoRaWANVersion:       ttnpb.MAC_V1_1,
+				NetworkServerAddress: nsAddr,
+			},
+			NextLastDevNonce:  0x2443,
+			NextLastJoinNonce: 0x42fffd,
+			JoinRequest: &ttnpb.JoinRequest{
+				SelectedMACVersion: ttnpb.MAC_V1_1,
+				RawPayload: []byte{
+					/* MHDR */
+					0x00,
+
+					/* MACPayload */
+					/** JoinEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42,
+					/** DevEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+					/** DevNonce **/
+					0x42, 0x24,
+
+					/* MIC */
+					0x6e, 0x54, 0x1b, 0x37,
+				},
+				DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+				NetID:   types.NetID{0x42, 0xff, 0xff},
+				DownlinkSettings: ttnpb.DLSettings{
+					OptNeg:      true,
+					Rx1DROffset: 0x7,
+					Rx2DR:       0xf,
+				},
+				RxDelay: 0x42,
+				CFList:  nil,
+			},
+			JoinResponse: nil,
+			ValidError:   errors.IsInvalidArgument,
+		},
+		{
+			Name: "1.1.0/used list mode/repeated DevNonce",
+			Device: &ttnpb.EndDevice{
+				UsedDevNonces: []uint32{0x2442, 23},
+				LastJoinNonce: 0x42fffd,
+				DevNoncePolicy: &ttnpb.DevNoncePolicy{
+					Mode:           ttnpb.DevNonceMode_DEV_NONCE_USED_LIST,
+					UsedListWindow: 2,
+				},
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					DevEUI:  &types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+					JoinEUI: &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+				},
+				RootKeys: &ttnpb.RootKeys{
+					AppKey: &ttnpb.KeyEnvelope{
+						Key:      appKey[:],
+						KEKLabel: "",
+					},
+					NwkKey: &ttnpb.KeyEnvelope{
+						Key:      nwkKey[:],
+						KEKLabel: "",
+					},
+				},
+				LoRaWANVersion:       ttnpb.MAC_V1_1,
+				NetworkServerAddress: nsAddr,
+			},
+			NextLastJoinNonce: 0x42fffd,
+			NextUsedDevNonces: []uint32{0x2442, 23},
+			JoinRequest: &ttnpb.JoinRequest{
+				SelectedMACVersion: ttnpb.MAC_V1_1,
+				RawPayload: []byte{
+					/* MHDR */
+					0x00,
+
+					/* MACPayload */
+					/** JoinEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42,
+					/** DevEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+					/** DevNonce **/
+					0x42, 0x24,
+
+					/* MIC */
+					0x6e, 0x54, 0x1b, 0x37,
+				},
+				DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+				NetID:   types.NetID{0x42, 0xff, 0xff},
+				DownlinkSettings: ttnpb.DLSettings{
+					OptNeg:      true,
+					Rx1DROffset: 0x7,
+					Rx2DR:       0xf,
+				},
+				RxDelay: 0x42,
+				CFList:  nil,
+			},
+			JoinResponse: nil,
+			ValidError:   errors.IsInvalidArgument,
+		},
 		{
 			Name: "1.0.2/new device",
 			Device: &ttnpb.EndDevice{
@@ -795,6 +1029,58 @@ func TestHandleJoin(t *testing.T) {
 			JoinResponse: nil,
 			ValidError:   errors.IsInvalidArgument,
 		},
+		{
+			Name: "1.0.0/DevNonce reset expired",
+			Device: &ttnpb.EndDevice{
+				UsedDevNonces: []uint32{23, 41, 42, 52, 0x2442},
+				LastJoinNonce: 0x42fffe,
+				DevNoncePolicy: &ttnpb.DevNoncePolicy{
+					ResetAllowedUntil: timePtr(time.Now().Add(-time.Hour).UTC()),
+				},
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					DevEUI:  &types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+					JoinEUI: &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+				},
+				RootKeys: &ttnpb.RootKeys{
+					AppKey: &ttnpb.KeyEnvelope{
+						Key:      appKey[:],
+						KEKLabel: "",
+					},
+				},
+				LoRaWANVersion:       ttnpb.MAC_V1_0,
+				NetworkServerAddress: nsAddr,
+			},
+			NextLastJoinNonce: 0x42fffe,
+			NextUsedDevNonces: []uint32{23, 41, 42, 52, 0x2442},
+			JoinRequest: &ttnpb.JoinRequest{
+				SelectedMACVersion: ttnpb.MAC_V1_0,
+				RawPayload: []byte{
+					/* MHDR */
+					0x00,
+
+					/* MACPayload */
+					/** JoinEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42,
+					/** DevEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+					/** DevNonce **/
+					0x42, 0x24,
+
+					/* MIC */
+					0xed, 0x8b, 0xd2, 0x24,
+				},
+				NetID: types.NetID{0x42, 0xff, 0xff},
+				DownlinkSettings: ttnpb.DLSettings{
+					OptNeg:      true,
+					Rx1DROffset: 0x7,
+					Rx2DR:       0xf,
+				},
+				RxDelay: 0x42,
+				CFList:  nil,
+			},
+			JoinResponse: nil,
+			ValidError:   errors.IsInvalidArgument,
+		},
 		{
 			Name: "1.0.0/no payload",
 			Device: &ttnpb.EndDevice{
@@ -1095,6 +1381,7 @@ func TestHandleJoin(t *testing.T) {
 			a.So(ret.UpdatedAt, should.HappenAfter, pb.UpdatedAt)
 			pb.UpdatedAt = ret.UpdatedAt
 			pb.LastJoinNonce = tc.NextLastJoinNonce
+			pb.DevNoncePolicy = tc.NextDevNoncePolicy
 			if tc.JoinRequest.SelectedMACVersion.Compare(ttnpb.MAC_V1_1) < 0 {
 				pb.UsedDevNonces = tc.NextUsedDevNonces
 			} else {
@@ -1112,6 +1399,12 @@ func TestHandleJoin(t *testing.T) {
 			a.So(ret, should.HaveEmptyDiff, pb)
 
 			res, err = js.HandleJoin(authorizedCtx, deepcopy.Copy(tc.JoinRequest).(*ttnpb.JoinRequest))
+			if until := tc.NextDevNoncePolicy.GetResetAllowedUntil(); until != nil && time.Now().Before(*until) {
+				// The DevNonce history is reset again until the reset window expires.
+				a.So(err, should.BeNil)
+				a.So(res, should.NotBeNil)
+				return
+			}
 			a.So(err, should.BeError)
 			a.So(res, should.BeNil)
 		})
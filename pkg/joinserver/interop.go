@@ -0,0 +1,297 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver
+
+import (
+	"context"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/interop"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+// InteropConfig represents the LoRaWAN Backend Interfaces configuration of the Join Server.
+type InteropConfig struct {
+	// NetworkKEKLabels maps the NetIDs of Network Servers to the labels of the KEKs that wrap their session keys.
+	NetworkKEKLabels map[string]string `name:"network-kek-labels" description:"KEK labels to wrap network session keys by NetID"`
+	// ApplicationKEKLabels maps the AS-IDs of Application Servers to the labels of the KEKs that wrap their session keys.
+	ApplicationKEKLabels map[string]string `name:"application-kek-labels" description:"KEK labels to wrap application session keys by AS-ID"`
+}
+
+// Parse attempts to parse the configuration and returns the network KEK labels by NetID.
+func (c InteropConfig) Parse() (map[types.NetID]string, error) {
+	labels := make(map[types.NetID]string, len(c.NetworkKEKLabels))
+	for id, label := range c.NetworkKEKLabels {
+		var netID types.NetID
+		if err := netID.UnmarshalText([]byte(id)); err != nil {
+			return nil, errInteropNetID.WithAttributes("value", id).WithCause(err)
+		}
+		labels[netID] = label
+	}
+	return labels, nil
+}
+
+var interopMACVersions = map[string]ttnpb.MACVersion{
+	"1.0":   ttnpb.MAC_V1_0,
+	"1.0.0": ttnpb.MAC_V1_0,
+	"1.0.1": ttnpb.MAC_V1_0_1,
+	"1.0.2": ttnpb.MAC_V1_0_2,
+	"1.1":   ttnpb.MAC_V1_1,
+	"1.1.0": ttnpb.MAC_V1_1,
+}
+
+// authorizeInteropSender returns whether the TLS client certificate of the request in ctx is issued to senderID.
+// Network Servers are identified by their NetID, Application Servers by their AS-ID.
+func (js *JoinServer) authorizeInteropSender(ctx context.Context, senderID string) bool {
+	return interop.ClientCertificateMatches(ctx, senderID)
+}
+
+// interopKeyEnvelope returns the key in env, wrapped with the KEK identified by kekLabel.
+// Keys are never returned in the clear; kekLabel must not be empty.
+func (js *JoinServer) interopKeyEnvelope(env *ttnpb.KeyEnvelope, kekLabel string) (*interop.KeyEnvelope, error) {
+	if env == nil {
+		return nil, nil
+	}
+	if kekLabel == "" {
+		return nil, errNoKEKLabel
+	}
+	if env.KEKLabel == kekLabel {
+		return &interop.KeyEnvelope{
+			KEKLabel: env.KEKLabel,
+			AESKey:   env.Key,
+		}, nil
+	}
+	key, err := cryptoutil.UnwrapAES128Key(*env, js.KeyVault)
+	if err != nil {
+		return nil, errWrapKey.WithCause(err)
+	}
+	wrapped, err := cryptoutil.WrapAES128Key(key, kekLabel, js.KeyVault)
+	if err != nil {
+		return nil, errWrapKey.WithCause(err)
+	}
+	return &interop.KeyEnvelope{
+		KEKLabel: wrapped.KEKLabel,
+		AESKey:   wrapped.Key,
+	}, nil
+}
+
+// isNotFound returns whether err or any of its causes is a not found error.
+func isNotFound(err error) bool {
+	for _, err := range errors.Stack(err) {
+		if errors.IsNotFound(err) {
+			return true
+		}
+	}
+	return false
+}
+
+// joinRequestFromInterop returns the join-request of req, forwarded by the Network Server with NetID netID.
+func joinRequestFromInterop(netID types.NetID, req *interop.JoinReq) (*ttnpb.JoinRequest, error) {
+	macVersion, ok := interopMACVersions[req.MACVersion]
+	if !ok {
+		return nil, errUnsupportedLoRaWANVersion.WithAttributes("version", req.MACVersion)
+	}
+	var devAddr types.DevAddr
+	if err := devAddr.UnmarshalBinary(req.DevAddr); err != nil {
+		return nil, errInteropField.WithAttributes("field", "DevAddr").WithCause(err)
+	}
+	jsReq := &ttnpb.JoinRequest{
+		RawPayload:         req.PHYPayload,
+		DevAddr:            devAddr,
+		SelectedMACVersion: macVersion,
+		NetID:              netID,
+		RxDelay:            ttnpb.RxDelay(req.RxDelay),
+	}
+	if err := lorawan.UnmarshalDLSettings(req.DLSettings, &jsReq.DownlinkSettings); err != nil {
+		return nil, errInteropField.WithAttributes("field", "DLSettings").WithCause(err)
+	}
+	if len(req.CFList) > 0 {
+		jsReq.CFList = &ttnpb.CFList{}
+		if err := lorawan.UnmarshalCFList(req.CFList, jsReq.CFList); err != nil {
+			return nil, errInteropField.WithAttributes("field", "CFList").WithCause(err)
+		}
+	}
+	return jsReq, nil
+}
+
+// HandleJoinReq implements interop.JoinServer.
+// The AppSKey is not included in the answer; Application Servers retrieve it with an AppSKeyReq.
+func (js *JoinServer) HandleJoinReq(ctx context.Context, req *interop.JoinReq) (*interop.JoinAns, error) {
+	ans := &interop.JoinAns{
+		MessageHeader: req.AnswerHeader(interop.MessageTypeJoinAns),
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}
+
+	var netID types.NetID
+	if err := netID.UnmarshalText([]byte(req.SenderID)); err != nil {
+		ans.Result = interop.Result{ResultCode: interop.ResultUnknownSender, Description: err.Error()}
+		return ans, nil
+	}
+	kekLabel := js.interopNetworkKEKLabels[netID]
+	if kekLabel == "" {
+		ans.Result = interop.Result{ResultCode: interop.ResultOther, Description: errNoKEKLabel.WithAttributes("sender_id", req.SenderID).Error()}
+		return ans, nil
+	}
+	jsReq, err := joinRequestFromInterop(netID, req)
+	if err != nil {
+		ans.Result = interop.Result{ResultCode: interop.ResultMalformedRequest, Description: err.Error()}
+		return ans, nil
+	}
+
+	ctx = log.NewContextWithField(ctx, "net_id", netID)
+	res, err := js.handleJoin(ctx, jsReq, func(dev *ttnpb.EndDevice) error {
+		if dev.NetID == nil || !dev.NetID.Equal(netID) {
+			return errNetIDMismatch.WithAttributes("net_id", netID)
+		}
+		return nil
+	})
+	if err != nil {
+		ans.Result = interop.Result{ResultCode: interop.ResultJoinReqFailed, Description: err.Error()}
+		switch {
+		case errors.Resemble(err, errNetIDMismatch):
+			ans.Result.ResultCode = interop.ResultUnknownSender
+		case errors.Resemble(err, errMICMismatch):
+			ans.Result.ResultCode = interop.ResultMICFailed
+		case isNotFound(err):
+			ans.Result.ResultCode = interop.ResultUnknownDevEUI
+		}
+		return ans, nil
+	}
+
+	keys := []struct {
+		env *ttnpb.KeyEnvelope
+		dst **interop.KeyEnvelope
+	}{
+		{res.FNwkSIntKey, &ans.FNwkSIntKey},
+		{res.SNwkSIntKey, &ans.SNwkSIntKey},
+		{res.NwkSEncKey, &ans.NwkSEncKey},
+	}
+	if jsReq.SelectedMACVersion.Compare(ttnpb.MAC_V1_1) < 0 {
+		keys = keys[:1]
+		keys[0].dst = &ans.NwkSKey
+	}
+	for _, k := range keys {
+		if *k.dst, err = js.interopKeyEnvelope(k.env, kekLabel); err != nil {
+			return nil, err
+		}
+	}
+	ans.PHYPayload = res.RawPayload
+	ans.SessionKeyID = res.SessionKeyID
+	return ans, nil
+}
+
+// HandleAppSKeyReq implements interop.JoinServer.
+func (js *JoinServer) HandleAppSKeyReq(ctx context.Context, req *interop.AppSKeyReq) (*interop.AppSKeyAns, error) {
+	ans := &interop.AppSKeyAns{
+		MessageHeader: req.AnswerHeader(interop.MessageTypeAppSKeyAns),
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}
+
+	var joinEUI, devEUI types.EUI64
+	if err := joinEUI.UnmarshalText([]byte(req.ReceiverID)); err != nil {
+		ans.Result = interop.Result{ResultCode: interop.ResultUnknownReceiver, Description: err.Error()}
+		return ans, nil
+	}
+	if err := devEUI.UnmarshalBinary(req.DevEUI); err != nil {
+		ans.Result = interop.Result{ResultCode: interop.ResultMalformedRequest, Description: err.Error()}
+		return ans, nil
+	}
+	dev, err := js.devices.GetByEUI(ctx, joinEUI, devEUI, []string{
+		"application_server_id",
+		"application_server_kek_label",
+	})
+	if err != nil {
+		ans.Result = interop.Result{ResultCode: interop.ResultOther, Description: err.Error()}
+		if isNotFound(err) {
+			ans.Result.ResultCode = interop.ResultUnknownDevEUI
+		}
+		return ans, nil
+	}
+	if !strings.EqualFold(dev.ApplicationServerID, req.SenderID) {
+		ans.Result = interop.Result{
+			ResultCode:  interop.ResultUnknownSender,
+			Description: errApplicationServerMismatch.WithAttributes("as_id", req.SenderID).Error(),
+		}
+		return ans, nil
+	}
+	kekLabel := dev.ApplicationServerKEKLabel
+	if kekLabel == "" {
+		kekLabel = js.interopApplicationKEKLabels[req.SenderID]
+	}
+	if kekLabel == "" {
+		ans.Result = interop.Result{ResultCode: interop.ResultOther, Description: errNoKEKLabel.WithAttributes("sender_id", req.SenderID).Error()}
+		return ans, nil
+	}
+	res, err := js.getAppSKey(ctx, &ttnpb.SessionKeyRequest{
+		DevEUI:       devEUI,
+		SessionKeyID: req.SessionKeyID,
+	})
+	if err != nil {
+		ans.Result = interop.Result{ResultCode: interop.ResultOther, Description: err.Error()}
+		if isNotFound(err) {
+			ans.Result.ResultCode = interop.ResultUnknownDevEUI
+		}
+		return ans, nil
+	}
+	if ans.AppSKey, err = js.interopKeyEnvelope(&res.AppSKey, kekLabel); err != nil {
+		return nil, err
+	}
+	ans.DevEUI = req.DevEUI
+	ans.SessionKeyID = req.SessionKeyID
+	return ans, nil
+}
+
+// HandleHomeNSReq implements interop.JoinServer.
+func (js *JoinServer) HandleHomeNSReq(ctx context.Context, req *interop.HomeNSReq) (*interop.HomeNSAns, error) {
+	ans := &interop.HomeNSAns{
+		MessageHeader: req.AnswerHeader(interop.MessageTypeHomeNSAns),
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}
+
+	var joinEUI, devEUI types.EUI64
+	if err := joinEUI.UnmarshalText([]byte(req.ReceiverID)); err != nil {
+		ans.Result = interop.Result{ResultCode: interop.ResultUnknownReceiver, Description: err.Error()}
+		return ans, nil
+	}
+	if err := devEUI.UnmarshalBinary(req.DevEUI); err != nil {
+		ans.Result = interop.Result{ResultCode: interop.ResultMalformedRequest, Description: err.Error()}
+		return ans, nil
+	}
+	dev, err := js.devices.GetByEUI(ctx, joinEUI, devEUI, []string{"net_id"})
+	if err != nil {
+		ans.Result = interop.Result{ResultCode: interop.ResultOther, Description: err.Error()}
+		if isNotFound(err) {
+			ans.Result.ResultCode = interop.ResultUnknownDevEUI
+		}
+		return ans, nil
+	}
+	if dev.NetID == nil {
+		ans.Result = interop.Result{ResultCode: interop.ResultOther, Description: errNoNetID.Error()}
+		return ans, nil
+	}
+	ans.HNetID = dev.NetID.String()
+	return ans, nil
+}
@@ -0,0 +1,486 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mohae/deepcopy"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/interop"
+	. "go.thethings.network/lorawan-stack/pkg/joinserver"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+var (
+	nsKEK = []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f}
+	asKEK = []byte{0x0f, 0x0e, 0x0d, 0x0c, 0x0b, 0x0a, 0x09, 0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01, 0x00}
+
+	errTestNotFound = errors.DefineNotFound("test_not_found", "not found")
+)
+
+func newInteropJoinServer(t *testing.T, devReg DeviceRegistry, keyReg KeyRegistry) *JoinServer {
+	c := component.MustNew(test.GetLogger(t), &component.Config{
+		ServiceBase: config.ServiceBase{
+			KeyVault: config.KeyVault{
+				Static: map[string][]byte{
+					"ns-kek": nsKEK,
+					"as-kek": asKEK,
+				},
+			},
+		},
+	})
+	js := test.Must(New(c, &Config{
+		Devices:         devReg,
+		Keys:            keyReg,
+		JoinEUIPrefixes: joinEUIPrefixes,
+		Interop: InteropConfig{
+			NetworkKEKLabels: map[string]string{
+				"42FFFF": "ns-kek",
+			},
+			ApplicationKEKLabels: map[string]string{
+				"as.test.org": "as-kek",
+			},
+		},
+	})).(*JoinServer)
+	test.Must(nil, c.Start())
+	return js
+}
+
+func mustWrapKey(key types.AES128Key, kek []byte) []byte {
+	b, err := crypto.WrapKey(key[:], kek)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func TestInteropConfig(t *testing.T) {
+	a := assertions.New(t)
+
+	_, err := New(component.MustNew(test.GetLogger(t), &component.Config{}), &Config{
+		Interop: InteropConfig{
+			NetworkKEKLabels: map[string]string{
+				"invalid": "ns-kek",
+			},
+		},
+	})
+	a.So(err, should.NotBeNil)
+}
+
+func TestHandleJoinReq(t *testing.T) {
+	dev := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			DevEUI:  &types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			JoinEUI: &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		},
+		RootKeys: &ttnpb.RootKeys{
+			AppKey: &ttnpb.KeyEnvelope{
+				Key: appKey[:],
+			},
+		},
+		LoRaWANVersion: ttnpb.MAC_V1_0_2,
+		NetID:          &types.NetID{0x42, 0xff, 0xff},
+	}
+	newReq := func() *interop.JoinReq {
+		return &interop.JoinReq{
+			MessageHeader: interop.MessageHeader{
+				ProtocolVersion: interop.ProtocolVersion,
+				SenderID:        "42FFFF",
+				ReceiverID:      "42FFFFFFFFFFFFFF",
+				TransactionID:   42,
+				MessageType:     interop.MessageTypeJoinReq,
+			},
+			MACVersion: "1.0.2",
+			PHYPayload: interop.Buffer{
+				0x00,
+				0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42,
+				0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+				0x01, 0x00,
+				0xc4, 0x8, 0x50, 0xcf,
+			},
+			DevEUI:     interop.Buffer{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			DevAddr:    interop.Buffer{0x42, 0xff, 0xff, 0xff},
+			DLSettings: interop.Buffer{0xff},
+			RxDelay:    0x42,
+		}
+	}
+
+	for _, tc := range []struct {
+		Name      string
+		Device    *ttnpb.EndDevice
+		Request   func(*interop.JoinReq)
+		Assertion func(*assertions.Assertion, *interop.JoinAns)
+	}{
+		{
+			Name:   "Success",
+			Device: dev,
+			Assertion: func(a *assertions.Assertion, ans *interop.JoinAns) {
+				a.So(ans.Result.ResultCode, should.Equal, interop.ResultSuccess)
+				a.So(ans.ReceiverID, should.Equal, "42FFFF")
+				a.So(ans.TransactionID, should.Equal, 42)
+				a.So(ans.PHYPayload, should.Resemble, interop.Buffer(append([]byte{0x20},
+					mustEncryptJoinAccept(appKey, []byte{
+						0x01, 0x00, 0x00,
+						0xff, 0xff, 0x42,
+						0xff, 0xff, 0xff, 0x42,
+						0xff,
+						0x42,
+						0xc9, 0x7a, 0x61, 0x04,
+					})...)))
+				a.So(ans.SessionKeyID, should.NotBeEmpty)
+				a.So(ans.NwkSKey, should.Resemble, &interop.KeyEnvelope{
+					KEKLabel: "ns-kek",
+					AESKey: mustWrapKey(crypto.DeriveLegacyNwkSKey(
+						appKey,
+						types.JoinNonce{0x00, 0x00, 0x01},
+						types.NetID{0x42, 0xff, 0xff},
+						types.DevNonce{0x00, 0x01}), nsKEK),
+				})
+				a.So(ans.FNwkSIntKey, should.BeNil)
+				a.So(ans.AppSKey, should.BeNil)
+			},
+		},
+		{
+			Name:   "MIC mismatch",
+			Device: dev,
+			Request: func(req *interop.JoinReq) {
+				copy(req.PHYPayload[19:], []byte{0x01, 0x02, 0x03, 0x04})
+			},
+			Assertion: func(a *assertions.Assertion, ans *interop.JoinAns) {
+				a.So(ans.Result.ResultCode, should.Equal, interop.ResultMICFailed)
+				a.So(ans.PHYPayload, should.BeEmpty)
+			},
+		},
+		{
+			Name: "Unknown device",
+			Assertion: func(a *assertions.Assertion, ans *interop.JoinAns) {
+				a.So(ans.Result.ResultCode, should.Equal, interop.ResultUnknownDevEUI)
+			},
+		},
+		{
+			Name:   "Invalid MAC version",
+			Device: dev,
+			Request: func(req *interop.JoinReq) {
+				req.MACVersion = "0.9"
+			},
+			Assertion: func(a *assertions.Assertion, ans *interop.JoinAns) {
+				a.So(ans.Result.ResultCode, should.Equal, interop.ResultMalformedRequest)
+			},
+		},
+		{
+			Name:   "Invalid sender",
+			Device: dev,
+			Request: func(req *interop.JoinReq) {
+				req.SenderID = "as.test.org"
+			},
+			Assertion: func(a *assertions.Assertion, ans *interop.JoinAns) {
+				a.So(ans.Result.ResultCode, should.Equal, interop.ResultUnknownSender)
+			},
+		},
+		{
+			Name: "NetID mismatch",
+			Device: func() *ttnpb.EndDevice {
+				dev := deepcopy.Copy(dev).(*ttnpb.EndDevice)
+				dev.NetID = &types.NetID{0x00, 0x00, 0x13}
+				return dev
+			}(),
+			Assertion: func(a *assertions.Assertion, ans *interop.JoinAns) {
+				a.So(ans.Result.ResultCode, should.Equal, interop.ResultUnknownSender)
+				a.So(ans.PHYPayload, should.BeEmpty)
+				a.So(ans.NwkSKey, should.BeNil)
+			},
+		},
+		{
+			Name: "No KEK label",
+			Device: func() *ttnpb.EndDevice {
+				dev := deepcopy.Copy(dev).(*ttnpb.EndDevice)
+				dev.NetID = &types.NetID{0x00, 0x00, 0x13}
+				return dev
+			}(),
+			Request: func(req *interop.JoinReq) {
+				req.SenderID = "000013"
+			},
+			Assertion: func(a *assertions.Assertion, ans *interop.JoinAns) {
+				a.So(ans.Result.ResultCode, should.Equal, interop.ResultOther)
+				a.So(ans.PHYPayload, should.BeEmpty)
+				a.So(ans.NwkSKey, should.BeNil)
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			js := newInteropJoinServer(t,
+				&MockDeviceRegistry{
+					SetByEUIFunc: func(_ context.Context, _, _ types.EUI64, _ []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+						var dev *ttnpb.EndDevice
+						if tc.Device != nil {
+							dev = deepcopy.Copy(tc.Device).(*ttnpb.EndDevice)
+						}
+						dev, _, err := f(dev)
+						return dev, err
+					},
+				},
+				&MockKeyRegistry{
+					SetByIDFunc: func(_ context.Context, _ types.EUI64, _ []byte, _ []string, f func(*ttnpb.SessionKeys) (*ttnpb.SessionKeys, []string, error)) (*ttnpb.SessionKeys, error) {
+						ks, _, err := f(nil)
+						return ks, err
+					},
+				},
+			)
+
+			req := newReq()
+			if tc.Request != nil {
+				tc.Request(req)
+			}
+			ans, err := js.HandleJoinReq(test.Context(), req)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(ans.MessageType, should.Equal, interop.MessageTypeJoinAns)
+			tc.Assertion(a, ans)
+		})
+	}
+}
+
+func TestHandleAppSKeyReq(t *testing.T) {
+	appSKey := types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0xff}
+	getByID := func(_ context.Context, devEUI types.EUI64, id []byte, _ []string) (*ttnpb.SessionKeys, error) {
+		return &ttnpb.SessionKeys{
+			SessionKeyID: id,
+			AppSKey: &ttnpb.KeyEnvelope{
+				Key: appSKey[:],
+			},
+		}, nil
+	}
+
+	for _, tc := range []struct {
+		Name      string
+		SenderID  string
+		Device    *ttnpb.EndDevice
+		GetByID   func(context.Context, types.EUI64, []byte, []string) (*ttnpb.SessionKeys, error)
+		Assertion func(*assertions.Assertion, *interop.AppSKeyAns)
+	}{
+		{
+			Name:     "Wrapped",
+			SenderID: "as.test.org",
+			Device: &ttnpb.EndDevice{
+				ApplicationServerID: "as.test.org",
+			},
+			GetByID: getByID,
+			Assertion: func(a *assertions.Assertion, ans *interop.AppSKeyAns) {
+				a.So(ans.Result.ResultCode, should.Equal, interop.ResultSuccess)
+				a.So(ans.DevEUI, should.Resemble, interop.Buffer{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+				a.So(ans.SessionKeyID, should.Resemble, interop.Buffer{0x11, 0x22, 0x33, 0x44})
+				a.So(ans.AppSKey, should.Resemble, &interop.KeyEnvelope{
+					KEKLabel: "as-kek",
+					AESKey:   mustWrapKey(appSKey, asKEK),
+				})
+			},
+		},
+		{
+			Name:     "Device KEK label",
+			SenderID: "other.test.org",
+			Device: &ttnpb.EndDevice{
+				ApplicationServerID:       "other.test.org",
+				ApplicationServerKEKLabel: "as-kek",
+			},
+			GetByID: func(_ context.Context, devEUI types.EUI64, id []byte, _ []string) (*ttnpb.SessionKeys, error) {
+				return &ttnpb.SessionKeys{
+					SessionKeyID: id,
+					AppSKey: &ttnpb.KeyEnvelope{
+						Key:      mustWrapKey(appSKey, asKEK),
+						KEKLabel: "as-kek",
+					},
+				}, nil
+			},
+			Assertion: func(a *assertions.Assertion, ans *interop.AppSKeyAns) {
+				a.So(ans.Result.ResultCode, should.Equal, interop.ResultSuccess)
+				a.So(ans.AppSKey, should.Resemble, &interop.KeyEnvelope{
+					KEKLabel: "as-kek",
+					AESKey:   mustWrapKey(appSKey, asKEK),
+				})
+			},
+		},
+		{
+			Name:     "No KEK label",
+			SenderID: "other.test.org",
+			Device: &ttnpb.EndDevice{
+				ApplicationServerID: "other.test.org",
+			},
+			GetByID: getByID,
+			Assertion: func(a *assertions.Assertion, ans *interop.AppSKeyAns) {
+				a.So(ans.Result.ResultCode, should.Equal, interop.ResultOther)
+				a.So(ans.AppSKey, should.BeNil)
+			},
+		},
+		{
+			Name:     "Other Application Server",
+			SenderID: "as.test.org",
+			Device: &ttnpb.EndDevice{
+				ApplicationServerID: "other.test.org",
+			},
+			GetByID: getByID,
+			Assertion: func(a *assertions.Assertion, ans *interop.AppSKeyAns) {
+				a.So(ans.Result.ResultCode, should.Equal, interop.ResultUnknownSender)
+				a.So(ans.AppSKey, should.BeNil)
+			},
+		},
+		{
+			Name:     "No Application Server",
+			SenderID: "as.test.org",
+			Device:   &ttnpb.EndDevice{},
+			GetByID:  getByID,
+			Assertion: func(a *assertions.Assertion, ans *interop.AppSKeyAns) {
+				a.So(ans.Result.ResultCode, should.Equal, interop.ResultUnknownSender)
+				a.So(ans.AppSKey, should.BeNil)
+			},
+		},
+		{
+			Name:     "Unknown device",
+			SenderID: "as.test.org",
+			GetByID:  getByID,
+			Assertion: func(a *assertions.Assertion, ans *interop.AppSKeyAns) {
+				a.So(ans.Result.ResultCode, should.Equal, interop.ResultUnknownDevEUI)
+				a.So(ans.AppSKey, should.BeNil)
+			},
+		},
+		{
+			Name:     "Unknown session",
+			SenderID: "as.test.org",
+			Device: &ttnpb.EndDevice{
+				ApplicationServerID: "as.test.org",
+			},
+			GetByID: func(context.Context, types.EUI64, []byte, []string) (*ttnpb.SessionKeys, error) {
+				return nil, errTestNotFound
+			},
+			Assertion: func(a *assertions.Assertion, ans *interop.AppSKeyAns) {
+				a.So(ans.Result.ResultCode, should.Equal, interop.ResultUnknownDevEUI)
+				a.So(ans.AppSKey, should.BeNil)
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			js := newInteropJoinServer(t,
+				&MockDeviceRegistry{
+					GetByEUIFunc: func(_ context.Context, joinEUI, devEUI types.EUI64, _ []string) (*ttnpb.EndDevice, error) {
+						a.So(joinEUI, should.Resemble, types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+						a.So(devEUI, should.Resemble, types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+						if tc.Device == nil {
+							return nil, errTestNotFound
+						}
+						return deepcopy.Copy(tc.Device).(*ttnpb.EndDevice), nil
+					},
+				},
+				&MockKeyRegistry{GetByIDFunc: tc.GetByID},
+			)
+			ans, err := js.HandleAppSKeyReq(test.Context(), &interop.AppSKeyReq{
+				MessageHeader: interop.MessageHeader{
+					ProtocolVersion: interop.ProtocolVersion,
+					SenderID:        tc.SenderID,
+					ReceiverID:      "42FFFFFFFFFFFFFF",
+					MessageType:     interop.MessageTypeAppSKeyReq,
+				},
+				DevEUI:       interop.Buffer{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+				SessionKeyID: interop.Buffer{0x11, 0x22, 0x33, 0x44},
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(ans.MessageType, should.Equal, interop.MessageTypeAppSKeyAns)
+			a.So(ans.ReceiverID, should.Equal, tc.SenderID)
+			tc.Assertion(a, ans)
+		})
+	}
+}
+
+func TestHandleHomeNSReq(t *testing.T) {
+	for _, tc := range []struct {
+		Name       string
+		ReceiverID string
+		GetByEUI   func(context.Context, types.EUI64, types.EUI64, []string) (*ttnpb.EndDevice, error)
+		ResultCode interop.ResultCode
+		HNetID     string
+	}{
+		{
+			Name:       "Success",
+			ReceiverID: "42FFFFFFFFFFFFFF",
+			GetByEUI: func(_ context.Context, joinEUI, devEUI types.EUI64, _ []string) (*ttnpb.EndDevice, error) {
+				if joinEUI != (types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}) ||
+					devEUI != (types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}) {
+					return nil, errTestNotFound
+				}
+				return &ttnpb.EndDevice{
+					NetID: &types.NetID{0x00, 0x00, 0x13},
+				}, nil
+			},
+			ResultCode: interop.ResultSuccess,
+			HNetID:     "000013",
+		},
+		{
+			Name:       "No NetID",
+			ReceiverID: "42FFFFFFFFFFFFFF",
+			GetByEUI: func(context.Context, types.EUI64, types.EUI64, []string) (*ttnpb.EndDevice, error) {
+				return &ttnpb.EndDevice{}, nil
+			},
+			ResultCode: interop.ResultOther,
+		},
+		{
+			Name:       "Unknown device",
+			ReceiverID: "42FFFFFFFFFFFFFF",
+			GetByEUI: func(context.Context, types.EUI64, types.EUI64, []string) (*ttnpb.EndDevice, error) {
+				return nil, errTestNotFound
+			},
+			ResultCode: interop.ResultUnknownDevEUI,
+		},
+		{
+			Name:       "Invalid receiver",
+			ReceiverID: "test",
+			ResultCode: interop.ResultUnknownReceiver,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			js := newInteropJoinServer(t, &MockDeviceRegistry{GetByEUIFunc: tc.GetByEUI}, &MockKeyRegistry{})
+			ans, err := js.HandleHomeNSReq(test.Context(), &interop.HomeNSReq{
+				MessageHeader: interop.MessageHeader{
+					ProtocolVersion: interop.ProtocolVersion,
+					SenderID:        "000042",
+					ReceiverID:      tc.ReceiverID,
+					MessageType:     interop.MessageTypeHomeNSReq,
+				},
+				DevEUI: interop.Buffer{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(ans.MessageType, should.Equal, interop.MessageTypeHomeNSAns)
+			a.So(ans.Result.ResultCode, should.Equal, tc.ResultCode)
+			a.So(ans.HNetID, should.Equal, tc.HNetID)
+		})
+	}
+}
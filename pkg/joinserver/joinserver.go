@@ -25,6 +25,8 @@ import (
 	"github.com/oklog/ulid"
 	"go.thethings.network/lorawan-stack/pkg/cluster"
 	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/interop"
+	"go.thethings.network/lorawan-stack/pkg/joinserver/provisioning"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/hooks"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
@@ -36,11 +38,14 @@ type Config struct {
 	Devices         DeviceRegistry       `name:"-"`
 	Keys            KeyRegistry          `name:"-"`
 	JoinEUIPrefixes []*types.EUI64Prefix `name:"join-eui-prefix" description:"JoinEUI prefixes handled by this JS"`
+	Interop         InteropConfig        `name:"interop" description:"LoRaWAN Backend Interfaces configuration"`
+	Provisioning    ProvisioningConfig   `name:"provisioning" description:"Device provisioning configuration"`
 }
 
 // JoinServer implements the Join Server component.
 //
-// The Join Server exposes the NsJs and DeviceRegistry services.
+// The Join Server exposes the NsJs and DeviceRegistry services,
+// and serves LoRaWAN Backend Interfaces requests of Network Servers and Application Servers.
 type JoinServer struct {
 	*component.Component
 
@@ -49,6 +54,11 @@ type JoinServer struct {
 
 	euiPrefixes []*types.EUI64Prefix
 
+	interopNetworkKEKLabels     map[types.NetID]string
+	interopApplicationKEKLabels map[string]string
+
+	provisioners map[string]provisioning.Provisioner
+
 	entropyMu *sync.Mutex
 	entropy   io.Reader
 
@@ -61,6 +71,15 @@ type JoinServer struct {
 
 // New returns new *JoinServer.
 func New(c *component.Component, conf *Config) (*JoinServer, error) {
+	networkKEKLabels, err := conf.Interop.Parse()
+	if err != nil {
+		return nil, err
+	}
+	provisioners, err := conf.Provisioning.Provisioners(c.KeyVault)
+	if err != nil {
+		return nil, err
+	}
+
 	js := &JoinServer{
 		Component: c,
 
@@ -69,6 +88,11 @@ func New(c *component.Component, conf *Config) (*JoinServer, error) {
 
 		euiPrefixes: conf.JoinEUIPrefixes,
 
+		interopNetworkKEKLabels:     networkKEKLabels,
+		interopApplicationKEKLabels: conf.Interop.ApplicationKEKLabels,
+
+		provisioners: provisioners,
+
 		entropyMu: &sync.Mutex{},
 		entropy:   ulid.Monotonic(rand.New(rand.NewSource(time.Now().UnixNano())), 0),
 	}
@@ -82,6 +106,7 @@ func New(c *component.Component, conf *Config) (*JoinServer, error) {
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.AsJs", cluster.HookName, c.ClusterAuthUnaryHook())
 
 	c.RegisterGRPC(js)
+	c.RegisterWeb(interop.NewJSServer(c.Context(), js, js.authorizeInteropSender))
 	return js, nil
 }
 
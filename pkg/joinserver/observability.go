@@ -25,8 +25,9 @@ import (
 )
 
 var (
-	evtRejectJoin = events.Define("js.join.reject", "reject join-request")
-	evtAcceptJoin = events.Define("js.join.accept", "accept join-request")
+	evtRejectJoin   = events.Define("js.join.reject", "reject join-request")
+	evtRejectReplay = events.Define("join_server.reject.replay", "reject join-request with replayed DevNonce")
+	evtAcceptJoin   = events.Define("js.join.accept", "accept join-request")
 )
 
 const (
@@ -89,3 +90,7 @@ func registerRejectJoin(ctx context.Context, req *ttnpb.JoinRequest, err error)
 		jsMetrics.joinRejected.WithLabelValues(ctx, unknown).Inc()
 	}
 }
+
+func registerRejectReplay(ctx context.Context, dev *ttnpb.EndDevice, err error) {
+	events.Publish(evtRejectReplay(ctx, dev.EndDeviceIdentifiers, err))
+}
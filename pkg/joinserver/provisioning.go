@@ -0,0 +1,99 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/joinserver/provisioning"
+)
+
+// ProvisioningConfig represents the device provisioning configuration of the Join Server.
+type ProvisioningConfig struct {
+	Manifest ManifestProvisioningConfig `name:"manifest" description:"Provisioner of signed manifests with KEK-wrapped root keys"`
+}
+
+// ManifestProvisioningConfig represents the configuration of the manifest provisioner.
+// The provisioner is enabled when a vendor certificate is configured. The vendor certificate must chain to the
+// root CA certificates.
+type ManifestProvisioningConfig struct {
+	ID               string `name:"id" description:"ID of the provisioner"`
+	Certificate      string `name:"certificate" description:"Path to the PEM encoded vendor certificate that signs the manifests"`
+	RootCA           string `name:"root-ca" description:"Path to the PEM encoded root CA certificates that the vendor certificate chains to"`
+	DeviceIDTemplate string `name:"device-id-template" description:"Template to derive device IDs from manifest entries"`
+	KEKLabel         string `name:"kek-label" description:"KEK label of root keys in manifest entries that do not specify one"`
+}
+
+var (
+	errProvisioningCertificate = errors.DefineInvalidArgument("provisioning_certificate", "invalid provisioning certificate `{path}`")
+	errProvisioningRootCA      = errors.DefineInvalidArgument("provisioning_root_ca", "invalid provisioning root CA `{path}`")
+)
+
+// Provisioners returns the configured provisioners by ID.
+// The key vault is used to verify that the root keys in manifests can be unwrapped.
+func (c ProvisioningConfig) Provisioners(keyVault crypto.KeyVault) (map[string]provisioning.Provisioner, error) {
+	provisioners := make(map[string]provisioning.Provisioner)
+	if c.Manifest.Certificate != "" {
+		certPEM, err := ioutil.ReadFile(c.Manifest.Certificate)
+		if err != nil {
+			return nil, errProvisioningCertificate.WithAttributes("path", c.Manifest.Certificate).WithCause(err)
+		}
+		block, _ := pem.Decode(certPEM)
+		if block == nil {
+			return nil, errProvisioningCertificate.WithAttributes("path", c.Manifest.Certificate)
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, errProvisioningCertificate.WithAttributes("path", c.Manifest.Certificate).WithCause(err)
+		}
+		rootsPEM, err := ioutil.ReadFile(c.Manifest.RootCA)
+		if err != nil {
+			return nil, errProvisioningRootCA.WithAttributes("path", c.Manifest.RootCA).WithCause(err)
+		}
+		roots := x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(rootsPEM) {
+			return nil, errProvisioningRootCA.WithAttributes("path", c.Manifest.RootCA)
+		}
+		p, err := provisioning.NewManifest(provisioning.ManifestConfig{
+			Certificate:      cert,
+			Roots:            roots,
+			KeyVault:         keyVault,
+			DeviceIDTemplate: c.Manifest.DeviceIDTemplate,
+			KEKLabel:         c.Manifest.KEKLabel,
+		})
+		if err != nil {
+			return nil, err
+		}
+		id := c.Manifest.ID
+		if id == "" {
+			id = "manifest"
+		}
+		provisioners[id] = p
+	}
+	return provisioners, nil
+}
+
+// provisioner returns the provisioner by ID. Provisioners configured in the Join Server take precedence over
+// the provisioners that are registered globally.
+func (js *JoinServer) provisioner(id string) provisioning.Provisioner {
+	if p, ok := js.provisioners[id]; ok {
+		return p
+	}
+	return provisioning.Get(id)
+}
@@ -0,0 +1,298 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provisioning
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"strings"
+	"text/template"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gogoproto"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	jose "gopkg.in/square/go-jose.v2"
+)
+
+// Fields of manifest entries.
+const (
+	ManifestFieldDevEUI         = "dev_eui"
+	ManifestFieldJoinEUI        = "join_eui"
+	ManifestFieldRootKeyID      = "root_key_id"
+	ManifestFieldAppKey         = "app_key"
+	ManifestFieldAppKeyKEKLabel = "app_key_kek_label"
+	ManifestFieldNwkKey         = "nwk_key"
+	ManifestFieldNwkKeyKEKLabel = "nwk_key_kek_label"
+)
+
+// DefaultManifestDeviceIDTemplate is the default template to derive device IDs from manifest entries.
+const DefaultManifestDeviceIDTemplate = "eui-{{.dev_eui}}"
+
+// ManifestConfig is the configuration of a manifest provisioner.
+type ManifestConfig struct {
+	// Certificate is the vendor certificate of which the public key verifies the manifest signatures.
+	Certificate *x509.Certificate
+	// Roots are the root CAs that the vendor certificate must chain to.
+	Roots *x509.CertPool
+	// KeyVault is the key vault of the Join Server, used to verify that the root keys can be unwrapped.
+	// If nil, the root keys are not verified.
+	KeyVault crypto.KeyVault
+	// DeviceIDTemplate is the text/template to derive device IDs with.
+	// The template is executed with the fields of the entry, where the DevEUI and JoinEUI are lowercase hex.
+	// If empty, DefaultManifestDeviceIDTemplate is used.
+	DeviceIDTemplate string
+	// KEKLabel is the KEK label of the root keys in entries that do not specify a KEK label.
+	KEKLabel string
+}
+
+// manifest is a device provisioner that accepts signed manifests.
+//
+// A manifest is a JWS, in compact or JSON serialization, of which the payload is either a JSON array of objects
+// or CSV with a header row. Each entry contains the DevEUI, optionally the JoinEUI and the root keys as hex,
+// wrapped with a KEK that is known to the Join Server.
+type manifest struct {
+	certificate      *x509.Certificate
+	roots            *x509.CertPool
+	keyVault         crypto.KeyVault
+	deviceIDTemplate *template.Template
+	kekLabel         string
+}
+
+// wrappedKeyLength is the length of an AES-128 key that is wrapped with the RFC 3394 algorithm.
+const wrappedKeyLength = 24
+
+var (
+	errManifestCertificate   = errors.DefineInvalidArgument("manifest_certificate", "no manifest certificate")
+	errManifestRoots         = errors.DefineInvalidArgument("manifest_roots", "no manifest root CAs")
+	errManifestUntrusted     = errors.DefineFailedPrecondition("manifest_untrusted", "manifest certificate is not trusted")
+	errManifestTemplate      = errors.DefineInvalidArgument("manifest_template", "invalid device ID template")
+	errManifestSignature     = errors.DefineInvalidArgument("manifest_signature", "invalid manifest signature")
+	errManifestFormat        = errors.DefineInvalidArgument("manifest_format", "invalid manifest format")
+	errManifestField         = errors.DefineInvalidArgument("manifest_field", "invalid manifest field `{field}`")
+	errManifestKeyNotWrapped = errors.DefineInvalidArgument("manifest_key_not_wrapped", "manifest key `{field}` is not wrapped with a KEK")
+	errManifestKeyLength     = errors.DefineInvalidArgument("manifest_key_length", "manifest key `{field}` is `{length}` bytes instead of `{expected}`")
+	errManifestKeyUnwrap     = errors.DefineInvalidArgument("manifest_key_unwrap", "failed to unwrap manifest key `{field}` with KEK `{kek_label}`")
+	errManifestNoRootKeys    = errors.DefineInvalidArgument("manifest_no_root_keys", "manifest entry has no root keys")
+	errManifestDeviceID      = errors.DefineInvalidArgument("manifest_device_id", "failed to derive device ID")
+)
+
+// NewManifest returns a new provisioner that accepts manifests signed by the vendor certificate.
+// The vendor certificate is verified against the root CAs when manifests are decoded.
+func NewManifest(conf ManifestConfig) (Provisioner, error) {
+	if conf.Certificate == nil {
+		return nil, errManifestCertificate
+	}
+	if conf.Roots == nil {
+		return nil, errManifestRoots
+	}
+	text := conf.DeviceIDTemplate
+	if text == "" {
+		text = DefaultManifestDeviceIDTemplate
+	}
+	tmpl, err := template.New("device_id").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, errManifestTemplate.WithCause(err)
+	}
+	return &manifest{
+		certificate:      conf.Certificate,
+		roots:            conf.Roots,
+		keyVault:         conf.KeyVault,
+		deviceIDTemplate: tmpl,
+		kekLabel:         conf.KEKLabel,
+	}, nil
+}
+
+func decodeManifestCSV(data []byte) ([]map[string]interface{}, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.TrimLeadingSpace = true
+	header, err := r.Read()
+	if err != nil {
+		return nil, err
+	}
+	for i, name := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(name))
+	}
+	var entries []map[string]interface{}
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		entry := make(map[string]interface{}, len(header))
+		for i, name := range header {
+			if record[i] != "" {
+				entry[name] = record[i]
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// Decode verifies the vendor certificate and the signature of the manifest and returns a struct for each entry.
+func (p *manifest) Decode(data []byte) ([]*pbtypes.Struct, error) {
+	// Verify checks the validity period of the certificates in the chain as well.
+	if _, err := p.certificate.Verify(x509.VerifyOptions{
+		Roots:     p.roots,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		return nil, errManifestUntrusted.WithCause(err)
+	}
+	jws, err := jose.ParseSigned(string(data))
+	if err != nil {
+		return nil, errManifestSignature.WithCause(err)
+	}
+	payload, err := jws.Verify(p.certificate.PublicKey)
+	if err != nil {
+		return nil, errManifestSignature.WithCause(err)
+	}
+	payload = bytes.TrimSpace(payload)
+	var entries []map[string]interface{}
+	if len(payload) > 0 && payload[0] == '[' {
+		err = json.Unmarshal(payload, &entries)
+	} else {
+		entries, err = decodeManifestCSV(payload)
+	}
+	if err != nil {
+		return nil, errManifestFormat.WithCause(err)
+	}
+	res := make([]*pbtypes.Struct, 0, len(entries))
+	for _, entry := range entries {
+		s, err := gogoproto.Struct(entry)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, s)
+	}
+	return res, nil
+}
+
+func manifestEUI(entry *pbtypes.Struct, field string) (types.EUI64, bool, error) {
+	s := entry.Fields[field].GetStringValue()
+	if s == "" {
+		return types.EUI64{}, false, nil
+	}
+	var eui types.EUI64
+	if err := eui.UnmarshalText([]byte(s)); err != nil {
+		return types.EUI64{}, false, errManifestField.WithAttributes("field", field).WithCause(err)
+	}
+	return eui, true, nil
+}
+
+// DefaultJoinEUI returns the JoinEUI of the entry.
+func (p *manifest) DefaultJoinEUI(entry *pbtypes.Struct) (types.EUI64, error) {
+	eui, ok, err := manifestEUI(entry, ManifestFieldJoinEUI)
+	if err != nil {
+		return types.EUI64{}, err
+	}
+	if !ok {
+		return types.EUI64{}, errManifestField.WithAttributes("field", ManifestFieldJoinEUI)
+	}
+	return eui, nil
+}
+
+// DefaultDevEUI returns the DevEUI of the entry.
+func (p *manifest) DefaultDevEUI(entry *pbtypes.Struct) (types.EUI64, error) {
+	eui, ok, err := manifestEUI(entry, ManifestFieldDevEUI)
+	if err != nil {
+		return types.EUI64{}, err
+	}
+	if !ok {
+		return types.EUI64{}, errManifestField.WithAttributes("field", ManifestFieldDevEUI)
+	}
+	return eui, nil
+}
+
+// DeviceID returns the device ID derived from the entry using the configured template.
+func (p *manifest) DeviceID(joinEUI, devEUI types.EUI64, entry *pbtypes.Struct) (string, error) {
+	data, err := gogoproto.Map(entry)
+	if err != nil {
+		return "", errEntry.WithCause(err)
+	}
+	data[ManifestFieldJoinEUI] = strings.ToLower(joinEUI.String())
+	data[ManifestFieldDevEUI] = strings.ToLower(devEUI.String())
+	var buf bytes.Buffer
+	if err := p.deviceIDTemplate.Execute(&buf, data); err != nil {
+		return "", errManifestDeviceID.WithCause(err)
+	}
+	return buf.String(), nil
+}
+
+func (p *manifest) keyEnvelope(entry *pbtypes.Struct, keyField, kekLabelField string) (*ttnpb.KeyEnvelope, error) {
+	s := entry.Fields[keyField].GetStringValue()
+	if s == "" {
+		return nil, nil
+	}
+	key, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, errManifestField.WithAttributes("field", keyField).WithCause(err)
+	}
+	kekLabel := entry.Fields[kekLabelField].GetStringValue()
+	if kekLabel == "" {
+		kekLabel = p.kekLabel
+	}
+	if kekLabel == "" {
+		return nil, errManifestKeyNotWrapped.WithAttributes("field", keyField)
+	}
+	if len(key) != wrappedKeyLength {
+		return nil, errManifestKeyLength.WithAttributes(
+			"field", keyField,
+			"length", len(key),
+			"expected", wrappedKeyLength,
+		)
+	}
+	env := &ttnpb.KeyEnvelope{
+		Key:      key,
+		KEKLabel: kekLabel,
+	}
+	if p.keyVault != nil {
+		if _, err := cryptoutil.UnwrapAES128Key(*env, p.keyVault); err != nil {
+			return nil, errManifestKeyUnwrap.WithAttributes("field", keyField, "kek_label", kekLabel).WithCause(err)
+		}
+	}
+	return env, nil
+}
+
+// RootKeys returns the KEK-wrapped root keys of the entry.
+// If a key vault is configured, the root keys are verified to unwrap with the KEKs of the key vault.
+func (p *manifest) RootKeys(entry *pbtypes.Struct) (*ttnpb.RootKeys, error) {
+	appKey, err := p.keyEnvelope(entry, ManifestFieldAppKey, ManifestFieldAppKeyKEKLabel)
+	if err != nil {
+		return nil, err
+	}
+	nwkKey, err := p.keyEnvelope(entry, ManifestFieldNwkKey, ManifestFieldNwkKeyKEKLabel)
+	if err != nil {
+		return nil, err
+	}
+	if appKey == nil && nwkKey == nil {
+		return nil, errManifestNoRootKeys
+	}
+	return &ttnpb.RootKeys{
+		RootKeyID: entry.Fields[ManifestFieldRootKeyID].GetStringValue(),
+		AppKey:    appKey,
+		NwkKey:    nwkKey,
+	}, nil
+}
@@ -0,0 +1,248 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provisioning_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/joinserver/provisioning"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	jose "gopkg.in/square/go-jose.v2"
+)
+
+func newManifestVendor(t *testing.T, notAfter time.Time) (*x509.Certificate, func([]byte) []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{Organization: []string{"Test Vendor"}},
+		NotBefore:    notAfter.Add(-2 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Failed to parse certificate: %v", err)
+	}
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: key}, nil)
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+	return cert, func(payload []byte) []byte {
+		jws, err := signer.Sign(payload)
+		if err != nil {
+			t.Fatalf("Failed to sign manifest: %v", err)
+		}
+		s, err := jws.CompactSerialize()
+		if err != nil {
+			t.Fatalf("Failed to serialize manifest: %v", err)
+		}
+		return []byte(s)
+	}
+}
+
+func certPool(certs ...*x509.Certificate) *x509.CertPool {
+	pool := x509.NewCertPool()
+	for _, cert := range certs {
+		pool.AddCert(cert)
+	}
+	return pool
+}
+
+func TestManifest(t *testing.T) {
+	cert, sign := newManifestVendor(t, time.Now().Add(time.Hour))
+	otherCert, signOther := newManifestVendor(t, time.Now().Add(time.Hour))
+	expiredCert, signExpired := newManifestVendor(t, time.Now().Add(-time.Hour))
+
+	// The root keys are wrapped with the RFC 3394 test KEK.
+	kek := []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f}
+	keyVault := cryptoutil.NewMemKeyVault(map[string][]byte{
+		"vendor": kek,
+		"other":  kek,
+	})
+
+	_, err := provisioning.NewManifest(provisioning.ManifestConfig{
+		Certificate: cert,
+	})
+	if !errors.IsInvalidArgument(err) {
+		t.Fatalf("Expected invalid argument error without roots, got: %v", err)
+	}
+
+	provisioner, err := provisioning.NewManifest(provisioning.ManifestConfig{
+		Certificate:      cert,
+		Roots:            certPool(cert),
+		KeyVault:         keyVault,
+		DeviceIDTemplate: "{{.serial_number}}-{{.dev_eui}}",
+		KEKLabel:         "vendor",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create provisioner: %v", err)
+	}
+
+	for _, tc := range []struct {
+		Name     string
+		Manifest []byte
+	}{
+		{
+			Name: "JSON",
+			Manifest: sign([]byte(`[{
+				"serial_number": "sn1",
+				"dev_eui": "0102030405060708",
+				"join_eui": "70B3D57ED0000000",
+				"root_key_id": "vendor-1",
+				"app_key": "1FA68B0A8112B447AEF34BD8FB5A7B829D3E862371D2CFE5",
+				"nwk_key": "1FA68B0A8112B447AEF34BD8FB5A7B829D3E862371D2CFE5",
+				"nwk_key_kek_label": "other"
+			}]`)),
+		},
+		{
+			Name: "CSV",
+			Manifest: sign([]byte(`serial_number,dev_eui,join_eui,root_key_id,app_key,nwk_key,nwk_key_kek_label
+sn1,0102030405060708,70B3D57ED0000000,vendor-1,1FA68B0A8112B447AEF34BD8FB5A7B829D3E862371D2CFE5,1FA68B0A8112B447AEF34BD8FB5A7B829D3E862371D2CFE5,other
+`)),
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			entries, err := provisioner.Decode(tc.Manifest)
+			a.So(err, should.BeNil)
+			if !a.So(entries, should.HaveLength, 1) {
+				t.FailNow()
+			}
+			entry := entries[0]
+
+			joinEUI, err := provisioner.DefaultJoinEUI(entry)
+			a.So(err, should.BeNil)
+			a.So(joinEUI, should.Resemble, types.EUI64{0x70, 0xB3, 0xD5, 0x7E, 0xD0, 0x00, 0x00, 0x00})
+
+			devEUI, err := provisioner.DefaultDevEUI(entry)
+			a.So(err, should.BeNil)
+			a.So(devEUI, should.Resemble, types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08})
+
+			deviceID, err := provisioner.DeviceID(joinEUI, devEUI, entry)
+			a.So(err, should.BeNil)
+			a.So(deviceID, should.Equal, "sn1-0102030405060708")
+
+			rootKeys, err := provisioner.(provisioning.RootKeysProvisioner).RootKeys(entry)
+			a.So(err, should.BeNil)
+			wrapped := []byte{0x1f, 0xa6, 0x8b, 0x0a, 0x81, 0x12, 0xb4, 0x47, 0xae, 0xf3, 0x4b, 0xd8, 0xfb, 0x5a, 0x7b, 0x82, 0x9d, 0x3e, 0x86, 0x23, 0x71, 0xd2, 0xcf, 0xe5}
+			a.So(rootKeys, should.Resemble, &ttnpb.RootKeys{
+				RootKeyID: "vendor-1",
+				AppKey: &ttnpb.KeyEnvelope{
+					Key:      wrapped,
+					KEKLabel: "vendor",
+				},
+				NwkKey: &ttnpb.KeyEnvelope{
+					Key:      wrapped,
+					KEKLabel: "other",
+				},
+			})
+		})
+	}
+
+	t.Run("InvalidSignature", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := provisioner.Decode(signOther([]byte(`[{"dev_eui": "0102030405060708"}]`)))
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	})
+
+	t.Run("Untrusted", func(t *testing.T) {
+		a := assertions.New(t)
+		provisioner, err := provisioning.NewManifest(provisioning.ManifestConfig{
+			Certificate: otherCert,
+			Roots:       certPool(cert),
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		_, err = provisioner.Decode(signOther([]byte(`[{"dev_eui": "0102030405060708"}]`)))
+		a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		a := assertions.New(t)
+		provisioner, err := provisioning.NewManifest(provisioning.ManifestConfig{
+			Certificate: expiredCert,
+			Roots:       certPool(expiredCert),
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		_, err = provisioner.Decode(signExpired([]byte(`[{"dev_eui": "0102030405060708"}]`)))
+		a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+	})
+
+	for _, tc := range []struct {
+		Name   string
+		AppKey string
+	}{
+		{
+			Name:   "InvalidKeyLength",
+			AppKey: "00112233445566778899AABBCCDDEEFF",
+		},
+		{
+			Name:   "InvalidKEK",
+			AppKey: "000102030405060708090A0B0C0D0E0F1011121314151617",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			entries, err := provisioner.Decode(sign([]byte(`[{"dev_eui": "0102030405060708", "app_key": "` + tc.AppKey + `"}]`)))
+			if !a.So(err, should.BeNil) || !a.So(entries, should.HaveLength, 1) {
+				t.FailNow()
+			}
+			_, err = provisioner.(provisioning.RootKeysProvisioner).RootKeys(entries[0])
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		})
+	}
+
+	t.Run("NotWrapped", func(t *testing.T) {
+		a := assertions.New(t)
+		provisioner, err := provisioning.NewManifest(provisioning.ManifestConfig{
+			Certificate: cert,
+			Roots:       certPool(cert),
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		entries, err := provisioner.Decode(sign([]byte(`[{"dev_eui": "0102030405060708", "app_key": "00112233445566778899AABBCCDDEEFF"}]`)))
+		if !a.So(err, should.BeNil) || !a.So(entries, should.HaveLength, 1) {
+			t.FailNow()
+		}
+		deviceID, err := provisioner.DeviceID(types.EUI64{}, types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}, entries[0])
+		a.So(err, should.BeNil)
+		a.So(deviceID, should.Equal, "eui-0102030405060708")
+		_, err = provisioner.(provisioning.RootKeysProvisioner).RootKeys(entries[0])
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	})
+}
@@ -18,6 +18,7 @@ package provisioning
 import (
 	pbtypes "github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
 )
 
@@ -33,6 +34,13 @@ type Provisioner interface {
 	DeviceID(joinEUI, devEUI types.EUI64, entry *pbtypes.Struct) (string, error)
 }
 
+// RootKeysProvisioner is a Provisioner that provides the root keys of the entries.
+type RootKeysProvisioner interface {
+	Provisioner
+	// RootKeys returns the root keys for the given entry, wrapped with a KEK that is known to the Join Server.
+	RootKeys(entry *pbtypes.Struct) (*ttnpb.RootKeys, error)
+}
+
 var (
 	registry = map[string]Provisioner{}
 
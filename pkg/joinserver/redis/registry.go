@@ -20,6 +20,10 @@ import (
 	"time"
 
 	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+	"github.com/mohae/deepcopy"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -42,8 +46,26 @@ func applyDeviceFieldMask(dst, src *ttnpb.EndDevice, paths ...string) (*ttnpb.En
 }
 
 // DeviceRegistry is an implementation of joinserver.DeviceRegistry.
+// If KEKLabel is set, the keys of the end devices are wrapped with the KEK label using KeyVault at rest.
 type DeviceRegistry struct {
-	Redis *ttnredis.Client
+	Redis    *ttnredis.Client
+	KeyVault crypto.KeyVault
+	KEKLabel string
+}
+
+func (r *DeviceRegistry) wrapKeys(pb *ttnpb.EndDevice) (*ttnpb.EndDevice, error) {
+	if r.KEKLabel == "" {
+		return pb, nil
+	}
+	pb = deepcopy.Copy(pb).(*ttnpb.EndDevice)
+	if err := cryptoutil.WrapKeyEnvelopes(cryptoutil.EndDeviceKeyEnvelopes(pb), r.KEKLabel, r.KeyVault); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+func (r *DeviceRegistry) unwrapKeys(pb *ttnpb.EndDevice) error {
+	return cryptoutil.UnwrapKeyEnvelopes(cryptoutil.EndDeviceKeyEnvelopes(pb), r.KEKLabel, r.KeyVault)
 }
 
 // GetByEUI gets device by joinEUI, devEUI.
@@ -56,6 +78,9 @@ func (r *DeviceRegistry) GetByEUI(ctx context.Context, joinEUI types.EUI64, devE
 	if err := ttnredis.GetProto(r.Redis, r.Redis.Key(joinEUI.String(), devEUI.String())).ScanProto(pb); err != nil {
 		return nil, err
 	}
+	if err := r.unwrapKeys(pb); err != nil {
+		return nil, err
+	}
 	return applyDeviceFieldMask(&ttnpb.EndDevice{}, pb, paths...)
 }
 
@@ -77,6 +102,8 @@ func (r *DeviceRegistry) SetByEUI(ctx context.Context, joinEUI types.EUI64, devE
 			stored = nil
 		} else if err != nil {
 			return err
+		} else if err := r.unwrapKeys(stored); err != nil {
+			return err
 		}
 
 		var err error
@@ -111,6 +138,8 @@ func (r *DeviceRegistry) SetByEUI(ctx context.Context, joinEUI types.EUI64, devE
 			stored = &ttnpb.EndDevice{}
 			if err := cmd.ScanProto(stored); err != nil && !errors.IsNotFound(err) {
 				return err
+			} else if err := r.unwrapKeys(stored); err != nil {
+				return err
 			}
 			stored, err = applyDeviceFieldMask(stored, pb, sets...)
 			if err != nil {
@@ -120,6 +149,10 @@ func (r *DeviceRegistry) SetByEUI(ctx context.Context, joinEUI types.EUI64, devE
 			if err != nil {
 				return err
 			}
+			stored, err = r.wrapKeys(stored)
+			if err != nil {
+				return err
+			}
 			f = func(p redis.Pipeliner) error {
 				_, err := ttnredis.SetProto(p, k, stored, 0)
 				return err
@@ -143,6 +176,18 @@ func (r *DeviceRegistry) SetByEUI(ctx context.Context, joinEUI types.EUI64, devE
 	return pb, nil
 }
 
+// RewrapKeys re-wraps the keys of all end devices from the old KEK label to the new KEK label.
+// RewrapKeys returns the number of updated end devices.
+func (r *DeviceRegistry) RewrapKeys(oldKEKLabel, newKEKLabel string) (int, error) {
+	return ttnredis.UpdateProtos(r.Redis, r.Redis.Key("*"), func(string) (proto.Message, func() (bool, error)) {
+		pb := &ttnpb.EndDevice{}
+		return pb, func() (bool, error) {
+			n, err := cryptoutil.RewrapKeyEnvelopes(cryptoutil.EndDeviceKeyEnvelopes(pb), oldKEKLabel, newKEKLabel, r.KeyVault)
+			return n > 0, err
+		}
+	})
+}
+
 func applyKeyFieldMask(dst, src *ttnpb.SessionKeys, paths ...string) (*ttnpb.SessionKeys, error) {
 	if dst == nil {
 		dst = &ttnpb.SessionKeys{}
@@ -151,8 +196,26 @@ func applyKeyFieldMask(dst, src *ttnpb.SessionKeys, paths ...string) (*ttnpb.Ses
 }
 
 // KeyRegistry is an implementation of joinserver.KeyRegistry.
+// If KEKLabel is set, the session keys are wrapped with the KEK label using KeyVault at rest.
 type KeyRegistry struct {
-	Redis *ttnredis.Client
+	Redis    *ttnredis.Client
+	KeyVault crypto.KeyVault
+	KEKLabel string
+}
+
+func (r *KeyRegistry) wrapKeys(pb *ttnpb.SessionKeys) (*ttnpb.SessionKeys, error) {
+	if r.KEKLabel == "" {
+		return pb, nil
+	}
+	pb = deepcopy.Copy(pb).(*ttnpb.SessionKeys)
+	if err := cryptoutil.WrapKeyEnvelopes(cryptoutil.SessionKeyEnvelopes(pb), r.KEKLabel, r.KeyVault); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+func (r *KeyRegistry) unwrapKeys(pb *ttnpb.SessionKeys) error {
+	return cryptoutil.UnwrapKeyEnvelopes(cryptoutil.SessionKeyEnvelopes(pb), r.KEKLabel, r.KeyVault)
 }
 
 // GetByID gets session keys by devEUI, id.
@@ -165,6 +228,9 @@ func (r *KeyRegistry) GetByID(ctx context.Context, devEUI types.EUI64, id []byte
 	if err := ttnredis.GetProto(r.Redis, r.Redis.Key(devEUI.String(), base64.RawStdEncoding.EncodeToString(id))).ScanProto(pb); err != nil {
 		return nil, err
 	}
+	if err := r.unwrapKeys(pb); err != nil {
+		return nil, err
+	}
 	return applyKeyFieldMask(&ttnpb.SessionKeys{}, pb, paths...)
 }
 
@@ -184,6 +250,8 @@ func (r *KeyRegistry) SetByID(ctx context.Context, devEUI types.EUI64, id []byte
 			stored = nil
 		} else if err != nil {
 			return err
+		} else if err := r.unwrapKeys(stored); err != nil {
+			return err
 		}
 
 		var err error
@@ -210,6 +278,8 @@ func (r *KeyRegistry) SetByID(ctx context.Context, devEUI types.EUI64, id []byte
 			stored = &ttnpb.SessionKeys{}
 			if err := cmd.ScanProto(stored); err != nil && !errors.IsNotFound(err) {
 				return err
+			} else if err := r.unwrapKeys(stored); err != nil {
+				return err
 			}
 			stored, err = applyKeyFieldMask(stored, pb, sets...)
 			if err != nil {
@@ -219,6 +289,10 @@ func (r *KeyRegistry) SetByID(ctx context.Context, devEUI types.EUI64, id []byte
 			if err != nil {
 				return err
 			}
+			stored, err = r.wrapKeys(stored)
+			if err != nil {
+				return err
+			}
 			f = func(p redis.Pipeliner) error {
 				_, err := ttnredis.SetProto(p, k, stored, 0)
 				return err
@@ -233,3 +307,15 @@ func (r *KeyRegistry) SetByID(ctx context.Context, devEUI types.EUI64, id []byte
 	}
 	return pb, nil
 }
+
+// RewrapKeys re-wraps all session keys from the old KEK label to the new KEK label.
+// RewrapKeys returns the number of updated session keys.
+func (r *KeyRegistry) RewrapKeys(oldKEKLabel, newKEKLabel string) (int, error) {
+	return ttnredis.UpdateProtos(r.Redis, r.Redis.Key("*"), func(string) (proto.Message, func() (bool, error)) {
+		pb := &ttnpb.SessionKeys{}
+		return pb, func() (bool, error) {
+			n, err := cryptoutil.RewrapKeyEnvelopes(cryptoutil.SessionKeyEnvelopes(pb), oldKEKLabel, newKEKLabel, r.KeyVault)
+			return n > 0, err
+		}
+	})
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/mohae/deepcopy"
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	. "go.thethings.network/lorawan-stack/pkg/joinserver"
 	"go.thethings.network/lorawan-stack/pkg/joinserver/redis"
@@ -45,6 +46,15 @@ func handleDeviceRegistryTest(t *testing.T, reg DeviceRegistry) {
 			JoinEUI: &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
 			DevEUI:  &types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
 		},
+		RootKeys: &ttnpb.RootKeys{
+			AppKey: &ttnpb.KeyEnvelope{
+				Key: []byte{0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff},
+			},
+			NwkKey: &ttnpb.KeyEnvelope{
+				Key:      []byte{0x1f, 0xa6, 0x8b, 0x0a, 0x81, 0x12, 0xb4, 0x47, 0xae, 0xf3, 0x4b, 0xd8, 0xfb, 0x5a, 0x7b, 0x82, 0x9d, 0x3e, 0x86, 0x23, 0x71, 0xd2, 0xcf, 0xe5},
+				KEKLabel: "other",
+			},
+		},
 	}
 
 	ret, err := reg.GetByEUI(ctx, *pb.EndDeviceIdentifiers.JoinEUI, *pb.EndDeviceIdentifiers.DevEUI, ttnpb.EndDeviceFieldPathsTopLevel)
@@ -145,6 +155,24 @@ func TestDeviceRegistries(t *testing.T) {
 			},
 			N: 8,
 		},
+		{
+			Name: "RedisWrapped",
+			New: func(t testing.TB) (DeviceRegistry, func() error) {
+				cl, flush := test.NewRedis(t, namespace[:]...)
+				reg := &redis.DeviceRegistry{
+					Redis: cl,
+					KeyVault: cryptoutil.NewMemKeyVault(map[string][]byte{
+						"registry": {0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+					}),
+					KEKLabel: "registry",
+				}
+				return reg, func() error {
+					flush()
+					return cl.Close()
+				}
+			},
+			N: 8,
+		},
 	} {
 		for i := 0; i < int(tc.N); i++ {
 			t.Run(fmt.Sprintf("%s/%d", tc.Name, i), func(t *testing.T) {
@@ -280,3 +308,150 @@ func TestSessionKeyRegistries(t *testing.T) {
 		}
 	}
 }
+
+func TestWrappedRegistries(t *testing.T) {
+	ctx := test.Context()
+
+	keyVault := cryptoutil.NewMemKeyVault(map[string][]byte{
+		"old": {0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+		"new": {0x0f, 0x0e, 0x0d, 0x0c, 0x0b, 0x0a, 0x09, 0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01, 0x00},
+	})
+	key := []byte{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	joinEUI := types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	devEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+	t.Run("DeviceRegistry", func(t *testing.T) {
+		a := assertions.New(t)
+
+		cl, flush := test.NewRedis(t, "joinserver_test", "devices")
+		defer func() {
+			flush()
+			cl.Close()
+		}()
+
+		reg := &redis.DeviceRegistry{
+			Redis:    cl,
+			KeyVault: keyVault,
+			KEKLabel: "old",
+		}
+		raw := &redis.DeviceRegistry{
+			Redis: cl,
+		}
+
+		_, err := CreateDevice(ctx, reg, &ttnpb.EndDevice{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				JoinEUI: &joinEUI,
+				DevEUI:  &devEUI,
+			},
+			RootKeys: &ttnpb.RootKeys{
+				AppKey: &ttnpb.KeyEnvelope{Key: key},
+				NwkKey: &ttnpb.KeyEnvelope{Key: key},
+			},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+
+		ret, err := raw.GetByEUI(ctx, joinEUI, devEUI, []string{"root_keys"})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		for _, ke := range []*ttnpb.KeyEnvelope{ret.RootKeys.AppKey, ret.RootKeys.NwkKey} {
+			a.So(ke.KEKLabel, should.Equal, "old")
+			a.So(ke.Key, should.NotResemble, key)
+		}
+
+		n, err := reg.RewrapKeys("old", "new")
+		a.So(err, should.BeNil)
+		a.So(n, should.Equal, 1)
+
+		ret, err = raw.GetByEUI(ctx, joinEUI, devEUI, []string{"root_keys"})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		for _, ke := range []*ttnpb.KeyEnvelope{ret.RootKeys.AppKey, ret.RootKeys.NwkKey} {
+			a.So(ke.KEKLabel, should.Equal, "new")
+			a.So(ke.Key, should.NotResemble, key)
+		}
+
+		n, err = reg.RewrapKeys("old", "new")
+		a.So(err, should.BeNil)
+		a.So(n, should.Equal, 0)
+
+		reg.KEKLabel = "new"
+		ret, err = reg.GetByEUI(ctx, joinEUI, devEUI, []string{"root_keys"})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(ret.RootKeys.AppKey, should.Resemble, &ttnpb.KeyEnvelope{Key: key})
+		a.So(ret.RootKeys.NwkKey, should.Resemble, &ttnpb.KeyEnvelope{Key: key})
+	})
+
+	t.Run("KeyRegistry", func(t *testing.T) {
+		a := assertions.New(t)
+
+		cl, flush := test.NewRedis(t, "joinserver_test", "keys")
+		defer func() {
+			flush()
+			cl.Close()
+		}()
+
+		reg := &redis.KeyRegistry{
+			Redis:    cl,
+			KeyVault: keyVault,
+			KEKLabel: "old",
+		}
+		raw := &redis.KeyRegistry{
+			Redis: cl,
+		}
+
+		pb := &ttnpb.SessionKeys{
+			SessionKeyID: []byte{0x11, 0x22, 0x33, 0x44},
+			FNwkSIntKey:  &ttnpb.KeyEnvelope{Key: key},
+			SNwkSIntKey:  &ttnpb.KeyEnvelope{Key: key},
+			NwkSEncKey:   &ttnpb.KeyEnvelope{Key: key},
+			AppSKey:      &ttnpb.KeyEnvelope{Key: key},
+		}
+
+		ret, err := CreateKeys(ctx, reg, devEUI, pb)
+		if !a.So(err, should.BeNil) || !a.So(ret, should.NotBeNil) {
+			t.FailNow()
+		}
+		a.So(ret, should.HaveEmptyDiff, pb)
+
+		ret, err = reg.GetByID(ctx, devEUI, pb.SessionKeyID, ttnpb.SessionKeysFieldPathsTopLevel)
+		a.So(err, should.BeNil)
+		a.So(ret, should.HaveEmptyDiff, pb)
+
+		ret, err = raw.GetByID(ctx, devEUI, pb.SessionKeyID, ttnpb.SessionKeysFieldPathsTopLevel)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		for _, ke := range []*ttnpb.KeyEnvelope{ret.FNwkSIntKey, ret.SNwkSIntKey, ret.NwkSEncKey, ret.AppSKey} {
+			a.So(ke.KEKLabel, should.Equal, "old")
+			a.So(ke.Key, should.NotResemble, key)
+		}
+
+		n, err := reg.RewrapKeys("old", "new")
+		a.So(err, should.BeNil)
+		a.So(n, should.Equal, 1)
+
+		ret, err = raw.GetByID(ctx, devEUI, pb.SessionKeyID, ttnpb.SessionKeysFieldPathsTopLevel)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		for _, ke := range []*ttnpb.KeyEnvelope{ret.FNwkSIntKey, ret.SNwkSIntKey, ret.NwkSEncKey, ret.AppSKey} {
+			a.So(ke.KEKLabel, should.Equal, "new")
+			a.So(ke.Key, should.NotResemble, key)
+		}
+
+		n, err = reg.RewrapKeys("old", "new")
+		a.So(err, should.BeNil)
+		a.So(n, should.Equal, 0)
+
+		reg.KEKLabel = "new"
+		ret, err = reg.GetByID(ctx, devEUI, pb.SessionKeyID, ttnpb.SessionKeysFieldPathsTopLevel)
+		a.So(err, should.BeNil)
+		a.So(ret, should.HaveEmptyDiff, pb)
+	})
+}
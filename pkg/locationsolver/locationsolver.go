@@ -0,0 +1,273 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package locationsolver estimates the location of an end device from the metadata of the gateways that received
+// an uplink message.
+//
+// When at least three gateways with known antenna locations report fine timestamps, the location is estimated by
+// time difference of arrival (TDoA) multilateration. Otherwise, the location is estimated as the RSSI-weighted
+// centroid of the receiving gateways.
+package locationsolver
+
+import (
+	"math"
+	"sort"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+const (
+	// Service is the name of the service that solves locations, used as key in the end device locations.
+	Service = "lora"
+
+	// speedOfLight is the propagation speed of radio waves in meters per nanosecond.
+	speedOfLight = 0.299792458
+
+	// earthRadius is the mean radius of the Earth in meters.
+	earthRadius = 6371008.8
+
+	// maxIterations is the maximum number of Gauss-Newton iterations for TDoA multilateration.
+	maxIterations = 50
+
+	// convergence is the step size in meters below which the TDoA multilateration is considered converged.
+	convergence = 0.01
+)
+
+var (
+	errNoGatewayLocations = errors.DefineFailedPrecondition("no_gateway_locations", "no gateway antenna locations in metadata")
+	errTooFewTimestamps   = errors.DefineFailedPrecondition("too_few_timestamps", "fine timestamps of `{count}` gateways is too few for TDoA, need at least `{min}`")
+	errNoConvergence      = errors.Define("no_convergence", "TDoA multilateration did not converge")
+)
+
+// point is a position in meters on a local tangent plane.
+type point struct {
+	x, y float64
+}
+
+// projection is a local equirectangular projection around a reference location.
+type projection struct {
+	lat0, lon0, cosLat0 float64
+}
+
+func newProjection(lat, lon float64) projection {
+	return projection{
+		lat0:    lat,
+		lon0:    lon,
+		cosLat0: math.Cos(lat * math.Pi / 180),
+	}
+}
+
+func (p projection) project(lat, lon float64) point {
+	return point{
+		x: (lon - p.lon0) * math.Pi / 180 * earthRadius * p.cosLat0,
+		y: (lat - p.lat0) * math.Pi / 180 * earthRadius,
+	}
+}
+
+func (p projection) unproject(pt point) (lat, lon float64) {
+	lat = p.lat0 + pt.y/earthRadius*180/math.Pi
+	lon = p.lon0 + pt.x/(earthRadius*p.cosLat0)*180/math.Pi
+	return
+}
+
+func distance(a, b point) float64 {
+	return math.Hypot(a.x-b.x, a.y-b.y)
+}
+
+// Distance returns the great-circle distance in meters between the given locations.
+func Distance(a, b ttnpb.Location) float64 {
+	lat1, lat2 := a.Latitude*math.Pi/180, b.Latitude*math.Pi/180
+	dLat, dLon := lat2-lat1, (b.Longitude-a.Longitude)*math.Pi/180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
+
+// gatewayLocations returns the metadata with antenna locations, keeping only the first metadata per location.
+func gatewayLocations(md []*ttnpb.RxMetadata) []*ttnpb.RxMetadata {
+	type key struct {
+		lat, lon float64
+	}
+	seen := make(map[key]bool, len(md))
+	res := make([]*ttnpb.RxMetadata, 0, len(md))
+	for _, m := range md {
+		if m == nil || m.Location == nil {
+			continue
+		}
+		k := key{m.Location.Latitude, m.Location.Longitude}
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		res = append(res, m)
+	}
+	return res
+}
+
+// centroid returns the mean latitude, longitude and altitude of the given metadata.
+func centroid(md []*ttnpb.RxMetadata) (lat, lon float64, alt int32) {
+	var sumAlt float64
+	for _, m := range md {
+		lat += m.Location.Latitude
+		lon += m.Location.Longitude
+		sumAlt += float64(m.Location.Altitude)
+	}
+	n := float64(len(md))
+	return lat / n, lon / n, int32(math.Round(sumAlt / n))
+}
+
+// Solve estimates the location by TDoA if enough fine timestamps are available, and falls back to the RSSI-weighted
+// centroid otherwise.
+func Solve(md []*ttnpb.RxMetadata) (*ttnpb.Location, error) {
+	if loc, err := SolveTDoA(md); err == nil {
+		return loc, nil
+	}
+	return SolveRSSI(md)
+}
+
+// SolveRSSI estimates the location as the centroid of the gateway antenna locations, weighted by the received
+// signal power.
+func SolveRSSI(md []*ttnpb.RxMetadata) (*ttnpb.Location, error) {
+	md = gatewayLocations(md)
+	if len(md) == 0 {
+		return nil, errNoGatewayLocations
+	}
+	lat0, lon0, _ := centroid(md)
+	proj := newProjection(lat0, lon0)
+
+	var sumW, sumAlt float64
+	var est point
+	pts := make([]point, len(md))
+	ws := make([]float64, len(md))
+	for i, m := range md {
+		// Weigh by the received power in mW.
+		w := math.Pow(10, float64(m.RSSI)/10)
+		pts[i] = proj.project(m.Location.Latitude, m.Location.Longitude)
+		ws[i] = w
+		est.x += w * pts[i].x
+		est.y += w * pts[i].y
+		sumAlt += w * float64(m.Location.Altitude)
+		sumW += w
+	}
+	est.x /= sumW
+	est.y /= sumW
+
+	var sumD float64
+	for i, pt := range pts {
+		sumD += ws[i] * distance(est, pt)
+	}
+	lat, lon := proj.unproject(est)
+	return &ttnpb.Location{
+		Latitude:  lat,
+		Longitude: lon,
+		Altitude:  int32(math.Round(sumAlt / sumW)),
+		Accuracy:  int32(math.Ceil(sumD / sumW)),
+		Source:    ttnpb.SOURCE_LORA_RSSI_GEOLOCATION,
+	}, nil
+}
+
+// SolveTDoA estimates the location by multilateration of the time differences of arrival, using the fine timestamps
+// of at least three gateways at distinct locations.
+func SolveTDoA(md []*ttnpb.RxMetadata) (*ttnpb.Location, error) {
+	md = gatewayLocations(md)
+	timestamped := make([]*ttnpb.RxMetadata, 0, len(md))
+	for _, m := range md {
+		if m.FineTimestamp != 0 {
+			timestamped = append(timestamped, m)
+		}
+	}
+	if len(timestamped) < 3 {
+		return nil, errTooFewTimestamps.WithAttributes(
+			"count", len(timestamped),
+			"min", 3,
+		)
+	}
+	sort.Slice(timestamped, func(i, j int) bool {
+		return timestamped[i].FineTimestamp < timestamped[j].FineTimestamp
+	})
+	md = timestamped
+
+	lat0, lon0, alt := centroid(md)
+	proj := newProjection(lat0, lon0)
+	pts := make([]point, len(md))
+	// ranges contains the range differences in meters to the reference gateway, which received the uplink first.
+	ranges := make([]float64, len(md))
+	for i, m := range md {
+		pts[i] = proj.project(m.Location.Latitude, m.Location.Longitude)
+		dt := int64(m.FineTimestamp) - int64(md[0].FineTimestamp)
+		// Fine timestamps are relative to the start of the second; correct for rollover.
+		if dt > 5e8 {
+			dt -= 1e9
+		}
+		ranges[i] = float64(dt) * speedOfLight
+	}
+
+	var est point
+	residuals := func(est point) []float64 {
+		d0 := distance(est, pts[0])
+		res := make([]float64, len(pts)-1)
+		for i := 1; i < len(pts); i++ {
+			res[i-1] = distance(est, pts[i]) - d0 - ranges[i]
+		}
+		return res
+	}
+	converged := false
+	for it := 0; it < maxIterations; it++ {
+		d0 := math.Max(distance(est, pts[0]), convergence)
+		// Solve the normal equations (JᵀJ)Δ = -Jᵀr of the linearized problem.
+		var jxx, jxy, jyy, rx, ry float64
+		for i := 1; i < len(pts); i++ {
+			di := math.Max(distance(est, pts[i]), convergence)
+			gx := (est.x-pts[i].x)/di - (est.x-pts[0].x)/d0
+			gy := (est.y-pts[i].y)/di - (est.y-pts[0].y)/d0
+			r := di - d0 - ranges[i]
+			jxx += gx * gx
+			jxy += gx * gy
+			jyy += gy * gy
+			rx += gx * r
+			ry += gy * r
+		}
+		det := jxx*jyy - jxy*jxy
+		if math.Abs(det) < 1e-12 {
+			break
+		}
+		step := point{
+			x: -(jyy*rx - jxy*ry) / det,
+			y: -(jxx*ry - jxy*rx) / det,
+		}
+		est.x += step.x
+		est.y += step.y
+		if math.Hypot(step.x, step.y) < convergence {
+			converged = true
+			break
+		}
+	}
+	if !converged || math.IsNaN(est.x) || math.IsNaN(est.y) {
+		return nil, errNoConvergence
+	}
+
+	var sumR float64
+	res := residuals(est)
+	for _, r := range res {
+		sumR += r * r
+	}
+	lat, lon := proj.unproject(est)
+	return &ttnpb.Location{
+		Latitude:  lat,
+		Longitude: lon,
+		Altitude:  alt,
+		Accuracy:  int32(math.Ceil(math.Sqrt(sumR / float64(len(res))))),
+		Source:    ttnpb.SOURCE_LORA_TDOA_GEOLOCATION,
+	}, nil
+}
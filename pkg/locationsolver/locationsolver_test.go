@@ -0,0 +1,140 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package locationsolver_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	. "go.thethings.network/lorawan-stack/pkg/locationsolver"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+var (
+	device   = ttnpb.Location{Latitude: 52.3740, Longitude: 4.8910}
+	gateways = []ttnpb.Location{
+		{Latitude: 52.3800, Longitude: 4.8800, Altitude: 10},
+		{Latitude: 52.3700, Longitude: 4.9100, Altitude: 20},
+		{Latitude: 52.3650, Longitude: 4.8750, Altitude: 30},
+		{Latitude: 52.3850, Longitude: 4.9050, Altitude: 40},
+	}
+)
+
+// metadata returns the metadata of the gateways receiving an uplink from device at fine timestamp t (nanoseconds).
+func metadata(t uint64, withFineTimestamps bool) []*ttnpb.RxMetadata {
+	md := make([]*ttnpb.RxMetadata, 0, len(gateways))
+	for i := range gateways {
+		loc := gateways[i]
+		d := Distance(device, loc)
+		m := &ttnpb.RxMetadata{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "test-gateway"},
+			Location:           &loc,
+			RSSI:               float32(-40 - 20*math.Log10(d)),
+		}
+		if withFineTimestamps {
+			m.FineTimestamp = (t + uint64(math.Round(d/0.299792458))) % 1e9
+		}
+		md = append(md, m)
+	}
+	return md
+}
+
+func TestDistance(t *testing.T) {
+	a := assertions.New(t)
+	a.So(Distance(device, device), should.Equal, 0)
+	a.So(Distance(ttnpb.Location{}, ttnpb.Location{Latitude: 1}), should.AlmostEqual, 111195, 1)
+	a.So(Distance(device, gateways[0]), should.AlmostEqual, Distance(gateways[0], device), 1e-6)
+}
+
+func TestSolveTDoA(t *testing.T) {
+	for _, tc := range []struct {
+		Name string
+		T    uint64
+	}{
+		{
+			Name: "Regular",
+			T:    123456789,
+		},
+		{
+			Name: "Rollover",
+			T:    1e9 - 2000,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			loc, err := SolveTDoA(metadata(tc.T, true))
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(loc.Source, should.Equal, ttnpb.SOURCE_LORA_TDOA_GEOLOCATION)
+			a.So(loc.Altitude, should.Equal, 25)
+			a.So(Distance(device, *loc), should.BeLessThan, 5)
+			a.So(loc.Accuracy, should.BeLessThan, 5)
+		})
+	}
+
+	t.Run("TooFewTimestamps", func(t *testing.T) {
+		a := assertions.New(t)
+		md := metadata(123456789, true)
+		md[0].FineTimestamp, md[1].FineTimestamp = 0, 0
+		_, err := SolveTDoA(md)
+		a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+	})
+}
+
+func TestSolveRSSI(t *testing.T) {
+	a := assertions.New(t)
+
+	_, err := SolveRSSI([]*ttnpb.RxMetadata{{RSSI: -100}})
+	a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+
+	loc, err := SolveRSSI([]*ttnpb.RxMetadata{
+		{Location: &ttnpb.Location{Latitude: 52.37, Longitude: 4.88, Altitude: 10}, RSSI: -80},
+		{Location: &ttnpb.Location{Latitude: 52.37, Longitude: 4.90, Altitude: 30}, RSSI: -80},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(loc.Source, should.Equal, ttnpb.SOURCE_LORA_RSSI_GEOLOCATION)
+	a.So(loc.Latitude, should.AlmostEqual, 52.37, 1e-6)
+	a.So(loc.Longitude, should.AlmostEqual, 4.89, 1e-6)
+	a.So(loc.Altitude, should.Equal, 20)
+	a.So(loc.Accuracy, should.BeBetween, 600, 700)
+
+	// The estimate is pulled towards the gateway with the strongest signal.
+	loc, err = SolveRSSI(metadata(0, false))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(Distance(gateways[0], *loc), should.BeLessThan, Distance(gateways[0], ttnpb.Location{Latitude: 52.375, Longitude: 4.8925}))
+}
+
+func TestSolve(t *testing.T) {
+	a := assertions.New(t)
+
+	loc, err := Solve(metadata(123456789, true))
+	a.So(err, should.BeNil)
+	a.So(loc.Source, should.Equal, ttnpb.SOURCE_LORA_TDOA_GEOLOCATION)
+
+	loc, err = Solve(metadata(123456789, false))
+	a.So(err, should.BeNil)
+	a.So(loc.Source, should.Equal, ttnpb.SOURCE_LORA_RSSI_GEOLOCATION)
+
+	_, err = Solve(nil)
+	a.So(err, should.NotBeNil)
+}
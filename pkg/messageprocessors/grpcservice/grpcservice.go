@@ -0,0 +1,350 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package grpcservice contains the payload formatter message processors that delegate encoding and decoding
+// to external gRPC services.
+//
+// The parameter of the payload formatter is the address of the service. It is either host:port, or a URL with
+// scheme grpc (insecure) or grpcs (TLS), for example grpcs://decoder.example.com:443?timeout=2s&parameter=sensor-v2.
+// The timeout query parameter overrides the default request timeout, and the parameter query parameter is passed
+// to the service as the parameter of the request.
+//
+// Connections are kept to a limited number of services; the connection to the least recently used service is closed
+// when the limit is exceeded. The hosts of the services can be restricted with an allow-list.
+package grpcservice
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/lru"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Config is the configuration of the gRPC service payload formatter.
+type Config struct {
+	// Timeout is the default timeout of requests to the service.
+	Timeout time.Duration
+	// TLS indicates whether TLS is used for services that are configured by host:port.
+	TLS bool
+	// FailureThreshold is the number of consecutive failures after which requests to the service are rejected.
+	// If zero, requests are never rejected.
+	FailureThreshold int
+	// ResetTimeout is the time after which a request to a service that is rejected is tried again.
+	ResetTimeout time.Duration
+	// MaxServices is the maximum number of services to keep connections to.
+	// If zero, DefaultMaxServices is used.
+	MaxServices int
+	// AllowedHosts are the hosts of the services that may be used. A host starting with a dot also allows the
+	// subdomains of the host. If empty, all hosts are allowed.
+	AllowedHosts []string
+	// DialOptions are additional options used to dial the services.
+	DialOptions []grpc.DialOption
+}
+
+// DefaultMaxServices is the default maximum number of services to keep connections to.
+const DefaultMaxServices = 64
+
+// allowed returns whether the given host is allowed.
+func (c Config) allowed(host string) bool {
+	if len(c.AllowedHosts) == 0 {
+		return true
+	}
+	host = strings.ToLower(host)
+	for _, allowed := range c.AllowedHosts {
+		allowed = strings.ToLower(allowed)
+		if host == allowed || strings.HasPrefix(allowed, ".") && (host == allowed[1:] || strings.HasSuffix(host, allowed)) {
+			return true
+		}
+	}
+	return false
+}
+
+type target struct {
+	address   string
+	tls       bool
+	timeout   time.Duration
+	parameter string
+}
+
+var (
+	errParameter      = errors.DefineInvalidArgument("parameter", "invalid parameter `{parameter}`")
+	errScheme         = errors.DefineInvalidArgument("scheme", "invalid scheme `{scheme}`")
+	errTimeout        = errors.DefineInvalidArgument("timeout", "invalid timeout `{timeout}`")
+	errHostNotAllowed = errors.DefineInvalidArgument("host_not_allowed", "host `{host}` is not allowed")
+)
+
+// checkHost returns an error if the host of the given address is not allowed.
+func checkHost(address string, conf Config) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+	if !conf.allowed(host) {
+		return errHostNotAllowed.WithAttributes("host", host)
+	}
+	return nil
+}
+
+func parseTarget(parameter string, conf Config) (*target, error) {
+	t := &target{
+		tls:     conf.TLS,
+		timeout: conf.Timeout,
+	}
+	if !strings.Contains(parameter, "://") {
+		if parameter == "" {
+			return nil, errParameter.WithAttributes("parameter", parameter)
+		}
+		t.address = parameter
+		if err := checkHost(t.address, conf); err != nil {
+			return nil, err
+		}
+		return t, nil
+	}
+	u, err := url.Parse(parameter)
+	if err != nil {
+		return nil, errParameter.WithCause(err).WithAttributes("parameter", parameter)
+	}
+	if u.Host == "" {
+		return nil, errParameter.WithAttributes("parameter", parameter)
+	}
+	switch u.Scheme {
+	case "grpc":
+		t.tls = false
+	case "grpcs":
+		t.tls = true
+	default:
+		return nil, errScheme.WithAttributes("scheme", u.Scheme)
+	}
+	t.address = u.Host
+	if err := checkHost(t.address, conf); err != nil {
+		return nil, err
+	}
+	query := u.Query()
+	if timeout := query.Get("timeout"); timeout != "" {
+		t.timeout, err = time.ParseDuration(timeout)
+		if err != nil || t.timeout <= 0 {
+			return nil, errTimeout.WithAttributes("timeout", timeout)
+		}
+	}
+	t.parameter = query.Get("parameter")
+	return t, nil
+}
+
+type service struct {
+	conn *grpc.ClientConn
+	// users is the number of requests using the connection, and evicted indicates whether the service is evicted.
+	// The connection is closed when the service is evicted and no longer used. Both are guarded by the host.
+	users   int
+	evicted bool
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+}
+
+var errCircuitOpen = errors.DefineUnavailable("circuit_open", "requests to service `{address}` are rejected after `{failures}` failures")
+
+// allow returns an error when requests to the service are rejected.
+// When the reset timeout has passed, a single request is allowed to test the service.
+func (s *service) allow(address string, conf Config) error {
+	if conf.FailureThreshold <= 0 {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failures < conf.FailureThreshold {
+		return nil
+	}
+	now := time.Now()
+	if now.Before(s.openUntil) {
+		return errCircuitOpen.WithAttributes("address", address, "failures", s.failures)
+	}
+	s.openUntil = now.Add(conf.ResetTimeout)
+	return nil
+}
+
+// report reports the result of a request to the service.
+// Invalid arguments are considered to be caused by the message and not by the service.
+func (s *service) report(err error, conf Config) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err == nil || errors.IsInvalidArgument(err) {
+		s.failures = 0
+		return
+	}
+	s.failures++
+	if conf.FailureThreshold > 0 && s.failures >= conf.FailureThreshold {
+		s.openUntil = time.Now().Add(conf.ResetTimeout)
+	}
+}
+
+type host struct {
+	ctx  context.Context
+	conf Config
+
+	mu       sync.Mutex
+	services *lru.Cache
+}
+
+// New returns a new payload encoder and decoder that delegates to gRPC services.
+// Connections to services are reused and closed when the service is evicted or when the context is done.
+// The returned value also implements messageprocessors.ParameterValidator to validate parameters.
+func New(ctx context.Context, conf Config) messageprocessors.PayloadEncodeDecoder {
+	if conf.MaxServices == 0 {
+		conf.MaxServices = DefaultMaxServices
+	}
+	h := &host{
+		ctx:  log.NewContextWithField(ctx, "namespace", "messageprocessors/grpcservice"),
+		conf: conf,
+	}
+	h.services = lru.New(conf.MaxServices, func(_ string, value interface{}) {
+		s := value.(*service)
+		s.evicted = true
+		if s.users == 0 {
+			s.conn.Close()
+		}
+	})
+	go func() {
+		<-ctx.Done()
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		h.services.Purge()
+	}()
+	return h
+}
+
+// acquire returns the service of the given target, dialing the service if there is no connection.
+// The service must be released when the request is done.
+func (h *host) acquire(t *target) (*service, error) {
+	key := t.address
+	if t.tls {
+		key = "tls:" + key
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if v, ok := h.services.Get(key); ok {
+		s := v.(*service)
+		s.users++
+		return s, nil
+	}
+	if err := h.ctx.Err(); err != nil {
+		return nil, err
+	}
+	opts := append(rpcclient.DefaultDialOptions(h.ctx), h.conf.DialOptions...)
+	if t.tls {
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(nil)))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	conn, err := grpc.DialContext(h.ctx, t.address, opts...)
+	if err != nil {
+		return nil, err
+	}
+	s := &service{conn: conn, users: 1}
+	h.services.Add(key, s)
+	return s, nil
+}
+
+// release releases the service, closing the connection if the service is evicted and no longer used.
+func (h *host) release(s *service) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s.users--
+	if s.evicted && s.users == 0 {
+		s.conn.Close()
+	}
+}
+
+// ValidateParameter implements messageprocessors.ParameterValidator.
+func (h *host) ValidateParameter(parameter string) error {
+	_, err := parseTarget(parameter, h.conf)
+	return err
+}
+
+func (h *host) call(ctx context.Context, parameter string, f func(context.Context, *grpc.ClientConn, string) error) error {
+	t, err := parseTarget(parameter, h.conf)
+	if err != nil {
+		return err
+	}
+	s, err := h.acquire(t)
+	if err != nil {
+		return err
+	}
+	defer h.release(s)
+	if err := s.allow(t.address, h.conf); err != nil {
+		return err
+	}
+	if t.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, t.timeout)
+		defer cancel()
+	}
+	err = f(ctx, s.conn, t.parameter)
+	s.report(err, h.conf)
+	return err
+}
+
+// Encode encodes the message's DecodedPayload to FRMPayload using the service.
+func (h *host) Encode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, parameter string) error {
+	if msg.DecodedPayload == nil {
+		return nil
+	}
+	return h.call(ctx, parameter, func(ctx context.Context, conn *grpc.ClientConn, parameter string) error {
+		req := &ttnpb.ProcessDownlinkMessageRequest{
+			EndDeviceIdentifiers: ids,
+			Message:              *msg,
+			Parameter:            parameter,
+		}
+		if version != nil {
+			req.EndDeviceVersionIDs = *version
+		}
+		res, err := ttnpb.NewDownlinkMessageProcessorClient(conn).Process(ctx, req)
+		if err != nil {
+			return err
+		}
+		msg.FRMPayload = res.FRMPayload
+		return nil
+	})
+}
+
+// Decode decodes the message's FRMPayload to DecodedPayload using the service.
+func (h *host) Decode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationUplink, parameter string) error {
+	return h.call(ctx, parameter, func(ctx context.Context, conn *grpc.ClientConn, parameter string) error {
+		req := &ttnpb.ProcessUplinkMessageRequest{
+			EndDeviceIdentifiers: ids,
+			Message:              *msg,
+			Parameter:            parameter,
+		}
+		if version != nil {
+			req.EndDeviceVersionIDs = *version
+		}
+		res, err := ttnpb.NewUplinkMessageProcessorClient(conn).Process(ctx, req)
+		if err != nil {
+			return err
+		}
+		msg.DecodedPayload = res.DecodedPayload
+		return nil
+	})
+}
@@ -0,0 +1,306 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcservice_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors/grpcservice"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+var errEmptyPayload = errors.DefineInvalidArgument("empty_payload", "empty payload")
+
+type mockProcessor struct{}
+
+func (mockProcessor) Encode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, parameter string) error {
+	msg.FRMPayload = []byte(fmt.Sprintf("%s:%s:%v", ids.DeviceID, parameter, msg.DecodedPayload.Fields["value"].GetNumberValue()))
+	return nil
+}
+
+func (mockProcessor) Decode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationUplink, parameter string) error {
+	switch {
+	case parameter == "slow":
+		<-ctx.Done()
+		return ctx.Err()
+	case len(msg.FRMPayload) == 0:
+		return errEmptyPayload
+	}
+	msg.DecodedPayload = &pbtypes.Struct{
+		Fields: map[string]*pbtypes.Value{
+			"device":    {Kind: &pbtypes.Value_StringValue{StringValue: ids.DeviceID}},
+			"parameter": {Kind: &pbtypes.Value_StringValue{StringValue: parameter}},
+			"model":     {Kind: &pbtypes.Value_StringValue{StringValue: version.ModelID}},
+			"length":    {Kind: &pbtypes.Value_NumberValue{NumberValue: float64(len(msg.FRMPayload))}},
+		},
+	}
+	return nil
+}
+
+// trackingListener counts the open connections that it accepted.
+type trackingListener struct {
+	net.Listener
+	open int32
+}
+
+func (l *trackingListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	atomic.AddInt32(&l.open, 1)
+	return &trackingConn{Conn: conn, l: l}, nil
+}
+
+type trackingConn struct {
+	net.Conn
+	l    *trackingListener
+	once sync.Once
+}
+
+func (c *trackingConn) Close() error {
+	c.once.Do(func() { atomic.AddInt32(&c.l.open, -1) })
+	return c.Conn.Close()
+}
+
+func startService(t *testing.T) (string, func()) {
+	addr, _, stop := startTrackedService(t)
+	return addr, stop
+}
+
+func startTrackedService(t *testing.T) (string, *trackingListener, func()) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	lis := &trackingListener{Listener: l}
+	srv := grpc.NewServer(
+		grpc.UnaryInterceptor(errors.UnaryServerInterceptor()),
+	)
+	ttnpb.RegisterUplinkMessageProcessorServer(srv, &messageprocessors.PayloadDecoderRPC{PayloadDecoder: mockProcessor{}})
+	ttnpb.RegisterDownlinkMessageProcessorServer(srv, &messageprocessors.PayloadEncoderRPC{PayloadEncoder: mockProcessor{}})
+	go srv.Serve(lis)
+	return lis.Addr().String(), lis, srv.Stop
+}
+
+func TestValidateParameter(t *testing.T) {
+	host := grpcservice.New(test.Context(), grpcservice.Config{})
+	validator := host.(messageprocessors.ParameterValidator)
+	for _, tc := range []struct {
+		Parameter string
+		OK        bool
+	}{
+		{Parameter: "localhost:1234", OK: true},
+		{Parameter: "grpc://localhost:1234", OK: true},
+		{Parameter: "grpcs://localhost:1234?timeout=2s&parameter=foo", OK: true},
+		{Parameter: ""},
+		{Parameter: "http://localhost:1234"},
+		{Parameter: "grpc://"},
+		{Parameter: "grpc://localhost:1234?timeout=foo"},
+		{Parameter: "grpc://localhost:1234?timeout=-1s"},
+	} {
+		t.Run(tc.Parameter, func(t *testing.T) {
+			a := assertions.New(t)
+			err := validator.ValidateParameter(tc.Parameter)
+			if tc.OK {
+				a.So(err, should.BeNil)
+			} else {
+				a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			}
+		})
+	}
+}
+
+func TestAllowedHosts(t *testing.T) {
+	host := grpcservice.New(test.Context(), grpcservice.Config{
+		AllowedHosts: []string{"localhost", ".example.com"},
+	})
+	validator := host.(messageprocessors.ParameterValidator)
+	for _, tc := range []struct {
+		Parameter string
+		OK        bool
+	}{
+		{Parameter: "localhost:1234", OK: true},
+		{Parameter: "grpcs://LOCALHOST:1234", OK: true},
+		{Parameter: "example.com:1234", OK: true},
+		{Parameter: "grpcs://decoder.example.com:443", OK: true},
+		{Parameter: "127.0.0.1:1234"},
+		{Parameter: "grpc://decoder.example.org:1234"},
+		{Parameter: "grpc://notexample.com:1234"},
+	} {
+		t.Run(tc.Parameter, func(t *testing.T) {
+			a := assertions.New(t)
+			err := validator.ValidateParameter(tc.Parameter)
+			if tc.OK {
+				a.So(err, should.BeNil)
+			} else {
+				a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			}
+		})
+	}
+}
+
+func TestMaxServices(t *testing.T) {
+	a := assertions.New(t)
+
+	addr1, lis1, stop1 := startTrackedService(t)
+	defer stop1()
+	addr2, lis2, stop2 := startTrackedService(t)
+	defer stop2()
+
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	host := grpcservice.New(ctx, grpcservice.Config{
+		Timeout:     time.Second,
+		MaxServices: 1,
+	})
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "foo-app",
+		},
+		DeviceID: "foo-device",
+	}
+	decode := func(addr string) error {
+		return host.Decode(ctx, ids, &ttnpb.EndDeviceVersionIdentifiers{}, &ttnpb.ApplicationUplink{FRMPayload: []byte{0x1}}, addr)
+	}
+	open := func(lis *trackingListener) int32 {
+		// Connections are closed asynchronously.
+		var n int32
+		for i := 0; i < 10; i++ {
+			if n = atomic.LoadInt32(&lis.open); n == 0 {
+				break
+			}
+			time.Sleep(test.Delay)
+		}
+		return n
+	}
+
+	a.So(decode(addr1), should.BeNil)
+	a.So(atomic.LoadInt32(&lis1.open), should.Equal, 1)
+
+	// Using the second service evicts the connection to the first service.
+	a.So(decode(addr2), should.BeNil)
+	a.So(open(lis1), should.Equal, 0)
+	a.So(atomic.LoadInt32(&lis2.open), should.Equal, 1)
+
+	// The first service is dialed again.
+	a.So(decode(addr1), should.BeNil)
+	a.So(open(lis2), should.Equal, 0)
+	a.So(atomic.LoadInt32(&lis1.open), should.Equal, 1)
+
+	// Connections are closed when the context is done.
+	cancel()
+	a.So(open(lis1), should.Equal, 0)
+}
+
+func TestGRPCService(t *testing.T) {
+	a := assertions.New(t)
+
+	addr, stop := startService(t)
+	defer stop()
+
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	resetTimeout := 10 * test.Delay
+	host := grpcservice.New(ctx, grpcservice.Config{
+		Timeout:          time.Second,
+		FailureThreshold: 2,
+		ResetTimeout:     resetTimeout,
+	})
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "foo-app",
+		},
+		DeviceID: "foo-device",
+	}
+	version := &ttnpb.EndDeviceVersionIdentifiers{
+		BrandID: "The Things Products",
+		ModelID: "The Things Uno",
+	}
+
+	// Decode using host:port.
+	{
+		up := &ttnpb.ApplicationUplink{FRMPayload: []byte{0x1, 0x2, 0x3}}
+		err := host.Decode(ctx, ids, version, up, addr)
+		if a.So(err, should.BeNil) {
+			a.So(up.DecodedPayload.Fields["device"].GetStringValue(), should.Equal, "foo-device")
+			a.So(up.DecodedPayload.Fields["model"].GetStringValue(), should.Equal, "The Things Uno")
+			a.So(up.DecodedPayload.Fields["parameter"].GetStringValue(), should.BeEmpty)
+			a.So(up.DecodedPayload.Fields["length"].GetNumberValue(), should.Equal, 3)
+		}
+	}
+
+	// Decode using URL with parameter.
+	{
+		up := &ttnpb.ApplicationUplink{FRMPayload: []byte{0x1}}
+		err := host.Decode(ctx, ids, version, up, fmt.Sprintf("grpc://%s?parameter=sensor", addr))
+		if a.So(err, should.BeNil) {
+			a.So(up.DecodedPayload.Fields["parameter"].GetStringValue(), should.Equal, "sensor")
+		}
+	}
+
+	// Encode.
+	{
+		down := &ttnpb.ApplicationDownlink{
+			DecodedPayload: &pbtypes.Struct{
+				Fields: map[string]*pbtypes.Value{
+					"value": {Kind: &pbtypes.Value_NumberValue{NumberValue: 42}},
+				},
+			},
+		}
+		err := host.Encode(ctx, ids, nil, down, fmt.Sprintf("grpc://%s?parameter=actuator", addr))
+		if a.So(err, should.BeNil) {
+			a.So(string(down.FRMPayload), should.Equal, "foo-device:actuator:42")
+		}
+	}
+
+	// Invalid arguments do not reject the service.
+	for i := 0; i < 3; i++ {
+		err := host.Decode(ctx, ids, version, &ttnpb.ApplicationUplink{}, addr)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	}
+
+	// Time out and reject the service after two failures.
+	slow := fmt.Sprintf("grpc://%s?parameter=slow&timeout=%s", addr, test.Delay)
+	for i := 0; i < 2; i++ {
+		err := host.Decode(ctx, ids, version, &ttnpb.ApplicationUplink{FRMPayload: []byte{0x1}}, slow)
+		a.So(errors.IsDeadlineExceeded(err), should.BeTrue)
+	}
+	err := host.Decode(ctx, ids, version, &ttnpb.ApplicationUplink{FRMPayload: []byte{0x1}}, addr)
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+
+	// Try the service again after the reset timeout.
+	time.Sleep(resetTimeout)
+	err = host.Decode(ctx, ids, version, &ttnpb.ApplicationUplink{FRMPayload: []byte{0x1}}, addr)
+	a.So(err, should.BeNil)
+	err = host.Decode(ctx, ids, version, &ttnpb.ApplicationUplink{FRMPayload: []byte{0x1}}, addr)
+	a.So(err, should.BeNil)
+}
@@ -35,3 +35,9 @@ type PayloadEncodeDecoder interface {
 	PayloadEncoder
 	PayloadDecoder
 }
+
+// ParameterValidator is implemented by payload encoders and decoders that can validate their parameter
+// before it is used.
+type ParameterValidator interface {
+	ValidateParameter(parameter string) error
+}
@@ -0,0 +1,425 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package schema contains the declarative binary schema payload formatter message processors.
+//
+// A schema defines the fields of a binary payload by their byte offset, bit offset, length in bits, endianness,
+// type, scaling and enum values. The same schema is used to decode uplink payloads and to encode downlink payloads.
+// Schemas are defined in YAML or JSON, for example:
+//
+//	fields:
+//	- name: temperature
+//	  offset: 0
+//	  length: 16
+//	  type: int
+//	  scale: 0.01
+//	- name: state
+//	  offset: 2
+//	  length: 2
+//	  type: enum
+//	  values:
+//	    idle: 0
+//	    active: 1
+//	    error: 3
+//	- name: alarm
+//	  offset: 2
+//	  bit: 7
+//	  length: 1
+//	  type: bool
+package schema
+
+import (
+	"context"
+	"math"
+	"sync"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gogoproto"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/lru"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Field types.
+const (
+	TypeUint = "uint"
+	TypeInt  = "int"
+	TypeBool = "bool"
+	TypeEnum = "enum"
+)
+
+// Endianness of fields.
+const (
+	BigEndian    = "big"
+	LittleEndian = "little"
+)
+
+// Field defines the position and interpretation of a field in the binary payload.
+type Field struct {
+	// Name is the name of the field in the decoded payload.
+	Name string `yaml:"name"`
+	// Offset is the offset of the field in bytes.
+	Offset uint `yaml:"offset"`
+	// Bit is the offset of the field in bits in the first byte, counted from the most significant bit.
+	Bit uint `yaml:"bit"`
+	// Length is the length of the field in bits. The default is 8.
+	Length uint `yaml:"length"`
+	// Endianness is the byte order of the field (big or little). The default is big.
+	// Little endian fields must be byte aligned.
+	Endianness string `yaml:"endianness"`
+	// Type is the type of the field (uint, int, bool or enum). The default is uint.
+	Type string `yaml:"type"`
+	// Scale is multiplied with integer values. The default is 1.
+	Scale float64 `yaml:"scale"`
+	// Bias is added to integer values after scaling.
+	Bias float64 `yaml:"bias"`
+	// Values maps the names of enum values to their raw values.
+	Values map[string]uint64 `yaml:"values"`
+}
+
+// Schema is a declarative binary payload schema.
+type Schema struct {
+	Fields []Field `yaml:"fields"`
+}
+
+var (
+	errSchema          = errors.DefineInvalidArgument("schema", "invalid schema")
+	errNoFields        = errors.DefineInvalidArgument("no_fields", "no fields in schema")
+	errFieldName       = errors.DefineInvalidArgument("field_name", "no name for field at index `{index}`")
+	errDuplicateField  = errors.DefineInvalidArgument("duplicate_field", "duplicate field `{name}`")
+	errFieldLength     = errors.DefineInvalidArgument("field_length", "invalid length `{length}` of field `{name}`")
+	errFieldBit        = errors.DefineInvalidArgument("field_bit", "invalid bit `{bit}` of field `{name}`")
+	errFieldEndianness = errors.DefineInvalidArgument("field_endianness", "invalid endianness `{endianness}` of field `{name}`")
+	errFieldType       = errors.DefineInvalidArgument("field_type", "invalid type `{type}` of field `{name}`")
+	errFieldValues     = errors.DefineInvalidArgument("field_values", "invalid values of enum field `{name}`")
+)
+
+func (f *Field) validate() error {
+	if f.Length == 0 {
+		f.Length = 8
+	}
+	if f.Length > 64 {
+		return errFieldLength.WithAttributes("length", f.Length, "name", f.Name)
+	}
+	if f.Bit > 7 {
+		return errFieldBit.WithAttributes("bit", f.Bit, "name", f.Name)
+	}
+	switch f.Endianness {
+	case "":
+		f.Endianness = BigEndian
+	case BigEndian:
+	case LittleEndian:
+		if f.Bit != 0 || f.Length%8 != 0 {
+			return errFieldEndianness.WithAttributes("endianness", f.Endianness, "name", f.Name)
+		}
+	default:
+		return errFieldEndianness.WithAttributes("endianness", f.Endianness, "name", f.Name)
+	}
+	switch f.Type {
+	case "":
+		f.Type = TypeUint
+	case TypeUint, TypeInt, TypeBool:
+	case TypeEnum:
+		if len(f.Values) == 0 {
+			return errFieldValues.WithAttributes("name", f.Name)
+		}
+		raws := make(map[uint64]bool, len(f.Values))
+		for _, raw := range f.Values {
+			if raws[raw] || f.Length < 64 && raw >= 1<<f.Length {
+				return errFieldValues.WithAttributes("name", f.Name)
+			}
+			raws[raw] = true
+		}
+	default:
+		return errFieldType.WithAttributes("type", f.Type, "name", f.Name)
+	}
+	if f.Scale == 0 {
+		f.Scale = 1
+	}
+	return nil
+}
+
+// Parse parses and validates the schema in YAML or JSON format.
+// Default values are set on fields that are not specified.
+func Parse(data string) (*Schema, error) {
+	var s Schema
+	if err := yaml.UnmarshalStrict([]byte(data), &s); err != nil {
+		return nil, errSchema.WithCause(err)
+	}
+	if len(s.Fields) == 0 {
+		return nil, errNoFields
+	}
+	names := make(map[string]bool, len(s.Fields))
+	for i := range s.Fields {
+		f := &s.Fields[i]
+		if f.Name == "" {
+			return nil, errFieldName.WithAttributes("index", i)
+		}
+		if names[f.Name] {
+			return nil, errDuplicateField.WithAttributes("name", f.Name)
+		}
+		names[f.Name] = true
+		if err := f.validate(); err != nil {
+			return nil, err
+		}
+	}
+	return &s, nil
+}
+
+// size returns the number of bytes that the field spans.
+func (f Field) size() int {
+	return int((f.Offset*8 + f.Bit + f.Length + 7) / 8)
+}
+
+// bits returns the bits of the field in big endian order.
+func (f Field) bits(payload []byte) []byte {
+	buf := payload[f.Offset:f.size()]
+	if f.Endianness == LittleEndian {
+		reversed := make([]byte, len(buf))
+		for i, b := range buf {
+			reversed[len(buf)-1-i] = b
+		}
+		buf = reversed
+	}
+	return buf
+}
+
+func (f Field) read(payload []byte) uint64 {
+	buf := f.bits(payload)
+	var raw uint64
+	for i := uint(0); i < f.Length; i++ {
+		pos := f.Bit + i
+		raw = raw<<1 | uint64(buf[pos/8]>>(7-pos%8)&1)
+	}
+	return raw
+}
+
+func (f Field) write(payload []byte, raw uint64) {
+	buf := make([]byte, f.size()-int(f.Offset))
+	mask := make([]byte, len(buf))
+	for i := uint(0); i < f.Length; i++ {
+		pos := f.Bit + f.Length - 1 - i
+		mask[pos/8] |= 1 << (7 - pos%8)
+		if raw>>i&1 == 1 {
+			buf[pos/8] |= 1 << (7 - pos%8)
+		}
+	}
+	if f.Endianness == LittleEndian {
+		for i, j := 0, len(buf)-1; i < j; i, j = i+1, j-1 {
+			buf[i], buf[j] = buf[j], buf[i]
+			mask[i], mask[j] = mask[j], mask[i]
+		}
+	}
+	for i := range buf {
+		payload[int(f.Offset)+i] = payload[int(f.Offset)+i]&^mask[i] | buf[i]
+	}
+}
+
+var errPayloadLength = errors.DefineInvalidArgument("payload_length", "payload length `{length}` is too short for field `{name}`")
+
+// Decode decodes the payload to a map of field names to values.
+func (s *Schema) Decode(payload []byte) (map[string]interface{}, error) {
+	m := make(map[string]interface{}, len(s.Fields))
+	for _, f := range s.Fields {
+		if len(payload) < f.size() {
+			return nil, errPayloadLength.WithAttributes("length", len(payload), "name", f.Name)
+		}
+		raw := f.read(payload)
+		switch f.Type {
+		case TypeBool:
+			m[f.Name] = raw != 0
+		case TypeEnum:
+			var name string
+			for n, v := range f.Values {
+				if v == raw {
+					name = n
+					break
+				}
+			}
+			if name != "" {
+				m[f.Name] = name
+			} else {
+				m[f.Name] = float64(raw)
+			}
+		case TypeInt:
+			value := int64(raw)
+			if f.Length < 64 && raw&(1<<(f.Length-1)) != 0 {
+				value -= 1 << f.Length
+			}
+			m[f.Name] = float64(value)*f.Scale + f.Bias
+		default:
+			m[f.Name] = float64(raw)*f.Scale + f.Bias
+		}
+	}
+	return m, nil
+}
+
+var (
+	errMissingField = errors.DefineInvalidArgument("missing_field", "missing value for field `{name}`")
+	errFieldValue   = errors.DefineInvalidArgument("field_value", "invalid value `{value}` for field `{name}`")
+)
+
+func (f Field) raw(value interface{}) (uint64, error) {
+	switch f.Type {
+	case TypeBool:
+		if v, ok := value.(bool); ok {
+			if v {
+				return 1, nil
+			}
+			return 0, nil
+		}
+	case TypeEnum:
+		if v, ok := value.(string); ok {
+			if raw, ok := f.Values[v]; ok {
+				return raw, nil
+			}
+		}
+	default:
+		v, ok := value.(float64)
+		if !ok {
+			break
+		}
+		v = math.Round((v - f.Bias) / f.Scale)
+		var low, high float64
+		if f.Type == TypeInt {
+			low, high = -math.Pow(2, float64(f.Length-1)), math.Pow(2, float64(f.Length-1))-1
+		} else {
+			low, high = 0, math.Pow(2, float64(f.Length))-1
+		}
+		if v < low || v > high {
+			break
+		}
+		var raw uint64
+		if v < 0 {
+			raw = uint64(int64(v))
+		} else {
+			raw = uint64(v)
+		}
+		if f.Length < 64 {
+			raw &= 1<<f.Length - 1
+		}
+		return raw, nil
+	}
+	return 0, errFieldValue.WithAttributes("value", value, "name", f.Name)
+}
+
+// Encode encodes the map of field names to values to a payload.
+func (s *Schema) Encode(m map[string]interface{}) ([]byte, error) {
+	var size int
+	for _, f := range s.Fields {
+		if n := f.size(); n > size {
+			size = n
+		}
+	}
+	payload := make([]byte, size)
+	for _, f := range s.Fields {
+		value, ok := m[f.Name]
+		if !ok {
+			return nil, errMissingField.WithAttributes("name", f.Name)
+		}
+		raw, err := f.raw(value)
+		if err != nil {
+			return nil, err
+		}
+		f.write(payload, raw)
+	}
+	return payload, nil
+}
+
+// cacheSize is the maximum number of parsed schemas that are cached.
+const cacheSize = 1024
+
+type host struct {
+	mu      sync.Mutex
+	schemas *lru.Cache
+}
+
+// New creates and returns a new schema payload encoder and decoder.
+// The parameter of the encoder and decoder is the schema. The returned value also implements
+// messageprocessors.ParameterValidator to validate schemas.
+// The most recently used schemas are cached.
+func New() messageprocessors.PayloadEncodeDecoder {
+	return &host{
+		schemas: lru.New(cacheSize, nil),
+	}
+}
+
+func (h *host) schema(parameter string) (*Schema, error) {
+	h.mu.Lock()
+	s, ok := h.schemas.Get(parameter)
+	h.mu.Unlock()
+	if ok {
+		return s.(*Schema), nil
+	}
+	parsed, err := Parse(parameter)
+	if err != nil {
+		return nil, err
+	}
+	h.mu.Lock()
+	h.schemas.Add(parameter, parsed)
+	h.mu.Unlock()
+	return parsed, nil
+}
+
+// ValidateParameter implements messageprocessors.ParameterValidator.
+func (h *host) ValidateParameter(parameter string) error {
+	_, err := h.schema(parameter)
+	return err
+}
+
+var (
+	errInput  = errors.DefineInvalidArgument("input", "invalid input")
+	errOutput = errors.Define("output", "invalid output")
+)
+
+// Encode encodes the message's DecodedPayload to FRMPayload using the given schema.
+func (h *host) Encode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, parameter string) error {
+	decoded := msg.DecodedPayload
+	if decoded == nil {
+		return nil
+	}
+	s, err := h.schema(parameter)
+	if err != nil {
+		return err
+	}
+	m, err := gogoproto.Map(decoded)
+	if err != nil {
+		return errInput.WithCause(err)
+	}
+	frmPayload, err := s.Encode(m)
+	if err != nil {
+		return errInput.WithCause(err)
+	}
+	msg.FRMPayload = frmPayload
+	return nil
+}
+
+// Decode decodes the message's FRMPayload to DecodedPayload using the given schema.
+func (h *host) Decode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationUplink, parameter string) error {
+	s, err := h.schema(parameter)
+	if err != nil {
+		return err
+	}
+	m, err := s.Decode(msg.FRMPayload)
+	if err != nil {
+		return errOutput.WithCause(err)
+	}
+	decoded, err := gogoproto.Struct(m)
+	if err != nil {
+		return errOutput.WithCause(err)
+	}
+	msg.DecodedPayload = decoded
+	return nil
+}
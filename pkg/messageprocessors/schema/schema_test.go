@@ -0,0 +1,251 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema_test
+
+import (
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors/schema"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+const testSchema = `
+fields:
+- name: temperature
+  offset: 0
+  length: 16
+  type: int
+  scale: 0.5
+- name: state
+  offset: 2
+  length: 2
+  type: enum
+  values:
+    idle: 0
+    active: 1
+    error: 3
+- name: level
+  offset: 2
+  bit: 2
+  length: 5
+- name: alarm
+  offset: 2
+  bit: 7
+  length: 1
+  type: bool
+- name: counter
+  offset: 3
+  length: 16
+  endianness: little
+- name: voltage
+  offset: 5
+  scale: 0.01
+  bias: 2
+`
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		Name      string
+		Schema    string
+		Assertion func(error) bool
+	}{
+		{
+			Name:      "Valid",
+			Schema:    testSchema,
+			Assertion: func(err error) bool { return err == nil },
+		},
+		{
+			Name:      "ValidJSON",
+			Schema:    `{"fields":[{"name":"a","length":12},{"name":"b","offset":1,"bit":4,"length":4,"type":"int"}]}`,
+			Assertion: func(err error) bool { return err == nil },
+		},
+		{
+			Name:      "Syntax",
+			Schema:    `fields: [`,
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:      "UnknownKey",
+			Schema:    `{"fields":[{"name":"a","size":8}]}`,
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:      "NoFields",
+			Schema:    `fields: []`,
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:      "NoName",
+			Schema:    `{"fields":[{"offset":1}]}`,
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:      "DuplicateName",
+			Schema:    `{"fields":[{"name":"a"},{"name":"a","offset":1}]}`,
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:      "Length",
+			Schema:    `{"fields":[{"name":"a","length":65}]}`,
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:      "Bit",
+			Schema:    `{"fields":[{"name":"a","bit":8}]}`,
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:      "LittleEndianUnaligned",
+			Schema:    `{"fields":[{"name":"a","length":12,"endianness":"little"}]}`,
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:      "Endianness",
+			Schema:    `{"fields":[{"name":"a","endianness":"middle"}]}`,
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:      "Type",
+			Schema:    `{"fields":[{"name":"a","type":"string"}]}`,
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:      "EnumNoValues",
+			Schema:    `{"fields":[{"name":"a","type":"enum"}]}`,
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:      "EnumValueTooLarge",
+			Schema:    `{"fields":[{"name":"a","type":"enum","length":2,"values":{"x":4}}]}`,
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:      "EnumDuplicateValue",
+			Schema:    `{"fields":[{"name":"a","type":"enum","values":{"x":1,"y":1}}]}`,
+			Assertion: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			_, err := schema.Parse(tc.Schema)
+			a.So(tc.Assertion(err), should.BeTrue)
+		})
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	a := assertions.New(t)
+
+	s, err := schema.Parse(testSchema)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	payload := []byte{
+		0xff, 0xce, // temperature: -50 * 0.5
+		0x55,       // state: 01 (active), level: 01010 (10), alarm: 1
+		0x34, 0x12, // counter: 0x1234
+		0x64, // voltage: 100 * 0.01 + 2
+	}
+	m, err := s.Decode(payload)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(m, should.Resemble, map[string]interface{}{
+		"temperature": -25.0,
+		"state":       "active",
+		"level":       10.0,
+		"alarm":       true,
+		"counter":     4660.0,
+		"voltage":     3.0,
+	})
+
+	encoded, err := s.Encode(m)
+	a.So(err, should.BeNil)
+	a.So(encoded, should.Resemble, payload)
+
+	_, err = s.Decode(payload[:4])
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	for _, m := range []map[string]interface{}{
+		{"temperature": -25.0, "state": "active", "level": 10.0, "alarm": true, "counter": 4660.0},
+		{"temperature": -25.0, "state": "unknown", "level": 10.0, "alarm": true, "counter": 4660.0, "voltage": 3.0},
+		{"temperature": -25.0, "state": "active", "level": 32.0, "alarm": true, "counter": 4660.0, "voltage": 3.0},
+		{"temperature": 20000.0, "state": "active", "level": 10.0, "alarm": true, "counter": 4660.0, "voltage": 3.0},
+		{"temperature": -25.0, "state": "active", "level": 10.0, "alarm": "yes", "counter": 4660.0, "voltage": 3.0},
+		{"temperature": -25.0, "state": "active", "level": 10.0, "alarm": true, "counter": 4660.0, "voltage": 1.0},
+	} {
+		_, err := s.Encode(m)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	}
+}
+
+func TestHost(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+	host := schema.New()
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "foo-app",
+		},
+		DeviceID: "foo-device",
+	}
+
+	validator, ok := host.(messageprocessors.ParameterValidator)
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	a.So(validator.ValidateParameter(testSchema), should.BeNil)
+	a.So(errors.IsInvalidArgument(validator.ValidateParameter(`fields: []`)), should.BeTrue)
+
+	up := &ttnpb.ApplicationUplink{
+		FRMPayload: []byte{0x00, 0x14, 0x00, 0x01, 0x00, 0x00},
+	}
+	err := host.Decode(ctx, ids, nil, up, testSchema)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(up.DecodedPayload.Fields["temperature"].GetNumberValue(), should.Equal, 10.0)
+	a.So(up.DecodedPayload.Fields["state"].GetStringValue(), should.Equal, "idle")
+	a.So(up.DecodedPayload.Fields["counter"].GetNumberValue(), should.Equal, 1.0)
+	a.So(up.DecodedPayload.Fields["voltage"].GetNumberValue(), should.Equal, 2.0)
+
+	err = host.Decode(ctx, ids, nil, &ttnpb.ApplicationUplink{FRMPayload: []byte{0x01}}, testSchema)
+	a.So(err, should.NotBeNil)
+
+	down := &ttnpb.ApplicationDownlink{
+		DecodedPayload: up.DecodedPayload,
+	}
+	err = host.Encode(ctx, ids, nil, down, testSchema)
+	a.So(err, should.BeNil)
+	a.So(down.FRMPayload, should.Resemble, up.FRMPayload)
+
+	down = &ttnpb.ApplicationDownlink{
+		DecodedPayload: &pbtypes.Struct{
+			Fields: map[string]*pbtypes.Value{
+				"temperature": {Kind: &pbtypes.Value_NumberValue{NumberValue: 10}},
+			},
+		},
+	}
+	err = host.Encode(ctx, ids, nil, down, testSchema)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
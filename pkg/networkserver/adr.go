@@ -15,10 +15,64 @@
 package networkserver
 
 import (
-	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"context"
+	"sort"
+
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
+// DefaultADRAlgorithm is the name of the ADR algorithm used for devices, which do not specify one in MACSettings.
+const DefaultADRAlgorithm = "max-snr"
+
+// ADRAlgorithm represents an adaptive data rate algorithm.
+type ADRAlgorithm interface {
+	// AdaptDataRate sets the desired ADR parameters of dev.MACState
+	// based on dev.RecentADRUplinks and the band b the device operates in.
+	AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, b band.Band) error
+}
+
+// ADRAlgorithmFunc is a function, which implements ADRAlgorithm.
+type ADRAlgorithmFunc func(ctx context.Context, dev *ttnpb.EndDevice, b band.Band) error
+
+// AdaptDataRate implements ADRAlgorithm.
+func (f ADRAlgorithmFunc) AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, b band.Band) error {
+	return f(ctx, dev, b)
+}
+
+// MaxSNRADRAlgorithm is the default ADRAlgorithm, which computes the link margin
+// using the maximum SNR of the recent uplinks and controls NbTrans based on the observed loss rate.
+var MaxSNRADRAlgorithm ADRAlgorithm = ADRAlgorithmFunc(adaptDataRateMaxSNR)
+
+// AverageSNRADRAlgorithm is an ADRAlgorithm, which computes the link margin using the average
+// of the best SNR of each recent uplink. It is less optimistic than MaxSNRADRAlgorithm for devices
+// with fluctuating link quality, like mobile trackers.
+var AverageSNRADRAlgorithm ADRAlgorithm = ADRAlgorithmFunc(adaptDataRateAverageSNR)
+
+// PercentileSNRADRAlgorithm returns an ADRAlgorithm, which computes the link margin using the given
+// percentile (0-100) of the best SNR of each recent uplink.
+func PercentileSNRADRAlgorithm(percentile float32) ADRAlgorithm {
+	return ADRAlgorithmFunc(func(ctx context.Context, dev *ttnpb.EndDevice, b band.Band) error {
+		return adaptDataRate(dev, b, func(ups []*ttnpb.UplinkMessage) float32 {
+			return percentileSNR(ups, percentile)
+		}, adaptNbTransLossRate)
+	})
+}
+
+// LossAwareADRAlgorithm is an ADRAlgorithm, which computes the link margin like MaxSNRADRAlgorithm,
+// but controls NbTrans stepwise based on the observed loss rate: NbTrans is increased while
+// the loss rate is above 10% and decreased while the loss rate is below 2%.
+var LossAwareADRAlgorithm ADRAlgorithm = ADRAlgorithmFunc(adaptDataRateLossAware)
+
+// builtinADRAlgorithms are the ADR algorithms that are registered by default.
+var builtinADRAlgorithms = map[string]ADRAlgorithm{
+	DefaultADRAlgorithm: MaxSNRADRAlgorithm,
+	"average-snr":       AverageSNRADRAlgorithm,
+	"percentile-snr":    PercentileSNRADRAlgorithm(25),
+	"loss-aware":        LossAwareADRAlgorithm,
+}
+
 // TODO: The values for BW250 and BW500 need to be verified
 // (https://github.com/TheThingsNetwork/lorawan-stack/issues/21)
 
@@ -72,24 +126,152 @@ const maxNbTrans = 3
 // optimalADRUplinkCount is the amount of uplinks required to ensure optimal results from the ADR algorithm.
 const optimalADRUplinkCount = 20
 
-func adaptDataRate(dev *ttnpb.EndDevice, fps *frequencyplans.Store) error {
-	ups := dev.RecentADRUplinks
-	if len(ups) == 0 {
-		return nil
+// adaptDataRate adapts the data rate of dev using the ADRAlgorithm selected in dev.MACSettings.
+// If the selected algorithm is not registered, the default algorithm is used.
+func (ns *NetworkServer) adaptDataRate(ctx context.Context, dev *ttnpb.EndDevice) error {
+	alg, err := ns.adrAlgorithm(dev.MACSettings.GetADRAlgorithm())
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to get ADR algorithm, use default")
+		alg, err = ns.adrAlgorithm("")
+		if err != nil {
+			return err
+		}
 	}
+	_, band, err := getDeviceBandVersion(dev, ns.FrequencyPlans)
+	if err != nil {
+		return err
+	}
+	return alg.AdaptDataRate(ctx, dev, band)
+}
+
+// adrAlgorithm returns the ADRAlgorithm registered under name.
+// If name is empty, the algorithm registered under DefaultADRAlgorithm is returned.
+func (ns *NetworkServer) adrAlgorithm(name string) (ADRAlgorithm, error) {
+	if name == "" {
+		name = DefaultADRAlgorithm
+	}
+	alg, ok := ns.adrAlgorithms.Load(name)
+	if !ok {
+		return nil, errADRAlgorithmNotFound.WithAttributes("name", name)
+	}
+	return alg.(ADRAlgorithm), nil
+}
+
+// uplinkMaxSNR returns the best SNR of all gateways that received up.
+func uplinkMaxSNR(up *ttnpb.UplinkMessage) float32 {
+	maxSNR := up.RxMetadata[0].SNR
+	for _, md := range up.RxMetadata[1:] {
+		if md.SNR > maxSNR {
+			maxSNR = md.SNR
+		}
+	}
+	return maxSNR
+}
+
+func maxSNR(ups []*ttnpb.UplinkMessage) float32 {
+	maxSNR := uplinkMaxSNR(ups[0])
+	for _, up := range ups[1:] {
+		if snr := uplinkMaxSNR(up); snr > maxSNR {
+			maxSNR = snr
+		}
+	}
+	return maxSNR
+}
 
-	maxSNR := ups[0].RxMetadata[0].SNR
+func averageSNR(ups []*ttnpb.UplinkMessage) float32 {
+	var sum float32
 	for _, up := range ups {
-		for _, md := range up.RxMetadata {
-			if md.SNR > maxSNR {
-				maxSNR = md.SNR
-			}
+		sum += uplinkMaxSNR(up)
+	}
+	return sum / float32(len(ups))
+}
+
+func percentileSNR(ups []*ttnpb.UplinkMessage, percentile float32) float32 {
+	snrs := make([]float64, len(ups))
+	for i, up := range ups {
+		snrs[i] = float64(uplinkMaxSNR(up))
+	}
+	sort.Float64s(snrs)
+	i := int(percentile / 100 * float32(len(snrs)-1))
+	switch {
+	case i < 0:
+		i = 0
+	case i >= len(snrs):
+		i = len(snrs) - 1
+	}
+	return float32(snrs[i])
+}
+
+// uplinkLossRate returns the ratio of lost uplinks based on the frame counters of ups.
+// Duplicate frame counters are ignored and only the uplinks since the last frame counter reset are considered.
+func uplinkLossRate(ups []*ttnpb.UplinkMessage) float32 {
+	first := ups[0].Payload.GetMACPayload().FHDR.FCnt
+	last, n := first, uint32(1)
+	for _, up := range ups[1:] {
+		fCnt := up.Payload.GetMACPayload().FHDR.FCnt
+		switch {
+		case fCnt == last:
+			continue
+		case fCnt < last:
+			first, n = fCnt, 0
 		}
+		last = fCnt
+		n++
+	}
+	expected := last - first + 1
+	if n >= expected {
+		return 0
 	}
+	return float32(expected-n) / float32(expected)
+}
 
-	_, band, err := getDeviceBandVersion(dev, fps)
-	if err != nil {
-		return err
+func adaptDataRateMaxSNR(ctx context.Context, dev *ttnpb.EndDevice, band band.Band) error {
+	return adaptDataRate(dev, band, maxSNR, adaptNbTransLossRate)
+}
+
+func adaptDataRateAverageSNR(ctx context.Context, dev *ttnpb.EndDevice, band band.Band) error {
+	return adaptDataRate(dev, band, averageSNR, adaptNbTransLossRate)
+}
+
+func adaptDataRateLossAware(ctx context.Context, dev *ttnpb.EndDevice, band band.Band) error {
+	return adaptDataRate(dev, band, maxSNR, adaptNbTransStepwise)
+}
+
+// adaptNbTransLossRate sets the desired NbTrans based on the loss rate of ups.
+func adaptNbTransLossRate(dev *ttnpb.EndDevice, ups []*ttnpb.UplinkMessage) {
+	if len(ups) < 2 {
+		return
+	}
+	switch lossRate := uplinkLossRate(ups); {
+	case lossRate < 0.05:
+		dev.MACState.DesiredParameters.ADRNbTrans = 1 + dev.MACState.DesiredParameters.ADRNbTrans/3
+	case lossRate < 0.10:
+	case lossRate < 0.30:
+		dev.MACState.DesiredParameters.ADRNbTrans = 2 + dev.MACState.DesiredParameters.ADRNbTrans/2
+	default:
+		dev.MACState.DesiredParameters.ADRNbTrans = maxNbTrans
+	}
+}
+
+// adaptNbTransStepwise increases or decreases the desired NbTrans by one based on the loss rate of ups.
+func adaptNbTransStepwise(dev *ttnpb.EndDevice, ups []*ttnpb.UplinkMessage) {
+	if len(ups) < 2 {
+		return
+	}
+	switch lossRate := uplinkLossRate(ups); {
+	case lossRate > 0.10 && dev.MACState.DesiredParameters.ADRNbTrans < maxNbTrans:
+		dev.MACState.DesiredParameters.ADRNbTrans++
+	case lossRate < 0.02 && dev.MACState.DesiredParameters.ADRNbTrans > 1:
+		dev.MACState.DesiredParameters.ADRNbTrans--
+	}
+}
+
+// adaptDataRate sets the desired ADR parameters of dev.MACState using the SNR computed by snr
+// over dev.RecentADRUplinks and the NbTrans controller adaptNbTrans.
+func adaptDataRate(dev *ttnpb.EndDevice, band band.Band, snr func([]*ttnpb.UplinkMessage) float32, adaptNbTrans func(*ttnpb.EndDevice, []*ttnpb.UplinkMessage)) error {
+	ups := dev.RecentADRUplinks
+	if len(ups) == 0 {
+		return nil
 	}
 
 	up := ups[len(ups)-1]
@@ -111,7 +293,7 @@ func adaptDataRate(dev *ttnpb.EndDevice, fps *frequencyplans.Store) error {
 	// minimum (floor) that we need to demodulate the signal. We subtract a
 	// configurable margin, and an extra safety margin if we're afraid that we
 	// don't have enough data for our decision.
-	margin := maxSNR - df - float32(dev.MACSettings.ADRMargin)
+	margin := snr(ups) - df - float32(dev.MACSettings.ADRMargin)
 	if len(ups) < optimalADRUplinkCount {
 		margin -= safetyMargin
 	}
@@ -139,22 +321,12 @@ func adaptDataRate(dev *ttnpb.EndDevice, fps *frequencyplans.Store) error {
 	}
 
 	dev.MACState.DesiredParameters.ADRNbTrans = dev.MACState.CurrentParameters.ADRNbTrans
-	if dev.MACState.DesiredParameters.ADRNbTrans > maxNbTrans {
+	switch {
+	case dev.MACState.DesiredParameters.ADRNbTrans == 0:
+		dev.MACState.DesiredParameters.ADRNbTrans = 1
+	case dev.MACState.DesiredParameters.ADRNbTrans > maxNbTrans:
 		dev.MACState.DesiredParameters.ADRNbTrans = maxNbTrans
 	}
-
-	if len(ups) >= 2 {
-		lossRate := float32(up.Payload.GetMACPayload().FHDR.FCnt-ups[0].Payload.GetMACPayload().FHDR.FCnt-uint32(len(ups))) /
-			float32(up.Payload.GetMACPayload().FHDR.FCnt-ups[0].Payload.GetMACPayload().FHDR.FCnt)
-		switch {
-		case lossRate < 0.05:
-			dev.MACState.DesiredParameters.ADRNbTrans = 1 + dev.MACState.DesiredParameters.ADRNbTrans/3
-		case lossRate < 0.10:
-		case lossRate < 0.30:
-			dev.MACState.DesiredParameters.ADRNbTrans = 2 + dev.MACState.DesiredParameters.ADRNbTrans/2
-		default:
-			dev.MACState.DesiredParameters.ADRNbTrans = maxNbTrans
-		}
-	}
+	adaptNbTrans(dev, ups)
 	return nil
 }
@@ -15,11 +15,16 @@
 package networkserver
 
 import (
+	"context"
 	"math"
 	"math/rand"
+	"sync"
 	"testing"
 
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
@@ -80,81 +85,142 @@ func adrMatrixToUplinks(m []adrMatrixRow) (ups []*ttnpb.UplinkMessage) {
 	return
 }
 
+// newSemtechADRDevice returns a device with the uplinks of the example in the Semtech ADR paper.
+func newSemtechADRDevice() *ttnpb.EndDevice {
+	return &ttnpb.EndDevice{
+		LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+		MACState: &ttnpb.MACState{
+			CurrentParameters: ttnpb.MACParameters{
+				ADRDataRateIndex: 0,
+				ADRNbTrans:       0,
+				ADRTxPowerIndex:  1,
+			},
+			DesiredParameters: ttnpb.MACParameters{
+				ADRDataRateIndex: 5,
+				ADRNbTrans:       3,
+				ADRTxPowerIndex:  2,
+			},
+		},
+		MACSettings: &ttnpb.MACSettings{
+			ADRMargin: 2,
+		},
+		FrequencyPlanID: test.EUFrequencyPlanID,
+		RecentADRUplinks: adrMatrixToUplinks([]adrMatrixRow{
+			{FCnt: 10, MaxSNR: -6, GtwDiversity: 2},
+			{FCnt: 11, MaxSNR: -7, GtwDiversity: 2},
+			{FCnt: 12, MaxSNR: -25, GtwDiversity: 1},
+			{FCnt: 13, MaxSNR: -25, GtwDiversity: 1},
+			{FCnt: 14, MaxSNR: -10, GtwDiversity: 2},
+			{FCnt: 16, MaxSNR: -25, GtwDiversity: 1},
+			{FCnt: 17, MaxSNR: -10, GtwDiversity: 2},
+			{FCnt: 19, MaxSNR: -10, GtwDiversity: 3},
+			{FCnt: 20, MaxSNR: -6, GtwDiversity: 2},
+			{FCnt: 21, MaxSNR: -7, GtwDiversity: 2},
+			{FCnt: 22, MaxSNR: -25, GtwDiversity: 1},
+			{FCnt: 23, MaxSNR: -25, GtwDiversity: 1},
+			{FCnt: 24, MaxSNR: -10, GtwDiversity: 2},
+			{FCnt: 25, MaxSNR: -10, GtwDiversity: 2},
+			{FCnt: 26, MaxSNR: -25, GtwDiversity: 1},
+			{FCnt: 27, MaxSNR: -8, GtwDiversity: 2},
+			{FCnt: 28, MaxSNR: -10, GtwDiversity: 2},
+			{FCnt: 29, MaxSNR: -10, GtwDiversity: 3},
+			{FCnt: 30, MaxSNR: -9, GtwDiversity: 3},
+			{FCnt: 31, MaxSNR: -7, GtwDiversity: 2,
+				TxSettings: ttnpb.TxSettings{
+					DataRate: ttnpb.DataRate{
+						Modulation: &ttnpb.DataRate_LoRa{
+							LoRa: &ttnpb.LoRaDataRate{
+								SpreadingFactor: 12,
+								Bandwidth:       125000,
+							},
+						},
+					},
+					DataRateIndex: 0,
+				},
+			},
+		}),
+	}
+}
+
 func TestAdaptDataRate(t *testing.T) {
 	for _, tc := range []struct {
 		Name       string
+		Algorithm  ADRAlgorithm
 		Device     *ttnpb.EndDevice
 		DeviceDiff func(*ttnpb.EndDevice)
 		Error      error
 	}{
 		{
-			Name: "adapted example from Semtech paper",
-			Device: &ttnpb.EndDevice{
-				LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
-				MACState: &ttnpb.MACState{
-					CurrentParameters: ttnpb.MACParameters{
-						ADRDataRateIndex: 0,
-						ADRNbTrans:       0,
-						ADRTxPowerIndex:  1,
-					},
-					DesiredParameters: ttnpb.MACParameters{
-						ADRDataRateIndex: 5,
-						ADRNbTrans:       3,
-						ADRTxPowerIndex:  2,
-					},
-				},
-				MACSettings: &ttnpb.MACSettings{
-					ADRMargin: 2,
-				},
-				FrequencyPlanID: test.EUFrequencyPlanID,
-				RecentADRUplinks: adrMatrixToUplinks([]adrMatrixRow{
-					{FCnt: 10, MaxSNR: -6, GtwDiversity: 2},
-					{FCnt: 11, MaxSNR: -7, GtwDiversity: 2},
-					{FCnt: 12, MaxSNR: -25, GtwDiversity: 1},
-					{FCnt: 13, MaxSNR: -25, GtwDiversity: 1},
-					{FCnt: 14, MaxSNR: -10, GtwDiversity: 2},
-					{FCnt: 16, MaxSNR: -25, GtwDiversity: 1},
-					{FCnt: 17, MaxSNR: -10, GtwDiversity: 2},
-					{FCnt: 19, MaxSNR: -10, GtwDiversity: 3},
-					{FCnt: 20, MaxSNR: -6, GtwDiversity: 2},
-					{FCnt: 21, MaxSNR: -7, GtwDiversity: 2},
-					{FCnt: 22, MaxSNR: -25, GtwDiversity: 1},
-					{FCnt: 23, MaxSNR: -25, GtwDiversity: 1},
-					{FCnt: 24, MaxSNR: -10, GtwDiversity: 2},
-					{FCnt: 25, MaxSNR: -10, GtwDiversity: 2},
-					{FCnt: 26, MaxSNR: -25, GtwDiversity: 1},
-					{FCnt: 27, MaxSNR: -8, GtwDiversity: 2},
-					{FCnt: 28, MaxSNR: -10, GtwDiversity: 2},
-					{FCnt: 29, MaxSNR: -10, GtwDiversity: 3},
-					{FCnt: 30, MaxSNR: -9, GtwDiversity: 3},
-					{FCnt: 31, MaxSNR: -7, GtwDiversity: 2,
-						TxSettings: ttnpb.TxSettings{
-							DataRate: ttnpb.DataRate{
-								Modulation: &ttnpb.DataRate_LoRa{
-									LoRa: &ttnpb.LoRaDataRate{
-										SpreadingFactor: 12,
-										Bandwidth:       125000,
-									},
-								},
-							},
-							DataRateIndex: 0,
-						},
-					},
-				}),
+			Name:   "adapted example from Semtech paper",
+			Device: newSemtechADRDevice(),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = 4
+				dev.MACState.DesiredParameters.ADRNbTrans = 1
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 1
 			},
+		},
+		{
+			Name:      "average SNR",
+			Algorithm: AverageSNRADRAlgorithm,
+			Device:    newSemtechADRDevice(),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = 1
+				dev.MACState.DesiredParameters.ADRNbTrans = 1
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 1
+			},
+		},
+		{
+			Name:      "25th percentile SNR",
+			Algorithm: PercentileSNRADRAlgorithm(25),
+			Device:    newSemtechADRDevice(),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = 0
+				dev.MACState.DesiredParameters.ADRNbTrans = 1
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 1
+			},
+		},
+		{
+			Name:      "loss aware",
+			Algorithm: LossAwareADRAlgorithm,
+			Device:    newSemtechADRDevice(),
 			DeviceDiff: func(dev *ttnpb.EndDevice) {
 				dev.MACState.DesiredParameters.ADRDataRateIndex = 4
 				dev.MACState.DesiredParameters.ADRNbTrans = 1
 				dev.MACState.DesiredParameters.ADRTxPowerIndex = 1
 			},
 		},
+		{
+			Name:      "loss aware with high loss",
+			Algorithm: LossAwareADRAlgorithm,
+			Device: func() *ttnpb.EndDevice {
+				dev := newSemtechADRDevice()
+				dev.MACState.CurrentParameters.ADRNbTrans = 2
+				dev.RecentADRUplinks = dev.RecentADRUplinks[len(dev.RecentADRUplinks)-3:]
+				dev.RecentADRUplinks[0].Payload.GetMACPayload().FHDR.FCnt = 20
+				return dev
+			}(),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = 3
+				dev.MACState.DesiredParameters.ADRNbTrans = 3
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
+			},
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
 
 			dev := CopyEndDevice(tc.Device)
 
-			err := adaptDataRate(dev, frequencyplans.NewStore(test.FrequencyPlansFetcher))
+			_, band, err := getDeviceBandVersion(dev, frequencyplans.NewStore(test.FrequencyPlansFetcher))
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+
+			alg := tc.Algorithm
+			if alg == nil {
+				alg = MaxSNRADRAlgorithm
+			}
+			err = alg.AdaptDataRate(test.Context(), dev, band)
 			if err != nil && !a.So(err, should.Equal, tc.Error) ||
 				err == nil && !a.So(err, should.BeNil) {
 				t.FailNow()
@@ -168,3 +234,85 @@ func TestAdaptDataRate(t *testing.T) {
 		})
 	}
 }
+
+func TestUplinkLossRate(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		FCnts    []uint32
+		LossRate float32
+	}{
+		{
+			Name:     "no loss",
+			FCnts:    []uint32{10, 11, 12, 13},
+			LossRate: 0,
+		},
+		{
+			Name:     "single loss",
+			FCnts:    []uint32{10, 11, 13},
+			LossRate: 0.25,
+		},
+		{
+			Name:     "duplicates",
+			FCnts:    []uint32{10, 10, 11, 11, 12},
+			LossRate: 0,
+		},
+		{
+			Name:     "duplicates with loss",
+			FCnts:    []uint32{10, 11, 11, 13},
+			LossRate: 0.25,
+		},
+		{
+			Name:     "FCnt reset",
+			FCnts:    []uint32{100, 101, 0, 1, 3},
+			LossRate: 0.25,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			ups := make([]*ttnpb.UplinkMessage, 0, len(tc.FCnts))
+			for _, fCnt := range tc.FCnts {
+				ups = append(ups, newADRUplink(fCnt, 0, 1, ttnpb.TxSettings{}))
+			}
+			assertions.New(t).So(uplinkLossRate(ups), should.Equal, tc.LossRate)
+		})
+	}
+}
+
+func TestADRAlgorithmRegistration(t *testing.T) {
+	a := assertions.New(t)
+
+	custom := ADRAlgorithmFunc(func(context.Context, *ttnpb.EndDevice, band.Band) error { return nil })
+
+	ns := &NetworkServer{adrAlgorithms: &sync.Map{}}
+	WithADRAlgorithm(DefaultADRAlgorithm, MaxSNRADRAlgorithm)(ns)
+	WithADRAlgorithm("custom", custom)(ns)
+
+	alg, err := ns.adrAlgorithm("")
+	a.So(err, should.BeNil)
+	a.So(alg, should.NotBeNil)
+
+	alg, err = ns.adrAlgorithm("custom")
+	a.So(err, should.BeNil)
+	a.So(alg, should.NotBeNil)
+
+	_, err = ns.adrAlgorithm("unknown")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	a.So(func() { WithADRAlgorithm("custom", custom)(ns) }, should.Panic)
+}
+
+func TestAdaptDataRateUnknownAlgorithm(t *testing.T) {
+	a := assertions.New(t)
+
+	ns := &NetworkServer{
+		Component:     &component.Component{FrequencyPlans: frequencyplans.NewStore(test.FrequencyPlansFetcher)},
+		adrAlgorithms: &sync.Map{},
+	}
+	WithADRAlgorithm(DefaultADRAlgorithm, MaxSNRADRAlgorithm)(ns)
+
+	dev := newSemtechADRDevice()
+	dev.MACSettings.ADRAlgorithm = "unknown"
+	err := ns.adaptDataRate(test.Context(), dev)
+	a.So(err, should.BeNil)
+	a.So(dev.MACState.DesiredParameters.ADRDataRateIndex, should.Equal, 4)
+	a.So(dev.MACState.DesiredParameters.ADRTxPowerIndex, should.Equal, 1)
+}
@@ -0,0 +1,93 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+const (
+	// beaconPeriod is the period of the class B beacon.
+	beaconPeriod = 128 * time.Second
+	// beaconReserved is the duration reserved for the beacon at the start of each beacon period.
+	beaconReserved = 2120 * time.Millisecond
+	// pingSlotLen is the length of a single ping slot.
+	pingSlotLen = 30 * time.Millisecond
+
+	// classBScheduleDelay is the minimum delay between processing of the downlink task and the ping slot used for transmission.
+	classBScheduleDelay = time.Second
+)
+
+// beaconTimeBefore returns the time of the last beacon transmitted at or before t in seconds since GPS epoch.
+func beaconTimeBefore(t time.Time) int64 {
+	sec := gpstime.ToGPS(t)
+	return sec - sec%int64(beaconPeriod/time.Second)
+}
+
+// pingPeriod returns the period of the ping slots in slots for the given periodicity.
+func pingPeriod(periodicity ttnpb.PingSlotPeriod) uint16 {
+	return 1 << (5 + uint(periodicity))
+}
+
+// nextPingSlotAt returns the start of the first ping slot of device with address addr and ping slot periodicity periodicity,
+// which starts at or after earliestAt, and the time of the beacon, which starts the beacon period containing the ping slot,
+// in seconds since GPS epoch.
+func nextPingSlotAt(addr types.DevAddr, periodicity ttnpb.PingSlotPeriod, earliestAt time.Time) (time.Time, int64) {
+	period := pingPeriod(periodicity)
+	for beaconTime := beaconTimeBefore(earliestAt); ; beaconTime += int64(beaconPeriod / time.Second) {
+		beaconAt := gpstime.Parse(beaconTime)
+		for slot := crypto.ComputePingOffset(uint32(beaconTime), addr, period); slot < 1<<12; slot += period {
+			t := beaconAt.Add(beaconReserved + time.Duration(slot)*pingSlotLen)
+			if !t.Before(earliestAt) {
+				return t, beaconTime
+			}
+		}
+	}
+}
+
+// pingSlotFrequency returns the frequency of the ping slot of dev in beacon period starting at beaconTime.
+// beaconTime is the time of the beacon in seconds since GPS epoch.
+func pingSlotFrequency(dev *ttnpb.EndDevice, band band.Band, beaconTime int64) uint64 {
+	if dev.MACState.CurrentParameters.PingSlotFrequency > 0 {
+		return dev.MACState.CurrentParameters.PingSlotFrequency
+	}
+	chs := band.Beacon.PingSlotChannels
+	if len(chs) == 0 {
+		return 0
+	}
+	i := (uint64(beaconTime/int64(beaconPeriod/time.Second)) + uint64(dev.EndDeviceIdentifiers.DevAddr.MarshalNumber())) % uint64(len(chs))
+	return uint64(chs[i])
+}
+
+// beaconChannelIndex returns the index of the channel in band used for the beacon transmitted at beaconTime.
+// beaconTime is the time of the beacon in seconds since GPS epoch.
+func beaconChannelIndex(band band.Band, beaconTime int64) uint32 {
+	if band.Beacon.BroadcastChannel == nil {
+		return 0
+	}
+	freq := band.Beacon.BroadcastChannel(float64(beaconTime))
+	for i, ch := range band.Beacon.PingSlotChannels {
+		if ch == freq {
+			return uint32(i)
+		}
+	}
+	return 0
+}
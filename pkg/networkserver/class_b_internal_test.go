@@ -0,0 +1,138 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestNextPingSlotAt(t *testing.T) {
+	const beaconTime = 0x12345680
+	addr := types.DevAddr{1, 2, 3, 4}
+	beaconAt := gpstime.Parse(beaconTime)
+
+	for _, tc := range []struct {
+		Periodicity        ttnpb.PingSlotPeriod
+		EarliestAt         time.Time
+		ExpectedAt         time.Time
+		ExpectedBeaconTime int64
+	}{
+		{
+			Periodicity:        ttnpb.PING_EVERY_128S,
+			EarliestAt:         beaconAt,
+			ExpectedAt:         beaconAt.Add(2120*time.Millisecond + 2234*30*time.Millisecond),
+			ExpectedBeaconTime: beaconTime,
+		},
+		{
+			Periodicity:        ttnpb.PING_EVERY_128S,
+			EarliestAt:         beaconAt.Add(2120*time.Millisecond + 2234*30*time.Millisecond),
+			ExpectedAt:         beaconAt.Add(2120*time.Millisecond + 2234*30*time.Millisecond),
+			ExpectedBeaconTime: beaconTime,
+		},
+		{
+			Periodicity: ttnpb.PING_EVERY_128S,
+			EarliestAt:  beaconAt.Add(70 * time.Second),
+			ExpectedAt: gpstime.Parse(beaconTime + 128).Add(
+				2120*time.Millisecond + time.Duration(crypto.ComputePingOffset(beaconTime+128, addr, 4096))*30*time.Millisecond,
+			),
+			ExpectedBeaconTime: beaconTime + 128,
+		},
+		{
+			Periodicity:        ttnpb.PING_EVERY_1S,
+			EarliestAt:         beaconAt.Add(10 * time.Second),
+			ExpectedAt:         beaconAt.Add(2120*time.Millisecond + (26+8*32)*30*time.Millisecond),
+			ExpectedBeaconTime: beaconTime,
+		},
+	} {
+		t.Run(fmt.Sprintf("%s/%s", tc.Periodicity, tc.EarliestAt.Sub(beaconAt)), func(t *testing.T) {
+			a := assertions.New(t)
+
+			at, bt := nextPingSlotAt(addr, tc.Periodicity, tc.EarliestAt)
+			a.So(at, should.Equal, tc.ExpectedAt)
+			a.So(bt, should.Equal, tc.ExpectedBeaconTime)
+		})
+	}
+}
+
+func TestPingSlotFrequency(t *testing.T) {
+	for _, tc := range []struct {
+		Name       string
+		Device     *ttnpb.EndDevice
+		BandID     string
+		BeaconTime int64
+		Expected   uint64
+	}{
+		{
+			Name: "EU/default",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					DevAddr: &types.DevAddr{0, 0, 0, 1},
+				},
+				MACState: &ttnpb.MACState{},
+			},
+			BandID:     band.EU_863_870,
+			BeaconTime: 128 * 42,
+			Expected:   869525000,
+		},
+		{
+			Name: "EU/ping slot frequency set",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					DevAddr: &types.DevAddr{0, 0, 0, 1},
+				},
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						PingSlotFrequency: 868100000,
+					},
+				},
+			},
+			BandID:     band.EU_863_870,
+			BeaconTime: 128 * 42,
+			Expected:   868100000,
+		},
+		{
+			Name: "US/hopping",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					DevAddr: &types.DevAddr{0, 0, 0, 1},
+				},
+				MACState: &ttnpb.MACState{},
+			},
+			BandID:     band.US_902_928,
+			BeaconTime: 128 * 42,
+			Expected:   925100000, // (42 + 1) % 8 = 3
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			b, err := band.GetByID(tc.BandID)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(pingSlotFrequency(tc.Device, b, tc.BeaconTime), should.Equal, tc.Expected)
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
 )
 
 // Config represents the NetworkServer configuration.
@@ -28,6 +29,8 @@ type Config struct {
 	DeduplicationWindow time.Duration          `name:"deduplication-window" description:"Time window during which, duplicate messages are collected for metadata"`
 	CooldownWindow      time.Duration          `name:"cooldown-window" description:"Time window starting right after deduplication window, during which, duplicate messages are discarded"`
 	DownlinkPriorities  DownlinkPriorityConfig `name:"downlink-priorities" description:"Downlink message priorities"`
+	Roaming             RoamingConfig          `name:"roaming" description:"Passive roaming configuration"`
+	SolveLocations      bool                   `name:"solve-locations" description:"Estimate end device locations from the metadata of deduplicated uplink messages"`
 }
 
 // DownlinkPriorityConfig defines priorities for downlink messages.
@@ -70,3 +73,27 @@ func (c DownlinkPriorityConfig) Parse() (DownlinkPriorities, error) {
 	}
 	return p, nil
 }
+
+// RoamingConfig defines the passive roaming peer networks.
+type RoamingConfig struct {
+	// Peers maps the NetIDs of the peer networks to their LoRaWAN Backend Interfaces URLs.
+	Peers map[string]string `name:"peers" description:"LoRaWAN Backend Interfaces URLs of roaming peer networks by NetID"`
+}
+
+var errRoamingPeerNetID = errors.DefineInvalidArgument("roaming_peer_net_id", "invalid roaming peer NetID `{value}`")
+
+// Parse attempts to parse the configuration and returns a MapRoamingPeerRegistry.
+func (c RoamingConfig) Parse() (MapRoamingPeerRegistry, error) {
+	r := make(MapRoamingPeerRegistry, len(c.Peers))
+	for id, url := range c.Peers {
+		var netID types.NetID
+		if err := netID.UnmarshalText([]byte(id)); err != nil {
+			return nil, errRoamingPeerNetID.WithAttributes("value", id).WithCause(err)
+		}
+		r[netID] = &RoamingPeer{
+			NetID: netID,
+			URL:   url,
+		}
+	}
+	return r, nil
+}
@@ -19,18 +19,20 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/mohae/deepcopy"
 	"go.thethings.network/lorawan-stack/pkg/cluster"
 	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/interop"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
-	"go.thethings.network/lorawan-stack/pkg/types"
 	"go.thethings.network/lorawan-stack/pkg/unique"
 )
 
@@ -49,6 +51,26 @@ type DownlinkTaskQueue interface {
 
 var errNoDownlink = errors.Define("no_downlink", "no downlink to send")
 
+// dropExpiredApplicationDownlinks removes the application downlinks that are expired at the given time from the
+// downlink queue of the given end device.
+func dropExpiredApplicationDownlinks(ctx context.Context, dev *ttnpb.EndDevice, now time.Time) {
+	var queue []*ttnpb.ApplicationDownlink
+	for _, down := range dev.QueuedApplicationDownlinks {
+		if down.ExpiresAt != nil && !down.ExpiresAt.After(now) {
+			log.FromContext(ctx).WithFields(log.Fields(
+				"expires_at", *down.ExpiresAt,
+				"f_cnt", down.FCnt,
+				"f_port", down.FPort,
+			)).Debug("Application downlink expired, dropping...")
+			continue
+		}
+		queue = append(queue, down)
+	}
+	if len(queue) < len(dev.QueuedApplicationDownlinks) {
+		dev.QueuedApplicationDownlinks = queue
+	}
+}
+
 // generateDownlink attempts to generate a downlink.
 // generateDownlink returns the marshaled payload of the downlink, application downlink, if included in the payload and error, if any.
 // generateDownlink may mutate the device in order to record the downlink generated.
@@ -60,7 +82,7 @@ var errNoDownlink = errors.Define("no_downlink", "no downlink to send")
 // For example, a sequence of 'NewChannel' MAC commands could be generated for a
 // device operating in a region where a fixed channel plan is defined in case
 // dev.MACState.CurrentParameters.Channels is not equal to dev.MACState.DesiredParameters.Channels.
-func generateDownlink(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen, maxUpLen uint16, fps *frequencyplans.Store) ([]byte, *ttnpb.ApplicationDownlink, error) {
+func generateDownlink(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen, maxUpLen uint16, fps *frequencyplans.Store, keyVault crypto.KeyVault) ([]byte, *ttnpb.ApplicationDownlink, error) {
 	if dev.MACState == nil {
 		return nil, nil, errUnknownMACState
 	}
@@ -95,33 +117,39 @@ func generateDownlink(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen, max
 
 	dev.MACState.PendingRequests = dev.MACState.PendingRequests[:0]
 
-	maxDownLen, maxUpLen, ok, err := enqueueLinkADRReq(ctx, dev, maxDownLen, maxUpLen, fps)
-	if err != nil {
-		return nil, nil, err
-	}
-	fPending := !ok
-	for _, f := range []func(context.Context, *ttnpb.EndDevice, uint16, uint16) (uint16, uint16, bool){
-		// LoRaWAN 1.0+
-		enqueueNewChannelReq,
-		enqueueDutyCycleReq,
-		enqueueRxParamSetupReq,
-		enqueueDevStatusReq,
-		enqueueRxTimingSetupReq,
-		enqueuePingSlotChannelReq,
-		enqueueBeaconFreqReq,
-
-		// LoRaWAN 1.0.2+
-		enqueueTxParamSetupReq,
-		enqueueDLChannelReq,
-
-		// LoRaWAN 1.1+
-		enqueueADRParamSetupReq,
-		enqueueForceRejoinReq,
-		enqueueRejoinParamSetupReq,
-	} {
+	var fPending bool
+	if !dev.Multicast {
+		// NOTE: Multicast groups do not send uplink messages, hence MAC requests cannot be answered.
 		var ok bool
-		maxDownLen, maxUpLen, ok = f(ctx, dev, maxDownLen, maxUpLen)
-		fPending = fPending || !ok
+		var err error
+		maxDownLen, maxUpLen, ok, err = enqueueLinkADRReq(ctx, dev, maxDownLen, maxUpLen, fps)
+		if err != nil {
+			return nil, nil, err
+		}
+		fPending = !ok
+		for _, f := range []func(context.Context, *ttnpb.EndDevice, uint16, uint16) (uint16, uint16, bool){
+			// LoRaWAN 1.0+
+			enqueueNewChannelReq,
+			enqueueDutyCycleReq,
+			enqueueRxParamSetupReq,
+			enqueueDevStatusReq,
+			enqueueRxTimingSetupReq,
+			enqueuePingSlotChannelReq,
+			enqueueBeaconFreqReq,
+
+			// LoRaWAN 1.0.2+
+			enqueueTxParamSetupReq,
+			enqueueDLChannelReq,
+
+			// LoRaWAN 1.1+
+			enqueueADRParamSetupReq,
+			enqueueForceRejoinReq,
+			enqueueRejoinParamSetupReq,
+		} {
+			var ok bool
+			maxDownLen, maxUpLen, ok = f(ctx, dev, maxDownLen, maxUpLen)
+			fPending = fPending || !ok
+		}
 	}
 	cmds = append(cmds, dev.MACState.PendingRequests...)
 
@@ -156,6 +184,7 @@ outer:
 			logger.WithField("m_type", up.Payload.MHDR.MType).Warn("Unknown MType stored in RecentUplinks")
 		}
 	}
+	dropExpiredApplicationDownlinks(ctx, dev, time.Now())
 	if !needsDownlink &&
 		len(cmdBuf) == 0 &&
 		len(dev.QueuedApplicationDownlinks) == 0 {
@@ -208,14 +237,10 @@ outer:
 			return nil, nil, errUnknownNwkSEncKey
 		}
 
-		var key types.AES128Key
-		if dev.Session.NwkSEncKey.KEKLabel != "" {
-			// TODO: (https://github.com/TheThingsNetwork/lorawan-stack/issues/5)
-			panic("unsupported")
+		key, err := cryptoutil.UnwrapAES128Key(*dev.Session.NwkSEncKey, keyVault)
+		if err != nil {
+			return nil, nil, err
 		}
-		copy(key[:], dev.Session.NwkSEncKey.Key[:])
-
-		var err error
 		cmdBuf, err = crypto.EncryptDownlink(key, *dev.EndDeviceIdentifiers.DevAddr, pld.FHDR.FCnt, cmdBuf)
 		if err != nil {
 			return nil, nil, errEncryptMAC.WithCause(err)
@@ -233,7 +258,7 @@ outer:
 		dev.Session.LastNFCntDown = pld.FCnt
 	}
 
-	pld.FHDR.FCtrl.FPending = fPending || len(dev.QueuedApplicationDownlinks) > 0
+	pld.FHDR.FCtrl.FPending = !dev.Multicast && (fPending || len(dev.QueuedApplicationDownlinks) > 0)
 	logger = logger.WithField("f_pending", pld.FHDR.FCtrl.FPending)
 
 	switch {
@@ -262,15 +287,13 @@ outer:
 	}
 	// NOTE: It is assumed, that b does not contain MIC.
 
-	var key types.AES128Key
 	if dev.Session.SNwkSIntKey == nil || len(dev.Session.SNwkSIntKey.Key) == 0 {
 		return nil, nil, errUnknownSNwkSIntKey
 	}
-	if dev.Session.SNwkSIntKey.KEKLabel != "" {
-		// TODO: https://github.com/TheThingsNetwork/lorawan-stack/issues/5
-		panic("unsupported")
+	key, err := cryptoutil.UnwrapAES128Key(*dev.Session.SNwkSIntKey, keyVault)
+	if err != nil {
+		return nil, nil, err
 	}
-	copy(key[:], dev.Session.SNwkSIntKey.Key[:])
 
 	var mic [4]byte
 	if dev.MACState.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0 {
@@ -348,38 +371,67 @@ func downlinkPathsFromRecentUplinks(ups ...*ttnpb.UplinkMessage) []downlinkPath
 	return nil
 }
 
+// downlinkAttempt is an attempt to schedule a downlink on a Gateway Server or roaming peer using one or more paths.
+type downlinkAttempt struct {
+	peer  cluster.Peer
+	paths []*ttnpb.DownlinkPath
+
+	roamingPeer *RoamingPeer
+	roamingGWs  []interop.GWInfo
+}
+
 // scheduleDownlinkByPaths attempts to schedule payload b using parameters in req for devID using paths.
 // scheduleDownlinkByPaths discards req.DownlinkPaths and mutates it arbitrarily.
 // scheduleDownlinkByPaths returns the scheduled downlink or error.
-func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *ttnpb.TxRequest, devID ttnpb.EndDeviceIdentifiers, b []byte, paths ...downlinkPath) (*ttnpb.DownlinkMessage, error) {
+func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *ttnpb.TxRequest, devID *ttnpb.EndDeviceIdentifiers, b []byte, paths ...downlinkPath) (*ttnpb.DownlinkMessage, error) {
 	if len(paths) == 0 {
 		return nil, errNoPath
 	}
 
 	logger := log.FromContext(ctx)
 
-	type attempt struct {
-		peer  cluster.Peer
-		paths []*ttnpb.DownlinkPath
-	}
-	attempts := make([]*attempt, 0, len(paths))
+	attempts := make([]*downlinkAttempt, 0, len(paths))
 
 	for _, path := range paths {
 		logger := logger.WithField(
 			"gateway_uid", unique.ID(ctx, path.GatewayIdentifiers),
 		)
 
+		if netID, ulToken, ok := parseRoamingUplinkToken(path.GetUplinkToken()); ok {
+			p, err := ns.roamingPeer(ctx, netID)
+			if err != nil {
+				logger.WithError(err).Debug("Could not get roaming peer")
+				continue
+			}
+
+			var a *downlinkAttempt
+			if len(attempts) > 0 && attempts[len(attempts)-1].roamingPeer != nil && attempts[len(attempts)-1].roamingPeer.NetID == p.NetID {
+				a = attempts[len(attempts)-1]
+			} else {
+				a = &downlinkAttempt{
+					roamingPeer: p,
+				}
+				attempts = append(attempts, a)
+			}
+			a.roamingGWs = append(a.roamingGWs, interop.GWInfo{
+				ID:        path.GatewayID,
+				ULToken:   ulToken,
+				DLAllowed: true,
+			})
+			continue
+		}
+
 		p := ns.GetPeer(ctx, ttnpb.PeerInfo_GATEWAY_SERVER, path.GatewayIdentifiers)
 		if p == nil {
 			logger.Debug("Could not get Gateway Server")
 			continue
 		}
 
-		var a *attempt
+		var a *downlinkAttempt
 		if len(attempts) > 0 && attempts[len(attempts)-1].peer == p {
 			a = attempts[len(attempts)-1]
 		} else {
-			a = &attempt{
+			a = &downlinkAttempt{
 				peer: p,
 			}
 			attempts = append(attempts, a)
@@ -387,25 +439,54 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *ttnpb
 		a.paths = append(a.paths, path.DownlinkPath)
 	}
 
-	ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("ns:downlink:%s", events.NewCorrelationID()))
+	ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("%s%s", downlinkCorrelationIDPrefix, events.NewCorrelationID()))
+	return ns.scheduleDownlinkAttempts(ctx, req, devID, b, attempts...)
+}
+
+// scheduleDownlinkAttempts attempts to schedule payload b using parameters in req for devID in the order of attempts.
+// If a Gateway Server schedules the downlink, the remaining attempts are kept until the transmission is acknowledged,
+// so that the downlink can be retried if the transmission fails.
+func (ns *NetworkServer) scheduleDownlinkAttempts(ctx context.Context, req *ttnpb.TxRequest, devID *ttnpb.EndDeviceIdentifiers, b []byte, attempts ...*downlinkAttempt) (*ttnpb.DownlinkMessage, error) {
+	logger := log.FromContext(ctx)
+
 	errs := make([]error, 0, len(attempts))
-	for _, a := range attempts {
+	for i, a := range attempts {
 		req.DownlinkPaths = a.paths
 		down := &ttnpb.DownlinkMessage{
 			RawPayload:     b,
-			EndDeviceIDs:   &devID,
+			EndDeviceIDs:   devID,
 			CorrelationIDs: events.CorrelationIDsFromContext(ctx),
 			Settings: &ttnpb.DownlinkMessage_Request{
 				Request: req,
 			},
 		}
 
+		if a.roamingPeer != nil {
+			logger.WithFields(log.Fields(
+				"net_id", a.roamingPeer.NetID,
+				"gateway_count", len(a.roamingGWs),
+			)).Debug("Transmitting downlink through roaming peer...")
+			if err := ns.transmitRoamingDownlink(ctx, a.roamingPeer, req, b, a.roamingGWs); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			return down, nil
+		}
+
 		logger.WithField("path_count", len(req.DownlinkPaths)).Debug("Scheduling downlink...")
 		_, err := ttnpb.NewNsGsClient(a.peer.Conn()).ScheduleDownlink(ctx, down, ns.WithClusterAuth())
 		if err != nil {
 			errs = append(errs, err)
 			continue
 		}
+		ns.addPendingTransmission(ctx, &pendingTransmission{
+			correlationIDs: down.CorrelationIDs,
+			req:            deepcopy.Copy(req).(*ttnpb.TxRequest),
+			devID:          devID,
+			payload:        b,
+			attempt:        a,
+			remaining:      attempts[i+1:],
+		})
 		return down, nil
 	}
 
@@ -418,6 +499,113 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *ttnpb
 	return nil, errSchedule
 }
 
+// downlinkCorrelationIDPrefix is the prefix of the correlation ID that identifies a downlink scheduled by the Network Server.
+const downlinkCorrelationIDPrefix = "ns:downlink:"
+
+// pendingTransmissionTimeout is the time the Network Server waits for the acknowledgment of a scheduled downlink.
+var pendingTransmissionTimeout = time.Minute
+
+// pendingTransmission is a downlink scheduled on a Gateway Server that awaits a Tx acknowledgment.
+// The devID is nil for downlinks that are transmitted on behalf of a roaming partner.
+type pendingTransmission struct {
+	correlationIDs []string
+	req            *ttnpb.TxRequest
+	devID          *ttnpb.EndDeviceIdentifiers
+	payload        []byte
+	attempt        *downlinkAttempt
+	remaining      []*downlinkAttempt
+}
+
+// publish publishes the event for the end device of the transmission, if any.
+func (t *pendingTransmission) publish(ctx context.Context, evt events.Definition, data interface{}) {
+	if t.devID == nil {
+		return
+	}
+	events.Publish(evt(ctx, *t.devID, data))
+}
+
+// downlinkCorrelationID returns the correlation ID in ids that identifies the downlink, if any.
+func downlinkCorrelationID(ids ...string) (string, bool) {
+	for _, id := range ids {
+		if strings.HasPrefix(id, downlinkCorrelationIDPrefix) {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+func (ns *NetworkServer) addPendingTransmission(ctx context.Context, t *pendingTransmission) {
+	id, ok := downlinkCorrelationID(t.correlationIDs...)
+	if !ok {
+		return
+	}
+	ns.pendingTransmissions.Store(id, t)
+	time.AfterFunc(pendingTransmissionTimeout, func() {
+		if v, ok := ns.pendingTransmissions.Load(id); ok && v == t {
+			ns.pendingTransmissions.Delete(id)
+		}
+	})
+}
+
+// handleTxAcknowledgment handles the acknowledgment of a downlink transmission.
+// If the transmission failed, the downlink is retried in Rx2 on the same paths if Rx1 failed, and on the remaining paths.
+func (ns *NetworkServer) handleTxAcknowledgment(ctx context.Context, ack *ttnpb.TxAcknowledgment) error {
+	ids := ack.CorrelationIDs
+	if ack.DownlinkMessage != nil {
+		ids = append(ids[:len(ids):len(ids)], ack.DownlinkMessage.CorrelationIDs...)
+	}
+	id, ok := downlinkCorrelationID(ids...)
+	if !ok {
+		return errTransmissionNotFound
+	}
+	v, ok := ns.pendingTransmissions.Load(id)
+	if !ok {
+		return errTransmissionNotFound
+	}
+	ns.pendingTransmissions.Delete(id)
+	t := v.(*pendingTransmission)
+
+	ctx = events.ContextWithCorrelationID(ctx, t.correlationIDs...)
+	logger := log.FromContext(ctx).WithField("result", ack.Result)
+	if t.devID != nil {
+		logger = logger.WithField("device_uid", unique.ID(ctx, *t.devID))
+	}
+	ctx = log.NewContext(ctx, logger)
+
+	if ack.Result == ttnpb.TxAcknowledgment_SUCCESS {
+		logger.Debug("Downlink transmitted")
+		t.publish(ctx, evtTransmitDownlinkSuccess, nil)
+		return nil
+	}
+	logger.Debug("Downlink transmission failed")
+	t.publish(ctx, evtTransmitDownlinkFail, ack.Result)
+
+	req := t.req
+	attempts := t.remaining
+	if req.Rx1Frequency != 0 && req.Rx2Frequency != 0 {
+		scheduled := ack.GetDownlinkMessage().GetScheduled()
+		if scheduled == nil || scheduled.Frequency == req.Rx1Frequency && scheduled.DataRateIndex == req.Rx1DataRateIndex {
+			// Rx1 failed, so retry in Rx2 on the same paths first.
+			req = deepcopy.Copy(req).(*ttnpb.TxRequest)
+			req.Rx1Frequency = 0
+			attempts = append([]*downlinkAttempt{t.attempt}, attempts...)
+		}
+	}
+	if len(attempts) == 0 {
+		logger.Debug("No paths left to retry downlink")
+		t.publish(ctx, evtDropDownlinkTransmission, ack.Result)
+		return nil
+	}
+
+	logger.WithField("attempt_count", len(attempts)).Debug("Retrying downlink...")
+	t.publish(ctx, evtRetryDownlinkTransmission, ack.Result)
+	if _, err := ns.scheduleDownlinkAttempts(ctx, req, t.devID, t.payload, attempts...); err != nil {
+		t.publish(ctx, evtDropDownlinkTransmission, err)
+		return err
+	}
+	return nil
+}
+
 func (ns *NetworkServer) sendQueueInvalidationToAS(ctx context.Context, dev *ttnpb.EndDevice) (bool, error) {
 	ok, err := ns.handleASUplink(ctx, dev.EndDeviceIdentifiers.ApplicationIdentifiers, &ttnpb.ApplicationUp{
 		EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
@@ -460,6 +648,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 				"lorawan_phy_version",
 				"mac_settings",
 				"mac_state",
+				"multicast",
 				"queued_application_downlinks",
 				"recent_downlinks",
 				"recent_uplinks",
@@ -475,11 +664,6 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 
 				case dev.MACState.DeviceClass == ttnpb.CLASS_A && !dev.MACState.RxWindowsAvailable:
 					return dev, nil, nil
-
-				case dev.MACState.DeviceClass == ttnpb.CLASS_B && !dev.MACState.RxWindowsAvailable:
-					// TODO: Support Class B (https://github.com/TheThingsNetwork/lorawan-stack/issues/19).
-					logger.Warn("Class B downlink task scheduled, but Rx windows are not available")
-					return dev, nil, nil
 				}
 				logger = logger.WithField("device_class", dev.MACState.DeviceClass)
 
@@ -554,7 +738,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 								"rx2_frequency", req.Rx2Frequency,
 							))),
 							req,
-							dev.EndDeviceIdentifiers,
+							&dev.EndDeviceIdentifiers,
 							dev.MACState.QueuedJoinAccept.Payload,
 							downlinkPathsFromRecentUplinks(dev.RecentUplinks...)...,
 						)
@@ -594,6 +778,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 							band.DataRates[minDR].DefaultMaxSize.PayloadSize(fp.DwellTime.GetDownlinks()),
 							maxUpLength,
 							ns.FrequencyPlans,
+							ns.KeyVault,
 						)
 						if err != nil {
 							return nil, nil, err
@@ -614,7 +799,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 								"rx2_frequency", req.Rx2Frequency,
 							))),
 							req,
-							dev.EndDeviceIdentifiers,
+							&dev.EndDeviceIdentifiers,
 							b,
 							downlinkPathsFromRecentUplinks(dev.RecentUplinks...)...,
 						)
@@ -648,6 +833,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 							band.DataRates[req.Rx1DataRateIndex].DefaultMaxSize.PayloadSize(fp.DwellTime.GetDownlinks()),
 							maxUpLength,
 							ns.FrequencyPlans,
+							ns.KeyVault,
 						)
 						if err != nil {
 							if errors.Resemble(err, errScheduleTooSoon) {
@@ -699,7 +885,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 									"rx1_frequency", req.Rx1Frequency,
 								))),
 								req,
-								dev.EndDeviceIdentifiers,
+								&dev.EndDeviceIdentifiers,
 								b,
 								paths...,
 							)
@@ -737,11 +923,16 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 						Rx2DataRateIndex: dev.MACState.CurrentParameters.Rx2DataRateIndex,
 						Rx2Frequency:     dev.MACState.CurrentParameters.Rx2Frequency,
 					}
+					if dev.MACState.DeviceClass == ttnpb.CLASS_B {
+						// NOTE: Class B downlink is transmitted in the ping slot, which uses Rx2 of the request.
+						req.Rx2DataRateIndex = dev.MACState.CurrentParameters.PingSlotDataRateIndex
+					}
 
 					b, appDown, err := generateDownlink(ctx, dev,
 						band.DataRates[req.Rx2DataRateIndex].DefaultMaxSize.PayloadSize(fp.DwellTime.GetDownlinks()),
 						maxUpLength,
 						ns.FrequencyPlans,
+						ns.KeyVault,
 					)
 					if err != nil {
 						return nil, nil, err
@@ -750,6 +941,20 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 						ctx = events.ContextWithCorrelationID(ctx, appDown.CorrelationIDs...)
 					}
 
+					if dev.MACState.DeviceClass == ttnpb.CLASS_B {
+						earliestAt := time.Now().Add(classBScheduleDelay)
+						if appDown != nil && appDown.ClassBC != nil && appDown.ClassBC.AbsoluteTime != nil && appDown.ClassBC.AbsoluteTime.After(earliestAt) {
+							earliestAt = *appDown.ClassBC.AbsoluteTime
+						}
+						pingSlotAt, beaconTime := nextPingSlotAt(*dev.EndDeviceIdentifiers.DevAddr, dev.MACState.PingSlotPeriodicity, earliestAt)
+						req.AbsoluteTime = &pingSlotAt
+						req.Rx2Frequency = pingSlotFrequency(dev, band, beaconTime)
+						logger = logger.WithFields(log.Fields(
+							"ping_slot_at", pingSlotAt,
+							"ping_slot_frequency", req.Rx2Frequency,
+						))
+					}
+
 					var paths []downlinkPath
 					if appDown != nil && appDown.ClassBC != nil {
 						paths = make([]downlinkPath, 0, len(appDown.ClassBC.Gateways))
@@ -779,7 +984,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 							"attempt_rx2", true,
 						))),
 						req,
-						dev.EndDeviceIdentifiers,
+						&dev.EndDeviceIdentifiers,
 						b,
 						paths...,
 					)
@@ -789,6 +994,9 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 						if appDown == nil && len(dev.QueuedApplicationDownlinks) > 0 && dev.MACState.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0 {
 							go ns.sendQueueInvalidationToAS(ctx, dev)
 						}
+						if dev.MACState.DeviceClass == ttnpb.CLASS_B && len(dev.QueuedApplicationDownlinks) > 0 {
+							nextDownlinkAt = *req.AbsoluteTime
+						}
 						dev.RecentDownlinks = append(dev.RecentDownlinks, down)
 						if len(dev.RecentDownlinks) > recentDownlinkCount {
 							dev.RecentDownlinks = append(dev.RecentDownlinks[:0], dev.RecentDownlinks[len(dev.RecentDownlinks)-recentDownlinkCount:]...)
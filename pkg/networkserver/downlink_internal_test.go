@@ -32,6 +32,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/component"
 	"go.thethings.network/lorawan-stack/pkg/config"
 	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/pkg/rpcserver"
@@ -270,6 +271,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					"lorawan_phy_version",
 					"mac_settings",
 					"mac_state",
+					"multicast",
 					"queued_application_downlinks",
 					"recent_downlinks",
 					"recent_uplinks",
@@ -311,6 +313,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					band.DataRates[ttnpb.DATA_RATE_1].DefaultMaxSize.PayloadSize(fp.DwellTime.GetDownlinks()),
 					band.DataRates[ttnpb.DATA_RATE_0].DefaultMaxSize.PayloadSize(fp.DwellTime.GetUplinks()),
 					ns.FrequencyPlans,
+					ns.KeyVault,
 				)
 				if !a.So(err, should.BeNil) {
 					t.Fatalf("Failed to generate Rx2 payload: %s", err)
@@ -386,6 +389,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 						band.DataRates[drIdx].DefaultMaxSize.PayloadSize(fp.DwellTime.GetDownlinks()),
 						band.DataRates[ttnpb.DATA_RATE_0].DefaultMaxSize.PayloadSize(fp.DwellTime.GetUplinks()),
 						ns.FrequencyPlans,
+						ns.KeyVault,
 					)
 					if !a.So(err, should.BeNil) {
 						t.Fatalf("Failed to generate Rx2 payload: %s", err)
@@ -698,6 +702,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					"lorawan_phy_version",
 					"mac_settings",
 					"mac_state",
+					"multicast",
 					"queued_application_downlinks",
 					"recent_downlinks",
 					"recent_uplinks",
@@ -739,6 +744,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					band.DataRates[rx1DRIdx].DefaultMaxSize.PayloadSize(fp.DwellTime.GetDownlinks()),
 					band.DataRates[ttnpb.DATA_RATE_0].DefaultMaxSize.PayloadSize(fp.DwellTime.GetUplinks()),
 					ns.FrequencyPlans,
+					ns.KeyVault,
 				)
 				if !a.So(err, should.BeNil) {
 					t.Fatalf("Failed to generate Rx1 payload: %s", err)
@@ -810,6 +816,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 						band.DataRates[rx1DRIdx].DefaultMaxSize.PayloadSize(fp.DwellTime.GetDownlinks()),
 						band.DataRates[ttnpb.DATA_RATE_0].DefaultMaxSize.PayloadSize(fp.DwellTime.GetUplinks()),
 						ns.FrequencyPlans,
+						ns.KeyVault,
 					)
 					if !a.So(err, should.BeNil) {
 						t.Fatalf("Failed to generate Rx1 payload: %s", err)
@@ -1115,6 +1122,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					"lorawan_phy_version",
 					"mac_settings",
 					"mac_state",
+					"multicast",
 					"queued_application_downlinks",
 					"recent_downlinks",
 					"recent_uplinks",
@@ -1154,6 +1162,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					band.DataRates[ttnpb.DATA_RATE_1].DefaultMaxSize.PayloadSize(fp.DwellTime.GetDownlinks()),
 					band.DataRates[ttnpb.DATA_RATE_0].DefaultMaxSize.PayloadSize(fp.DwellTime.GetUplinks()),
 					ns.FrequencyPlans,
+					ns.KeyVault,
 				)
 				if !a.So(err, should.BeNil) {
 					t.Fatalf("Failed to generate Rx2 payload: %s", err)
@@ -1227,6 +1236,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 						band.DataRates[rx1DRIdx].DefaultMaxSize.PayloadSize(fp.DwellTime.GetDownlinks()),
 						band.DataRates[ttnpb.DATA_RATE_0].DefaultMaxSize.PayloadSize(fp.DwellTime.GetUplinks()),
 						ns.FrequencyPlans,
+						ns.KeyVault,
 					)
 					if !a.So(err, should.BeNil) {
 						t.Fatalf("Failed to generate Rx1 payload: %s", err)
@@ -1235,6 +1245,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 						band.DataRates[ttnpb.DATA_RATE_1].DefaultMaxSize.PayloadSize(fp.DwellTime.GetDownlinks()),
 						band.DataRates[ttnpb.DATA_RATE_0].DefaultMaxSize.PayloadSize(fp.DwellTime.GetUplinks()),
 						ns.FrequencyPlans,
+						ns.KeyVault,
 					)
 					if !a.So(err, should.BeNil) {
 						t.Fatalf("Failed to generate Rx2 payload: %s", err)
@@ -1697,6 +1708,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					"lorawan_phy_version",
 					"mac_settings",
 					"mac_state",
+					"multicast",
 					"queued_application_downlinks",
 					"recent_downlinks",
 					"recent_uplinks",
@@ -1736,6 +1748,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					band.DataRates[ttnpb.DATA_RATE_1].DefaultMaxSize.PayloadSize(fp.DwellTime.GetDownlinks()),
 					band.DataRates[ttnpb.DATA_RATE_0].DefaultMaxSize.PayloadSize(fp.DwellTime.GetUplinks()),
 					ns.FrequencyPlans,
+					ns.KeyVault,
 				)
 				if !a.So(err, should.BeNil) {
 					t.Fatalf("Failed to generate Rx2 payload: %s", err)
@@ -1812,6 +1825,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 						band.DataRates[rx1DRIdx].DefaultMaxSize.PayloadSize(fp.DwellTime.GetDownlinks()),
 						band.DataRates[ttnpb.DATA_RATE_0].DefaultMaxSize.PayloadSize(fp.DwellTime.GetUplinks()),
 						ns.FrequencyPlans,
+						ns.KeyVault,
 					)
 					if !a.So(err, should.BeNil) {
 						t.Fatalf("Failed to generate Rx1 payload: %s", err)
@@ -1820,6 +1834,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 						band.DataRates[ttnpb.DATA_RATE_1].DefaultMaxSize.PayloadSize(fp.DwellTime.GetDownlinks()),
 						band.DataRates[ttnpb.DATA_RATE_0].DefaultMaxSize.PayloadSize(fp.DwellTime.GetUplinks()),
 						ns.FrequencyPlans,
+						ns.KeyVault,
 					)
 					if !a.So(err, should.BeNil) {
 						t.Fatalf("Failed to generate Rx2 payload: %s", err)
@@ -2283,6 +2298,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					"lorawan_phy_version",
 					"mac_settings",
 					"mac_state",
+					"multicast",
 					"queued_application_downlinks",
 					"recent_downlinks",
 					"recent_uplinks",
@@ -2640,6 +2656,15 @@ func TestGenerateDownlink(t *testing.T) {
 		return
 	}
 
+	keyVault := cryptoutil.NewMemKeyVault(map[string][]byte{
+		"test": {0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+	})
+	wrapKey := func(key types.AES128Key) *ttnpb.KeyEnvelope {
+		ke := test.Must(cryptoutil.WrapAES128Key(key, "test", keyVault)).(ttnpb.KeyEnvelope)
+		return &ke
+	}
+	_, errUnknownKEK := keyVault.Unwrap(nil, "unknown")
+
 	for _, tc := range []struct {
 		Name       string
 		Device     *ttnpb.EndDevice
@@ -3141,6 +3166,110 @@ func TestGenerateDownlink(t *testing.T) {
 				dev.Session.LastNFCntDown++
 			},
 		},
+		{
+			Name:    "1.1/no app downlink/status(count)/no ack/wrapped keys",
+			Context: test.Context(),
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: ApplicationID},
+					DeviceID:               DeviceID,
+					DevAddr:                &DevAddr,
+				},
+				MACSettings: &ttnpb.MACSettings{
+					StatusCountPeriodicity: 3,
+				},
+				MACState: &ttnpb.MACState{
+					LastDevStatusFCntUp: 4,
+					LoRaWANVersion:      ttnpb.MAC_V1_1,
+				},
+				Session: &ttnpb.Session{
+					LastFCntUp:    99,
+					LastNFCntDown: 41,
+					SessionKeys: ttnpb.SessionKeys{
+						NwkSEncKey:  wrapKey(NwkSEncKey),
+						SNwkSIntKey: wrapKey(SNwkSIntKey),
+					},
+				},
+				RecentUplinks: []*ttnpb.UplinkMessage{{
+					Payload: &ttnpb.Message{
+						MHDR: ttnpb.MHDR{
+							MType: ttnpb.MType_UNCONFIRMED_UP,
+						},
+						Payload: &ttnpb.Message_MACPayload{MACPayload: &ttnpb.MACPayload{}},
+					},
+				}},
+			},
+			Bytes: encodeMessage(&ttnpb.Message{
+				MHDR: ttnpb.MHDR{
+					MType: ttnpb.MType_UNCONFIRMED_DOWN,
+					Major: ttnpb.Major_LORAWAN_R1,
+				},
+				Payload: &ttnpb.Message_MACPayload{
+					MACPayload: &ttnpb.MACPayload{
+						FHDR: ttnpb.FHDR{
+							DevAddr: DevAddr,
+							FCtrl: ttnpb.FCtrl{
+								Ack: false,
+							},
+							FCnt: 42,
+						},
+						FPort: 0,
+						FRMPayload: encodeMAC(
+							ttnpb.CID_DEV_STATUS.MACCommand(),
+						),
+					},
+				},
+			}, ttnpb.MAC_V1_1, 0),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.PendingRequests = []*ttnpb.MACCommand{
+					ttnpb.CID_DEV_STATUS.MACCommand(),
+				}
+				dev.Session.LastNFCntDown++
+			},
+		},
+		{
+			Name:    "1.1/no app downlink/status(count)/no ack/unknown KEK label",
+			Context: test.Context(),
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: ApplicationID},
+					DeviceID:               DeviceID,
+					DevAddr:                &DevAddr,
+				},
+				MACSettings: &ttnpb.MACSettings{
+					StatusCountPeriodicity: 3,
+				},
+				MACState: &ttnpb.MACState{
+					LastDevStatusFCntUp: 4,
+					LoRaWANVersion:      ttnpb.MAC_V1_1,
+				},
+				Session: &ttnpb.Session{
+					LastFCntUp:    99,
+					LastNFCntDown: 41,
+					SessionKeys: ttnpb.SessionKeys{
+						NwkSEncKey: &ttnpb.KeyEnvelope{
+							Key:      wrapKey(NwkSEncKey).Key,
+							KEKLabel: "unknown",
+						},
+						SNwkSIntKey: wrapKey(SNwkSIntKey),
+					},
+				},
+				RecentUplinks: []*ttnpb.UplinkMessage{{
+					Payload: &ttnpb.Message{
+						MHDR: ttnpb.MHDR{
+							MType: ttnpb.MType_UNCONFIRMED_UP,
+						},
+						Payload: &ttnpb.Message_MACPayload{MACPayload: &ttnpb.MACPayload{}},
+					},
+				}},
+			},
+			Error: errUnknownKEK,
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.PendingRequests = []*ttnpb.MACCommand{
+					ttnpb.CID_DEV_STATUS.MACCommand(),
+				}
+			},
+		},
 		{
 			Name:    "1.1/no app downlink/status(time/zero time)/no ack",
 			Context: test.Context(),
@@ -3204,13 +3333,170 @@ func TestGenerateDownlink(t *testing.T) {
 				dev.Session.LastNFCntDown++
 			},
 		},
+		{
+			Name:    "1.1/multicast/unconfirmed app downlink/status(time/zero time)",
+			Context: test.Context(),
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: ApplicationID},
+					DeviceID:               DeviceID,
+					DevAddr:                &DevAddr,
+				},
+				Multicast: true,
+				MACSettings: &ttnpb.MACSettings{
+					StatusTimePeriodicity: time.Nanosecond,
+				},
+				MACState: &ttnpb.MACState{
+					DeviceClass:    ttnpb.CLASS_C,
+					LoRaWANVersion: ttnpb.MAC_V1_1,
+				},
+				Session: &ttnpb.Session{
+					LastNFCntDown: 41,
+					SessionKeys: ttnpb.SessionKeys{
+						NwkSEncKey: &ttnpb.KeyEnvelope{
+							Key: NwkSEncKey[:],
+						},
+						SNwkSIntKey: &ttnpb.KeyEnvelope{
+							Key: SNwkSIntKey[:],
+						},
+					},
+				},
+				QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
+					{
+						FCnt:       42,
+						FPort:      1,
+						FRMPayload: []byte("test"),
+					},
+					{
+						FCnt:       43,
+						FPort:      1,
+						FRMPayload: []byte("test"),
+					},
+				},
+				LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+			},
+			Bytes: encodeMessage(&ttnpb.Message{
+				MHDR: ttnpb.MHDR{
+					MType: ttnpb.MType_UNCONFIRMED_DOWN,
+					Major: ttnpb.Major_LORAWAN_R1,
+				},
+				Payload: &ttnpb.Message_MACPayload{
+					MACPayload: &ttnpb.MACPayload{
+						FHDR: ttnpb.FHDR{
+							DevAddr: DevAddr,
+							FCnt:    42,
+						},
+						FPort:      1,
+						FRMPayload: []byte("test"),
+					},
+				},
+			}, ttnpb.MAC_V1_1, 0),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.QueuedApplicationDownlinks = dev.QueuedApplicationDownlinks[1:]
+			},
+		},
+		{
+			Name:    "1.1/multicast/expired app downlink",
+			Context: test.Context(),
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: ApplicationID},
+					DeviceID:               DeviceID,
+					DevAddr:                &DevAddr,
+				},
+				Multicast:   true,
+				MACSettings: &ttnpb.MACSettings{},
+				MACState: &ttnpb.MACState{
+					DeviceClass:    ttnpb.CLASS_C,
+					LoRaWANVersion: ttnpb.MAC_V1_1,
+				},
+				Session: &ttnpb.Session{
+					LastNFCntDown: 41,
+					SessionKeys: ttnpb.SessionKeys{
+						NwkSEncKey: &ttnpb.KeyEnvelope{
+							Key: NwkSEncKey[:],
+						},
+						SNwkSIntKey: &ttnpb.KeyEnvelope{
+							Key: SNwkSIntKey[:],
+						},
+					},
+				},
+				QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
+					{
+						FCnt:       42,
+						FPort:      1,
+						FRMPayload: []byte("expired"),
+						ExpiresAt:  timePtr(time.Unix(42, 0)),
+					},
+					{
+						FCnt:       43,
+						FPort:      1,
+						FRMPayload: []byte("test"),
+						ExpiresAt:  timePtr(time.Now().Add(time.Hour)),
+					},
+				},
+				LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+			},
+			Bytes: encodeMessage(&ttnpb.Message{
+				MHDR: ttnpb.MHDR{
+					MType: ttnpb.MType_UNCONFIRMED_DOWN,
+					Major: ttnpb.Major_LORAWAN_R1,
+				},
+				Payload: &ttnpb.Message_MACPayload{
+					MACPayload: &ttnpb.MACPayload{
+						FHDR: ttnpb.FHDR{
+							DevAddr: DevAddr,
+							FCnt:    43,
+						},
+						FPort:      1,
+						FRMPayload: []byte("test"),
+					},
+				},
+			}, ttnpb.MAC_V1_1, 0),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.QueuedApplicationDownlinks = dev.QueuedApplicationDownlinks[2:]
+			},
+		},
+		{
+			Name:    "1.1/multicast/only expired app downlinks",
+			Context: test.Context(),
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: ApplicationID},
+					DeviceID:               DeviceID,
+					DevAddr:                &DevAddr,
+				},
+				Multicast:   true,
+				MACSettings: &ttnpb.MACSettings{},
+				MACState: &ttnpb.MACState{
+					DeviceClass:    ttnpb.CLASS_C,
+					LoRaWANVersion: ttnpb.MAC_V1_1,
+				},
+				Session: &ttnpb.Session{
+					LastNFCntDown: 41,
+				},
+				QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
+					{
+						FCnt:       42,
+						FPort:      1,
+						FRMPayload: []byte("expired"),
+						ExpiresAt:  timePtr(time.Unix(42, 0)),
+					},
+				},
+				LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+			},
+			Error: errNoDownlink,
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.QueuedApplicationDownlinks = nil
+			},
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
 
 			dev := CopyEndDevice(tc.Device)
 
-			b, _, err := generateDownlink(tc.Context, dev, math.MaxUint16, math.MaxUint16, frequencyplans.NewStore(test.FrequencyPlansFetcher))
+			b, _, err := generateDownlink(tc.Context, dev, math.MaxUint16, math.MaxUint16, frequencyplans.NewStore(test.FrequencyPlansFetcher), keyVault)
 			if tc.Error != nil && !a.So(err, should.EqualErrorOrDefinition, tc.Error) ||
 				tc.Error == nil && !a.So(err, should.BeNil) {
 				t.FailNow()
@@ -3226,3 +3512,155 @@ func TestGenerateDownlink(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleTxAcknowledgment(t *testing.T) {
+	a := assertions.New(t)
+
+	gateways := []ttnpb.GatewayIdentifiers{
+		{GatewayID: "gateway-0"},
+		{GatewayID: "gateway-1"},
+	}
+	scheduled := make(chan *ttnpb.DownlinkMessage, 1)
+	newPeer := func(name string) cluster.Peer {
+		return test.Must(test.NewGRPCServerPeer(test.Context(), &MockNsGsServer{
+			ScheduleDownlinkFunc: func(_ context.Context, msg *ttnpb.DownlinkMessage) (*pbtypes.Empty, error) {
+				msg.CorrelationIDs = append(msg.CorrelationIDs, fmt.Sprintf("test:%s", name))
+				scheduled <- msg
+				return ttnpb.Empty, nil
+			},
+		}, ttnpb.RegisterNsGsServer)).(cluster.Peer)
+	}
+	peers := map[string]cluster.Peer{
+		unique.ID(test.Context(), gateways[0]): newPeer("gs0"),
+		unique.ID(test.Context(), gateways[1]): newPeer("gs1"),
+	}
+
+	ns := test.Must(New(
+		component.MustNew(test.GetLogger(t),
+			&component.Config{},
+			component.WithClusterNew(func(context.Context, *config.ServiceBase, ...rpcserver.Registerer) (cluster.Cluster, error) {
+				return &test.MockCluster{
+					GetPeerFunc: func(ctx context.Context, role ttnpb.PeerInfo_Role, ids ttnpb.Identifiers) cluster.Peer {
+						return peers[unique.ID(ctx, ids)]
+					},
+				}, nil
+			}),
+		),
+		&Config{
+			DeduplicationWindow: 42,
+			CooldownWindow:      42,
+			DownlinkTasks:       &MockDownlinkTaskQueue{},
+			Devices:             &MockDeviceRegistry{},
+		},
+	)).(*NetworkServer)
+	test.Must(nil, ns.Start())
+	defer ns.Close()
+
+	ctx := ns.FillContext(ns.Context())
+	devID := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: ApplicationID},
+		DeviceID:               DeviceID,
+		DevAddr:                &DevAddr,
+	}
+	paths := []downlinkPath{
+		{
+			GatewayIdentifiers: gateways[0],
+			DownlinkPath: &ttnpb.DownlinkPath{
+				Path: &ttnpb.DownlinkPath_UplinkToken{UplinkToken: []byte("testToken0")},
+			},
+		},
+		{
+			GatewayIdentifiers: gateways[1],
+			DownlinkPath: &ttnpb.DownlinkPath{
+				Path: &ttnpb.DownlinkPath_UplinkToken{UplinkToken: []byte("testToken1")},
+			},
+		},
+	}
+	expectScheduled := func(token string) *ttnpb.DownlinkMessage {
+		select {
+		case msg := <-scheduled:
+			a.So(msg.GetRequest().DownlinkPaths, should.HaveLength, 1)
+			a.So(msg.GetRequest().DownlinkPaths[0].GetUplinkToken(), should.Resemble, []byte(token))
+			return msg
+		case <-time.After(Timeout):
+			t.Fatal("Timed out waiting for ScheduleDownlink to be called")
+			return nil
+		}
+	}
+	ackScheduled := func(msg *ttnpb.DownlinkMessage, frequency uint64, drIdx ttnpb.DataRateIndex, result ttnpb.TxAcknowledgment_Result) error {
+		msg = deepcopy.Copy(msg).(*ttnpb.DownlinkMessage)
+		msg.Settings = &ttnpb.DownlinkMessage_Scheduled{
+			Scheduled: &ttnpb.TxSettings{
+				Frequency:     frequency,
+				DataRateIndex: drIdx,
+			},
+		}
+		return ns.handleTxAcknowledgment(ctx, &ttnpb.TxAcknowledgment{
+			Result:          result,
+			DownlinkMessage: msg,
+		})
+	}
+
+	down, err := ns.scheduleDownlinkByPaths(ctx, &ttnpb.TxRequest{
+		Class:            ttnpb.CLASS_A,
+		Rx1Frequency:     868100000,
+		Rx1DataRateIndex: ttnpb.DATA_RATE_5,
+		Rx2Frequency:     869525000,
+		Rx2DataRateIndex: ttnpb.DATA_RATE_0,
+	}, devID, []byte{0x42}, paths...)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	msg := expectScheduled("testToken0")
+	a.So(msg.RawPayload, should.Resemble, down.RawPayload)
+
+	// Rx1 fails on the first path, so Rx2 is attempted on the same path.
+	a.So(ackScheduled(msg, 868100000, ttnpb.DATA_RATE_5, ttnpb.TxAcknowledgment_TOO_LATE), should.BeNil)
+	msg = expectScheduled("testToken0")
+	a.So(msg.GetRequest().Rx1Frequency, should.BeZeroValue)
+	a.So(msg.GetRequest().Rx2Frequency, should.Equal, 869525000)
+
+	// Rx2 fails on the first path, so the next path is attempted.
+	a.So(ackScheduled(msg, 869525000, ttnpb.DATA_RATE_0, ttnpb.TxAcknowledgment_COLLISION_PACKET), should.BeNil)
+	msg = expectScheduled("testToken1")
+	a.So(msg.GetRequest().Rx1Frequency, should.BeZeroValue)
+
+	// The transmission succeeds, so it is not pending anymore.
+	a.So(ackScheduled(msg, 869525000, ttnpb.DATA_RATE_0, ttnpb.TxAcknowledgment_SUCCESS), should.BeNil)
+	a.So(ackScheduled(msg, 869525000, ttnpb.DATA_RATE_0, ttnpb.TxAcknowledgment_SUCCESS), should.EqualErrorOrDefinition, errTransmissionNotFound)
+
+	// No paths are left after the last path fails.
+	_, err = ns.scheduleDownlinkByPaths(ctx, &ttnpb.TxRequest{
+		Class:            ttnpb.CLASS_C,
+		Rx2Frequency:     869525000,
+		Rx2DataRateIndex: ttnpb.DATA_RATE_0,
+	}, devID, []byte{0x42}, paths[1])
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	msg = expectScheduled("testToken1")
+	a.So(ackScheduled(msg, 869525000, ttnpb.DATA_RATE_0, ttnpb.TxAcknowledgment_TOO_EARLY), should.BeNil)
+	select {
+	case <-scheduled:
+		t.Fatal("ScheduleDownlink must not be called")
+	case <-time.After(Timeout / 10):
+	}
+
+	// Downlinks transmitted on behalf of a roaming partner have no end device, but are retried as well.
+	_, err = ns.scheduleDownlinkByPaths(ctx, &ttnpb.TxRequest{
+		Class:            ttnpb.CLASS_A,
+		Rx1Frequency:     868100000,
+		Rx1DataRateIndex: ttnpb.DATA_RATE_5,
+		Rx2Frequency:     869525000,
+		Rx2DataRateIndex: ttnpb.DATA_RATE_0,
+	}, nil, []byte{0x42}, paths[0])
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	msg = expectScheduled("testToken0")
+	a.So(msg.EndDeviceIDs, should.BeNil)
+	a.So(ackScheduled(msg, 868100000, ttnpb.DATA_RATE_5, ttnpb.TxAcknowledgment_TOO_LATE), should.BeNil)
+	msg = expectScheduled("testToken0")
+	a.So(msg.GetRequest().Rx1Frequency, should.BeZeroValue)
+	a.So(ackScheduled(msg, 869525000, ttnpb.DATA_RATE_0, ttnpb.TxAcknowledgment_SUCCESS), should.BeNil)
+}
@@ -19,13 +19,16 @@ import (
 )
 
 var (
+	errADRAlgorithmNotFound      = errors.DefineNotFound("adr_algorithm_not_found", "ADR algorithm `{name}` not found")
 	errCIDOutOfRange             = errors.DefineInvalidArgument("cid_out_of_range", "CID must be in range from {min} to {max}")
 	errComputeMIC                = errors.DefineInvalidArgument("compute_mic", "failed to compute MIC")
+	errConfirmedMulticast        = errors.DefineInvalidArgument("confirmed_multicast_downlink", "confirmed downlink is not supported for multicast device")
 	errCorruptedMACState         = errors.DefineCorruption("corrupted_mac_state", "MAC state is corrupted")
 	errDataRateNotFound          = errors.DefineNotFound("data_rate_not_found", "data rate not found")
 	errDecodePayload             = errors.DefineInvalidArgument("decode_payload", "failed to decode payload")
 	errDecrypt                   = errors.DefineInvalidArgument("decrypt", "failed to decrypt")
 	errDeviceNotFound            = errors.DefineNotFound("device_not_found", "device not found")
+	errDuplicateADRAlgorithm     = errors.DefineAlreadyExists("duplicate_adr_algorithm", "an ADR algorithm with name `{name}` is already registered")
 	errDuplicateCIDHandler       = errors.DefineAlreadyExists("duplicate_cid_handler", "a handler for MAC command with CID {cid} is already registered")
 	errDuplicateIdentifiers      = errors.DefineAlreadyExists("duplicate_identifiers", "a device identified by the identifiers already exists")
 	errDuplicateSubscription     = errors.DefineAlreadyExists("duplicate_subscription", "another subscription already started")
@@ -40,6 +43,7 @@ var (
 	errInvalidClassBTimeout      = errors.DefineInvalidArgument("class_b_timeout", "invalid class B timeout")
 	errInvalidClassCTimeout      = errors.DefineInvalidArgument("class_c_timeout", "invalid class C timeout")
 	errInvalidConfiguration      = errors.DefineInvalidArgument("configuration", "invalid configuration")
+	errInvalidADRAlgorithm       = errors.DefineInvalidArgument("invalid_adr_algorithm", "invalid ADR algorithm `{name}`")
 	errInvalidDataRate           = errors.DefineInvalidArgument("data_rate", "invalid data rate")
 	errInvalidFieldMask          = errors.DefineInvalidArgument("field_mask", "invalid field mask")
 	errInvalidFNwkSIntKey        = errors.DefineInvalidArgument("invalid_f_nwk_s_int_key", "invalid FNwkSIntKey")
@@ -49,21 +53,29 @@ var (
 	errInvalidSNwkSIntKey        = errors.DefineInvalidArgument("invalid_s_nwk_s_int_key", "invalid SNwkSIntKey")
 	errJoinServerNotFound        = errors.DefineNotFound("join_server_not_found", "Join Server not found")
 	errMACRequestNotFound        = errors.DefineInvalidArgument("mac_request_not_found", "MAC response received, but corresponding request not found")
+	errMulticastClassA           = errors.DefineInvalidArgument("multicast_class_a", "multicast device must support class B or C")
+	errMulticastJoin             = errors.DefineInvalidArgument("multicast_join", "multicast device must not support join")
 	errNoFrequencyPlan           = errors.DefineInvalidArgument("no_frequency_plan", "no frequency plan specified")
 	errNoMACSettings             = errors.DefineInvalidArgument("no_mac_settings", "no mac settings specified")
+	errNoMulticastGateways       = errors.DefineInvalidArgument("no_multicast_gateways", "no gateways specified for multicast downlink")
 	errNoPath                    = errors.DefineNotFound("no_downlink_path", "no downlink path available")
 	errNoPayload                 = errors.DefineInvalidArgument("no_payload", "no message payload specified")
 	errNoRekey                   = errors.DefineInvalidArgument("no_rekey", "rekey not received after join-accept")
+	errNoRoamingPeer             = errors.DefineNotFound("no_roaming_peer", "no roaming peer for DevAddr `{dev_addr}`")
 	errOutdatedData              = errors.DefineNotFound("outdated_data", "data is outdated")
 	errRawPayloadTooShort        = errors.Define("raw_payload_too_short", "length of RawPayload must not be less than 4")
+	errRoamingPeerNotFound       = errors.DefineNotFound("roaming_peer_not_found", "roaming peer with NetID `{net_id}` not found")
+	errRoamingResult             = errors.DefineAborted("roaming_result", "roaming peer `{net_id}` answered with result `{result_code}`: {description}")
 	errSchedule                  = errors.Define("schedule", "all downlink scheduling attempts failed")
 	errScheduleTooSoon           = errors.DefineUnavailable("schedule_too_soon", "confirmed downlink is scheduled too soon")
+	errTransmissionNotFound      = errors.DefineNotFound("transmission_not_found", "pending downlink transmission not found")
 	errUnknownBand               = errors.Define("unknown_band", "band is unknown")
 	errUnknownChannel            = errors.Define("unknown_chanel", "channel is unknown")
 	errUnknownFNwkSIntKey        = errors.DefineNotFound("unknown_f_nwk_s_int_key", "FNwkSIntKey is unknown")
 	errUnknownFrequencyPlan      = errors.Define("unknown_frequency_plan", "frequency plan is unknown")
 	errUnknownMACState           = errors.DefineFailedPrecondition("unknown_mac_state", "MAC state is unknown")
 	errUnknownNwkSEncKey         = errors.DefineNotFound("unknown_nwk_s_enc_key", "NwkSEncKey is unknown")
+	errUnknownRFRegion           = errors.DefineInvalidArgument("unknown_rf_region", "RFRegion `{rf_region}` is unknown")
 	errUnknownSNwkSIntKey        = errors.DefineNotFound("unknown_s_nwk_s_int_key", "SNwkSIntKey is unknown")
 	errUnsupportedLoRaWANVersion = errors.DefineInvalidArgument("unsupported_lorawan_version", "unsupported LoRaWAN version: {version}", "version")
 	errUplinkChannelNotFound     = errors.DefineNotFound("uplink_channel_not_found", "uplink channel not found")
@@ -93,6 +93,21 @@ func (ns *NetworkServer) LinkApplication(link ttnpb.AsNs_LinkApplicationServer)
 	}
 }
 
+// validateMulticastDownlinks checks whether downs can be transmitted to a multicast group.
+// Multicast groups do not send uplink messages, hence, downlink messages cannot be confirmed
+// and the gateways to transmit the downlink messages must be specified.
+func validateMulticastDownlinks(downs ...*ttnpb.ApplicationDownlink) error {
+	for _, down := range downs {
+		if down.Confirmed {
+			return errConfirmedMulticast
+		}
+		if down.ClassBC == nil || len(down.ClassBC.Gateways) == 0 {
+			return errNoMulticastGateways
+		}
+	}
+	return nil
+}
+
 // DownlinkQueueReplace is called by the Application Server to completely replace the downlink queue for a device.
 func (ns *NetworkServer) DownlinkQueueReplace(ctx context.Context, req *ttnpb.DownlinkQueueRequest) (*pbtypes.Empty, error) {
 	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_LINK); err != nil {
@@ -104,11 +119,17 @@ func (ns *NetworkServer) DownlinkQueueReplace(ctx context.Context, req *ttnpb.Do
 	dev, err := ns.devices.SetByID(ctx, req.EndDeviceIdentifiers.ApplicationIdentifiers, req.EndDeviceIdentifiers.DeviceID, []string{
 		"queued_application_downlinks",
 		"mac_state.device_class",
+		"multicast",
 	},
 		func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 			if dev == nil {
 				return nil, nil, errDeviceNotFound
 			}
+			if dev.Multicast {
+				if err := validateMulticastDownlinks(req.Downlinks...); err != nil {
+					return nil, nil, err
+				}
+			}
 			dev.QueuedApplicationDownlinks = req.Downlinks
 			return dev, []string{"queued_application_downlinks"}, nil
 		})
@@ -141,11 +162,17 @@ func (ns *NetworkServer) DownlinkQueuePush(ctx context.Context, req *ttnpb.Downl
 		[]string{
 			"queued_application_downlinks",
 			"mac_state.device_class",
+			"multicast",
 		},
 		func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 			if dev == nil {
 				return nil, nil, errDeviceNotFound
 			}
+			if dev.Multicast {
+				if err := validateMulticastDownlinks(req.Downlinks...); err != nil {
+					return nil, nil, err
+				}
+			}
 			dev.QueuedApplicationDownlinks = append(dev.QueuedApplicationDownlinks, req.Downlinks...)
 			return dev, []string{"queued_application_downlinks"}, nil
 		})
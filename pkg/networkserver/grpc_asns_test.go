@@ -429,6 +429,62 @@ func TestDownlinkQueuePush(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:    "multicast/confirmed downlink",
+			Context: authorizedCtx,
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ids,
+				Multicast:            true,
+				MACState: &ttnpb.MACState{
+					DeviceClass: ttnpb.CLASS_C,
+				},
+			},
+			Request: &ttnpb.DownlinkQueueRequest{
+				EndDeviceIdentifiers: ids,
+				Downlinks: []*ttnpb.ApplicationDownlink{
+					{
+						Confirmed:  true,
+						FCnt:       42,
+						FPort:      1,
+						FRMPayload: []byte("test"),
+						ClassBC: &ttnpb.ApplicationDownlink_ClassBC{
+							Gateways: []*ttnpb.GatewayAntennaIdentifiers{
+								{GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "test-gtw"}},
+							},
+						},
+					},
+				},
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				a := assertions.New(t)
+				return a.So(err, should.BeError) && a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			},
+		},
+		{
+			Name:    "multicast/no gateways",
+			Context: authorizedCtx,
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ids,
+				Multicast:            true,
+				MACState: &ttnpb.MACState{
+					DeviceClass: ttnpb.CLASS_C,
+				},
+			},
+			Request: &ttnpb.DownlinkQueueRequest{
+				EndDeviceIdentifiers: ids,
+				Downlinks: []*ttnpb.ApplicationDownlink{
+					{
+						FCnt:       42,
+						FPort:      1,
+						FRMPayload: []byte("test"),
+					},
+				},
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				a := assertions.New(t)
+				return a.So(err, should.BeError) && a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			},
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
@@ -40,14 +40,76 @@ func validABPSessionKey(key *ttnpb.KeyEnvelope) bool {
 		!bytes.Equal(key.Key, bytes.Repeat([]byte{0}, 16))
 }
 
+// multicastFields are the fields that determine whether the multicast settings of a device are valid.
+var multicastFields = []string{
+	"multicast",
+	"supports_class_b",
+	"supports_class_c",
+	"supports_join",
+}
+
+// validateMulticast validates the multicast settings of stored with the fields in paths set from update.
+// stored is nil if the device does not exist yet.
+func validateMulticast(stored, update *ttnpb.EndDevice, paths []string) error {
+	var dev ttnpb.EndDevice
+	if stored != nil {
+		dev.Multicast = stored.Multicast
+		dev.SupportsClassB = stored.SupportsClassB
+		dev.SupportsClassC = stored.SupportsClassC
+		dev.SupportsJoin = stored.SupportsJoin
+	}
+	if ttnpb.HasAnyField(paths, "multicast") {
+		dev.Multicast = update.Multicast
+	}
+	if ttnpb.HasAnyField(paths, "supports_class_b") {
+		dev.SupportsClassB = update.SupportsClassB
+	}
+	if ttnpb.HasAnyField(paths, "supports_class_c") {
+		dev.SupportsClassC = update.SupportsClassC
+	}
+	if ttnpb.HasAnyField(paths, "supports_join") {
+		dev.SupportsJoin = update.SupportsJoin
+	}
+	if !dev.Multicast {
+		return nil
+	}
+	if dev.SupportsJoin {
+		return errMulticastJoin
+	}
+	if !dev.SupportsClassB && !dev.SupportsClassC {
+		return errMulticastClassA
+	}
+	return nil
+}
+
 // Set implements NsEndDeviceRegistryServer.
 func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest) (*ttnpb.EndDevice, error) {
 	if err := rights.RequireApplication(ctx, req.Device.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
 		return nil, err
 	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "mac_settings.adr_algorithm") {
+		if _, err := ns.adrAlgorithm(req.Device.MACSettings.GetADRAlgorithm()); err != nil {
+			return nil, errInvalidADRAlgorithm.WithAttributes("name", req.Device.MACSettings.GetADRAlgorithm()).WithCause(err)
+		}
+	}
+	gets := req.FieldMask.Paths
+	checkMulticast := ttnpb.HasAnyField(req.FieldMask.Paths, multicastFields...)
+	if checkMulticast {
+		gets = append(make([]string, 0, len(gets)+len(multicastFields)), gets...)
+		for _, path := range multicastFields {
+			if !ttnpb.HasAnyField(gets, path) {
+				gets = append(gets, path)
+			}
+		}
+	}
 	var addDownlinkTask bool
-	dev, err := ns.devices.SetByID(ctx, req.Device.EndDeviceIdentifiers.ApplicationIdentifiers, req.Device.EndDeviceIdentifiers.DeviceID, req.FieldMask.Paths, func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+	dev, err := ns.devices.SetByID(ctx, req.Device.EndDeviceIdentifiers.ApplicationIdentifiers, req.Device.EndDeviceIdentifiers.DeviceID, gets, func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 		paths := req.FieldMask.Paths
+		if checkMulticast {
+			if err := validateMulticast(dev, &req.Device, paths); err != nil {
+				return nil, nil, err
+			}
+		}
 		if dev != nil {
 			addDownlinkTask = ttnpb.HasAnyField(paths, "mac_state.device_class") && req.Device.MACState.DeviceClass != ttnpb.CLASS_A ||
 				ttnpb.HasAnyField(paths, "queued_application_downlinks") && len(req.Device.QueuedApplicationDownlinks) > 0
@@ -268,6 +268,7 @@ func TestDeviceRegistrySet(t *testing.T) {
 					"lorawan_phy_version",
 					"lorawan_version",
 					"mac_settings.use_adr",
+					"multicast",
 					"resets_f_cnt",
 					"resets_join_nonces",
 					"supports_class_b",
@@ -347,6 +348,161 @@ func TestDeviceRegistrySet(t *testing.T) {
 				return a.So(test.MustCounterFromContext(ctx, setByIDCallKey{}), should.Equal, 1)
 			},
 		},
+		{
+			Name: "Update multicast device with partial field mask",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(ctx, ids.ApplicationIdentifiers): {
+							Rights: []ttnpb.Right{
+								ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+							},
+						},
+					},
+				})
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				defer test.MustIncrementContextCounter(ctx, setByIDCallKey{}, 1)
+				a := assertions.New(test.MustTFromContext(ctx))
+				a.So(gets, should.HaveSameElementsDeep, []string{
+					"multicast",
+					"session.dev_addr",
+					"supports_class_b",
+					"supports_class_c",
+					"supports_join",
+				})
+
+				dev, sets, err := f(&ttnpb.EndDevice{
+					EndDeviceIdentifiers: ids,
+					SupportsClassC:       true,
+				})
+				a.So(err, should.BeNil)
+				a.So(sets, should.HaveSameElementsDeep, []string{
+					"multicast",
+					"session.dev_addr",
+				})
+				return dev, err
+			},
+			Request: &ttnpb.SetEndDeviceRequest{
+				Device: ttnpb.EndDevice{
+					EndDeviceIdentifiers: ids,
+					Multicast:            true,
+					Session: &ttnpb.Session{
+						DevAddr: DevAddr,
+					},
+				},
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{
+						"multicast",
+						"session.dev_addr",
+					},
+				},
+			},
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ids,
+				Multicast:            true,
+				Session: &ttnpb.Session{
+					DevAddr: DevAddr,
+				},
+			},
+			ContextAssertion: func(ctx context.Context) bool {
+				a := assertions.New(test.MustTFromContext(ctx))
+				return a.So(test.MustCounterFromContext(ctx, setByIDCallKey{}), should.Equal, 1)
+			},
+		},
+
+		{
+			Name: "Disable class C on multicast device",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(ctx, ids.ApplicationIdentifiers): {
+							Rights: []ttnpb.Right{
+								ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+							},
+						},
+					},
+				})
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				defer test.MustIncrementContextCounter(ctx, setByIDCallKey{}, 1)
+				a := assertions.New(test.MustTFromContext(ctx))
+
+				dev, sets, err := f(&ttnpb.EndDevice{
+					EndDeviceIdentifiers: ids,
+					Multicast:            true,
+					SupportsClassC:       true,
+				})
+				a.So(errors.IsInvalidArgument(err), should.BeTrue)
+				a.So(dev, should.BeNil)
+				a.So(sets, should.BeNil)
+				return dev, err
+			},
+			Request: &ttnpb.SetEndDeviceRequest{
+				Device: ttnpb.EndDevice{
+					EndDeviceIdentifiers: ids,
+				},
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{
+						"supports_class_c",
+					},
+				},
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				if !assertions.New(t).So(errors.IsInvalidArgument(err), should.BeTrue) {
+					t.Errorf("Received error: %s", err)
+					return false
+				}
+				return true
+			},
+			ContextAssertion: func(ctx context.Context) bool {
+				a := assertions.New(test.MustTFromContext(ctx))
+				return a.So(test.MustCounterFromContext(ctx, setByIDCallKey{}), should.Equal, 1)
+			},
+		},
+
+		{
+			Name: "Unknown ADR algorithm",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(ctx, ids.ApplicationIdentifiers): {
+							Rights: []ttnpb.Right{
+								ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+							},
+						},
+					},
+				})
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				test.MustTFromContext(ctx).Fatal("SetByIDFunc must not be called")
+				panic("Unreachable")
+			},
+			Request: &ttnpb.SetEndDeviceRequest{
+				Device: ttnpb.EndDevice{
+					EndDeviceIdentifiers: ids,
+					MACSettings: &ttnpb.MACSettings{
+						ADRAlgorithm: "unknown",
+					},
+				},
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{
+						"mac_settings.adr_algorithm",
+					},
+				},
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				if !assertions.New(t).So(errors.IsInvalidArgument(err), should.BeTrue) {
+					t.Errorf("Received error: %s", err)
+					return false
+				}
+				return true
+			},
+			ContextAssertion: func(ctx context.Context) bool {
+				a := assertions.New(test.MustTFromContext(ctx))
+				return a.So(test.MustCounterFromContext(ctx, setByIDCallKey{}), should.Equal, 0)
+			},
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
@@ -27,6 +27,7 @@ import (
 	"github.com/mohae/deepcopy"
 	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
@@ -106,6 +107,7 @@ func (ns *NetworkServer) matchDevice(ctx context.Context, up *ttnpb.UplinkMessag
 			"frequency_plan_id",
 			"lorawan_phy_version",
 			"mac_state",
+			"multicast",
 			"pending_session",
 			"recent_downlinks",
 			"recent_uplinks",
@@ -114,7 +116,7 @@ func (ns *NetworkServer) matchDevice(ctx context.Context, up *ttnpb.UplinkMessag
 			"uses_32_bit_f_cnt",
 		},
 		func(dev *ttnpb.EndDevice) bool {
-			if dev.MACState == nil {
+			if dev.MACState == nil || dev.Multicast {
 				return true
 			}
 
@@ -263,15 +265,13 @@ outer:
 			continue
 		}
 
-		var fNwkSIntKey types.AES128Key
-		if dev.matchedSession.FNwkSIntKey.KEKLabel != "" {
-			// TODO: https://github.com/TheThingsNetwork/lorawan-stack/issues/5
-			panic("unsupported")
+		fNwkSIntKey, err := cryptoutil.UnwrapAES128Key(*dev.matchedSession.FNwkSIntKey, ns.KeyVault)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to unwrap FNwkSIntKey")
+			continue
 		}
-		copy(fNwkSIntKey[:], dev.matchedSession.FNwkSIntKey.Key[:])
 
 		var computedMIC [4]byte
-		var err error
 		if dev.MACState.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0 {
 			computedMIC, err = crypto.ComputeLegacyUplinkMIC(
 				fNwkSIntKey,
@@ -285,12 +285,11 @@ outer:
 				continue
 			}
 
-			var sNwkSIntKey types.AES128Key
-			if dev.matchedSession.SNwkSIntKey.KEKLabel != "" {
-				// TODO: https://github.com/TheThingsNetwork/lorawan-stack/issues/5
-				panic("unsupported")
+			sNwkSIntKey, err := cryptoutil.UnwrapAES128Key(*dev.matchedSession.SNwkSIntKey, ns.KeyVault)
+			if err != nil {
+				logger.WithError(err).Warn("Failed to unwrap SNwkSIntKey")
+				continue
 			}
-			copy(sNwkSIntKey[:], dev.matchedSession.SNwkSIntKey.Key[:])
 
 			var confFCnt uint32
 			if pld.Ack {
@@ -353,6 +352,11 @@ func (ns *NetworkServer) handleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	logger.Debug("Matching device...")
 	matched, ses, err := ns.matchDevice(ctx, up)
 	if err != nil {
+		if errors.IsNotFound(err) {
+			if peer, ok := ns.roamingPeerByDevAddr(ctx, pld.DevAddr); ok {
+				return ns.forwardRoamingUplink(ctx, peer, up, acc)
+			}
+		}
 		registerDropDataUplink(ctx, nil, up, err)
 		log.FromContext(ctx).WithError(err).Warn("Failed to match device")
 		return errDeviceNotFound.WithCause(err)
@@ -403,12 +407,10 @@ func (ns *NetworkServer) handleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 			return errUnknownNwkSEncKey
 		}
 
-		var key types.AES128Key
-		if ses.NwkSEncKey.KEKLabel != "" {
-			// TODO: https://github.com/TheThingsNetwork/lorawan-stack/issues/5
-			panic("unsupported")
+		key, err := cryptoutil.UnwrapAES128Key(*ses.NwkSEncKey, ns.KeyVault)
+		if err != nil {
+			return err
 		}
-		copy(key[:], ses.NwkSEncKey.Key[:])
 
 		mac, err = crypto.DecryptUplink(key, *matched.EndDeviceIdentifiers.DevAddr, pld.FCnt, mac)
 		if err != nil {
@@ -622,7 +624,7 @@ func (ns *NetworkServer) handleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 				case ttnpb.CID_PING_SLOT_CHANNEL:
 					err = handlePingSlotChannelAns(ctx, stored, cmd.GetPingSlotChannelAns())
 				case ttnpb.CID_BEACON_TIMING:
-					err = handleBeaconTimingReq(ctx, stored)
+					err = handleBeaconTimingReq(ctx, stored, up, ns.FrequencyPlans)
 				case ttnpb.CID_BEACON_FREQ:
 					err = handleBeaconFreqAns(ctx, stored, cmd.GetBeaconFreqAns())
 				case ttnpb.CID_DEVICE_MODE:
@@ -669,7 +671,7 @@ func (ns *NetworkServer) handleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 				stored.RecentADRUplinks = append(stored.RecentADRUplinks[:0], stored.RecentADRUplinks[len(stored.RecentADRUplinks)-recentUplinkCount:]...)
 			}
 
-			if err := adaptDataRate(stored, ns.FrequencyPlans); err != nil {
+			if err := ns.adaptDataRate(ctx, stored); err != nil {
 				handleErr = true
 				return nil, nil, err
 			}
@@ -707,6 +709,9 @@ func (ns *NetworkServer) handleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 		logger.Warn("Application Server not found, not forwarding uplink")
 	} else {
 		registerForwardDataUplink(ctx, &matched.EndDeviceIdentifiers, up)
+		if ns.solveLocations {
+			ns.solveLocation(asCtx, matched.EndDeviceIdentifiers, up)
+		}
 	}
 	return ns.downlinkTasks.Add(ctx, matched.EndDeviceIdentifiers, time.Now().UTC())
 }
@@ -927,7 +932,26 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	if err := clusterauth.Authorized(ctx); err != nil {
 		return nil, err
 	}
+	if err := ns.processUplink(ctx, up); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// HandleTxAcknowledgment is called by the Gateway Server when a downlink message is transmitted or the transmission failed.
+// If the transmission failed, the downlink message is retried on the next path or Rx window, if any.
+func (ns *NetworkServer) HandleTxAcknowledgment(ctx context.Context, ack *ttnpb.TxAcknowledgment) (*pbtypes.Empty, error) {
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+	if err := ns.handleTxAcknowledgment(ctx, ack); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
 
+// processUplink deduplicates and handles the uplink message up.
+func (ns *NetworkServer) processUplink(ctx context.Context, up *ttnpb.UplinkMessage) error {
 	ctx = events.ContextWithCorrelationID(ctx, append(
 		up.CorrelationIDs,
 		fmt.Sprintf("ns:uplink:%s", events.NewCorrelationID()),
@@ -939,14 +963,14 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	logger := log.FromContext(ctx)
 
 	if up.Payload.Major != ttnpb.Major_LORAWAN_R1 {
-		return nil, errUnsupportedLoRaWANVersion.WithAttributes(
+		return errUnsupportedLoRaWANVersion.WithAttributes(
 			"major", up.Payload.Major,
 		)
 	}
 
 	if up.Payload.Payload == nil {
 		if err := lorawan.UnmarshalMessage(up.RawPayload, up.Payload); err != nil {
-			return nil, errDecodePayload.WithCause(err)
+			return errDecodePayload.WithCause(err)
 		}
 	}
 
@@ -955,7 +979,7 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	if ok {
 		logger.Debug("Dropped duplicate uplink")
 		registerReceiveUplinkDuplicate(ctx, up)
-		return ttnpb.Empty, nil
+		return nil
 	}
 	registerReceiveUplink(ctx, up)
 
@@ -970,15 +994,15 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	switch up.Payload.MType {
 	case ttnpb.MType_CONFIRMED_UP, ttnpb.MType_UNCONFIRMED_UP:
 		logger.Debug("Handling data uplink...")
-		return ttnpb.Empty, ns.handleUplink(ctx, up, acc)
+		return ns.handleUplink(ctx, up, acc)
 	case ttnpb.MType_JOIN_REQUEST:
 		logger.Debug("Handling join-request...")
-		return ttnpb.Empty, ns.handleJoin(ctx, up, acc)
+		return ns.handleJoin(ctx, up, acc)
 	case ttnpb.MType_REJOIN_REQUEST:
 		logger.Debug("Handling rejoin-request...")
-		return ttnpb.Empty, ns.handleRejoin(ctx, up, acc)
+		return ns.handleRejoin(ctx, up, acc)
 	default:
 		logger.Error("Unmatched MType")
-		return ttnpb.Empty, nil
+		return nil
 	}
 }
@@ -0,0 +1,61 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"strconv"
+
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/locationsolver"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// solveLocation estimates the location of the end device from the deduplicated metadata of the uplink message,
+// and sends the solved location to the Application Server.
+func (ns *NetworkServer) solveLocation(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, up *ttnpb.UplinkMessage) {
+	logger := log.FromContext(ctx)
+	loc, err := locationsolver.Solve(up.RxMetadata)
+	if err != nil {
+		logger.WithError(err).Debug("Failed to solve location")
+		return
+	}
+	events.Publish(evtSolveLocation(ctx, ids, loc))
+
+	gtws := make(map[string]struct{}, len(up.RxMetadata))
+	for _, md := range up.RxMetadata {
+		if md.Location != nil {
+			gtws[unique.ID(ctx, md.GatewayIdentifiers)] = struct{}{}
+		}
+	}
+	ok, err := ns.handleASUplink(ctx, ids.ApplicationIdentifiers, &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: ids,
+		CorrelationIDs:       up.CorrelationIDs,
+		Up: &ttnpb.ApplicationUp_LocationSolved{LocationSolved: &ttnpb.ApplicationLocation{
+			Service:  locationsolver.Service,
+			Location: *loc,
+			Attributes: map[string]string{
+				"gateways": strconv.Itoa(len(gtws)),
+			},
+		}},
+	})
+	if err != nil {
+		logger.WithError(err).Warn("Failed to forward solved location to AS")
+	} else if !ok {
+		logger.Warn("Application Server not found, not forwarding solved location")
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/locationsolver"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestSolveLocation(t *testing.T) {
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: ApplicationID},
+		DeviceID:               DeviceID,
+	}
+
+	for _, tc := range []struct {
+		Name       string
+		RxMetadata []*ttnpb.RxMetadata
+		Expected   *ttnpb.ApplicationLocation
+	}{
+		{
+			Name: "NoLocations",
+			RxMetadata: []*ttnpb.RxMetadata{
+				{GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-0"}, RSSI: -80},
+			},
+		},
+		{
+			Name: "RSSI",
+			RxMetadata: []*ttnpb.RxMetadata{
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-0"},
+					RSSI:               -80,
+					Location:           &ttnpb.Location{Latitude: 52.37, Longitude: 4.89, Altitude: 10},
+				},
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-1"},
+					RSSI:               -90,
+				},
+			},
+			Expected: &ttnpb.ApplicationLocation{
+				Service: locationsolver.Service,
+				Location: ttnpb.Location{
+					Latitude:  52.37,
+					Longitude: 4.89,
+					Altitude:  10,
+					Source:    ttnpb.SOURCE_LORA_RSSI_GEOLOCATION,
+				},
+				Attributes: map[string]string{
+					"gateways": "1",
+				},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			var sent []*ttnpb.ApplicationUp
+			ns := &NetworkServer{
+				handleASUplink: func(_ context.Context, appIDs ttnpb.ApplicationIdentifiers, up *ttnpb.ApplicationUp) (bool, error) {
+					a.So(appIDs, should.Resemble, ids.ApplicationIdentifiers)
+					sent = append(sent, up)
+					return true, nil
+				},
+			}
+			ns.solveLocation(test.Context(), ids, &ttnpb.UplinkMessage{
+				RxMetadata:     tc.RxMetadata,
+				CorrelationIDs: []string{"test"},
+			})
+
+			if tc.Expected == nil {
+				a.So(sent, should.BeEmpty)
+				return
+			}
+			if !a.So(sent, should.HaveLength, 1) {
+				t.FailNow()
+			}
+			a.So(sent[0].EndDeviceIdentifiers, should.Resemble, ids)
+			a.So(sent[0].CorrelationIDs, should.Resemble, []string{"test"})
+			a.So(sent[0].GetLocationSolved(), should.Resemble, tc.Expected)
+		})
+	}
+}
@@ -16,12 +16,35 @@ package networkserver
 
 import (
 	"context"
+	"time"
 
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/gpstime"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
-func handleBeaconTimingReq(ctx context.Context, dev *ttnpb.EndDevice) error {
-	// TODO: Support Class B (https://github.com/TheThingsNetwork/lorawan-stack/issues/19)
-	// NOTE: This command is deprecated in LoRaWAN 1.1
+var (
+	evtReceiveBeaconTimingRequest = defineReceiveMACRequestEvent("beacon_timing", "beacon timing")()
+	evtEnqueueBeaconTimingAnswer  = defineEnqueueMACAnswerEvent("beacon_timing", "beacon timing")()
+)
+
+// NOTE: This command is deprecated in LoRaWAN 1.1
+func handleBeaconTimingReq(ctx context.Context, dev *ttnpb.EndDevice, msg *ttnpb.UplinkMessage, fps *frequencyplans.Store) error {
+	events.Publish(evtReceiveBeaconTimingRequest(ctx, dev.EndDeviceIdentifiers, nil))
+
+	_, band, err := getDeviceBandVersion(dev, fps)
+	if err != nil {
+		return err
+	}
+
+	beaconTime := beaconTimeBefore(msg.ReceivedAt) + int64(beaconPeriod/time.Second)
+	ans := &ttnpb.MACCommand_BeaconTimingAns{
+		Delay:        uint32(gpstime.Parse(beaconTime).Sub(msg.ReceivedAt) / pingSlotLen),
+		ChannelIndex: beaconChannelIndex(band, beaconTime),
+	}
+	dev.MACState.QueuedResponses = append(dev.MACState.QueuedResponses, ans.MACCommand())
+
+	events.Publish(evtEnqueueBeaconTimingAnswer(ctx, dev.EndDeviceIdentifiers, ans))
 	return nil
 }
@@ -16,9 +16,13 @@ package networkserver
 
 import (
 	"testing"
+	"time"
 
 	"github.com/mohae/deepcopy"
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/gpstime"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
@@ -28,26 +32,51 @@ func TestHandleBeaconTimingReq(t *testing.T) {
 	for _, tc := range []struct {
 		Name             string
 		Device, Expected *ttnpb.EndDevice
+		Message          *ttnpb.UplinkMessage
+		AssertEvents     func(*testing.T, ...events.Event) bool
 		Error            error
 	}{
 		{
-			Name: "empty queue",
+			Name: "EU/empty queue",
 			Device: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
 				MACState: &ttnpb.MACState{
 					QueuedResponses: []*ttnpb.MACCommand{},
 				},
 			},
 			Expected: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
 				MACState: &ttnpb.MACState{
 					QueuedResponses: []*ttnpb.MACCommand{
-						// TODO: Support Class B (https://github.com/TheThingsNetwork/lorawan-stack/issues/19)
+						(&ttnpb.MACCommand_BeaconTimingAns{
+							Delay:        3333,
+							ChannelIndex: 0,
+						}).MACCommand(),
 					},
 				},
 			},
+			Message: &ttnpb.UplinkMessage{
+				ReceivedAt: gpstime.Parse(128*10000 + 28),
+			},
+			AssertEvents: func(t *testing.T, evs ...events.Event) bool {
+				a := assertions.New(t)
+				return a.So(evs, should.HaveLength, 2) &&
+					a.So(evs[0].Name(), should.Equal, "ns.mac.beacon_timing.request") &&
+					a.So(evs[0].Data(), should.BeNil) &&
+					a.So(evs[1].Name(), should.Equal, "ns.mac.beacon_timing.answer") &&
+					a.So(evs[1].Data(), should.Resemble, &ttnpb.MACCommand_BeaconTimingAns{
+						Delay:        3333,
+						ChannelIndex: 0,
+					})
+			},
 		},
 		{
-			Name: "non-empty queue",
+			Name: "US/non-empty queue",
 			Device: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.USFrequencyPlanID,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
 				MACState: &ttnpb.MACState{
 					QueuedResponses: []*ttnpb.MACCommand{
 						{},
@@ -57,15 +86,34 @@ func TestHandleBeaconTimingReq(t *testing.T) {
 				},
 			},
 			Expected: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.USFrequencyPlanID,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
 				MACState: &ttnpb.MACState{
 					QueuedResponses: []*ttnpb.MACCommand{
 						{},
 						{},
 						{},
-						// TODO: Support Class B (https://github.com/TheThingsNetwork/lorawan-stack/issues/19)
+						(&ttnpb.MACCommand_BeaconTimingAns{
+							Delay:        100,
+							ChannelIndex: 1,
+						}).MACCommand(),
 					},
 				},
 			},
+			Message: &ttnpb.UplinkMessage{
+				ReceivedAt: gpstime.Parse(128 * 10001).Add(-3 * time.Second),
+			},
+			AssertEvents: func(t *testing.T, evs ...events.Event) bool {
+				a := assertions.New(t)
+				return a.So(evs, should.HaveLength, 2) &&
+					a.So(evs[0].Name(), should.Equal, "ns.mac.beacon_timing.request") &&
+					a.So(evs[0].Data(), should.BeNil) &&
+					a.So(evs[1].Name(), should.Equal, "ns.mac.beacon_timing.answer") &&
+					a.So(evs[1].Data(), should.Resemble, &ttnpb.MACCommand_BeaconTimingAns{
+						Delay:        100,
+						ChannelIndex: 1,
+					})
+			},
 		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
@@ -73,12 +121,16 @@ func TestHandleBeaconTimingReq(t *testing.T) {
 
 			dev := deepcopy.Copy(tc.Device).(*ttnpb.EndDevice)
 
-			err := handleBeaconTimingReq(test.Context(), dev)
+			var err error
+			evs := collectEvents(func() {
+				err = handleBeaconTimingReq(test.Context(), dev, tc.Message, frequencyplans.NewStore(test.FrequencyPlansFetcher))
+			})
 			if tc.Error != nil && !a.So(err, should.EqualErrorOrDefinition, tc.Error) ||
 				tc.Error == nil && !a.So(err, should.BeNil) {
 				t.FailNow()
 			}
 			a.So(dev, should.Resemble, tc.Expected)
+			a.So(tc.AssertEvents(t, evs...), should.BeTrue)
 		})
 	}
 }
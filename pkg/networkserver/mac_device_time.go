@@ -43,9 +43,13 @@ func handleDeviceTimeReq(ctx context.Context, dev *ttnpb.EndDevice, msg *ttnpb.U
 	})
 
 	var t time.Time
-	if n := len(ts); n%2 == 1 {
+	switch n := len(ts); {
+	case n == 0:
+		// NOTE: None of the gateways has a synchronized clock, hence fall back to the time the uplink was received by Network Server.
+		t = msg.ReceivedAt
+	case n%2 == 1:
 		t = ts[n/2]
-	} else {
+	default:
 		i := (n - 1) / 2
 		t = time.Unix(0, (ts[i].UnixNano()+ts[i+1].UnixNano())/2)
 	}
@@ -66,6 +66,37 @@ func TestHandleDeviceTimeReq(t *testing.T) {
 					})
 			},
 		},
+		{
+			Name: "empty queue/no gateway time",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					QueuedResponses: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_DeviceTimeAns{
+							Time: time.Unix(42, 42),
+						}).MACCommand(),
+					},
+				},
+			},
+			Message: &ttnpb.UplinkMessage{
+				ReceivedAt: time.Unix(42, 42),
+				RxMetadata: []*ttnpb.RxMetadata{
+					{},
+				},
+			},
+			AssertEvents: func(t *testing.T, evs ...events.Event) bool {
+				a := assertions.New(t)
+				return a.So(evs, should.HaveLength, 2) &&
+					a.So(evs[0].Name(), should.Equal, "ns.mac.device_time.request") &&
+					a.So(evs[0].Data(), should.BeNil) &&
+					a.So(evs[1].Name(), should.Equal, "ns.mac.device_time.answer") &&
+					a.So(evs[1].Data(), should.Resemble, &ttnpb.MACCommand_DeviceTimeAns{
+						Time: time.Unix(42, 42),
+					})
+			},
+		},
 		{
 			Name: "non-empty queue/odd",
 			Device: &ttnpb.EndDevice{
@@ -26,6 +26,8 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/cluster"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/interop"
+	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/hooks"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
@@ -106,6 +108,7 @@ type DownlinkPriorities struct {
 // NetworkServer implements the Network Server component.
 //
 // The Network Server exposes the GsNs, AsNs, DeviceRegistry and ApplicationDownlinkQueue services.
+// If roaming peers are configured, the Network Server exposes the LoRaWAN Backend Interfaces passive roaming endpoint.
 type NetworkServer struct {
 	*component.Component
 
@@ -122,6 +125,7 @@ type NetworkServer struct {
 	hashPool                *sync.Pool
 
 	macHandlers        *sync.Map // ttnpb.MACCommandIdentifier -> MACHandler
+	adrAlgorithms      *sync.Map // string -> ADRAlgorithm
 	downlinkTasks      DownlinkTaskQueue
 	downlinkPriorities DownlinkPriorities
 
@@ -131,6 +135,14 @@ type NetworkServer struct {
 	jsClient NsJsClientFunc
 
 	handleASUplink func(ctx context.Context, ids ttnpb.ApplicationIdentifiers, up *ttnpb.ApplicationUp) (bool, error)
+
+	pendingTransmissions *sync.Map // string -> *pendingTransmission
+
+	solveLocations bool
+
+	roamingPeers         RoamingPeerRegistry
+	roamingTransactionID uint32
+	interopClient        *interop.Client
 }
 
 // Option configures the NetworkServer.
@@ -167,6 +179,13 @@ func WithASUplinkHandler(f func(context.Context, ttnpb.ApplicationIdentifiers, *
 	}
 }
 
+// WithRoamingPeerRegistry overrides the roaming peers, which are configured in Config.Roaming.
+func WithRoamingPeerRegistry(r RoamingPeerRegistry) Option {
+	return func(ns *NetworkServer) {
+		ns.roamingPeers = r
+	}
+}
+
 // WithMACHandler registers a MACHandler for specified CID in 0x80-0xFF range.
 // WithMACHandler panics if a MACHandler for the CID is already registered, or if
 // the CID is out of range.
@@ -188,6 +207,20 @@ func WithMACHandler(cid ttnpb.MACCommandIdentifier, fn MACHandler) Option {
 	}
 }
 
+// WithADRAlgorithm registers an ADRAlgorithm under specified name.
+// Devices select the algorithm by setting the name in MACSettings.
+// WithADRAlgorithm panics if an ADRAlgorithm with the name is already registered.
+func WithADRAlgorithm(name string, alg ADRAlgorithm) Option {
+	return func(ns *NetworkServer) {
+		_, ok := ns.adrAlgorithms.LoadOrStore(name, alg)
+		if ok {
+			panic(errDuplicateADRAlgorithm.WithAttributes(
+				"name", name,
+			))
+		}
+	}
+}
+
 // New returns new NetworkServer.
 func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer, error) {
 	downlinkPriorities, err := conf.DownlinkPriorities.Parse()
@@ -205,6 +238,9 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		metadataAccumulatorPool: &sync.Pool{},
 		hashPool:                &sync.Pool{},
 		macHandlers:             &sync.Map{},
+		adrAlgorithms:           &sync.Map{},
+		pendingTransmissions:    &sync.Map{},
+		solveLocations:          conf.SolveLocations,
 	}
 	ns.hashPool.New = func() interface{} {
 		return fnv.New64a()
@@ -216,6 +252,9 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 	for _, opt := range opts {
 		opt(ns)
 	}
+	for name, alg := range builtinADRAlgorithms {
+		ns.adrAlgorithms.LoadOrStore(name, alg)
+	}
 
 	switch {
 	case ns.deduplicationDone == nil && conf.DeduplicationWindow == 0:
@@ -262,6 +301,23 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		}
 	}
 
+	if ns.roamingPeers == nil && len(conf.Roaming.Peers) > 0 {
+		peers, err := conf.Roaming.Parse()
+		if err != nil {
+			return nil, errInvalidConfiguration.WithCause(err)
+		}
+		ns.roamingPeers = peers
+	}
+	if ns.roamingPeers != nil {
+		ns.interopClient = interop.NewClient(nil)
+		// Roaming peers are authenticated by their TLS client certificate, which can only be verified with a client CA.
+		if c.GetBaseConfig(c.Context()).TLS.ClientCA == "" {
+			log.FromContext(c.Context()).Warn("No TLS client CA configured, not serving passive roaming requests")
+		} else {
+			c.RegisterWeb(interop.NewServer(c.Context(), ns, ns.authorizeRoamingPeer))
+		}
+	}
+
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.GsNs", cluster.HookName, c.ClusterAuthUnaryHook())
 	hooks.RegisterStreamHook("/ttn.lorawan.v3.AsNs", cluster.HookName, c.ClusterAuthStreamHook())
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.AsNs", cluster.HookName, c.ClusterAuthUnaryHook())
@@ -77,6 +77,13 @@ var (
 	evtDropRejoinRequest    = events.Define("ns.up.rejoin.drop", "drop rejoin-request")
 	evtForwardRejoinRequest = events.Define("ns.up.rejoin.forward", "forward rejoin-request")
 
+	evtSolveLocation = events.Define("ns.up.location.solve", "solve end device location")
+
+	evtTransmitDownlinkSuccess   = events.Define("ns.down.transmission.success", "downlink transmission succeeded")
+	evtTransmitDownlinkFail      = events.Define("ns.down.transmission.fail", "downlink transmission failed")
+	evtRetryDownlinkTransmission = events.Define("ns.down.transmission.retry", "retry downlink transmission")
+	evtDropDownlinkTransmission  = events.Define("ns.down.transmission.drop", "drop downlink transmission")
+
 	evtEnqueueProprietaryMACAnswer  = defineEnqueueMACAnswerEvent("proprietary", "proprietary MAC command")
 	evtEnqueueProprietaryMACRequest = defineEnqueueMACRequestEvent("proprietary", "proprietary MAC command")
 	evtReceiveProprietaryMAC        = events.Define("ns.mac.proprietary.receive", "proprietary MAC command received")
@@ -16,10 +16,14 @@ package redis
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/go-redis/redis"
 	"github.com/gogo/protobuf/proto"
+	"github.com/mohae/deepcopy"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -51,8 +55,26 @@ func applyDeviceFieldMask(dst, src *ttnpb.EndDevice, paths ...string) (*ttnpb.En
 }
 
 // DeviceRegistry is an implementation of networkserver.DeviceRegistry.
+// If KEKLabel is set, the keys of the end devices are wrapped with the KEK label using KeyVault at rest.
 type DeviceRegistry struct {
-	Redis *ttnredis.Client
+	Redis    *ttnredis.Client
+	KeyVault crypto.KeyVault
+	KEKLabel string
+}
+
+func (r *DeviceRegistry) wrapKeys(pb *ttnpb.EndDevice) (*ttnpb.EndDevice, error) {
+	if r.KEKLabel == "" {
+		return pb, nil
+	}
+	pb = deepcopy.Copy(pb).(*ttnpb.EndDevice)
+	if err := cryptoutil.WrapKeyEnvelopes(cryptoutil.EndDeviceKeyEnvelopes(pb), r.KEKLabel, r.KeyVault); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+func (r *DeviceRegistry) unwrapKeys(pb *ttnpb.EndDevice) error {
+	return cryptoutil.UnwrapKeyEnvelopes(cryptoutil.EndDeviceKeyEnvelopes(pb), r.KEKLabel, r.KeyVault)
 }
 
 // GetByID gets device by appID, devID.
@@ -69,6 +91,9 @@ func (r *DeviceRegistry) GetByID(ctx context.Context, appID ttnpb.ApplicationIde
 	if err := ttnredis.GetProto(r.Redis, r.Redis.Key(unique.ID(ctx, ids))).ScanProto(pb); err != nil {
 		return nil, err
 	}
+	if err := r.unwrapKeys(pb); err != nil {
+		return nil, err
+	}
 	return applyDeviceFieldMask(nil, pb, paths...)
 }
 
@@ -78,10 +103,15 @@ func (r *DeviceRegistry) GetByEUI(_ context.Context, joinEUI, devEUI types.EUI64
 	if err := ttnredis.FindProto(r.Redis, r.Redis.Key(euiKey, joinEUI.String(), devEUI.String()), r.Redis.Key).ScanProto(pb); err != nil {
 		return nil, err
 	}
+	if err := r.unwrapKeys(pb); err != nil {
+		return nil, err
+	}
 	return applyDeviceFieldMask(nil, pb, paths...)
 }
 
 // RangeByAddr ranges over devices by addr.
+// The keys of the devices are not unwrapped, as typically only one of the devices matches; the caller unwraps the keys
+// of the matched device.
 func (r *DeviceRegistry) RangeByAddr(addr types.DevAddr, paths []string, f func(*ttnpb.EndDevice) bool) error {
 	return ttnredis.FindProtos(r.Redis, r.Redis.Key(addrKey, addr.String()), r.Redis.Key).Range(func() (proto.Message, func() (bool, error)) {
 		pb := &ttnpb.EndDevice{}
@@ -149,6 +179,8 @@ func (r *DeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIde
 			stored = nil
 		} else if err != nil {
 			return err
+		} else if err := r.unwrapKeys(stored); err != nil {
+			return err
 		}
 
 		oldAddrs, oldIDs := getDevAddrsAndIDs(stored)
@@ -194,6 +226,8 @@ func (r *DeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIde
 			stored = &ttnpb.EndDevice{}
 			if err := cmd.ScanProto(stored); err != nil && !errors.IsNotFound(err) {
 				return err
+			} else if err := r.unwrapKeys(stored); err != nil {
+				return err
 			}
 			stored, err = applyDeviceFieldMask(stored, pb, sets...)
 			if err != nil {
@@ -211,6 +245,11 @@ func (r *DeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIde
 				return errInvalidIdentifiers
 			}
 
+			stored, err = r.wrapKeys(stored)
+			if err != nil {
+				return err
+			}
+
 			f = func(p redis.Pipeliner) error {
 				if create && newIDs.JoinEUI != nil && newIDs.DevEUI != nil {
 					ek := r.Redis.Key(euiKey, newIDs.JoinEUI.String(), newIDs.DevEUI.String())
@@ -255,3 +294,20 @@ func (r *DeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIde
 	}
 	return pb, nil
 }
+
+// RewrapKeys re-wraps the keys of all end devices from the old KEK label to the new KEK label.
+// RewrapKeys returns the number of updated end devices.
+func (r *DeviceRegistry) RewrapKeys(oldKEKLabel, newKEKLabel string) (int, error) {
+	prefix := r.Redis.Key("")
+	return ttnredis.UpdateProtos(r.Redis, r.Redis.Key("*"), func(k string) (proto.Message, func() (bool, error)) {
+		if strings.ContainsRune(strings.TrimPrefix(k, prefix), ':') {
+			// Skip the EUI and address indexes.
+			return nil, nil
+		}
+		pb := &ttnpb.EndDevice{}
+		return pb, func() (bool, error) {
+			n, err := cryptoutil.RewrapKeyEnvelopes(cryptoutil.EndDeviceKeyEnvelopes(pb), oldKEKLabel, newKEKLabel, r.KeyVault)
+			return n > 0, err
+		}
+	})
+}
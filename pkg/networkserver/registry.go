@@ -22,6 +22,7 @@ import (
 )
 
 // DeviceRegistry is a registry, containing devices.
+// RangeByAddr may return the keys of the devices wrapped, so that only the keys of the matched device are unwrapped.
 type DeviceRegistry interface {
 	GetByEUI(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, error)
 	GetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, error)
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	. "go.thethings.network/lorawan-stack/pkg/networkserver"
 	"go.thethings.network/lorawan-stack/pkg/networkserver/redis"
@@ -259,6 +260,24 @@ func TestRegistries(t *testing.T) {
 			},
 			N: 8,
 		},
+		{
+			Name: "RedisWrapped",
+			New: func(t testing.TB) (DeviceRegistry, func() error) {
+				cl, flush := test.NewRedis(t, namespace[:]...)
+				reg := &redis.DeviceRegistry{
+					Redis: cl,
+					KeyVault: cryptoutil.NewMemKeyVault(map[string][]byte{
+						"registry": {0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+					}),
+					KEKLabel: "registry",
+				}
+				return reg, func() error {
+					flush()
+					return cl.Close()
+				}
+			},
+			N: 8,
+		},
 	} {
 		for i := 0; i < int(tc.N); i++ {
 			t.Run(fmt.Sprintf("%s/%d", tc.Name, i), func(t *testing.T) {
@@ -280,3 +299,109 @@ func TestRegistries(t *testing.T) {
 		}
 	}
 }
+
+func TestWrappedRegistry(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+
+	cl, flush := test.NewRedis(t, "networkserver_test")
+	defer func() {
+		flush()
+		cl.Close()
+	}()
+
+	keyVault := cryptoutil.NewMemKeyVault(map[string][]byte{
+		"old": {0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+		"new": {0x0f, 0x0e, 0x0d, 0x0c, 0x0b, 0x0a, 0x09, 0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01, 0x00},
+	})
+	reg := &redis.DeviceRegistry{
+		Redis:    cl,
+		KeyVault: keyVault,
+		KEKLabel: "old",
+	}
+	raw := &redis.DeviceRegistry{
+		Redis: cl,
+	}
+
+	key := []byte{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	pb := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			JoinEUI: &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			DevEUI:  &types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationID: "test-app",
+			},
+			DeviceID: "test-dev",
+		},
+		Session: &ttnpb.Session{
+			DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+			SessionKeys: ttnpb.SessionKeys{
+				FNwkSIntKey: &ttnpb.KeyEnvelope{Key: key},
+				SNwkSIntKey: &ttnpb.KeyEnvelope{Key: key},
+				NwkSEncKey:  &ttnpb.KeyEnvelope{Key: key},
+			},
+		},
+	}
+	paths := []string{"session"}
+
+	ret, err := CreateDevice(ctx, reg, pb)
+	if !a.So(err, should.BeNil) || !a.So(ret, should.NotBeNil) {
+		t.FailNow()
+	}
+	a.So(ret.Session.FNwkSIntKey, should.Resemble, &ttnpb.KeyEnvelope{Key: key})
+
+	ret, err = raw.GetByID(ctx, pb.EndDeviceIdentifiers.ApplicationIdentifiers, pb.EndDeviceIdentifiers.DeviceID, paths)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	for _, ke := range []*ttnpb.KeyEnvelope{ret.Session.FNwkSIntKey, ret.Session.SNwkSIntKey, ret.Session.NwkSEncKey} {
+		a.So(ke.KEKLabel, should.Equal, "old")
+		a.So(ke.Key, should.NotResemble, key)
+	}
+	stored := ret.Session.SessionKeys
+
+	ret, err = reg.GetByID(ctx, pb.EndDeviceIdentifiers.ApplicationIdentifiers, pb.EndDeviceIdentifiers.DeviceID, paths)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	for _, ke := range []*ttnpb.KeyEnvelope{ret.Session.FNwkSIntKey, ret.Session.SNwkSIntKey, ret.Session.NwkSEncKey} {
+		a.So(ke, should.Resemble, &ttnpb.KeyEnvelope{Key: key})
+	}
+
+	var rets []*ttnpb.EndDevice
+	err = reg.RangeByAddr(pb.Session.DevAddr, paths, func(dev *ttnpb.EndDevice) bool {
+		rets = append(rets, dev)
+		return true
+	})
+	if !a.So(err, should.BeNil) || !a.So(rets, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	a.So(rets[0].Session.SessionKeys, should.Resemble, stored)
+
+	n, err := reg.RewrapKeys("old", "new")
+	a.So(err, should.BeNil)
+	a.So(n, should.Equal, 1)
+
+	ret, err = raw.GetByID(ctx, pb.EndDeviceIdentifiers.ApplicationIdentifiers, pb.EndDeviceIdentifiers.DeviceID, paths)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	for _, ke := range []*ttnpb.KeyEnvelope{ret.Session.FNwkSIntKey, ret.Session.SNwkSIntKey, ret.Session.NwkSEncKey} {
+		a.So(ke.KEKLabel, should.Equal, "new")
+		a.So(ke.Key, should.NotResemble, key)
+	}
+
+	n, err = reg.RewrapKeys("old", "new")
+	a.So(err, should.BeNil)
+	a.So(n, should.Equal, 0)
+
+	reg.KEKLabel = "new"
+	ret, err = reg.GetByID(ctx, pb.EndDeviceIdentifiers.ApplicationIdentifiers, pb.EndDeviceIdentifiers.DeviceID, paths)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	for _, ke := range []*ttnpb.KeyEnvelope{ret.Session.FNwkSIntKey, ret.Session.SNwkSIntKey, ret.Session.NwkSEncKey} {
+		a.So(ke, should.Resemble, &ttnpb.KeyEnvelope{Key: key})
+	}
+}
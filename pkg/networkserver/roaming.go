@@ -0,0 +1,464 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"sort"
+	"sync/atomic"
+
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/interop"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+// RoamingPeer is a peer network, with which uplinks and downlinks are exchanged
+// using LoRaWAN Backend Interfaces passive roaming.
+type RoamingPeer struct {
+	NetID types.NetID
+	// URL is the LoRaWAN Backend Interfaces endpoint of the peer.
+	URL string
+}
+
+// RoamingPeerRegistry is a registry of roaming peers, keyed by NetID.
+type RoamingPeerRegistry interface {
+	// Get returns the roaming peer with NetID netID.
+	Get(ctx context.Context, netID types.NetID) (*RoamingPeer, error)
+	// GetByDevAddr returns the roaming peer, to which addr is assigned.
+	GetByDevAddr(ctx context.Context, addr types.DevAddr) (*RoamingPeer, error)
+}
+
+// MapRoamingPeerRegistry is a RoamingPeerRegistry backed by a map.
+type MapRoamingPeerRegistry map[types.NetID]*RoamingPeer
+
+// Get implements RoamingPeerRegistry.
+func (r MapRoamingPeerRegistry) Get(ctx context.Context, netID types.NetID) (*RoamingPeer, error) {
+	p, ok := r[netID]
+	if !ok {
+		return nil, errRoamingPeerNotFound.WithAttributes("net_id", netID)
+	}
+	return p, nil
+}
+
+// GetByDevAddr implements RoamingPeerRegistry.
+func (r MapRoamingPeerRegistry) GetByDevAddr(ctx context.Context, addr types.DevAddr) (*RoamingPeer, error) {
+	for netID, p := range r {
+		if devAddrPrefix(netID).Matches(addr) {
+			return p, nil
+		}
+	}
+	return nil, errNoRoamingPeer.WithAttributes("dev_addr", addr)
+}
+
+// devAddrPrefix returns the prefix of the DevAddr block assigned to netID.
+func devAddrPrefix(netID types.NetID) types.DevAddrPrefix {
+	addr, err := types.NewDevAddr(netID, nil)
+	if err != nil {
+		panic(err)
+	}
+	return types.DevAddrPrefix{
+		DevAddr: addr,
+		Length:  uint8(32 - types.NwkAddrBits(netID)),
+	}
+}
+
+type roamedUplinkKeyType struct{}
+
+// roamedUplinkKey is the context key, under which the NetID of the serving network of a roamed uplink is stored.
+var roamedUplinkKey roamedUplinkKeyType
+
+// roamingUplinkTokenPrefix prefixes the uplink tokens of uplinks received from roaming peers.
+// Uplink tokens issued by the Gateway Server are marshaled ttnpb.UplinkToken messages, which never start with this prefix.
+var roamingUplinkTokenPrefix = []byte("roaming:")
+
+// roamingUplinkToken returns the uplink token for the uplink received by network netID with the uplink token ulToken.
+func roamingUplinkToken(netID types.NetID, ulToken []byte) []byte {
+	b := make([]byte, 0, len(roamingUplinkTokenPrefix)+3+len(ulToken))
+	b = append(b, roamingUplinkTokenPrefix...)
+	b = append(b, netID[:]...)
+	return append(b, ulToken...)
+}
+
+// parseRoamingUplinkToken parses the uplink token generated by roamingUplinkToken.
+func parseRoamingUplinkToken(token []byte) (types.NetID, []byte, bool) {
+	if !bytes.HasPrefix(token, roamingUplinkTokenPrefix) || len(token) < len(roamingUplinkTokenPrefix)+3 {
+		return types.NetID{}, nil, false
+	}
+	token = token[len(roamingUplinkTokenPrefix):]
+	var netID types.NetID
+	copy(netID[:], token[:3])
+	return netID, token[3:], true
+}
+
+// roamingPeerByDevAddr returns the roaming peer to forward the uplink with DevAddr addr to, if any.
+// Uplinks, which were roamed themselves, and uplinks with DevAddr assigned to ns.NetID are never forwarded.
+func (ns *NetworkServer) roamingPeerByDevAddr(ctx context.Context, addr types.DevAddr) (*RoamingPeer, bool) {
+	if ns.roamingPeers == nil || ctx.Value(roamedUplinkKey) != nil || devAddrPrefix(ns.NetID).Matches(addr) {
+		return nil, false
+	}
+	p, err := ns.roamingPeers.GetByDevAddr(ctx, addr)
+	if err != nil {
+		return nil, false
+	}
+	return p, true
+}
+
+func (ns *NetworkServer) roamingPeer(ctx context.Context, netID types.NetID) (*RoamingPeer, error) {
+	if ns.roamingPeers == nil {
+		return nil, errRoamingPeerNotFound.WithAttributes("net_id", netID)
+	}
+	return ns.roamingPeers.Get(ctx, netID)
+}
+
+// authorizeRoamingPeer returns whether senderID is the NetID of a roaming peer,
+// and whether the TLS client certificate of the request in ctx is issued to senderID.
+func (ns *NetworkServer) authorizeRoamingPeer(ctx context.Context, senderID string) bool {
+	if !interop.ClientCertificateMatches(ctx, senderID) {
+		return false
+	}
+	var netID types.NetID
+	if err := netID.UnmarshalText([]byte(senderID)); err != nil {
+		return false
+	}
+	_, err := ns.roamingPeer(ctx, netID)
+	return err == nil
+}
+
+func (ns *NetworkServer) newRoamingHeader(peer *RoamingPeer) interop.MessageHeader {
+	return interop.MessageHeader{
+		ProtocolVersion: interop.ProtocolVersion,
+		SenderID:        ns.NetID.String(),
+		ReceiverID:      peer.NetID.String(),
+		TransactionID:   atomic.AddUint32(&ns.roamingTransactionID, 1),
+	}
+}
+
+// uplinkBandID returns the ID of the band, in which the uplink with settings was received.
+// As the band of uplinks of unknown devices is not known, it is determined on best-effort basis
+// by matching the frequency and data rate against all bands, in order of band ID.
+func uplinkBandID(settings ttnpb.TxSettings) (string, bool) {
+	ids := make([]string, 0, len(band.All))
+	for id := range band.All {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		b := band.All[id]
+		if int(settings.DataRateIndex) >= len(b.DataRates) || !b.DataRates[settings.DataRateIndex].Rate.Equal(settings.DataRate) {
+			continue
+		}
+		for _, sb := range b.SubBands {
+			if sb.Comprises(settings.Frequency) {
+				return id, true
+			}
+		}
+	}
+	return "", false
+}
+
+// roamingULMetaData returns the LoRaWAN Backend Interfaces uplink metadata of up.
+func roamingULMetaData(up *ttnpb.UplinkMessage) (*interop.ULMetaData, error) {
+	bandID, ok := uplinkBandID(up.Settings)
+	if !ok {
+		return nil, errUnknownBand
+	}
+	rfRegion, ok := interop.RFRegion(bandID)
+	if !ok {
+		return nil, errUnknownBand
+	}
+
+	dataRate := uint32(up.Settings.DataRateIndex)
+	md := &interop.ULMetaData{
+		DevAddr:  up.Payload.GetMACPayload().DevAddr[:],
+		DataRate: &dataRate,
+		ULFreq:   interop.FrequencyMHz(up.Settings.Frequency),
+		RFRegion: rfRegion,
+		RecvTime: up.ReceivedAt,
+		GWCnt:    len(up.RxMetadata),
+		GWInfo:   make([]interop.GWInfo, 0, len(up.RxMetadata)),
+	}
+	for _, rx := range up.RxMetadata {
+		rssi := int32(math.Round(float64(rx.RSSI)))
+		snr := rx.SNR
+		gw := interop.GWInfo{
+			ID:        rx.GatewayID,
+			RFRegion:  rfRegion,
+			RSSI:      &rssi,
+			SNR:       &snr,
+			ULToken:   rx.UplinkToken,
+			DLAllowed: len(rx.UplinkToken) > 0 && rx.DownlinkPathConstraint != ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER,
+		}
+		if rx.Location != nil {
+			lat, lon := rx.Location.Latitude, rx.Location.Longitude
+			gw.Lat, gw.Lon = &lat, &lon
+		}
+		md.GWInfo = append(md.GWInfo, gw)
+	}
+	return md, nil
+}
+
+// forwardRoamingUplink forwards the data uplink up of an unknown device to the roaming peer.
+func (ns *NetworkServer) forwardRoamingUplink(ctx context.Context, peer *RoamingPeer, up *ttnpb.UplinkMessage, acc *metadataAccumulator) error {
+	logger := log.FromContext(ctx).WithField("net_id", peer.NetID)
+
+	logger.Debug("Waiting for deduplication window to close...")
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-ns.deduplicationDone(ctx, up):
+	}
+	up.RxMetadata = acc.Accumulated()
+
+	md, err := roamingULMetaData(up)
+	if err != nil {
+		registerDropDataUplink(ctx, nil, up, err)
+		logger.WithError(err).Warn("Failed to determine uplink metadata for roaming peer")
+		return err
+	}
+
+	logger.Debug("Forwarding uplink to roaming peer...")
+	ans, err := ns.interopClient.SendPRStartReq(ctx, peer.URL, &interop.PRStartReq{
+		MessageHeader: ns.newRoamingHeader(peer),
+		PHYPayload:    up.RawPayload,
+		ULMetaData:    *md,
+	})
+	if err == nil && ans.Result.ResultCode != interop.ResultSuccess {
+		err = errRoamingResult.WithAttributes(
+			"net_id", peer.NetID,
+			"result_code", ans.Result.ResultCode,
+			"description", ans.Result.Description,
+		)
+	}
+	if err != nil {
+		registerDropDataUplink(ctx, nil, up, err)
+		logger.WithError(err).Warn("Failed to forward uplink to roaming peer")
+		return err
+	}
+	registerForwardDataUplink(ctx, nil, up)
+	return nil
+}
+
+// roamedUplink returns the uplink received by the serving network netID, described by pld and md.
+func roamedUplink(netID types.NetID, pld []byte, md interop.ULMetaData) (*ttnpb.UplinkMessage, error) {
+	bandID, ok := interop.BandID(md.RFRegion)
+	if !ok {
+		return nil, errUnknownRFRegion.WithAttributes("rf_region", md.RFRegion)
+	}
+	b := band.All[bandID]
+	if md.DataRate == nil || int(*md.DataRate) >= len(b.DataRates) || b.DataRates[*md.DataRate].Rate.Modulation == nil {
+		return nil, errInvalidDataRate
+	}
+
+	up := &ttnpb.UplinkMessage{
+		RawPayload: pld,
+		Payload:    &ttnpb.Message{},
+		Settings: ttnpb.TxSettings{
+			DataRate:      b.DataRates[*md.DataRate].Rate,
+			DataRateIndex: ttnpb.DataRateIndex(*md.DataRate),
+			Frequency:     interop.FrequencyHz(md.ULFreq),
+		},
+		RxMetadata: make([]*ttnpb.RxMetadata, 0, len(md.GWInfo)),
+	}
+	if err := lorawan.UnmarshalMessage(pld, up.Payload); err != nil {
+		return nil, errDecodePayload.WithCause(err)
+	}
+	switch up.Payload.MType {
+	case ttnpb.MType_CONFIRMED_UP, ttnpb.MType_UNCONFIRMED_UP:
+	default:
+		return nil, errInvalidPayload
+	}
+
+	for _, gw := range md.GWInfo {
+		rx := &ttnpb.RxMetadata{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+				GatewayID: gw.ID,
+			},
+		}
+		if !md.RecvTime.IsZero() {
+			recvTime := md.RecvTime
+			rx.Time = &recvTime
+		}
+		if gw.RSSI != nil {
+			rx.RSSI = float32(*gw.RSSI)
+		}
+		if gw.SNR != nil {
+			rx.SNR = *gw.SNR
+		}
+		if gw.Lat != nil && gw.Lon != nil {
+			rx.Location = &ttnpb.Location{
+				Latitude:  *gw.Lat,
+				Longitude: *gw.Lon,
+			}
+		}
+		if gw.DLAllowed && len(gw.ULToken) > 0 {
+			rx.UplinkToken = roamingUplinkToken(netID, gw.ULToken)
+		} else {
+			rx.DownlinkPathConstraint = ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER
+		}
+		up.RxMetadata = append(up.RxMetadata, rx)
+	}
+	return up, nil
+}
+
+// HandlePRStartReq implements interop.NetworkServer.
+// HandlePRStartReq handles the uplink forwarded by a serving network, for which the Network Server is the home network.
+func (ns *NetworkServer) HandlePRStartReq(ctx context.Context, req *interop.PRStartReq) (*interop.PRStartAns, error) {
+	ans := &interop.PRStartAns{
+		MessageHeader: req.AnswerHeader(interop.MessageTypePRStartAns),
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}
+
+	var netID, receiverID types.NetID
+	if err := netID.UnmarshalText([]byte(req.SenderID)); err != nil {
+		ans.Result = interop.Result{ResultCode: interop.ResultUnknownSender, Description: err.Error()}
+		return ans, nil
+	}
+	if err := receiverID.UnmarshalText([]byte(req.ReceiverID)); err != nil || receiverID != ns.NetID {
+		ans.Result = interop.Result{ResultCode: interop.ResultUnknownReceiver}
+		return ans, nil
+	}
+
+	up, err := roamedUplink(netID, req.PHYPayload, req.ULMetaData)
+	if err != nil {
+		ans.Result = interop.Result{ResultCode: interop.ResultMalformedRequest, Description: err.Error()}
+		return ans, nil
+	}
+
+	ctx = context.WithValue(ctx, roamedUplinkKey, netID)
+	ctx = log.NewContextWithField(ctx, "net_id", netID)
+	if err := ns.processUplink(ctx, up); err != nil {
+		ans.Result = interop.Result{ResultCode: interop.ResultOther, Description: err.Error()}
+		if errors.Resemble(err, errDeviceNotFound) {
+			ans.Result.ResultCode = interop.ResultUnknownDevAddr
+		}
+	}
+	return ans, nil
+}
+
+var roamingClassModes = map[ttnpb.Class]string{
+	ttnpb.CLASS_A: "A",
+	ttnpb.CLASS_B: "B",
+	ttnpb.CLASS_C: "C",
+}
+
+// transmitRoamingDownlink transmits the downlink b through the serving network peer, using the gateways gws.
+func (ns *NetworkServer) transmitRoamingDownlink(ctx context.Context, peer *RoamingPeer, req *ttnpb.TxRequest, b []byte, gws []interop.GWInfo) error {
+	md := &interop.DLMetaData{
+		RXDelay1:       uint32(req.Rx1Delay),
+		ClassMode:      roamingClassModes[req.Class],
+		GWInfo:         gws,
+		HiPriorityFlag: req.Priority >= ttnpb.TxSchedulePriority_HIGH,
+	}
+	if req.Rx1Frequency != 0 {
+		freq, dr := interop.FrequencyMHz(req.Rx1Frequency), uint32(req.Rx1DataRateIndex)
+		md.DLFreq1, md.DataRate1 = &freq, &dr
+	}
+	if req.Rx2Frequency != 0 {
+		freq, dr := interop.FrequencyMHz(req.Rx2Frequency), uint32(req.Rx2DataRateIndex)
+		md.DLFreq2, md.DataRate2 = &freq, &dr
+	}
+
+	ans, err := ns.interopClient.SendXmitDataReq(ctx, peer.URL, &interop.XmitDataReq{
+		MessageHeader: ns.newRoamingHeader(peer),
+		PHYPayload:    b,
+		DLMetaData:    md,
+	})
+	if err != nil {
+		return err
+	}
+	if ans.Result.ResultCode != interop.ResultSuccess {
+		return errRoamingResult.WithAttributes(
+			"net_id", peer.NetID,
+			"result_code", ans.Result.ResultCode,
+			"description", ans.Result.Description,
+		)
+	}
+	return nil
+}
+
+// HandleXmitDataReq implements interop.NetworkServer.
+// HandleXmitDataReq schedules the downlink transmitted by a home network, for which the Network Server is the serving network.
+func (ns *NetworkServer) HandleXmitDataReq(ctx context.Context, req *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+	ans := &interop.XmitDataAns{
+		MessageHeader: req.AnswerHeader(interop.MessageTypeXmitDataAns),
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}
+
+	md := req.DLMetaData
+	if md == nil || len(req.PHYPayload) == 0 {
+		ans.Result = interop.Result{ResultCode: interop.ResultMalformedRequest}
+		return ans, nil
+	}
+
+	txReq := &ttnpb.TxRequest{
+		Class:    ttnpb.CLASS_A,
+		Rx1Delay: ttnpb.RxDelay(md.RXDelay1),
+		Priority: ttnpb.TxSchedulePriority_NORMAL,
+	}
+	for class, mode := range roamingClassModes {
+		if mode == md.ClassMode {
+			txReq.Class = class
+		}
+	}
+	if md.HiPriorityFlag {
+		txReq.Priority = ttnpb.TxSchedulePriority_HIGH
+	}
+	if md.DLFreq1 != nil && md.DataRate1 != nil {
+		txReq.Rx1Frequency = interop.FrequencyHz(*md.DLFreq1)
+		txReq.Rx1DataRateIndex = ttnpb.DataRateIndex(*md.DataRate1)
+	}
+	if md.DLFreq2 != nil && md.DataRate2 != nil {
+		txReq.Rx2Frequency = interop.FrequencyHz(*md.DLFreq2)
+		txReq.Rx2DataRateIndex = ttnpb.DataRateIndex(*md.DataRate2)
+	}
+
+	paths := make([]downlinkPath, 0, len(md.GWInfo))
+	for _, gw := range md.GWInfo {
+		if len(gw.ULToken) == 0 {
+			continue
+		}
+		if _, _, ok := parseRoamingUplinkToken(gw.ULToken); ok {
+			// NOTE: Downlinks are only transmitted through gateways connected to this network.
+			continue
+		}
+		paths = append(paths, downlinkPath{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+				GatewayID: gw.ID,
+			},
+			DownlinkPath: &ttnpb.DownlinkPath{
+				Path: &ttnpb.DownlinkPath_UplinkToken{
+					UplinkToken: gw.ULToken,
+				},
+			},
+		})
+	}
+
+	if _, err := ns.scheduleDownlinkByPaths(ctx, txReq, nil, req.PHYPayload, paths...); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to schedule downlink of roaming peer")
+		ans.Result = interop.Result{ResultCode: interop.ResultXmitFailed, Description: err.Error()}
+	}
+	return ans, nil
+}
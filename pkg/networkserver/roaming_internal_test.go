@@ -0,0 +1,721 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/cluster"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/pkg/interop"
+	"go.thethings.network/lorawan-stack/pkg/interop/mock"
+	"go.thethings.network/lorawan-stack/pkg/rpcserver"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+var (
+	homeNetID    = types.NetID{0x00, 0x00, 0x13}
+	servingNetID = types.NetID{0x00, 0x00, 0x42}
+)
+
+func closedWindow(context.Context, *ttnpb.UplinkMessage) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	ch <- time.Now()
+	return ch
+}
+
+func roamingDataUplink(t *testing.T, devAddr types.DevAddr) *ttnpb.UplinkMessage {
+	msg := ttnpb.Message{
+		MHDR: ttnpb.MHDR{
+			MType: ttnpb.MType_UNCONFIRMED_UP,
+			Major: ttnpb.Major_LORAWAN_R1,
+		},
+		MIC: []byte{0x01, 0x02, 0x03, 0x04},
+		Payload: &ttnpb.Message_MACPayload{
+			MACPayload: &ttnpb.MACPayload{
+				FHDR: ttnpb.FHDR{
+					DevAddr: devAddr,
+					FCnt:    42,
+				},
+				FPort:      1,
+				FRMPayload: []byte{0x42},
+			},
+		},
+	}
+	b, err := lorawan.MarshalMessage(msg)
+	if err != nil {
+		t.Fatalf("Failed to marshal uplink: %s", err)
+	}
+	return &ttnpb.UplinkMessage{
+		RawPayload: b,
+		Payload:    &msg,
+		Settings: ttnpb.TxSettings{
+			DataRate:      band.All[band.EU_863_870].DataRates[5].Rate,
+			DataRateIndex: 5,
+			Frequency:     868100000,
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+					GatewayID: "test-gateway",
+				},
+				RSSI:        -42,
+				SNR:         5.5,
+				UplinkToken: []byte{0x01, 0x02, 0x03},
+			},
+		},
+	}
+}
+
+func TestRoamingPeerRegistry(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	reg, err := RoamingConfig{
+		Peers: map[string]string{
+			"000013": "http://home.local",
+			"600042": "http://other.local",
+		},
+	}.Parse()
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	p, err := reg.Get(ctx, homeNetID)
+	a.So(err, should.BeNil)
+	a.So(p, should.Resemble, &RoamingPeer{NetID: homeNetID, URL: "http://home.local"})
+
+	_, err = reg.Get(ctx, servingNetID)
+	a.So(err, should.HaveSameErrorDefinitionAs, errRoamingPeerNotFound)
+
+	p, err = reg.GetByDevAddr(ctx, test.Must(types.NewDevAddr(homeNetID, []byte{0x01, 0x02, 0x03})).(types.DevAddr))
+	a.So(err, should.BeNil)
+	a.So(p.NetID, should.Equal, homeNetID)
+
+	p, err = reg.GetByDevAddr(ctx, test.Must(types.NewDevAddr(types.NetID{0x60, 0x00, 0x42}, []byte{0x01, 0x02})).(types.DevAddr))
+	a.So(err, should.BeNil)
+	a.So(p.NetID, should.Equal, types.NetID{0x60, 0x00, 0x42})
+
+	_, err = reg.GetByDevAddr(ctx, test.Must(types.NewDevAddr(servingNetID, []byte{0x01, 0x02, 0x03})).(types.DevAddr))
+	a.So(err, should.HaveSameErrorDefinitionAs, errNoRoamingPeer)
+
+	_, err = RoamingConfig{
+		Peers: map[string]string{
+			"invalid": "http://home.local",
+		},
+	}.Parse()
+	a.So(err, should.HaveSameErrorDefinitionAs, errRoamingPeerNetID)
+}
+
+func TestRoamingUplinkToken(t *testing.T) {
+	a := assertions.New(t)
+
+	netID, ulToken, ok := parseRoamingUplinkToken(roamingUplinkToken(servingNetID, []byte{0x01, 0x02}))
+	a.So(ok, should.BeTrue)
+	a.So(netID, should.Equal, servingNetID)
+	a.So(ulToken, should.Resemble, []byte{0x01, 0x02})
+
+	gsToken, err := (&ttnpb.UplinkToken{
+		GatewayAntennaIdentifiers: ttnpb.GatewayAntennaIdentifiers{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "test-gateway"},
+		},
+		Timestamp: 42,
+	}).Marshal()
+	a.So(err, should.BeNil)
+	_, _, ok = parseRoamingUplinkToken(gsToken)
+	a.So(ok, should.BeFalse)
+}
+
+func TestForwardRoamingUplink(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	home := mock.NewPeer(ctx, homeNetID)
+
+	ns := test.Must(New(
+		component.MustNew(test.GetLogger(t), &component.Config{}),
+		&Config{
+			Devices: &MockDeviceRegistry{
+				RangeByAddrFunc: func(types.DevAddr, []string, func(*ttnpb.EndDevice) bool) error {
+					return nil
+				},
+			},
+			DownlinkTasks: &MockDownlinkTaskQueue{},
+			Roaming: RoamingConfig{
+				Peers: map[string]string{
+					homeNetID.String(): home.URL(),
+				},
+			},
+		},
+		WithDeduplicationDoneFunc(closedWindow),
+		WithCollectionDoneFunc(closedWindow),
+	)).(*NetworkServer)
+	ns.NetID = servingNetID
+
+	devAddr := test.Must(types.NewDevAddr(homeNetID, []byte{0x01, 0x02, 0x03})).(types.DevAddr)
+	up := roamingDataUplink(t, devAddr)
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := ns.HandleUplink(clusterauth.NewContext(ctx, nil), CopyUplinkMessage(up))
+		errCh <- err
+	}()
+
+	select {
+	case req := <-home.PRStartReq:
+		a.So(req.SenderID, should.Equal, "000042")
+		a.So(req.ReceiverID, should.Equal, "000013")
+		a.So(req.PHYPayload, should.Resemble, interop.Buffer(up.RawPayload))
+		a.So(req.ULMetaData.RecvTime.IsZero(), should.BeFalse)
+		req.ULMetaData.RecvTime = time.Time{}
+
+		dataRate := uint32(5)
+		rssi := int32(-42)
+		snr := float32(5.5)
+		a.So(req.ULMetaData, should.Resemble, interop.ULMetaData{
+			DevAddr:  interop.Buffer(devAddr[:]),
+			DataRate: &dataRate,
+			ULFreq:   868.1,
+			RFRegion: "EU868",
+			GWCnt:    1,
+			GWInfo: []interop.GWInfo{
+				{
+					ID:        "test-gateway",
+					RFRegion:  "EU868",
+					RSSI:      &rssi,
+					SNR:       &snr,
+					ULToken:   interop.Buffer{0x01, 0x02, 0x03},
+					DLAllowed: true,
+				},
+			},
+		})
+	case <-time.After(Timeout):
+		t.Fatal("Timed out waiting for PRStartReq")
+	}
+
+	select {
+	case err := <-errCh:
+		a.So(err, should.BeNil)
+	case <-time.After(Timeout):
+		t.Fatal("Timed out waiting for HandleUplink to return")
+	}
+}
+
+func TestHandlePRStartReq(t *testing.T) {
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	ns := test.Must(New(
+		component.MustNew(test.GetLogger(t), &component.Config{}),
+		&Config{
+			Devices: &MockDeviceRegistry{
+				RangeByAddrFunc: func(types.DevAddr, []string, func(*ttnpb.EndDevice) bool) error {
+					return nil
+				},
+			},
+			DownlinkTasks: &MockDownlinkTaskQueue{},
+			Roaming: RoamingConfig{
+				Peers: map[string]string{
+					servingNetID.String(): "http://serving.local",
+				},
+			},
+		},
+		WithDeduplicationDoneFunc(closedWindow),
+		WithCollectionDoneFunc(closedWindow),
+	)).(*NetworkServer)
+	ns.NetID = homeNetID
+
+	up := roamingDataUplink(t, test.Must(types.NewDevAddr(homeNetID, []byte{0x01, 0x02, 0x03})).(types.DevAddr))
+	dataRate := uint32(5)
+
+	for _, tc := range []struct {
+		Name       string
+		Request    *interop.PRStartReq
+		ResultCode interop.ResultCode
+	}{
+		{
+			Name: "UnknownDevAddr",
+			Request: &interop.PRStartReq{
+				MessageHeader: interop.MessageHeader{
+					SenderID:   "000042",
+					ReceiverID: "000013",
+				},
+				PHYPayload: up.RawPayload,
+				ULMetaData: interop.ULMetaData{
+					DataRate: &dataRate,
+					ULFreq:   868.1,
+					RFRegion: "EU868",
+					GWCnt:    1,
+					GWInfo: []interop.GWInfo{
+						{
+							ID:        "test-gateway",
+							ULToken:   interop.Buffer{0x01, 0x02, 0x03},
+							DLAllowed: true,
+						},
+					},
+				},
+			},
+			ResultCode: interop.ResultUnknownDevAddr,
+		},
+		{
+			Name: "UnknownReceiver",
+			Request: &interop.PRStartReq{
+				MessageHeader: interop.MessageHeader{
+					SenderID:   "000042",
+					ReceiverID: "000001",
+				},
+				PHYPayload: up.RawPayload,
+			},
+			ResultCode: interop.ResultUnknownReceiver,
+		},
+		{
+			Name: "UnknownRFRegion",
+			Request: &interop.PRStartReq{
+				MessageHeader: interop.MessageHeader{
+					SenderID:   "000042",
+					ReceiverID: "000013",
+				},
+				PHYPayload: up.RawPayload,
+				ULMetaData: interop.ULMetaData{
+					DataRate: &dataRate,
+					RFRegion: "Mars",
+				},
+			},
+			ResultCode: interop.ResultMalformedRequest,
+		},
+		{
+			Name: "InvalidPayload",
+			Request: &interop.PRStartReq{
+				MessageHeader: interop.MessageHeader{
+					SenderID:   "000042",
+					ReceiverID: "000013",
+				},
+				PHYPayload: interop.Buffer{0x40, 0x01},
+				ULMetaData: interop.ULMetaData{
+					DataRate: &dataRate,
+					RFRegion: "EU868",
+				},
+			},
+			ResultCode: interop.ResultMalformedRequest,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			ans, err := ns.HandlePRStartReq(ctx, tc.Request)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(ans.MessageType, should.Equal, interop.MessageTypePRStartAns)
+			a.So(ans.SenderID, should.Equal, tc.Request.ReceiverID)
+			a.So(ans.ReceiverID, should.Equal, tc.Request.SenderID)
+			a.So(ans.Result.ResultCode, should.Equal, tc.ResultCode)
+		})
+	}
+}
+
+func mustRoamingCertificate(t *testing.T, commonName string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  parent == nil,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if parent == nil {
+		parent, parentKey = tmpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Failed to parse certificate: %v", err)
+	}
+	return cert, key
+}
+
+func TestAuthorizeRoamingPeer(t *testing.T) {
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	ns := test.Must(New(
+		component.MustNew(test.GetLogger(t), &component.Config{}),
+		&Config{
+			Devices: &MockDeviceRegistry{
+				RangeByAddrFunc: func(types.DevAddr, []string, func(*ttnpb.EndDevice) bool) error {
+					return nil
+				},
+			},
+			DownlinkTasks: &MockDownlinkTaskQueue{},
+			Roaming: RoamingConfig{
+				Peers: map[string]string{
+					servingNetID.String(): "http://serving.local",
+				},
+			},
+		},
+		WithDeduplicationDoneFunc(closedWindow),
+		WithCollectionDoneFunc(closedWindow),
+	)).(*NetworkServer)
+	ns.NetID = homeNetID
+
+	caCert, caKey := mustRoamingCertificate(t, "Test CA", nil, nil)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(caCert)
+
+	srv := httptest.NewUnstartedServer(interop.NewServer(ctx, ns, ns.authorizeRoamingPeer))
+	srv.TLS = &tls.Config{
+		ClientCAs:  clientCAs,
+		ClientAuth: tls.VerifyClientCertIfGiven,
+	}
+	srv.StartTLS()
+	defer srv.Close()
+
+	newClient := func(commonName string) *http.Client {
+		transport := &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: srv.Client().Transport.(*http.Transport).TLSClientConfig.RootCAs,
+			},
+		}
+		if commonName != "" {
+			cert, key := mustRoamingCertificate(t, commonName, caCert, caKey)
+			transport.TLSClientConfig.Certificates = []tls.Certificate{
+				{
+					Certificate: [][]byte{cert.Raw},
+					PrivateKey:  key,
+				},
+			}
+		}
+		return &http.Client{Transport: transport}
+	}
+
+	up := roamingDataUplink(t, test.Must(types.NewDevAddr(homeNetID, []byte{0x01, 0x02, 0x03})).(types.DevAddr))
+	dataRate := uint32(5)
+
+	for _, tc := range []struct {
+		Name       string
+		Client     *http.Client
+		SenderID   string
+		ResultCode interop.ResultCode
+	}{
+		{
+			Name:       "MatchingCertificate",
+			Client:     newClient(servingNetID.String()),
+			SenderID:   servingNetID.String(),
+			ResultCode: interop.ResultUnknownDevAddr,
+		},
+		{
+			Name:       "ForgedSenderID",
+			Client:     newClient("000001"),
+			SenderID:   servingNetID.String(),
+			ResultCode: interop.ResultUnknownSender,
+		},
+		{
+			Name:       "NoCertificate",
+			Client:     newClient(""),
+			SenderID:   servingNetID.String(),
+			ResultCode: interop.ResultUnknownSender,
+		},
+		{
+			Name:       "UnknownPeer",
+			Client:     newClient("000001"),
+			SenderID:   "000001",
+			ResultCode: interop.ResultUnknownSender,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			ans, err := interop.NewClient(tc.Client).SendPRStartReq(ctx, srv.URL, &interop.PRStartReq{
+				MessageHeader: interop.MessageHeader{
+					ProtocolVersion: interop.ProtocolVersion,
+					SenderID:        tc.SenderID,
+					ReceiverID:      homeNetID.String(),
+				},
+				PHYPayload: up.RawPayload,
+				ULMetaData: interop.ULMetaData{
+					DataRate: &dataRate,
+					ULFreq:   868.1,
+					RFRegion: "EU868",
+					GWCnt:    1,
+					GWInfo: []interop.GWInfo{
+						{
+							ID:        "test-gateway",
+							ULToken:   interop.Buffer{0x01, 0x02, 0x03},
+							DLAllowed: true,
+						},
+					},
+				},
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(ans.Result.ResultCode, should.Equal, tc.ResultCode)
+		})
+	}
+}
+
+func TestRoamedUplink(t *testing.T) {
+	a := assertions.New(t)
+
+	up := roamingDataUplink(t, test.Must(types.NewDevAddr(homeNetID, []byte{0x01, 0x02, 0x03})).(types.DevAddr))
+	dataRate := uint32(5)
+	rssi := int32(-42)
+	recvTime := time.Unix(42, 0).UTC()
+
+	ret, err := roamedUplink(servingNetID, up.RawPayload, interop.ULMetaData{
+		DataRate: &dataRate,
+		ULFreq:   868.1,
+		RFRegion: "EU868",
+		RecvTime: recvTime,
+		GWCnt:    2,
+		GWInfo: []interop.GWInfo{
+			{
+				ID:        "test-gateway",
+				RSSI:      &rssi,
+				ULToken:   interop.Buffer{0x01, 0x02, 0x03},
+				DLAllowed: true,
+			},
+			{
+				ID:      "other-gateway",
+				ULToken: interop.Buffer{0x04},
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(ret.RawPayload, should.Resemble, up.RawPayload)
+	a.So(ret.Payload.GetMACPayload().FCnt, should.Equal, 42)
+	a.So(ret.Settings, should.Resemble, up.Settings)
+	a.So(ret.RxMetadata, should.Resemble, []*ttnpb.RxMetadata{
+		{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+				GatewayID: "test-gateway",
+			},
+			Time:        &recvTime,
+			RSSI:        -42,
+			UplinkToken: roamingUplinkToken(servingNetID, []byte{0x01, 0x02, 0x03}),
+		},
+		{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+				GatewayID: "other-gateway",
+			},
+			Time:                   &recvTime,
+			DownlinkPathConstraint: ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER,
+		},
+	})
+}
+
+func TestRoamingDownlink(t *testing.T) {
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	serving := mock.NewPeer(ctx, servingNetID)
+
+	gs := &MockNsGsServer{}
+	gsPeer := test.Must(test.NewGRPCServerPeer(ctx, gs, ttnpb.RegisterNsGsServer)).(cluster.Peer)
+
+	newNS := func(t *testing.T, netID types.NetID, peers map[string]string) *NetworkServer {
+		ns := test.Must(New(
+			component.MustNew(test.GetLogger(t),
+				&component.Config{},
+				component.WithClusterNew(func(context.Context, *config.ServiceBase, ...rpcserver.Registerer) (cluster.Cluster, error) {
+					return &test.MockCluster{
+						GetPeerFunc: func(ctx context.Context, role ttnpb.PeerInfo_Role, ids ttnpb.Identifiers) cluster.Peer {
+							return gsPeer
+						},
+					}, nil
+				}),
+			),
+			&Config{
+				DeduplicationWindow: 42,
+				CooldownWindow:      42,
+				Devices:             &MockDeviceRegistry{},
+				DownlinkTasks:       &MockDownlinkTaskQueue{},
+				Roaming: RoamingConfig{
+					Peers: peers,
+				},
+			},
+		)).(*NetworkServer)
+		ns.NetID = netID
+		test.Must(nil, ns.Start())
+		return ns
+	}
+
+	t.Run("Home", func(t *testing.T) {
+		a := assertions.New(t)
+
+		ns := newNS(t, homeNetID, map[string]string{
+			servingNetID.String(): serving.URL(),
+		})
+		defer ns.Close()
+
+		downCh := make(chan *ttnpb.DownlinkMessage, 1)
+		errCh := make(chan error, 1)
+		go func() {
+			down, err := ns.scheduleDownlinkByPaths(ctx, &ttnpb.TxRequest{
+				Class:            ttnpb.CLASS_A,
+				Rx1Delay:         ttnpb.RX_DELAY_1,
+				Rx1DataRateIndex: ttnpb.DATA_RATE_5,
+				Rx1Frequency:     868100000,
+				Rx2DataRateIndex: ttnpb.DATA_RATE_0,
+				Rx2Frequency:     869525000,
+				Priority:         ttnpb.TxSchedulePriority_NORMAL,
+			}, &ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+				DeviceID:               "test-dev",
+			}, []byte{0x60, 0x01, 0x02}, downlinkPath{
+				GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+					GatewayID: "test-gateway",
+				},
+				DownlinkPath: &ttnpb.DownlinkPath{
+					Path: &ttnpb.DownlinkPath_UplinkToken{
+						UplinkToken: roamingUplinkToken(servingNetID, []byte{0x01, 0x02, 0x03}),
+					},
+				},
+			})
+			downCh <- down
+			errCh <- err
+		}()
+
+		select {
+		case req := <-serving.XmitDataReq:
+			a.So(req.SenderID, should.Equal, "000013")
+			a.So(req.ReceiverID, should.Equal, "000042")
+			a.So(req.PHYPayload, should.Resemble, interop.Buffer{0x60, 0x01, 0x02})
+			freq1, freq2 := 868.1, 869.525
+			dr1, dr2 := uint32(5), uint32(0)
+			a.So(req.DLMetaData, should.Resemble, &interop.DLMetaData{
+				DLFreq1:   &freq1,
+				DLFreq2:   &freq2,
+				RXDelay1:  1,
+				ClassMode: "A",
+				DataRate1: &dr1,
+				DataRate2: &dr2,
+				GWInfo: []interop.GWInfo{
+					{
+						ID:        "test-gateway",
+						ULToken:   interop.Buffer{0x01, 0x02, 0x03},
+						DLAllowed: true,
+					},
+				},
+			})
+		case <-time.After(Timeout):
+			t.Fatal("Timed out waiting for XmitDataReq")
+		}
+
+		down, err := <-downCh, <-errCh
+		a.So(err, should.BeNil)
+		a.So(down, should.NotBeNil)
+	})
+
+	t.Run("Serving", func(t *testing.T) {
+		a := assertions.New(t)
+
+		ns := newNS(t, servingNetID, map[string]string{
+			homeNetID.String(): "http://home.local",
+		})
+		defer ns.Close()
+
+		scheduleCh := make(chan *ttnpb.DownlinkMessage, 1)
+		gs.ScheduleDownlinkFunc = func(_ context.Context, msg *ttnpb.DownlinkMessage) (*pbtypes.Empty, error) {
+			scheduleCh <- msg
+			return ttnpb.Empty, nil
+		}
+
+		freq1 := 868.1
+		dr1 := uint32(5)
+		ans, err := ns.HandleXmitDataReq(ctx, &interop.XmitDataReq{
+			MessageHeader: interop.MessageHeader{
+				SenderID:      "000013",
+				ReceiverID:    "000042",
+				TransactionID: 42,
+			},
+			PHYPayload: interop.Buffer{0x60, 0x01, 0x02},
+			DLMetaData: &interop.DLMetaData{
+				DLFreq1:        &freq1,
+				DataRate1:      &dr1,
+				RXDelay1:       1,
+				ClassMode:      "A",
+				HiPriorityFlag: true,
+				GWInfo: []interop.GWInfo{
+					{
+						ID:        "test-gateway",
+						ULToken:   interop.Buffer{0x01, 0x02, 0x03},
+						DLAllowed: true,
+					},
+				},
+			},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(ans.TransactionID, should.Equal, 42)
+		a.So(ans.Result.ResultCode, should.Equal, interop.ResultSuccess)
+
+		select {
+		case msg := <-scheduleCh:
+			a.So(msg.RawPayload, should.Resemble, []byte{0x60, 0x01, 0x02})
+			a.So(msg.EndDeviceIDs, should.BeNil)
+			a.So(msg.GetRequest(), should.Resemble, &ttnpb.TxRequest{
+				Class: ttnpb.CLASS_A,
+				DownlinkPaths: []*ttnpb.DownlinkPath{
+					{
+						Path: &ttnpb.DownlinkPath_UplinkToken{
+							UplinkToken: []byte{0x01, 0x02, 0x03},
+						},
+					},
+				},
+				Rx1Delay:         ttnpb.RX_DELAY_1,
+				Rx1DataRateIndex: ttnpb.DATA_RATE_5,
+				Rx1Frequency:     868100000,
+				Priority:         ttnpb.TxSchedulePriority_HIGH,
+			})
+		default:
+			t.Fatal("Downlink not scheduled")
+		}
+	})
+}
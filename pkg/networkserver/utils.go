@@ -71,14 +71,15 @@ func resetMACState(dev *ttnpb.EndDevice, fps *frequencyplans.Store) error {
 		DeviceClass:    ttnpb.CLASS_A,
 		LoRaWANVersion: dev.LoRaWANVersion,
 		CurrentParameters: ttnpb.MACParameters{
-			ADRAckDelay:      uint32(band.ADRAckDelay),
-			ADRAckLimit:      uint32(band.ADRAckLimit),
-			ADRNbTrans:       1,
-			MaxDutyCycle:     ttnpb.DUTY_CYCLE_1,
-			MaxEIRP:          band.DefaultMaxEIRP,
-			Rx1Delay:         ttnpb.RxDelay(band.ReceiveDelay1.Seconds()),
-			Rx2DataRateIndex: band.DefaultRx2Parameters.DataRateIndex,
-			Rx2Frequency:     band.DefaultRx2Parameters.Frequency,
+			ADRAckDelay:           uint32(band.ADRAckDelay),
+			ADRAckLimit:           uint32(band.ADRAckLimit),
+			ADRNbTrans:            1,
+			MaxDutyCycle:          ttnpb.DUTY_CYCLE_1,
+			MaxEIRP:               band.DefaultMaxEIRP,
+			PingSlotDataRateIndex: ttnpb.DataRateIndex(band.Beacon.DataRateIndex),
+			Rx1Delay:              ttnpb.RxDelay(band.ReceiveDelay1.Seconds()),
+			Rx2DataRateIndex:      band.DefaultRx2Parameters.DataRateIndex,
+			Rx2Frequency:          band.DefaultRx2Parameters.Frequency,
 		},
 	}
 	if dev.SupportsClassB {
@@ -58,7 +58,7 @@ func TestResetMACState(t *testing.T) {
 						DownlinkDwellTime:      false,
 						MaxDutyCycle:           ttnpb.DUTY_CYCLE_1,
 						MaxEIRP:                band.DefaultMaxEIRP,
-						PingSlotDataRateIndex:  ttnpb.DATA_RATE_0,
+						PingSlotDataRateIndex:  ttnpb.DataRateIndex(band.Beacon.DataRateIndex),
 						PingSlotFrequency:      0,
 						RejoinCountPeriodicity: ttnpb.REJOIN_COUNT_16,
 						RejoinTimePeriodicity:  ttnpb.REJOIN_TIME_0,
@@ -101,7 +101,7 @@ func TestResetMACState(t *testing.T) {
 						DownlinkDwellTime:      false,
 						MaxDutyCycle:           ttnpb.DUTY_CYCLE_1,
 						MaxEIRP:                band.DefaultMaxEIRP,
-						PingSlotDataRateIndex:  ttnpb.DATA_RATE_0,
+						PingSlotDataRateIndex:  ttnpb.DataRateIndex(band.Beacon.DataRateIndex),
 						PingSlotFrequency:      0,
 						RejoinCountPeriodicity: ttnpb.REJOIN_COUNT_16,
 						RejoinTimePeriodicity:  ttnpb.REJOIN_TIME_0,
@@ -226,7 +226,7 @@ func TestResetMACState(t *testing.T) {
 							DownlinkDwellTime:      false,
 							MaxDutyCycle:           ttnpb.DUTY_CYCLE_1,
 							MaxEIRP:                band.DefaultMaxEIRP,
-							PingSlotDataRateIndex:  ttnpb.DATA_RATE_0,
+							PingSlotDataRateIndex:  ttnpb.DataRateIndex(band.Beacon.DataRateIndex),
 							PingSlotFrequency:      0,
 							RejoinCountPeriodicity: ttnpb.REJOIN_COUNT_16,
 							RejoinTimePeriodicity:  ttnpb.REJOIN_TIME_0,
@@ -247,7 +247,7 @@ func TestResetMACState(t *testing.T) {
 							DownlinkDwellTime:      false,
 							MaxDutyCycle:           ttnpb.DUTY_CYCLE_1,
 							MaxEIRP:                band.DefaultMaxEIRP,
-							PingSlotDataRateIndex:  ttnpb.DATA_RATE_0,
+							PingSlotDataRateIndex:  ttnpb.DataRateIndex(band.Beacon.DataRateIndex),
 							PingSlotFrequency:      0,
 							RejoinCountPeriodicity: ttnpb.REJOIN_COUNT_16,
 							RejoinTimePeriodicity:  ttnpb.REJOIN_TIME_0,
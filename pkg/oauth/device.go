@@ -0,0 +1,398 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RangelReale/osin"
+	"github.com/labstack/echo"
+	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/lru"
+)
+
+const (
+	// deviceCodeGrantType is the grant type of the device authorization grant (RFC 8628).
+	deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+	deviceCodeExpiration   = 10 * time.Minute
+	deviceCodePollInterval = 5 * time.Second
+
+	// The user code only contains consonants, so that it can not spell words and is easy to type.
+	userCodeCharset = "BCDFGHJKLMNPQRSTVWXZ"
+	userCodeLength  = 8
+
+	// deviceCodeAttempts is the number of times the codes are generated when they are already taken.
+	deviceCodeAttempts = 3
+
+	// verifyAttemptsLimit is the number of invalid user codes that a user can enter in verifyAttemptsWindow.
+	verifyAttemptsLimit  = 10
+	verifyAttemptsWindow = deviceCodeExpiration
+	// verifyAttemptsSize is the maximum number of users of which the invalid user codes are counted.
+	verifyAttemptsSize = 4096
+)
+
+// OAuth error codes of the device authorization grant (RFC 8628, section 3.5).
+const (
+	errAuthorizationPending = "authorization_pending"
+	errExpiredToken         = "expired_token"
+)
+
+func generateUserCode() (string, error) {
+	max := big.NewInt(int64(len(userCodeCharset)))
+	code := make([]byte, userCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = userCodeCharset[n.Int64()]
+	}
+	return string(code), nil
+}
+
+// formatUserCode formats the user code as XXXX-XXXX for display.
+func formatUserCode(userCode string) string {
+	return userCode[:userCodeLength/2] + "-" + userCode[userCodeLength/2:]
+}
+
+// normalizeUserCode removes the separators from the user code that is entered by the user.
+func normalizeUserCode(userCode string) string {
+	return strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(userCode))
+}
+
+// authenticateClient returns the OAuth client that is authenticated by basic authentication or the given credentials.
+// If the client can not be authenticated, the error is set in the response and nil is returned.
+func (s *server) authenticateClient(ctx context.Context, resp *osin.Response, req *http.Request, clientID, clientSecret string) (*ttnpb.Client, error) {
+	if username, password, ok := req.BasicAuth(); ok {
+		clientID, clientSecret = username, password
+	}
+	if clientID == "" {
+		resp.SetError(osin.E_INVALID_REQUEST, "Missing client ID")
+		return nil, nil
+	}
+	client, err := s.store.GetClient(ctx, &ttnpb.ClientIdentifiers{ClientID: clientID}, nil)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			resp.SetError(osin.E_INVALID_CLIENT, "OAuth client not found")
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !osinClient(*client).ClientSecretMatches(clientSecret) {
+		resp.SetError(osin.E_INVALID_CLIENT, "Invalid client secret")
+		return nil, nil
+	}
+	switch client.State {
+	case ttnpb.STATE_REJECTED:
+		resp.SetError(osin.E_INVALID_CLIENT, "OAuth client was rejected")
+		return nil, nil
+	case ttnpb.STATE_SUSPENDED:
+		resp.SetError(osin.E_INVALID_CLIENT, "OAuth client was suspended")
+		return nil, nil
+	case ttnpb.STATE_REQUESTED:
+		resp.SetError(osin.E_INVALID_CLIENT, "OAuth client is not yet approved")
+		return nil, nil
+	}
+	if !clientHasGrant(client, ttnpb.GRANT_DEVICE_CODE) {
+		resp.SetError(osin.E_UNAUTHORIZED_CLIENT, "OAuth client does not have device code grant")
+		return nil, nil
+	}
+	return client, nil
+}
+
+func (s *server) verificationURI(c echo.Context) string {
+	return (&url.URL{
+		Scheme: c.Scheme(),
+		Host:   c.Request().Host,
+		Path:   strings.TrimSuffix(s.config.Mount, "/") + "/device",
+	}).String()
+}
+
+type deviceAuthorizationRequest struct {
+	ClientID     string `json:"client_id" form:"client_id"`
+	ClientSecret string `json:"client_secret" form:"client_secret"`
+}
+
+type deviceAuthorizationResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// DeviceAuthorization handles the device authorization request of the device authorization grant (RFC 8628).
+func (s *server) DeviceAuthorization(c echo.Context) error {
+	req := c.Request()
+	ctx := req.Context()
+
+	var authorizationRequest deviceAuthorizationRequest
+	if err := c.Bind(&authorizationRequest); err != nil {
+		return err
+	}
+
+	resp := s.oauth2(ctx).NewResponse()
+	defer resp.Close()
+	client, err := s.authenticateClient(ctx, resp, req, authorizationRequest.ClientID, authorizationRequest.ClientSecret)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return s.output(c, resp)
+	}
+
+	var deviceCode, userCode string
+	// The user code is short, so it may collide with the user code of a pending device authorization.
+	for attempt := 1; ; attempt++ {
+		deviceCode, err = auth.DeviceCode.Generate(ctx, "")
+		if err != nil {
+			return err
+		}
+		userCode, err = generateUserCode()
+		if err != nil {
+			return err
+		}
+		now := s.now()
+		err = s.store.CreateDeviceAuthorization(ctx, &ttnpb.OAuthDeviceAuthorization{
+			ClientIDs:  client.ClientIdentifiers,
+			Rights:     client.Rights,
+			DeviceCode: deviceCode,
+			UserCode:   userCode,
+			CreatedAt:  now,
+			ExpiresAt:  now.Add(deviceCodeExpiration),
+		})
+		if err == nil {
+			break
+		}
+		if !errors.IsAlreadyExists(err) || attempt >= deviceCodeAttempts {
+			return err
+		}
+	}
+
+	verificationURI := s.verificationURI(c)
+	return c.JSON(http.StatusOK, deviceAuthorizationResponse{
+		DeviceCode:              deviceCode,
+		UserCode:                formatUserCode(userCode),
+		VerificationURI:         verificationURI,
+		VerificationURIComplete: verificationURI + "?" + url.Values{"user_code": {formatUserCode(userCode)}}.Encode(),
+		ExpiresIn:               int(deviceCodeExpiration.Seconds()),
+		Interval:                int(deviceCodePollInterval.Seconds()),
+	})
+}
+
+type verifyAttempt struct {
+	count int
+	start time.Time
+}
+
+// verifyAttempts counts the invalid user codes that users enter, so that user codes can not be guessed.
+type verifyAttempts struct {
+	mu       sync.Mutex
+	attempts *lru.Cache
+}
+
+func (a *verifyAttempts) get(userID string, now time.Time) *verifyAttempt {
+	if a.attempts == nil {
+		a.attempts = lru.New(verifyAttemptsSize, nil)
+	}
+	if v, ok := a.attempts.Get(userID); ok {
+		if attempt := v.(*verifyAttempt); now.Sub(attempt.start) < verifyAttemptsWindow {
+			return attempt
+		}
+	}
+	attempt := &verifyAttempt{start: now}
+	a.attempts.Add(userID, attempt)
+	return attempt
+}
+
+// exceeded returns whether the user entered too many invalid user codes.
+func (a *verifyAttempts) exceeded(userID string, now time.Time) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.get(userID, now).count >= verifyAttemptsLimit
+}
+
+// fail counts an invalid user code that the user entered.
+func (a *verifyAttempts) fail(userID string, now time.Time) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.get(userID, now).count++
+}
+
+var (
+	errVerifyAttempts             = errors.DefineResourceExhausted("verify_attempts", "too many invalid user codes, try again later")
+	errDeviceAuthorizationExpired = errors.DefineInvalidArgument("device_authorization_expired", "device authorization expired")
+	errDeviceAuthorizationDecided = errors.DefineFailedPrecondition("device_authorization_decided", "device authorization already approved or denied")
+)
+
+// VerifyDevice handles the user verification of the device authorization grant (RFC 8628).
+// A GET request renders the verification page, a POST request approves or denies the device authorization.
+func (s *server) VerifyDevice(verifyPage echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+		ctx := req.Context()
+		session, err := s.getSession(c)
+		if err != nil {
+			return err
+		}
+		userCode := normalizeUserCode(c.FormValue("user_code"))
+		if userCode == "" {
+			if req.Method == http.MethodPost {
+				return c.NoContent(http.StatusBadRequest)
+			}
+			return verifyPage(c)
+		}
+		if s.verifyAttempts.exceeded(session.UserIdentifiers.UserID, s.now()) {
+			return errVerifyAttempts
+		}
+		authorization, err := s.store.GetDeviceAuthorizationByUserCode(ctx, userCode)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				s.verifyAttempts.fail(session.UserIdentifiers.UserID, s.now())
+			}
+			return err
+		}
+		if authorization.ExpiresAt.Before(s.now()) {
+			return errDeviceAuthorizationExpired
+		}
+		if authorization.UserIDs.UserID != "" {
+			return errDeviceAuthorizationDecided
+		}
+
+		switch req.Method {
+		case http.MethodPost:
+			authorized, _ := strconv.ParseBool(req.PostForm.Get("authorize"))
+			if err := s.store.SetDeviceAuthorizationDecision(ctx, userCode, &session.UserIdentifiers, authorized); err != nil {
+				return err
+			}
+			if authorized {
+				events.Publish(evtAuthorize(ctx, ttnpb.CombineIdentifiers(session.UserIdentifiers, authorization.ClientIDs), nil))
+			}
+			c.Set("page_data", struct {
+				Authorized bool `json:"authorized"`
+			}{
+				Authorized: authorized,
+			})
+			return verifyPage(c)
+		case http.MethodGet:
+			client, err := s.store.GetClient(ctx, &authorization.ClientIDs, nil)
+			if err != nil {
+				return err
+			}
+			safeClient := client.PublicSafe()
+			clientJSON, _ := jsonpb.TTN().Marshal(safeClient)
+			user, err := s.getUser(c)
+			if err != nil {
+				return err
+			}
+			safeUser := user.PublicSafe()
+			userJSON, _ := jsonpb.TTN().Marshal(safeUser)
+			c.Set("page_data", struct {
+				Client   json.RawMessage `json:"client"`
+				User     json.RawMessage `json:"user"`
+				UserCode string          `json:"user_code"`
+			}{
+				Client:   clientJSON,
+				User:     userJSON,
+				UserCode: formatUserCode(userCode),
+			})
+			return verifyPage(c)
+		}
+		return c.NoContent(http.StatusMethodNotAllowed)
+	}
+}
+
+// deviceCodeToken handles the access token request of the device authorization grant (RFC 8628).
+func (s *server) deviceCodeToken(c echo.Context, tokenRequest tokenRequest) error {
+	req := c.Request()
+	ctx := req.Context()
+	oauth2 := s.oauth2(ctx)
+	resp := oauth2.NewResponse()
+	defer resp.Close()
+
+	client, err := s.authenticateClient(ctx, resp, req, tokenRequest.ClientID, tokenRequest.ClientSecret)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return s.output(c, resp)
+	}
+	if tokenRequest.DeviceCode == "" {
+		resp.SetError(osin.E_INVALID_REQUEST, "Missing device code")
+		return s.output(c, resp)
+	}
+	authorization, err := s.store.GetDeviceAuthorization(ctx, tokenRequest.DeviceCode)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			resp.SetError(osin.E_INVALID_GRANT, "Invalid device code")
+			return s.output(c, resp)
+		}
+		return err
+	}
+	if authorization.ClientIDs.ClientID != client.ClientID {
+		resp.SetError(osin.E_INVALID_GRANT, "Device code was issued to another OAuth client")
+		return s.output(c, resp)
+	}
+	switch {
+	case authorization.ExpiresAt.Before(s.now()):
+		if err := s.store.DeleteDeviceAuthorization(ctx, authorization.DeviceCode); err != nil {
+			return err
+		}
+		resp.SetError(errExpiredToken, "Device code expired")
+		return s.output(c, resp)
+	case authorization.UserIDs.UserID == "":
+		resp.SetError(errAuthorizationPending, "User did not yet approve or deny the device authorization")
+		return s.output(c, resp)
+	case !authorization.Authorized:
+		if err := s.store.DeleteDeviceAuthorization(ctx, authorization.DeviceCode); err != nil {
+			return err
+		}
+		resp.SetError(osin.E_ACCESS_DENIED, "User denied the device authorization")
+		return s.output(c, resp)
+	}
+	if err := s.store.DeleteDeviceAuthorization(ctx, authorization.DeviceCode); err != nil {
+		return err
+	}
+
+	ar := &osin.AccessRequest{
+		Type:            osin.AccessRequestType(deviceCodeGrantType),
+		Client:          osinClient(*client),
+		Scope:           rightsToScope(authorization.Rights...),
+		UserData:        userData{UserIdentifiers: authorization.UserIDs},
+		Expiration:      s.osinConfig.AccessExpiration,
+		GenerateRefresh: clientHasGrant(client, ttnpb.GRANT_REFRESH_TOKEN),
+		Authorized:      true,
+		HttpRequest:     req,
+	}
+	events.Publish(evtTokenExchange(ctx, ttnpb.CombineIdentifiers(authorization.UserIDs, client.ClientIdentifiers), nil))
+	oauth2.FinishAccessRequest(resp, req, ar)
+	delete(resp.Output, "scope")
+	return s.output(c, resp)
+}
@@ -0,0 +1,41 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oauth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+)
+
+func TestVerifyAttempts(t *testing.T) {
+	a := assertions.New(t)
+
+	var attempts verifyAttempts
+	now := time.Unix(1262304000, 0)
+
+	for i := 0; i < verifyAttemptsLimit; i++ {
+		a.So(attempts.exceeded("foo-user", now), should.BeFalse)
+		attempts.fail("foo-user", now)
+	}
+	a.So(attempts.exceeded("foo-user", now), should.BeTrue)
+	a.So(attempts.exceeded("foo-user", now.Add(verifyAttemptsWindow-time.Second)), should.BeTrue)
+	a.So(attempts.exceeded("bar-user", now), should.BeFalse)
+
+	// The attempts are reset after the window.
+	a.So(attempts.exceeded("foo-user", now.Add(verifyAttemptsWindow)), should.BeFalse)
+}
@@ -15,6 +15,7 @@
 package oauth
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/url"
@@ -145,6 +146,8 @@ type tokenRequest struct {
 	RedirectURI  string `json:"redirect_uri" form:"redirect_uri"`
 	ClientID     string `json:"client_id" form:"client_id"`
 	ClientSecret string `json:"client_secret" form:"client_secret"`
+	Scope        string `json:"scope" form:"scope"`
+	DeviceCode   string `json:"device_code" form:"device_code"`
 }
 
 func (r tokenRequest) Values() (values url.Values) {
@@ -167,6 +170,12 @@ func (r tokenRequest) Values() (values url.Values) {
 	if r.ClientSecret != "" {
 		values.Set("client_secret", r.ClientSecret)
 	}
+	if r.Scope != "" {
+		values.Set("scope", r.Scope)
+	}
+	if r.DeviceCode != "" {
+		values.Set("device_code", r.DeviceCode)
+	}
 	return
 }
 
@@ -181,6 +190,11 @@ func (s *server) Token(c echo.Context) error {
 	req.Form = tokenRequest.Values()
 	req.PostForm = req.Form
 
+	// The device code grant is not supported by osin, so we handle it ourselves.
+	if tokenRequest.GrantType == deviceCodeGrantType {
+		return s.deviceCodeToken(c, tokenRequest)
+	}
+
 	oauth2 := s.oauth2(req.Context())
 	resp := oauth2.NewResponse()
 	defer resp.Close()
@@ -190,7 +204,6 @@ func (s *server) Token(c echo.Context) error {
 	}
 
 	client := ttnpb.Client(ar.Client.(osinClient))
-	userIDs := ar.UserData.(userData).UserIdentifiers
 	ar.GenerateRefresh = clientHasGrant(&client, ttnpb.GRANT_REFRESH_TOKEN)
 	switch ar.Type {
 	case osin.AUTHORIZATION_CODE:
@@ -202,10 +215,35 @@ func (s *server) Token(c echo.Context) error {
 			if err := s.doLogin(req.Context(), ar.Username, ar.Password); err != nil {
 				return err
 			}
+			ar.UserData = userData{UserIdentifiers: ttnpb.UserIdentifiers{UserID: ar.Username}}
+			ar.Authorized = true
+		}
+	case osin.CLIENT_CREDENTIALS:
+		if clientHasGrant(&client, ttnpb.GRANT_CLIENT_CREDENTIALS) {
+			if client.Secret == "" {
+				resp.SetError(osin.E_UNAUTHORIZED_CLIENT, "OAuth client without secret can not use client credentials")
+				return s.output(c, resp)
+			}
+			ownerIDs, err := s.getClientOwner(req.Context(), &client.ClientIdentifiers)
+			if err != nil {
+				return err
+			}
+			if ownerIDs == nil {
+				resp.SetError(osin.E_UNAUTHORIZED_CLIENT, "OAuth client does not have a single owner")
+				return s.output(c, resp)
+			}
+			rights := client.Rights
+			if ar.Scope != "" {
+				rights = ttnpb.RightsFrom(rightsFromScope(ar.Scope)...).Intersect(ttnpb.RightsFrom(rights...)).GetRights()
+			}
+			ar.UserData = userData{UserIdentifiers: *ownerIDs}
+			ar.Scope = rightsToScope(rights...)
+			ar.GenerateRefresh = false
 			ar.Authorized = true
 		}
 	}
 	if ar.Authorized {
+		userIDs := ar.UserData.(userData).UserIdentifiers
 		events.Publish(evtTokenExchange(req.Context(), ttnpb.CombineIdentifiers(userIDs, client.ClientIdentifiers), nil))
 	}
 	oauth2.FinishAccessRequest(resp, req, ar)
@@ -221,3 +259,25 @@ func clientHasGrant(cli *ttnpb.Client, wanted ttnpb.GrantType) bool {
 	}
 	return false
 }
+
+// getClientOwner returns the identifiers of the user that owns the OAuth client,
+// which is the only user collaborator with all rights on the client.
+// If the client does not have exactly one such collaborator, nil is returned.
+func (s *server) getClientOwner(ctx context.Context, ids *ttnpb.ClientIdentifiers) (*ttnpb.UserIdentifiers, error) {
+	members, err := s.store.FindMembers(ctx, ids.EntityIdentifiers())
+	if err != nil {
+		return nil, err
+	}
+	var ownerIDs *ttnpb.UserIdentifiers
+	for ouIDs, rights := range members {
+		userIDs := ouIDs.GetUserIDs()
+		if userIDs == nil || !rights.IncludesAll(ttnpb.RIGHT_CLIENT_ALL) {
+			continue
+		}
+		if ownerIDs != nil {
+			return nil, nil
+		}
+		ownerIDs = userIDs
+	}
+	return ownerIDs, nil
+}
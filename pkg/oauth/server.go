@@ -38,6 +38,8 @@ type Server interface {
 	Logout(c echo.Context) error
 	Authorize(authorizePage echo.HandlerFunc) echo.HandlerFunc
 	Token(c echo.Context) error
+	DeviceAuthorization(c echo.Context) error
+	VerifyDevice(verifyPage echo.HandlerFunc) echo.HandlerFunc
 }
 
 type server struct {
@@ -45,6 +47,8 @@ type server struct {
 	config     Config
 	osinConfig *osin.ServerConfig
 	store      Store
+
+	verifyAttempts verifyAttempts
 }
 
 // Store used by the OAuth server.
@@ -56,6 +60,8 @@ type Store interface {
 	store.ClientStore
 	// OAuth is needed for OAuth authorizations.
 	store.OAuthStore
+	// MembershipStore is needed for finding the owner of the OAuth client in the client credentials grant.
+	store.MembershipStore
 }
 
 // UIConfig is the combined configuration for the OAuth UI.
@@ -98,6 +104,7 @@ func NewServer(ctx context.Context, store Store, config Config) Server {
 			osin.AUTHORIZATION_CODE,
 			osin.REFRESH_TOKEN,
 			osin.PASSWORD,
+			osin.CLIENT_CREDENTIALS,
 		},
 		ErrorStatusCode:           http.StatusBadRequest,
 		AllowClientSecretInParams: true,
@@ -179,11 +186,14 @@ func (s *server) RegisterRoutes(server *web.Server) {
 	page.GET("/login", webui.Template.Handler, s.redirectToNext)
 	page.GET("/authorize", s.Authorize(webui.Template.Handler), s.redirectToLogin)
 	page.POST("/authorize", s.Authorize(webui.Template.Handler), s.redirectToLogin)
+	page.GET("/device", s.VerifyDevice(webui.Template.Handler), s.redirectToLogin)
+	page.POST("/device", s.VerifyDevice(webui.Template.Handler), s.redirectToLogin)
 
 	if s.config.Mount != "" && s.config.Mount != "/" {
 		group.GET("", webui.Template.Handler, middleware.CSRF())
 	}
 	group.GET("/*", webui.Template.Handler, middleware.CSRF())
 
-	group.POST("/token", s.Token) // No CSRF here.
+	group.POST("/token", s.Token)                              // No CSRF here.
+	group.POST("/device_authorization", s.DeviceAuthorization) // No CSRF here.
 }
@@ -32,6 +32,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/auth"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/oauth"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
@@ -66,6 +67,13 @@ var (
 		RedirectURIs:      []string{"https://uri/callback", "http://uri/callback"},
 		Rights:            []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
 	}
+	mockHeadlessClient = &ttnpb.Client{
+		ClientIdentifiers: ttnpb.ClientIdentifiers{ClientID: "client"},
+		State:             ttnpb.STATE_APPROVED,
+		Grants:            []ttnpb.GrantType{ttnpb.GRANT_CLIENT_CREDENTIALS, ttnpb.GRANT_DEVICE_CODE, ttnpb.GRANT_REFRESH_TOKEN},
+		RedirectURIs:      []string{"http://localhost"},
+		Rights:            []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
+	}
 )
 
 func init() {
@@ -80,6 +88,7 @@ func init() {
 		panic(err)
 	}
 	mockClient.Secret = string(secret)
+	mockHeadlessClient.Secret = string(secret)
 }
 
 func TestOAuthFlow(t *testing.T) {
@@ -441,6 +450,7 @@ func TestTokenExchange(t *testing.T) {
 		Method       string
 		Path         string
 		Body         interface{}
+		BasicAuth    *url.Userinfo
 		ExpectedCode int
 		ExpectedBody string
 	}{
@@ -519,6 +529,239 @@ func TestTokenExchange(t *testing.T) {
 				a.So(s.req.previousID, should.Equal, "IBTFXELDVVT64Y26IZZFFNSL7GWZY2Y3ALQQI3A")
 			},
 		},
+		{
+			Name: "Exchange Client Credentials",
+			StoreSetup: func(s *mockStore) {
+				s.res.client = mockHeadlessClient
+				s.res.members = map[*ttnpb.OrganizationOrUserIdentifiers]*ttnpb.Rights{
+					mockUser.OrganizationOrUserIdentifiers(): ttnpb.RightsFrom(ttnpb.RIGHT_CLIENT_ALL),
+				}
+			},
+			Method: "POST",
+			Path:   "/oauth/token",
+			Body: map[string]string{
+				"grant_type":    "client_credentials",
+				"client_id":     "client",
+				"client_secret": "secret",
+			},
+			ExpectedCode: http.StatusOK,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "FindMembers")
+				a.So(s.req.entityIDs, should.Resemble, mockHeadlessClient.EntityIdentifiers())
+				a.So(s.calls, should.Contain, "CreateAccessToken")
+				a.So(s.req.token.UserIDs, should.Resemble, mockUser.UserIdentifiers)
+				a.So(s.req.token.ClientIDs, should.Resemble, mockHeadlessClient.ClientIdentifiers)
+				a.So(s.req.token.Rights, should.Resemble, mockHeadlessClient.Rights)
+				a.So(s.req.token.AccessToken, should.NotBeEmpty)
+				a.So(s.req.token.RefreshToken, should.BeEmpty)
+			},
+		},
+		{
+			Name: "Exchange Client Credentials Without Grant",
+			StoreSetup: func(s *mockStore) {
+				s.res.client = mockClient
+			},
+			Method: "POST",
+			Path:   "/oauth/token",
+			Body: map[string]string{
+				"grant_type":    "client_credentials",
+				"client_id":     "client",
+				"client_secret": "secret",
+			},
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: "access_denied",
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "FindMembers")
+				a.So(s.calls, should.NotContain, "CreateAccessToken")
+			},
+		},
+		{
+			Name: "Exchange Client Credentials Without Secret",
+			StoreSetup: func(s *mockStore) {
+				client := *mockHeadlessClient
+				client.Secret = ""
+				s.res.client = &client
+				s.res.members = map[*ttnpb.OrganizationOrUserIdentifiers]*ttnpb.Rights{
+					mockUser.OrganizationOrUserIdentifiers(): ttnpb.RightsFrom(ttnpb.RIGHT_CLIENT_ALL),
+				}
+			},
+			Method: "POST",
+			Path:   "/oauth/token",
+			Body: map[string]string{
+				"grant_type": "client_credentials",
+			},
+			BasicAuth:    url.UserPassword("client", ""),
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: "unauthorized_client",
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "FindMembers")
+				a.So(s.calls, should.NotContain, "CreateAccessToken")
+			},
+		},
+		{
+			Name: "Device Authorization",
+			StoreSetup: func(s *mockStore) {
+				s.res.client = mockHeadlessClient
+			},
+			Method: "POST",
+			Path:   "/oauth/device_authorization",
+			Body: map[string]string{
+				"client_id":     "client",
+				"client_secret": "secret",
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: "http://example.com/oauth/device",
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				if a.So(s.calls, should.Contain, "CreateDeviceAuthorization") {
+					a.So(s.req.deviceAuth.ClientIDs, should.Resemble, mockHeadlessClient.ClientIdentifiers)
+					a.So(s.req.deviceAuth.Rights, should.Resemble, mockHeadlessClient.Rights)
+					a.So(s.req.deviceAuth.DeviceCode, should.NotBeEmpty)
+					a.So(s.req.deviceAuth.UserCode, should.HaveLength, 8)
+					a.So(s.req.deviceAuth.ExpiresAt, should.HappenAfter, time.Now())
+				}
+			},
+		},
+		{
+			Name: "Device Authorization Without Grant",
+			StoreSetup: func(s *mockStore) {
+				s.res.client = mockClient
+			},
+			Method: "POST",
+			Path:   "/oauth/device_authorization",
+			Body: map[string]string{
+				"client_id":     "client",
+				"client_secret": "secret",
+			},
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: "unauthorized_client",
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "CreateDeviceAuthorization")
+			},
+		},
+		{
+			Name: "Device Authorization Codes Taken",
+			StoreSetup: func(s *mockStore) {
+				s.res.client = mockHeadlessClient
+				s.err.createDeviceAuth = errors.DefineAlreadyExists("test_codes_taken", "codes taken")
+			},
+			Method: "POST",
+			Path:   "/oauth/device_authorization",
+			Body: map[string]string{
+				"client_id":     "client",
+				"client_secret": "secret",
+			},
+			ExpectedCode: http.StatusConflict,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				var creates int
+				for _, call := range s.calls {
+					if call == "CreateDeviceAuthorization" {
+						creates++
+					}
+				}
+				a.So(creates, should.Equal, 3)
+			},
+		},
+		{
+			Name: "Exchange Pending Device Code",
+			StoreSetup: func(s *mockStore) {
+				s.res.client = mockHeadlessClient
+				s.res.deviceAuth = &ttnpb.OAuthDeviceAuthorization{
+					ClientIDs:  mockHeadlessClient.ClientIdentifiers,
+					Rights:     mockHeadlessClient.Rights,
+					DeviceCode: "the device code",
+					UserCode:   "BCDFGHJK",
+					CreatedAt:  time.Now().Truncate(time.Second),
+					ExpiresAt:  time.Now().Truncate(time.Second).Add(time.Hour),
+				}
+			},
+			Method: "POST",
+			Path:   "/oauth/token",
+			Body: map[string]string{
+				"grant_type":    "urn:ietf:params:oauth:grant-type:device_code",
+				"device_code":   "the device code",
+				"client_id":     "client",
+				"client_secret": "secret",
+			},
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: "authorization_pending",
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.req.deviceCode, should.Equal, "the device code")
+				a.So(s.calls, should.NotContain, "DeleteDeviceAuthorization")
+				a.So(s.calls, should.NotContain, "CreateAccessToken")
+			},
+		},
+		{
+			Name: "Exchange Expired Device Code",
+			StoreSetup: func(s *mockStore) {
+				s.res.client = mockHeadlessClient
+				s.res.deviceAuth = &ttnpb.OAuthDeviceAuthorization{
+					ClientIDs:  mockHeadlessClient.ClientIdentifiers,
+					Rights:     mockHeadlessClient.Rights,
+					DeviceCode: "the device code",
+					UserCode:   "BCDFGHJK",
+					CreatedAt:  time.Now().Truncate(time.Second).Add(-2 * time.Hour),
+					ExpiresAt:  time.Now().Truncate(time.Second).Add(-time.Hour),
+				}
+			},
+			Method: "POST",
+			Path:   "/oauth/token",
+			Body: map[string]string{
+				"grant_type":    "urn:ietf:params:oauth:grant-type:device_code",
+				"device_code":   "the device code",
+				"client_id":     "client",
+				"client_secret": "secret",
+			},
+			ExpectedCode: http.StatusBadRequest,
+			ExpectedBody: "expired_token",
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "DeleteDeviceAuthorization")
+				a.So(s.calls, should.NotContain, "CreateAccessToken")
+			},
+		},
+		{
+			Name: "Exchange Approved Device Code",
+			StoreSetup: func(s *mockStore) {
+				s.res.client = mockHeadlessClient
+				s.res.deviceAuth = &ttnpb.OAuthDeviceAuthorization{
+					ClientIDs:  mockHeadlessClient.ClientIdentifiers,
+					UserIDs:    mockUser.UserIdentifiers,
+					Rights:     mockHeadlessClient.Rights,
+					DeviceCode: "the device code",
+					UserCode:   "BCDFGHJK",
+					Authorized: true,
+					CreatedAt:  time.Now().Truncate(time.Second),
+					ExpiresAt:  time.Now().Truncate(time.Second).Add(time.Hour),
+				}
+			},
+			Method: "POST",
+			Path:   "/oauth/token",
+			Body: map[string]string{
+				"grant_type":    "urn:ietf:params:oauth:grant-type:device_code",
+				"device_code":   "the device code",
+				"client_id":     "client",
+				"client_secret": "secret",
+			},
+			ExpectedCode: http.StatusOK,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "DeleteDeviceAuthorization")
+				a.So(s.req.deviceCode, should.Equal, "the device code")
+				a.So(s.calls, should.Contain, "CreateAccessToken")
+				a.So(s.req.token.UserIDs, should.Resemble, mockUser.UserIdentifiers)
+				a.So(s.req.token.ClientIDs, should.Resemble, mockHeadlessClient.ClientIdentifiers)
+				a.So(s.req.token.Rights, should.Resemble, mockHeadlessClient.Rights)
+				a.So(s.req.token.AccessToken, should.NotBeEmpty)
+				a.So(s.req.token.RefreshToken, should.NotBeEmpty)
+			},
+		},
 	} {
 		name := tt.Name
 		if name == "" {
@@ -551,6 +794,10 @@ func TestTokenExchange(t *testing.T) {
 			if contentType != "" {
 				req.Header.Set("Content-Type", contentType)
 			}
+			if tt.BasicAuth != nil {
+				password, _ := tt.BasicAuth.Password()
+				req.SetBasicAuth(tt.BasicAuth.Username(), password)
+			}
 
 			res := httptest.NewRecorder()
 
@@ -39,6 +39,11 @@ type mockStoreContents struct {
 		token             *ttnpb.OAuthAccessToken
 		previousID        string
 		tokenID           string
+		entityIDs         *ttnpb.EntityIdentifiers
+		deviceAuth        *ttnpb.OAuthDeviceAuthorization
+		deviceCode        string
+		userCode          string
+		authorized        bool
 	}
 	res struct {
 		session           *ttnpb.UserSession
@@ -47,6 +52,8 @@ type mockStoreContents struct {
 		authorization     *ttnpb.OAuthClientAuthorization
 		authorizationCode *ttnpb.OAuthAuthorizationCode
 		accessToken       *ttnpb.OAuthAccessToken
+		members           map[*ttnpb.OrganizationOrUserIdentifiers]*ttnpb.Rights
+		deviceAuth        *ttnpb.OAuthDeviceAuthorization
 	}
 	err struct {
 		getUser                 error
@@ -62,6 +69,11 @@ type mockStoreContents struct {
 		createAccessToken       error
 		getAccessToken          error
 		deleteAccessToken       error
+		findMembers             error
+		createDeviceAuth        error
+		getDeviceAuth           error
+		setDeviceAuthDecision   error
+		deleteDeviceAuth        error
 	}
 }
 
@@ -70,6 +82,7 @@ type mockStore struct {
 	store.UserSessionStore
 	store.ClientStore
 	store.OAuthStore
+	store.MembershipStore
 
 	mockStoreContents
 }
@@ -163,3 +176,39 @@ func (s *mockStore) DeleteAccessToken(ctx context.Context, tokenID string) error
 	s.calls = append(s.calls, "DeleteAccessToken")
 	return s.err.deleteAccessToken
 }
+
+func (s *mockStore) FindMembers(ctx context.Context, entityIDs *ttnpb.EntityIdentifiers) (map[*ttnpb.OrganizationOrUserIdentifiers]*ttnpb.Rights, error) {
+	s.req.ctx, s.req.entityIDs = ctx, entityIDs
+	s.calls = append(s.calls, "FindMembers")
+	return s.res.members, s.err.findMembers
+}
+
+func (s *mockStore) CreateDeviceAuthorization(ctx context.Context, authorization *ttnpb.OAuthDeviceAuthorization) error {
+	s.req.ctx, s.req.deviceAuth = ctx, authorization
+	s.calls = append(s.calls, "CreateDeviceAuthorization")
+	return s.err.createDeviceAuth
+}
+
+func (s *mockStore) GetDeviceAuthorization(ctx context.Context, deviceCode string) (*ttnpb.OAuthDeviceAuthorization, error) {
+	s.req.ctx, s.req.deviceCode = ctx, deviceCode
+	s.calls = append(s.calls, "GetDeviceAuthorization")
+	return s.res.deviceAuth, s.err.getDeviceAuth
+}
+
+func (s *mockStore) GetDeviceAuthorizationByUserCode(ctx context.Context, userCode string) (*ttnpb.OAuthDeviceAuthorization, error) {
+	s.req.ctx, s.req.userCode = ctx, userCode
+	s.calls = append(s.calls, "GetDeviceAuthorizationByUserCode")
+	return s.res.deviceAuth, s.err.getDeviceAuth
+}
+
+func (s *mockStore) SetDeviceAuthorizationDecision(ctx context.Context, userCode string, userIDs *ttnpb.UserIdentifiers, authorized bool) error {
+	s.req.ctx, s.req.userCode, s.req.userIDs, s.req.authorized = ctx, userCode, userIDs, authorized
+	s.calls = append(s.calls, "SetDeviceAuthorizationDecision")
+	return s.err.setDeviceAuthDecision
+}
+
+func (s *mockStore) DeleteDeviceAuthorization(ctx context.Context, deviceCode string) error {
+	s.req.ctx, s.req.deviceCode = ctx, deviceCode
+	s.calls = append(s.calls, "DeleteDeviceAuthorization")
+	return s.err.deleteDeviceAuth
+}
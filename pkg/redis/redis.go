@@ -183,6 +183,63 @@ func FindProtos(r redis.Cmdable, k string, keyCmd func(...string) string) *Proto
 	}
 }
 
+// UpdateProtos scans the keys matching pattern and updates the protocol buffers stored under them transactionally.
+// f must return a new empty proto.Message of the type expected to be stored under the key, or nil if the key should be skipped.
+// The function returned by f will be called after the value is unmarshaled into the message returned by f and
+// must report whether the message was changed and should be stored.
+// UpdateProtos returns the number of updated keys.
+func UpdateProtos(r WatchCmdable, pattern string, f func(k string) (proto.Message, func() (bool, error))) (int, error) {
+	var n int
+	it := r.Scan(0, pattern, 0).Iterator()
+	for it.Next() {
+		k := it.Val()
+		pb, cb := f(k)
+		if pb == nil {
+			continue
+		}
+		var updated bool
+		if err := r.Watch(func(tx *redis.Tx) error {
+			s, err := tx.Get(k).Result()
+			if err == redis.Nil {
+				// The key was deleted after scanning.
+				return nil
+			} else if err != nil {
+				return ConvertError(err)
+			}
+			if err := UnmarshalProto(s, pb); err != nil {
+				return err
+			}
+			ok, err := cb()
+			if err != nil || !ok {
+				return err
+			}
+			cmds, err := tx.Pipelined(func(p redis.Pipeliner) error {
+				_, err := SetProto(p, k, pb, 0)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			for _, cmd := range cmds {
+				if err := cmd.Err(); err != nil {
+					return err
+				}
+			}
+			updated = true
+			return nil
+		}, k); err != nil {
+			return n, err
+		}
+		if updated {
+			n++
+		}
+	}
+	if err := it.Err(); err != nil {
+		return n, ConvertError(err)
+	}
+	return n, nil
+}
+
 const (
 	payloadKey = "payload"
 	startAtKey = "start_at"
@@ -15,12 +15,16 @@ var ApplicationLinkFieldPathsNested = []string{
 	"default_formatters.down_formatter_parameter",
 	"default_formatters.up_formatter",
 	"default_formatters.up_formatter_parameter",
+	"downlink_policy",
+	"downlink_policy.expiry",
+	"downlink_policy.max_confirmed_retries",
 	"network_server_address",
 }
 
 var ApplicationLinkFieldPathsTopLevel = []string{
 	"api_key",
 	"default_formatters",
+	"downlink_policy",
 	"network_server_address",
 }
 
@@ -68,6 +72,27 @@ func (dst *ApplicationLink) SetFields(src *ApplicationLink, paths ...string) err
 					dst.DefaultFormatters = nil
 				}
 			}
+		case "downlink_policy":
+			if len(subs) > 0 {
+				newDst := dst.DownlinkPolicy
+				if newDst == nil {
+					newDst = &ApplicationDownlinkPolicy{}
+					dst.DownlinkPolicy = newDst
+				}
+				var newSrc *ApplicationDownlinkPolicy
+				if src != nil {
+					newSrc = src.DownlinkPolicy
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DownlinkPolicy = src.DownlinkPolicy
+				} else {
+					dst.DownlinkPolicy = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -137,6 +162,9 @@ var SetApplicationLinkRequestFieldPathsNested = []string{
 	"link.default_formatters.down_formatter_parameter",
 	"link.default_formatters.up_formatter",
 	"link.default_formatters.up_formatter_parameter",
+	"link.downlink_policy",
+	"link.downlink_policy.expiry",
+	"link.downlink_policy.max_confirmed_retries",
 	"link.network_server_address",
 }
 
@@ -14,7 +14,6 @@ import _ "google.golang.org/genproto/googleapis/api/annotations"
 
 import (
 	context "context"
-
 	grpc "google.golang.org/grpc"
 )
 
@@ -40,17 +39,23 @@ type ApplicationLink struct {
 	// The typical format of the address is "host:port". If the port is omitted,
 	// the normal port inference (with DNS lookup, otherwise defaults) is used.
 	// Leave empty when linking to a cluster Network Server.
-	NetworkServerAddress string                    `protobuf:"bytes,1,opt,name=network_server_address,json=networkServerAddress,proto3" json:"network_server_address,omitempty"`
-	APIKey               string                    `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
-	DefaultFormatters    *MessagePayloadFormatters `protobuf:"bytes,3,opt,name=default_formatters,json=defaultFormatters,proto3" json:"default_formatters,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	NetworkServerAddress string `protobuf:"bytes,1,opt,name=network_server_address,json=networkServerAddress,proto3" json:"network_server_address,omitempty"`
+	// The API key to link with, which needs the RIGHT_APPLICATION_LINK right.
+	// To store solved end device locations in the Entity Registry, the API key also needs the
+	// RIGHT_APPLICATION_DEVICES_READ and RIGHT_APPLICATION_DEVICES_WRITE rights.
+	APIKey            string                    `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	DefaultFormatters *MessagePayloadFormatters `protobuf:"bytes,3,opt,name=default_formatters,json=defaultFormatters,proto3" json:"default_formatters,omitempty"`
+	// The downlink policy for the end devices of the application.
+	// If null, the Application Server default policy is used.
+	DownlinkPolicy       *ApplicationDownlinkPolicy `protobuf:"bytes,4,opt,name=downlink_policy,json=downlinkPolicy,proto3" json:"downlink_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
 func (m *ApplicationLink) Reset()      { *m = ApplicationLink{} }
 func (*ApplicationLink) ProtoMessage() {}
 func (*ApplicationLink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_applicationserver_bb4b6097f23b5b16, []int{0}
+	return fileDescriptor_applicationserver_4575df38d85ac5de, []int{0}
 }
 func (m *ApplicationLink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -100,6 +105,13 @@ func (m *ApplicationLink) GetDefaultFormatters() *MessagePayloadFormatters {
 	return nil
 }
 
+func (m *ApplicationLink) GetDownlinkPolicy() *ApplicationDownlinkPolicy {
+	if m != nil {
+		return m.DownlinkPolicy
+	}
+	return nil
+}
+
 type GetApplicationLinkRequest struct {
 	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
 	FieldMask              types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
@@ -110,7 +122,7 @@ type GetApplicationLinkRequest struct {
 func (m *GetApplicationLinkRequest) Reset()      { *m = GetApplicationLinkRequest{} }
 func (*GetApplicationLinkRequest) ProtoMessage() {}
 func (*GetApplicationLinkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_applicationserver_bb4b6097f23b5b16, []int{1}
+	return fileDescriptor_applicationserver_4575df38d85ac5de, []int{1}
 }
 func (m *GetApplicationLinkRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -157,7 +169,7 @@ type SetApplicationLinkRequest struct {
 func (m *SetApplicationLinkRequest) Reset()      { *m = SetApplicationLinkRequest{} }
 func (*SetApplicationLinkRequest) ProtoMessage() {}
 func (*SetApplicationLinkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_applicationserver_bb4b6097f23b5b16, []int{2}
+	return fileDescriptor_applicationserver_4575df38d85ac5de, []int{2}
 }
 func (m *SetApplicationLinkRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -229,6 +241,9 @@ func (this *ApplicationLink) Equal(that interface{}) bool {
 	if !this.DefaultFormatters.Equal(that1.DefaultFormatters) {
 		return false
 	}
+	if !this.DownlinkPolicy.Equal(that1.DownlinkPolicy) {
+		return false
+	}
 	return true
 }
 func (this *GetApplicationLinkRequest) Equal(that interface{}) bool {
@@ -795,6 +810,16 @@ func (m *ApplicationLink) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n1
 	}
+	if m.DownlinkPolicy != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.DownlinkPolicy.Size()))
+		n2, err := m.DownlinkPolicy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
 	return i, nil
 }
 
@@ -816,19 +841,19 @@ func (m *GetApplicationLinkRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintApplicationserver(dAtA, i, uint64(m.ApplicationIdentifiers.Size()))
-	n2, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
+	n3, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n2
+	i += n3
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintApplicationserver(dAtA, i, uint64(m.FieldMask.Size()))
-	n3, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n4, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n3
+	i += n4
 	return i, nil
 }
 
@@ -850,27 +875,27 @@ func (m *SetApplicationLinkRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintApplicationserver(dAtA, i, uint64(m.ApplicationIdentifiers.Size()))
-	n4, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
+	n5, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n4
+	i += n5
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintApplicationserver(dAtA, i, uint64(m.ApplicationLink.Size()))
-	n5, err := m.ApplicationLink.MarshalTo(dAtA[i:])
+	n6, err := m.ApplicationLink.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n5
+	i += n6
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintApplicationserver(dAtA, i, uint64(m.FieldMask.Size()))
-	n6, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n7, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n6
+	i += n7
 	return i, nil
 }
 
@@ -890,6 +915,9 @@ func NewPopulatedApplicationLink(r randyApplicationserver, easy bool) *Applicati
 	if r.Intn(10) != 0 {
 		this.DefaultFormatters = NewPopulatedMessagePayloadFormatters(r, easy)
 	}
+	if r.Intn(10) != 0 {
+		this.DownlinkPolicy = NewPopulatedApplicationDownlinkPolicy(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1009,6 +1037,10 @@ func (m *ApplicationLink) Size() (n int) {
 		l = m.DefaultFormatters.Size()
 		n += 1 + l + sovApplicationserver(uint64(l))
 	}
+	if m.DownlinkPolicy != nil {
+		l = m.DownlinkPolicy.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
 	return n
 }
 
@@ -1061,6 +1093,7 @@ func (this *ApplicationLink) String() string {
 		`NetworkServerAddress:` + fmt.Sprintf("%v", this.NetworkServerAddress) + `,`,
 		`APIKey:` + fmt.Sprintf("%v", this.APIKey) + `,`,
 		`DefaultFormatters:` + strings.Replace(fmt.Sprintf("%v", this.DefaultFormatters), "MessagePayloadFormatters", "MessagePayloadFormatters", 1) + `,`,
+		`DownlinkPolicy:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkPolicy), "ApplicationDownlinkPolicy", "ApplicationDownlinkPolicy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1216,6 +1249,39 @@ func (m *ApplicationLink) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkPolicy == nil {
+				m.DownlinkPolicy = &ApplicationDownlinkPolicy{}
+			}
+			if err := m.DownlinkPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationserver(dAtA[iNdEx:])
@@ -1593,75 +1659,77 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("lorawan-stack/api/applicationserver.proto", fileDescriptor_applicationserver_bb4b6097f23b5b16)
+	proto.RegisterFile("lorawan-stack/api/applicationserver.proto", fileDescriptor_applicationserver_4575df38d85ac5de)
 }
 func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/applicationserver.proto", fileDescriptor_applicationserver_bb4b6097f23b5b16)
+	golang_proto.RegisterFile("lorawan-stack/api/applicationserver.proto", fileDescriptor_applicationserver_4575df38d85ac5de)
 }
 
-var fileDescriptor_applicationserver_bb4b6097f23b5b16 = []byte{
-	// 997 bytes of a gzipped FileDescriptorProto
+var fileDescriptor_applicationserver_4575df38d85ac5de = []byte{
+	// 1025 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0x4f, 0x68, 0x1b, 0x47,
-	0x1b, 0xc6, 0x77, 0x2c, 0xc7, 0xfe, 0x3c, 0x86, 0xe4, 0xcb, 0x36, 0x04, 0x5b, 0x6d, 0x47, 0x66,
-	0x13, 0x82, 0x6d, 0xea, 0xdd, 0xa2, 0x94, 0x50, 0x52, 0xda, 0x22, 0x63, 0xc7, 0xb8, 0xb1, 0xc1,
-	0x95, 0x5a, 0xda, 0xa6, 0x07, 0x31, 0xd2, 0x8e, 0x56, 0x83, 0x56, 0x3b, 0xdb, 0x9d, 0x59, 0xab,
-	0x22, 0x0d, 0x84, 0x9e, 0x72, 0x2c, 0x94, 0x40, 0x8e, 0xa5, 0xa7, 0x40, 0x2f, 0x21, 0x25, 0x34,
-	0xc7, 0x1c, 0x0d, 0xb9, 0xb8, 0xf4, 0x92, 0x93, 0x13, 0xad, 0x7a, 0xc8, 0x31, 0xa7, 0x12, 0x7a,
-	0x2a, 0x9a, 0x5d, 0xfd, 0xdb, 0xb5, 0x1c, 0x3b, 0x0d, 0xe9, 0x6d, 0x57, 0xef, 0x33, 0xef, 0xfc,
-	0x9e, 0xf7, 0x19, 0x0d, 0x0b, 0x17, 0x6c, 0xe6, 0xe1, 0x06, 0x76, 0x96, 0xb8, 0xc0, 0xe5, 0x9a,
-	0x81, 0x5d, 0x6a, 0x60, 0xd7, 0xb5, 0x69, 0x19, 0x0b, 0xca, 0x1c, 0x4e, 0xbc, 0x6d, 0xe2, 0xe9,
-	0xae, 0xc7, 0x04, 0x53, 0x8f, 0x0b, 0xe1, 0xe8, 0x91, 0x5c, 0xdf, 0x3e, 0x9f, 0x5e, 0xb2, 0xa8,
-	0xa8, 0xfa, 0x25, 0xbd, 0xcc, 0xea, 0x86, 0xc5, 0x2c, 0x66, 0x48, 0x59, 0xc9, 0xaf, 0xc8, 0x37,
-	0xf9, 0x22, 0x9f, 0xc2, 0xe5, 0xe9, 0x0b, 0x03, 0xf2, 0x7a, 0x83, 0x8a, 0x1a, 0x6b, 0x18, 0x16,
-	0x5b, 0x92, 0xc5, 0xa5, 0x6d, 0x6c, 0x53, 0x13, 0x0b, 0xe6, 0x71, 0xa3, 0xf7, 0x18, 0xad, 0x7b,
-	0xcb, 0x62, 0xcc, 0xb2, 0x49, 0x88, 0xe6, 0x38, 0x4c, 0x84, 0x64, 0x51, 0xf5, 0xcd, 0xa8, 0xda,
-	0xdb, 0x9b, 0xd4, 0x5d, 0xd1, 0x8c, 0x8a, 0x73, 0xf1, 0x62, 0x85, 0x12, 0xdb, 0x2c, 0xd6, 0x31,
-	0xaf, 0x45, 0x0a, 0x2d, 0x69, 0x9f, 0x38, 0x66, 0xd1, 0x24, 0xdb, 0xb4, 0x4c, 0x22, 0xcd, 0x99,
-	0xa4, 0x86, 0x9a, 0xc4, 0x11, 0xb4, 0x42, 0x89, 0xd7, 0xe5, 0x98, 0x4b, 0x8a, 0xea, 0x84, 0x73,
-	0x6c, 0x91, 0x48, 0xa1, 0x3d, 0x04, 0xf0, 0x44, 0xae, 0x3f, 0xda, 0x0d, 0xea, 0xd4, 0xd4, 0xf7,
-	0xe0, 0x69, 0x87, 0x88, 0x06, 0xf3, 0x6a, 0xc5, 0x70, 0xd4, 0x45, 0x6c, 0x9a, 0x1e, 0xe1, 0x7c,
-	0x06, 0xcc, 0x81, 0xf9, 0xa9, 0xfc, 0xa9, 0xa8, 0x5a, 0x90, 0xc5, 0x5c, 0x58, 0x53, 0x17, 0xe0,
-	0x24, 0x76, 0x69, 0xb1, 0x46, 0x9a, 0x33, 0x63, 0x1d, 0xd9, 0xf2, 0xff, 0x83, 0xbd, 0xcc, 0x44,
-	0x6e, 0x6b, 0xfd, 0x32, 0x69, 0x06, 0x8f, 0x33, 0x63, 0x5f, 0x82, 0xfc, 0x04, 0x76, 0xe9, 0x65,
-	0xd2, 0x54, 0xbf, 0x80, 0xaa, 0x49, 0x2a, 0xd8, 0xb7, 0x45, 0xb1, 0xc2, 0xbc, 0x3a, 0x16, 0x82,
-	0x78, 0x7c, 0x26, 0x35, 0x07, 0xe6, 0xa7, 0xb3, 0xf3, 0xfa, 0x70, 0xa0, 0xfa, 0x66, 0x08, 0xbc,
-	0x85, 0x9b, 0x36, 0xc3, 0xe6, 0xa5, 0x9e, 0x3e, 0x7f, 0x32, 0xea, 0xd1, 0xff, 0x49, 0xfb, 0x0d,
-	0xc0, 0xd9, 0x35, 0x22, 0x62, 0x86, 0xf2, 0xe4, 0x1b, 0x9f, 0x70, 0xa1, 0x7e, 0x05, 0x4f, 0x0c,
-	0x9c, 0xa2, 0x22, 0x35, 0x43, 0x43, 0xd3, 0xd9, 0x73, 0xf1, 0x3d, 0x07, 0x1a, 0xac, 0xf7, 0x87,
-	0xba, 0xfc, 0xbf, 0x9d, 0xbd, 0x8c, 0xb2, 0xbb, 0x97, 0x01, 0xf9, 0xe3, 0x78, 0x50, 0xc1, 0xd5,
-	0x8f, 0x21, 0xec, 0xa7, 0x28, 0xfd, 0x4f, 0x67, 0xd3, 0x7a, 0x18, 0xb4, 0xde, 0x0d, 0x5a, 0xbf,
-	0xd4, 0x91, 0x6c, 0x62, 0x5e, 0x5b, 0x1e, 0xef, 0x74, 0xca, 0x4f, 0x55, 0xba, 0x3f, 0x68, 0x7f,
-	0x01, 0x38, 0x5b, 0xf8, 0x2f, 0xc8, 0x3f, 0x84, 0xe3, 0x36, 0x75, 0xba, 0xcc, 0x99, 0x03, 0xfa,
-	0x75, 0x80, 0x06, 0x1a, 0xc9, 0x65, 0x31, 0xe3, 0xa9, 0x23, 0x1b, 0xcf, 0xde, 0x4b, 0xc1, 0xb1,
-	0x1c, 0x57, 0x6f, 0x02, 0x38, 0xb9, 0x46, 0x84, 0x3c, 0x7f, 0x0b, 0x71, 0x88, 0x91, 0x91, 0xa6,
-	0x5f, 0xc4, 0xab, 0x7d, 0xf4, 0xfd, 0x1f, 0x7f, 0xfe, 0x38, 0xf6, 0xbe, 0x7a, 0xc1, 0xc0, 0x7c,
-	0xe8, 0x0e, 0x31, 0xae, 0xc6, 0x26, 0xaa, 0x0f, 0xbf, 0x5f, 0x33, 0xa4, 0xbf, 0x5b, 0x00, 0x4e,
-	0x16, 0x46, 0x71, 0x15, 0x5e, 0x9e, 0x2b, 0x27, 0xb9, 0x3e, 0x48, 0xbf, 0x24, 0xd7, 0x45, 0xb0,
-	0xa8, 0x7e, 0x07, 0xe1, 0x0a, 0xb1, 0x89, 0x20, 0x12, 0xee, 0x90, 0x27, 0x21, 0x7d, 0x3a, 0x11,
-	0xce, 0x6a, 0xe7, 0x6e, 0xd2, 0x74, 0x09, 0x34, 0xbf, 0x78, 0xee, 0x45, 0x40, 0x21, 0x40, 0xf6,
-	0xde, 0x31, 0x78, 0x2c, 0xe7, 0xba, 0x39, 0xae, 0x7e, 0x06, 0xa7, 0x0a, 0x7e, 0x89, 0x97, 0x3d,
-	0x5a, 0x22, 0x87, 0xc6, 0x78, 0xfb, 0x00, 0xdd, 0xe7, 0xee, 0xbb, 0x40, 0x7d, 0x08, 0xe0, 0xc9,
-	0x15, 0xd6, 0x70, 0x3a, 0x9b, 0x7d, 0xea, 0x13, 0x9f, 0x6c, 0xf9, 0xbc, 0xaa, 0x9e, 0x8d, 0x2f,
-	0x1b, 0x92, 0x74, 0xa7, 0x3f, 0xca, 0xe3, 0xb7, 0xd2, 0xa3, 0xa7, 0xd5, 0x93, 0x1e, 0xfb, 0xf7,
-	0xeb, 0x3e, 0x33, 0x4f, 0x66, 0x10, 0x4a, 0x93, 0xeb, 0x7a, 0x8f, 0xd7, 0x0c, 0x93, 0x35, 0x1c,
-	0xc3, 0xf5, 0x79, 0xb5, 0x93, 0xd5, 0xef, 0x00, 0x9e, 0x8a, 0xa1, 0xba, 0x36, 0x2e, 0x93, 0x7f,
-	0x69, 0xe8, 0xaa, 0x34, 0xe4, 0x6b, 0xee, 0x6b, 0x33, 0xe4, 0x85, 0xdc, 0x1d, 0x4f, 0xbf, 0xc6,
-	0x13, 0xda, 0xa0, 0x5c, 0x24, 0x0d, 0xad, 0x3a, 0xe6, 0x8a, 0x6c, 0x32, 0x18, 0xff, 0xd9, 0x03,
-	0xe2, 0xef, 0xf6, 0xe4, 0x5a, 0x5e, 0xda, 0xdb, 0x50, 0x3f, 0x39, 0xfa, 0x9f, 0xa4, 0xe7, 0x27,
-	0x66, 0x20, 0xfb, 0x78, 0x1c, 0xbe, 0x91, 0xe3, 0x3d, 0xa8, 0x3c, 0xb1, 0x28, 0x17, 0x5e, 0x53,
-	0xbd, 0x0b, 0x60, 0x6a, 0x8d, 0x08, 0xf5, 0xcc, 0x3e, 0x97, 0xcf, 0x80, 0x3a, 0xcc, 0x63, 0x76,
-	0xa4, 0x49, 0xad, 0x26, 0x99, 0x89, 0x5a, 0x7e, 0x0d, 0x91, 0xa8, 0x7f, 0x03, 0x98, 0x2a, 0xec,
-	0x07, 0x5d, 0x38, 0x1a, 0xf4, 0x5d, 0x20, 0xa9, 0x7f, 0x01, 0xe9, 0xaf, 0x93, 0xd8, 0xd1, 0x67,
-	0xc7, 0x91, 0x90, 0x07, 0xd6, 0xf4, 0x71, 0x2f, 0x82, 0xc5, 0x2b, 0xeb, 0xda, 0xca, 0xab, 0xd8,
-	0xa1, 0x73, 0xfe, 0x6e, 0x02, 0x38, 0x11, 0x5e, 0x80, 0x87, 0x3c, 0x74, 0xa3, 0xfe, 0x45, 0x9b,
-	0xd2, 0xfc, 0xda, 0xe2, 0xea, 0x2b, 0x39, 0x66, 0xcb, 0x3f, 0x83, 0x9d, 0x16, 0x02, 0xbb, 0x2d,
-	0x04, 0x1e, 0xb5, 0x90, 0xf2, 0xa4, 0x85, 0x94, 0xa7, 0x2d, 0xa4, 0x3c, 0x6b, 0x21, 0xe5, 0x79,
-	0x0b, 0x81, 0xeb, 0x01, 0x02, 0x37, 0x02, 0xa4, 0xdc, 0x0e, 0x10, 0xb8, 0x13, 0x20, 0xe5, 0x7e,
-	0x80, 0x94, 0x07, 0x01, 0x52, 0x76, 0x02, 0x04, 0x76, 0x03, 0x04, 0x1e, 0x05, 0x48, 0x79, 0x12,
-	0x20, 0xf0, 0x34, 0x40, 0xca, 0xb3, 0x00, 0x81, 0xe7, 0x01, 0x52, 0xae, 0xb7, 0x91, 0x72, 0xa3,
-	0x8d, 0xc0, 0x0f, 0x6d, 0xa4, 0xdc, 0x6a, 0x23, 0xf0, 0x53, 0x1b, 0x29, 0xb7, 0xdb, 0x48, 0xb9,
-	0xd3, 0x46, 0xe0, 0x7e, 0x1b, 0x81, 0x07, 0x6d, 0x04, 0xae, 0xbc, 0x63, 0x31, 0x5d, 0x54, 0x89,
-	0xa8, 0x52, 0xc7, 0xe2, 0x7a, 0xf4, 0x89, 0x66, 0x0c, 0x7f, 0x00, 0xba, 0x35, 0xcb, 0x10, 0xc2,
-	0x71, 0x4b, 0xa5, 0x09, 0x39, 0x83, 0xf3, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x46, 0x93, 0x3e,
-	0x61, 0x6a, 0x0b, 0x00, 0x00,
+	0x14, 0xc6, 0x77, 0x6c, 0xc7, 0xae, 0xc7, 0x60, 0x37, 0xdb, 0x10, 0x6c, 0xb5, 0x1d, 0x9b, 0x4d,
+	0x08, 0xb6, 0xa8, 0x77, 0x8b, 0x52, 0x42, 0x71, 0x69, 0x8b, 0x8c, 0x1d, 0xe3, 0xc6, 0x06, 0x77,
+	0xd5, 0xd2, 0x36, 0x3d, 0x88, 0x91, 0x76, 0xb4, 0x1e, 0x76, 0xb5, 0xb3, 0xdd, 0x19, 0x59, 0x15,
+	0x69, 0x20, 0xf4, 0x94, 0x63, 0xa1, 0x04, 0x72, 0x6b, 0xe9, 0x29, 0xd0, 0x4b, 0x48, 0x09, 0xcd,
+	0x31, 0x47, 0x43, 0x2f, 0x2e, 0xbd, 0xe4, 0xe4, 0x44, 0xab, 0x1e, 0x72, 0xcc, 0xa9, 0x84, 0x9e,
+	0x8a, 0x66, 0x57, 0x7f, 0xd7, 0x72, 0xec, 0x34, 0x24, 0xb7, 0x1d, 0xbd, 0xef, 0x3d, 0xfd, 0xbe,
+	0xf7, 0xde, 0x0e, 0x0b, 0x17, 0x5c, 0x16, 0xe0, 0x2a, 0xf6, 0x16, 0xb9, 0xc0, 0x45, 0xc7, 0xc0,
+	0x3e, 0x35, 0xb0, 0xef, 0xbb, 0xb4, 0x88, 0x05, 0x65, 0x1e, 0x27, 0xc1, 0x0e, 0x09, 0x74, 0x3f,
+	0x60, 0x82, 0xa9, 0x93, 0x42, 0x78, 0x7a, 0x2c, 0xd7, 0x77, 0xce, 0xa7, 0x16, 0x6d, 0x2a, 0xb6,
+	0x2b, 0x05, 0xbd, 0xc8, 0xca, 0x86, 0xcd, 0x6c, 0x66, 0x48, 0x59, 0xa1, 0x52, 0x92, 0x27, 0x79,
+	0x90, 0x4f, 0x51, 0x7a, 0xea, 0x42, 0x97, 0xbc, 0x5c, 0xa5, 0xc2, 0x61, 0x55, 0xc3, 0x66, 0x8b,
+	0x32, 0xb8, 0xb8, 0x83, 0x5d, 0x6a, 0x61, 0xc1, 0x02, 0x6e, 0xb4, 0x1f, 0xe3, 0xbc, 0xb7, 0x6c,
+	0xc6, 0x6c, 0x97, 0x44, 0x68, 0x9e, 0xc7, 0x44, 0x44, 0x16, 0x47, 0xdf, 0x8c, 0xa3, 0xed, 0xff,
+	0x26, 0x65, 0x5f, 0xd4, 0xe2, 0xe0, 0x5c, 0x7f, 0xb0, 0x44, 0x89, 0x6b, 0xe5, 0xcb, 0x98, 0x3b,
+	0xb1, 0x42, 0x4b, 0xda, 0x27, 0x9e, 0x95, 0xb7, 0xc8, 0x0e, 0x2d, 0x92, 0x58, 0x73, 0x26, 0xa9,
+	0xa1, 0x16, 0xf1, 0x04, 0x2d, 0x51, 0x12, 0xb4, 0x38, 0xe6, 0x92, 0xa2, 0x32, 0xe1, 0x1c, 0xdb,
+	0x24, 0x56, 0x68, 0x3f, 0x0d, 0xc1, 0xa9, 0x6c, 0xa7, 0xb5, 0x1b, 0xd4, 0x73, 0xd4, 0xf7, 0xe0,
+	0x69, 0x8f, 0x88, 0x2a, 0x0b, 0x9c, 0x7c, 0xd4, 0xea, 0x3c, 0xb6, 0xac, 0x80, 0x70, 0x3e, 0x0d,
+	0xe6, 0xc0, 0xfc, 0xb8, 0x79, 0x2a, 0x8e, 0xe6, 0x64, 0x30, 0x1b, 0xc5, 0xd4, 0x05, 0x38, 0x86,
+	0x7d, 0x9a, 0x77, 0x48, 0x6d, 0x7a, 0xa8, 0x29, 0x5b, 0x7e, 0x3d, 0xdc, 0x9f, 0x1d, 0xcd, 0x6e,
+	0xad, 0x5f, 0x22, 0xb5, 0xf0, 0xe1, 0xec, 0xd0, 0x97, 0xc0, 0x1c, 0xc5, 0x3e, 0xbd, 0x44, 0x6a,
+	0xea, 0x17, 0x50, 0xb5, 0x48, 0x09, 0x57, 0x5c, 0x91, 0x2f, 0xb1, 0xa0, 0x8c, 0x85, 0x20, 0x01,
+	0x9f, 0x1e, 0x9e, 0x03, 0xf3, 0x13, 0x99, 0x79, 0xbd, 0x77, 0xa0, 0xfa, 0x66, 0x04, 0xbc, 0x85,
+	0x6b, 0x2e, 0xc3, 0xd6, 0xc5, 0xb6, 0xde, 0x3c, 0x19, 0xd7, 0xe8, 0xfc, 0xa4, 0x9a, 0x70, 0xca,
+	0x62, 0x55, 0xcf, 0xa5, 0x9e, 0x93, 0xf7, 0x99, 0x4b, 0x8b, 0xb5, 0xe9, 0x11, 0x59, 0x75, 0xa1,
+	0xbf, 0x6a, 0x97, 0xe7, 0x95, 0x38, 0x63, 0x4b, 0x26, 0x98, 0x93, 0x56, 0xcf, 0x59, 0xfb, 0x1d,
+	0xc0, 0x99, 0x35, 0x22, 0xfa, 0x9a, 0x64, 0x92, 0x6f, 0x2a, 0x84, 0x0b, 0xf5, 0x2b, 0x38, 0xd5,
+	0xb5, 0x99, 0x79, 0x6a, 0x45, 0x4d, 0x9a, 0xc8, 0x9c, 0x3b, 0xe4, 0x1f, 0xd7, 0x3b, 0x83, 0x5a,
+	0x7e, 0x6d, 0x77, 0x7f, 0x56, 0xd9, 0xdb, 0x9f, 0x05, 0xe6, 0x24, 0xee, 0x56, 0x70, 0xf5, 0x63,
+	0x08, 0x3b, 0x9b, 0x21, 0x7b, 0x3a, 0x91, 0x49, 0xe9, 0xd1, 0xf2, 0xe8, 0xad, 0xe5, 0xd1, 0x2f,
+	0x36, 0x25, 0x9b, 0x98, 0x3b, 0xcb, 0x23, 0xcd, 0x4a, 0xe6, 0x78, 0xa9, 0xf5, 0x83, 0xf6, 0x0f,
+	0x80, 0x33, 0xb9, 0x57, 0x41, 0xfe, 0x21, 0x1c, 0x69, 0x36, 0x30, 0x66, 0x9e, 0x3d, 0xa4, 0x5e,
+	0x13, 0xa8, 0xab, 0x90, 0x4c, 0xeb, 0x33, 0x3e, 0x7c, 0x6c, 0xe3, 0x99, 0xbb, 0xc3, 0x70, 0x28,
+	0xcb, 0xd5, 0x1b, 0x00, 0x8e, 0xad, 0x11, 0x21, 0x77, 0x3a, 0xb1, 0x00, 0x03, 0x47, 0x9a, 0x7a,
+	0x16, 0xaf, 0xf6, 0xd1, 0xf7, 0x7f, 0xfd, 0xfd, 0xe3, 0xd0, 0xfb, 0xea, 0x05, 0x03, 0xf3, 0x9e,
+	0x7b, 0xc9, 0xb8, 0xd2, 0xd7, 0x51, 0xbd, 0xf7, 0x7c, 0xd5, 0x90, 0xfe, 0x6e, 0x02, 0x38, 0x96,
+	0x1b, 0xc4, 0x95, 0x7b, 0x7e, 0xae, 0xac, 0xe4, 0xfa, 0x20, 0xf5, 0x9c, 0x5c, 0x4b, 0x20, 0xad,
+	0x7e, 0x07, 0xe1, 0x0a, 0x71, 0x89, 0x20, 0x12, 0xee, 0x88, 0x9b, 0x90, 0x3a, 0x9d, 0x18, 0xce,
+	0x6a, 0xf3, 0xbe, 0xd3, 0x74, 0x09, 0x34, 0x9f, 0x3e, 0xf7, 0x2c, 0xa0, 0x08, 0x20, 0x73, 0xf7,
+	0x04, 0x3c, 0x91, 0xf5, 0xfd, 0x2c, 0x57, 0x3f, 0x83, 0xe3, 0xb9, 0x4a, 0x81, 0x17, 0x03, 0x5a,
+	0x20, 0x47, 0xc6, 0x78, 0xfb, 0x10, 0xdd, 0xe7, 0xfe, 0xbb, 0x40, 0xfd, 0x03, 0xc0, 0x93, 0xad,
+	0xb7, 0xfd, 0xd3, 0x0a, 0xa9, 0x90, 0xad, 0x0a, 0xdf, 0x56, 0xcf, 0xf6, 0xa7, 0xf5, 0x48, 0x5a,
+	0xdd, 0x1f, 0xe4, 0xf1, 0x5b, 0xe9, 0x31, 0x58, 0x02, 0x69, 0xad, 0x9c, 0xb4, 0xd9, 0xb9, 0xb6,
+	0x0f, 0x68, 0x7b, 0x72, 0x0c, 0x91, 0x34, 0x99, 0xd7, 0x7e, 0xbc, 0x6a, 0x34, 0x6f, 0x27, 0xc3,
+	0x6f, 0x72, 0xff, 0x09, 0xe0, 0xa9, 0x3e, 0x54, 0xdf, 0xc5, 0x45, 0xf2, 0x3f, 0x0d, 0x5d, 0x91,
+	0x86, 0x2a, 0x9a, 0xff, 0xd2, 0xdc, 0x04, 0x11, 0x77, 0x73, 0xff, 0x7e, 0xeb, 0x9f, 0xd0, 0x06,
+	0xe5, 0x22, 0x69, 0x68, 0xd5, 0xb3, 0x56, 0x64, 0x91, 0xee, 0xf1, 0x9f, 0x3d, 0xc2, 0x1d, 0xcf,
+	0x35, 0x53, 0xda, 0xdb, 0x50, 0x3f, 0x39, 0xfe, 0x4b, 0xd2, 0xf6, 0xd3, 0x67, 0x20, 0xf3, 0x70,
+	0x04, 0xbe, 0x91, 0xe5, 0x6d, 0x28, 0x93, 0xd8, 0x94, 0x8b, 0xa0, 0xa6, 0xde, 0x01, 0x70, 0x78,
+	0x8d, 0x08, 0xf5, 0xcc, 0x01, 0x97, 0x4f, 0x97, 0x3a, 0x9a, 0xc7, 0xcc, 0x40, 0x93, 0x9a, 0x23,
+	0x99, 0x89, 0x5a, 0x7c, 0x09, 0x23, 0x51, 0xff, 0x05, 0x70, 0x38, 0x77, 0x10, 0x74, 0xee, 0x78,
+	0xd0, 0x77, 0x80, 0xa4, 0xfe, 0x15, 0x2c, 0x81, 0xf4, 0xe5, 0x75, 0x6d, 0x25, 0xc9, 0x1e, 0x7f,
+	0xcf, 0x1c, 0x83, 0x7b, 0x09, 0xa4, 0x53, 0x5f, 0xbf, 0x88, 0x42, 0x3d, 0x39, 0x5d, 0xe6, 0x6f,
+	0x00, 0x38, 0x1a, 0x5d, 0x80, 0x47, 0x5c, 0xba, 0x41, 0x6f, 0xd1, 0xa6, 0x34, 0xbf, 0x96, 0x5e,
+	0x7d, 0x21, 0x6b, 0xb6, 0xfc, 0x0b, 0xd8, 0xad, 0x23, 0xb0, 0x57, 0x47, 0xe0, 0x41, 0x1d, 0x29,
+	0x8f, 0xea, 0x48, 0x79, 0x5c, 0x47, 0xca, 0x93, 0x3a, 0x52, 0x9e, 0xd6, 0x11, 0xb8, 0x16, 0x22,
+	0x70, 0x3d, 0x44, 0xca, 0xad, 0x10, 0x81, 0xdb, 0x21, 0x52, 0xee, 0x85, 0x48, 0xb9, 0x1f, 0x22,
+	0x65, 0x37, 0x44, 0x60, 0x2f, 0x44, 0xe0, 0x41, 0x88, 0x94, 0x47, 0x21, 0x02, 0x8f, 0x43, 0xa4,
+	0x3c, 0x09, 0x11, 0x78, 0x1a, 0x22, 0xe5, 0x5a, 0x03, 0x29, 0xd7, 0x1b, 0x08, 0xfc, 0xd0, 0x40,
+	0xca, 0xcd, 0x06, 0x02, 0x3f, 0x37, 0x90, 0x72, 0xab, 0x81, 0x94, 0xdb, 0x0d, 0x04, 0xee, 0x35,
+	0x10, 0xb8, 0xdf, 0x40, 0xe0, 0xf2, 0x3b, 0x36, 0xd3, 0xc5, 0x36, 0x11, 0xdb, 0xd4, 0xb3, 0xb9,
+	0x1e, 0x7f, 0xf6, 0x19, 0xbd, 0x1f, 0x95, 0xbe, 0x63, 0x1b, 0x42, 0x78, 0x7e, 0xa1, 0x30, 0x2a,
+	0x7b, 0x70, 0xfe, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xaf, 0x8d, 0xad, 0x3f, 0xbe, 0x0b, 0x00,
+	0x00,
 }
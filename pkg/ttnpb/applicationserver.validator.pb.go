@@ -30,6 +30,11 @@ func (this *ApplicationLink) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("DefaultFormatters", err)
 		}
 	}
+	if this.DownlinkPolicy != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.DownlinkPolicy); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("DownlinkPolicy", err)
+		}
+	}
 	return nil
 }
 func (this *GetApplicationLinkRequest) Validate() error {
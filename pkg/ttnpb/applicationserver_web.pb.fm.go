@@ -74,6 +74,11 @@ var ApplicationWebhookFieldPathsNested = []string{
 	"downlink_sent.path",
 	"format",
 	"headers",
+	"health",
+	"health.failed_attempts",
+	"health.last_failed_attempt_at",
+	"health.last_failed_attempt_error",
+	"health.suspended_at",
 	"ids",
 	"ids.application_ids",
 	"ids.application_ids.application_id",
@@ -82,6 +87,15 @@ var ApplicationWebhookFieldPathsNested = []string{
 	"join_accept.path",
 	"location_solved",
 	"location_solved.path",
+	"oauth2_client_credentials",
+	"oauth2_client_credentials.client_id",
+	"oauth2_client_credentials.client_secret",
+	"oauth2_client_credentials.scopes",
+	"oauth2_client_credentials.token_url",
+	"signing",
+	"signing.secret",
+	"template_ids",
+	"template_ids.template_id",
 	"updated_at",
 	"uplink_message",
 	"uplink_message.path",
@@ -97,9 +111,13 @@ var ApplicationWebhookFieldPathsTopLevel = []string{
 	"downlink_sent",
 	"format",
 	"headers",
+	"health",
 	"ids",
 	"join_accept",
 	"location_solved",
+	"oauth2_client_credentials",
+	"signing",
+	"template_ids",
 	"updated_at",
 	"uplink_message",
 }
@@ -342,6 +360,90 @@ func (dst *ApplicationWebhook) SetFields(src *ApplicationWebhook, paths ...strin
 					dst.LocationSolved = nil
 				}
 			}
+		case "health":
+			if len(subs) > 0 {
+				newDst := dst.Health
+				if newDst == nil {
+					newDst = &ApplicationWebhookHealth{}
+					dst.Health = newDst
+				}
+				var newSrc *ApplicationWebhookHealth
+				if src != nil {
+					newSrc = src.Health
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Health = src.Health
+				} else {
+					dst.Health = nil
+				}
+			}
+		case "template_ids":
+			if len(subs) > 0 {
+				newDst := dst.TemplateIDs
+				if newDst == nil {
+					newDst = &ApplicationWebhookTemplateIdentifiers{}
+					dst.TemplateIDs = newDst
+				}
+				var newSrc *ApplicationWebhookTemplateIdentifiers
+				if src != nil {
+					newSrc = src.TemplateIDs
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.TemplateIDs = src.TemplateIDs
+				} else {
+					dst.TemplateIDs = nil
+				}
+			}
+		case "signing":
+			if len(subs) > 0 {
+				newDst := dst.Signing
+				if newDst == nil {
+					newDst = &ApplicationWebhookSigning{}
+					dst.Signing = newDst
+				}
+				var newSrc *ApplicationWebhookSigning
+				if src != nil {
+					newSrc = src.Signing
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Signing = src.Signing
+				} else {
+					dst.Signing = nil
+				}
+			}
+		case "oauth2_client_credentials":
+			if len(subs) > 0 {
+				newDst := dst.OAuth2ClientCredentials
+				if newDst == nil {
+					newDst = &ApplicationWebhookOAuth2ClientCredentials{}
+					dst.OAuth2ClientCredentials = newDst
+				}
+				var newSrc *ApplicationWebhookOAuth2ClientCredentials
+				if src != nil {
+					newSrc = src.OAuth2ClientCredentials
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.OAuth2ClientCredentials = src.OAuth2ClientCredentials
+				} else {
+					dst.OAuth2ClientCredentials = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -368,36 +470,940 @@ func (dst *ApplicationWebhook_Message) SetFields(src *ApplicationWebhook_Message
 			if src != nil {
 				dst.Path = src.Path
 			} else {
-				var zero string
-				dst.Path = zero
+				var zero string
+				dst.Path = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var ApplicationWebhookSigningFieldPathsNested = []string{
+	"secret",
+}
+
+var ApplicationWebhookSigningFieldPathsTopLevel = []string{
+	"secret",
+}
+
+func (dst *ApplicationWebhookSigning) SetFields(src *ApplicationWebhookSigning, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "secret":
+			if len(subs) > 0 {
+				return fmt.Errorf("'secret' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Secret = src.Secret
+			} else {
+				var zero string
+				dst.Secret = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var ApplicationWebhookOAuth2ClientCredentialsFieldPathsNested = []string{
+	"client_id",
+	"client_secret",
+	"scopes",
+	"token_url",
+}
+
+var ApplicationWebhookOAuth2ClientCredentialsFieldPathsTopLevel = []string{
+	"client_id",
+	"client_secret",
+	"scopes",
+	"token_url",
+}
+
+func (dst *ApplicationWebhookOAuth2ClientCredentials) SetFields(src *ApplicationWebhookOAuth2ClientCredentials, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "token_url":
+			if len(subs) > 0 {
+				return fmt.Errorf("'token_url' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TokenURL = src.TokenURL
+			} else {
+				var zero string
+				dst.TokenURL = zero
+			}
+		case "client_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'client_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ClientID = src.ClientID
+			} else {
+				var zero string
+				dst.ClientID = zero
+			}
+		case "client_secret":
+			if len(subs) > 0 {
+				return fmt.Errorf("'client_secret' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ClientSecret = src.ClientSecret
+			} else {
+				var zero string
+				dst.ClientSecret = zero
+			}
+		case "scopes":
+			if len(subs) > 0 {
+				return fmt.Errorf("'scopes' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Scopes = src.Scopes
+			} else {
+				dst.Scopes = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var ApplicationWebhookHealthFieldPathsNested = []string{
+	"failed_attempts",
+	"last_failed_attempt_at",
+	"last_failed_attempt_error",
+	"suspended_at",
+}
+
+var ApplicationWebhookHealthFieldPathsTopLevel = []string{
+	"failed_attempts",
+	"last_failed_attempt_at",
+	"last_failed_attempt_error",
+	"suspended_at",
+}
+
+func (dst *ApplicationWebhookHealth) SetFields(src *ApplicationWebhookHealth, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "failed_attempts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'failed_attempts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FailedAttempts = src.FailedAttempts
+			} else {
+				var zero uint32
+				dst.FailedAttempts = zero
+			}
+		case "last_failed_attempt_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_failed_attempt_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastFailedAttemptAt = src.LastFailedAttemptAt
+			} else {
+				dst.LastFailedAttemptAt = nil
+			}
+		case "last_failed_attempt_error":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_failed_attempt_error' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastFailedAttemptError = src.LastFailedAttemptError
+			} else {
+				var zero string
+				dst.LastFailedAttemptError = zero
+			}
+		case "suspended_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'suspended_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SuspendedAt = src.SuspendedAt
+			} else {
+				dst.SuspendedAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var ApplicationWebhookDeliveryFieldPathsNested = []string{
+	"attempts",
+	"created_at",
+	"id",
+	"last_error",
+	"message",
+	"message.correlation_ids",
+	"message.end_device_ids",
+	"message.end_device_ids.application_ids",
+	"message.end_device_ids.application_ids.application_id",
+	"message.end_device_ids.dev_addr",
+	"message.end_device_ids.dev_eui",
+	"message.end_device_ids.device_id",
+	"message.end_device_ids.join_eui",
+	"message.received_at",
+	"message.up",
+	"message.up.downlink_ack",
+	"message.up.downlink_ack.class_b_c",
+	"message.up.downlink_ack.class_b_c.absolute_time",
+	"message.up.downlink_ack.class_b_c.gateways",
+	"message.up.downlink_ack.confirmed",
+	"message.up.downlink_ack.correlation_ids",
+	"message.up.downlink_ack.decoded_payload",
+	"message.up.downlink_ack.f_cnt",
+	"message.up.downlink_ack.f_port",
+	"message.up.downlink_ack.frm_payload",
+	"message.up.downlink_ack.priority",
+	"message.up.downlink_ack.session_key_id",
+	"message.up.downlink_failed",
+	"message.up.downlink_failed.downlink",
+	"message.up.downlink_failed.downlink.class_b_c",
+	"message.up.downlink_failed.downlink.class_b_c.absolute_time",
+	"message.up.downlink_failed.downlink.class_b_c.gateways",
+	"message.up.downlink_failed.downlink.confirmed",
+	"message.up.downlink_failed.downlink.correlation_ids",
+	"message.up.downlink_failed.downlink.decoded_payload",
+	"message.up.downlink_failed.downlink.f_cnt",
+	"message.up.downlink_failed.downlink.f_port",
+	"message.up.downlink_failed.downlink.frm_payload",
+	"message.up.downlink_failed.downlink.priority",
+	"message.up.downlink_failed.downlink.session_key_id",
+	"message.up.downlink_failed.error",
+	"message.up.downlink_failed.error.attributes",
+	"message.up.downlink_failed.error.cause",
+	"message.up.downlink_failed.error.cause.attributes",
+	"message.up.downlink_failed.error.cause.correlation_id",
+	"message.up.downlink_failed.error.cause.message_format",
+	"message.up.downlink_failed.error.cause.name",
+	"message.up.downlink_failed.error.cause.namespace",
+	"message.up.downlink_failed.error.correlation_id",
+	"message.up.downlink_failed.error.message_format",
+	"message.up.downlink_failed.error.name",
+	"message.up.downlink_failed.error.namespace",
+	"message.up.downlink_nack",
+	"message.up.downlink_nack.class_b_c",
+	"message.up.downlink_nack.class_b_c.absolute_time",
+	"message.up.downlink_nack.class_b_c.gateways",
+	"message.up.downlink_nack.confirmed",
+	"message.up.downlink_nack.correlation_ids",
+	"message.up.downlink_nack.decoded_payload",
+	"message.up.downlink_nack.f_cnt",
+	"message.up.downlink_nack.f_port",
+	"message.up.downlink_nack.frm_payload",
+	"message.up.downlink_nack.priority",
+	"message.up.downlink_nack.session_key_id",
+	"message.up.downlink_queue_invalidated",
+	"message.up.downlink_queue_invalidated.downlinks",
+	"message.up.downlink_queue_invalidated.last_f_cnt_down",
+	"message.up.downlink_queued",
+	"message.up.downlink_queued.class_b_c",
+	"message.up.downlink_queued.class_b_c.absolute_time",
+	"message.up.downlink_queued.class_b_c.gateways",
+	"message.up.downlink_queued.confirmed",
+	"message.up.downlink_queued.correlation_ids",
+	"message.up.downlink_queued.decoded_payload",
+	"message.up.downlink_queued.f_cnt",
+	"message.up.downlink_queued.f_port",
+	"message.up.downlink_queued.frm_payload",
+	"message.up.downlink_queued.priority",
+	"message.up.downlink_queued.session_key_id",
+	"message.up.downlink_sent",
+	"message.up.downlink_sent.class_b_c",
+	"message.up.downlink_sent.class_b_c.absolute_time",
+	"message.up.downlink_sent.class_b_c.gateways",
+	"message.up.downlink_sent.confirmed",
+	"message.up.downlink_sent.correlation_ids",
+	"message.up.downlink_sent.decoded_payload",
+	"message.up.downlink_sent.f_cnt",
+	"message.up.downlink_sent.f_port",
+	"message.up.downlink_sent.frm_payload",
+	"message.up.downlink_sent.priority",
+	"message.up.downlink_sent.session_key_id",
+	"message.up.join_accept",
+	"message.up.join_accept.app_s_key",
+	"message.up.join_accept.app_s_key.kek_label",
+	"message.up.join_accept.app_s_key.key",
+	"message.up.join_accept.invalidated_downlinks",
+	"message.up.join_accept.pending_session",
+	"message.up.join_accept.session_key_id",
+	"message.up.location_solved",
+	"message.up.location_solved.attributes",
+	"message.up.location_solved.location",
+	"message.up.location_solved.location.accuracy",
+	"message.up.location_solved.location.altitude",
+	"message.up.location_solved.location.latitude",
+	"message.up.location_solved.location.longitude",
+	"message.up.location_solved.location.source",
+	"message.up.location_solved.service",
+	"message.up.uplink_message",
+	"message.up.uplink_message.decoded_payload",
+	"message.up.uplink_message.f_cnt",
+	"message.up.uplink_message.f_port",
+	"message.up.uplink_message.frm_payload",
+	"message.up.uplink_message.rx_metadata",
+	"message.up.uplink_message.session_key_id",
+	"message.up.uplink_message.settings",
+	"message.up.uplink_message.settings.coding_rate",
+	"message.up.uplink_message.settings.data_rate",
+	"message.up.uplink_message.settings.data_rate.modulation",
+	"message.up.uplink_message.settings.data_rate.modulation.fsk",
+	"message.up.uplink_message.settings.data_rate.modulation.fsk.bit_rate",
+	"message.up.uplink_message.settings.data_rate.modulation.lora",
+	"message.up.uplink_message.settings.data_rate.modulation.lora.bandwidth",
+	"message.up.uplink_message.settings.data_rate.modulation.lora.spreading_factor",
+	"message.up.uplink_message.settings.data_rate_index",
+	"message.up.uplink_message.settings.device_channel_index",
+	"message.up.uplink_message.settings.enable_crc",
+	"message.up.uplink_message.settings.frequency",
+	"message.up.uplink_message.settings.gateway_channel_index",
+	"message.up.uplink_message.settings.invert_polarization",
+	"message.up.uplink_message.settings.time",
+	"message.up.uplink_message.settings.timestamp",
+	"message.up.uplink_message.settings.tx_power",
+	"webhook_ids",
+	"webhook_ids.application_ids",
+	"webhook_ids.application_ids.application_id",
+	"webhook_ids.webhook_id",
+}
+
+var ApplicationWebhookDeliveryFieldPathsTopLevel = []string{
+	"attempts",
+	"created_at",
+	"id",
+	"last_error",
+	"message",
+	"webhook_ids",
+}
+
+func (dst *ApplicationWebhookDelivery) SetFields(src *ApplicationWebhookDelivery, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ID = src.ID
+			} else {
+				var zero string
+				dst.ID = zero
+			}
+		case "webhook_ids":
+			if len(subs) > 0 {
+				newDst := &dst.WebhookIDs
+				var newSrc *ApplicationWebhookIdentifiers
+				if src != nil {
+					newSrc = &src.WebhookIDs
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.WebhookIDs = src.WebhookIDs
+				} else {
+					var zero ApplicationWebhookIdentifiers
+					dst.WebhookIDs = zero
+				}
+			}
+		case "message":
+			if len(subs) > 0 {
+				newDst := dst.Message
+				if newDst == nil {
+					newDst = &ApplicationUp{}
+					dst.Message = newDst
+				}
+				var newSrc *ApplicationUp
+				if src != nil {
+					newSrc = src.Message
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Message = src.Message
+				} else {
+					dst.Message = nil
+				}
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				var zero time.Time
+				dst.CreatedAt = zero
+			}
+		case "attempts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'attempts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Attempts = src.Attempts
+			} else {
+				var zero uint32
+				dst.Attempts = zero
+			}
+		case "last_error":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_error' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastError = src.LastError
+			} else {
+				var zero string
+				dst.LastError = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var ApplicationWebhookDeliveriesFieldPathsNested = []string{
+	"deliveries",
+}
+
+var ApplicationWebhookDeliveriesFieldPathsTopLevel = []string{
+	"deliveries",
+}
+
+func (dst *ApplicationWebhookDeliveries) SetFields(src *ApplicationWebhookDeliveries, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "deliveries":
+			if len(subs) > 0 {
+				return fmt.Errorf("'deliveries' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Deliveries = src.Deliveries
+			} else {
+				dst.Deliveries = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var ApplicationWebhooksFieldPathsNested = []string{
+	"webhooks",
+}
+
+var ApplicationWebhooksFieldPathsTopLevel = []string{
+	"webhooks",
+}
+
+func (dst *ApplicationWebhooks) SetFields(src *ApplicationWebhooks, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "webhooks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'webhooks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Webhooks = src.Webhooks
+			} else {
+				dst.Webhooks = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var ApplicationWebhookFormatsFieldPathsNested = []string{
+	"formats",
+}
+
+var ApplicationWebhookFormatsFieldPathsTopLevel = []string{
+	"formats",
+}
+
+func (dst *ApplicationWebhookFormats) SetFields(src *ApplicationWebhookFormats, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "formats":
+			if len(subs) > 0 {
+				return fmt.Errorf("'formats' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Formats = src.Formats
+			} else {
+				dst.Formats = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var ApplicationWebhookTemplateIdentifiersFieldPathsNested = []string{
+	"template_id",
+}
+
+var ApplicationWebhookTemplateIdentifiersFieldPathsTopLevel = []string{
+	"template_id",
+}
+
+func (dst *ApplicationWebhookTemplateIdentifiers) SetFields(src *ApplicationWebhookTemplateIdentifiers, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "template_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'template_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TemplateID = src.TemplateID
+			} else {
+				var zero string
+				dst.TemplateID = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var ApplicationWebhookTemplateFieldFieldPathsNested = []string{
+	"default_value",
+	"description",
+	"id",
+	"name",
+	"optional",
+	"secret",
+}
+
+var ApplicationWebhookTemplateFieldFieldPathsTopLevel = []string{
+	"default_value",
+	"description",
+	"id",
+	"name",
+	"optional",
+	"secret",
+}
+
+func (dst *ApplicationWebhookTemplateField) SetFields(src *ApplicationWebhookTemplateField, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ID = src.ID
+			} else {
+				var zero string
+				dst.ID = zero
+			}
+		case "name":
+			if len(subs) > 0 {
+				return fmt.Errorf("'name' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Name = src.Name
+			} else {
+				var zero string
+				dst.Name = zero
+			}
+		case "description":
+			if len(subs) > 0 {
+				return fmt.Errorf("'description' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Description = src.Description
+			} else {
+				var zero string
+				dst.Description = zero
+			}
+		case "secret":
+			if len(subs) > 0 {
+				return fmt.Errorf("'secret' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Secret = src.Secret
+			} else {
+				var zero bool
+				dst.Secret = zero
+			}
+		case "default_value":
+			if len(subs) > 0 {
+				return fmt.Errorf("'default_value' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DefaultValue = src.DefaultValue
+			} else {
+				var zero string
+				dst.DefaultValue = zero
+			}
+		case "optional":
+			if len(subs) > 0 {
+				return fmt.Errorf("'optional' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Optional = src.Optional
+			} else {
+				var zero bool
+				dst.Optional = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var ApplicationWebhookTemplateFieldPathsNested = []string{
+	"base_url",
+	"description",
+	"documentation_url",
+	"downlink_ack",
+	"downlink_ack.path",
+	"downlink_failed",
+	"downlink_failed.path",
+	"downlink_nack",
+	"downlink_nack.path",
+	"downlink_queued",
+	"downlink_queued.path",
+	"downlink_sent",
+	"downlink_sent.path",
+	"fields",
+	"format",
+	"headers",
+	"ids",
+	"ids.template_id",
+	"info_url",
+	"join_accept",
+	"join_accept.path",
+	"location_solved",
+	"location_solved.path",
+	"logo_url",
+	"name",
+	"uplink_message",
+	"uplink_message.path",
+}
+
+var ApplicationWebhookTemplateFieldPathsTopLevel = []string{
+	"base_url",
+	"description",
+	"documentation_url",
+	"downlink_ack",
+	"downlink_failed",
+	"downlink_nack",
+	"downlink_queued",
+	"downlink_sent",
+	"fields",
+	"format",
+	"headers",
+	"ids",
+	"info_url",
+	"join_accept",
+	"location_solved",
+	"logo_url",
+	"name",
+	"uplink_message",
+}
+
+func (dst *ApplicationWebhookTemplate) SetFields(src *ApplicationWebhookTemplate, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				newDst := &dst.ApplicationWebhookTemplateIdentifiers
+				var newSrc *ApplicationWebhookTemplateIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationWebhookTemplateIdentifiers
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationWebhookTemplateIdentifiers = src.ApplicationWebhookTemplateIdentifiers
+				} else {
+					var zero ApplicationWebhookTemplateIdentifiers
+					dst.ApplicationWebhookTemplateIdentifiers = zero
+				}
+			}
+		case "name":
+			if len(subs) > 0 {
+				return fmt.Errorf("'name' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Name = src.Name
+			} else {
+				var zero string
+				dst.Name = zero
+			}
+		case "description":
+			if len(subs) > 0 {
+				return fmt.Errorf("'description' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Description = src.Description
+			} else {
+				var zero string
+				dst.Description = zero
+			}
+		case "logo_url":
+			if len(subs) > 0 {
+				return fmt.Errorf("'logo_url' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LogoURL = src.LogoURL
+			} else {
+				var zero string
+				dst.LogoURL = zero
+			}
+		case "info_url":
+			if len(subs) > 0 {
+				return fmt.Errorf("'info_url' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.InfoURL = src.InfoURL
+			} else {
+				var zero string
+				dst.InfoURL = zero
+			}
+		case "documentation_url":
+			if len(subs) > 0 {
+				return fmt.Errorf("'documentation_url' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DocumentationURL = src.DocumentationURL
+			} else {
+				var zero string
+				dst.DocumentationURL = zero
+			}
+		case "base_url":
+			if len(subs) > 0 {
+				return fmt.Errorf("'base_url' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.BaseURL = src.BaseURL
+			} else {
+				var zero string
+				dst.BaseURL = zero
+			}
+		case "headers":
+			if len(subs) > 0 {
+				return fmt.Errorf("'headers' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Headers = src.Headers
+			} else {
+				dst.Headers = nil
+			}
+		case "format":
+			if len(subs) > 0 {
+				return fmt.Errorf("'format' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Format = src.Format
+			} else {
+				var zero string
+				dst.Format = zero
+			}
+		case "fields":
+			if len(subs) > 0 {
+				return fmt.Errorf("'fields' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Fields = src.Fields
+			} else {
+				dst.Fields = nil
+			}
+		case "uplink_message":
+			if len(subs) > 0 {
+				newDst := dst.UplinkMessage
+				if newDst == nil {
+					newDst = &ApplicationWebhook_Message{}
+					dst.UplinkMessage = newDst
+				}
+				var newSrc *ApplicationWebhook_Message
+				if src != nil {
+					newSrc = src.UplinkMessage
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UplinkMessage = src.UplinkMessage
+				} else {
+					dst.UplinkMessage = nil
+				}
+			}
+		case "join_accept":
+			if len(subs) > 0 {
+				newDst := dst.JoinAccept
+				if newDst == nil {
+					newDst = &ApplicationWebhook_Message{}
+					dst.JoinAccept = newDst
+				}
+				var newSrc *ApplicationWebhook_Message
+				if src != nil {
+					newSrc = src.JoinAccept
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.JoinAccept = src.JoinAccept
+				} else {
+					dst.JoinAccept = nil
+				}
+			}
+		case "downlink_ack":
+			if len(subs) > 0 {
+				newDst := dst.DownlinkAck
+				if newDst == nil {
+					newDst = &ApplicationWebhook_Message{}
+					dst.DownlinkAck = newDst
+				}
+				var newSrc *ApplicationWebhook_Message
+				if src != nil {
+					newSrc = src.DownlinkAck
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DownlinkAck = src.DownlinkAck
+				} else {
+					dst.DownlinkAck = nil
+				}
+			}
+		case "downlink_nack":
+			if len(subs) > 0 {
+				newDst := dst.DownlinkNack
+				if newDst == nil {
+					newDst = &ApplicationWebhook_Message{}
+					dst.DownlinkNack = newDst
+				}
+				var newSrc *ApplicationWebhook_Message
+				if src != nil {
+					newSrc = src.DownlinkNack
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DownlinkNack = src.DownlinkNack
+				} else {
+					dst.DownlinkNack = nil
+				}
+			}
+		case "downlink_sent":
+			if len(subs) > 0 {
+				newDst := dst.DownlinkSent
+				if newDst == nil {
+					newDst = &ApplicationWebhook_Message{}
+					dst.DownlinkSent = newDst
+				}
+				var newSrc *ApplicationWebhook_Message
+				if src != nil {
+					newSrc = src.DownlinkSent
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DownlinkSent = src.DownlinkSent
+				} else {
+					dst.DownlinkSent = nil
+				}
+			}
+		case "downlink_failed":
+			if len(subs) > 0 {
+				newDst := dst.DownlinkFailed
+				if newDst == nil {
+					newDst = &ApplicationWebhook_Message{}
+					dst.DownlinkFailed = newDst
+				}
+				var newSrc *ApplicationWebhook_Message
+				if src != nil {
+					newSrc = src.DownlinkFailed
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DownlinkFailed = src.DownlinkFailed
+				} else {
+					dst.DownlinkFailed = nil
+				}
+			}
+		case "downlink_queued":
+			if len(subs) > 0 {
+				newDst := dst.DownlinkQueued
+				if newDst == nil {
+					newDst = &ApplicationWebhook_Message{}
+					dst.DownlinkQueued = newDst
+				}
+				var newSrc *ApplicationWebhook_Message
+				if src != nil {
+					newSrc = src.DownlinkQueued
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DownlinkQueued = src.DownlinkQueued
+				} else {
+					dst.DownlinkQueued = nil
+				}
 			}
-
-		default:
-			return fmt.Errorf("invalid field: '%s'", name)
-		}
-	}
-	return nil
-}
-
-var ApplicationWebhooksFieldPathsNested = []string{
-	"webhooks",
-}
-
-var ApplicationWebhooksFieldPathsTopLevel = []string{
-	"webhooks",
-}
-
-func (dst *ApplicationWebhooks) SetFields(src *ApplicationWebhooks, paths ...string) error {
-	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
-		switch name {
-		case "webhooks":
+		case "location_solved":
 			if len(subs) > 0 {
-				return fmt.Errorf("'webhooks' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.Webhooks = src.Webhooks
+				newDst := dst.LocationSolved
+				if newDst == nil {
+					newDst = &ApplicationWebhook_Message{}
+					dst.LocationSolved = newDst
+				}
+				var newSrc *ApplicationWebhook_Message
+				if src != nil {
+					newSrc = src.LocationSolved
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
 			} else {
-				dst.Webhooks = nil
+				if src != nil {
+					dst.LocationSolved = src.LocationSolved
+				} else {
+					dst.LocationSolved = nil
+				}
 			}
 
 		default:
@@ -407,25 +1413,25 @@ func (dst *ApplicationWebhooks) SetFields(src *ApplicationWebhooks, paths ...str
 	return nil
 }
 
-var ApplicationWebhookFormatsFieldPathsNested = []string{
-	"formats",
+var ApplicationWebhookTemplatesFieldPathsNested = []string{
+	"templates",
 }
 
-var ApplicationWebhookFormatsFieldPathsTopLevel = []string{
-	"formats",
+var ApplicationWebhookTemplatesFieldPathsTopLevel = []string{
+	"templates",
 }
 
-func (dst *ApplicationWebhookFormats) SetFields(src *ApplicationWebhookFormats, paths ...string) error {
+func (dst *ApplicationWebhookTemplates) SetFields(src *ApplicationWebhookTemplates, paths ...string) error {
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		switch name {
-		case "formats":
+		case "templates":
 			if len(subs) > 0 {
-				return fmt.Errorf("'formats' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'templates' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Formats = src.Formats
+				dst.Templates = src.Templates
 			} else {
-				dst.Formats = nil
+				dst.Templates = nil
 			}
 
 		default:
@@ -554,6 +1560,11 @@ var SetApplicationWebhookRequestFieldPathsNested = []string{
 	"webhook.downlink_sent.path",
 	"webhook.format",
 	"webhook.headers",
+	"webhook.health",
+	"webhook.health.failed_attempts",
+	"webhook.health.last_failed_attempt_at",
+	"webhook.health.last_failed_attempt_error",
+	"webhook.health.suspended_at",
 	"webhook.ids",
 	"webhook.ids.application_ids",
 	"webhook.ids.application_ids.application_id",
@@ -562,6 +1573,15 @@ var SetApplicationWebhookRequestFieldPathsNested = []string{
 	"webhook.join_accept.path",
 	"webhook.location_solved",
 	"webhook.location_solved.path",
+	"webhook.oauth2_client_credentials",
+	"webhook.oauth2_client_credentials.client_id",
+	"webhook.oauth2_client_credentials.client_secret",
+	"webhook.oauth2_client_credentials.scopes",
+	"webhook.oauth2_client_credentials.token_url",
+	"webhook.signing",
+	"webhook.signing.secret",
+	"webhook.template_ids",
+	"webhook.template_ids.template_id",
 	"webhook.updated_at",
 	"webhook.uplink_message",
 	"webhook.uplink_message.path",
@@ -610,3 +1630,257 @@ func (dst *SetApplicationWebhookRequest) SetFields(src *SetApplicationWebhookReq
 	}
 	return nil
 }
+
+var GetApplicationWebhookTemplateRequestFieldPathsNested = []string{
+	"field_mask",
+	"ids",
+	"ids.template_id",
+}
+
+var GetApplicationWebhookTemplateRequestFieldPathsTopLevel = []string{
+	"field_mask",
+	"ids",
+}
+
+func (dst *GetApplicationWebhookTemplateRequest) SetFields(src *GetApplicationWebhookTemplateRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				newDst := &dst.ApplicationWebhookTemplateIdentifiers
+				var newSrc *ApplicationWebhookTemplateIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationWebhookTemplateIdentifiers
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationWebhookTemplateIdentifiers = src.ApplicationWebhookTemplateIdentifiers
+				} else {
+					var zero ApplicationWebhookTemplateIdentifiers
+					dst.ApplicationWebhookTemplateIdentifiers = zero
+				}
+			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				var zero github_com_gogo_protobuf_types.FieldMask
+				dst.FieldMask = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var ListApplicationWebhookTemplatesRequestFieldPathsNested = []string{
+	"field_mask",
+}
+
+var ListApplicationWebhookTemplatesRequestFieldPathsTopLevel = []string{
+	"field_mask",
+}
+
+func (dst *ListApplicationWebhookTemplatesRequest) SetFields(src *ListApplicationWebhookTemplatesRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				var zero github_com_gogo_protobuf_types.FieldMask
+				dst.FieldMask = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var InstantiateApplicationWebhookTemplateRequestFieldPathsNested = []string{
+	"fields",
+	"template_ids",
+	"template_ids.template_id",
+	"webhook_ids",
+	"webhook_ids.application_ids",
+	"webhook_ids.application_ids.application_id",
+	"webhook_ids.webhook_id",
+}
+
+var InstantiateApplicationWebhookTemplateRequestFieldPathsTopLevel = []string{
+	"fields",
+	"template_ids",
+	"webhook_ids",
+}
+
+func (dst *InstantiateApplicationWebhookTemplateRequest) SetFields(src *InstantiateApplicationWebhookTemplateRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "webhook_ids":
+			if len(subs) > 0 {
+				newDst := &dst.WebhookIDs
+				var newSrc *ApplicationWebhookIdentifiers
+				if src != nil {
+					newSrc = &src.WebhookIDs
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.WebhookIDs = src.WebhookIDs
+				} else {
+					var zero ApplicationWebhookIdentifiers
+					dst.WebhookIDs = zero
+				}
+			}
+		case "template_ids":
+			if len(subs) > 0 {
+				newDst := &dst.TemplateIDs
+				var newSrc *ApplicationWebhookTemplateIdentifiers
+				if src != nil {
+					newSrc = &src.TemplateIDs
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.TemplateIDs = src.TemplateIDs
+				} else {
+					var zero ApplicationWebhookTemplateIdentifiers
+					dst.TemplateIDs = zero
+				}
+			}
+		case "fields":
+			if len(subs) > 0 {
+				return fmt.Errorf("'fields' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Fields = src.Fields
+			} else {
+				dst.Fields = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var ListApplicationWebhookDeadLettersRequestFieldPathsNested = []string{
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.webhook_id",
+	"limit",
+}
+
+var ListApplicationWebhookDeadLettersRequestFieldPathsTopLevel = []string{
+	"ids",
+	"limit",
+}
+
+func (dst *ListApplicationWebhookDeadLettersRequest) SetFields(src *ListApplicationWebhookDeadLettersRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				newDst := &dst.ApplicationWebhookIdentifiers
+				var newSrc *ApplicationWebhookIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationWebhookIdentifiers
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationWebhookIdentifiers = src.ApplicationWebhookIdentifiers
+				} else {
+					var zero ApplicationWebhookIdentifiers
+					dst.ApplicationWebhookIdentifiers = zero
+				}
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var ReplayApplicationWebhookDeadLettersRequestFieldPathsNested = []string{
+	"delivery_ids",
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.webhook_id",
+}
+
+var ReplayApplicationWebhookDeadLettersRequestFieldPathsTopLevel = []string{
+	"delivery_ids",
+	"ids",
+}
+
+func (dst *ReplayApplicationWebhookDeadLettersRequest) SetFields(src *ReplayApplicationWebhookDeadLettersRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				newDst := &dst.ApplicationWebhookIdentifiers
+				var newSrc *ApplicationWebhookIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationWebhookIdentifiers
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationWebhookIdentifiers = src.ApplicationWebhookIdentifiers
+				} else {
+					var zero ApplicationWebhookIdentifiers
+					dst.ApplicationWebhookIdentifiers = zero
+				}
+			}
+		case "delivery_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'delivery_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeliveryIDs = src.DeliveryIDs
+			} else {
+				dst.DeliveryIDs = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
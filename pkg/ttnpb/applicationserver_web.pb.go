@@ -16,7 +16,6 @@ import time "time"
 
 import (
 	context "context"
-
 	grpc "google.golang.org/grpc"
 )
 
@@ -51,7 +50,7 @@ type ApplicationWebhookIdentifiers struct {
 func (m *ApplicationWebhookIdentifiers) Reset()      { *m = ApplicationWebhookIdentifiers{} }
 func (*ApplicationWebhookIdentifiers) ProtoMessage() {}
 func (*ApplicationWebhookIdentifiers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_applicationserver_web_cae7911b27bddc2a, []int{0}
+	return fileDescriptor_applicationserver_web_bbcb93b1afd2aa47, []int{0}
 }
 func (m *ApplicationWebhookIdentifiers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -97,23 +96,32 @@ type ApplicationWebhook struct {
 	Headers map[string]string `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// The format to use for the body.
 	// Supported values depend on the Application Server configuration.
-	Format               string                      `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`
-	UplinkMessage        *ApplicationWebhook_Message `protobuf:"bytes,7,opt,name=uplink_message,json=uplinkMessage,proto3" json:"uplink_message,omitempty"`
-	JoinAccept           *ApplicationWebhook_Message `protobuf:"bytes,8,opt,name=join_accept,json=joinAccept,proto3" json:"join_accept,omitempty"`
-	DownlinkAck          *ApplicationWebhook_Message `protobuf:"bytes,9,opt,name=downlink_ack,json=downlinkAck,proto3" json:"downlink_ack,omitempty"`
-	DownlinkNack         *ApplicationWebhook_Message `protobuf:"bytes,10,opt,name=downlink_nack,json=downlinkNack,proto3" json:"downlink_nack,omitempty"`
-	DownlinkSent         *ApplicationWebhook_Message `protobuf:"bytes,11,opt,name=downlink_sent,json=downlinkSent,proto3" json:"downlink_sent,omitempty"`
-	DownlinkFailed       *ApplicationWebhook_Message `protobuf:"bytes,12,opt,name=downlink_failed,json=downlinkFailed,proto3" json:"downlink_failed,omitempty"`
-	DownlinkQueued       *ApplicationWebhook_Message `protobuf:"bytes,13,opt,name=downlink_queued,json=downlinkQueued,proto3" json:"downlink_queued,omitempty"`
-	LocationSolved       *ApplicationWebhook_Message `protobuf:"bytes,14,opt,name=location_solved,json=locationSolved,proto3" json:"location_solved,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	Format         string                      `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`
+	UplinkMessage  *ApplicationWebhook_Message `protobuf:"bytes,7,opt,name=uplink_message,json=uplinkMessage,proto3" json:"uplink_message,omitempty"`
+	JoinAccept     *ApplicationWebhook_Message `protobuf:"bytes,8,opt,name=join_accept,json=joinAccept,proto3" json:"join_accept,omitempty"`
+	DownlinkAck    *ApplicationWebhook_Message `protobuf:"bytes,9,opt,name=downlink_ack,json=downlinkAck,proto3" json:"downlink_ack,omitempty"`
+	DownlinkNack   *ApplicationWebhook_Message `protobuf:"bytes,10,opt,name=downlink_nack,json=downlinkNack,proto3" json:"downlink_nack,omitempty"`
+	DownlinkSent   *ApplicationWebhook_Message `protobuf:"bytes,11,opt,name=downlink_sent,json=downlinkSent,proto3" json:"downlink_sent,omitempty"`
+	DownlinkFailed *ApplicationWebhook_Message `protobuf:"bytes,12,opt,name=downlink_failed,json=downlinkFailed,proto3" json:"downlink_failed,omitempty"`
+	DownlinkQueued *ApplicationWebhook_Message `protobuf:"bytes,13,opt,name=downlink_queued,json=downlinkQueued,proto3" json:"downlink_queued,omitempty"`
+	LocationSolved *ApplicationWebhook_Message `protobuf:"bytes,14,opt,name=location_solved,json=locationSolved,proto3" json:"location_solved,omitempty"`
+	// The health of the webhook. The health is maintained by the Application Server.
+	// Reset the health to resume a suspended webhook.
+	Health *ApplicationWebhookHealth `protobuf:"bytes,15,opt,name=health,proto3" json:"health,omitempty"`
+	// The template from which the webhook is instantiated, if any.
+	TemplateIDs *ApplicationWebhookTemplateIdentifiers `protobuf:"bytes,16,opt,name=template_ids,json=templateIds,proto3" json:"template_ids,omitempty"`
+	// Signing of the request body, so that the receiver can verify the origin of the request.
+	Signing *ApplicationWebhookSigning `protobuf:"bytes,17,opt,name=signing,proto3" json:"signing,omitempty"`
+	// OAuth 2.0 client credentials to acquire a bearer token with that is set in the Authorization header.
+	OAuth2ClientCredentials *ApplicationWebhookOAuth2ClientCredentials `protobuf:"bytes,18,opt,name=oauth2_client_credentials,json=oauth2ClientCredentials,proto3" json:"oauth2_client_credentials,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}                                   `json:"-"`
+	XXX_sizecache           int32                                      `json:"-"`
 }
 
 func (m *ApplicationWebhook) Reset()      { *m = ApplicationWebhook{} }
 func (*ApplicationWebhook) ProtoMessage() {}
 func (*ApplicationWebhook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_applicationserver_web_cae7911b27bddc2a, []int{1}
+	return fileDescriptor_applicationserver_web_bbcb93b1afd2aa47, []int{1}
 }
 func (m *ApplicationWebhook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -233,6 +241,34 @@ func (m *ApplicationWebhook) GetLocationSolved() *ApplicationWebhook_Message {
 	return nil
 }
 
+func (m *ApplicationWebhook) GetHealth() *ApplicationWebhookHealth {
+	if m != nil {
+		return m.Health
+	}
+	return nil
+}
+
+func (m *ApplicationWebhook) GetTemplateIDs() *ApplicationWebhookTemplateIdentifiers {
+	if m != nil {
+		return m.TemplateIDs
+	}
+	return nil
+}
+
+func (m *ApplicationWebhook) GetSigning() *ApplicationWebhookSigning {
+	if m != nil {
+		return m.Signing
+	}
+	return nil
+}
+
+func (m *ApplicationWebhook) GetOAuth2ClientCredentials() *ApplicationWebhookOAuth2ClientCredentials {
+	if m != nil {
+		return m.OAuth2ClientCredentials
+	}
+	return nil
+}
+
 type ApplicationWebhook_Message struct {
 	// Path to append to the base URL.
 	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
@@ -243,7 +279,7 @@ type ApplicationWebhook_Message struct {
 func (m *ApplicationWebhook_Message) Reset()      { *m = ApplicationWebhook_Message{} }
 func (*ApplicationWebhook_Message) ProtoMessage() {}
 func (*ApplicationWebhook_Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_applicationserver_web_cae7911b27bddc2a, []int{1, 1}
+	return fileDescriptor_applicationserver_web_bbcb93b1afd2aa47, []int{1, 1}
 }
 func (m *ApplicationWebhook_Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -279,6 +315,336 @@ func (m *ApplicationWebhook_Message) GetPath() string {
 	return ""
 }
 
+// ApplicationWebhookSigning configures HMAC-SHA256 signing of the request body.
+// The X-TTN-Signature-Timestamp header contains the Unix time in seconds at which the request is signed.
+// The X-TTN-Signature header contains sha256=<signature>, where the signature is the hex encoded
+// HMAC-SHA256 of the timestamp, a period and the request body.
+// Receivers should reject requests with a timestamp that is too far in the past to prevent replay.
+type ApplicationWebhookSigning struct {
+	// Secret key used to compute the signature.
+	Secret               string   `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationWebhookSigning) Reset()      { *m = ApplicationWebhookSigning{} }
+func (*ApplicationWebhookSigning) ProtoMessage() {}
+func (*ApplicationWebhookSigning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_applicationserver_web_bbcb93b1afd2aa47, []int{2}
+}
+func (m *ApplicationWebhookSigning) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationWebhookSigning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationWebhookSigning.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ApplicationWebhookSigning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhookSigning.Merge(dst, src)
+}
+func (m *ApplicationWebhookSigning) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWebhookSigning) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhookSigning.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationWebhookSigning proto.InternalMessageInfo
+
+func (m *ApplicationWebhookSigning) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+// ApplicationWebhookOAuth2ClientCredentials configures the OAuth 2.0 client credentials grant.
+// Tokens are cached until they expire.
+type ApplicationWebhookOAuth2ClientCredentials struct {
+	TokenURL             string   `protobuf:"bytes,1,opt,name=token_url,json=tokenUrl,proto3" json:"token_url,omitempty"`
+	ClientID             string   `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret         string   `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	Scopes               []string `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationWebhookOAuth2ClientCredentials) Reset() {
+	*m = ApplicationWebhookOAuth2ClientCredentials{}
+}
+func (*ApplicationWebhookOAuth2ClientCredentials) ProtoMessage() {}
+func (*ApplicationWebhookOAuth2ClientCredentials) Descriptor() ([]byte, []int) {
+	return fileDescriptor_applicationserver_web_bbcb93b1afd2aa47, []int{3}
+}
+func (m *ApplicationWebhookOAuth2ClientCredentials) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationWebhookOAuth2ClientCredentials) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationWebhookOAuth2ClientCredentials.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ApplicationWebhookOAuth2ClientCredentials) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhookOAuth2ClientCredentials.Merge(dst, src)
+}
+func (m *ApplicationWebhookOAuth2ClientCredentials) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWebhookOAuth2ClientCredentials) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhookOAuth2ClientCredentials.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationWebhookOAuth2ClientCredentials proto.InternalMessageInfo
+
+func (m *ApplicationWebhookOAuth2ClientCredentials) GetTokenURL() string {
+	if m != nil {
+		return m.TokenURL
+	}
+	return ""
+}
+
+func (m *ApplicationWebhookOAuth2ClientCredentials) GetClientID() string {
+	if m != nil {
+		return m.ClientID
+	}
+	return ""
+}
+
+func (m *ApplicationWebhookOAuth2ClientCredentials) GetClientSecret() string {
+	if m != nil {
+		return m.ClientSecret
+	}
+	return ""
+}
+
+func (m *ApplicationWebhookOAuth2ClientCredentials) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+type ApplicationWebhookHealth struct {
+	// Number of consecutive failed delivery attempts.
+	FailedAttempts      uint32     `protobuf:"varint,1,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
+	LastFailedAttemptAt *time.Time `protobuf:"bytes,2,opt,name=last_failed_attempt_at,json=lastFailedAttemptAt,proto3,stdtime" json:"last_failed_attempt_at,omitempty"`
+	// Error of the last failed delivery attempt.
+	LastFailedAttemptError string `protobuf:"bytes,3,opt,name=last_failed_attempt_error,json=lastFailedAttemptError,proto3" json:"last_failed_attempt_error,omitempty"`
+	// Time when the webhook got suspended because of too many consecutive failed delivery attempts.
+	// Messages are not delivered to suspended webhooks.
+	SuspendedAt          *time.Time `protobuf:"bytes,4,opt,name=suspended_at,json=suspendedAt,proto3,stdtime" json:"suspended_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ApplicationWebhookHealth) Reset()      { *m = ApplicationWebhookHealth{} }
+func (*ApplicationWebhookHealth) ProtoMessage() {}
+func (*ApplicationWebhookHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_applicationserver_web_bbcb93b1afd2aa47, []int{4}
+}
+func (m *ApplicationWebhookHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationWebhookHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationWebhookHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ApplicationWebhookHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhookHealth.Merge(dst, src)
+}
+func (m *ApplicationWebhookHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWebhookHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhookHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationWebhookHealth proto.InternalMessageInfo
+
+func (m *ApplicationWebhookHealth) GetFailedAttempts() uint32 {
+	if m != nil {
+		return m.FailedAttempts
+	}
+	return 0
+}
+
+func (m *ApplicationWebhookHealth) GetLastFailedAttemptAt() *time.Time {
+	if m != nil {
+		return m.LastFailedAttemptAt
+	}
+	return nil
+}
+
+func (m *ApplicationWebhookHealth) GetLastFailedAttemptError() string {
+	if m != nil {
+		return m.LastFailedAttemptError
+	}
+	return ""
+}
+
+func (m *ApplicationWebhookHealth) GetSuspendedAt() *time.Time {
+	if m != nil {
+		return m.SuspendedAt
+	}
+	return nil
+}
+
+// ApplicationWebhookDelivery is the delivery of an upstream message to a webhook.
+type ApplicationWebhookDelivery struct {
+	ID         string                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookIDs ApplicationWebhookIdentifiers `protobuf:"bytes,2,opt,name=webhook_ids,json=webhookIds,proto3" json:"webhook_ids"`
+	Message    *ApplicationUp                `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	CreatedAt  time.Time                     `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// Number of delivery attempts.
+	Attempts uint32 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Error of the last failed delivery attempt.
+	LastError            string   `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationWebhookDelivery) Reset()      { *m = ApplicationWebhookDelivery{} }
+func (*ApplicationWebhookDelivery) ProtoMessage() {}
+func (*ApplicationWebhookDelivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_applicationserver_web_bbcb93b1afd2aa47, []int{5}
+}
+func (m *ApplicationWebhookDelivery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationWebhookDelivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationWebhookDelivery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ApplicationWebhookDelivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhookDelivery.Merge(dst, src)
+}
+func (m *ApplicationWebhookDelivery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWebhookDelivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhookDelivery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationWebhookDelivery proto.InternalMessageInfo
+
+func (m *ApplicationWebhookDelivery) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *ApplicationWebhookDelivery) GetWebhookIDs() ApplicationWebhookIdentifiers {
+	if m != nil {
+		return m.WebhookIDs
+	}
+	return ApplicationWebhookIdentifiers{}
+}
+
+func (m *ApplicationWebhookDelivery) GetMessage() *ApplicationUp {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *ApplicationWebhookDelivery) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *ApplicationWebhookDelivery) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *ApplicationWebhookDelivery) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+type ApplicationWebhookDeliveries struct {
+	Deliveries           []*ApplicationWebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ApplicationWebhookDeliveries) Reset()      { *m = ApplicationWebhookDeliveries{} }
+func (*ApplicationWebhookDeliveries) ProtoMessage() {}
+func (*ApplicationWebhookDeliveries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_applicationserver_web_bbcb93b1afd2aa47, []int{6}
+}
+func (m *ApplicationWebhookDeliveries) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationWebhookDeliveries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationWebhookDeliveries.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ApplicationWebhookDeliveries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhookDeliveries.Merge(dst, src)
+}
+func (m *ApplicationWebhookDeliveries) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWebhookDeliveries) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhookDeliveries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationWebhookDeliveries proto.InternalMessageInfo
+
+func (m *ApplicationWebhookDeliveries) GetDeliveries() []*ApplicationWebhookDelivery {
+	if m != nil {
+		return m.Deliveries
+	}
+	return nil
+}
+
 type ApplicationWebhooks struct {
 	Webhooks             []*ApplicationWebhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
@@ -288,7 +654,7 @@ type ApplicationWebhooks struct {
 func (m *ApplicationWebhooks) Reset()      { *m = ApplicationWebhooks{} }
 func (*ApplicationWebhooks) ProtoMessage() {}
 func (*ApplicationWebhooks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_applicationserver_web_cae7911b27bddc2a, []int{2}
+	return fileDescriptor_applicationserver_web_bbcb93b1afd2aa47, []int{7}
 }
 func (m *ApplicationWebhooks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -334,7 +700,7 @@ type ApplicationWebhookFormats struct {
 func (m *ApplicationWebhookFormats) Reset()      { *m = ApplicationWebhookFormats{} }
 func (*ApplicationWebhookFormats) ProtoMessage() {}
 func (*ApplicationWebhookFormats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_applicationserver_web_cae7911b27bddc2a, []int{3}
+	return fileDescriptor_applicationserver_web_bbcb93b1afd2aa47, []int{8}
 }
 func (m *ApplicationWebhookFormats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -370,24 +736,23 @@ func (m *ApplicationWebhookFormats) GetFormats() map[string]string {
 	return nil
 }
 
-type GetApplicationWebhookRequest struct {
-	ApplicationWebhookIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
-	FieldMask                     types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
-	XXX_NoUnkeyedLiteral          struct{}        `json:"-"`
-	XXX_sizecache                 int32           `json:"-"`
+type ApplicationWebhookTemplateIdentifiers struct {
+	TemplateID           string   `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetApplicationWebhookRequest) Reset()      { *m = GetApplicationWebhookRequest{} }
-func (*GetApplicationWebhookRequest) ProtoMessage() {}
-func (*GetApplicationWebhookRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_applicationserver_web_cae7911b27bddc2a, []int{4}
+func (m *ApplicationWebhookTemplateIdentifiers) Reset()      { *m = ApplicationWebhookTemplateIdentifiers{} }
+func (*ApplicationWebhookTemplateIdentifiers) ProtoMessage() {}
+func (*ApplicationWebhookTemplateIdentifiers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_applicationserver_web_bbcb93b1afd2aa47, []int{9}
 }
-func (m *GetApplicationWebhookRequest) XXX_Unmarshal(b []byte) error {
+func (m *ApplicationWebhookTemplateIdentifiers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetApplicationWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationWebhookTemplateIdentifiers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetApplicationWebhookRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationWebhookTemplateIdentifiers.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -397,43 +762,52 @@ func (m *GetApplicationWebhookRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (dst *GetApplicationWebhookRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetApplicationWebhookRequest.Merge(dst, src)
+func (dst *ApplicationWebhookTemplateIdentifiers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhookTemplateIdentifiers.Merge(dst, src)
 }
-func (m *GetApplicationWebhookRequest) XXX_Size() int {
+func (m *ApplicationWebhookTemplateIdentifiers) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetApplicationWebhookRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetApplicationWebhookRequest.DiscardUnknown(m)
+func (m *ApplicationWebhookTemplateIdentifiers) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhookTemplateIdentifiers.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetApplicationWebhookRequest proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationWebhookTemplateIdentifiers proto.InternalMessageInfo
 
-func (m *GetApplicationWebhookRequest) GetFieldMask() types.FieldMask {
+func (m *ApplicationWebhookTemplateIdentifiers) GetTemplateID() string {
 	if m != nil {
-		return m.FieldMask
+		return m.TemplateID
 	}
-	return types.FieldMask{}
+	return ""
 }
 
-type ListApplicationWebhooksRequest struct {
-	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
-	FieldMask              types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
-	XXX_NoUnkeyedLiteral   struct{}        `json:"-"`
-	XXX_sizecache          int32           `json:"-"`
+// ApplicationWebhookTemplateField is a field that is filled in by the user when instantiating a template.
+type ApplicationWebhookTemplateField struct {
+	// Identifier of the field. The field is referenced as {id} in the base URL, header values and paths of the template.
+	ID          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Secret decides whether the value of the field should be hidden when entered.
+	Secret bool `protobuf:"varint,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Value of the field when it is not filled in.
+	DefaultValue string `protobuf:"bytes,5,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	// Optional decides whether the field may be left empty.
+	Optional             bool     `protobuf:"varint,6,opt,name=optional,proto3" json:"optional,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListApplicationWebhooksRequest) Reset()      { *m = ListApplicationWebhooksRequest{} }
-func (*ListApplicationWebhooksRequest) ProtoMessage() {}
-func (*ListApplicationWebhooksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_applicationserver_web_cae7911b27bddc2a, []int{5}
+func (m *ApplicationWebhookTemplateField) Reset()      { *m = ApplicationWebhookTemplateField{} }
+func (*ApplicationWebhookTemplateField) ProtoMessage() {}
+func (*ApplicationWebhookTemplateField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_applicationserver_web_bbcb93b1afd2aa47, []int{10}
 }
-func (m *ListApplicationWebhooksRequest) XXX_Unmarshal(b []byte) error {
+func (m *ApplicationWebhookTemplateField) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListApplicationWebhooksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationWebhookTemplateField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListApplicationWebhooksRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationWebhookTemplateField.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -443,43 +817,100 @@ func (m *ListApplicationWebhooksRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (dst *ListApplicationWebhooksRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListApplicationWebhooksRequest.Merge(dst, src)
+func (dst *ApplicationWebhookTemplateField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhookTemplateField.Merge(dst, src)
 }
-func (m *ListApplicationWebhooksRequest) XXX_Size() int {
+func (m *ApplicationWebhookTemplateField) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListApplicationWebhooksRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListApplicationWebhooksRequest.DiscardUnknown(m)
+func (m *ApplicationWebhookTemplateField) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhookTemplateField.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListApplicationWebhooksRequest proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationWebhookTemplateField proto.InternalMessageInfo
 
-func (m *ListApplicationWebhooksRequest) GetFieldMask() types.FieldMask {
+func (m *ApplicationWebhookTemplateField) GetID() string {
 	if m != nil {
-		return m.FieldMask
+		return m.ID
 	}
-	return types.FieldMask{}
+	return ""
 }
 
-type SetApplicationWebhookRequest struct {
-	ApplicationWebhook   `protobuf:"bytes,1,opt,name=webhook,proto3,embedded=webhook" json:"webhook"`
-	FieldMask            types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+func (m *ApplicationWebhookTemplateField) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *SetApplicationWebhookRequest) Reset()      { *m = SetApplicationWebhookRequest{} }
-func (*SetApplicationWebhookRequest) ProtoMessage() {}
-func (*SetApplicationWebhookRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_applicationserver_web_cae7911b27bddc2a, []int{6}
+func (m *ApplicationWebhookTemplateField) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
-func (m *SetApplicationWebhookRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *ApplicationWebhookTemplateField) GetSecret() bool {
+	if m != nil {
+		return m.Secret
+	}
+	return false
+}
+
+func (m *ApplicationWebhookTemplateField) GetDefaultValue() string {
+	if m != nil {
+		return m.DefaultValue
+	}
+	return ""
+}
+
+func (m *ApplicationWebhookTemplateField) GetOptional() bool {
+	if m != nil {
+		return m.Optional
+	}
+	return false
+}
+
+// ApplicationWebhookTemplate is a template of a webhook for a third-party integration.
+type ApplicationWebhookTemplate struct {
+	ApplicationWebhookTemplateIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
+	Name                                  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description                           string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	LogoURL                               string `protobuf:"bytes,4,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	InfoURL                               string `protobuf:"bytes,5,opt,name=info_url,json=infoUrl,proto3" json:"info_url,omitempty"`
+	DocumentationURL                      string `protobuf:"bytes,6,opt,name=documentation_url,json=documentationUrl,proto3" json:"documentation_url,omitempty"`
+	// Base URL to which the message's path is appended.
+	BaseURL string `protobuf:"bytes,7,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
+	// HTTP headers to use.
+	Headers map[string]string `protobuf:"bytes,8,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The format to use for the body.
+	// Supported values depend on the Application Server configuration.
+	Format string `protobuf:"bytes,9,opt,name=format,proto3" json:"format,omitempty"`
+	// The fields that are filled in when instantiating the template.
+	Fields               []*ApplicationWebhookTemplateField `protobuf:"bytes,10,rep,name=fields,proto3" json:"fields,omitempty"`
+	UplinkMessage        *ApplicationWebhook_Message        `protobuf:"bytes,11,opt,name=uplink_message,json=uplinkMessage,proto3" json:"uplink_message,omitempty"`
+	JoinAccept           *ApplicationWebhook_Message        `protobuf:"bytes,12,opt,name=join_accept,json=joinAccept,proto3" json:"join_accept,omitempty"`
+	DownlinkAck          *ApplicationWebhook_Message        `protobuf:"bytes,13,opt,name=downlink_ack,json=downlinkAck,proto3" json:"downlink_ack,omitempty"`
+	DownlinkNack         *ApplicationWebhook_Message        `protobuf:"bytes,14,opt,name=downlink_nack,json=downlinkNack,proto3" json:"downlink_nack,omitempty"`
+	DownlinkSent         *ApplicationWebhook_Message        `protobuf:"bytes,15,opt,name=downlink_sent,json=downlinkSent,proto3" json:"downlink_sent,omitempty"`
+	DownlinkFailed       *ApplicationWebhook_Message        `protobuf:"bytes,16,opt,name=downlink_failed,json=downlinkFailed,proto3" json:"downlink_failed,omitempty"`
+	DownlinkQueued       *ApplicationWebhook_Message        `protobuf:"bytes,17,opt,name=downlink_queued,json=downlinkQueued,proto3" json:"downlink_queued,omitempty"`
+	LocationSolved       *ApplicationWebhook_Message        `protobuf:"bytes,18,opt,name=location_solved,json=locationSolved,proto3" json:"location_solved,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *ApplicationWebhookTemplate) Reset()      { *m = ApplicationWebhookTemplate{} }
+func (*ApplicationWebhookTemplate) ProtoMessage() {}
+func (*ApplicationWebhookTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_applicationserver_web_bbcb93b1afd2aa47, []int{11}
+}
+func (m *ApplicationWebhookTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SetApplicationWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationWebhookTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SetApplicationWebhookRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationWebhookTemplate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -489,1391 +920,6313 @@ func (m *SetApplicationWebhookRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (dst *SetApplicationWebhookRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetApplicationWebhookRequest.Merge(dst, src)
+func (dst *ApplicationWebhookTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhookTemplate.Merge(dst, src)
 }
-func (m *SetApplicationWebhookRequest) XXX_Size() int {
+func (m *ApplicationWebhookTemplate) XXX_Size() int {
 	return m.Size()
 }
-func (m *SetApplicationWebhookRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetApplicationWebhookRequest.DiscardUnknown(m)
+func (m *ApplicationWebhookTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhookTemplate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetApplicationWebhookRequest proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationWebhookTemplate proto.InternalMessageInfo
 
-func (m *SetApplicationWebhookRequest) GetFieldMask() types.FieldMask {
+func (m *ApplicationWebhookTemplate) GetName() string {
 	if m != nil {
-		return m.FieldMask
+		return m.Name
 	}
-	return types.FieldMask{}
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*ApplicationWebhookIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookIdentifiers")
-	golang_proto.RegisterType((*ApplicationWebhookIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookIdentifiers")
-	proto.RegisterType((*ApplicationWebhook)(nil), "ttn.lorawan.v3.ApplicationWebhook")
-	golang_proto.RegisterType((*ApplicationWebhook)(nil), "ttn.lorawan.v3.ApplicationWebhook")
-	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhook.HeadersEntry")
-	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhook.HeadersEntry")
-	proto.RegisterType((*ApplicationWebhook_Message)(nil), "ttn.lorawan.v3.ApplicationWebhook.Message")
-	golang_proto.RegisterType((*ApplicationWebhook_Message)(nil), "ttn.lorawan.v3.ApplicationWebhook.Message")
-	proto.RegisterType((*ApplicationWebhooks)(nil), "ttn.lorawan.v3.ApplicationWebhooks")
-	golang_proto.RegisterType((*ApplicationWebhooks)(nil), "ttn.lorawan.v3.ApplicationWebhooks")
-	proto.RegisterType((*ApplicationWebhookFormats)(nil), "ttn.lorawan.v3.ApplicationWebhookFormats")
-	golang_proto.RegisterType((*ApplicationWebhookFormats)(nil), "ttn.lorawan.v3.ApplicationWebhookFormats")
-	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhookFormats.FormatsEntry")
-	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhookFormats.FormatsEntry")
-	proto.RegisterType((*GetApplicationWebhookRequest)(nil), "ttn.lorawan.v3.GetApplicationWebhookRequest")
-	golang_proto.RegisterType((*GetApplicationWebhookRequest)(nil), "ttn.lorawan.v3.GetApplicationWebhookRequest")
-	proto.RegisterType((*ListApplicationWebhooksRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhooksRequest")
-	golang_proto.RegisterType((*ListApplicationWebhooksRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhooksRequest")
-	proto.RegisterType((*SetApplicationWebhookRequest)(nil), "ttn.lorawan.v3.SetApplicationWebhookRequest")
-	golang_proto.RegisterType((*SetApplicationWebhookRequest)(nil), "ttn.lorawan.v3.SetApplicationWebhookRequest")
-}
-func (this *ApplicationWebhookIdentifiers) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ApplicationWebhookTemplate) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
+	return ""
+}
 
-	that1, ok := that.(*ApplicationWebhookIdentifiers)
-	if !ok {
-		that2, ok := that.(ApplicationWebhookIdentifiers)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
-		return false
-	}
-	if this.WebhookID != that1.WebhookID {
-		return false
+func (m *ApplicationWebhookTemplate) GetLogoURL() string {
+	if m != nil {
+		return m.LogoURL
 	}
-	return true
+	return ""
 }
-func (this *ApplicationWebhook) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*ApplicationWebhook)
-	if !ok {
-		that2, ok := that.(ApplicationWebhook)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.ApplicationWebhookIdentifiers.Equal(&that1.ApplicationWebhookIdentifiers) {
-		return false
-	}
-	if !this.CreatedAt.Equal(that1.CreatedAt) {
-		return false
-	}
-	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
-		return false
+func (m *ApplicationWebhookTemplate) GetInfoURL() string {
+	if m != nil {
+		return m.InfoURL
 	}
-	if this.BaseURL != that1.BaseURL {
-		return false
+	return ""
+}
+
+func (m *ApplicationWebhookTemplate) GetDocumentationURL() string {
+	if m != nil {
+		return m.DocumentationURL
 	}
-	if len(this.Headers) != len(that1.Headers) {
-		return false
+	return ""
+}
+
+func (m *ApplicationWebhookTemplate) GetBaseURL() string {
+	if m != nil {
+		return m.BaseURL
 	}
-	for i := range this.Headers {
-		if this.Headers[i] != that1.Headers[i] {
-			return false
-		}
+	return ""
+}
+
+func (m *ApplicationWebhookTemplate) GetHeaders() map[string]string {
+	if m != nil {
+		return m.Headers
 	}
-	if this.Format != that1.Format {
-		return false
+	return nil
+}
+
+func (m *ApplicationWebhookTemplate) GetFormat() string {
+	if m != nil {
+		return m.Format
 	}
-	if !this.UplinkMessage.Equal(that1.UplinkMessage) {
-		return false
+	return ""
+}
+
+func (m *ApplicationWebhookTemplate) GetFields() []*ApplicationWebhookTemplateField {
+	if m != nil {
+		return m.Fields
 	}
-	if !this.JoinAccept.Equal(that1.JoinAccept) {
-		return false
+	return nil
+}
+
+func (m *ApplicationWebhookTemplate) GetUplinkMessage() *ApplicationWebhook_Message {
+	if m != nil {
+		return m.UplinkMessage
 	}
-	if !this.DownlinkAck.Equal(that1.DownlinkAck) {
-		return false
+	return nil
+}
+
+func (m *ApplicationWebhookTemplate) GetJoinAccept() *ApplicationWebhook_Message {
+	if m != nil {
+		return m.JoinAccept
 	}
-	if !this.DownlinkNack.Equal(that1.DownlinkNack) {
-		return false
+	return nil
+}
+
+func (m *ApplicationWebhookTemplate) GetDownlinkAck() *ApplicationWebhook_Message {
+	if m != nil {
+		return m.DownlinkAck
 	}
-	if !this.DownlinkSent.Equal(that1.DownlinkSent) {
-		return false
+	return nil
+}
+
+func (m *ApplicationWebhookTemplate) GetDownlinkNack() *ApplicationWebhook_Message {
+	if m != nil {
+		return m.DownlinkNack
 	}
-	if !this.DownlinkFailed.Equal(that1.DownlinkFailed) {
-		return false
+	return nil
+}
+
+func (m *ApplicationWebhookTemplate) GetDownlinkSent() *ApplicationWebhook_Message {
+	if m != nil {
+		return m.DownlinkSent
 	}
-	if !this.DownlinkQueued.Equal(that1.DownlinkQueued) {
-		return false
+	return nil
+}
+
+func (m *ApplicationWebhookTemplate) GetDownlinkFailed() *ApplicationWebhook_Message {
+	if m != nil {
+		return m.DownlinkFailed
 	}
-	if !this.LocationSolved.Equal(that1.LocationSolved) {
-		return false
+	return nil
+}
+
+func (m *ApplicationWebhookTemplate) GetDownlinkQueued() *ApplicationWebhook_Message {
+	if m != nil {
+		return m.DownlinkQueued
 	}
-	return true
+	return nil
 }
-func (this *ApplicationWebhook_Message) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ApplicationWebhookTemplate) GetLocationSolved() *ApplicationWebhook_Message {
+	if m != nil {
+		return m.LocationSolved
 	}
+	return nil
+}
 
-	that1, ok := that.(*ApplicationWebhook_Message)
-	if !ok {
-		that2, ok := that.(ApplicationWebhook_Message)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ApplicationWebhookTemplates struct {
+	Templates            []*ApplicationWebhookTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ApplicationWebhookTemplates) Reset()      { *m = ApplicationWebhookTemplates{} }
+func (*ApplicationWebhookTemplates) ProtoMessage() {}
+func (*ApplicationWebhookTemplates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_applicationserver_web_bbcb93b1afd2aa47, []int{12}
+}
+func (m *ApplicationWebhookTemplates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationWebhookTemplates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationWebhookTemplates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Path != that1.Path {
-		return false
-	}
-	return true
 }
-func (this *ApplicationWebhooks) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (dst *ApplicationWebhookTemplates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhookTemplates.Merge(dst, src)
+}
+func (m *ApplicationWebhookTemplates) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWebhookTemplates) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhookTemplates.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ApplicationWebhooks)
-	if !ok {
-		that2, ok := that.(ApplicationWebhooks)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Webhooks) != len(that1.Webhooks) {
-		return false
-	}
-	for i := range this.Webhooks {
-		if !this.Webhooks[i].Equal(that1.Webhooks[i]) {
-			return false
-		}
-	}
-	return true
-}
-func (this *ApplicationWebhookFormats) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+var xxx_messageInfo_ApplicationWebhookTemplates proto.InternalMessageInfo
 
-	that1, ok := that.(*ApplicationWebhookFormats)
-	if !ok {
-		that2, ok := that.(ApplicationWebhookFormats)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Formats) != len(that1.Formats) {
-		return false
-	}
-	for i := range this.Formats {
-		if this.Formats[i] != that1.Formats[i] {
-			return false
-		}
+func (m *ApplicationWebhookTemplates) GetTemplates() []*ApplicationWebhookTemplate {
+	if m != nil {
+		return m.Templates
 	}
-	return true
+	return nil
 }
-func (this *GetApplicationWebhookRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*GetApplicationWebhookRequest)
-	if !ok {
-		that2, ok := that.(GetApplicationWebhookRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.ApplicationWebhookIdentifiers.Equal(&that1.ApplicationWebhookIdentifiers) {
-		return false
-	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
-		return false
-	}
-	return true
+type GetApplicationWebhookRequest struct {
+	ApplicationWebhookIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
+	FieldMask                     types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral          struct{}        `json:"-"`
+	XXX_sizecache                 int32           `json:"-"`
 }
-func (this *ListApplicationWebhooksRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*ListApplicationWebhooksRequest)
-	if !ok {
-		that2, ok := that.(ListApplicationWebhooksRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
-		return false
-	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
-		return false
-	}
-	return true
+func (m *GetApplicationWebhookRequest) Reset()      { *m = GetApplicationWebhookRequest{} }
+func (*GetApplicationWebhookRequest) ProtoMessage() {}
+func (*GetApplicationWebhookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_applicationserver_web_bbcb93b1afd2aa47, []int{13}
 }
-func (this *SetApplicationWebhookRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*SetApplicationWebhookRequest)
-	if !ok {
-		that2, ok := that.(SetApplicationWebhookRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *GetApplicationWebhookRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetApplicationWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetApplicationWebhookRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.ApplicationWebhook.Equal(&that1.ApplicationWebhook) {
-		return false
-	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
-		return false
-	}
-	return true
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// ApplicationWebhookRegistryClient is the client API for ApplicationWebhookRegistry service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ApplicationWebhookRegistryClient interface {
-	GetFormats(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ApplicationWebhookFormats, error)
-	Get(ctx context.Context, in *GetApplicationWebhookRequest, opts ...grpc.CallOption) (*ApplicationWebhook, error)
-	List(ctx context.Context, in *ListApplicationWebhooksRequest, opts ...grpc.CallOption) (*ApplicationWebhooks, error)
-	Set(ctx context.Context, in *SetApplicationWebhookRequest, opts ...grpc.CallOption) (*ApplicationWebhook, error)
-	Delete(ctx context.Context, in *ApplicationWebhookIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+func (dst *GetApplicationWebhookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetApplicationWebhookRequest.Merge(dst, src)
 }
-
-type applicationWebhookRegistryClient struct {
-	cc *grpc.ClientConn
+func (m *GetApplicationWebhookRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func NewApplicationWebhookRegistryClient(cc *grpc.ClientConn) ApplicationWebhookRegistryClient {
-	return &applicationWebhookRegistryClient{cc}
+func (m *GetApplicationWebhookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetApplicationWebhookRequest.DiscardUnknown(m)
 }
 
-func (c *applicationWebhookRegistryClient) GetFormats(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ApplicationWebhookFormats, error) {
-	out := new(ApplicationWebhookFormats)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/GetFormats", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetApplicationWebhookRequest proto.InternalMessageInfo
+
+func (m *GetApplicationWebhookRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
 	}
-	return out, nil
+	return types.FieldMask{}
 }
 
-func (c *applicationWebhookRegistryClient) Get(ctx context.Context, in *GetApplicationWebhookRequest, opts ...grpc.CallOption) (*ApplicationWebhook, error) {
-	out := new(ApplicationWebhook)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ListApplicationWebhooksRequest struct {
+	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
+	FieldMask              types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral   struct{}        `json:"-"`
+	XXX_sizecache          int32           `json:"-"`
 }
 
-func (c *applicationWebhookRegistryClient) List(ctx context.Context, in *ListApplicationWebhooksRequest, opts ...grpc.CallOption) (*ApplicationWebhooks, error) {
-	out := new(ApplicationWebhooks)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/List", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListApplicationWebhooksRequest) Reset()      { *m = ListApplicationWebhooksRequest{} }
+func (*ListApplicationWebhooksRequest) ProtoMessage() {}
+func (*ListApplicationWebhooksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_applicationserver_web_bbcb93b1afd2aa47, []int{14}
+}
+func (m *ListApplicationWebhooksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListApplicationWebhooksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListApplicationWebhooksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (dst *ListApplicationWebhooksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApplicationWebhooksRequest.Merge(dst, src)
+}
+func (m *ListApplicationWebhooksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListApplicationWebhooksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApplicationWebhooksRequest.DiscardUnknown(m)
 }
 
-func (c *applicationWebhookRegistryClient) Set(ctx context.Context, in *SetApplicationWebhookRequest, opts ...grpc.CallOption) (*ApplicationWebhook, error) {
-	out := new(ApplicationWebhook)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/Set", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListApplicationWebhooksRequest proto.InternalMessageInfo
+
+func (m *ListApplicationWebhooksRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
 	}
-	return out, nil
+	return types.FieldMask{}
 }
 
-func (c *applicationWebhookRegistryClient) Delete(ctx context.Context, in *ApplicationWebhookIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type SetApplicationWebhookRequest struct {
+	ApplicationWebhook   `protobuf:"bytes,1,opt,name=webhook,proto3,embedded=webhook" json:"webhook"`
+	FieldMask            types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-// ApplicationWebhookRegistryServer is the server API for ApplicationWebhookRegistry service.
-type ApplicationWebhookRegistryServer interface {
-	GetFormats(context.Context, *types.Empty) (*ApplicationWebhookFormats, error)
-	Get(context.Context, *GetApplicationWebhookRequest) (*ApplicationWebhook, error)
-	List(context.Context, *ListApplicationWebhooksRequest) (*ApplicationWebhooks, error)
-	Set(context.Context, *SetApplicationWebhookRequest) (*ApplicationWebhook, error)
-	Delete(context.Context, *ApplicationWebhookIdentifiers) (*types.Empty, error)
+func (m *SetApplicationWebhookRequest) Reset()      { *m = SetApplicationWebhookRequest{} }
+func (*SetApplicationWebhookRequest) ProtoMessage() {}
+func (*SetApplicationWebhookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_applicationserver_web_bbcb93b1afd2aa47, []int{15}
 }
-
-func RegisterApplicationWebhookRegistryServer(s *grpc.Server, srv ApplicationWebhookRegistryServer) {
-	s.RegisterService(&_ApplicationWebhookRegistry_serviceDesc, srv)
+func (m *SetApplicationWebhookRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _ApplicationWebhookRegistry_GetFormats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationWebhookRegistryServer).GetFormats(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/GetFormats",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationWebhookRegistryServer).GetFormats(ctx, req.(*types.Empty))
+func (m *SetApplicationWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetApplicationWebhookRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (dst *SetApplicationWebhookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetApplicationWebhookRequest.Merge(dst, src)
+}
+func (m *SetApplicationWebhookRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetApplicationWebhookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetApplicationWebhookRequest.DiscardUnknown(m)
 }
 
-func _ApplicationWebhookRegistry_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetApplicationWebhookRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationWebhookRegistryServer).Get(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/Get",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationWebhookRegistryServer).Get(ctx, req.(*GetApplicationWebhookRequest))
+var xxx_messageInfo_SetApplicationWebhookRequest proto.InternalMessageInfo
+
+func (m *SetApplicationWebhookRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.FieldMask{}
 }
 
-func _ApplicationWebhookRegistry_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListApplicationWebhooksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationWebhookRegistryServer).List(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/List",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationWebhookRegistryServer).List(ctx, req.(*ListApplicationWebhooksRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type GetApplicationWebhookTemplateRequest struct {
+	ApplicationWebhookTemplateIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
+	FieldMask                             types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral                  struct{}        `json:"-"`
+	XXX_sizecache                         int32           `json:"-"`
 }
 
-func _ApplicationWebhookRegistry_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetApplicationWebhookRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationWebhookRegistryServer).Set(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/Set",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationWebhookRegistryServer).Set(ctx, req.(*SetApplicationWebhookRequest))
+func (m *GetApplicationWebhookTemplateRequest) Reset()      { *m = GetApplicationWebhookTemplateRequest{} }
+func (*GetApplicationWebhookTemplateRequest) ProtoMessage() {}
+func (*GetApplicationWebhookTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_applicationserver_web_bbcb93b1afd2aa47, []int{16}
+}
+func (m *GetApplicationWebhookTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetApplicationWebhookTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetApplicationWebhookTemplateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (dst *GetApplicationWebhookTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetApplicationWebhookTemplateRequest.Merge(dst, src)
+}
+func (m *GetApplicationWebhookTemplateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetApplicationWebhookTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetApplicationWebhookTemplateRequest.DiscardUnknown(m)
 }
 
-func _ApplicationWebhookRegistry_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationWebhookIdentifiers)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationWebhookRegistryServer).Delete(ctx, in)
+var xxx_messageInfo_GetApplicationWebhookTemplateRequest proto.InternalMessageInfo
+
+func (m *GetApplicationWebhookTemplateRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/Delete",
+	return types.FieldMask{}
+}
+
+type ListApplicationWebhookTemplatesRequest struct {
+	FieldMask            types.FieldMask `protobuf:"bytes,1,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListApplicationWebhookTemplatesRequest) Reset() {
+	*m = ListApplicationWebhookTemplatesRequest{}
+}
+func (*ListApplicationWebhookTemplatesRequest) ProtoMessage() {}
+func (*ListApplicationWebhookTemplatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_applicationserver_web_bbcb93b1afd2aa47, []int{17}
+}
+func (m *ListApplicationWebhookTemplatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListApplicationWebhookTemplatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListApplicationWebhookTemplatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationWebhookRegistryServer).Delete(ctx, req.(*ApplicationWebhookIdentifiers))
+}
+func (dst *ListApplicationWebhookTemplatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApplicationWebhookTemplatesRequest.Merge(dst, src)
+}
+func (m *ListApplicationWebhookTemplatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListApplicationWebhookTemplatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApplicationWebhookTemplatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApplicationWebhookTemplatesRequest proto.InternalMessageInfo
+
+func (m *ListApplicationWebhookTemplatesRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.FieldMask{}
 }
 
-var _ApplicationWebhookRegistry_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.ApplicationWebhookRegistry",
-	HandlerType: (*ApplicationWebhookRegistryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetFormats",
-			Handler:    _ApplicationWebhookRegistry_GetFormats_Handler,
-		},
-		{
-			MethodName: "Get",
-			Handler:    _ApplicationWebhookRegistry_Get_Handler,
-		},
-		{
-			MethodName: "List",
-			Handler:    _ApplicationWebhookRegistry_List_Handler,
-		},
-		{
-			MethodName: "Set",
-			Handler:    _ApplicationWebhookRegistry_Set_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _ApplicationWebhookRegistry_Delete_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lorawan-stack/api/applicationserver_web.proto",
+type InstantiateApplicationWebhookTemplateRequest struct {
+	WebhookIDs  ApplicationWebhookIdentifiers         `protobuf:"bytes,1,opt,name=webhook_ids,json=webhookIds,proto3" json:"webhook_ids"`
+	TemplateIDs ApplicationWebhookTemplateIdentifiers `protobuf:"bytes,2,opt,name=template_ids,json=templateIds,proto3" json:"template_ids"`
+	// Values of the template fields by field identifier.
+	Fields               map[string]string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ApplicationWebhookIdentifiers) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *InstantiateApplicationWebhookTemplateRequest) Reset() {
+	*m = InstantiateApplicationWebhookTemplateRequest{}
+}
+func (*InstantiateApplicationWebhookTemplateRequest) ProtoMessage() {}
+func (*InstantiateApplicationWebhookTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_applicationserver_web_bbcb93b1afd2aa47, []int{18}
+}
+func (m *InstantiateApplicationWebhookTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InstantiateApplicationWebhookTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InstantiateApplicationWebhookTemplateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (dst *InstantiateApplicationWebhookTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstantiateApplicationWebhookTemplateRequest.Merge(dst, src)
+}
+func (m *InstantiateApplicationWebhookTemplateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InstantiateApplicationWebhookTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstantiateApplicationWebhookTemplateRequest.DiscardUnknown(m)
 }
 
-func (m *ApplicationWebhookIdentifiers) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.ApplicationIdentifiers.Size()))
-	n1, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n1
-	if len(m.WebhookID) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.WebhookID)))
-		i += copy(dAtA[i:], m.WebhookID)
+var xxx_messageInfo_InstantiateApplicationWebhookTemplateRequest proto.InternalMessageInfo
+
+func (m *InstantiateApplicationWebhookTemplateRequest) GetWebhookIDs() ApplicationWebhookIdentifiers {
+	if m != nil {
+		return m.WebhookIDs
 	}
-	return i, nil
+	return ApplicationWebhookIdentifiers{}
 }
 
-func (m *ApplicationWebhook) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *InstantiateApplicationWebhookTemplateRequest) GetTemplateIDs() ApplicationWebhookTemplateIdentifiers {
+	if m != nil {
+		return m.TemplateIDs
 	}
-	return dAtA[:n], nil
+	return ApplicationWebhookTemplateIdentifiers{}
 }
 
-func (m *ApplicationWebhook) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.ApplicationWebhookIdentifiers.Size()))
-	n2, err := m.ApplicationWebhookIdentifiers.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+func (m *InstantiateApplicationWebhookTemplateRequest) GetFields() map[string]string {
+	if m != nil {
+		return m.Fields
 	}
-	i += n2
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)))
-	n3, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n3
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)))
-	n4, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n4
-	if len(m.BaseURL) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.BaseURL)))
-		i += copy(dAtA[i:], m.BaseURL)
-	}
-	if len(m.Headers) > 0 {
-		for k := range m.Headers {
-			dAtA[i] = 0x2a
-			i++
-			v := m.Headers[k]
-			mapSize := 1 + len(k) + sovApplicationserverWeb(uint64(len(k))) + 1 + len(v) + sovApplicationserverWeb(uint64(len(v)))
-			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
-		}
-	}
-	if len(m.Format) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Format)))
-		i += copy(dAtA[i:], m.Format)
-	}
-	if m.UplinkMessage != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.UplinkMessage.Size()))
-		n5, err := m.UplinkMessage.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
-	}
-	if m.JoinAccept != nil {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.JoinAccept.Size()))
-		n6, err := m.JoinAccept.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
-	}
-	if m.DownlinkAck != nil {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.DownlinkAck.Size()))
-		n7, err := m.DownlinkAck.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n7
-	}
-	if m.DownlinkNack != nil {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.DownlinkNack.Size()))
-		n8, err := m.DownlinkNack.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n8
-	}
-	if m.DownlinkSent != nil {
-		dAtA[i] = 0x5a
-		i++
-		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.DownlinkSent.Size()))
-		n9, err := m.DownlinkSent.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n9
-	}
-	if m.DownlinkFailed != nil {
-		dAtA[i] = 0x62
-		i++
-		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.DownlinkFailed.Size()))
-		n10, err := m.DownlinkFailed.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n10
-	}
-	if m.DownlinkQueued != nil {
-		dAtA[i] = 0x6a
-		i++
-		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.DownlinkQueued.Size()))
-		n11, err := m.DownlinkQueued.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n11
-	}
-	if m.LocationSolved != nil {
-		dAtA[i] = 0x72
-		i++
-		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.LocationSolved.Size()))
-		n12, err := m.LocationSolved.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n12
-	}
-	return i, nil
+	return nil
 }
 
-func (m *ApplicationWebhook_Message) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ListApplicationWebhookDeadLettersRequest struct {
+	ApplicationWebhookIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
+	// Maximum number of dead letters to return, starting with the most recent. If zero, all dead letters are returned.
+	Limit                uint32   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ApplicationWebhook_Message) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Path) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Path)))
-		i += copy(dAtA[i:], m.Path)
-	}
-	return i, nil
+func (m *ListApplicationWebhookDeadLettersRequest) Reset() {
+	*m = ListApplicationWebhookDeadLettersRequest{}
 }
-
-func (m *ApplicationWebhooks) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*ListApplicationWebhookDeadLettersRequest) ProtoMessage() {}
+func (*ListApplicationWebhookDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_applicationserver_web_bbcb93b1afd2aa47, []int{19}
 }
-
-func (m *ApplicationWebhooks) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Webhooks) > 0 {
-		for _, msg := range m.Webhooks {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+func (m *ListApplicationWebhookDeadLettersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListApplicationWebhookDeadLettersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListApplicationWebhookDeadLettersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *ListApplicationWebhookDeadLettersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApplicationWebhookDeadLettersRequest.Merge(dst, src)
+}
+func (m *ListApplicationWebhookDeadLettersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListApplicationWebhookDeadLettersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApplicationWebhookDeadLettersRequest.DiscardUnknown(m)
 }
 
-func (m *ApplicationWebhookFormats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListApplicationWebhookDeadLettersRequest proto.InternalMessageInfo
+
+func (m *ListApplicationWebhookDeadLettersRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ApplicationWebhookFormats) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Formats) > 0 {
-		for k := range m.Formats {
-			dAtA[i] = 0xa
-			i++
-			v := m.Formats[k]
-			mapSize := 1 + len(k) + sovApplicationserverWeb(uint64(len(k))) + 1 + len(v) + sovApplicationserverWeb(uint64(len(v)))
-			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
+type ReplayApplicationWebhookDeadLettersRequest struct {
+	ApplicationWebhookIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
+	// Identifiers of the deliveries to replay. If empty, all dead letters are replayed.
+	DeliveryIDs          []string `protobuf:"bytes,2,rep,name=delivery_ids,json=deliveryIds,proto3" json:"delivery_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplayApplicationWebhookDeadLettersRequest) Reset() {
+	*m = ReplayApplicationWebhookDeadLettersRequest{}
+}
+func (*ReplayApplicationWebhookDeadLettersRequest) ProtoMessage() {}
+func (*ReplayApplicationWebhookDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_applicationserver_web_bbcb93b1afd2aa47, []int{20}
+}
+func (m *ReplayApplicationWebhookDeadLettersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplayApplicationWebhookDeadLettersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplayApplicationWebhookDeadLettersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *ReplayApplicationWebhookDeadLettersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayApplicationWebhookDeadLettersRequest.Merge(dst, src)
+}
+func (m *ReplayApplicationWebhookDeadLettersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplayApplicationWebhookDeadLettersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayApplicationWebhookDeadLettersRequest.DiscardUnknown(m)
 }
 
-func (m *GetApplicationWebhookRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ReplayApplicationWebhookDeadLettersRequest proto.InternalMessageInfo
+
+func (m *ReplayApplicationWebhookDeadLettersRequest) GetDeliveryIDs() []string {
+	if m != nil {
+		return m.DeliveryIDs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetApplicationWebhookRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.ApplicationWebhookIdentifiers.Size()))
-	n13, err := m.ApplicationWebhookIdentifiers.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n13
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.FieldMask.Size()))
-	n14, err := m.FieldMask.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n14
-	return i, nil
+func init() {
+	proto.RegisterType((*ApplicationWebhookIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookIdentifiers")
+	golang_proto.RegisterType((*ApplicationWebhookIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookIdentifiers")
+	proto.RegisterType((*ApplicationWebhook)(nil), "ttn.lorawan.v3.ApplicationWebhook")
+	golang_proto.RegisterType((*ApplicationWebhook)(nil), "ttn.lorawan.v3.ApplicationWebhook")
+	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhook.HeadersEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhook.HeadersEntry")
+	proto.RegisterType((*ApplicationWebhook_Message)(nil), "ttn.lorawan.v3.ApplicationWebhook.Message")
+	golang_proto.RegisterType((*ApplicationWebhook_Message)(nil), "ttn.lorawan.v3.ApplicationWebhook.Message")
+	proto.RegisterType((*ApplicationWebhookSigning)(nil), "ttn.lorawan.v3.ApplicationWebhookSigning")
+	golang_proto.RegisterType((*ApplicationWebhookSigning)(nil), "ttn.lorawan.v3.ApplicationWebhookSigning")
+	proto.RegisterType((*ApplicationWebhookOAuth2ClientCredentials)(nil), "ttn.lorawan.v3.ApplicationWebhookOAuth2ClientCredentials")
+	golang_proto.RegisterType((*ApplicationWebhookOAuth2ClientCredentials)(nil), "ttn.lorawan.v3.ApplicationWebhookOAuth2ClientCredentials")
+	proto.RegisterType((*ApplicationWebhookHealth)(nil), "ttn.lorawan.v3.ApplicationWebhookHealth")
+	golang_proto.RegisterType((*ApplicationWebhookHealth)(nil), "ttn.lorawan.v3.ApplicationWebhookHealth")
+	proto.RegisterType((*ApplicationWebhookDelivery)(nil), "ttn.lorawan.v3.ApplicationWebhookDelivery")
+	golang_proto.RegisterType((*ApplicationWebhookDelivery)(nil), "ttn.lorawan.v3.ApplicationWebhookDelivery")
+	proto.RegisterType((*ApplicationWebhookDeliveries)(nil), "ttn.lorawan.v3.ApplicationWebhookDeliveries")
+	golang_proto.RegisterType((*ApplicationWebhookDeliveries)(nil), "ttn.lorawan.v3.ApplicationWebhookDeliveries")
+	proto.RegisterType((*ApplicationWebhooks)(nil), "ttn.lorawan.v3.ApplicationWebhooks")
+	golang_proto.RegisterType((*ApplicationWebhooks)(nil), "ttn.lorawan.v3.ApplicationWebhooks")
+	proto.RegisterType((*ApplicationWebhookFormats)(nil), "ttn.lorawan.v3.ApplicationWebhookFormats")
+	golang_proto.RegisterType((*ApplicationWebhookFormats)(nil), "ttn.lorawan.v3.ApplicationWebhookFormats")
+	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhookFormats.FormatsEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhookFormats.FormatsEntry")
+	proto.RegisterType((*ApplicationWebhookTemplateIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplateIdentifiers")
+	golang_proto.RegisterType((*ApplicationWebhookTemplateIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplateIdentifiers")
+	proto.RegisterType((*ApplicationWebhookTemplateField)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplateField")
+	golang_proto.RegisterType((*ApplicationWebhookTemplateField)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplateField")
+	proto.RegisterType((*ApplicationWebhookTemplate)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplate")
+	golang_proto.RegisterType((*ApplicationWebhookTemplate)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplate")
+	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplate.HeadersEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplate.HeadersEntry")
+	proto.RegisterType((*ApplicationWebhookTemplates)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplates")
+	golang_proto.RegisterType((*ApplicationWebhookTemplates)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplates")
+	proto.RegisterType((*GetApplicationWebhookRequest)(nil), "ttn.lorawan.v3.GetApplicationWebhookRequest")
+	golang_proto.RegisterType((*GetApplicationWebhookRequest)(nil), "ttn.lorawan.v3.GetApplicationWebhookRequest")
+	proto.RegisterType((*ListApplicationWebhooksRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhooksRequest")
+	golang_proto.RegisterType((*ListApplicationWebhooksRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhooksRequest")
+	proto.RegisterType((*SetApplicationWebhookRequest)(nil), "ttn.lorawan.v3.SetApplicationWebhookRequest")
+	golang_proto.RegisterType((*SetApplicationWebhookRequest)(nil), "ttn.lorawan.v3.SetApplicationWebhookRequest")
+	proto.RegisterType((*GetApplicationWebhookTemplateRequest)(nil), "ttn.lorawan.v3.GetApplicationWebhookTemplateRequest")
+	golang_proto.RegisterType((*GetApplicationWebhookTemplateRequest)(nil), "ttn.lorawan.v3.GetApplicationWebhookTemplateRequest")
+	proto.RegisterType((*ListApplicationWebhookTemplatesRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhookTemplatesRequest")
+	golang_proto.RegisterType((*ListApplicationWebhookTemplatesRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhookTemplatesRequest")
+	proto.RegisterType((*InstantiateApplicationWebhookTemplateRequest)(nil), "ttn.lorawan.v3.InstantiateApplicationWebhookTemplateRequest")
+	golang_proto.RegisterType((*InstantiateApplicationWebhookTemplateRequest)(nil), "ttn.lorawan.v3.InstantiateApplicationWebhookTemplateRequest")
+	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.InstantiateApplicationWebhookTemplateRequest.FieldsEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.InstantiateApplicationWebhookTemplateRequest.FieldsEntry")
+	proto.RegisterType((*ListApplicationWebhookDeadLettersRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhookDeadLettersRequest")
+	golang_proto.RegisterType((*ListApplicationWebhookDeadLettersRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhookDeadLettersRequest")
+	proto.RegisterType((*ReplayApplicationWebhookDeadLettersRequest)(nil), "ttn.lorawan.v3.ReplayApplicationWebhookDeadLettersRequest")
+	golang_proto.RegisterType((*ReplayApplicationWebhookDeadLettersRequest)(nil), "ttn.lorawan.v3.ReplayApplicationWebhookDeadLettersRequest")
 }
-
-func (m *ListApplicationWebhooksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *ApplicationWebhookIdentifiers) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *ListApplicationWebhooksRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.ApplicationIdentifiers.Size()))
-	n15, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	that1, ok := that.(*ApplicationWebhookIdentifiers)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookIdentifiers)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	i += n15
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.FieldMask.Size()))
-	n16, err := m.FieldMask.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i += n16
-	return i, nil
-}
-
-func (m *SetApplicationWebhookRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.WebhookID != that1.WebhookID {
+		return false
+	}
+	return true
 }
+func (this *ApplicationWebhook) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SetApplicationWebhookRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.ApplicationWebhook.Size()))
-	n17, err := m.ApplicationWebhook.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	that1, ok := that.(*ApplicationWebhook)
+	if !ok {
+		that2, ok := that.(ApplicationWebhook)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	i += n17
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.FieldMask.Size()))
-	n18, err := m.FieldMask.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i += n18
-	return i, nil
-}
-
-func encodeVarintApplicationserverWeb(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if !this.ApplicationWebhookIdentifiers.Equal(&that1.ApplicationWebhookIdentifiers) {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func NewPopulatedApplicationWebhookIdentifiers(r randyApplicationserverWeb, easy bool) *ApplicationWebhookIdentifiers {
-	this := &ApplicationWebhookIdentifiers{}
-	v1 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v1
-	this.WebhookID = randStringApplicationserverWeb(r)
-	if !easy && r.Intn(10) != 0 {
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedApplicationWebhook(r randyApplicationserverWeb, easy bool) *ApplicationWebhook {
-	this := &ApplicationWebhook{}
-	v2 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
-	this.ApplicationWebhookIdentifiers = *v2
-	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.CreatedAt = *v3
-	v4 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.UpdatedAt = *v4
-	this.BaseURL = randStringApplicationserverWeb(r)
-	if r.Intn(10) != 0 {
-		v5 := r.Intn(10)
-		this.Headers = make(map[string]string)
-		for i := 0; i < v5; i++ {
-			this.Headers[randStringApplicationserverWeb(r)] = randStringApplicationserverWeb(r)
+	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
+		return false
+	}
+	if this.BaseURL != that1.BaseURL {
+		return false
+	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if this.Headers[i] != that1.Headers[i] {
+			return false
 		}
 	}
-	this.Format = randStringApplicationserverWeb(r)
-	if r.Intn(10) != 0 {
-		this.UplinkMessage = NewPopulatedApplicationWebhook_Message(r, easy)
+	if this.Format != that1.Format {
+		return false
 	}
-	if r.Intn(10) != 0 {
-		this.JoinAccept = NewPopulatedApplicationWebhook_Message(r, easy)
+	if !this.UplinkMessage.Equal(that1.UplinkMessage) {
+		return false
 	}
-	if r.Intn(10) != 0 {
-		this.DownlinkAck = NewPopulatedApplicationWebhook_Message(r, easy)
+	if !this.JoinAccept.Equal(that1.JoinAccept) {
+		return false
 	}
-	if r.Intn(10) != 0 {
-		this.DownlinkNack = NewPopulatedApplicationWebhook_Message(r, easy)
+	if !this.DownlinkAck.Equal(that1.DownlinkAck) {
+		return false
 	}
-	if r.Intn(10) != 0 {
-		this.DownlinkSent = NewPopulatedApplicationWebhook_Message(r, easy)
+	if !this.DownlinkNack.Equal(that1.DownlinkNack) {
+		return false
 	}
-	if r.Intn(10) != 0 {
-		this.DownlinkFailed = NewPopulatedApplicationWebhook_Message(r, easy)
+	if !this.DownlinkSent.Equal(that1.DownlinkSent) {
+		return false
 	}
-	if r.Intn(10) != 0 {
-		this.DownlinkQueued = NewPopulatedApplicationWebhook_Message(r, easy)
+	if !this.DownlinkFailed.Equal(that1.DownlinkFailed) {
+		return false
 	}
-	if r.Intn(10) != 0 {
-		this.LocationSolved = NewPopulatedApplicationWebhook_Message(r, easy)
+	if !this.DownlinkQueued.Equal(that1.DownlinkQueued) {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
+	if !this.LocationSolved.Equal(that1.LocationSolved) {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedApplicationWebhook_Message(r randyApplicationserverWeb, easy bool) *ApplicationWebhook_Message {
-	this := &ApplicationWebhook_Message{}
-	this.Path = randStringApplicationserverWeb(r)
-	if !easy && r.Intn(10) != 0 {
+	if !this.Health.Equal(that1.Health) {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedApplicationWebhooks(r randyApplicationserverWeb, easy bool) *ApplicationWebhooks {
-	this := &ApplicationWebhooks{}
-	if r.Intn(10) != 0 {
-		v6 := r.Intn(5)
-		this.Webhooks = make([]*ApplicationWebhook, v6)
-		for i := 0; i < v6; i++ {
-			this.Webhooks[i] = NewPopulatedApplicationWebhook(r, easy)
-		}
+	if !this.TemplateIDs.Equal(that1.TemplateIDs) {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
+	if !this.Signing.Equal(that1.Signing) {
+		return false
 	}
-	return this
+	if !this.OAuth2ClientCredentials.Equal(that1.OAuth2ClientCredentials) {
+		return false
+	}
+	return true
 }
+func (this *ApplicationWebhook_Message) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func NewPopulatedApplicationWebhookFormats(r randyApplicationserverWeb, easy bool) *ApplicationWebhookFormats {
-	this := &ApplicationWebhookFormats{}
-	if r.Intn(10) != 0 {
-		v7 := r.Intn(10)
-		this.Formats = make(map[string]string)
-		for i := 0; i < v7; i++ {
-			this.Formats[randStringApplicationserverWeb(r)] = randStringApplicationserverWeb(r)
+	that1, ok := that.(*ApplicationWebhook_Message)
+	if !ok {
+		that2, ok := that.(ApplicationWebhook_Message)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if !easy && r.Intn(10) != 0 {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return this
+	if this.Path != that1.Path {
+		return false
+	}
+	return true
 }
+func (this *ApplicationWebhookSigning) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func NewPopulatedGetApplicationWebhookRequest(r randyApplicationserverWeb, easy bool) *GetApplicationWebhookRequest {
-	this := &GetApplicationWebhookRequest{}
-	v8 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
-	this.ApplicationWebhookIdentifiers = *v8
-	v9 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v9
-	if !easy && r.Intn(10) != 0 {
+	that1, ok := that.(*ApplicationWebhookSigning)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookSigning)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return this
-}
-
-func NewPopulatedListApplicationWebhooksRequest(r randyApplicationserverWeb, easy bool) *ListApplicationWebhooksRequest {
-	this := &ListApplicationWebhooksRequest{}
-	v10 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v10
-	v11 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v11
-	if !easy && r.Intn(10) != 0 {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedSetApplicationWebhookRequest(r randyApplicationserverWeb, easy bool) *SetApplicationWebhookRequest {
-	this := &SetApplicationWebhookRequest{}
-	v12 := NewPopulatedApplicationWebhook(r, easy)
-	this.ApplicationWebhook = *v12
-	v13 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v13
-	if !easy && r.Intn(10) != 0 {
+	if this.Secret != that1.Secret {
+		return false
 	}
-	return this
-}
-
-type randyApplicationserverWeb interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+	return true
 }
+func (this *ApplicationWebhookOAuth2ClientCredentials) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func randUTF8RuneApplicationserverWeb(r randyApplicationserverWeb) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+	that1, ok := that.(*ApplicationWebhookOAuth2ClientCredentials)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookOAuth2ClientCredentials)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return rune(ru + 61)
-}
-func randStringApplicationserverWeb(r randyApplicationserverWeb) string {
-	v14 := r.Intn(100)
-	tmps := make([]rune, v14)
-	for i := 0; i < v14; i++ {
-		tmps[i] = randUTF8RuneApplicationserverWeb(r)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return string(tmps)
-}
-func randUnrecognizedApplicationserverWeb(r randyApplicationserverWeb, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
-		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldApplicationserverWeb(dAtA, r, fieldNumber, wire)
+	if this.TokenURL != that1.TokenURL {
+		return false
 	}
-	return dAtA
-}
-func randFieldApplicationserverWeb(dAtA []byte, r randyApplicationserverWeb, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(key))
-		v15 := r.Int63()
-		if r.Intn(2) == 0 {
-			v15 *= -1
-		}
-		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(v15))
-	case 1:
-		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
-		}
-	default:
-		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	if this.ClientID != that1.ClientID {
+		return false
 	}
-	return dAtA
-}
-func encodeVarintPopulateApplicationserverWeb(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(v&0x7f|0x80))
-		v >>= 7
+	if this.ClientSecret != that1.ClientSecret {
+		return false
 	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
-}
-func (m *ApplicationWebhookIdentifiers) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.Scopes) != len(that1.Scopes) {
+		return false
 	}
-	var l int
-	_ = l
-	l = m.ApplicationIdentifiers.Size()
-	n += 1 + l + sovApplicationserverWeb(uint64(l))
-	l = len(m.WebhookID)
-	if l > 0 {
-		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	for i := range this.Scopes {
+		if this.Scopes[i] != that1.Scopes[i] {
+			return false
+		}
 	}
-	return n
+	return true
 }
+func (this *ApplicationWebhookHealth) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ApplicationWebhook) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ApplicationWebhookHealth)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookHealth)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = m.ApplicationWebhookIdentifiers.Size()
-	n += 1 + l + sovApplicationserverWeb(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
-	n += 1 + l + sovApplicationserverWeb(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
-	n += 1 + l + sovApplicationserverWeb(uint64(l))
-	l = len(m.BaseURL)
-	if l > 0 {
-		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Headers) > 0 {
-		for k, v := range m.Headers {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApplicationserverWeb(uint64(len(k))) + 1 + len(v) + sovApplicationserverWeb(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApplicationserverWeb(uint64(mapEntrySize))
+	if this.FailedAttempts != that1.FailedAttempts {
+		return false
+	}
+	if that1.LastFailedAttemptAt == nil {
+		if this.LastFailedAttemptAt != nil {
+			return false
 		}
+	} else if !this.LastFailedAttemptAt.Equal(*that1.LastFailedAttemptAt) {
+		return false
 	}
-	l = len(m.Format)
-	if l > 0 {
-		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	if this.LastFailedAttemptError != that1.LastFailedAttemptError {
+		return false
 	}
-	if m.UplinkMessage != nil {
-		l = m.UplinkMessage.Size()
-		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	if that1.SuspendedAt == nil {
+		if this.SuspendedAt != nil {
+			return false
+		}
+	} else if !this.SuspendedAt.Equal(*that1.SuspendedAt) {
+		return false
 	}
-	if m.JoinAccept != nil {
-		l = m.JoinAccept.Size()
-		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	return true
+}
+func (this *ApplicationWebhookDelivery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.DownlinkAck != nil {
-		l = m.DownlinkAck.Size()
-		n += 1 + l + sovApplicationserverWeb(uint64(l))
+
+	that1, ok := that.(*ApplicationWebhookDelivery)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookDelivery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.DownlinkNack != nil {
-		l = m.DownlinkNack.Size()
-		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.DownlinkSent != nil {
-		l = m.DownlinkSent.Size()
-		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	if this.ID != that1.ID {
+		return false
 	}
-	if m.DownlinkFailed != nil {
-		l = m.DownlinkFailed.Size()
-		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	if !this.WebhookIDs.Equal(&that1.WebhookIDs) {
+		return false
 	}
-	if m.DownlinkQueued != nil {
-		l = m.DownlinkQueued.Size()
-		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	if !this.Message.Equal(that1.Message) {
+		return false
 	}
-	if m.LocationSolved != nil {
-		l = m.LocationSolved.Size()
-		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
 	}
-	return n
-}
-
-func (m *ApplicationWebhook_Message) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Attempts != that1.Attempts {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	if this.LastError != that1.LastError {
+		return false
 	}
-	return n
+	return true
 }
+func (this *ApplicationWebhookDeliveries) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ApplicationWebhooks) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ApplicationWebhookDeliveries)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookDeliveries)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if len(m.Webhooks) > 0 {
-		for _, e := range m.Webhooks {
-			l = e.Size()
-			n += 1 + l + sovApplicationserverWeb(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Deliveries) != len(that1.Deliveries) {
+		return false
+	}
+	for i := range this.Deliveries {
+		if !this.Deliveries[i].Equal(that1.Deliveries[i]) {
+			return false
 		}
 	}
-	return n
+	return true
 }
+func (this *ApplicationWebhooks) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ApplicationWebhookFormats) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ApplicationWebhooks)
+	if !ok {
+		that2, ok := that.(ApplicationWebhooks)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if len(m.Formats) > 0 {
-		for k, v := range m.Formats {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApplicationserverWeb(uint64(len(k))) + 1 + len(v) + sovApplicationserverWeb(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApplicationserverWeb(uint64(mapEntrySize))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Webhooks) != len(that1.Webhooks) {
+		return false
+	}
+	for i := range this.Webhooks {
+		if !this.Webhooks[i].Equal(that1.Webhooks[i]) {
+			return false
 		}
 	}
-	return n
+	return true
 }
-
-func (m *GetApplicationWebhookRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ApplicationWebhookFormats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = m.ApplicationWebhookIdentifiers.Size()
-	n += 1 + l + sovApplicationserverWeb(uint64(l))
-	l = m.FieldMask.Size()
-	n += 1 + l + sovApplicationserverWeb(uint64(l))
-	return n
-}
 
-func (m *ListApplicationWebhooksRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ApplicationWebhookFormats)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookFormats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = m.ApplicationIdentifiers.Size()
-	n += 1 + l + sovApplicationserverWeb(uint64(l))
-	l = m.FieldMask.Size()
-	n += 1 + l + sovApplicationserverWeb(uint64(l))
-	return n
-}
-
-func (m *SetApplicationWebhookRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	var l int
-	_ = l
-	l = m.ApplicationWebhook.Size()
-	n += 1 + l + sovApplicationserverWeb(uint64(l))
-	l = m.FieldMask.Size()
-	n += 1 + l + sovApplicationserverWeb(uint64(l))
-	return n
+	if len(this.Formats) != len(that1.Formats) {
+		return false
+	}
+	for i := range this.Formats {
+		if this.Formats[i] != that1.Formats[i] {
+			return false
+		}
+	}
+	return true
 }
+func (this *ApplicationWebhookTemplateIdentifiers) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func sovApplicationserverWeb(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+	that1, ok := that.(*ApplicationWebhookTemplateIdentifiers)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookTemplateIdentifiers)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return n
-}
-func sozApplicationserverWeb(x uint64) (n int) {
-	return sovApplicationserverWeb((x << 1) ^ uint64((int64(x) >> 63)))
-}
-func (this *ApplicationWebhookIdentifiers) String() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&ApplicationWebhookIdentifiers{`,
-		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationIdentifiers.String(), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
-		`WebhookID:` + fmt.Sprintf("%v", this.WebhookID) + `,`,
-		`}`,
-	}, "")
-	return s
+	if this.TemplateID != that1.TemplateID {
+		return false
+	}
+	return true
 }
-func (this *ApplicationWebhook) String() string {
-	if this == nil {
-		return "nil"
+func (this *ApplicationWebhookTemplateField) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	keysForHeaders := make([]string, 0, len(this.Headers))
-	for k := range this.Headers {
-		keysForHeaders = append(keysForHeaders, k)
+
+	that1, ok := that.(*ApplicationWebhookTemplateField)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookTemplateField)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
-	mapStringForHeaders := "map[string]string{"
-	for _, k := range keysForHeaders {
-		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	mapStringForHeaders += "}"
-	s := strings.Join([]string{`&ApplicationWebhook{`,
-		`ApplicationWebhookIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationWebhookIdentifiers.String(), "ApplicationWebhookIdentifiers", "ApplicationWebhookIdentifiers", 1), `&`, ``, 1) + `,`,
-		`CreatedAt:` + strings.Replace(strings.Replace(this.CreatedAt.String(), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`UpdatedAt:` + strings.Replace(strings.Replace(this.UpdatedAt.String(), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`BaseURL:` + fmt.Sprintf("%v", this.BaseURL) + `,`,
-		`Headers:` + mapStringForHeaders + `,`,
-		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
-		`UplinkMessage:` + strings.Replace(fmt.Sprintf("%v", this.UplinkMessage), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
-		`JoinAccept:` + strings.Replace(fmt.Sprintf("%v", this.JoinAccept), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
-		`DownlinkAck:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkAck), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
-		`DownlinkNack:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkNack), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
-		`DownlinkSent:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkSent), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
-		`DownlinkFailed:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkFailed), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
-		`DownlinkQueued:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkQueued), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
-		`LocationSolved:` + strings.Replace(fmt.Sprintf("%v", this.LocationSolved), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ApplicationWebhook_Message) String() string {
-	if this == nil {
-		return "nil"
+	if this.ID != that1.ID {
+		return false
 	}
-	s := strings.Join([]string{`&ApplicationWebhook_Message{`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ApplicationWebhooks) String() string {
-	if this == nil {
-		return "nil"
+	if this.Name != that1.Name {
+		return false
 	}
-	s := strings.Join([]string{`&ApplicationWebhooks{`,
-		`Webhooks:` + strings.Replace(fmt.Sprintf("%v", this.Webhooks), "ApplicationWebhook", "ApplicationWebhook", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ApplicationWebhookFormats) String() string {
-	if this == nil {
-		return "nil"
+	if this.Description != that1.Description {
+		return false
 	}
-	keysForFormats := make([]string, 0, len(this.Formats))
-	for k := range this.Formats {
-		keysForFormats = append(keysForFormats, k)
+	if this.Secret != that1.Secret {
+		return false
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForFormats)
-	mapStringForFormats := "map[string]string{"
-	for _, k := range keysForFormats {
-		mapStringForFormats += fmt.Sprintf("%v: %v,", k, this.Formats[k])
+	if this.DefaultValue != that1.DefaultValue {
+		return false
 	}
-	mapStringForFormats += "}"
-	s := strings.Join([]string{`&ApplicationWebhookFormats{`,
-		`Formats:` + mapStringForFormats + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetApplicationWebhookRequest) String() string {
-	if this == nil {
-		return "nil"
+	if this.Optional != that1.Optional {
+		return false
 	}
-	s := strings.Join([]string{`&GetApplicationWebhookRequest{`,
-		`ApplicationWebhookIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationWebhookIdentifiers.String(), "ApplicationWebhookIdentifiers", "ApplicationWebhookIdentifiers", 1), `&`, ``, 1) + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(this.FieldMask.String(), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return true
 }
-func (this *ListApplicationWebhooksRequest) String() string {
-	if this == nil {
-		return "nil"
+func (this *ApplicationWebhookTemplate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&ListApplicationWebhooksRequest{`,
-		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationIdentifiers.String(), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(this.FieldMask.String(), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SetApplicationWebhookRequest) String() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*ApplicationWebhookTemplate)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookTemplate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&SetApplicationWebhookRequest{`,
-		`ApplicationWebhook:` + strings.Replace(strings.Replace(this.ApplicationWebhook.String(), "ApplicationWebhook", "ApplicationWebhook", 1), `&`, ``, 1) + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(this.FieldMask.String(), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringApplicationserverWeb(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	pv := reflect.Indirect(rv).Interface()
+	if !this.ApplicationWebhookTemplateIdentifiers.Equal(&that1.ApplicationWebhookTemplateIdentifiers) {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.LogoURL != that1.LogoURL {
+		return false
+	}
+	if this.InfoURL != that1.InfoURL {
+		return false
+	}
+	if this.DocumentationURL != that1.DocumentationURL {
+		return false
+	}
+	if this.BaseURL != that1.BaseURL {
+		return false
+	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if this.Headers[i] != that1.Headers[i] {
+			return false
+		}
+	}
+	if this.Format != that1.Format {
+		return false
+	}
+	if len(this.Fields) != len(that1.Fields) {
+		return false
+	}
+	for i := range this.Fields {
+		if !this.Fields[i].Equal(that1.Fields[i]) {
+			return false
+		}
+	}
+	if !this.UplinkMessage.Equal(that1.UplinkMessage) {
+		return false
+	}
+	if !this.JoinAccept.Equal(that1.JoinAccept) {
+		return false
+	}
+	if !this.DownlinkAck.Equal(that1.DownlinkAck) {
+		return false
+	}
+	if !this.DownlinkNack.Equal(that1.DownlinkNack) {
+		return false
+	}
+	if !this.DownlinkSent.Equal(that1.DownlinkSent) {
+		return false
+	}
+	if !this.DownlinkFailed.Equal(that1.DownlinkFailed) {
+		return false
+	}
+	if !this.DownlinkQueued.Equal(that1.DownlinkQueued) {
+		return false
+	}
+	if !this.LocationSolved.Equal(that1.LocationSolved) {
+		return false
+	}
+	return true
+}
+func (this *ApplicationWebhookTemplates) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationWebhookTemplates)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookTemplates)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Templates) != len(that1.Templates) {
+		return false
+	}
+	for i := range this.Templates {
+		if !this.Templates[i].Equal(that1.Templates[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GetApplicationWebhookRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetApplicationWebhookRequest)
+	if !ok {
+		that2, ok := that.(GetApplicationWebhookRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationWebhookIdentifiers.Equal(&that1.ApplicationWebhookIdentifiers) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
+}
+func (this *ListApplicationWebhooksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListApplicationWebhooksRequest)
+	if !ok {
+		that2, ok := that.(ListApplicationWebhooksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
+}
+func (this *SetApplicationWebhookRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetApplicationWebhookRequest)
+	if !ok {
+		that2, ok := that.(SetApplicationWebhookRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationWebhook.Equal(&that1.ApplicationWebhook) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
+}
+func (this *GetApplicationWebhookTemplateRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetApplicationWebhookTemplateRequest)
+	if !ok {
+		that2, ok := that.(GetApplicationWebhookTemplateRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationWebhookTemplateIdentifiers.Equal(&that1.ApplicationWebhookTemplateIdentifiers) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
+}
+func (this *ListApplicationWebhookTemplatesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListApplicationWebhookTemplatesRequest)
+	if !ok {
+		that2, ok := that.(ListApplicationWebhookTemplatesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
+}
+func (this *InstantiateApplicationWebhookTemplateRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InstantiateApplicationWebhookTemplateRequest)
+	if !ok {
+		that2, ok := that.(InstantiateApplicationWebhookTemplateRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.WebhookIDs.Equal(&that1.WebhookIDs) {
+		return false
+	}
+	if !this.TemplateIDs.Equal(&that1.TemplateIDs) {
+		return false
+	}
+	if len(this.Fields) != len(that1.Fields) {
+		return false
+	}
+	for i := range this.Fields {
+		if this.Fields[i] != that1.Fields[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *ListApplicationWebhookDeadLettersRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListApplicationWebhookDeadLettersRequest)
+	if !ok {
+		that2, ok := that.(ListApplicationWebhookDeadLettersRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationWebhookIdentifiers.Equal(&that1.ApplicationWebhookIdentifiers) {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	return true
+}
+func (this *ReplayApplicationWebhookDeadLettersRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReplayApplicationWebhookDeadLettersRequest)
+	if !ok {
+		that2, ok := that.(ReplayApplicationWebhookDeadLettersRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationWebhookIdentifiers.Equal(&that1.ApplicationWebhookIdentifiers) {
+		return false
+	}
+	if len(this.DeliveryIDs) != len(that1.DeliveryIDs) {
+		return false
+	}
+	for i := range this.DeliveryIDs {
+		if this.DeliveryIDs[i] != that1.DeliveryIDs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ApplicationWebhookRegistryClient is the client API for ApplicationWebhookRegistry service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ApplicationWebhookRegistryClient interface {
+	GetFormats(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ApplicationWebhookFormats, error)
+	GetTemplate(ctx context.Context, in *GetApplicationWebhookTemplateRequest, opts ...grpc.CallOption) (*ApplicationWebhookTemplate, error)
+	ListTemplates(ctx context.Context, in *ListApplicationWebhookTemplatesRequest, opts ...grpc.CallOption) (*ApplicationWebhookTemplates, error)
+	// Instantiate the template into a webhook. An existing webhook with the same identifiers is replaced.
+	InstantiateTemplate(ctx context.Context, in *InstantiateApplicationWebhookTemplateRequest, opts ...grpc.CallOption) (*ApplicationWebhook, error)
+	Get(ctx context.Context, in *GetApplicationWebhookRequest, opts ...grpc.CallOption) (*ApplicationWebhook, error)
+	List(ctx context.Context, in *ListApplicationWebhooksRequest, opts ...grpc.CallOption) (*ApplicationWebhooks, error)
+	Set(ctx context.Context, in *SetApplicationWebhookRequest, opts ...grpc.CallOption) (*ApplicationWebhook, error)
+	Delete(ctx context.Context, in *ApplicationWebhookIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// List the deliveries that failed after all attempts.
+	ListDeadLetters(ctx context.Context, in *ListApplicationWebhookDeadLettersRequest, opts ...grpc.CallOption) (*ApplicationWebhookDeliveries, error)
+	// Replay the deliveries that failed after all attempts. Replayed deliveries are removed from the dead letters.
+	ReplayDeadLetters(ctx context.Context, in *ReplayApplicationWebhookDeadLettersRequest, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type applicationWebhookRegistryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewApplicationWebhookRegistryClient(cc *grpc.ClientConn) ApplicationWebhookRegistryClient {
+	return &applicationWebhookRegistryClient{cc}
+}
+
+func (c *applicationWebhookRegistryClient) GetFormats(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ApplicationWebhookFormats, error) {
+	out := new(ApplicationWebhookFormats)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/GetFormats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationWebhookRegistryClient) GetTemplate(ctx context.Context, in *GetApplicationWebhookTemplateRequest, opts ...grpc.CallOption) (*ApplicationWebhookTemplate, error) {
+	out := new(ApplicationWebhookTemplate)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/GetTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationWebhookRegistryClient) ListTemplates(ctx context.Context, in *ListApplicationWebhookTemplatesRequest, opts ...grpc.CallOption) (*ApplicationWebhookTemplates, error) {
+	out := new(ApplicationWebhookTemplates)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/ListTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationWebhookRegistryClient) InstantiateTemplate(ctx context.Context, in *InstantiateApplicationWebhookTemplateRequest, opts ...grpc.CallOption) (*ApplicationWebhook, error) {
+	out := new(ApplicationWebhook)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/InstantiateTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationWebhookRegistryClient) Get(ctx context.Context, in *GetApplicationWebhookRequest, opts ...grpc.CallOption) (*ApplicationWebhook, error) {
+	out := new(ApplicationWebhook)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationWebhookRegistryClient) List(ctx context.Context, in *ListApplicationWebhooksRequest, opts ...grpc.CallOption) (*ApplicationWebhooks, error) {
+	out := new(ApplicationWebhooks)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationWebhookRegistryClient) Set(ctx context.Context, in *SetApplicationWebhookRequest, opts ...grpc.CallOption) (*ApplicationWebhook, error) {
+	out := new(ApplicationWebhook)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/Set", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationWebhookRegistryClient) Delete(ctx context.Context, in *ApplicationWebhookIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationWebhookRegistryClient) ListDeadLetters(ctx context.Context, in *ListApplicationWebhookDeadLettersRequest, opts ...grpc.CallOption) (*ApplicationWebhookDeliveries, error) {
+	out := new(ApplicationWebhookDeliveries)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/ListDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationWebhookRegistryClient) ReplayDeadLetters(ctx context.Context, in *ReplayApplicationWebhookDeadLettersRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/ReplayDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApplicationWebhookRegistryServer is the server API for ApplicationWebhookRegistry service.
+type ApplicationWebhookRegistryServer interface {
+	GetFormats(context.Context, *types.Empty) (*ApplicationWebhookFormats, error)
+	GetTemplate(context.Context, *GetApplicationWebhookTemplateRequest) (*ApplicationWebhookTemplate, error)
+	ListTemplates(context.Context, *ListApplicationWebhookTemplatesRequest) (*ApplicationWebhookTemplates, error)
+	// Instantiate the template into a webhook. An existing webhook with the same identifiers is replaced.
+	InstantiateTemplate(context.Context, *InstantiateApplicationWebhookTemplateRequest) (*ApplicationWebhook, error)
+	Get(context.Context, *GetApplicationWebhookRequest) (*ApplicationWebhook, error)
+	List(context.Context, *ListApplicationWebhooksRequest) (*ApplicationWebhooks, error)
+	Set(context.Context, *SetApplicationWebhookRequest) (*ApplicationWebhook, error)
+	Delete(context.Context, *ApplicationWebhookIdentifiers) (*types.Empty, error)
+	// List the deliveries that failed after all attempts.
+	ListDeadLetters(context.Context, *ListApplicationWebhookDeadLettersRequest) (*ApplicationWebhookDeliveries, error)
+	// Replay the deliveries that failed after all attempts. Replayed deliveries are removed from the dead letters.
+	ReplayDeadLetters(context.Context, *ReplayApplicationWebhookDeadLettersRequest) (*types.Empty, error)
+}
+
+func RegisterApplicationWebhookRegistryServer(s *grpc.Server, srv ApplicationWebhookRegistryServer) {
+	s.RegisterService(&_ApplicationWebhookRegistry_serviceDesc, srv)
+}
+
+func _ApplicationWebhookRegistry_GetFormats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).GetFormats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/GetFormats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).GetFormats(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationWebhookRegistry_GetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationWebhookTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).GetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/GetTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).GetTemplate(ctx, req.(*GetApplicationWebhookTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationWebhookRegistry_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationWebhookTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/ListTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).ListTemplates(ctx, req.(*ListApplicationWebhookTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationWebhookRegistry_InstantiateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstantiateApplicationWebhookTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).InstantiateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/InstantiateTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).InstantiateTemplate(ctx, req.(*InstantiateApplicationWebhookTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationWebhookRegistry_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).Get(ctx, req.(*GetApplicationWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationWebhookRegistry_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).List(ctx, req.(*ListApplicationWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationWebhookRegistry_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetApplicationWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/Set",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).Set(ctx, req.(*SetApplicationWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationWebhookRegistry_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationWebhookIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).Delete(ctx, req.(*ApplicationWebhookIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationWebhookRegistry_ListDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationWebhookDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).ListDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/ListDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).ListDeadLetters(ctx, req.(*ListApplicationWebhookDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationWebhookRegistry_ReplayDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayApplicationWebhookDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).ReplayDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/ReplayDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).ReplayDeadLetters(ctx, req.(*ReplayApplicationWebhookDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ApplicationWebhookRegistry_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.ApplicationWebhookRegistry",
+	HandlerType: (*ApplicationWebhookRegistryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetFormats",
+			Handler:    _ApplicationWebhookRegistry_GetFormats_Handler,
+		},
+		{
+			MethodName: "GetTemplate",
+			Handler:    _ApplicationWebhookRegistry_GetTemplate_Handler,
+		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _ApplicationWebhookRegistry_ListTemplates_Handler,
+		},
+		{
+			MethodName: "InstantiateTemplate",
+			Handler:    _ApplicationWebhookRegistry_InstantiateTemplate_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ApplicationWebhookRegistry_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _ApplicationWebhookRegistry_List_Handler,
+		},
+		{
+			MethodName: "Set",
+			Handler:    _ApplicationWebhookRegistry_Set_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ApplicationWebhookRegistry_Delete_Handler,
+		},
+		{
+			MethodName: "ListDeadLetters",
+			Handler:    _ApplicationWebhookRegistry_ListDeadLetters_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetters",
+			Handler:    _ApplicationWebhookRegistry_ReplayDeadLetters_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/applicationserver_web.proto",
+}
+
+func (m *ApplicationWebhookIdentifiers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhookIdentifiers) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.ApplicationIdentifiers.Size()))
+	n1, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	if len(m.WebhookID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.WebhookID)))
+		i += copy(dAtA[i:], m.WebhookID)
+	}
+	return i, nil
+}
+
+func (m *ApplicationWebhook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhook) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.ApplicationWebhookIdentifiers.Size()))
+	n2, err := m.ApplicationWebhookIdentifiers.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)))
+	n3, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)))
+	n4, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n4
+	if len(m.BaseURL) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.BaseURL)))
+		i += copy(dAtA[i:], m.BaseURL)
+	}
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			dAtA[i] = 0x2a
+			i++
+			v := m.Headers[k]
+			mapSize := 1 + len(k) + sovApplicationserverWeb(uint64(len(k))) + 1 + len(v) + sovApplicationserverWeb(uint64(len(v)))
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.Format) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Format)))
+		i += copy(dAtA[i:], m.Format)
+	}
+	if m.UplinkMessage != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.UplinkMessage.Size()))
+		n5, err := m.UplinkMessage.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.JoinAccept != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.JoinAccept.Size()))
+		n6, err := m.JoinAccept.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.DownlinkAck != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.DownlinkAck.Size()))
+		n7, err := m.DownlinkAck.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.DownlinkNack != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.DownlinkNack.Size()))
+		n8, err := m.DownlinkNack.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.DownlinkSent != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.DownlinkSent.Size()))
+		n9, err := m.DownlinkSent.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.DownlinkFailed != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.DownlinkFailed.Size()))
+		n10, err := m.DownlinkFailed.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.DownlinkQueued != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.DownlinkQueued.Size()))
+		n11, err := m.DownlinkQueued.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if m.LocationSolved != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.LocationSolved.Size()))
+		n12, err := m.LocationSolved.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.Health != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.Health.Size()))
+		n13, err := m.Health.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if m.TemplateIDs != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.TemplateIDs.Size()))
+		n14, err := m.TemplateIDs.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if m.Signing != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.Signing.Size()))
+		n15, err := m.Signing.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if m.OAuth2ClientCredentials != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.OAuth2ClientCredentials.Size()))
+		n16, err := m.OAuth2ClientCredentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	return i, nil
+}
+
+func (m *ApplicationWebhook_Message) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhook_Message) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	return i, nil
+}
+
+func (m *ApplicationWebhookSigning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhookSigning) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Secret) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Secret)))
+		i += copy(dAtA[i:], m.Secret)
+	}
+	return i, nil
+}
+
+func (m *ApplicationWebhookOAuth2ClientCredentials) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhookOAuth2ClientCredentials) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenURL) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.TokenURL)))
+		i += copy(dAtA[i:], m.TokenURL)
+	}
+	if len(m.ClientID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.ClientID)))
+		i += copy(dAtA[i:], m.ClientID)
+	}
+	if len(m.ClientSecret) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.ClientSecret)))
+		i += copy(dAtA[i:], m.ClientSecret)
+	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *ApplicationWebhookHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhookHealth) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.FailedAttempts != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.FailedAttempts))
+	}
+	if m.LastFailedAttemptAt != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastFailedAttemptAt)))
+		n17, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastFailedAttemptAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if len(m.LastFailedAttemptError) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.LastFailedAttemptError)))
+		i += copy(dAtA[i:], m.LastFailedAttemptError)
+	}
+	if m.SuspendedAt != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.SuspendedAt)))
+		n18, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.SuspendedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	return i, nil
+}
+
+func (m *ApplicationWebhookDelivery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhookDelivery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.WebhookIDs.Size()))
+	n19, err := m.WebhookIDs.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n19
+	if m.Message != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.Message.Size()))
+		n20, err := m.Message.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)))
+	n21, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n21
+	if m.Attempts != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.Attempts))
+	}
+	if len(m.LastError) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.LastError)))
+		i += copy(dAtA[i:], m.LastError)
+	}
+	return i, nil
+}
+
+func (m *ApplicationWebhookDeliveries) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhookDeliveries) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deliveries) > 0 {
+		for _, msg := range m.Deliveries {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ApplicationWebhooks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhooks) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Webhooks) > 0 {
+		for _, msg := range m.Webhooks {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ApplicationWebhookFormats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhookFormats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Formats) > 0 {
+		for k := range m.Formats {
+			dAtA[i] = 0xa
+			i++
+			v := m.Formats[k]
+			mapSize := 1 + len(k) + sovApplicationserverWeb(uint64(len(k))) + 1 + len(v) + sovApplicationserverWeb(uint64(len(v)))
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func (m *ApplicationWebhookTemplateIdentifiers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhookTemplateIdentifiers) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TemplateID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.TemplateID)))
+		i += copy(dAtA[i:], m.TemplateID)
+	}
+	return i, nil
+}
+
+func (m *ApplicationWebhookTemplateField) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhookTemplateField) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if m.Secret {
+		dAtA[i] = 0x20
+		i++
+		if m.Secret {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.DefaultValue) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.DefaultValue)))
+		i += copy(dAtA[i:], m.DefaultValue)
+	}
+	if m.Optional {
+		dAtA[i] = 0x30
+		i++
+		if m.Optional {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ApplicationWebhookTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhookTemplate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.ApplicationWebhookTemplateIdentifiers.Size()))
+	n22, err := m.ApplicationWebhookTemplateIdentifiers.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n22
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if len(m.LogoURL) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.LogoURL)))
+		i += copy(dAtA[i:], m.LogoURL)
+	}
+	if len(m.InfoURL) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.InfoURL)))
+		i += copy(dAtA[i:], m.InfoURL)
+	}
+	if len(m.DocumentationURL) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.DocumentationURL)))
+		i += copy(dAtA[i:], m.DocumentationURL)
+	}
+	if len(m.BaseURL) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.BaseURL)))
+		i += copy(dAtA[i:], m.BaseURL)
+	}
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			dAtA[i] = 0x42
+			i++
+			v := m.Headers[k]
+			mapSize := 1 + len(k) + sovApplicationserverWeb(uint64(len(k))) + 1 + len(v) + sovApplicationserverWeb(uint64(len(v)))
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.Format) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Format)))
+		i += copy(dAtA[i:], m.Format)
+	}
+	if len(m.Fields) > 0 {
+		for _, msg := range m.Fields {
+			dAtA[i] = 0x52
+			i++
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.UplinkMessage != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.UplinkMessage.Size()))
+		n23, err := m.UplinkMessage.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	if m.JoinAccept != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.JoinAccept.Size()))
+		n24, err := m.JoinAccept.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	if m.DownlinkAck != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.DownlinkAck.Size()))
+		n25, err := m.DownlinkAck.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	if m.DownlinkNack != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.DownlinkNack.Size()))
+		n26, err := m.DownlinkNack.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
+	}
+	if m.DownlinkSent != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.DownlinkSent.Size()))
+		n27, err := m.DownlinkSent.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	if m.DownlinkFailed != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.DownlinkFailed.Size()))
+		n28, err := m.DownlinkFailed.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	if m.DownlinkQueued != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.DownlinkQueued.Size()))
+		n29, err := m.DownlinkQueued.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
+	if m.LocationSolved != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.LocationSolved.Size()))
+		n30, err := m.LocationSolved.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
+	return i, nil
+}
+
+func (m *ApplicationWebhookTemplates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhookTemplates) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Templates) > 0 {
+		for _, msg := range m.Templates {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *GetApplicationWebhookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetApplicationWebhookRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.ApplicationWebhookIdentifiers.Size()))
+	n31, err := m.ApplicationWebhookIdentifiers.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n31
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.FieldMask.Size()))
+	n32, err := m.FieldMask.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n32
+	return i, nil
+}
+
+func (m *ListApplicationWebhooksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListApplicationWebhooksRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.ApplicationIdentifiers.Size()))
+	n33, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n33
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.FieldMask.Size()))
+	n34, err := m.FieldMask.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n34
+	return i, nil
+}
+
+func (m *SetApplicationWebhookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetApplicationWebhookRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.ApplicationWebhook.Size()))
+	n35, err := m.ApplicationWebhook.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n35
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.FieldMask.Size()))
+	n36, err := m.FieldMask.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n36
+	return i, nil
+}
+
+func (m *GetApplicationWebhookTemplateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetApplicationWebhookTemplateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.ApplicationWebhookTemplateIdentifiers.Size()))
+	n37, err := m.ApplicationWebhookTemplateIdentifiers.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n37
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.FieldMask.Size()))
+	n38, err := m.FieldMask.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n38
+	return i, nil
+}
+
+func (m *ListApplicationWebhookTemplatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListApplicationWebhookTemplatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.FieldMask.Size()))
+	n39, err := m.FieldMask.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n39
+	return i, nil
+}
+
+func (m *InstantiateApplicationWebhookTemplateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InstantiateApplicationWebhookTemplateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.WebhookIDs.Size()))
+	n40, err := m.WebhookIDs.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n40
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.TemplateIDs.Size()))
+	n41, err := m.TemplateIDs.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n41
+	if len(m.Fields) > 0 {
+		for k := range m.Fields {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Fields[k]
+			mapSize := 1 + len(k) + sovApplicationserverWeb(uint64(len(k))) + 1 + len(v) + sovApplicationserverWeb(uint64(len(v)))
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func (m *ListApplicationWebhookDeadLettersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListApplicationWebhookDeadLettersRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.ApplicationWebhookIdentifiers.Size()))
+	n42, err := m.ApplicationWebhookIdentifiers.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n42
+	if m.Limit != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func (m *ReplayApplicationWebhookDeadLettersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayApplicationWebhookDeadLettersRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.ApplicationWebhookIdentifiers.Size()))
+	n43, err := m.ApplicationWebhookIdentifiers.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n43
+	if len(m.DeliveryIDs) > 0 {
+		for _, s := range m.DeliveryIDs {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func encodeVarintApplicationserverWeb(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func NewPopulatedApplicationWebhookIdentifiers(r randyApplicationserverWeb, easy bool) *ApplicationWebhookIdentifiers {
+	this := &ApplicationWebhookIdentifiers{}
+	v1 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v1
+	this.WebhookID = randStringApplicationserverWeb(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhook(r randyApplicationserverWeb, easy bool) *ApplicationWebhook {
+	this := &ApplicationWebhook{}
+	v2 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
+	this.ApplicationWebhookIdentifiers = *v2
+	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v3
+	v4 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.UpdatedAt = *v4
+	this.BaseURL = randStringApplicationserverWeb(r)
+	if r.Intn(10) != 0 {
+		v5 := r.Intn(10)
+		this.Headers = make(map[string]string)
+		for i := 0; i < v5; i++ {
+			this.Headers[randStringApplicationserverWeb(r)] = randStringApplicationserverWeb(r)
+		}
+	}
+	this.Format = randStringApplicationserverWeb(r)
+	if r.Intn(10) != 0 {
+		this.UplinkMessage = NewPopulatedApplicationWebhook_Message(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.JoinAccept = NewPopulatedApplicationWebhook_Message(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.DownlinkAck = NewPopulatedApplicationWebhook_Message(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.DownlinkNack = NewPopulatedApplicationWebhook_Message(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.DownlinkSent = NewPopulatedApplicationWebhook_Message(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.DownlinkFailed = NewPopulatedApplicationWebhook_Message(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.DownlinkQueued = NewPopulatedApplicationWebhook_Message(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.LocationSolved = NewPopulatedApplicationWebhook_Message(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Health = NewPopulatedApplicationWebhookHealth(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.TemplateIDs = NewPopulatedApplicationWebhookTemplateIdentifiers(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Signing = NewPopulatedApplicationWebhookSigning(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.OAuth2ClientCredentials = NewPopulatedApplicationWebhookOAuth2ClientCredentials(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhook_Message(r randyApplicationserverWeb, easy bool) *ApplicationWebhook_Message {
+	this := &ApplicationWebhook_Message{}
+	this.Path = randStringApplicationserverWeb(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookSigning(r randyApplicationserverWeb, easy bool) *ApplicationWebhookSigning {
+	this := &ApplicationWebhookSigning{}
+	this.Secret = randStringApplicationserverWeb(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookOAuth2ClientCredentials(r randyApplicationserverWeb, easy bool) *ApplicationWebhookOAuth2ClientCredentials {
+	this := &ApplicationWebhookOAuth2ClientCredentials{}
+	this.TokenURL = randStringApplicationserverWeb(r)
+	this.ClientID = randStringApplicationserverWeb(r)
+	this.ClientSecret = randStringApplicationserverWeb(r)
+	v6 := r.Intn(10)
+	this.Scopes = make([]string, v6)
+	for i := 0; i < v6; i++ {
+		this.Scopes[i] = randStringApplicationserverWeb(r)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookHealth(r randyApplicationserverWeb, easy bool) *ApplicationWebhookHealth {
+	this := &ApplicationWebhookHealth{}
+	this.FailedAttempts = r.Uint32()
+	if r.Intn(10) != 0 {
+		this.LastFailedAttemptAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.LastFailedAttemptError = randStringApplicationserverWeb(r)
+	if r.Intn(10) != 0 {
+		this.SuspendedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookDelivery(r randyApplicationserverWeb, easy bool) *ApplicationWebhookDelivery {
+	this := &ApplicationWebhookDelivery{}
+	this.ID = randStringApplicationserverWeb(r)
+	v7 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
+	this.WebhookIDs = *v7
+	if r.Intn(10) == 0 {
+		this.Message = NewPopulatedApplicationUp(r, easy)
+	}
+	v8 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v8
+	this.Attempts = r.Uint32()
+	this.LastError = randStringApplicationserverWeb(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookDeliveries(r randyApplicationserverWeb, easy bool) *ApplicationWebhookDeliveries {
+	this := &ApplicationWebhookDeliveries{}
+	if r.Intn(10) == 0 {
+		v9 := r.Intn(5)
+		this.Deliveries = make([]*ApplicationWebhookDelivery, v9)
+		for i := 0; i < v9; i++ {
+			this.Deliveries[i] = NewPopulatedApplicationWebhookDelivery(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhooks(r randyApplicationserverWeb, easy bool) *ApplicationWebhooks {
+	this := &ApplicationWebhooks{}
+	if r.Intn(10) != 0 {
+		v10 := r.Intn(5)
+		this.Webhooks = make([]*ApplicationWebhook, v10)
+		for i := 0; i < v10; i++ {
+			this.Webhooks[i] = NewPopulatedApplicationWebhook(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookFormats(r randyApplicationserverWeb, easy bool) *ApplicationWebhookFormats {
+	this := &ApplicationWebhookFormats{}
+	if r.Intn(10) != 0 {
+		v11 := r.Intn(10)
+		this.Formats = make(map[string]string)
+		for i := 0; i < v11; i++ {
+			this.Formats[randStringApplicationserverWeb(r)] = randStringApplicationserverWeb(r)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookTemplateIdentifiers(r randyApplicationserverWeb, easy bool) *ApplicationWebhookTemplateIdentifiers {
+	this := &ApplicationWebhookTemplateIdentifiers{}
+	this.TemplateID = randStringApplicationserverWeb(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookTemplateField(r randyApplicationserverWeb, easy bool) *ApplicationWebhookTemplateField {
+	this := &ApplicationWebhookTemplateField{}
+	this.ID = randStringApplicationserverWeb(r)
+	this.Name = randStringApplicationserverWeb(r)
+	this.Description = randStringApplicationserverWeb(r)
+	this.Secret = bool(r.Intn(2) == 0)
+	this.DefaultValue = randStringApplicationserverWeb(r)
+	this.Optional = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookTemplate(r randyApplicationserverWeb, easy bool) *ApplicationWebhookTemplate {
+	this := &ApplicationWebhookTemplate{}
+	v12 := NewPopulatedApplicationWebhookTemplateIdentifiers(r, easy)
+	this.ApplicationWebhookTemplateIdentifiers = *v12
+	this.Name = randStringApplicationserverWeb(r)
+	this.Description = randStringApplicationserverWeb(r)
+	this.LogoURL = randStringApplicationserverWeb(r)
+	this.InfoURL = randStringApplicationserverWeb(r)
+	this.DocumentationURL = randStringApplicationserverWeb(r)
+	this.BaseURL = randStringApplicationserverWeb(r)
+	if r.Intn(10) != 0 {
+		v13 := r.Intn(10)
+		this.Headers = make(map[string]string)
+		for i := 0; i < v13; i++ {
+			this.Headers[randStringApplicationserverWeb(r)] = randStringApplicationserverWeb(r)
+		}
+	}
+	this.Format = randStringApplicationserverWeb(r)
+	if r.Intn(10) != 0 {
+		v14 := r.Intn(5)
+		this.Fields = make([]*ApplicationWebhookTemplateField, v14)
+		for i := 0; i < v14; i++ {
+			this.Fields[i] = NewPopulatedApplicationWebhookTemplateField(r, easy)
+		}
+	}
+	if r.Intn(10) != 0 {
+		this.UplinkMessage = NewPopulatedApplicationWebhook_Message(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.JoinAccept = NewPopulatedApplicationWebhook_Message(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.DownlinkAck = NewPopulatedApplicationWebhook_Message(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.DownlinkNack = NewPopulatedApplicationWebhook_Message(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.DownlinkSent = NewPopulatedApplicationWebhook_Message(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.DownlinkFailed = NewPopulatedApplicationWebhook_Message(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.DownlinkQueued = NewPopulatedApplicationWebhook_Message(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.LocationSolved = NewPopulatedApplicationWebhook_Message(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookTemplates(r randyApplicationserverWeb, easy bool) *ApplicationWebhookTemplates {
+	this := &ApplicationWebhookTemplates{}
+	if r.Intn(10) != 0 {
+		v15 := r.Intn(5)
+		this.Templates = make([]*ApplicationWebhookTemplate, v15)
+		for i := 0; i < v15; i++ {
+			this.Templates[i] = NewPopulatedApplicationWebhookTemplate(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetApplicationWebhookRequest(r randyApplicationserverWeb, easy bool) *GetApplicationWebhookRequest {
+	this := &GetApplicationWebhookRequest{}
+	v16 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
+	this.ApplicationWebhookIdentifiers = *v16
+	v17 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v17
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedListApplicationWebhooksRequest(r randyApplicationserverWeb, easy bool) *ListApplicationWebhooksRequest {
+	this := &ListApplicationWebhooksRequest{}
+	v18 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v18
+	v19 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v19
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSetApplicationWebhookRequest(r randyApplicationserverWeb, easy bool) *SetApplicationWebhookRequest {
+	this := &SetApplicationWebhookRequest{}
+	v20 := NewPopulatedApplicationWebhook(r, easy)
+	this.ApplicationWebhook = *v20
+	v21 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v21
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetApplicationWebhookTemplateRequest(r randyApplicationserverWeb, easy bool) *GetApplicationWebhookTemplateRequest {
+	this := &GetApplicationWebhookTemplateRequest{}
+	v22 := NewPopulatedApplicationWebhookTemplateIdentifiers(r, easy)
+	this.ApplicationWebhookTemplateIdentifiers = *v22
+	v23 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v23
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedListApplicationWebhookTemplatesRequest(r randyApplicationserverWeb, easy bool) *ListApplicationWebhookTemplatesRequest {
+	this := &ListApplicationWebhookTemplatesRequest{}
+	v24 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v24
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedInstantiateApplicationWebhookTemplateRequest(r randyApplicationserverWeb, easy bool) *InstantiateApplicationWebhookTemplateRequest {
+	this := &InstantiateApplicationWebhookTemplateRequest{}
+	v25 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
+	this.WebhookIDs = *v25
+	v26 := NewPopulatedApplicationWebhookTemplateIdentifiers(r, easy)
+	this.TemplateIDs = *v26
+	if r.Intn(10) != 0 {
+		v27 := r.Intn(10)
+		this.Fields = make(map[string]string)
+		for i := 0; i < v27; i++ {
+			this.Fields[randStringApplicationserverWeb(r)] = randStringApplicationserverWeb(r)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedListApplicationWebhookDeadLettersRequest(r randyApplicationserverWeb, easy bool) *ListApplicationWebhookDeadLettersRequest {
+	this := &ListApplicationWebhookDeadLettersRequest{}
+	v28 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
+	this.ApplicationWebhookIdentifiers = *v28
+	this.Limit = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedReplayApplicationWebhookDeadLettersRequest(r randyApplicationserverWeb, easy bool) *ReplayApplicationWebhookDeadLettersRequest {
+	this := &ReplayApplicationWebhookDeadLettersRequest{}
+	v29 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
+	this.ApplicationWebhookIdentifiers = *v29
+	v30 := r.Intn(10)
+	this.DeliveryIDs = make([]string, v30)
+	for i := 0; i < v30; i++ {
+		this.DeliveryIDs[i] = randStringApplicationserverWeb(r)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyApplicationserverWeb interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneApplicationserverWeb(r randyApplicationserverWeb) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringApplicationserverWeb(r randyApplicationserverWeb) string {
+	v31 := r.Intn(100)
+	tmps := make([]rune, v31)
+	for i := 0; i < v31; i++ {
+		tmps[i] = randUTF8RuneApplicationserverWeb(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedApplicationserverWeb(r randyApplicationserverWeb, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldApplicationserverWeb(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldApplicationserverWeb(dAtA []byte, r randyApplicationserverWeb, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(key))
+		v32 := r.Int63()
+		if r.Intn(2) == 0 {
+			v32 *= -1
+		}
+		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(v32))
+	case 1:
+		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateApplicationserverWeb(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(v&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *ApplicationWebhookIdentifiers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = len(m.WebhookID)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationWebhook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationWebhookIdentifiers.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = len(m.BaseURL)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApplicationserverWeb(uint64(len(k))) + 1 + len(v) + sovApplicationserverWeb(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApplicationserverWeb(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.UplinkMessage != nil {
+		l = m.UplinkMessage.Size()
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.JoinAccept != nil {
+		l = m.JoinAccept.Size()
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.DownlinkAck != nil {
+		l = m.DownlinkAck.Size()
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.DownlinkNack != nil {
+		l = m.DownlinkNack.Size()
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.DownlinkSent != nil {
+		l = m.DownlinkSent.Size()
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.DownlinkFailed != nil {
+		l = m.DownlinkFailed.Size()
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.DownlinkQueued != nil {
+		l = m.DownlinkQueued.Size()
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.LocationSolved != nil {
+		l = m.LocationSolved.Size()
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.Health != nil {
+		l = m.Health.Size()
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.TemplateIDs != nil {
+		l = m.TemplateIDs.Size()
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.Signing != nil {
+		l = m.Signing.Size()
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.OAuth2ClientCredentials != nil {
+		l = m.OAuth2ClientCredentials.Size()
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationWebhook_Message) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationWebhookSigning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationWebhookOAuth2ClientCredentials) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenURL)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.ClientSecret)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			l = len(s)
+			n += 1 + l + sovApplicationserverWeb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ApplicationWebhookHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FailedAttempts != 0 {
+		n += 1 + sovApplicationserverWeb(uint64(m.FailedAttempts))
+	}
+	if m.LastFailedAttemptAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastFailedAttemptAt)
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.LastFailedAttemptError)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.SuspendedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.SuspendedAt)
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationWebhookDelivery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = m.WebhookIDs.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	if m.Attempts != 0 {
+		n += 1 + sovApplicationserverWeb(uint64(m.Attempts))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationWebhookDeliveries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deliveries) > 0 {
+		for _, e := range m.Deliveries {
+			l = e.Size()
+			n += 1 + l + sovApplicationserverWeb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ApplicationWebhooks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Webhooks) > 0 {
+		for _, e := range m.Webhooks {
+			l = e.Size()
+			n += 1 + l + sovApplicationserverWeb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ApplicationWebhookFormats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Formats) > 0 {
+		for k, v := range m.Formats {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApplicationserverWeb(uint64(len(k))) + 1 + len(v) + sovApplicationserverWeb(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApplicationserverWeb(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ApplicationWebhookTemplateIdentifiers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TemplateID)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationWebhookTemplateField) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.Secret {
+		n += 2
+	}
+	l = len(m.DefaultValue)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.Optional {
+		n += 2
+	}
+	return n
+}
+
+func (m *ApplicationWebhookTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationWebhookTemplateIdentifiers.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.LogoURL)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.InfoURL)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.DocumentationURL)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.BaseURL)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApplicationserverWeb(uint64(len(k))) + 1 + len(v) + sovApplicationserverWeb(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApplicationserverWeb(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovApplicationserverWeb(uint64(l))
+		}
+	}
+	if m.UplinkMessage != nil {
+		l = m.UplinkMessage.Size()
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.JoinAccept != nil {
+		l = m.JoinAccept.Size()
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.DownlinkAck != nil {
+		l = m.DownlinkAck.Size()
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.DownlinkNack != nil {
+		l = m.DownlinkNack.Size()
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.DownlinkSent != nil {
+		l = m.DownlinkSent.Size()
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.DownlinkFailed != nil {
+		l = m.DownlinkFailed.Size()
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.DownlinkQueued != nil {
+		l = m.DownlinkQueued.Size()
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.LocationSolved != nil {
+		l = m.LocationSolved.Size()
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationWebhookTemplates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Templates) > 0 {
+		for _, e := range m.Templates {
+			l = e.Size()
+			n += 1 + l + sovApplicationserverWeb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetApplicationWebhookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationWebhookIdentifiers.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	return n
+}
+
+func (m *ListApplicationWebhooksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	return n
+}
+
+func (m *SetApplicationWebhookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationWebhook.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	return n
+}
+
+func (m *GetApplicationWebhookTemplateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationWebhookTemplateIdentifiers.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	return n
+}
+
+func (m *ListApplicationWebhookTemplatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FieldMask.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	return n
+}
+
+func (m *InstantiateApplicationWebhookTemplateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.WebhookIDs.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = m.TemplateIDs.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	if len(m.Fields) > 0 {
+		for k, v := range m.Fields {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApplicationserverWeb(uint64(len(k))) + 1 + len(v) + sovApplicationserverWeb(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApplicationserverWeb(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ListApplicationWebhookDeadLettersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationWebhookIdentifiers.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	if m.Limit != 0 {
+		n += 1 + sovApplicationserverWeb(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *ReplayApplicationWebhookDeadLettersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationWebhookIdentifiers.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	if len(m.DeliveryIDs) > 0 {
+		for _, s := range m.DeliveryIDs {
+			l = len(s)
+			n += 1 + l + sovApplicationserverWeb(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovApplicationserverWeb(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozApplicationserverWeb(x uint64) (n int) {
+	return sovApplicationserverWeb((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *ApplicationWebhookIdentifiers) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhookIdentifiers{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationIdentifiers.String(), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`WebhookID:` + fmt.Sprintf("%v", this.WebhookID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWebhook) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
+	s := strings.Join([]string{`&ApplicationWebhook{`,
+		`ApplicationWebhookIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationWebhookIdentifiers.String(), "ApplicationWebhookIdentifiers", "ApplicationWebhookIdentifiers", 1), `&`, ``, 1) + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(this.CreatedAt.String(), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`UpdatedAt:` + strings.Replace(strings.Replace(this.UpdatedAt.String(), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`BaseURL:` + fmt.Sprintf("%v", this.BaseURL) + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`UplinkMessage:` + strings.Replace(fmt.Sprintf("%v", this.UplinkMessage), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
+		`JoinAccept:` + strings.Replace(fmt.Sprintf("%v", this.JoinAccept), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
+		`DownlinkAck:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkAck), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
+		`DownlinkNack:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkNack), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
+		`DownlinkSent:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkSent), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
+		`DownlinkFailed:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkFailed), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
+		`DownlinkQueued:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkQueued), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
+		`LocationSolved:` + strings.Replace(fmt.Sprintf("%v", this.LocationSolved), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
+		`Health:` + strings.Replace(fmt.Sprintf("%v", this.Health), "ApplicationWebhookHealth", "ApplicationWebhookHealth", 1) + `,`,
+		`TemplateIDs:` + strings.Replace(fmt.Sprintf("%v", this.TemplateIDs), "ApplicationWebhookTemplateIdentifiers", "ApplicationWebhookTemplateIdentifiers", 1) + `,`,
+		`Signing:` + strings.Replace(fmt.Sprintf("%v", this.Signing), "ApplicationWebhookSigning", "ApplicationWebhookSigning", 1) + `,`,
+		`OAuth2ClientCredentials:` + strings.Replace(fmt.Sprintf("%v", this.OAuth2ClientCredentials), "ApplicationWebhookOAuth2ClientCredentials", "ApplicationWebhookOAuth2ClientCredentials", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWebhook_Message) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhook_Message{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWebhookSigning) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhookSigning{`,
+		`Secret:` + fmt.Sprintf("%v", this.Secret) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWebhookOAuth2ClientCredentials) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhookOAuth2ClientCredentials{`,
+		`TokenURL:` + fmt.Sprintf("%v", this.TokenURL) + `,`,
+		`ClientID:` + fmt.Sprintf("%v", this.ClientID) + `,`,
+		`ClientSecret:` + fmt.Sprintf("%v", this.ClientSecret) + `,`,
+		`Scopes:` + fmt.Sprintf("%v", this.Scopes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWebhookHealth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhookHealth{`,
+		`FailedAttempts:` + fmt.Sprintf("%v", this.FailedAttempts) + `,`,
+		`LastFailedAttemptAt:` + strings.Replace(fmt.Sprintf("%v", this.LastFailedAttemptAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastFailedAttemptError:` + fmt.Sprintf("%v", this.LastFailedAttemptError) + `,`,
+		`SuspendedAt:` + strings.Replace(fmt.Sprintf("%v", this.SuspendedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWebhookDelivery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhookDelivery{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`WebhookIDs:` + strings.Replace(strings.Replace(this.WebhookIDs.String(), "ApplicationWebhookIdentifiers", "ApplicationWebhookIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Message:` + strings.Replace(fmt.Sprintf("%v", this.Message), "ApplicationUp", "ApplicationUp", 1) + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(this.CreatedAt.String(), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Attempts:` + fmt.Sprintf("%v", this.Attempts) + `,`,
+		`LastError:` + fmt.Sprintf("%v", this.LastError) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWebhookDeliveries) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhookDeliveries{`,
+		`Deliveries:` + strings.Replace(fmt.Sprintf("%v", this.Deliveries), "ApplicationWebhookDelivery", "ApplicationWebhookDelivery", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWebhooks) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhooks{`,
+		`Webhooks:` + strings.Replace(fmt.Sprintf("%v", this.Webhooks), "ApplicationWebhook", "ApplicationWebhook", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWebhookFormats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForFormats := make([]string, 0, len(this.Formats))
+	for k := range this.Formats {
+		keysForFormats = append(keysForFormats, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForFormats)
+	mapStringForFormats := "map[string]string{"
+	for _, k := range keysForFormats {
+		mapStringForFormats += fmt.Sprintf("%v: %v,", k, this.Formats[k])
+	}
+	mapStringForFormats += "}"
+	s := strings.Join([]string{`&ApplicationWebhookFormats{`,
+		`Formats:` + mapStringForFormats + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWebhookTemplateIdentifiers) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhookTemplateIdentifiers{`,
+		`TemplateID:` + fmt.Sprintf("%v", this.TemplateID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWebhookTemplateField) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhookTemplateField{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`Secret:` + fmt.Sprintf("%v", this.Secret) + `,`,
+		`DefaultValue:` + fmt.Sprintf("%v", this.DefaultValue) + `,`,
+		`Optional:` + fmt.Sprintf("%v", this.Optional) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWebhookTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
+	s := strings.Join([]string{`&ApplicationWebhookTemplate{`,
+		`ApplicationWebhookTemplateIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationWebhookTemplateIdentifiers.String(), "ApplicationWebhookTemplateIdentifiers", "ApplicationWebhookTemplateIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`LogoURL:` + fmt.Sprintf("%v", this.LogoURL) + `,`,
+		`InfoURL:` + fmt.Sprintf("%v", this.InfoURL) + `,`,
+		`DocumentationURL:` + fmt.Sprintf("%v", this.DocumentationURL) + `,`,
+		`BaseURL:` + fmt.Sprintf("%v", this.BaseURL) + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`Fields:` + strings.Replace(fmt.Sprintf("%v", this.Fields), "ApplicationWebhookTemplateField", "ApplicationWebhookTemplateField", 1) + `,`,
+		`UplinkMessage:` + strings.Replace(fmt.Sprintf("%v", this.UplinkMessage), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
+		`JoinAccept:` + strings.Replace(fmt.Sprintf("%v", this.JoinAccept), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
+		`DownlinkAck:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkAck), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
+		`DownlinkNack:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkNack), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
+		`DownlinkSent:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkSent), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
+		`DownlinkFailed:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkFailed), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
+		`DownlinkQueued:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkQueued), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
+		`LocationSolved:` + strings.Replace(fmt.Sprintf("%v", this.LocationSolved), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWebhookTemplates) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhookTemplates{`,
+		`Templates:` + strings.Replace(fmt.Sprintf("%v", this.Templates), "ApplicationWebhookTemplate", "ApplicationWebhookTemplate", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetApplicationWebhookRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetApplicationWebhookRequest{`,
+		`ApplicationWebhookIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationWebhookIdentifiers.String(), "ApplicationWebhookIdentifiers", "ApplicationWebhookIdentifiers", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(this.FieldMask.String(), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListApplicationWebhooksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListApplicationWebhooksRequest{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationIdentifiers.String(), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(this.FieldMask.String(), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetApplicationWebhookRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetApplicationWebhookRequest{`,
+		`ApplicationWebhook:` + strings.Replace(strings.Replace(this.ApplicationWebhook.String(), "ApplicationWebhook", "ApplicationWebhook", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(this.FieldMask.String(), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetApplicationWebhookTemplateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetApplicationWebhookTemplateRequest{`,
+		`ApplicationWebhookTemplateIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationWebhookTemplateIdentifiers.String(), "ApplicationWebhookTemplateIdentifiers", "ApplicationWebhookTemplateIdentifiers", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(this.FieldMask.String(), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListApplicationWebhookTemplatesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListApplicationWebhookTemplatesRequest{`,
+		`FieldMask:` + strings.Replace(strings.Replace(this.FieldMask.String(), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InstantiateApplicationWebhookTemplateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForFields := make([]string, 0, len(this.Fields))
+	for k := range this.Fields {
+		keysForFields = append(keysForFields, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForFields)
+	mapStringForFields := "map[string]string{"
+	for _, k := range keysForFields {
+		mapStringForFields += fmt.Sprintf("%v: %v,", k, this.Fields[k])
+	}
+	mapStringForFields += "}"
+	s := strings.Join([]string{`&InstantiateApplicationWebhookTemplateRequest{`,
+		`WebhookIDs:` + strings.Replace(strings.Replace(this.WebhookIDs.String(), "ApplicationWebhookIdentifiers", "ApplicationWebhookIdentifiers", 1), `&`, ``, 1) + `,`,
+		`TemplateIDs:` + strings.Replace(strings.Replace(this.TemplateIDs.String(), "ApplicationWebhookTemplateIdentifiers", "ApplicationWebhookTemplateIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Fields:` + mapStringForFields + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListApplicationWebhookDeadLettersRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListApplicationWebhookDeadLettersRequest{`,
+		`ApplicationWebhookIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationWebhookIdentifiers.String(), "ApplicationWebhookIdentifiers", "ApplicationWebhookIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplayApplicationWebhookDeadLettersRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplayApplicationWebhookDeadLettersRequest{`,
+		`ApplicationWebhookIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationWebhookIdentifiers.String(), "ApplicationWebhookIdentifiers", "ApplicationWebhookIdentifiers", 1), `&`, ``, 1) + `,`,
+		`DeliveryIDs:` + fmt.Sprintf("%v", this.DeliveryIDs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringApplicationserverWeb(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
 	return fmt.Sprintf("*%v", pv)
 }
-func (m *ApplicationWebhookIdentifiers) Unmarshal(dAtA []byte) error {
+func (m *ApplicationWebhookIdentifiers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhookIdentifiers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhookIdentifiers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebhookID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationWebhookIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplicationserverWeb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverWeb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverWeb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UplinkMessage == nil {
+				m.UplinkMessage = &ApplicationWebhook_Message{}
+			}
+			if err := m.UplinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinAccept", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JoinAccept == nil {
+				m.JoinAccept = &ApplicationWebhook_Message{}
+			}
+			if err := m.JoinAccept.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkAck", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkAck == nil {
+				m.DownlinkAck = &ApplicationWebhook_Message{}
+			}
+			if err := m.DownlinkAck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkNack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkNack == nil {
+				m.DownlinkNack = &ApplicationWebhook_Message{}
+			}
+			if err := m.DownlinkNack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkSent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkSent == nil {
+				m.DownlinkSent = &ApplicationWebhook_Message{}
+			}
+			if err := m.DownlinkSent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkFailed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkFailed == nil {
+				m.DownlinkFailed = &ApplicationWebhook_Message{}
+			}
+			if err := m.DownlinkFailed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkQueued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkQueued == nil {
+				m.DownlinkQueued = &ApplicationWebhook_Message{}
+			}
+			if err := m.DownlinkQueued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocationSolved", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LocationSolved == nil {
+				m.LocationSolved = &ApplicationWebhook_Message{}
+			}
+			if err := m.LocationSolved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Health == nil {
+				m.Health = &ApplicationWebhookHealth{}
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TemplateIDs == nil {
+				m.TemplateIDs = &ApplicationWebhookTemplateIdentifiers{}
+			}
+			if err := m.TemplateIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signing == nil {
+				m.Signing = &ApplicationWebhookSigning{}
+			}
+			if err := m.Signing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OAuth2ClientCredentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OAuth2ClientCredentials == nil {
+				m.OAuth2ClientCredentials = &ApplicationWebhookOAuth2ClientCredentials{}
+			}
+			if err := m.OAuth2ClientCredentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhook_Message) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhookSigning) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhookSigning: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhookSigning: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhookOAuth2ClientCredentials) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhookOAuth2ClientCredentials: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhookOAuth2ClientCredentials: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSecret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientSecret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhookHealth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhookHealth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhookHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAttempts", wireType)
+			}
+			m.FailedAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedAttempts |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailedAttemptAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastFailedAttemptAt == nil {
+				m.LastFailedAttemptAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastFailedAttemptAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailedAttemptError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastFailedAttemptError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SuspendedAt == nil {
+				m.SuspendedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.SuspendedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhookDelivery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhookDelivery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhookDelivery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WebhookIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &ApplicationUp{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhookDeliveries) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhookDeliveries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhookDeliveries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deliveries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deliveries = append(m.Deliveries, &ApplicationWebhookDelivery{})
+			if err := m.Deliveries[len(m.Deliveries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhooks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhooks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhooks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Webhooks = append(m.Webhooks, &ApplicationWebhook{})
+			if err := m.Webhooks[len(m.Webhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhookFormats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhookFormats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhookFormats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Formats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Formats == nil {
+				m.Formats = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplicationserverWeb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverWeb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverWeb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Formats[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhookTemplateIdentifiers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhookTemplateIdentifiers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhookTemplateIdentifiers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhookTemplateField) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhookTemplateField: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhookTemplateField: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Secret = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Optional", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Optional = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhookTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1883,28 +7236,232 @@ func (m *ApplicationWebhookIdentifiers) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowApplicationserverWeb
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhookTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhookTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookTemplateIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationWebhookTemplateIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InfoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentationURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentationURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationWebhookIdentifiers: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationWebhookIdentifiers: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1928,13 +7485,101 @@ func (m *ApplicationWebhookIdentifiers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplicationserverWeb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverWeb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverWeb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Headers[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WebhookID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1959,61 +7604,141 @@ func (m *ApplicationWebhookIdentifiers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WebhookID = string(dAtA[iNdEx:postIndex])
+			m.Format = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
-			if err != nil {
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, &ApplicationWebhookTemplateField{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UplinkMessage == nil {
+				m.UplinkMessage = &ApplicationWebhook_Message{}
+			}
+			if err := m.UplinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinAccept", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JoinAccept == nil {
+				m.JoinAccept = &ApplicationWebhook_Message{}
+			}
+			if err := m.JoinAccept.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkAck", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplicationserverWeb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.DownlinkAck == nil {
+				m.DownlinkAck = &ApplicationWebhook_Message{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.DownlinkAck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationWebhook: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationWebhook: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkNack", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2037,13 +7762,16 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationWebhookIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DownlinkNack == nil {
+				m.DownlinkNack = &ApplicationWebhook_Message{}
+			}
+			if err := m.DownlinkNack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkSent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2067,13 +7795,16 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if m.DownlinkSent == nil {
+				m.DownlinkSent = &ApplicationWebhook_Message{}
+			}
+			if err := m.DownlinkSent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkFailed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2097,15 +7828,18 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if m.DownlinkFailed == nil {
+				m.DownlinkFailed = &ApplicationWebhook_Message{}
+			}
+			if err := m.DownlinkFailed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkQueued", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverWeb
@@ -2115,24 +7849,28 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseURL = string(dAtA[iNdEx:postIndex])
+			if m.DownlinkQueued == nil {
+				m.DownlinkQueued = &ApplicationWebhook_Message{}
+			}
+			if err := m.DownlinkQueued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocationSolved", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2156,103 +7894,68 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = make(map[string]string)
+			if m.LocationSolved == nil {
+				m.LocationSolved = &ApplicationWebhook_Message{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApplicationserverWeb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApplicationserverWeb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApplicationserverWeb
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApplicationserverWeb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthApplicationserverWeb
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthApplicationserverWeb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.LocationSolved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Headers[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhookTemplates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhookTemplates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhookTemplates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverWeb
@@ -2262,24 +7965,76 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Templates = append(m.Templates, &ApplicationWebhookTemplate{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Format = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetApplicationWebhookRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetApplicationWebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2303,16 +8058,13 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UplinkMessage == nil {
-				m.UplinkMessage = &ApplicationWebhook_Message{}
-			}
-			if err := m.UplinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationWebhookIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinAccept", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2336,16 +8088,63 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JoinAccept == nil {
-				m.JoinAccept = &ApplicationWebhook_Message{}
-			}
-			if err := m.JoinAccept.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListApplicationWebhooksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListApplicationWebhooksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListApplicationWebhooksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2369,16 +8168,13 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkAck == nil {
-				m.DownlinkAck = &ApplicationWebhook_Message{}
-			}
-			if err := m.DownlinkAck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkNack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2402,16 +8198,63 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkNack == nil {
-				m.DownlinkNack = &ApplicationWebhook_Message{}
-			}
-			if err := m.DownlinkNack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetApplicationWebhookRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetApplicationWebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkSent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhook", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2435,16 +8278,13 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkSent == nil {
-				m.DownlinkSent = &ApplicationWebhook_Message{}
-			}
-			if err := m.DownlinkSent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationWebhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkFailed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2468,16 +8308,63 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkFailed == nil {
-				m.DownlinkFailed = &ApplicationWebhook_Message{}
-			}
-			if err := m.DownlinkFailed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetApplicationWebhookTemplateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetApplicationWebhookTemplateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetApplicationWebhookTemplateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkQueued", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookTemplateIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2501,16 +8388,13 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkQueued == nil {
-				m.DownlinkQueued = &ApplicationWebhook_Message{}
-			}
-			if err := m.DownlinkQueued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationWebhookTemplateIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocationSolved", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2534,10 +8418,7 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LocationSolved == nil {
-				m.LocationSolved = &ApplicationWebhook_Message{}
-			}
-			if err := m.LocationSolved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2562,7 +8443,7 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationWebhook_Message) Unmarshal(dAtA []byte) error {
+func (m *ListApplicationWebhookTemplatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2585,17 +8466,17 @@ func (m *ApplicationWebhook_Message) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListApplicationWebhookTemplatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListApplicationWebhookTemplatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverWeb
@@ -2605,20 +8486,21 @@ func (m *ApplicationWebhook_Message) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2641,7 +8523,7 @@ func (m *ApplicationWebhook_Message) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationWebhooks) Unmarshal(dAtA []byte) error {
+func (m *InstantiateApplicationWebhookTemplateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2664,15 +8546,15 @@ func (m *ApplicationWebhooks) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationWebhooks: wiretype end group for non-group")
+			return fmt.Errorf("proto: InstantiateApplicationWebhookTemplateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationWebhooks: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InstantiateApplicationWebhookTemplateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhooks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookIDs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2696,64 +8578,43 @@ func (m *ApplicationWebhooks) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Webhooks = append(m.Webhooks, &ApplicationWebhook{})
-			if err := m.Webhooks[len(m.Webhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WebhookIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApplicationserverWeb
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateIDs", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationWebhookFormats) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplicationserverWeb
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TemplateIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationWebhookFormats: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationWebhookFormats: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Formats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2777,8 +8638,8 @@ func (m *ApplicationWebhookFormats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Formats == nil {
-				m.Formats = make(map[string]string)
+			if m.Fields == nil {
+				m.Fields = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -2867,7 +8728,7 @@ func (m *ApplicationWebhookFormats) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Formats[mapkey] = mapvalue
+			m.Fields[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2890,7 +8751,7 @@ func (m *ApplicationWebhookFormats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
+func (m *ListApplicationWebhookDeadLettersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2913,10 +8774,10 @@ func (m *GetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetApplicationWebhookRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListApplicationWebhookDeadLettersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetApplicationWebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListApplicationWebhookDeadLettersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2950,120 +8811,10 @@ func (m *GetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplicationserverWeb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListApplicationWebhooksRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplicationserverWeb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListApplicationWebhooksRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListApplicationWebhooksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplicationserverWeb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var msglen int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverWeb
@@ -3073,22 +8824,11 @@ func (m *ListApplicationWebhooksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Limit |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
@@ -3110,7 +8850,7 @@ func (m *ListApplicationWebhooksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
+func (m *ReplayApplicationWebhookDeadLettersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3133,15 +8873,15 @@ func (m *SetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetApplicationWebhookRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplayApplicationWebhookDeadLettersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetApplicationWebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplayApplicationWebhookDeadLettersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3165,15 +8905,15 @@ func (m *SetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationWebhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationWebhookIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryIDs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverWeb
@@ -3183,21 +8923,20 @@ func (m *SetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DeliveryIDs = append(m.DeliveryIDs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3326,86 +9065,156 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("lorawan-stack/api/applicationserver_web.proto", fileDescriptor_applicationserver_web_cae7911b27bddc2a)
+	proto.RegisterFile("lorawan-stack/api/applicationserver_web.proto", fileDescriptor_applicationserver_web_bbcb93b1afd2aa47)
 }
 func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/applicationserver_web.proto", fileDescriptor_applicationserver_web_cae7911b27bddc2a)
-}
-
-var fileDescriptor_applicationserver_web_cae7911b27bddc2a = []byte{
-	// 1179 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0x41, 0x6c, 0xd3, 0x56,
-	0x1c, 0xc6, 0xfd, 0x48, 0x69, 0x9a, 0x17, 0x28, 0xd3, 0x63, 0x43, 0x5e, 0x80, 0x97, 0xca, 0x6c,
-	0xa8, 0x20, 0x62, 0x4f, 0x45, 0x42, 0x5b, 0x35, 0x0d, 0x35, 0x83, 0x76, 0xd5, 0x60, 0x0c, 0x67,
-	0x08, 0x6d, 0x88, 0x45, 0x2f, 0xf1, 0x4b, 0x62, 0xe2, 0xd8, 0xc6, 0xef, 0xa5, 0x19, 0x43, 0x48,
-	0x68, 0x27, 0x8e, 0x48, 0xbb, 0xec, 0x36, 0xb4, 0xcb, 0xd8, 0x4e, 0x1c, 0x39, 0xec, 0x80, 0xb4,
-	0x4b, 0x4f, 0x53, 0xa5, 0x5d, 0x38, 0xb5, 0xc4, 0xd9, 0x81, 0x23, 0x47, 0x8e, 0x93, 0xed, 0xe7,
-	0xd4, 0x4d, 0x4a, 0xdb, 0xb4, 0xdb, 0xa9, 0x7e, 0x79, 0xff, 0xef, 0xf7, 0xbe, 0xf7, 0xe5, 0xff,
-	0x5e, 0x5c, 0x58, 0xb0, 0x1c, 0x8f, 0x74, 0x88, 0x5d, 0x60, 0x9c, 0x54, 0x9b, 0x1a, 0x71, 0x4d,
-	0x8d, 0xb8, 0xae, 0x65, 0x56, 0x09, 0x37, 0x1d, 0x9b, 0x51, 0x6f, 0x89, 0x7a, 0xe5, 0x0e, 0xad,
-	0xa8, 0xae, 0xe7, 0x70, 0x07, 0x4d, 0x72, 0x6e, 0xab, 0x42, 0xa2, 0x2e, 0x9d, 0xcd, 0x15, 0xea,
-	0x26, 0x6f, 0xb4, 0x2b, 0x6a, 0xd5, 0x69, 0x69, 0x75, 0xa7, 0xee, 0x68, 0x61, 0x59, 0xa5, 0x5d,
-	0x0b, 0x47, 0xe1, 0x20, 0x7c, 0x8a, 0xe4, 0xb9, 0x73, 0x89, 0xf2, 0x56, 0xc7, 0xe4, 0x4d, 0xa7,
-	0xa3, 0xd5, 0x9d, 0x42, 0x38, 0x59, 0x58, 0x22, 0x96, 0x69, 0x10, 0xee, 0x78, 0x4c, 0xeb, 0x3f,
-	0x0a, 0xdd, 0xb1, 0xba, 0xe3, 0xd4, 0x2d, 0x1a, 0xd9, 0xb3, 0x6d, 0x87, 0x47, 0xee, 0xc4, 0xec,
-	0x51, 0x31, 0xdb, 0x5f, 0x9b, 0xb6, 0x5c, 0x7e, 0x47, 0x4c, 0x4e, 0x0d, 0x4e, 0xd6, 0x4c, 0x6a,
-	0x19, 0xe5, 0x16, 0x61, 0x4d, 0x51, 0x91, 0x1f, 0xac, 0xe0, 0x66, 0x8b, 0x32, 0x4e, 0x5a, 0xae,
-	0x28, 0x38, 0x31, 0x9c, 0x91, 0x69, 0x50, 0x9b, 0x9b, 0x35, 0x93, 0x7a, 0xc2, 0x84, 0xf2, 0x17,
-	0x80, 0xc7, 0xe7, 0xd6, 0x93, 0xbb, 0x4e, 0x2b, 0x0d, 0xc7, 0x69, 0x2e, 0xae, 0xd7, 0xa1, 0xaf,
-	0xe1, 0xa1, 0x44, 0xb4, 0x65, 0xd3, 0x60, 0x32, 0x98, 0x02, 0xd3, 0xd9, 0x99, 0x93, 0xea, 0xc6,
-	0x54, 0xd5, 0x04, 0x27, 0x01, 0x28, 0x4e, 0x2c, 0xaf, 0xe6, 0xa5, 0x95, 0xd5, 0x3c, 0xd0, 0x27,
-	0x49, 0xb2, 0x82, 0x21, 0x1d, 0xc2, 0x4e, 0xb4, 0x60, 0xd9, 0x34, 0xe4, 0x7d, 0x53, 0x60, 0x3a,
-	0x53, 0x3c, 0xeb, 0xaf, 0xe6, 0x33, 0xb1, 0x8d, 0x0b, 0xfe, 0x5a, 0x5e, 0x81, 0xf8, 0xdb, 0x1b,
-	0xa4, 0xf0, 0xfd, 0x07, 0x85, 0x8f, 0x6e, 0x4e, 0x9f, 0x9f, 0xbd, 0x51, 0xb8, 0x79, 0x3e, 0x1e,
-	0x9e, 0xba, 0x3b, 0x73, 0xe6, 0xde, 0x7b, 0xdf, 0xbd, 0xaf, 0x67, 0x3a, 0xb1, 0x6f, 0x65, 0x6d,
-	0x02, 0xa2, 0xe1, 0x0d, 0xa1, 0x45, 0x98, 0x5a, 0x77, 0x5e, 0xd8, 0xc2, 0xf9, 0x70, 0x02, 0x89,
-	0x0d, 0x04, 0x0c, 0xf4, 0x29, 0x84, 0x55, 0x8f, 0x12, 0x4e, 0x8d, 0x32, 0xe1, 0xa1, 0xeb, 0xec,
-	0x4c, 0x4e, 0x8d, 0xbe, 0x0d, 0x35, 0xfe, 0x36, 0xd4, 0xaf, 0xe2, 0x6f, 0x23, 0x92, 0x3f, 0x5c,
-	0xcb, 0x03, 0x3d, 0x23, 0x74, 0x73, 0x3c, 0x80, 0xb4, 0x5d, 0x23, 0x86, 0xa4, 0x46, 0x81, 0x08,
-	0xdd, 0x1c, 0x47, 0x27, 0xe1, 0x44, 0x85, 0x30, 0x5a, 0x6e, 0x7b, 0x96, 0x3c, 0x16, 0xa6, 0x97,
-	0xf5, 0x57, 0xf3, 0xe9, 0x22, 0x61, 0xf4, 0x9a, 0x7e, 0x49, 0x4f, 0x07, 0x93, 0xd7, 0x3c, 0x0b,
-	0x2d, 0xc2, 0x74, 0x83, 0x12, 0x83, 0x7a, 0x4c, 0xde, 0x3f, 0x95, 0x9a, 0xce, 0xce, 0x68, 0xdb,
-	0x07, 0xa0, 0x7e, 0x16, 0x29, 0x2e, 0xda, 0xdc, 0xbb, 0xa3, 0xc7, 0x7a, 0x74, 0x04, 0x8e, 0xd7,
-	0x1c, 0xaf, 0x45, 0xb8, 0x3c, 0x1e, 0x2c, 0xa8, 0x8b, 0x11, 0xba, 0x0a, 0x27, 0xdb, 0xae, 0x65,
-	0xda, 0xcd, 0x72, 0x8b, 0x32, 0x46, 0xea, 0x54, 0x4e, 0x87, 0x7b, 0x3a, 0xbd, 0x83, 0x95, 0x2e,
-	0x47, 0x0a, 0xfd, 0x60, 0x44, 0x10, 0x43, 0xf4, 0x39, 0xcc, 0xde, 0x72, 0x4c, 0xbb, 0x4c, 0xaa,
-	0x55, 0xea, 0x72, 0x79, 0x62, 0x64, 0x1e, 0x0c, 0xe4, 0x73, 0xa1, 0x1a, 0x5d, 0x86, 0x07, 0x0c,
-	0xa7, 0x63, 0x87, 0x0e, 0x49, 0xb5, 0x29, 0x67, 0x46, 0xa6, 0x65, 0x63, 0xfd, 0x5c, 0xb5, 0x89,
-	0xae, 0xc0, 0x83, 0x7d, 0x9c, 0x1d, 0xf0, 0xe0, 0xc8, 0xbc, 0xbe, 0x9f, 0x2f, 0xc8, 0x00, 0x90,
-	0x51, 0x9b, 0xcb, 0xd9, 0xdd, 0x03, 0x4b, 0xd4, 0xe6, 0xa8, 0x04, 0x0f, 0xf5, 0x81, 0x35, 0x62,
-	0x5a, 0xd4, 0x90, 0x0f, 0x8c, 0x8c, 0x9c, 0x8c, 0x11, 0xf3, 0x21, 0x61, 0x03, 0xf4, 0x76, 0x9b,
-	0xb6, 0xa9, 0x21, 0x1f, 0xdc, 0x3d, 0xf4, 0x6a, 0x48, 0x08, 0xa0, 0x96, 0x23, 0x6e, 0x17, 0xe6,
-	0x58, 0x4b, 0xd4, 0x90, 0x27, 0x47, 0x87, 0xc6, 0x88, 0x52, 0x48, 0xc8, 0xcd, 0xc2, 0x03, 0xc9,
-	0x06, 0x46, 0x6f, 0xc1, 0x54, 0x93, 0xde, 0x09, 0xcf, 0x7f, 0x46, 0x0f, 0x1e, 0xd1, 0xdb, 0x70,
-	0xff, 0x12, 0xb1, 0xda, 0x34, 0xba, 0x77, 0xf4, 0x68, 0x30, 0xbb, 0xef, 0x43, 0x90, 0x3b, 0x0e,
-	0xd3, 0x71, 0x0f, 0x22, 0x38, 0xe6, 0x12, 0xde, 0x10, 0xba, 0xf0, 0x59, 0xb9, 0x06, 0x0f, 0x0f,
-	0x1b, 0x61, 0xe8, 0x13, 0x38, 0x21, 0x6e, 0xa1, 0xe0, 0x9a, 0x09, 0x4e, 0x99, 0xb2, 0xbd, 0x7f,
-	0xbd, 0xaf, 0x51, 0x7e, 0x03, 0xf0, 0xdd, 0xe1, 0x82, 0xf9, 0xf0, 0x78, 0x31, 0xf4, 0x25, 0x4c,
-	0x47, 0x27, 0x2d, 0x86, 0x9f, 0xdb, 0x1e, 0x2e, 0xb4, 0xaa, 0xf8, 0x2b, 0x4e, 0xb2, 0xc0, 0x04,
-	0x09, 0x25, 0x27, 0x46, 0x49, 0x48, 0xf9, 0x1d, 0xc0, 0x63, 0x0b, 0x94, 0x6f, 0xb2, 0x1f, 0x7a,
-	0xbb, 0x4d, 0x19, 0xff, 0x2f, 0xaf, 0xdb, 0xf3, 0x10, 0xae, 0xff, 0xf6, 0xbd, 0xf1, 0xba, 0x9d,
-	0x0f, 0x4a, 0x2e, 0x13, 0xd6, 0x2c, 0x8e, 0x05, 0x72, 0x3d, 0x53, 0x8b, 0x3f, 0x50, 0xfe, 0x00,
-	0x10, 0x5f, 0x32, 0xd9, 0x26, 0x6e, 0x59, 0x6c, 0xf7, 0x7f, 0xfc, 0x8d, 0xdb, 0xb3, 0xfd, 0x5f,
-	0x01, 0x3c, 0x56, 0xda, 0x2a, 0xeb, 0x79, 0x98, 0x16, 0x4d, 0x24, 0x4c, 0xef, 0xa0, 0xef, 0x12,
-	0x86, 0x63, 0xf1, 0x9e, 0x9d, 0xce, 0x2c, 0x8f, 0xc3, 0xdc, 0x66, 0x36, 0xeb, 0x26, 0x0b, 0x1a,
-	0xcc, 0x82, 0x70, 0x81, 0xf2, 0xb8, 0xa1, 0x8f, 0x0c, 0x91, 0x2f, 0x06, 0xaf, 0x3f, 0xb9, 0x53,
-	0x3b, 0xee, 0x6b, 0xe5, 0xe8, 0x0f, 0x7f, 0xff, 0xf3, 0xe3, 0xbe, 0x77, 0xd0, 0x61, 0x8d, 0x30,
-	0x4d, 0xec, 0xa2, 0x20, 0xda, 0x1b, 0x3d, 0x01, 0x30, 0xb5, 0x40, 0x39, 0x3a, 0x33, 0xc8, 0xdb,
-	0xaa, 0x6f, 0x73, 0x3b, 0x88, 0x4e, 0xb9, 0x1e, 0x2e, 0x7b, 0x15, 0x5d, 0x09, 0x96, 0x4d, 0xbe,
-	0x75, 0x6a, 0x77, 0x4d, 0x83, 0xa9, 0x03, 0x8d, 0x34, 0x30, 0xbe, 0x17, 0x1b, 0x15, 0xd5, 0xeb,
-	0xef, 0x3f, 0xf7, 0xd0, 0xcf, 0x00, 0x8e, 0x05, 0x8d, 0x8a, 0xd4, 0x41, 0x17, 0x5b, 0xb7, 0x6f,
-	0xee, 0xc4, 0xf6, 0xae, 0x99, 0x52, 0x0c, 0x6d, 0x7f, 0x8c, 0x66, 0x87, 0x6d, 0xef, 0xd4, 0x32,
-	0xfa, 0x13, 0xc0, 0x54, 0x69, 0xb3, 0x50, 0x4b, 0x7b, 0x0d, 0xf5, 0x56, 0xe8, 0xce, 0x50, 0xca,
-	0xc3, 0xee, 0xc4, 0xea, 0xea, 0x68, 0xe1, 0x26, 0x55, 0x89, 0x90, 0x67, 0xc1, 0x69, 0xf4, 0x08,
-	0xc0, 0xf1, 0x0b, 0xd4, 0xa2, 0x9c, 0xa2, 0xd1, 0xae, 0xa6, 0xdc, 0x1b, 0x9a, 0x56, 0xb9, 0x12,
-	0xba, 0x5f, 0x3c, 0xbd, 0xb0, 0xfb, 0x6c, 0xfb, 0x8e, 0x83, 0x4f, 0x8b, 0xbf, 0x80, 0xe5, 0x2e,
-	0x06, 0x2b, 0x5d, 0x0c, 0x9e, 0x77, 0xb1, 0xf4, 0xa2, 0x8b, 0xa5, 0x97, 0x5d, 0x2c, 0xbd, 0xea,
-	0x62, 0xe9, 0x75, 0x17, 0x83, 0xfb, 0x3e, 0x06, 0x0f, 0x7c, 0x2c, 0x3d, 0xf6, 0x31, 0x78, 0xe2,
-	0x63, 0xe9, 0xa9, 0x8f, 0xa5, 0x67, 0x3e, 0x96, 0x96, 0x7d, 0x0c, 0x56, 0x7c, 0x0c, 0x9e, 0xfb,
-	0x58, 0x7a, 0xe1, 0x63, 0xf0, 0xd2, 0xc7, 0xd2, 0x2b, 0x1f, 0x83, 0xd7, 0x3e, 0x96, 0xee, 0xf7,
-	0xb0, 0xf4, 0xa0, 0x87, 0xc1, 0xc3, 0x1e, 0x96, 0x7e, 0xea, 0x61, 0xf0, 0xa8, 0x87, 0xa5, 0xc7,
-	0x3d, 0x2c, 0x3d, 0xe9, 0x61, 0xf0, 0xb4, 0x87, 0xc1, 0xb3, 0x1e, 0x06, 0xdf, 0x9c, 0xa9, 0x3b,
-	0x2a, 0x6f, 0x50, 0xde, 0x30, 0xed, 0x3a, 0x53, 0x6d, 0xca, 0x3b, 0x8e, 0xd7, 0xd4, 0x36, 0xfe,
-	0x1f, 0xe1, 0x36, 0xeb, 0x1a, 0xe7, 0xb6, 0x5b, 0xa9, 0x8c, 0x87, 0x29, 0x9c, 0xfd, 0x37, 0x00,
-	0x00, 0xff, 0xff, 0xeb, 0x39, 0xe7, 0x6a, 0x8d, 0x0d, 0x00, 0x00,
+	golang_proto.RegisterFile("lorawan-stack/api/applicationserver_web.proto", fileDescriptor_applicationserver_web_bbcb93b1afd2aa47)
+}
+
+var fileDescriptor_applicationserver_web_bbcb93b1afd2aa47 = []byte{
+	// 2302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x4b, 0x6c, 0x1b, 0xc7,
+	0x19, 0xe6, 0xe8, 0x49, 0xfe, 0xd4, 0xc3, 0x1e, 0x3b, 0x36, 0x4d, 0xdb, 0x4b, 0x75, 0x9d, 0x38,
+	0x92, 0x6b, 0x92, 0x81, 0x9c, 0x26, 0xb1, 0x90, 0xc6, 0x95, 0x2c, 0x5b, 0x56, 0x6b, 0xd7, 0xf5,
+	0xca, 0x6a, 0xda, 0xa6, 0x09, 0xbb, 0xe2, 0x8e, 0xa8, 0x35, 0x97, 0xbb, 0xcc, 0xce, 0x50, 0x8a,
+	0x6a, 0xb8, 0x48, 0x7b, 0x0a, 0xd0, 0x4b, 0xd0, 0x5c, 0x8a, 0x1e, 0xda, 0xa0, 0x97, 0xa6, 0x8f,
+	0x83, 0x8f, 0x41, 0xd1, 0x43, 0x80, 0xf6, 0xe0, 0x53, 0xe1, 0xa2, 0x87, 0xe6, 0x24, 0x59, 0xab,
+	0xa2, 0xc8, 0xa1, 0x40, 0x73, 0xcc, 0xb1, 0x98, 0xd9, 0xd9, 0xe5, 0x8a, 0x0f, 0x8b, 0xd4, 0x23,
+	0x27, 0x73, 0x66, 0xfe, 0xff, 0x9b, 0x6f, 0xfe, 0xf9, 0xe7, 0x7f, 0xac, 0x05, 0x59, 0xcb, 0x71,
+	0xf5, 0x35, 0xdd, 0xce, 0x52, 0xa6, 0x17, 0xcb, 0x79, 0xbd, 0x6a, 0xe6, 0xf5, 0x6a, 0xd5, 0x32,
+	0x8b, 0x3a, 0x33, 0x1d, 0x9b, 0x12, 0x77, 0x95, 0xb8, 0x85, 0x35, 0xb2, 0x94, 0xab, 0xba, 0x0e,
+	0x73, 0xf0, 0x08, 0x63, 0x76, 0x4e, 0xaa, 0xe4, 0x56, 0x2f, 0xa5, 0xb3, 0x25, 0x93, 0xad, 0xd4,
+	0x96, 0x72, 0x45, 0xa7, 0x92, 0x2f, 0x39, 0x25, 0x27, 0x2f, 0xc4, 0x96, 0x6a, 0xcb, 0x62, 0x24,
+	0x06, 0xe2, 0x97, 0xaf, 0x9e, 0x7e, 0x29, 0x22, 0x5e, 0x59, 0x33, 0x59, 0xd9, 0x59, 0xcb, 0x97,
+	0x9c, 0xac, 0x58, 0xcc, 0xae, 0xea, 0x96, 0x69, 0xe8, 0xcc, 0x71, 0x69, 0x3e, 0xfc, 0x29, 0xf5,
+	0xce, 0x94, 0x1c, 0xa7, 0x64, 0x11, 0x9f, 0x9e, 0x6d, 0x3b, 0xcc, 0x67, 0x27, 0x57, 0x4f, 0xcb,
+	0xd5, 0x70, 0x6f, 0x52, 0xa9, 0xb2, 0x75, 0xb9, 0x38, 0xd6, 0xb8, 0xb8, 0x6c, 0x12, 0xcb, 0x28,
+	0x54, 0x74, 0x5a, 0x96, 0x12, 0x99, 0x46, 0x09, 0x66, 0x56, 0x08, 0x65, 0x7a, 0xa5, 0x2a, 0x05,
+	0xce, 0x35, 0xdb, 0xc8, 0x34, 0x88, 0xcd, 0xcc, 0x65, 0x93, 0xb8, 0x01, 0x89, 0xb1, 0x66, 0xa1,
+	0x0a, 0xa1, 0x54, 0x2f, 0x11, 0x29, 0xa1, 0xfe, 0x1d, 0xc1, 0xd9, 0xe9, 0xba, 0x6d, 0x5f, 0x27,
+	0x4b, 0x2b, 0x8e, 0x53, 0x9e, 0xaf, 0x23, 0xe1, 0xef, 0xc3, 0x68, 0xc4, 0xf8, 0x05, 0xd3, 0xa0,
+	0x29, 0x34, 0x86, 0xc6, 0x93, 0x93, 0xe7, 0x73, 0x3b, 0xed, 0x9e, 0x8b, 0xe0, 0x44, 0x00, 0x66,
+	0xe2, 0x8f, 0x36, 0x32, 0xb1, 0xc7, 0x1b, 0x19, 0xa4, 0x8d, 0xe8, 0x51, 0x09, 0x8a, 0x35, 0x80,
+	0x35, 0x7f, 0xc3, 0x82, 0x69, 0xa4, 0x7a, 0xc6, 0xd0, 0x78, 0x62, 0xe6, 0x92, 0xb7, 0x91, 0x49,
+	0x04, 0x34, 0x66, 0xbd, 0xcd, 0x8c, 0x0a, 0xca, 0x5b, 0x6f, 0xe8, 0xd9, 0x1f, 0xbf, 0x90, 0xbd,
+	0xfc, 0xe6, 0xf8, 0x95, 0xa9, 0x37, 0xb2, 0x6f, 0x5e, 0x09, 0x86, 0x13, 0xf7, 0x27, 0x2f, 0x3e,
+	0x78, 0xf6, 0x9d, 0xe7, 0xb4, 0xc4, 0x5a, 0xc0, 0x5b, 0xfd, 0x4f, 0x12, 0x70, 0xf3, 0x81, 0xf0,
+	0x3c, 0xf4, 0xd6, 0x99, 0x67, 0x9f, 0xc2, 0xbc, 0xd9, 0x02, 0x91, 0x03, 0x70, 0x0c, 0x7c, 0x15,
+	0xa0, 0xe8, 0x12, 0x9d, 0x11, 0xa3, 0xa0, 0x33, 0xc1, 0x3a, 0x39, 0x99, 0xce, 0xf9, 0xf7, 0x95,
+	0x0b, 0xee, 0x2b, 0x77, 0x37, 0xb8, 0x2f, 0x5f, 0xfd, 0xfd, 0xcd, 0x0c, 0xd2, 0x12, 0x52, 0x6f,
+	0x9a, 0x71, 0x90, 0x5a, 0xd5, 0x08, 0x40, 0x7a, 0xbb, 0x01, 0x91, 0x7a, 0xd3, 0x0c, 0x9f, 0x87,
+	0xf8, 0x92, 0x4e, 0x49, 0xa1, 0xe6, 0x5a, 0xa9, 0x3e, 0x61, 0xbd, 0xa4, 0xb7, 0x91, 0x19, 0x9c,
+	0xd1, 0x29, 0x59, 0xd4, 0x6e, 0x6a, 0x83, 0x7c, 0x71, 0xd1, 0xb5, 0xf0, 0x3c, 0x0c, 0xae, 0x10,
+	0xdd, 0x20, 0x2e, 0x4d, 0xf5, 0x8f, 0xf5, 0x8e, 0x27, 0x27, 0xf3, 0xbb, 0x1b, 0x20, 0x77, 0xc3,
+	0xd7, 0xb8, 0x66, 0x33, 0x77, 0x5d, 0x0b, 0xf4, 0xf1, 0x09, 0x18, 0x58, 0x76, 0xdc, 0x8a, 0xce,
+	0x52, 0x03, 0x7c, 0x43, 0x4d, 0x8e, 0xf0, 0x1d, 0x18, 0xa9, 0x55, 0x2d, 0xd3, 0x2e, 0x17, 0xa4,
+	0x83, 0xa5, 0x06, 0xc5, 0x99, 0x2e, 0x74, 0xb0, 0xd3, 0x2d, 0x5f, 0x43, 0x1b, 0xf6, 0x11, 0xe4,
+	0x10, 0x7f, 0x0b, 0x92, 0xf7, 0x1c, 0xd3, 0x2e, 0xe8, 0xc5, 0x22, 0xa9, 0xb2, 0x54, 0xbc, 0x6b,
+	0x3c, 0xe0, 0xea, 0xd3, 0x42, 0x1b, 0xdf, 0x82, 0x21, 0xc3, 0x59, 0xb3, 0x05, 0x43, 0xbd, 0x58,
+	0x4e, 0x25, 0xba, 0x46, 0x4b, 0x06, 0xfa, 0xd3, 0xc5, 0x32, 0xbe, 0x0d, 0xc3, 0x21, 0x9c, 0xcd,
+	0xf1, 0xa0, 0x6b, 0xbc, 0x90, 0xcf, 0xb7, 0xf5, 0x06, 0x40, 0x4a, 0x6c, 0x96, 0x4a, 0xee, 0x1d,
+	0x70, 0x81, 0xd8, 0x0c, 0x2f, 0xc0, 0x68, 0x08, 0xb8, 0xac, 0x9b, 0x16, 0x31, 0x52, 0x43, 0x5d,
+	0x43, 0x8e, 0x04, 0x10, 0xd7, 0x05, 0xc2, 0x0e, 0xd0, 0xb7, 0x6b, 0xa4, 0x46, 0x8c, 0xd4, 0xf0,
+	0xde, 0x41, 0xef, 0x08, 0x04, 0x0e, 0x6a, 0x39, 0x32, 0xba, 0x50, 0xc7, 0x5a, 0x25, 0x46, 0x6a,
+	0xa4, 0x7b, 0xd0, 0x00, 0x62, 0x41, 0x20, 0xe0, 0x6f, 0xc0, 0xc0, 0x0a, 0xd1, 0x2d, 0xb6, 0x92,
+	0x1a, 0x15, 0x58, 0xe3, 0xbb, 0x63, 0xdd, 0x10, 0xf2, 0x9a, 0xd4, 0xc3, 0x26, 0x0c, 0x31, 0x52,
+	0xa9, 0x5a, 0x3a, 0x23, 0x22, 0xe8, 0x1d, 0x11, 0x38, 0x5f, 0xdb, 0x1d, 0xe7, 0xae, 0xd4, 0x8a,
+	0x86, 0x90, 0x51, 0x6f, 0x23, 0x93, 0x0c, 0x17, 0x66, 0xa9, 0x96, 0x64, 0xa1, 0x14, 0x8f, 0x28,
+	0x83, 0xd4, 0x2c, 0xd9, 0xa6, 0x5d, 0x4a, 0x1d, 0x15, 0xbb, 0x4c, 0xec, 0xbe, 0xcb, 0x82, 0xaf,
+	0xa0, 0x05, 0x9a, 0xf8, 0x17, 0x08, 0x4e, 0x39, 0x7a, 0x8d, 0xad, 0x4c, 0x16, 0x8a, 0x96, 0x49,
+	0x6c, 0x56, 0x28, 0xba, 0x44, 0x50, 0xd0, 0x2d, 0x9a, 0xc2, 0x02, 0xf7, 0xf2, 0xee, 0xb8, 0xb7,
+	0xa7, 0x39, 0xc4, 0x55, 0x81, 0x70, 0xb5, 0x0e, 0x30, 0x73, 0xda, 0xdb, 0xc8, 0x9c, 0x6c, 0xb3,
+	0xa8, 0x9d, 0xf4, 0x37, 0x6e, 0x5a, 0x48, 0x4f, 0xc1, 0x50, 0x34, 0x8e, 0xe0, 0x23, 0xd0, 0x5b,
+	0x26, 0xeb, 0x22, 0x0c, 0x27, 0x34, 0xfe, 0x13, 0x1f, 0x87, 0xfe, 0x55, 0xdd, 0xaa, 0x11, 0x3f,
+	0xfc, 0x6b, 0xfe, 0x60, 0xaa, 0xe7, 0x15, 0x94, 0x3e, 0x0b, 0x83, 0x41, 0x28, 0xc0, 0xd0, 0x57,
+	0xd5, 0xd9, 0x8a, 0xd4, 0x13, 0xbf, 0xd5, 0xd7, 0xe0, 0x54, 0x5b, 0xab, 0xe0, 0xaf, 0xc0, 0x00,
+	0x25, 0x45, 0x97, 0x30, 0x5f, 0x65, 0x26, 0xe1, 0x6d, 0x66, 0xfa, 0xab, 0xa3, 0xef, 0xfc, 0xb4,
+	0x47, 0x93, 0x0b, 0xea, 0xdf, 0x10, 0x4c, 0x74, 0x7c, 0x7c, 0x9c, 0x87, 0x04, 0x73, 0xca, 0xc4,
+	0x16, 0xb1, 0xd6, 0xc7, 0xc4, 0xde, 0x46, 0x26, 0x7e, 0x97, 0x4f, 0x2e, 0x6a, 0x37, 0xbd, 0xcd,
+	0x4c, 0xcf, 0xf7, 0x90, 0x16, 0x17, 0x42, 0x3c, 0xe6, 0x4e, 0x40, 0x42, 0x5e, 0x43, 0x98, 0xda,
+	0x86, 0xb8, 0x82, 0x0f, 0x3d, 0x3f, 0xab, 0xc5, 0xfd, 0xe5, 0x79, 0x03, 0x9f, 0x83, 0x61, 0x29,
+	0x2a, 0x39, 0xf7, 0x8a, 0x63, 0x0e, 0xf9, 0x93, 0x0b, 0x62, 0x8e, 0x07, 0x5e, 0x5a, 0x74, 0xaa,
+	0x84, 0xa6, 0xfa, 0xc6, 0x7a, 0x79, 0xe0, 0xf5, 0x47, 0xea, 0xaf, 0x7a, 0x20, 0xd5, 0xce, 0x97,
+	0xf1, 0xf3, 0x30, 0xea, 0xbf, 0xfd, 0x82, 0xce, 0xb8, 0xc3, 0x31, 0x3f, 0x03, 0x0e, 0x6b, 0x23,
+	0xfe, 0xf4, 0xb4, 0x9c, 0xc5, 0x8b, 0x70, 0xc2, 0xd2, 0x29, 0x2b, 0xec, 0x94, 0xee, 0x2c, 0xbf,
+	0xf5, 0x89, 0xb4, 0x74, 0x8c, 0xeb, 0x5f, 0x8f, 0xa2, 0x4e, 0x33, 0x7c, 0x19, 0x4e, 0xb5, 0x82,
+	0x25, 0xae, 0xeb, 0xb8, 0xf2, 0x94, 0x27, 0x9a, 0xf4, 0xae, 0xf1, 0x55, 0x7c, 0x15, 0x86, 0x68,
+	0x8d, 0x56, 0x89, 0x6d, 0xf8, 0x29, 0xb2, 0xaf, 0x43, 0x1e, 0xc9, 0x50, 0x6b, 0x9a, 0xa9, 0xff,
+	0xe8, 0x81, 0x74, 0xb3, 0x71, 0x66, 0x89, 0x65, 0xae, 0x12, 0x77, 0x1d, 0x9f, 0x80, 0x1e, 0xd3,
+	0x90, 0xb7, 0x39, 0xe0, 0x6d, 0x64, 0x7a, 0xe6, 0x67, 0xb5, 0x1e, 0xd3, 0xc0, 0x4b, 0x90, 0xac,
+	0xd7, 0x25, 0x54, 0x9a, 0xa0, 0xcb, 0xa2, 0x01, 0xf3, 0x84, 0xed, 0x6d, 0x64, 0x20, 0xac, 0x65,
+	0xa8, 0x06, 0x61, 0x99, 0x42, 0xf1, 0xcb, 0x30, 0x18, 0x64, 0x4a, 0x3f, 0xfb, 0x9f, 0x7d, 0x0a,
+	0xfe, 0x62, 0x55, 0x0b, 0xa4, 0x1b, 0xca, 0x8f, 0xbe, 0xbd, 0x95, 0x1f, 0x69, 0x88, 0x87, 0x1e,
+	0xd1, 0x2f, 0x3c, 0x22, 0x1c, 0xe3, 0xb3, 0x00, 0xe2, 0xd2, 0xfc, 0x5b, 0xf2, 0xd3, 0x7c, 0x82,
+	0xcf, 0x88, 0x8b, 0x51, 0xef, 0xc1, 0x99, 0xb6, 0x26, 0x35, 0x09, 0xc5, 0xdf, 0x04, 0x30, 0xc2,
+	0x51, 0x0a, 0x89, 0x7a, 0xa3, 0x83, 0x48, 0x1e, 0x5c, 0x8a, 0x16, 0xd1, 0x56, 0x17, 0xe1, 0x58,
+	0xb3, 0x24, 0xc5, 0xaf, 0x41, 0x5c, 0x5a, 0x32, 0xd8, 0x40, 0xdd, 0x7d, 0x03, 0x2d, 0xd4, 0x51,
+	0x7f, 0x8f, 0x5a, 0xc5, 0x8e, 0xeb, 0xa2, 0x92, 0xa1, 0xf8, 0x3b, 0x30, 0xe8, 0x17, 0x35, 0x01,
+	0xf8, 0x4b, 0xbb, 0x83, 0x4b, 0xdd, 0x9c, 0xfc, 0x57, 0x16, 0x4d, 0x12, 0x86, 0x47, 0xc1, 0xe8,
+	0x42, 0x37, 0x51, 0x50, 0xfd, 0x09, 0x3c, 0xd7, 0x51, 0x8a, 0xc1, 0x8b, 0x90, 0x8c, 0xe4, 0x2b,
+	0xe9, 0xd5, 0x2f, 0x72, 0x0f, 0xac, 0xe7, 0x9d, 0x0e, 0xcb, 0x69, 0xa8, 0x27, 0x27, 0xf5, 0x7f,
+	0x08, 0x32, 0xed, 0x09, 0x5c, 0xe7, 0x7d, 0x0b, 0xfe, 0x7a, 0xe4, 0x1d, 0x65, 0xfd, 0x77, 0xe4,
+	0x6d, 0x66, 0xce, 0x41, 0x66, 0xc7, 0x4e, 0x85, 0x1d, 0x5b, 0xbd, 0xe0, 0x6f, 0xc5, 0x9f, 0x1b,
+	0x86, 0x3e, 0x5b, 0xaf, 0x04, 0x67, 0x17, 0xbf, 0xf1, 0x18, 0x24, 0x0d, 0x42, 0x8b, 0xae, 0x59,
+	0xe5, 0xbb, 0xca, 0x58, 0x11, 0x9d, 0x12, 0x01, 0xd1, 0x0f, 0x97, 0xfc, 0x0d, 0xc4, 0x83, 0xb8,
+	0xce, 0xa3, 0xa9, 0x41, 0x96, 0xf5, 0x9a, 0xc5, 0x0a, 0xbe, 0x49, 0xfb, 0xfd, 0x68, 0x2a, 0x27,
+	0xbf, 0xcb, 0xe7, 0xb8, 0xff, 0x3b, 0x02, 0x46, 0xb7, 0x84, 0x87, 0xc7, 0xb5, 0x70, 0xac, 0x3e,
+	0x49, 0xb4, 0x0a, 0x1a, 0xc1, 0x89, 0xf1, 0x9d, 0x68, 0x27, 0xb1, 0xc7, 0x72, 0xa0, 0xa1, 0xa3,
+	0xd8, 0x9b, 0x01, 0xce, 0x43, 0xdc, 0x72, 0x4a, 0x4e, 0x63, 0xf5, 0x7f, 0xd3, 0x29, 0x39, 0xa2,
+	0xfa, 0xe7, 0x8b, 0x3c, 0x13, 0x9d, 0x87, 0xb8, 0x69, 0x2f, 0xfb, 0x72, 0xfd, 0x75, 0xb9, 0x79,
+	0x7b, 0xd9, 0x97, 0xe3, 0x8b, 0x5c, 0x6e, 0x1a, 0x8e, 0x1a, 0x4e, 0xb1, 0x56, 0x21, 0xb6, 0xdf,
+	0xc9, 0x0a, 0x05, 0xf1, 0xfc, 0x67, 0x8e, 0x7b, 0x1b, 0x99, 0x23, 0xb3, 0xd1, 0x45, 0xae, 0x79,
+	0x64, 0x87, 0xb8, 0xdc, 0x2a, 0x6c, 0x48, 0x06, 0x9f, 0xd2, 0x90, 0xdc, 0xa9, 0x37, 0x24, 0x71,
+	0xf1, 0xc4, 0x5e, 0xee, 0xdc, 0x8e, 0xbb, 0x36, 0x26, 0x89, 0x1d, 0x8d, 0xc9, 0x1c, 0x0c, 0x88,
+	0xe6, 0x9a, 0xa6, 0xa0, 0xd3, 0xd6, 0x67, 0x87, 0x73, 0x6b, 0x52, 0xbd, 0x45, 0x87, 0x93, 0x3c,
+	0xe0, 0x0e, 0x67, 0xe8, 0x40, 0x3b, 0x9c, 0xe1, 0x03, 0xee, 0x70, 0x46, 0x0e, 0xba, 0xc3, 0x19,
+	0x3d, 0xf8, 0x0e, 0xe7, 0xc8, 0x61, 0x74, 0x38, 0x47, 0x0f, 0xa3, 0xc3, 0xc1, 0xfb, 0xed, 0x70,
+	0xf6, 0x53, 0x5a, 0xab, 0x25, 0x38, 0xdd, 0xde, 0xed, 0x29, 0xbe, 0x01, 0x89, 0x20, 0x03, 0x74,
+	0x91, 0xc1, 0x03, 0x7d, 0xad, 0xae, 0xac, 0xfe, 0x01, 0xc1, 0x99, 0x39, 0xc2, 0x5a, 0x64, 0x63,
+	0xf2, 0x76, 0x8d, 0x50, 0x76, 0x90, 0xdf, 0x65, 0xae, 0x00, 0xd4, 0x3f, 0xa3, 0xb5, 0xad, 0x5b,
+	0xc5, 0xa3, 0xbe, 0xa5, 0xd3, 0xf2, 0x4c, 0x1f, 0x57, 0xd7, 0x12, 0xcb, 0xc1, 0x84, 0xfa, 0x17,
+	0x04, 0xca, 0x4d, 0x93, 0xb6, 0x60, 0x4b, 0x03, 0xba, 0x87, 0xf8, 0x31, 0x6c, 0xdf, 0xf4, 0x7f,
+	0x87, 0xe0, 0xcc, 0xc2, 0xd3, 0x6c, 0x7d, 0x1d, 0x06, 0x65, 0x09, 0x24, 0x49, 0x77, 0x50, 0x35,
+	0x45, 0x08, 0x07, 0xca, 0xfb, 0x67, 0xfa, 0x67, 0x04, 0xcf, 0xb6, 0xf4, 0x8a, 0xd0, 0x85, 0x24,
+	0xe3, 0x43, 0xc8, 0xb5, 0xfb, 0x26, 0x6f, 0xc2, 0xf9, 0xd6, 0x4e, 0x12, 0xbe, 0x9f, 0x80, 0xfd,
+	0xce, 0xad, 0x50, 0xf7, 0x5b, 0xfd, 0xba, 0x17, 0x2e, 0xce, 0xdb, 0x94, 0xe9, 0xbc, 0x09, 0x65,
+	0x64, 0x77, 0x7b, 0x35, 0x34, 0x2e, 0xe8, 0x30, 0x1a, 0x97, 0x4a, 0xc3, 0x77, 0x91, 0x9e, 0xfd,
+	0x5c, 0xce, 0x31, 0xb9, 0x59, 0xfb, 0x6f, 0x23, 0x3f, 0x0a, 0xf3, 0x77, 0xaf, 0x08, 0x44, 0x37,
+	0x1a, 0x37, 0xea, 0xc6, 0x40, 0xbe, 0xb1, 0x65, 0xe9, 0x20, 0x71, 0xd3, 0x97, 0x21, 0x19, 0x99,
+	0xee, 0x2a, 0x8e, 0xfe, 0x1c, 0xc1, 0x78, 0x6b, 0x67, 0x98, 0x25, 0xba, 0x71, 0x93, 0x30, 0x46,
+	0x5c, 0x7a, 0x08, 0xa1, 0xee, 0x38, 0xf4, 0x5b, 0x66, 0xc5, 0xf4, 0xbb, 0xf3, 0x61, 0xcd, 0x1f,
+	0xa8, 0x7f, 0x44, 0x70, 0x41, 0x23, 0x55, 0x4b, 0x5f, 0xff, 0xb2, 0xf9, 0x4c, 0xc2, 0x90, 0xec,
+	0xda, 0xd6, 0xa5, 0x4f, 0xf4, 0x8e, 0x27, 0xfc, 0x8f, 0x5e, 0x41, 0x5f, 0x27, 0x2e, 0x36, 0x10,
+	0x9a, 0x37, 0xe8, 0xe4, 0x9f, 0x46, 0x5a, 0x95, 0xd9, 0x1a, 0x29, 0x99, 0x94, 0x5f, 0x83, 0x05,
+	0x30, 0x47, 0x58, 0xd0, 0x93, 0x9d, 0x68, 0x7a, 0x36, 0xd7, 0x2a, 0x55, 0xb6, 0x9e, 0x9e, 0xe8,
+	0xb8, 0x35, 0x53, 0x4f, 0xff, 0xec, 0x9f, 0xff, 0xfe, 0xa0, 0xe7, 0x19, 0x7c, 0x2c, 0xaf, 0xd3,
+	0xbc, 0xf4, 0xe7, 0xac, 0xec, 0xd0, 0xf0, 0x87, 0x08, 0x92, 0x73, 0x84, 0x85, 0x45, 0xfe, 0x8b,
+	0x8d, 0xb8, 0x9d, 0x84, 0xab, 0x74, 0x17, 0x49, 0x52, 0xcd, 0x0b, 0x3a, 0x13, 0xf8, 0xf9, 0x28,
+	0x9d, 0x30, 0x71, 0xe6, 0xef, 0x9b, 0x06, 0xcd, 0x45, 0xde, 0xd9, 0x03, 0xfc, 0x01, 0x82, 0x61,
+	0xee, 0x6b, 0xf5, 0x34, 0xdd, 0xd4, 0x97, 0x76, 0x16, 0x97, 0xd2, 0x5f, 0xed, 0x9c, 0x26, 0x55,
+	0xcf, 0x0a, 0x9e, 0x27, 0xf1, 0x33, 0x2d, 0x79, 0xe2, 0xff, 0x22, 0x38, 0x16, 0x79, 0x81, 0xa1,
+	0x01, 0x5f, 0xdd, 0xcf, 0x33, 0x4d, 0x77, 0x90, 0x98, 0x54, 0x2a, 0x88, 0x55, 0xa6, 0xd0, 0x05,
+	0x75, 0x85, 0x73, 0x8b, 0xfe, 0x0f, 0x61, 0xfe, 0x7e, 0x24, 0x0c, 0xe6, 0x1a, 0x32, 0x76, 0xc3,
+	0xf8, 0x41, 0x70, 0xaa, 0x06, 0xad, 0xfa, 0xef, 0x07, 0xf9, 0xe0, 0xbc, 0xf8, 0x21, 0x82, 0xde,
+	0x39, 0xc2, 0xf0, 0xc5, 0x8e, 0xfc, 0xa3, 0x9b, 0xe3, 0xbc, 0x2e, 0x8e, 0x73, 0x07, 0xdf, 0x6e,
+	0x3e, 0x4b, 0x77, 0x67, 0x68, 0xe0, 0x8e, 0x7f, 0x83, 0xa0, 0x8f, 0x3b, 0x06, 0xce, 0x75, 0xe6,
+	0x2e, 0xa1, 0x9b, 0x9c, 0xdb, 0x9d, 0x35, 0x55, 0x67, 0x04, 0xed, 0x57, 0xf1, 0x54, 0x33, 0xed,
+	0x4e, 0x29, 0xe3, 0xbf, 0x22, 0xe8, 0x5d, 0x68, 0x65, 0xd4, 0x85, 0xfd, 0x1a, 0xf5, 0x9e, 0x60,
+	0x67, 0xa8, 0x85, 0xb6, 0x0e, 0x92, 0xdb, 0x93, 0x83, 0xe4, 0x1a, 0x8c, 0x3c, 0x85, 0x2e, 0xf0,
+	0x10, 0x32, 0x30, 0x4b, 0x2c, 0xc2, 0x08, 0xee, 0x2e, 0x98, 0xa6, 0xdb, 0x04, 0x37, 0xf5, 0xb6,
+	0x60, 0x3f, 0x7f, 0x61, 0x6e, 0xef, 0xb6, 0x8d, 0xba, 0xf4, 0x03, 0xbc, 0x89, 0x60, 0x94, 0x5f,
+	0x7a, 0x24, 0x19, 0xe0, 0x57, 0x3a, 0xf3, 0x8a, 0xe6, 0xfc, 0x91, 0xbe, 0xd8, 0xf1, 0x47, 0x3d,
+	0x93, 0x50, 0xd5, 0x10, 0x87, 0x79, 0x0b, 0xff, 0xf0, 0x80, 0xfd, 0x3b, 0x6f, 0x10, 0xdd, 0xc8,
+	0x5a, 0xf2, 0x34, 0xff, 0x42, 0x70, 0xd4, 0x4f, 0x81, 0xd1, 0x33, 0x4e, 0x35, 0x32, 0xed, 0x3c,
+	0x4b, 0xb6, 0xbd, 0x1c, 0x5b, 0x9c, 0x67, 0x85, 0x87, 0x9f, 0xe2, 0x61, 0x1e, 0x29, 0xef, 0x0a,
+	0xae, 0x33, 0xbf, 0x45, 0x8f, 0xb6, 0x14, 0xf4, 0x78, 0x4b, 0x41, 0x9f, 0x6e, 0x29, 0xb1, 0x27,
+	0x5b, 0x4a, 0xec, 0xb3, 0x2d, 0x25, 0xf6, 0xf9, 0x96, 0x12, 0xfb, 0x62, 0x4b, 0x41, 0xef, 0x7a,
+	0x0a, 0x7a, 0xcf, 0x53, 0x62, 0x1f, 0x79, 0x0a, 0x7a, 0xe8, 0x29, 0xb1, 0x8f, 0x3d, 0x25, 0xf6,
+	0x89, 0xa7, 0xc4, 0x1e, 0x79, 0x0a, 0x7a, 0xec, 0x29, 0xe8, 0x53, 0x4f, 0x89, 0x3d, 0xf1, 0x14,
+	0xf4, 0x99, 0xa7, 0xc4, 0x3e, 0xf7, 0x14, 0xf4, 0x85, 0xa7, 0xc4, 0xde, 0xdd, 0x56, 0x62, 0xef,
+	0x6d, 0x2b, 0xe8, 0xfd, 0x6d, 0x25, 0xf6, 0xcb, 0x6d, 0x05, 0x7d, 0xb8, 0xad, 0xc4, 0x3e, 0xda,
+	0x56, 0x62, 0x0f, 0xb7, 0x15, 0xf4, 0xf1, 0xb6, 0x82, 0x3e, 0xd9, 0x56, 0xd0, 0x0f, 0x2e, 0x96,
+	0x9c, 0x1c, 0x5b, 0x21, 0x6c, 0xc5, 0xb4, 0x4b, 0x34, 0x67, 0x13, 0xb6, 0xe6, 0xb8, 0xe5, 0xfc,
+	0xce, 0x3f, 0x2b, 0xa8, 0x96, 0x4b, 0x79, 0xc6, 0xec, 0xea, 0xd2, 0xd2, 0x80, 0x30, 0xd2, 0xa5,
+	0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x53, 0xd1, 0x75, 0xd7, 0xc1, 0x21, 0x00, 0x00,
 }
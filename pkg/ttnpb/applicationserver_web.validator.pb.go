@@ -88,11 +88,83 @@ func (this *ApplicationWebhook) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("LocationSolved", err)
 		}
 	}
+	if this.Health != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Health); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Health", err)
+		}
+	}
+	if this.TemplateIDs != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.TemplateIDs); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("TemplateIDs", err)
+		}
+	}
+	if this.Signing != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Signing); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Signing", err)
+		}
+	}
+	if this.OAuth2ClientCredentials != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.OAuth2ClientCredentials); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("OAuth2ClientCredentials", err)
+		}
+	}
 	return nil
 }
 func (this *ApplicationWebhook_Message) Validate() error {
 	return nil
 }
+func (this *ApplicationWebhookSigning) Validate() error {
+	if !(len(this.Secret) > 15) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Secret", fmt.Errorf(`value '%v' must length be greater than '15'`, this.Secret))
+	}
+	if !(len(this.Secret) < 257) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Secret", fmt.Errorf(`value '%v' must length be less than '257'`, this.Secret))
+	}
+	return nil
+}
+func (this *ApplicationWebhookOAuth2ClientCredentials) Validate() error {
+	if this.TokenURL == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("TokenURL", fmt.Errorf(`value '%v' must not be an empty string`, this.TokenURL))
+	}
+	return nil
+}
+func (this *ApplicationWebhookHealth) Validate() error {
+	if this.LastFailedAttemptAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.LastFailedAttemptAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("LastFailedAttemptAt", err)
+		}
+	}
+	if this.SuspendedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.SuspendedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("SuspendedAt", err)
+		}
+	}
+	return nil
+}
+func (this *ApplicationWebhookDelivery) Validate() error {
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.WebhookIDs)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("WebhookIDs", err)
+	}
+	if this.Message != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Message); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Message", err)
+		}
+	}
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.CreatedAt)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("CreatedAt", err)
+	}
+	return nil
+}
+func (this *ApplicationWebhookDeliveries) Validate() error {
+	for _, item := range this.Deliveries {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Deliveries", err)
+			}
+		}
+	}
+	return nil
+}
 func (this *ApplicationWebhooks) Validate() error {
 	for _, item := range this.Webhooks {
 		if item != nil {
@@ -107,6 +179,94 @@ func (this *ApplicationWebhookFormats) Validate() error {
 	// Validation of proto3 map<> fields is unsupported.
 	return nil
 }
+
+var _regex_ApplicationWebhookTemplateIdentifiers_TemplateID = regexp.MustCompile(`^[a-z0-9](?:[-]?[a-z0-9]){2,}$`)
+
+func (this *ApplicationWebhookTemplateIdentifiers) Validate() error {
+	if !_regex_ApplicationWebhookTemplateIdentifiers_TemplateID.MatchString(this.TemplateID) {
+		return github_com_mwitkow_go_proto_validators.FieldError("TemplateID", fmt.Errorf(`value '%v' must be a string conforming to regex "^[a-z0-9](?:[-]?[a-z0-9]){2,}$"`, this.TemplateID))
+	}
+	if !(len(this.TemplateID) < 37) {
+		return github_com_mwitkow_go_proto_validators.FieldError("TemplateID", fmt.Errorf(`value '%v' must length be less than '37'`, this.TemplateID))
+	}
+	return nil
+}
+
+var _regex_ApplicationWebhookTemplateField_ID = regexp.MustCompile(`^[a-z0-9](?:[_-]?[a-z0-9]){0,}$`)
+
+func (this *ApplicationWebhookTemplateField) Validate() error {
+	if !_regex_ApplicationWebhookTemplateField_ID.MatchString(this.ID) {
+		return github_com_mwitkow_go_proto_validators.FieldError("ID", fmt.Errorf(`value '%v' must be a string conforming to regex "^[a-z0-9](?:[_-]?[a-z0-9]){0,}$"`, this.ID))
+	}
+	if !(len(this.ID) < 37) {
+		return github_com_mwitkow_go_proto_validators.FieldError("ID", fmt.Errorf(`value '%v' must length be less than '37'`, this.ID))
+	}
+	return nil
+}
+func (this *ApplicationWebhookTemplate) Validate() error {
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.ApplicationWebhookTemplateIdentifiers)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("ApplicationWebhookTemplateIdentifiers", err)
+	}
+	// Validation of proto3 map<> fields is unsupported.
+	for _, item := range this.Fields {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Fields", err)
+			}
+		}
+	}
+	if this.UplinkMessage != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.UplinkMessage); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("UplinkMessage", err)
+		}
+	}
+	if this.JoinAccept != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.JoinAccept); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("JoinAccept", err)
+		}
+	}
+	if this.DownlinkAck != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.DownlinkAck); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("DownlinkAck", err)
+		}
+	}
+	if this.DownlinkNack != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.DownlinkNack); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("DownlinkNack", err)
+		}
+	}
+	if this.DownlinkSent != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.DownlinkSent); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("DownlinkSent", err)
+		}
+	}
+	if this.DownlinkFailed != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.DownlinkFailed); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("DownlinkFailed", err)
+		}
+	}
+	if this.DownlinkQueued != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.DownlinkQueued); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("DownlinkQueued", err)
+		}
+	}
+	if this.LocationSolved != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.LocationSolved); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("LocationSolved", err)
+		}
+	}
+	return nil
+}
+func (this *ApplicationWebhookTemplates) Validate() error {
+	for _, item := range this.Templates {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Templates", err)
+			}
+		}
+	}
+	return nil
+}
 func (this *GetApplicationWebhookRequest) Validate() error {
 	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.ApplicationWebhookIdentifiers)); err != nil {
 		return github_com_mwitkow_go_proto_validators.FieldError("ApplicationWebhookIdentifiers", err)
@@ -134,3 +294,40 @@ func (this *SetApplicationWebhookRequest) Validate() error {
 	}
 	return nil
 }
+func (this *GetApplicationWebhookTemplateRequest) Validate() error {
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.ApplicationWebhookTemplateIdentifiers)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("ApplicationWebhookTemplateIdentifiers", err)
+	}
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.FieldMask)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("FieldMask", err)
+	}
+	return nil
+}
+func (this *ListApplicationWebhookTemplatesRequest) Validate() error {
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.FieldMask)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("FieldMask", err)
+	}
+	return nil
+}
+func (this *InstantiateApplicationWebhookTemplateRequest) Validate() error {
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.WebhookIDs)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("WebhookIDs", err)
+	}
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.TemplateIDs)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("TemplateIDs", err)
+	}
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *ListApplicationWebhookDeadLettersRequest) Validate() error {
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.ApplicationWebhookIdentifiers)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("ApplicationWebhookIdentifiers", err)
+	}
+	return nil
+}
+func (this *ReplayApplicationWebhookDeadLettersRequest) Validate() error {
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.ApplicationWebhookIdentifiers)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("ApplicationWebhookIdentifiers", err)
+	}
+	return nil
+}
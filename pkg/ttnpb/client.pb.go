@@ -45,21 +45,31 @@ const (
 	GRANT_PASSWORD GrantType = 1
 	// Grant type used to exchange a refresh token for an access token.
 	GRANT_REFRESH_TOKEN GrantType = 2
+	// Grant type used to exchange the client ID and secret for an access token.
+	// The access token is issued on behalf of the user that owns the client.
+	GRANT_CLIENT_CREDENTIALS GrantType = 3
+	// Grant type used to exchange a device code for an access token, after a user
+	// verified the device authorization request (RFC 8628).
+	GRANT_DEVICE_CODE GrantType = 4
 )
 
 var GrantType_name = map[int32]string{
 	0: "GRANT_AUTHORIZATION_CODE",
 	1: "GRANT_PASSWORD",
 	2: "GRANT_REFRESH_TOKEN",
+	3: "GRANT_CLIENT_CREDENTIALS",
+	4: "GRANT_DEVICE_CODE",
 }
 var GrantType_value = map[string]int32{
 	"GRANT_AUTHORIZATION_CODE": 0,
 	"GRANT_PASSWORD":           1,
 	"GRANT_REFRESH_TOKEN":      2,
+	"GRANT_CLIENT_CREDENTIALS": 3,
+	"GRANT_DEVICE_CODE":        4,
 }
 
 func (GrantType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_client_02751df5c6a7bb69, []int{0}
+	return fileDescriptor_client_65e0d63c6134a421, []int{0}
 }
 
 // An OAuth client on the network.
@@ -100,7 +110,7 @@ type Client struct {
 func (m *Client) Reset()      { *m = Client{} }
 func (*Client) ProtoMessage() {}
 func (*Client) Descriptor() ([]byte, []int) {
-	return fileDescriptor_client_02751df5c6a7bb69, []int{0}
+	return fileDescriptor_client_65e0d63c6134a421, []int{0}
 }
 func (m *Client) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -229,7 +239,7 @@ type Clients struct {
 func (m *Clients) Reset()      { *m = Clients{} }
 func (*Clients) ProtoMessage() {}
 func (*Clients) Descriptor() ([]byte, []int) {
-	return fileDescriptor_client_02751df5c6a7bb69, []int{1}
+	return fileDescriptor_client_65e0d63c6134a421, []int{1}
 }
 func (m *Clients) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,7 +285,7 @@ type GetClientRequest struct {
 func (m *GetClientRequest) Reset()      { *m = GetClientRequest{} }
 func (*GetClientRequest) ProtoMessage() {}
 func (*GetClientRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_client_02751df5c6a7bb69, []int{2}
+	return fileDescriptor_client_65e0d63c6134a421, []int{2}
 }
 func (m *GetClientRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,7 +338,7 @@ type ListClientsRequest struct {
 func (m *ListClientsRequest) Reset()      { *m = ListClientsRequest{} }
 func (*ListClientsRequest) ProtoMessage() {}
 func (*ListClientsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_client_02751df5c6a7bb69, []int{3}
+	return fileDescriptor_client_65e0d63c6134a421, []int{3}
 }
 func (m *ListClientsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +413,7 @@ type CreateClientRequest struct {
 func (m *CreateClientRequest) Reset()      { *m = CreateClientRequest{} }
 func (*CreateClientRequest) ProtoMessage() {}
 func (*CreateClientRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_client_02751df5c6a7bb69, []int{4}
+	return fileDescriptor_client_65e0d63c6134a421, []int{4}
 }
 func (m *CreateClientRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -449,7 +459,7 @@ type UpdateClientRequest struct {
 func (m *UpdateClientRequest) Reset()      { *m = UpdateClientRequest{} }
 func (*UpdateClientRequest) ProtoMessage() {}
 func (*UpdateClientRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_client_02751df5c6a7bb69, []int{5}
+	return fileDescriptor_client_65e0d63c6134a421, []int{5}
 }
 func (m *UpdateClientRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -495,7 +505,7 @@ type SetClientCollaboratorRequest struct {
 func (m *SetClientCollaboratorRequest) Reset()      { *m = SetClientCollaboratorRequest{} }
 func (*SetClientCollaboratorRequest) ProtoMessage() {}
 func (*SetClientCollaboratorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_client_02751df5c6a7bb69, []int{6}
+	return fileDescriptor_client_65e0d63c6134a421, []int{6}
 }
 func (m *SetClientCollaboratorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1245,7 +1255,7 @@ func NewPopulatedClient(r randyClient, easy bool) *Client {
 	v7 := r.Intn(10)
 	this.Grants = make([]GrantType, v7)
 	for i := 0; i < v7; i++ {
-		this.Grants[i] = GrantType([]int32{0, 1, 2}[r.Intn(3)])
+		this.Grants[i] = GrantType([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
 	}
 	v8 := r.Intn(10)
 	this.Rights = make([]Right, v8)
@@ -3089,76 +3099,78 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("lorawan-stack/api/client.proto", fileDescriptor_client_02751df5c6a7bb69)
+	proto.RegisterFile("lorawan-stack/api/client.proto", fileDescriptor_client_65e0d63c6134a421)
 }
 func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/client.proto", fileDescriptor_client_02751df5c6a7bb69)
-}
-
-var fileDescriptor_client_02751df5c6a7bb69 = []byte{
-	// 1018 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xde, 0x89, 0x13, 0x27, 0x9e, 0xfc, 0xf9, 0xf9, 0x37, 0x81, 0xb2, 0x98, 0x30, 0x31, 0x06,
-	0x21, 0x0b, 0xc8, 0x1a, 0x52, 0x21, 0x55, 0xa0, 0x82, 0x9c, 0x34, 0x49, 0xc3, 0x9f, 0x18, 0x26,
-	0x8e, 0x2a, 0x55, 0x48, 0xd6, 0xd8, 0x3b, 0xde, 0x8c, 0x6c, 0xef, 0x2e, 0x33, 0xe3, 0x56, 0xe1,
-	0xd4, 0x63, 0x8e, 0x39, 0x72, 0x03, 0xc1, 0x25, 0xc7, 0x8a, 0x53, 0x8f, 0x3d, 0xe6, 0x98, 0x63,
-	0x4f, 0xa1, 0x5e, 0x5f, 0x72, 0xec, 0x8d, 0x1e, 0xd1, 0xce, 0xee, 0x26, 0x8e, 0xed, 0x1e, 0x68,
-	0xe1, 0x36, 0x6f, 0xbe, 0xef, 0xbd, 0xfd, 0xfc, 0xbd, 0x37, 0xcf, 0x10, 0xb7, 0x3d, 0x41, 0xef,
-	0x53, 0x77, 0x45, 0x2a, 0xda, 0x68, 0x95, 0xa8, 0xcf, 0x4b, 0x8d, 0x36, 0x67, 0xae, 0xb2, 0x7c,
-	0xe1, 0x29, 0x0f, 0x2d, 0x28, 0xe5, 0x5a, 0x31, 0xc7, 0xba, 0x77, 0x3d, 0xb7, 0xe2, 0x70, 0xb5,
-	0xdf, 0xad, 0x5b, 0x0d, 0xaf, 0x53, 0x72, 0x3c, 0xc7, 0x2b, 0x69, 0x5a, 0xbd, 0xdb, 0xd4, 0x91,
-	0x0e, 0xf4, 0x29, 0x4a, 0xcf, 0xe5, 0x1d, 0xcf, 0x73, 0xda, 0xec, 0x92, 0xd5, 0xe4, 0xac, 0x6d,
-	0xd7, 0x3a, 0x54, 0xb6, 0x62, 0xc6, 0xf2, 0x30, 0x43, 0xf1, 0x0e, 0x93, 0x8a, 0x76, 0xfc, 0x98,
-	0xf0, 0xde, 0x18, 0x85, 0x9e, 0xab, 0x68, 0x43, 0xd5, 0xb8, 0xdb, 0x4c, 0x3e, 0xf4, 0xf6, 0x28,
-	0x8b, 0xb9, 0xdd, 0x8e, 0x8c, 0xe1, 0x77, 0x47, 0x61, 0x6e, 0x33, 0x57, 0xf1, 0x26, 0x67, 0x22,
-	0x21, 0x8d, 0xf1, 0x42, 0x70, 0x67, 0x5f, 0xc5, 0x78, 0xe1, 0xaf, 0x29, 0x98, 0x5e, 0xd7, 0xe6,
-	0xa0, 0x9b, 0x30, 0xc5, 0x6d, 0x69, 0x82, 0x3c, 0x28, 0xce, 0xae, 0xbe, 0x63, 0x5d, 0x35, 0xc9,
-	0x8a, 0x48, 0xdb, 0x97, 0x1f, 0x58, 0x9b, 0x39, 0x39, 0x5b, 0x36, 0x4e, 0xcf, 0x96, 0x01, 0x09,
-	0xf3, 0xd0, 0x3a, 0x84, 0x0d, 0xc1, 0xa8, 0x62, 0x76, 0x8d, 0x2a, 0x73, 0x42, 0x57, 0xc9, 0x59,
-	0x91, 0x13, 0x56, 0xe2, 0x84, 0x55, 0x4d, 0x9c, 0x88, 0xd2, 0x8f, 0xfe, 0x5c, 0x06, 0x24, 0x13,
-	0xe7, 0x95, 0x55, 0x58, 0xa4, 0xeb, 0xdb, 0x49, 0x91, 0xd4, 0x3f, 0x29, 0x12, 0xe7, 0x95, 0x15,
-	0x42, 0x70, 0xd2, 0xa5, 0x1d, 0x66, 0x4e, 0xe6, 0x41, 0x31, 0x43, 0xf4, 0x19, 0xe5, 0xe1, 0xac,
-	0xcd, 0x64, 0x43, 0x70, 0x5f, 0x71, 0xcf, 0x35, 0xa7, 0x34, 0x34, 0x78, 0x85, 0x36, 0x21, 0xa4,
-	0x4a, 0x09, 0x5e, 0xef, 0x2a, 0x26, 0xcd, 0x74, 0x3e, 0x55, 0x9c, 0x5d, 0x7d, 0x7f, 0xbc, 0x0b,
-	0x56, 0xf9, 0x82, 0xb8, 0xe1, 0x2a, 0x71, 0x40, 0x06, 0x32, 0xd1, 0x17, 0x70, 0x6e, 0xb0, 0x97,
-	0xe6, 0xb4, 0xae, 0xf4, 0xd6, 0x48, 0xa5, 0x88, 0xb3, 0xed, 0x36, 0x3d, 0x32, 0xdb, 0xb8, 0x0c,
-	0xd0, 0x35, 0x98, 0x96, 0xac, 0x21, 0x98, 0x32, 0x67, 0xb4, 0xc8, 0x38, 0x42, 0x9f, 0xc2, 0x79,
-	0xc1, 0x6c, 0x2e, 0x58, 0x43, 0xd5, 0xba, 0x82, 0x4b, 0x33, 0x93, 0x4f, 0x15, 0x33, 0x6b, 0xd9,
-	0xe0, 0x6c, 0x79, 0x8e, 0xc4, 0xc0, 0x1e, 0xd9, 0x96, 0x64, 0x2e, 0xa1, 0xed, 0x09, 0x2e, 0xd1,
-	0x87, 0x70, 0x4a, 0x2a, 0xaa, 0x98, 0x09, 0xf3, 0xa0, 0xb8, 0xb0, 0xfa, 0xfa, 0xb0, 0x8e, 0xdd,
-	0x10, 0x24, 0x11, 0x07, 0xad, 0x40, 0x24, 0x5b, 0xdc, 0xaf, 0xd1, 0xae, 0xda, 0xf7, 0x04, 0xff,
-	0x89, 0x6a, 0xb3, 0x66, 0xf3, 0xa0, 0x38, 0x43, 0xfe, 0x1f, 0x22, 0xe5, 0x41, 0x00, 0xe5, 0xe0,
-	0x0c, 0x73, 0x6d, 0x4f, 0x48, 0x66, 0x9b, 0x73, 0x9a, 0x74, 0x11, 0xa3, 0x4f, 0x60, 0xda, 0x11,
-	0xd4, 0x55, 0xd2, 0x9c, 0xcf, 0xa7, 0x8a, 0x0b, 0xab, 0x6f, 0x0e, 0x7f, 0x78, 0x2b, 0x44, 0xab,
-	0x07, 0x3e, 0x23, 0x31, 0x11, 0xad, 0xc0, 0x74, 0x34, 0x9b, 0xe6, 0x82, 0x4e, 0x19, 0xd1, 0x4a,
-	0x42, 0x94, 0xc4, 0xa4, 0xdc, 0x4d, 0xf8, 0xbf, 0xa1, 0x3e, 0xa0, 0x2c, 0x4c, 0xb5, 0xd8, 0x81,
-	0x1e, 0xe1, 0x0c, 0x09, 0x8f, 0xe8, 0x35, 0x38, 0x75, 0x8f, 0xb6, 0xbb, 0x4c, 0x0f, 0x64, 0x86,
-	0x44, 0xc1, 0x67, 0x13, 0x37, 0x40, 0xe1, 0x73, 0x38, 0x1d, 0x75, 0x53, 0xa2, 0x8f, 0xe1, 0x74,
-	0xb4, 0x20, 0xc2, 0xe9, 0x0f, 0xbb, 0x75, 0x6d, 0x7c, 0xdf, 0x49, 0x42, 0x2b, 0xfc, 0x02, 0x60,
-	0x76, 0x8b, 0xa9, 0xf8, 0x9a, 0xfd, 0xd8, 0x65, 0x52, 0xa1, 0xaf, 0x20, 0x8c, 0xf0, 0xda, 0x4b,
-	0xbe, 0xa3, 0x4c, 0x23, 0x06, 0x25, 0xfa, 0x12, 0xc2, 0xcb, 0xb5, 0xf2, 0xc2, 0xd7, 0xb4, 0x19,
-	0x52, 0xbe, 0xa5, 0xb2, 0xb5, 0x36, 0x19, 0x16, 0x21, 0x99, 0x66, 0x72, 0x51, 0x38, 0x07, 0x10,
-	0x7d, 0xc3, 0x65, 0x2c, 0x51, 0x26, 0x1a, 0xbf, 0x0f, 0xa7, 0xb3, 0xdd, 0xa6, 0x75, 0x4f, 0x50,
-	0xe5, 0x89, 0x58, 0xe5, 0xca, 0xb0, 0xca, 0x8a, 0x70, 0xa8, 0x1b, 0xb7, 0xb9, 0x22, 0xf6, 0x24,
-	0x13, 0x03, 0x8a, 0xc9, 0x95, 0x12, 0xaf, 0x2c, 0x35, 0xec, 0x91, 0x27, 0x6c, 0x26, 0xf4, 0x7b,
-	0xcf, 0x90, 0x28, 0x08, 0x6f, 0xdb, 0xbc, 0xc3, 0x95, 0x7e, 0xc6, 0xf3, 0x24, 0x0a, 0xc2, 0xb7,
-	0xed, 0x53, 0x87, 0xe9, 0x07, 0x3c, 0x4f, 0xf4, 0xb9, 0x70, 0x0c, 0xe0, 0xe2, 0xba, 0x5e, 0x21,
-	0x57, 0xfb, 0x71, 0x03, 0xa6, 0x23, 0x43, 0xe3, 0x5f, 0xf9, 0x82, 0xae, 0x0e, 0x34, 0x20, 0xe6,
-	0xa3, 0x3b, 0x43, 0x2e, 0x4d, 0xbc, 0x84, 0x4b, 0xf1, 0xef, 0xbc, 0x52, 0xa8, 0x70, 0x04, 0xe0,
-	0xe2, 0x9e, 0x5e, 0x54, 0xff, 0x96, 0xd4, 0x57, 0x1e, 0x94, 0x3f, 0x00, 0x5c, 0xda, 0x4d, 0x46,
-	0x79, 0x7d, 0x40, 0xec, 0x7f, 0x31, 0xd6, 0x9b, 0x63, 0x8d, 0x5d, 0x1a, 0x5d, 0x8e, 0x97, 0x9c,
-	0x71, 0x3e, 0x7e, 0xf0, 0x03, 0xcc, 0x5c, 0xec, 0x0f, 0xb4, 0x04, 0xcd, 0x2d, 0x52, 0xde, 0xa9,
-	0xd6, 0xca, 0x7b, 0xd5, 0xdb, 0x15, 0xb2, 0x7d, 0xb7, 0x5c, 0xdd, 0xae, 0xec, 0xd4, 0xd6, 0x2b,
-	0xb7, 0x36, 0xb2, 0x06, 0x42, 0x70, 0x21, 0x42, 0xbf, 0x2b, 0xef, 0xee, 0xde, 0xa9, 0x90, 0x5b,
-	0x59, 0x80, 0xde, 0x80, 0x8b, 0xd1, 0x1d, 0xd9, 0xd8, 0x24, 0x1b, 0xbb, 0xb7, 0x6b, 0xd5, 0xca,
-	0xd7, 0x1b, 0x3b, 0xd9, 0x89, 0xdc, 0xe4, 0xe1, 0xef, 0xd8, 0x58, 0xfb, 0x0d, 0x9c, 0xf4, 0x30,
-	0x38, 0xed, 0x61, 0xf0, 0xa4, 0x87, 0x8d, 0xa7, 0x3d, 0x6c, 0x9c, 0xf7, 0xb0, 0xf1, 0xac, 0x87,
-	0x8d, 0xe7, 0x3d, 0x0c, 0x1e, 0x04, 0x18, 0x1c, 0x06, 0xd8, 0x38, 0x0e, 0x30, 0x78, 0x18, 0x60,
-	0xe3, 0x51, 0x80, 0x8d, 0xc7, 0x01, 0x36, 0x4e, 0x02, 0x0c, 0x4e, 0x03, 0x0c, 0x9e, 0x04, 0xd8,
-	0x78, 0x1a, 0x60, 0x70, 0x1e, 0x60, 0xe3, 0x59, 0x80, 0xc1, 0xf3, 0x00, 0x1b, 0x0f, 0xfa, 0xd8,
+	golang_proto.RegisterFile("lorawan-stack/api/client.proto", fileDescriptor_client_65e0d63c6134a421)
+}
+
+var fileDescriptor_client_65e0d63c6134a421 = []byte{
+	// 1050 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x3f, 0x70, 0x1b, 0xc5,
+	0x17, 0xbe, 0xb5, 0x6c, 0xd9, 0x5a, 0xff, 0xf9, 0x29, 0xeb, 0x5f, 0xc2, 0x21, 0xcc, 0x5a, 0x08,
+	0x86, 0xd1, 0x00, 0x3e, 0x81, 0x33, 0xcc, 0x64, 0x60, 0x02, 0x23, 0xcb, 0xb2, 0x23, 0x30, 0x16,
+	0xac, 0x64, 0x32, 0x93, 0x46, 0xb3, 0x92, 0x56, 0xe7, 0x1d, 0x49, 0x77, 0x62, 0x77, 0x95, 0x8c,
+	0xa9, 0x52, 0xba, 0x34, 0x1d, 0x1d, 0x0c, 0x34, 0x2e, 0x33, 0x54, 0x29, 0x53, 0xba, 0x74, 0x99,
+	0xca, 0x44, 0xa7, 0xc6, 0x65, 0x3a, 0x52, 0x32, 0xb7, 0x77, 0xb2, 0x64, 0x59, 0x29, 0x48, 0xa0,
+	0xd2, 0xbe, 0xfd, 0xbe, 0xf7, 0xf6, 0xbb, 0xef, 0xbd, 0x5d, 0x41, 0xdc, 0x72, 0x05, 0x7d, 0x40,
+	0x9d, 0x35, 0xa9, 0x68, 0xad, 0x99, 0xa1, 0x1d, 0x9e, 0xa9, 0xb5, 0x38, 0x73, 0x94, 0xd5, 0x11,
+	0xae, 0x72, 0xd1, 0x92, 0x52, 0x8e, 0x15, 0x72, 0xac, 0xfb, 0x37, 0x13, 0x6b, 0x36, 0x57, 0xfb,
+	0xdd, 0xaa, 0x55, 0x73, 0xdb, 0x19, 0xdb, 0xb5, 0xdd, 0x8c, 0xa6, 0x55, 0xbb, 0x0d, 0x1d, 0xe9,
+	0x40, 0xaf, 0x82, 0xf4, 0x44, 0xd2, 0x76, 0x5d, 0xbb, 0xc5, 0x86, 0xac, 0x06, 0x67, 0xad, 0x7a,
+	0xa5, 0x4d, 0x65, 0x33, 0x64, 0xac, 0x8e, 0x33, 0x14, 0x6f, 0x33, 0xa9, 0x68, 0xbb, 0x13, 0x12,
+	0xde, 0x9b, 0xa0, 0xd0, 0x75, 0x14, 0xad, 0xa9, 0x0a, 0x77, 0x1a, 0x83, 0x83, 0xde, 0xbe, 0xca,
+	0x62, 0x4e, 0xb7, 0x2d, 0x43, 0xf8, 0xdd, 0xab, 0x30, 0xaf, 0x33, 0x47, 0xf1, 0x06, 0x67, 0x62,
+	0x40, 0x9a, 0xe0, 0x85, 0xe0, 0xf6, 0xbe, 0x0a, 0xf1, 0xd4, 0x5f, 0x33, 0x30, 0x9a, 0xd3, 0xe6,
+	0xa0, 0xdb, 0x30, 0xc2, 0xeb, 0xd2, 0x04, 0x49, 0x90, 0x9e, 0x5f, 0x7f, 0xc7, 0xba, 0x6c, 0x92,
+	0x15, 0x90, 0x0a, 0xc3, 0x03, 0x36, 0xe6, 0x4e, 0xce, 0x56, 0x8d, 0xd3, 0xb3, 0x55, 0x40, 0xfc,
+	0x3c, 0x94, 0x83, 0xb0, 0x26, 0x18, 0x55, 0xac, 0x5e, 0xa1, 0xca, 0x9c, 0xd2, 0x55, 0x12, 0x56,
+	0xe0, 0x84, 0x35, 0x70, 0xc2, 0x2a, 0x0f, 0x9c, 0x08, 0xd2, 0x8f, 0xfe, 0x5c, 0x05, 0x24, 0x16,
+	0xe6, 0x65, 0x95, 0x5f, 0xa4, 0xdb, 0xa9, 0x0f, 0x8a, 0x44, 0xfe, 0x49, 0x91, 0x30, 0x2f, 0xab,
+	0x10, 0x82, 0xd3, 0x0e, 0x6d, 0x33, 0x73, 0x3a, 0x09, 0xd2, 0x31, 0xa2, 0xd7, 0x28, 0x09, 0xe7,
+	0xeb, 0x4c, 0xd6, 0x04, 0xef, 0x28, 0xee, 0x3a, 0xe6, 0x8c, 0x86, 0x46, 0xb7, 0xd0, 0x16, 0x84,
+	0x54, 0x29, 0xc1, 0xab, 0x5d, 0xc5, 0xa4, 0x19, 0x4d, 0x46, 0xd2, 0xf3, 0xeb, 0xef, 0x4f, 0x76,
+	0xc1, 0xca, 0x5e, 0x10, 0xf3, 0x8e, 0x12, 0x07, 0x64, 0x24, 0x13, 0x7d, 0x01, 0x17, 0x46, 0x7b,
+	0x69, 0xce, 0xea, 0x4a, 0x6f, 0x5d, 0xa9, 0x14, 0x70, 0x0a, 0x4e, 0xc3, 0x25, 0xf3, 0xb5, 0x61,
+	0x80, 0x6e, 0xc0, 0xa8, 0x64, 0x35, 0xc1, 0x94, 0x39, 0xa7, 0x45, 0x86, 0x11, 0xfa, 0x14, 0x2e,
+	0x0a, 0x56, 0xe7, 0x82, 0xd5, 0x54, 0xa5, 0x2b, 0xb8, 0x34, 0x63, 0xc9, 0x48, 0x3a, 0xb6, 0x11,
+	0xf7, 0xce, 0x56, 0x17, 0x48, 0x08, 0xec, 0x91, 0x82, 0x24, 0x0b, 0x03, 0xda, 0x9e, 0xe0, 0x12,
+	0x7d, 0x08, 0x67, 0xa4, 0xa2, 0x8a, 0x99, 0x30, 0x09, 0xd2, 0x4b, 0xeb, 0xd7, 0xc7, 0x75, 0x94,
+	0x7c, 0x90, 0x04, 0x1c, 0xb4, 0x06, 0x91, 0x6c, 0xf2, 0x4e, 0x85, 0x76, 0xd5, 0xbe, 0x2b, 0xf8,
+	0x8f, 0x54, 0x9b, 0x35, 0x9f, 0x04, 0xe9, 0x39, 0x72, 0xcd, 0x47, 0xb2, 0xa3, 0x00, 0x4a, 0xc0,
+	0x39, 0xe6, 0xd4, 0x5d, 0x21, 0x59, 0xdd, 0x5c, 0xd0, 0xa4, 0x8b, 0x18, 0x7d, 0x02, 0xa3, 0xb6,
+	0xa0, 0x8e, 0x92, 0xe6, 0x62, 0x32, 0x92, 0x5e, 0x5a, 0x7f, 0x73, 0xfc, 0xe0, 0x6d, 0x1f, 0x2d,
+	0x1f, 0x74, 0x18, 0x09, 0x89, 0x68, 0x0d, 0x46, 0x83, 0xd9, 0x34, 0x97, 0x74, 0xca, 0x15, 0xad,
+	0xc4, 0x47, 0x49, 0x48, 0x4a, 0xdc, 0x86, 0xff, 0x1b, 0xeb, 0x03, 0x8a, 0xc3, 0x48, 0x93, 0x1d,
+	0xe8, 0x11, 0x8e, 0x11, 0x7f, 0x89, 0xfe, 0x0f, 0x67, 0xee, 0xd3, 0x56, 0x97, 0xe9, 0x81, 0x8c,
+	0x91, 0x20, 0xf8, 0x6c, 0xea, 0x16, 0x48, 0x7d, 0x0e, 0x67, 0x83, 0x6e, 0x4a, 0xf4, 0x31, 0x9c,
+	0x0d, 0x1e, 0x08, 0x7f, 0xfa, 0xfd, 0x6e, 0xdd, 0x98, 0xdc, 0x77, 0x32, 0xa0, 0xa5, 0x7e, 0x01,
+	0x30, 0xbe, 0xcd, 0x54, 0xb8, 0xcd, 0x7e, 0xe8, 0x32, 0xa9, 0xd0, 0x57, 0x10, 0x06, 0x78, 0xe5,
+	0x15, 0xef, 0x51, 0xac, 0x16, 0x82, 0x12, 0x7d, 0x09, 0xe1, 0xf0, 0x59, 0x79, 0xe9, 0x6d, 0xda,
+	0xf2, 0x29, 0xdf, 0x50, 0xd9, 0xdc, 0x98, 0xf6, 0x8b, 0x90, 0x58, 0x63, 0xb0, 0x91, 0x3a, 0x07,
+	0x10, 0xed, 0x70, 0x19, 0x4a, 0x94, 0x03, 0x8d, 0xdf, 0xf9, 0xd3, 0xd9, 0x6a, 0xd1, 0xaa, 0x2b,
+	0xa8, 0x72, 0x45, 0xa8, 0x72, 0x6d, 0x5c, 0x65, 0x51, 0xd8, 0xd4, 0x09, 0xdb, 0x5c, 0x14, 0x7b,
+	0x92, 0x89, 0x11, 0xc5, 0xe4, 0x52, 0x89, 0xd7, 0x96, 0xea, 0xf7, 0xc8, 0x15, 0x75, 0x26, 0xf4,
+	0x7d, 0x8f, 0x91, 0x20, 0xf0, 0x77, 0x5b, 0xbc, 0xcd, 0x95, 0xbe, 0xc6, 0x8b, 0x24, 0x08, 0xfc,
+	0xbb, 0xdd, 0xa1, 0x36, 0xd3, 0x17, 0x78, 0x91, 0xe8, 0x75, 0xea, 0x18, 0xc0, 0xe5, 0x9c, 0x7e,
+	0x42, 0x2e, 0xf7, 0xe3, 0x16, 0x8c, 0x06, 0x86, 0x86, 0x5f, 0xf9, 0x92, 0xae, 0x8e, 0x34, 0x20,
+	0xe4, 0xa3, 0xbb, 0x63, 0x2e, 0x4d, 0xbd, 0x82, 0x4b, 0xe1, 0x77, 0x5e, 0x2a, 0x94, 0x3a, 0x02,
+	0x70, 0x79, 0x4f, 0x3f, 0x54, 0xff, 0x96, 0xd4, 0xd7, 0x1e, 0x94, 0x3f, 0x00, 0x5c, 0x29, 0x0d,
+	0x46, 0x39, 0x37, 0x22, 0xf6, 0xbf, 0x18, 0xeb, 0xad, 0x89, 0xc6, 0xae, 0x5c, 0x7d, 0x1c, 0x87,
+	0x9c, 0x49, 0x3e, 0x7e, 0xf0, 0x13, 0x80, 0xb1, 0x8b, 0x07, 0x04, 0xad, 0x40, 0x73, 0x9b, 0x64,
+	0x77, 0xcb, 0x95, 0xec, 0x5e, 0xf9, 0x4e, 0x91, 0x14, 0xee, 0x65, 0xcb, 0x85, 0xe2, 0x6e, 0x25,
+	0x57, 0xdc, 0xcc, 0xc7, 0x0d, 0x84, 0xe0, 0x52, 0x80, 0x7e, 0x9b, 0x2d, 0x95, 0xee, 0x16, 0xc9,
+	0x66, 0x1c, 0xa0, 0x37, 0xe0, 0x72, 0xb0, 0x47, 0xf2, 0x5b, 0x24, 0x5f, 0xba, 0x53, 0x29, 0x17,
+	0xbf, 0xce, 0xef, 0xc6, 0xa7, 0x86, 0xa5, 0x72, 0x3b, 0x85, 0xbc, 0xff, 0x43, 0xf2, 0x9b, 0xf9,
+	0xdd, 0x72, 0x21, 0xbb, 0x53, 0x8a, 0x47, 0xd0, 0x75, 0x78, 0x2d, 0x40, 0x37, 0xf3, 0xdf, 0x17,
+	0x72, 0xf9, 0xe0, 0x84, 0xe9, 0xc4, 0xf4, 0xe1, 0xef, 0xd8, 0xd8, 0xf8, 0x0d, 0x9c, 0xf4, 0x30,
+	0x38, 0xed, 0x61, 0xf0, 0xb4, 0x87, 0x8d, 0x67, 0x3d, 0x6c, 0x9c, 0xf7, 0xb0, 0xf1, 0xbc, 0x87,
+	0x8d, 0x17, 0x3d, 0x0c, 0x1e, 0x7a, 0x18, 0x1c, 0x7a, 0xd8, 0x38, 0xf6, 0x30, 0x78, 0xe4, 0x61,
+	0xe3, 0xb1, 0x87, 0x8d, 0x27, 0x1e, 0x36, 0x4e, 0x3c, 0x0c, 0x4e, 0x3d, 0x0c, 0x9e, 0x7a, 0xd8,
+	0x78, 0xe6, 0x61, 0x70, 0xee, 0x61, 0xe3, 0xb9, 0x87, 0xc1, 0x0b, 0x0f, 0x1b, 0x0f, 0xfb, 0xd8,
 	0x38, 0xec, 0x63, 0x70, 0xd4, 0xc7, 0xc6, 0xcf, 0x7d, 0x0c, 0x7e, 0xed, 0x63, 0xe3, 0xb8, 0x8f,
-	0x8d, 0x87, 0x7d, 0x0c, 0x1e, 0xf5, 0x31, 0x78, 0xdc, 0xc7, 0xe0, 0xee, 0x47, 0x8e, 0x67, 0xa9,
-	0x7d, 0xa6, 0xf6, 0xb9, 0xeb, 0x48, 0xcb, 0x65, 0xea, 0xbe, 0x27, 0x5a, 0xa5, 0xab, 0x7f, 0xe0,
-	0x7e, 0xcb, 0x29, 0x29, 0xe5, 0xfa, 0xf5, 0x7a, 0x5a, 0x37, 0xf7, 0xfa, 0xdf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x18, 0xbe, 0x65, 0x85, 0xee, 0x08, 0x00, 0x00,
+	0x8d, 0x47, 0x7d, 0x0c, 0x1e, 0xf7, 0x31, 0x78, 0xd2, 0xc7, 0xe0, 0xde, 0x47, 0xb6, 0x6b, 0xa9,
+	0x7d, 0xa6, 0xf6, 0xb9, 0x63, 0x4b, 0xcb, 0x61, 0xea, 0x81, 0x2b, 0x9a, 0x99, 0xcb, 0x7f, 0xfb,
+	0x9d, 0xa6, 0x9d, 0x51, 0xca, 0xe9, 0x54, 0xab, 0x51, 0x3d, 0x12, 0x37, 0xff, 0x0e, 0x00, 0x00,
+	0xff, 0xff, 0x82, 0x1c, 0x88, 0x4a, 0x24, 0x09, 0x00, 0x00,
 }
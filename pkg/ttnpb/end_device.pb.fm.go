@@ -886,6 +886,7 @@ func (dst *EndDeviceVersion) SetFields(src *EndDeviceVersion, paths ...string) e
 }
 
 var MACSettingsFieldPathsNested = []string{
+	"adr_algorithm",
 	"adr_margin",
 	"class_b_timeout",
 	"class_c_timeout",
@@ -895,6 +896,7 @@ var MACSettingsFieldPathsNested = []string{
 }
 
 var MACSettingsFieldPathsTopLevel = []string{
+	"adr_algorithm",
 	"adr_margin",
 	"class_b_timeout",
 	"class_c_timeout",
@@ -966,6 +968,16 @@ func (dst *MACSettings) SetFields(src *MACSettings, paths ...string) error {
 				var zero uint32
 				dst.StatusCountPeriodicity = zero
 			}
+		case "adr_algorithm":
+			if len(subs) > 0 {
+				return fmt.Errorf("'adr_algorithm' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ADRAlgorithm = src.ADRAlgorithm
+			} else {
+				var zero string
+				dst.ADRAlgorithm = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1024,8 +1036,10 @@ var MACStateFieldPathsNested = []string{
 	"pending_application_downlink.class_b_c.absolute_time",
 	"pending_application_downlink.class_b_c.gateways",
 	"pending_application_downlink.confirmed",
+	"pending_application_downlink.confirmed_retries",
 	"pending_application_downlink.correlation_ids",
 	"pending_application_downlink.decoded_payload",
+	"pending_application_downlink.expires_at",
 	"pending_application_downlink.f_cnt",
 	"pending_application_downlink.f_port",
 	"pending_application_downlink.frm_payload",
@@ -1514,8 +1528,62 @@ func (dst *MACState_JoinAccept) SetFields(src *MACState_JoinAccept, paths ...str
 	return nil
 }
 
+var DevNoncePolicyFieldPathsNested = []string{
+	"mode",
+	"reset_allowed_until",
+	"used_list_window",
+}
+
+var DevNoncePolicyFieldPathsTopLevel = []string{
+	"mode",
+	"reset_allowed_until",
+	"used_list_window",
+}
+
+func (dst *DevNoncePolicy) SetFields(src *DevNoncePolicy, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "mode":
+			if len(subs) > 0 {
+				return fmt.Errorf("'mode' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Mode = src.Mode
+			} else {
+				var zero DevNonceMode
+				dst.Mode = zero
+			}
+		case "used_list_window":
+			if len(subs) > 0 {
+				return fmt.Errorf("'used_list_window' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UsedListWindow = src.UsedListWindow
+			} else {
+				var zero uint32
+				dst.UsedListWindow = zero
+			}
+		case "reset_allowed_until":
+			if len(subs) > 0 {
+				return fmt.Errorf("'reset_allowed_until' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ResetAllowedUntil = src.ResetAllowedUntil
+			} else {
+				dst.ResetAllowedUntil = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 var EndDeviceFieldPathsNested = []string{
 	"application_server_address",
+	"application_server_id",
+	"application_server_kek_label",
 	"attributes",
 	"battery_percentage",
 	"created_at",
@@ -1540,7 +1608,14 @@ var EndDeviceFieldPathsNested = []string{
 	"default_mac_parameters.rx2_frequency",
 	"default_mac_parameters.uplink_dwell_time",
 	"description",
+	"dev_nonce_policy",
+	"dev_nonce_policy.mode",
+	"dev_nonce_policy.reset_allowed_until",
+	"dev_nonce_policy.used_list_window",
 	"downlink_margin",
+	"downlink_policy",
+	"downlink_policy.expiry",
+	"downlink_policy.max_confirmed_retries",
 	"formatters",
 	"formatters.down_formatter",
 	"formatters.down_formatter_parameter",
@@ -1564,6 +1639,7 @@ var EndDeviceFieldPathsNested = []string{
 	"lorawan_phy_version",
 	"lorawan_version",
 	"mac_settings",
+	"mac_settings.adr_algorithm",
 	"mac_settings.adr_margin",
 	"mac_settings.class_b_timeout",
 	"mac_settings.class_c_timeout",
@@ -1620,8 +1696,10 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_state.pending_application_downlink.class_b_c.absolute_time",
 	"mac_state.pending_application_downlink.class_b_c.gateways",
 	"mac_state.pending_application_downlink.confirmed",
+	"mac_state.pending_application_downlink.confirmed_retries",
 	"mac_state.pending_application_downlink.correlation_ids",
 	"mac_state.pending_application_downlink.decoded_payload",
+	"mac_state.pending_application_downlink.expires_at",
 	"mac_state.pending_application_downlink.f_cnt",
 	"mac_state.pending_application_downlink.f_port",
 	"mac_state.pending_application_downlink.frm_payload",
@@ -1767,6 +1845,7 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_state.rx_windows_available",
 	"max_frequency",
 	"min_frequency",
+	"multicast",
 	"name",
 	"net_id",
 	"network_server_address",
@@ -1845,12 +1924,16 @@ var EndDeviceFieldPathsNested = []string{
 
 var EndDeviceFieldPathsTopLevel = []string{
 	"application_server_address",
+	"application_server_id",
+	"application_server_kek_label",
 	"attributes",
 	"battery_percentage",
 	"created_at",
 	"default_mac_parameters",
 	"description",
+	"dev_nonce_policy",
 	"downlink_margin",
+	"downlink_policy",
 	"formatters",
 	"frequency_plan_id",
 	"ids",
@@ -1867,6 +1950,7 @@ var EndDeviceFieldPathsTopLevel = []string{
 	"mac_state",
 	"max_frequency",
 	"min_frequency",
+	"multicast",
 	"name",
 	"net_id",
 	"network_server_address",
@@ -2441,6 +2525,78 @@ func (dst *EndDevice) SetFields(src *EndDevice, paths ...string) error {
 			} else {
 				dst.ProvisioningData = nil
 			}
+		case "multicast":
+			if len(subs) > 0 {
+				return fmt.Errorf("'multicast' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Multicast = src.Multicast
+			} else {
+				var zero bool
+				dst.Multicast = zero
+			}
+		case "downlink_policy":
+			if len(subs) > 0 {
+				newDst := dst.DownlinkPolicy
+				if newDst == nil {
+					newDst = &ApplicationDownlinkPolicy{}
+					dst.DownlinkPolicy = newDst
+				}
+				var newSrc *ApplicationDownlinkPolicy
+				if src != nil {
+					newSrc = src.DownlinkPolicy
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DownlinkPolicy = src.DownlinkPolicy
+				} else {
+					dst.DownlinkPolicy = nil
+				}
+			}
+		case "dev_nonce_policy":
+			if len(subs) > 0 {
+				newDst := dst.DevNoncePolicy
+				if newDst == nil {
+					newDst = &DevNoncePolicy{}
+					dst.DevNoncePolicy = newDst
+				}
+				var newSrc *DevNoncePolicy
+				if src != nil {
+					newSrc = src.DevNoncePolicy
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DevNoncePolicy = src.DevNoncePolicy
+				} else {
+					dst.DevNoncePolicy = nil
+				}
+			}
+		case "application_server_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'application_server_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ApplicationServerID = src.ApplicationServerID
+			} else {
+				var zero string
+				dst.ApplicationServerID = zero
+			}
+		case "application_server_kek_label":
+			if len(subs) > 0 {
+				return fmt.Errorf("'application_server_kek_label' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ApplicationServerKEKLabel = src.ApplicationServerKEKLabel
+			} else {
+				var zero string
+				dst.ApplicationServerKEKLabel = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -2480,6 +2636,8 @@ func (dst *EndDevices) SetFields(src *EndDevices, paths ...string) error {
 var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device",
 	"end_device.application_server_address",
+	"end_device.application_server_id",
+	"end_device.application_server_kek_label",
 	"end_device.attributes",
 	"end_device.battery_percentage",
 	"end_device.created_at",
@@ -2504,7 +2662,14 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.default_mac_parameters.rx2_frequency",
 	"end_device.default_mac_parameters.uplink_dwell_time",
 	"end_device.description",
+	"end_device.dev_nonce_policy",
+	"end_device.dev_nonce_policy.mode",
+	"end_device.dev_nonce_policy.reset_allowed_until",
+	"end_device.dev_nonce_policy.used_list_window",
 	"end_device.downlink_margin",
+	"end_device.downlink_policy",
+	"end_device.downlink_policy.expiry",
+	"end_device.downlink_policy.max_confirmed_retries",
 	"end_device.formatters",
 	"end_device.formatters.down_formatter",
 	"end_device.formatters.down_formatter_parameter",
@@ -2528,6 +2693,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr_algorithm",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.class_b_timeout",
 	"end_device.mac_settings.class_c_timeout",
@@ -2584,8 +2750,10 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.class_b_c.absolute_time",
 	"end_device.mac_state.pending_application_downlink.class_b_c.gateways",
 	"end_device.mac_state.pending_application_downlink.confirmed",
+	"end_device.mac_state.pending_application_downlink.confirmed_retries",
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
+	"end_device.mac_state.pending_application_downlink.expires_at",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
@@ -2731,6 +2899,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.rx_windows_available",
 	"end_device.max_frequency",
 	"end_device.min_frequency",
+	"end_device.multicast",
 	"end_device.name",
 	"end_device.net_id",
 	"end_device.network_server_address",
@@ -2843,6 +3012,8 @@ func (dst *CreateEndDeviceRequest) SetFields(src *CreateEndDeviceRequest, paths
 var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device",
 	"end_device.application_server_address",
+	"end_device.application_server_id",
+	"end_device.application_server_kek_label",
 	"end_device.attributes",
 	"end_device.battery_percentage",
 	"end_device.created_at",
@@ -2867,7 +3038,14 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.default_mac_parameters.rx2_frequency",
 	"end_device.default_mac_parameters.uplink_dwell_time",
 	"end_device.description",
+	"end_device.dev_nonce_policy",
+	"end_device.dev_nonce_policy.mode",
+	"end_device.dev_nonce_policy.reset_allowed_until",
+	"end_device.dev_nonce_policy.used_list_window",
 	"end_device.downlink_margin",
+	"end_device.downlink_policy",
+	"end_device.downlink_policy.expiry",
+	"end_device.downlink_policy.max_confirmed_retries",
 	"end_device.formatters",
 	"end_device.formatters.down_formatter",
 	"end_device.formatters.down_formatter_parameter",
@@ -2891,6 +3069,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr_algorithm",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.class_b_timeout",
 	"end_device.mac_settings.class_c_timeout",
@@ -2947,8 +3126,10 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.class_b_c.absolute_time",
 	"end_device.mac_state.pending_application_downlink.class_b_c.gateways",
 	"end_device.mac_state.pending_application_downlink.confirmed",
+	"end_device.mac_state.pending_application_downlink.confirmed_retries",
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
+	"end_device.mac_state.pending_application_downlink.expires_at",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
@@ -3094,6 +3275,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.rx_windows_available",
 	"end_device.max_frequency",
 	"end_device.min_frequency",
+	"end_device.multicast",
 	"end_device.name",
 	"end_device.net_id",
 	"end_device.network_server_address",
@@ -3359,6 +3541,8 @@ func (dst *ListEndDevicesRequest) SetFields(src *ListEndDevicesRequest, paths ..
 var SetEndDeviceRequestFieldPathsNested = []string{
 	"device",
 	"device.application_server_address",
+	"device.application_server_id",
+	"device.application_server_kek_label",
 	"device.attributes",
 	"device.battery_percentage",
 	"device.created_at",
@@ -3383,7 +3567,14 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"device.default_mac_parameters.rx2_frequency",
 	"device.default_mac_parameters.uplink_dwell_time",
 	"device.description",
+	"device.dev_nonce_policy",
+	"device.dev_nonce_policy.mode",
+	"device.dev_nonce_policy.reset_allowed_until",
+	"device.dev_nonce_policy.used_list_window",
 	"device.downlink_margin",
+	"device.downlink_policy",
+	"device.downlink_policy.expiry",
+	"device.downlink_policy.max_confirmed_retries",
 	"device.formatters",
 	"device.formatters.down_formatter",
 	"device.formatters.down_formatter_parameter",
@@ -3407,6 +3598,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"device.lorawan_phy_version",
 	"device.lorawan_version",
 	"device.mac_settings",
+	"device.mac_settings.adr_algorithm",
 	"device.mac_settings.adr_margin",
 	"device.mac_settings.class_b_timeout",
 	"device.mac_settings.class_c_timeout",
@@ -3463,8 +3655,10 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"device.mac_state.pending_application_downlink.class_b_c.absolute_time",
 	"device.mac_state.pending_application_downlink.class_b_c.gateways",
 	"device.mac_state.pending_application_downlink.confirmed",
+	"device.mac_state.pending_application_downlink.confirmed_retries",
 	"device.mac_state.pending_application_downlink.correlation_ids",
 	"device.mac_state.pending_application_downlink.decoded_payload",
+	"device.mac_state.pending_application_downlink.expires_at",
 	"device.mac_state.pending_application_downlink.f_cnt",
 	"device.mac_state.pending_application_downlink.f_port",
 	"device.mac_state.pending_application_downlink.frm_payload",
@@ -3610,6 +3804,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"device.mac_state.rx_windows_available",
 	"device.max_frequency",
 	"device.min_frequency",
+	"device.multicast",
 	"device.name",
 	"device.net_id",
 	"device.network_server_address",
@@ -62,7 +62,35 @@ var PowerState_value = map[string]int32{
 }
 
 func (PowerState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{0}
+	return fileDescriptor_end_device_d2fa1ca7395b0195, []int{0}
+}
+
+// DevNonce replay protection mode of the device.
+type DevNonceMode int32
+
+const (
+	// DevNonces are verified according to the LoRaWAN version of the join-request:
+	// strictly increasing for LoRaWAN 1.1 and later, and not used before for earlier versions.
+	DevNonceMode_DEV_NONCE_DEFAULT DevNonceMode = 0
+	// DevNonces must be strictly increasing, as specified by LoRaWAN 1.1.
+	DevNonceMode_DEV_NONCE_MONOTONIC DevNonceMode = 1
+	// DevNonces must not have been used before, as specified by LoRaWAN 1.0.x.
+	DevNonceMode_DEV_NONCE_USED_LIST DevNonceMode = 2
+)
+
+var DevNonceMode_name = map[int32]string{
+	0: "DEV_NONCE_DEFAULT",
+	1: "DEV_NONCE_MONOTONIC",
+	2: "DEV_NONCE_USED_LIST",
+}
+var DevNonceMode_value = map[string]int32{
+	"DEV_NONCE_DEFAULT":   0,
+	"DEV_NONCE_MONOTONIC": 1,
+	"DEV_NONCE_USED_LIST": 2,
+}
+
+func (DevNonceMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_end_device_d2fa1ca7395b0195, []int{1}
 }
 
 type Session struct {
@@ -87,7 +115,7 @@ type Session struct {
 func (m *Session) Reset()      { *m = Session{} }
 func (*Session) ProtoMessage() {}
 func (*Session) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{0}
+	return fileDescriptor_end_device_d2fa1ca7395b0195, []int{0}
 }
 func (m *Session) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -199,7 +227,7 @@ type MACParameters struct {
 func (m *MACParameters) Reset()      { *m = MACParameters{} }
 func (*MACParameters) ProtoMessage() {}
 func (*MACParameters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{1}
+	return fileDescriptor_end_device_d2fa1ca7395b0195, []int{1}
 }
 func (m *MACParameters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -379,7 +407,7 @@ type MACParameters_Channel struct {
 func (m *MACParameters_Channel) Reset()      { *m = MACParameters_Channel{} }
 func (*MACParameters_Channel) ProtoMessage() {}
 func (*MACParameters_Channel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{1, 0}
+	return fileDescriptor_end_device_d2fa1ca7395b0195, []int{1, 0}
 }
 func (m *MACParameters_Channel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -456,7 +484,7 @@ type EndDeviceBrand struct {
 func (m *EndDeviceBrand) Reset()      { *m = EndDeviceBrand{} }
 func (*EndDeviceBrand) ProtoMessage() {}
 func (*EndDeviceBrand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{2}
+	return fileDescriptor_end_device_d2fa1ca7395b0195, []int{2}
 }
 func (m *EndDeviceBrand) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -524,7 +552,7 @@ type EndDeviceModel struct {
 func (m *EndDeviceModel) Reset()      { *m = EndDeviceModel{} }
 func (*EndDeviceModel) ProtoMessage() {}
 func (*EndDeviceModel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{3}
+	return fileDescriptor_end_device_d2fa1ca7395b0195, []int{3}
 }
 func (m *EndDeviceModel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -587,7 +615,7 @@ type EndDeviceVersionIdentifiers struct {
 func (m *EndDeviceVersionIdentifiers) Reset()      { *m = EndDeviceVersionIdentifiers{} }
 func (*EndDeviceVersionIdentifiers) ProtoMessage() {}
 func (*EndDeviceVersionIdentifiers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{4}
+	return fileDescriptor_end_device_d2fa1ca7395b0195, []int{4}
 }
 func (m *EndDeviceVersionIdentifiers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +711,7 @@ type EndDeviceVersion struct {
 func (m *EndDeviceVersion) Reset()      { *m = EndDeviceVersion{} }
 func (*EndDeviceVersion) ProtoMessage() {}
 func (*EndDeviceVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{5}
+	return fileDescriptor_end_device_d2fa1ca7395b0195, []int{5}
 }
 func (m *EndDeviceVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -822,15 +850,18 @@ type MACSettings struct {
 	// The interval after which a DevStatusReq MACCommand shall be sent.
 	StatusTimePeriodicity time.Duration `protobuf:"bytes,5,opt,name=status_time_periodicity,json=statusTimePeriodicity,proto3,stdduration" json:"status_time_periodicity"`
 	// Number of uplink messages after which a DevStatusReq MACCommand shall be sent.
-	StatusCountPeriodicity uint32   `protobuf:"varint,6,opt,name=status_count_periodicity,json=statusCountPeriodicity,proto3" json:"status_count_periodicity,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	StatusCountPeriodicity uint32 `protobuf:"varint,6,opt,name=status_count_periodicity,json=statusCountPeriodicity,proto3" json:"status_count_periodicity,omitempty"`
+	// Name of the ADR algorithm the Network Server uses for the device.
+	// If empty, the default ADR algorithm of the Network Server is used.
+	ADRAlgorithm         string   `protobuf:"bytes,7,opt,name=adr_algorithm,json=adrAlgorithm,proto3" json:"adr_algorithm,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *MACSettings) Reset()      { *m = MACSettings{} }
 func (*MACSettings) ProtoMessage() {}
 func (*MACSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{6}
+	return fileDescriptor_end_device_d2fa1ca7395b0195, []int{6}
 }
 func (m *MACSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -901,6 +932,13 @@ func (m *MACSettings) GetStatusCountPeriodicity() uint32 {
 	return 0
 }
 
+func (m *MACSettings) GetADRAlgorithm() string {
+	if m != nil {
+		return m.ADRAlgorithm
+	}
+	return ""
+}
+
 // MACState represents the state of MAC layer of the device.
 // MACState is reset on each join for OTAA or ResetInd for ABP devices.
 // This is used internally by the Network Server and is read only.
@@ -947,7 +985,7 @@ type MACState struct {
 func (m *MACState) Reset()      { *m = MACState{} }
 func (*MACState) ProtoMessage() {}
 func (*MACState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{7}
+	return fileDescriptor_end_device_d2fa1ca7395b0195, []int{7}
 }
 func (m *MACState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1081,7 +1119,7 @@ type MACState_JoinAccept struct {
 func (m *MACState_JoinAccept) Reset()      { *m = MACState_JoinAccept{} }
 func (*MACState_JoinAccept) ProtoMessage() {}
 func (*MACState_JoinAccept) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{7, 0}
+	return fileDescriptor_end_device_d2fa1ca7395b0195, []int{7, 0}
 }
 func (m *MACState_JoinAccept) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1131,6 +1169,72 @@ func (m *MACState_JoinAccept) GetKeys() SessionKeys {
 	return SessionKeys{}
 }
 
+// DevNonce replay protection policy of the device.
+type DevNoncePolicy struct {
+	Mode DevNonceMode `protobuf:"varint,1,opt,name=mode,proto3,enum=ttn.lorawan.v3.DevNonceMode" json:"mode,omitempty"`
+	// Number of most recently used DevNonces to remember in used list mode.
+	// If zero, all used DevNonces are remembered.
+	UsedListWindow uint32 `protobuf:"varint,2,opt,name=used_list_window,json=usedListWindow,proto3" json:"used_list_window,omitempty"`
+	// Time until which the device is allowed to reset its DevNonce.
+	// A join-request with a replayed DevNonce received before this time clears the DevNonce history of the device.
+	ResetAllowedUntil    *time.Time `protobuf:"bytes,3,opt,name=reset_allowed_until,json=resetAllowedUntil,proto3,stdtime" json:"reset_allowed_until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *DevNoncePolicy) Reset()      { *m = DevNoncePolicy{} }
+func (*DevNoncePolicy) ProtoMessage() {}
+func (*DevNoncePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_end_device_d2fa1ca7395b0195, []int{8}
+}
+func (m *DevNoncePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DevNoncePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DevNoncePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *DevNoncePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DevNoncePolicy.Merge(dst, src)
+}
+func (m *DevNoncePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *DevNoncePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DevNoncePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DevNoncePolicy proto.InternalMessageInfo
+
+func (m *DevNoncePolicy) GetMode() DevNonceMode {
+	if m != nil {
+		return m.Mode
+	}
+	return DevNonceMode_DEV_NONCE_DEFAULT
+}
+
+func (m *DevNoncePolicy) GetUsedListWindow() uint32 {
+	if m != nil {
+		return m.UsedListWindow
+	}
+	return 0
+}
+
+func (m *DevNoncePolicy) GetResetAllowedUntil() *time.Time {
+	if m != nil {
+		return m.ResetAllowedUntil
+	}
+	return nil
+}
+
 // Defines an End Device registration and its state on the network.
 // The persistence of the EndDevice is divided between the Network Server, Application Server and Join Server.
 // SDKs are responsible for combining (if desired) the three.
@@ -1220,11 +1324,11 @@ type EndDevice struct {
 	// Pending session. Stored in Network Server and Application Server until RekeyInd is received.
 	PendingSession *Session `protobuf:"bytes,30,opt,name=pending_session,json=pendingSession,proto3" json:"pending_session,omitempty"`
 	// Last DevNonce used.
-	// This field is only used for devices using LoRaWAN version 1.1 and later.
+	// This field is only used for devices using LoRaWAN version 1.1 and later or the monotonic DevNonce mode.
 	// Stored in Join Server.
 	LastDevNonce uint32 `protobuf:"varint,31,opt,name=last_dev_nonce,json=lastDevNonce,proto3" json:"last_dev_nonce,omitempty"`
-	// Used DevNonces sorted in ascending order.
-	// This field is only used for devices using LoRaWAN versions preceding 1.1.
+	// Used DevNonces sorted in ascending order, or in order of use for devices with the used list DevNonce mode.
+	// This field is only used for devices using LoRaWAN versions preceding 1.1 or the used list DevNonce mode.
 	// Stored in Join Server.
 	UsedDevNonces []uint32 `protobuf:"varint,32,rep,packed,name=used_dev_nonces,json=usedDevNonces,proto3" json:"used_dev_nonces,omitempty"`
 	// Last JoinNonce/AppNonce(for devices using LoRaWAN versions preceding 1.1) used.
@@ -1270,15 +1374,33 @@ type EndDevice struct {
 	// ID of the provisioner. Stored in Join Server.
 	ProvisionerID string `protobuf:"bytes,45,opt,name=provisioner_id,json=provisionerId,proto3" json:"provisioner_id,omitempty"`
 	// Vendor-specific provisioning data. Stored in Join Server.
-	ProvisioningData     *types.Struct `protobuf:"bytes,46,opt,name=provisioning_data,json=provisioningData,proto3" json:"provisioning_data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	ProvisioningData *types.Struct `protobuf:"bytes,46,opt,name=provisioning_data,json=provisioningData,proto3" json:"provisioning_data,omitempty"`
+	// Whether the device represents a multicast group.
+	// A multicast group has a DevAddr, session keys and frame counters shared by all devices in the group.
+	// Multicast groups do not send uplink messages and only receive class B or C downlink messages,
+	// which are transmitted by the gateways specified in the downlink message.
+	// Stored in Network Server.
+	Multicast bool `protobuf:"varint,47,opt,name=multicast,proto3" json:"multicast,omitempty"`
+	// The downlink policy for this end device. Stored in Application Server.
+	// If null, the downlink policy of the application link is used.
+	DownlinkPolicy *ApplicationDownlinkPolicy `protobuf:"bytes,48,opt,name=downlink_policy,json=downlinkPolicy,proto3" json:"downlink_policy,omitempty"`
+	// The DevNonce replay protection policy for this end device. Stored in Join Server.
+	// If null, DevNonces are verified according to the LoRaWAN version.
+	DevNoncePolicy *DevNoncePolicy `protobuf:"bytes,49,opt,name=dev_nonce_policy,json=devNoncePolicy,proto3" json:"dev_nonce_policy,omitempty"`
+	// The AS-ID of the Application Server that may retrieve the AppSKey using LoRaWAN Backend Interfaces. Stored in Join Server.
+	// If empty, the AppSKey cannot be retrieved using LoRaWAN Backend Interfaces.
+	ApplicationServerID string `protobuf:"bytes,50,opt,name=application_server_id,json=applicationServerId,proto3" json:"application_server_id,omitempty"`
+	// The label of the KEK that wraps the AppSKey for the Application Server. Stored in Join Server.
+	// If empty, the KEK label configured for the AS-ID of the Application Server is used.
+	ApplicationServerKEKLabel string   `protobuf:"bytes,51,opt,name=application_server_kek_label,json=applicationServerKekLabel,proto3" json:"application_server_kek_label,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
 }
 
 func (m *EndDevice) Reset()      { *m = EndDevice{} }
 func (*EndDevice) ProtoMessage() {}
 func (*EndDevice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{8}
+	return fileDescriptor_end_device_d2fa1ca7395b0195, []int{9}
 }
 func (m *EndDevice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1615,6 +1737,41 @@ func (m *EndDevice) GetProvisioningData() *types.Struct {
 	return nil
 }
 
+func (m *EndDevice) GetMulticast() bool {
+	if m != nil {
+		return m.Multicast
+	}
+	return false
+}
+
+func (m *EndDevice) GetDownlinkPolicy() *ApplicationDownlinkPolicy {
+	if m != nil {
+		return m.DownlinkPolicy
+	}
+	return nil
+}
+
+func (m *EndDevice) GetDevNoncePolicy() *DevNoncePolicy {
+	if m != nil {
+		return m.DevNoncePolicy
+	}
+	return nil
+}
+
+func (m *EndDevice) GetApplicationServerID() string {
+	if m != nil {
+		return m.ApplicationServerID
+	}
+	return ""
+}
+
+func (m *EndDevice) GetApplicationServerKEKLabel() string {
+	if m != nil {
+		return m.ApplicationServerKEKLabel
+	}
+	return ""
+}
+
 type EndDevices struct {
 	EndDevices           []*EndDevice `protobuf:"bytes,1,rep,name=end_devices,json=endDevices,proto3" json:"end_devices,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -1624,7 +1781,7 @@ type EndDevices struct {
 func (m *EndDevices) Reset()      { *m = EndDevices{} }
 func (*EndDevices) ProtoMessage() {}
 func (*EndDevices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{9}
+	return fileDescriptor_end_device_d2fa1ca7395b0195, []int{10}
 }
 func (m *EndDevices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1669,7 +1826,7 @@ type CreateEndDeviceRequest struct {
 func (m *CreateEndDeviceRequest) Reset()      { *m = CreateEndDeviceRequest{} }
 func (*CreateEndDeviceRequest) ProtoMessage() {}
 func (*CreateEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{10}
+	return fileDescriptor_end_device_d2fa1ca7395b0195, []int{11}
 }
 func (m *CreateEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1708,7 +1865,7 @@ type UpdateEndDeviceRequest struct {
 func (m *UpdateEndDeviceRequest) Reset()      { *m = UpdateEndDeviceRequest{} }
 func (*UpdateEndDeviceRequest) ProtoMessage() {}
 func (*UpdateEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{11}
+	return fileDescriptor_end_device_d2fa1ca7395b0195, []int{12}
 }
 func (m *UpdateEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1754,7 +1911,7 @@ type GetEndDeviceRequest struct {
 func (m *GetEndDeviceRequest) Reset()      { *m = GetEndDeviceRequest{} }
 func (*GetEndDeviceRequest) ProtoMessage() {}
 func (*GetEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{12}
+	return fileDescriptor_end_device_d2fa1ca7395b0195, []int{13}
 }
 func (m *GetEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1807,7 +1964,7 @@ type ListEndDevicesRequest struct {
 func (m *ListEndDevicesRequest) Reset()      { *m = ListEndDevicesRequest{} }
 func (*ListEndDevicesRequest) ProtoMessage() {}
 func (*ListEndDevicesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{13}
+	return fileDescriptor_end_device_d2fa1ca7395b0195, []int{14}
 }
 func (m *ListEndDevicesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1874,7 +2031,7 @@ type SetEndDeviceRequest struct {
 func (m *SetEndDeviceRequest) Reset()      { *m = SetEndDeviceRequest{} }
 func (*SetEndDeviceRequest) ProtoMessage() {}
 func (*SetEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{14}
+	return fileDescriptor_end_device_d2fa1ca7395b0195, []int{15}
 }
 func (m *SetEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1938,6 +2095,8 @@ func init() {
 	golang_proto.RegisterType((*MACState)(nil), "ttn.lorawan.v3.MACState")
 	proto.RegisterType((*MACState_JoinAccept)(nil), "ttn.lorawan.v3.MACState.JoinAccept")
 	golang_proto.RegisterType((*MACState_JoinAccept)(nil), "ttn.lorawan.v3.MACState.JoinAccept")
+	proto.RegisterType((*DevNoncePolicy)(nil), "ttn.lorawan.v3.DevNoncePolicy")
+	golang_proto.RegisterType((*DevNoncePolicy)(nil), "ttn.lorawan.v3.DevNoncePolicy")
 	proto.RegisterType((*EndDevice)(nil), "ttn.lorawan.v3.EndDevice")
 	golang_proto.RegisterType((*EndDevice)(nil), "ttn.lorawan.v3.EndDevice")
 	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.EndDevice.AttributesEntry")
@@ -1958,6 +2117,8 @@ func init() {
 	golang_proto.RegisterType((*SetEndDeviceRequest)(nil), "ttn.lorawan.v3.SetEndDeviceRequest")
 	proto.RegisterEnum("ttn.lorawan.v3.PowerState", PowerState_name, PowerState_value)
 	golang_proto.RegisterEnum("ttn.lorawan.v3.PowerState", PowerState_name, PowerState_value)
+	proto.RegisterEnum("ttn.lorawan.v3.DevNonceMode", DevNonceMode_name, DevNonceMode_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.DevNonceMode", DevNonceMode_name, DevNonceMode_value)
 }
 func (x PowerState) String() string {
 	s, ok := PowerState_name[int32(x)]
@@ -1966,6 +2127,13 @@ func (x PowerState) String() string {
 	}
 	return strconv.Itoa(int(x))
 }
+func (x DevNonceMode) String() string {
+	s, ok := DevNonceMode_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
 func (this *Session) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2336,6 +2504,9 @@ func (this *MACSettings) Equal(that interface{}) bool {
 	if this.StatusCountPeriodicity != that1.StatusCountPeriodicity {
 		return false
 	}
+	if this.ADRAlgorithm != that1.ADRAlgorithm {
+		return false
+	}
 	return true
 }
 func (this *MACState) Equal(that interface{}) bool {
@@ -2442,6 +2613,40 @@ func (this *MACState_JoinAccept) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DevNoncePolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DevNoncePolicy)
+	if !ok {
+		that2, ok := that.(DevNoncePolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Mode != that1.Mode {
+		return false
+	}
+	if this.UsedListWindow != that1.UsedListWindow {
+		return false
+	}
+	if that1.ResetAllowedUntil == nil {
+		if this.ResetAllowedUntil != nil {
+			return false
+		}
+	} else if !this.ResetAllowedUntil.Equal(*that1.ResetAllowedUntil) {
+		return false
+	}
+	return true
+}
 func (this *EndDevice) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2642,6 +2847,21 @@ func (this *EndDevice) Equal(that interface{}) bool {
 	if !this.ProvisioningData.Equal(that1.ProvisioningData) {
 		return false
 	}
+	if this.Multicast != that1.Multicast {
+		return false
+	}
+	if !this.DownlinkPolicy.Equal(that1.DownlinkPolicy) {
+		return false
+	}
+	if !this.DevNoncePolicy.Equal(that1.DevNoncePolicy) {
+		return false
+	}
+	if this.ApplicationServerID != that1.ApplicationServerID {
+		return false
+	}
+	if this.ApplicationServerKEKLabel != that1.ApplicationServerKEKLabel {
+		return false
+	}
 	return true
 }
 func (this *EndDevices) Equal(that interface{}) bool {
@@ -3396,6 +3616,12 @@ func (m *MACSettings) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.StatusCountPeriodicity))
 	}
+	if len(m.ADRAlgorithm) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ADRAlgorithm)))
+		i += copy(dAtA[i:], m.ADRAlgorithm)
+	}
 	return i, nil
 }
 
@@ -3567,6 +3793,44 @@ func (m *MACState_JoinAccept) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *DevNoncePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DevNoncePolicy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Mode))
+	}
+	if m.UsedListWindow != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.UsedListWindow))
+	}
+	if m.ResetAllowedUntil != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ResetAllowedUntil)))
+		n18, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ResetAllowedUntil, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	return i, nil
+}
+
 func (m *EndDevice) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3585,27 +3849,27 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.EndDeviceIdentifiers.Size()))
-	n18, err := m.EndDeviceIdentifiers.MarshalTo(dAtA[i:])
+	n19, err := m.EndDeviceIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n19
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)))
-	n19, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i:])
+	n20, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n19
+	i += n20
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)))
-	n20, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i:])
+	n21, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n20
+	i += n21
 	if len(m.Name) > 0 {
 		dAtA[i] = 0x22
 		i++
@@ -3639,11 +3903,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.VersionIDs.Size()))
-		n21, err := m.VersionIDs.MarshalTo(dAtA[i:])
+		n22, err := m.VersionIDs.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n22
 	}
 	if len(m.ServiceProfileID) > 0 {
 		dAtA[i] = 0x42
@@ -3689,11 +3953,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 				dAtA[i] = 0x12
 				i++
 				i = encodeVarintEndDevice(dAtA, i, uint64(v.Size()))
-				n22, err := v.MarshalTo(dAtA[i:])
+				n23, err := v.MarshalTo(dAtA[i:])
 				if err != nil {
 					return 0, err
 				}
-				i += n22
+				i += n23
 			}
 		}
 	}
@@ -3743,11 +4007,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.DefaultMACParameters.Size()))
-		n23, err := m.DefaultMACParameters.MarshalTo(dAtA[i:])
+		n24, err := m.DefaultMACParameters.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n24
 	}
 	if m.MinFrequency != 0 {
 		dAtA[i] = 0x98
@@ -3817,11 +4081,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.RootKeys.Size()))
-		n24, err := m.RootKeys.MarshalTo(dAtA[i:])
+		n25, err := m.RootKeys.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n25
 	}
 	if m.NetID != nil {
 		dAtA[i] = 0xd2
@@ -3829,11 +4093,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.NetID.Size()))
-		n25, err := m.NetID.MarshalTo(dAtA[i:])
+		n26, err := m.NetID.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n26
 	}
 	if m.MACSettings != nil {
 		dAtA[i] = 0xda
@@ -3841,11 +4105,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.MACSettings.Size()))
-		n26, err := m.MACSettings.MarshalTo(dAtA[i:])
+		n27, err := m.MACSettings.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n27
 	}
 	if m.MACState != nil {
 		dAtA[i] = 0xe2
@@ -3853,11 +4117,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.MACState.Size()))
-		n27, err := m.MACState.MarshalTo(dAtA[i:])
+		n28, err := m.MACState.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n28
 	}
 	if m.Session != nil {
 		dAtA[i] = 0xea
@@ -3865,11 +4129,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.Session.Size()))
-		n28, err := m.Session.MarshalTo(dAtA[i:])
+		n29, err := m.Session.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n29
 	}
 	if m.PendingSession != nil {
 		dAtA[i] = 0xf2
@@ -3877,11 +4141,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.PendingSession.Size()))
-		n29, err := m.PendingSession.MarshalTo(dAtA[i:])
+		n30, err := m.PendingSession.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n30
 	}
 	if m.LastDevNonce != 0 {
 		dAtA[i] = 0xf8
@@ -3891,23 +4155,23 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastDevNonce))
 	}
 	if len(m.UsedDevNonces) > 0 {
-		dAtA31 := make([]byte, len(m.UsedDevNonces)*10)
-		var j30 int
+		dAtA32 := make([]byte, len(m.UsedDevNonces)*10)
+		var j31 int
 		for _, num := range m.UsedDevNonces {
 			for num >= 1<<7 {
-				dAtA31[j30] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j30++
+				j31++
 			}
-			dAtA31[j30] = uint8(num)
-			j30++
+			dAtA32[j31] = uint8(num)
+			j31++
 		}
 		dAtA[i] = 0x82
 		i++
 		dAtA[i] = 0x2
 		i++
-		i = encodeVarintEndDevice(dAtA, i, uint64(j30))
-		i += copy(dAtA[i:], dAtA31[:j30])
+		i = encodeVarintEndDevice(dAtA, i, uint64(j31))
+		i += copy(dAtA[i:], dAtA32[:j31])
 	}
 	if m.LastJoinNonce != 0 {
 		dAtA[i] = 0x88
@@ -3936,11 +4200,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDevStatusReceivedAt)))
-		n32, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDevStatusReceivedAt, dAtA[i:])
+		n33, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDevStatusReceivedAt, dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n33
 	}
 	if m.PowerState != 0 {
 		dAtA[i] = 0xa8
@@ -4026,11 +4290,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.Formatters.Size()))
-		n33, err := m.Formatters.MarshalTo(dAtA[i:])
+		n34, err := m.Formatters.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n34
 	}
 	if len(m.ProvisionerID) > 0 {
 		dAtA[i] = 0xea
@@ -4046,11 +4310,63 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.ProvisioningData.Size()))
-		n34, err := m.ProvisioningData.MarshalTo(dAtA[i:])
+		n35, err := m.ProvisioningData.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n35
+	}
+	if m.Multicast {
+		dAtA[i] = 0xf8
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.Multicast {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.DownlinkPolicy != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.DownlinkPolicy.Size()))
+		n36, err := m.DownlinkPolicy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
+	if m.DevNoncePolicy != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.DevNoncePolicy.Size()))
+		n37, err := m.DevNoncePolicy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n37
+	}
+	if len(m.ApplicationServerID) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ApplicationServerID)))
+		i += copy(dAtA[i:], m.ApplicationServerID)
+	}
+	if len(m.ApplicationServerKEKLabel) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ApplicationServerKEKLabel)))
+		i += copy(dAtA[i:], m.ApplicationServerKEKLabel)
 	}
 	return i, nil
 }
@@ -4103,11 +4419,11 @@ func (m *CreateEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.EndDevice.Size()))
-	n35, err := m.EndDevice.MarshalTo(dAtA[i:])
+	n38, err := m.EndDevice.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n35
+	i += n38
 	return i, nil
 }
 
@@ -4129,19 +4445,19 @@ func (m *UpdateEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.EndDevice.Size()))
-	n36, err := m.EndDevice.MarshalTo(dAtA[i:])
+	n39, err := m.EndDevice.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n36
+	i += n39
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.FieldMask.Size()))
-	n37, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n40, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n37
+	i += n40
 	return i, nil
 }
 
@@ -4163,19 +4479,19 @@ func (m *GetEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.EndDeviceIdentifiers.Size()))
-	n38, err := m.EndDeviceIdentifiers.MarshalTo(dAtA[i:])
+	n41, err := m.EndDeviceIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n38
+	i += n41
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.FieldMask.Size()))
-	n39, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n42, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n39
+	i += n42
 	return i, nil
 }
 
@@ -4197,19 +4513,19 @@ func (m *ListEndDevicesRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.ApplicationIdentifiers.Size()))
-	n40, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
+	n43, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n40
+	i += n43
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.FieldMask.Size()))
-	n41, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n44, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n41
+	i += n44
 	if len(m.Order) > 0 {
 		dAtA[i] = 0x1a
 		i++
@@ -4247,19 +4563,19 @@ func (m *SetEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.Device.Size()))
-	n42, err := m.Device.MarshalTo(dAtA[i:])
+	n45, err := m.Device.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n42
+	i += n45
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.FieldMask.Size()))
-	n43, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n46, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n43
+	i += n46
 	return i, nil
 }
 
@@ -4336,6 +4652,7 @@ func NewPopulatedMACSettings(r randyEndDevice, easy bool) *MACSettings {
 	v7 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
 	this.StatusTimePeriodicity = *v7
 	this.StatusCountPeriodicity = r.Uint32()
+	this.ADRAlgorithm = randStringEndDevice(r)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4357,6 +4674,18 @@ func NewPopulatedMACState_JoinAccept(r randyEndDevice, easy bool) *MACState_Join
 	return this
 }
 
+func NewPopulatedDevNoncePolicy(r randyEndDevice, easy bool) *DevNoncePolicy {
+	this := &DevNoncePolicy{}
+	this.Mode = DevNonceMode([]int32{0, 1, 2}[r.Intn(3)])
+	this.UsedListWindow = r.Uint32()
+	if r.Intn(10) != 0 {
+		this.ResetAllowedUntil = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedEndDevices(r randyEndDevice, easy bool) *EndDevices {
 	this := &EndDevices{}
 	if r.Intn(10) != 0 {
@@ -4770,6 +5099,10 @@ func (m *MACSettings) Size() (n int) {
 	if m.StatusCountPeriodicity != 0 {
 		n += 1 + sovEndDevice(uint64(m.StatusCountPeriodicity))
 	}
+	l = len(m.ADRAlgorithm)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
 	return n
 }
 
@@ -4846,6 +5179,25 @@ func (m *MACState_JoinAccept) Size() (n int) {
 	return n
 }
 
+func (m *DevNoncePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		n += 1 + sovEndDevice(uint64(m.Mode))
+	}
+	if m.UsedListWindow != 0 {
+		n += 1 + sovEndDevice(uint64(m.UsedListWindow))
+	}
+	if m.ResetAllowedUntil != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ResetAllowedUntil)
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
 func (m *EndDevice) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5037,6 +5389,25 @@ func (m *EndDevice) Size() (n int) {
 		l = m.ProvisioningData.Size()
 		n += 2 + l + sovEndDevice(uint64(l))
 	}
+	if m.Multicast {
+		n += 3
+	}
+	if m.DownlinkPolicy != nil {
+		l = m.DownlinkPolicy.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DevNoncePolicy != nil {
+		l = m.DevNoncePolicy.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.ApplicationServerID)
+	if l > 0 {
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	l = len(m.ApplicationServerKEKLabel)
+	if l > 0 {
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
 	return n
 }
 
@@ -5272,6 +5643,7 @@ func (this *MACSettings) String() string {
 		`ClassCTimeout:` + strings.Replace(strings.Replace(this.ClassCTimeout.String(), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
 		`StatusTimePeriodicity:` + strings.Replace(strings.Replace(this.StatusTimePeriodicity.String(), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
 		`StatusCountPeriodicity:` + fmt.Sprintf("%v", this.StatusCountPeriodicity) + `,`,
+		`ADRAlgorithm:` + fmt.Sprintf("%v", this.ADRAlgorithm) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5310,6 +5682,18 @@ func (this *MACState_JoinAccept) String() string {
 	}, "")
 	return s
 }
+func (this *DevNoncePolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DevNoncePolicy{`,
+		`Mode:` + fmt.Sprintf("%v", this.Mode) + `,`,
+		`UsedListWindow:` + fmt.Sprintf("%v", this.UsedListWindow) + `,`,
+		`ResetAllowedUntil:` + strings.Replace(fmt.Sprintf("%v", this.ResetAllowedUntil), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *EndDevice) String() string {
 	if this == nil {
 		return "nil"
@@ -5381,6 +5765,11 @@ func (this *EndDevice) String() string {
 		`Formatters:` + strings.Replace(fmt.Sprintf("%v", this.Formatters), "MessagePayloadFormatters", "MessagePayloadFormatters", 1) + `,`,
 		`ProvisionerID:` + fmt.Sprintf("%v", this.ProvisionerID) + `,`,
 		`ProvisioningData:` + strings.Replace(fmt.Sprintf("%v", this.ProvisioningData), "Struct", "types.Struct", 1) + `,`,
+		`Multicast:` + fmt.Sprintf("%v", this.Multicast) + `,`,
+		`DownlinkPolicy:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkPolicy), "ApplicationDownlinkPolicy", "ApplicationDownlinkPolicy", 1) + `,`,
+		`DevNoncePolicy:` + strings.Replace(fmt.Sprintf("%v", this.DevNoncePolicy), "DevNoncePolicy", "DevNoncePolicy", 1) + `,`,
+		`ApplicationServerID:` + fmt.Sprintf("%v", this.ApplicationServerID) + `,`,
+		`ApplicationServerKEKLabel:` + fmt.Sprintf("%v", this.ApplicationServerKEKLabel) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7282,7 +7671,36 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRAlgorithm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ADRAlgorithm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
 			if err != nil {
@@ -7844,6 +8262,127 @@ func (m *MACState_JoinAccept) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DevNoncePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DevNoncePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DevNoncePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= (DevNonceMode(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedListWindow", wireType)
+			}
+			m.UsedListWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedListWindow |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetAllowedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResetAllowedUntil == nil {
+				m.ResetAllowedUntil = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ResetAllowedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EndDevice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9316,6 +9855,150 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 47:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multicast", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Multicast = bool(v != 0)
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkPolicy == nil {
+				m.DownlinkPolicy = &ApplicationDownlinkPolicy{}
+			}
+			if err := m.DownlinkPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevNoncePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DevNoncePolicy == nil {
+				m.DevNoncePolicy = &DevNoncePolicy{}
+			}
+			if err := m.DevNoncePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 50:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationServerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApplicationServerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationServerKEKLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApplicationServerKEKLabel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
@@ -10111,227 +10794,245 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("lorawan-stack/api/end_device.proto", fileDescriptor_end_device_f8ea6acb7b9cd33a)
+	proto.RegisterFile("lorawan-stack/api/end_device.proto", fileDescriptor_end_device_d2fa1ca7395b0195)
 }
 func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/end_device.proto", fileDescriptor_end_device_f8ea6acb7b9cd33a)
-}
-
-var fileDescriptor_end_device_f8ea6acb7b9cd33a = []byte{
-	// 3434 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x4d, 0x6c, 0x1c, 0xc7,
-	0x95, 0x9e, 0x1e, 0x52, 0xe4, 0x4c, 0x0d, 0xe7, 0xaf, 0x48, 0x49, 0x6d, 0xda, 0xee, 0xa1, 0x29,
-	0xd9, 0xa1, 0x1d, 0x71, 0x28, 0x51, 0xf6, 0xc6, 0x51, 0xb2, 0x90, 0x67, 0x38, 0x54, 0x4c, 0x9b,
-	0xa2, 0xb8, 0x25, 0xc9, 0x5a, 0xc7, 0x91, 0x1b, 0xc5, 0xe9, 0xe2, 0xb0, 0xcd, 0x99, 0xee, 0x4e,
-	0x55, 0x0d, 0x39, 0xdc, 0x1f, 0x20, 0x97, 0x05, 0x72, 0x4b, 0x16, 0xd8, 0x05, 0x72, 0x59, 0x20,
-	0x58, 0xec, 0x02, 0xc1, 0x62, 0x0f, 0x39, 0xfa, 0x98, 0xa3, 0x8f, 0x3e, 0x06, 0x39, 0x8c, 0xa2,
-	0xe1, 0x25, 0xc7, 0x00, 0x7b, 0xc9, 0x71, 0x51, 0x3f, 0xfd, 0x33, 0x3f, 0x94, 0x49, 0x7b, 0xbd,
-	0x17, 0xa2, 0xfb, 0xbd, 0xef, 0x7d, 0xfd, 0xaa, 0x5e, 0xd5, 0xab, 0xf7, 0x6a, 0x08, 0x96, 0xdb,
-	0x3e, 0xc5, 0xc7, 0xd8, 0x5b, 0x65, 0x1c, 0x37, 0x0f, 0xd7, 0x70, 0xe0, 0xae, 0x11, 0xcf, 0xb1,
-	0x1d, 0x72, 0xe4, 0x36, 0x49, 0x35, 0xa0, 0x3e, 0xf7, 0x61, 0x81, 0x73, 0xaf, 0xaa, 0x71, 0xd5,
-	0xa3, 0xdb, 0x8b, 0xab, 0x2d, 0x97, 0x1f, 0x74, 0xf7, 0xaa, 0x4d, 0xbf, 0xb3, 0xd6, 0xf2, 0x5b,
-	0xfe, 0x9a, 0x84, 0xed, 0x75, 0xf7, 0xe5, 0x9b, 0x7c, 0x91, 0x4f, 0xca, 0x7c, 0xf1, 0xaf, 0x12,
-	0xf0, 0xce, 0xb1, 0xcb, 0x0f, 0xfd, 0xe3, 0xb5, 0x96, 0xbf, 0x2a, 0x95, 0xab, 0x47, 0xb8, 0xed,
-	0x3a, 0x98, 0xfb, 0x94, 0xad, 0x45, 0x8f, 0xda, 0xee, 0x95, 0x96, 0xef, 0xb7, 0xda, 0x44, 0xfa,
-	0x84, 0x3d, 0xcf, 0xe7, 0x98, 0xbb, 0xbe, 0xc7, 0xb4, 0xd6, 0xd2, 0xda, 0xe8, 0xdb, 0x4e, 0x97,
-	0x4a, 0x80, 0xd6, 0x2f, 0x8d, 0xea, 0xf7, 0x5d, 0xd2, 0x76, 0xec, 0x0e, 0x66, 0x87, 0x23, 0xfc,
-	0x11, 0x82, 0x71, 0xda, 0x6d, 0x72, 0xad, 0xad, 0x8c, 0x6a, 0xb9, 0xdb, 0x21, 0x8c, 0xe3, 0x4e,
-	0xa0, 0x01, 0xd7, 0xc6, 0x67, 0xce, 0x75, 0x88, 0xc7, 0xdd, 0x7d, 0x97, 0xd0, 0xd0, 0xcb, 0x57,
-	0xc6, 0x41, 0x9f, 0xf9, 0xae, 0x77, 0xb6, 0xf6, 0x90, 0x9c, 0x84, 0xb6, 0x95, 0x71, 0x6d, 0x18,
-	0x04, 0x3d, 0xc4, 0x71, 0x40, 0x87, 0x30, 0x86, 0x5b, 0x84, 0xbd, 0x08, 0xc1, 0xb1, 0x83, 0x39,
-	0x56, 0x88, 0xe5, 0x5f, 0x4c, 0x81, 0xd9, 0x87, 0x84, 0x31, 0xd7, 0xf7, 0xe0, 0x13, 0x90, 0x71,
-	0xc8, 0x91, 0x8d, 0x1d, 0x87, 0x9a, 0xe9, 0x25, 0x63, 0x65, 0xae, 0xfe, 0xc3, 0x2f, 0xfa, 0x95,
-	0xd4, 0x1f, 0xfa, 0x95, 0xb7, 0x5b, 0x7e, 0x95, 0x1f, 0x10, 0x7e, 0xe0, 0x7a, 0x2d, 0x56, 0xf5,
-	0x08, 0x3f, 0xf6, 0xe9, 0xe1, 0xda, 0x30, 0x79, 0x70, 0xd8, 0x5a, 0xe3, 0x27, 0x01, 0x61, 0xd5,
-	0x06, 0x39, 0xaa, 0x39, 0x0e, 0x45, 0xb3, 0x8e, 0x7a, 0x80, 0xdf, 0x07, 0xd3, 0x62, 0x5c, 0xe6,
-	0xd4, 0x92, 0xb1, 0x92, 0x5b, 0x7f, 0xb9, 0x3a, 0xbc, 0x9e, 0xaa, 0xfa, 0xfb, 0x1f, 0x92, 0x13,
-	0x56, 0xcf, 0x88, 0x2f, 0x7e, 0xd9, 0xaf, 0x18, 0x48, 0x9a, 0xc0, 0xd7, 0x40, 0xbe, 0x8d, 0x19,
-	0xb7, 0xf7, 0xed, 0xa6, 0xc7, 0xed, 0x6e, 0x60, 0x4e, 0x2f, 0x19, 0x2b, 0x79, 0x04, 0x84, 0xf0,
-	0xde, 0x86, 0xc7, 0x1f, 0x07, 0x70, 0x05, 0x94, 0x25, 0xc4, 0xd3, 0x20, 0xc7, 0x3f, 0xf6, 0xcc,
-	0x4b, 0x12, 0x26, 0x6d, 0x77, 0x04, 0xae, 0xe1, 0x1f, 0x7b, 0x11, 0x12, 0x27, 0x91, 0x33, 0x31,
-	0xb2, 0x16, 0x21, 0xab, 0x60, 0x41, 0x22, 0x9b, 0xbe, 0xb7, 0x9f, 0x04, 0xcf, 0x4a, 0x70, 0x49,
-	0xe8, 0x36, 0x7c, 0x6f, 0x3f, 0xc2, 0x6f, 0x00, 0xc0, 0x38, 0xa6, 0x9c, 0x38, 0x36, 0xe6, 0x66,
-	0x46, 0x8e, 0x73, 0xb1, 0xaa, 0x96, 0x50, 0x35, 0x5c, 0x42, 0xd5, 0x47, 0xe1, 0x12, 0x52, 0xc3,
-	0xfc, 0xe5, 0xb3, 0x8a, 0x81, 0xb2, 0xda, 0xae, 0xc6, 0x3f, 0x98, 0xce, 0x18, 0xa5, 0xf4, 0xf2,
-	0xb3, 0x1c, 0xc8, 0xdf, 0xaf, 0x6d, 0xec, 0x62, 0x8a, 0x3b, 0x84, 0x13, 0xca, 0xe0, 0x1b, 0x20,
-	0xd3, 0xc1, 0x3d, 0x9b, 0xb8, 0x34, 0x30, 0x8d, 0x25, 0x63, 0x25, 0x5d, 0xcf, 0x0d, 0xfa, 0x95,
-	0xd9, 0xfb, 0xb8, 0xb7, 0xb9, 0x85, 0x76, 0xd1, 0x6c, 0x07, 0xf7, 0x36, 0x5d, 0x1a, 0xc0, 0xb7,
-	0x40, 0xb9, 0x1b, 0xb4, 0x5d, 0xef, 0xd0, 0x76, 0x8e, 0x49, 0xbb, 0x6d, 0x8b, 0x15, 0x2b, 0x03,
-	0x99, 0x41, 0x45, 0xa5, 0x68, 0x08, 0xb9, 0xf0, 0x02, 0x56, 0xc1, 0xbc, 0x18, 0xd0, 0x28, 0x7a,
-	0x4a, 0xa2, 0xcb, 0xa1, 0x2a, 0xc6, 0xef, 0x81, 0x79, 0xec, 0x50, 0x5b, 0xac, 0x1c, 0x9b, 0x62,
-	0x4e, 0x6c, 0xd7, 0x73, 0x48, 0x4f, 0x46, 0xa3, 0xb0, 0xfe, 0xea, 0x68, 0x44, 0x1b, 0x98, 0x63,
-	0x84, 0x39, 0xd9, 0x12, 0xa0, 0xfa, 0xc2, 0xa0, 0x5f, 0x29, 0xd5, 0x1a, 0x68, 0x48, 0x8a, 0x4a,
-	0xd8, 0xa1, 0x43, 0x12, 0xf8, 0x1e, 0x80, 0xe2, 0x1b, 0xbc, 0x67, 0x07, 0xfe, 0x31, 0xa1, 0xfa,
-	0x13, 0x32, 0x92, 0xf5, 0xf9, 0x41, 0xbf, 0x52, 0xac, 0x35, 0xd0, 0xa3, 0xde, 0xae, 0xd0, 0x29,
-	0x8a, 0x22, 0x76, 0x68, 0x52, 0x00, 0x6f, 0x82, 0x39, 0xc1, 0xe0, 0xed, 0xd9, 0x9c, 0x62, 0x8f,
-	0xa9, 0xd8, 0xd6, 0x0b, 0x83, 0x7e, 0x05, 0xd4, 0x1a, 0x68, 0x67, 0xef, 0x91, 0x90, 0x22, 0x80,
-	0x1d, 0xaa, 0x9f, 0xe1, 0x6d, 0x90, 0x17, 0x16, 0xb8, 0x79, 0x68, 0xb7, 0xdd, 0x8e, 0xcb, 0x55,
-	0x84, 0xeb, 0xc5, 0x41, 0xbf, 0x92, 0xab, 0x35, 0x50, 0xad, 0x79, 0xb8, 0x2d, 0xc4, 0x28, 0x87,
-	0x1d, 0x1a, 0xbe, 0x24, 0x8d, 0x1c, 0xd2, 0xc6, 0x27, 0x32, 0xe0, 0x43, 0x46, 0x0d, 0x21, 0x0e,
-	0x8d, 0xe4, 0x0b, 0x7c, 0x1b, 0x64, 0x69, 0xef, 0x96, 0x36, 0xc8, 0xca, 0x79, 0xbb, 0x3a, 0x3a,
-	0x6f, 0xa8, 0xa7, 0x0c, 0x33, 0xb4, 0x77, 0x4b, 0x59, 0xad, 0x81, 0x05, 0x69, 0x15, 0xcd, 0xbb,
-	0xbf, 0xbf, 0xcf, 0x08, 0x37, 0x81, 0x5c, 0x88, 0x65, 0x81, 0xd3, 0x73, 0xf8, 0x40, 0x2a, 0xe0,
-	0x36, 0x98, 0xa7, 0xbd, 0xf5, 0xb1, 0x40, 0xe5, 0xce, 0x11, 0x28, 0x54, 0xa2, 0xbd, 0xf5, 0xe1,
-	0x90, 0x5c, 0x03, 0x79, 0xc1, 0xb6, 0x4f, 0xc9, 0x4f, 0xbb, 0xc4, 0x6b, 0x9e, 0x98, 0x73, 0x4b,
-	0xc6, 0xca, 0x34, 0x9a, 0xa3, 0xbd, 0xf5, 0x7b, 0xa1, 0x0c, 0xfe, 0x18, 0x5c, 0xa5, 0x44, 0xa4,
-	0x35, 0xb9, 0x86, 0xec, 0x80, 0x50, 0xd7, 0x77, 0xdc, 0xa6, 0xcb, 0x4f, 0xcc, 0xbc, 0xfc, 0xec,
-	0xf2, 0xd8, 0x38, 0x25, 0x5c, 0x2c, 0xac, 0xcd, 0x5e, 0xe0, 0x7b, 0xc4, 0xe3, 0xe8, 0x32, 0x8d,
-	0x64, 0xbb, 0x31, 0x01, 0x7c, 0x0a, 0x4c, 0xcd, 0xdd, 0xf4, 0xbb, 0x1e, 0x1f, 0x22, 0x2f, 0x48,
-	0xf2, 0x6b, 0x93, 0xc9, 0x37, 0x04, 0x3c, 0x62, 0xbf, 0x42, 0x63, 0x61, 0x92, 0x7e, 0x0b, 0x14,
-	0xc4, 0xd6, 0x72, 0xba, 0xfc, 0xc4, 0x6e, 0x9e, 0x34, 0xdb, 0xc4, 0x2c, 0x4e, 0x26, 0xad, 0xb5,
-	0x5a, 0x94, 0xb4, 0x30, 0x27, 0x4e, 0xa3, 0xcb, 0x4f, 0x36, 0x04, 0x14, 0xcd, 0x75, 0x70, 0x2f,
-	0x7a, 0x83, 0x35, 0x90, 0x69, 0x1e, 0x60, 0xcf, 0x23, 0x6d, 0x66, 0x96, 0x96, 0xa6, 0x56, 0x72,
-	0xeb, 0xaf, 0x8f, 0x92, 0x0c, 0x6d, 0xeb, 0xea, 0x86, 0x42, 0xa3, 0xc8, 0x4c, 0x6c, 0xca, 0xc0,
-	0xf5, 0x5a, 0x36, 0x6b, 0xfb, 0x3c, 0x31, 0xe7, 0x65, 0x39, 0xe7, 0x65, 0xa1, 0x7a, 0xd8, 0xf6,
-	0x79, 0x3c, 0xf1, 0x4f, 0xc0, 0x4b, 0x31, 0x7e, 0x34, 0xe2, 0xf0, 0x3c, 0x11, 0xbf, 0x1c, 0x92,
-	0x0e, 0x87, 0xfd, 0x4d, 0x50, 0xda, 0x23, 0xb8, 0xe9, 0x7b, 0x09, 0x2f, 0xe6, 0xa5, 0x17, 0x45,
-	0x25, 0x8f, 0x7c, 0x58, 0xfc, 0xaf, 0x34, 0x98, 0xd5, 0x23, 0x11, 0x66, 0x3a, 0x01, 0xc5, 0x66,
-	0x86, 0x32, 0x53, 0xf2, 0xd8, 0xf5, 0x55, 0x00, 0xa3, 0xfc, 0x13, 0x83, 0xd3, 0x6a, 0xa4, 0xa1,
-	0x26, 0x86, 0x6f, 0x83, 0xf9, 0x8e, 0xeb, 0x8d, 0x8d, 0x71, 0xea, 0x5c, 0xab, 0xba, 0xe3, 0x7a,
-	0xc3, 0xc3, 0x13, 0x6c, 0x22, 0xea, 0x5f, 0x23, 0x99, 0xa1, 0x92, 0x08, 0xfa, 0xe8, 0x1e, 0x21,
-	0x1e, 0xde, 0x6b, 0x13, 0x5b, 0x0d, 0x52, 0x66, 0xac, 0x0c, 0x9a, 0x53, 0xc2, 0xc7, 0x52, 0x76,
-	0x67, 0xfa, 0xf3, 0x5f, 0x57, 0x52, 0xea, 0xef, 0x72, 0x07, 0x14, 0x36, 0x3d, 0xa7, 0x21, 0x4b,
-	0xac, 0x3a, 0xc5, 0x9e, 0x03, 0xaf, 0x80, 0xb4, 0xeb, 0xc8, 0xa9, 0xca, 0xd6, 0x67, 0x06, 0xfd,
-	0x4a, 0x7a, 0xab, 0x81, 0xd2, 0xae, 0x03, 0x21, 0x98, 0xf6, 0xb0, 0x4e, 0xe2, 0x59, 0x24, 0x9f,
-	0xe1, 0x4b, 0x60, 0xaa, 0x4b, 0xdb, 0x72, 0xe8, 0xd9, 0xfa, 0xec, 0xa0, 0x5f, 0x99, 0x7a, 0x8c,
-	0xb6, 0x91, 0x90, 0xc1, 0x05, 0x70, 0xa9, 0xed, 0xb7, 0x7c, 0x66, 0x4e, 0x2f, 0x4d, 0xad, 0x64,
-	0x91, 0x7a, 0x59, 0x76, 0x12, 0x9f, 0xbb, 0xef, 0x3b, 0xa4, 0x2d, 0x0e, 0x94, 0x3d, 0xf1, 0x5d,
-	0x3b, 0xfa, 0xa8, 0x3c, 0x50, 0xa4, 0x2f, 0x5b, 0x0d, 0x34, 0x2b, 0x95, 0x5b, 0xa1, 0x5b, 0xe9,
-	0x33, 0xdd, 0x9a, 0x8a, 0xdd, 0x5a, 0xfe, 0xd7, 0x34, 0x78, 0x39, 0xfa, 0xcc, 0x47, 0x84, 0x8a,
-	0x13, 0x7d, 0x2b, 0xae, 0x87, 0xe0, 0x83, 0xb1, 0x6f, 0xbe, 0x9d, 0xf8, 0xe6, 0xe0, 0x59, 0xe5,
-	0x75, 0xf0, 0xda, 0xa7, 0x9f, 0xe0, 0xd5, 0xbf, 0xbb, 0xb9, 0xfa, 0xfd, 0xa7, 0x2b, 0x77, 0xef,
-	0x7c, 0xb2, 0xfa, 0xf4, 0x6e, 0xf8, 0xfa, 0xe6, 0xdf, 0xaf, 0xdf, 0xf8, 0xc7, 0xeb, 0xff, 0xf0,
-	0xe9, 0xf5, 0xde, 0xeb, 0xb1, 0x73, 0x0f, 0x40, 0xa6, 0x23, 0x46, 0x63, 0x47, 0x2e, 0x4a, 0x42,
-	0x39, 0xc2, 0x0b, 0x11, 0x4a, 0x96, 0x2d, 0x47, 0xac, 0xde, 0x03, 0x4c, 0x9d, 0x63, 0x4c, 0x89,
-	0x7d, 0xa4, 0x06, 0xa0, 0x47, 0x58, 0x0c, 0xe5, 0x7a, 0x5c, 0x02, 0xba, 0xef, 0xd2, 0xce, 0x10,
-	0x74, 0x5a, 0x41, 0x43, 0xb9, 0x86, 0x2e, 0xff, 0xcb, 0x2c, 0x28, 0x8d, 0xce, 0x0b, 0xfc, 0x11,
-	0x98, 0x72, 0x1d, 0x26, 0xe7, 0x21, 0xb7, 0xfe, 0xdd, 0xd1, 0x05, 0xf7, 0x82, 0x69, 0x4c, 0xd4,
-	0x47, 0x82, 0x01, 0x3e, 0x01, 0x45, 0x6d, 0x18, 0xf9, 0x91, 0x96, 0xab, 0x78, 0x71, 0x42, 0xee,
-	0xd1, 0x74, 0x75, 0x38, 0xe8, 0x57, 0x0a, 0xdb, 0x3e, 0xc2, 0x4f, 0x6a, 0x3b, 0x5a, 0x86, 0x0a,
-	0x1a, 0x1a, 0x7a, 0x88, 0xc1, 0x7c, 0x48, 0x1c, 0x1c, 0x9c, 0x0c, 0xcd, 0xc7, 0x04, 0xf2, 0xdd,
-	0xf7, 0x3f, 0x0e, 0xc9, 0x2f, 0x0f, 0xfa, 0x95, 0xb2, 0x26, 0x8f, 0xc5, 0xa8, 0xac, 0xd1, 0xbb,
-	0x07, 0x27, 0xe1, 0x27, 0xee, 0x82, 0x72, 0xb4, 0xf3, 0xed, 0xa0, 0x8d, 0x3d, 0x11, 0x49, 0x39,
-	0x8b, 0xea, 0xb4, 0x8f, 0x76, 0xff, 0x6e, 0x1b, 0x7b, 0x5b, 0x0d, 0x54, 0xdc, 0x1f, 0x12, 0x88,
-	0xe5, 0x39, 0x13, 0x1c, 0xf8, 0xdc, 0x67, 0xe6, 0x25, 0xb9, 0xde, 0xf5, 0x1b, 0x5c, 0x01, 0x25,
-	0xd6, 0x0d, 0x02, 0x9f, 0x72, 0x66, 0x37, 0xdb, 0x98, 0x31, 0x7b, 0x4f, 0x56, 0x02, 0x19, 0x54,
-	0x08, 0xe5, 0x1b, 0x42, 0x5c, 0x9f, 0x80, 0x6c, 0xca, 0x02, 0x60, 0x14, 0xb9, 0x01, 0x3b, 0xe0,
-	0x8a, 0x43, 0xf6, 0x71, 0xb7, 0xcd, 0xed, 0x0e, 0x6e, 0xda, 0x41, 0x94, 0xc6, 0x75, 0xb1, 0xf7,
-	0xea, 0x0b, 0x73, 0x7d, 0xdd, 0x1c, 0xf4, 0x2b, 0x0b, 0x0d, 0x45, 0x30, 0xa4, 0x41, 0x0b, 0x9a,
-	0xf6, 0x3e, 0x6e, 0x26, 0x4a, 0xbe, 0x6b, 0x20, 0x2f, 0xf2, 0x5d, 0x9c, 0x19, 0xb3, 0xea, 0xdc,
-	0xed, 0xb8, 0x71, 0xea, 0x95, 0x20, 0xdc, 0x4b, 0x80, 0x80, 0x06, 0xe1, 0x5e, 0x0c, 0x5a, 0x02,
-	0x73, 0x94, 0x30, 0xc2, 0x99, 0x2a, 0x63, 0x65, 0x21, 0x90, 0x41, 0x40, 0xc9, 0x44, 0xfd, 0x0a,
-	0x7f, 0x00, 0xca, 0x5d, 0x46, 0x98, 0x7d, 0x7b, 0xdd, 0xde, 0x73, 0x75, 0xa5, 0x2d, 0xcf, 0xf9,
-	0x4c, 0xbd, 0x3c, 0xe8, 0x57, 0xf2, 0x8f, 0x19, 0x61, 0xb7, 0xd7, 0xeb, 0xae, 0xac, 0xb7, 0x51,
-	0xbe, 0x9b, 0x7c, 0x15, 0x3e, 0x44, 0x33, 0x28, 0x4e, 0x58, 0x79, 0xe2, 0x67, 0xd0, 0x5c, 0x28,
-	0xfc, 0xc0, 0x77, 0x3d, 0x78, 0x03, 0x40, 0xed, 0x83, 0x3c, 0xc9, 0x3d, 0xdf, 0x6b, 0x12, 0x26,
-	0x8f, 0xef, 0x0c, 0x2a, 0x29, 0x8d, 0xc0, 0xed, 0x48, 0x39, 0x7c, 0x0a, 0x60, 0x38, 0xd5, 0xfb,
-	0x3e, 0xed, 0x60, 0x2e, 0xa7, 0xb9, 0x28, 0xa7, 0x79, 0x65, 0x6c, 0x9a, 0x55, 0xc3, 0xb3, 0x8b,
-	0x4f, 0xda, 0x3e, 0x76, 0xee, 0x45, 0xf8, 0xfa, 0xb4, 0xd8, 0x28, 0xa8, 0xac, 0x99, 0x62, 0x85,
-	0xce, 0xc1, 0xff, 0x3c, 0x05, 0x72, 0xf7, 0x6b, 0x1b, 0x0f, 0x09, 0xe7, 0xa2, 0xa7, 0x81, 0xd7,
-	0xc0, 0x6c, 0x97, 0x11, 0x1b, 0x3b, 0x54, 0xee, 0xca, 0x4c, 0x1d, 0x0c, 0xfa, 0x95, 0x99, 0xc7,
-	0x8c, 0xd4, 0x1a, 0x08, 0xcd, 0x74, 0x19, 0xa9, 0x39, 0x14, 0xde, 0x00, 0xa2, 0x74, 0xb4, 0x3b,
-	0x98, 0xb6, 0x5c, 0xb5, 0xd1, 0xf2, 0xf5, 0xfc, 0xa0, 0x5f, 0xc9, 0xd6, 0x1a, 0xe8, 0xbe, 0x14,
-	0xa2, 0x2c, 0x76, 0xa8, 0x7a, 0x84, 0x1f, 0x82, 0xa2, 0x5e, 0x7d, 0xb2, 0x2e, 0xf2, 0xbb, 0x5c,
-	0x37, 0x40, 0x2f, 0x8d, 0x35, 0x06, 0x0d, 0xdd, 0xbb, 0xaa, 0xed, 0xfd, 0x2b, 0xd1, 0x17, 0xe4,
-	0xa5, 0x6d, 0xfd, 0x91, 0xb2, 0x8c, 0xc9, 0x9a, 0x11, 0xd9, 0xf4, 0x45, 0xc9, 0x36, 0x42, 0xb2,
-	0x4f, 0xc0, 0x55, 0xc6, 0x31, 0xef, 0xb2, 0xf1, 0x82, 0xed, 0xd2, 0xf9, 0x49, 0x2f, 0x2b, 0x8e,
-	0xd1, 0x8a, 0xed, 0x5d, 0x60, 0x6a, 0xf2, 0xf1, 0x8a, 0x4d, 0xf5, 0x5a, 0x57, 0x94, 0x7e, 0xb4,
-	0x18, 0x5b, 0xfe, 0xcf, 0x2c, 0xc8, 0x88, 0x98, 0x70, 0xcc, 0x09, 0x44, 0x00, 0x36, 0xbb, 0x94,
-	0x12, 0xc1, 0x10, 0x6f, 0x36, 0xe3, 0x3c, 0x9b, 0x4d, 0x87, 0x5e, 0x9b, 0x27, 0x76, 0x15, 0x12,
-	0x2b, 0x8b, 0xb9, 0x94, 0x38, 0x49, 0xce, 0xf4, 0x05, 0x38, 0xb5, 0x79, 0x82, 0xf3, 0x5d, 0x30,
-	0xa7, 0x2e, 0x4b, 0x54, 0x02, 0xd1, 0x19, 0xf2, 0xf2, 0x28, 0x9b, 0x4c, 0x23, 0x28, 0xa7, 0xa0,
-	0xf2, 0x65, 0x52, 0xee, 0x9e, 0xfe, 0x3f, 0xc9, 0xdd, 0x4f, 0xc1, 0x62, 0xd4, 0xbc, 0xba, 0xb4,
-	0x43, 0x1c, 0x3b, 0x2a, 0xb5, 0x30, 0xd7, 0x11, 0x7e, 0x51, 0x73, 0x3a, 0x2d, 0x1b, 0xd3, 0xab,
-	0x61, 0x93, 0x2b, 0x29, 0x1a, 0x9a, 0xa1, 0xc6, 0xe1, 0x3b, 0xc0, 0x94, 0xf4, 0x0e, 0x39, 0xb2,
-	0x75, 0xa4, 0xa3, 0xee, 0x5c, 0x05, 0x78, 0x5e, 0xe8, 0x1b, 0xe4, 0xe8, 0xa1, 0xd4, 0xea, 0x36,
-	0x1d, 0x81, 0xcb, 0x71, 0xb1, 0x9a, 0x5c, 0x14, 0xb3, 0x72, 0xd0, 0xd6, 0xd8, 0x99, 0xa2, 0x2b,
-	0x53, 0xb5, 0x42, 0xd0, 0x7c, 0x30, 0xf4, 0xae, 0xd6, 0x1a, 0x01, 0xaf, 0x04, 0xc4, 0x73, 0x04,
-	0x2d, 0x0e, 0x82, 0xb6, 0xdb, 0x94, 0x6b, 0x34, 0x1a, 0xae, 0xce, 0xcd, 0xe3, 0xc5, 0x7c, 0x8c,
-	0x0d, 0xc7, 0x85, 0x16, 0x35, 0xd1, 0x04, 0x1d, 0xdc, 0x04, 0xa5, 0x9f, 0x76, 0x49, 0x97, 0x38,
-	0x36, 0x25, 0x2c, 0xf0, 0x3d, 0x46, 0x98, 0x99, 0x95, 0x25, 0xfe, 0xa4, 0x50, 0x6d, 0xf8, 0x9d,
-	0x0e, 0xf6, 0x1c, 0x54, 0x54, 0x36, 0x28, 0x34, 0x11, 0x34, 0xa1, 0xb7, 0x32, 0x3d, 0x33, 0xce,
-	0x4c, 0xf0, 0xd5, 0x34, 0xda, 0x06, 0x69, 0x13, 0xf8, 0x37, 0x00, 0x6a, 0x6f, 0x64, 0x36, 0xc5,
-	0xcd, 0x26, 0x09, 0x54, 0x5e, 0x9f, 0x30, 0xd4, 0x70, 0x3f, 0x55, 0x45, 0x82, 0xad, 0x49, 0x28,
-	0xd2, 0x83, 0x89, 0x25, 0xf0, 0x3e, 0x58, 0x08, 0x3d, 0x93, 0x9c, 0xda, 0x3d, 0x79, 0x0a, 0x4c,
-	0xb8, 0xb0, 0x11, 0x96, 0xda, 0x1d, 0x04, 0xb5, 0x61, 0x42, 0x06, 0x6f, 0x8a, 0xa6, 0xd5, 0x3e,
-	0x76, 0x3d, 0xc7, 0x3f, 0x66, 0x36, 0x3e, 0xc2, 0x6e, 0x5b, 0x94, 0xc2, 0xfa, 0x6c, 0x80, 0xb4,
-	0xf7, 0x44, 0xa9, 0x6a, 0xa1, 0x66, 0xf1, 0x3f, 0x0c, 0x00, 0x12, 0xfe, 0x2c, 0x83, 0xd9, 0x40,
-	0x65, 0x74, 0xb9, 0xe3, 0xe7, 0xea, 0x99, 0xc1, 0xb3, 0xca, 0x74, 0x90, 0xeb, 0xbd, 0x8a, 0x42,
-	0x05, 0xfc, 0x01, 0x98, 0x0d, 0xdd, 0x4c, 0x7f, 0xa5, 0x9b, 0x7a, 0xff, 0x86, 0x16, 0xf0, 0x9d,
-	0xf3, 0xdf, 0x48, 0x29, 0x4b, 0x09, 0xd7, 0x67, 0xc7, 0x3f, 0x99, 0x20, 0x1b, 0xd5, 0x68, 0xf0,
-	0xbd, 0x64, 0x2d, 0x77, 0xfd, 0xcc, 0x5a, 0xee, 0x05, 0x45, 0xdc, 0x06, 0x00, 0x4d, 0x4a, 0xb0,
-	0xbe, 0x3c, 0x4a, 0x5f, 0xe4, 0xf2, 0x48, 0xdb, 0xd5, 0xb8, 0x20, 0xe9, 0x06, 0x4e, 0x48, 0x32,
-	0x75, 0x11, 0x12, 0x6d, 0x57, 0xe3, 0x51, 0x61, 0x3f, 0x9d, 0xe8, 0x37, 0x96, 0x40, 0xce, 0x21,
-	0xac, 0x49, 0xdd, 0x40, 0xec, 0x09, 0x99, 0x3e, 0xb2, 0x28, 0x29, 0x82, 0x5b, 0x00, 0x60, 0xce,
-	0xa9, 0xbb, 0xd7, 0xe5, 0x84, 0x99, 0x33, 0x72, 0x45, 0xbf, 0x79, 0xe6, 0x44, 0x54, 0x6b, 0x11,
-	0x76, 0xd3, 0xe3, 0xf4, 0x04, 0x25, 0x8c, 0xe1, 0x4f, 0x40, 0x4e, 0xe7, 0x42, 0x5b, 0x4c, 0xea,
-	0xec, 0xc5, 0x0b, 0x64, 0x79, 0xd9, 0x13, 0xca, 0x1b, 0x0c, 0x81, 0xa3, 0x10, 0xc3, 0x60, 0x1d,
-	0x40, 0x46, 0xa8, 0x4c, 0xd6, 0x01, 0xf5, 0xf7, 0xdd, 0x36, 0x11, 0x25, 0x67, 0x46, 0x96, 0x9c,
-	0xf2, 0x92, 0xea, 0xa1, 0xd2, 0xee, 0x2a, 0xe5, 0x56, 0x03, 0x95, 0xd8, 0xb0, 0xc4, 0x81, 0x6f,
-	0x83, 0x2b, 0xfa, 0xfe, 0xd3, 0x16, 0x3a, 0x42, 0xe5, 0x7d, 0x29, 0x61, 0x4c, 0x96, 0x68, 0x59,
-	0xb4, 0xa0, 0xb5, 0x0f, 0xa5, 0xb2, 0xa6, 0x74, 0xf0, 0x87, 0x60, 0x31, 0x99, 0xa0, 0x46, 0x2c,
-	0x81, 0xb4, 0x34, 0x13, 0x88, 0x61, 0xeb, 0x2a, 0x98, 0x97, 0xdb, 0x72, 0xc4, 0x2c, 0x27, 0xcd,
-	0xca, 0x42, 0x35, 0x8c, 0xbf, 0x07, 0xb2, 0x6d, 0x5f, 0x11, 0x31, 0x73, 0x4e, 0xc6, 0x63, 0xe5,
-	0xec, 0x78, 0x6c, 0x87, 0x50, 0x15, 0x8e, 0xd8, 0x74, 0x62, 0x21, 0x9d, 0x3f, 0x77, 0x21, 0x5d,
-	0x98, 0x58, 0x48, 0x4f, 0x38, 0xf5, 0x8a, 0xdf, 0x66, 0xc7, 0x52, 0xfa, 0xb6, 0x3b, 0x96, 0xf2,
-	0x05, 0x3a, 0x96, 0xb3, 0xbb, 0x08, 0xf8, 0xff, 0xd2, 0x45, 0xcc, 0x9f, 0xa7, 0x8b, 0x58, 0x38,
-	0x47, 0x17, 0x71, 0xf9, 0x7c, 0x5d, 0xc4, 0x95, 0xaf, 0xdb, 0x45, 0x5c, 0x3d, 0x77, 0x17, 0x61,
-	0x9e, 0xd1, 0x45, 0xbc, 0x03, 0xb2, 0xd4, 0xf7, 0xb9, 0x2d, 0xd3, 0xfc, 0x4b, 0x72, 0x76, 0xcd,
-	0xb1, 0x9b, 0x42, 0xdf, 0xe7, 0x22, 0xc7, 0xa3, 0x0c, 0xd5, 0x4f, 0xf0, 0x23, 0x30, 0xe3, 0x11,
-	0x2e, 0xe2, 0xba, 0x28, 0x0f, 0x9e, 0xbb, 0x7f, 0xe8, 0x57, 0xd6, 0x2f, 0xf4, 0xeb, 0xc7, 0x0e,
-	0xe1, 0x5b, 0x8d, 0x41, 0xbf, 0x72, 0x49, 0x3e, 0xa0, 0x4b, 0x1e, 0xe1, 0xf2, 0xb6, 0x62, 0x4e,
-	0x44, 0x9c, 0xe9, 0x7e, 0xc3, 0x7c, 0x79, 0xf2, 0xc1, 0x93, 0x68, 0x49, 0xd4, 0x75, 0x72, 0x42,
-	0x80, 0x72, 0x1d, 0xdc, 0x8c, 0x1a, 0x96, 0x0d, 0x90, 0x95, 0x84, 0xe2, 0x70, 0x37, 0x5f, 0x99,
-	0x3c, 0xbe, 0xf0, 0xf0, 0xaf, 0xcf, 0x0d, 0xfa, 0x95, 0xa8, 0xb4, 0x46, 0x19, 0xc1, 0x23, 0x8b,
-	0xec, 0x5b, 0x60, 0x96, 0xa9, 0xa3, 0xce, 0x7c, 0x55, 0x52, 0x5c, 0x3d, 0xe3, 0x24, 0x44, 0x21,
-	0x0e, 0xbe, 0x07, 0xc2, 0x82, 0xc4, 0x0e, 0x4d, 0xad, 0x17, 0x9b, 0x16, 0x34, 0x3e, 0xfc, 0x99,
-	0xe9, 0x3a, 0x28, 0x44, 0xf5, 0xa3, 0x0c, 0xa2, 0x59, 0x91, 0x55, 0xe3, 0x9c, 0xae, 0x1a, 0x65,
-	0x00, 0xe1, 0x1b, 0xa0, 0xd8, 0x65, 0xc4, 0x89, 0x51, 0xcc, 0x5c, 0x5a, 0x9a, 0x5a, 0xc9, 0xcb,
-	0xa5, 0xe3, 0x84, 0x30, 0x26, 0x70, 0x92, 0x2d, 0x5e, 0x13, 0xe6, 0x6b, 0xf1, 0x2f, 0x3a, 0xd1,
-	0x82, 0x80, 0xdf, 0xd3, 0x38, 0xfa, 0x99, 0xee, 0x4b, 0x6e, 0x9a, 0xcb, 0xb2, 0x81, 0x2b, 0x0d,
-	0xfa, 0x95, 0xb9, 0x6d, 0xcc, 0x38, 0xfa, 0x40, 0x76, 0x24, 0x37, 0x95, 0x23, 0xe8, 0x33, 0xf5,
-	0x36, 0x6e, 0x78, 0xcb, 0xbc, 0x36, 0xd1, 0xf0, 0xd6, 0x90, 0xe1, 0x2d, 0xf8, 0x29, 0x78, 0x79,
-	0xb4, 0x4e, 0xa6, 0xa4, 0x49, 0xdc, 0x23, 0x75, 0x44, 0x5f, 0xbf, 0x48, 0x1d, 0x1e, 0x15, 0xd3,
-	0x48, 0x33, 0xd4, 0xc4, 0x8e, 0xcb, 0xa9, 0xdf, 0x49, 0xd4, 0x1a, 0x78, 0xfd, 0x8c, 0x44, 0x27,
-	0x20, 0x2a, 0xee, 0x20, 0x88, 0x9e, 0xe1, 0x2a, 0x80, 0x7b, 0xb2, 0x21, 0x3e, 0x11, 0xb5, 0x78,
-	0x93, 0x78, 0x1c, 0xb7, 0x88, 0xf9, 0xc6, 0x92, 0xb1, 0x92, 0x46, 0x65, 0xad, 0xd9, 0x8d, 0x14,
-	0xf0, 0x3b, 0xa0, 0x18, 0xf5, 0x10, 0xba, 0xfd, 0xfd, 0xce, 0x92, 0xb1, 0x72, 0x09, 0x15, 0x42,
-	0xb1, 0x6e, 0x7a, 0xb1, 0xd8, 0xa4, 0xc2, 0x4a, 0xb4, 0xd2, 0xfa, 0x42, 0x94, 0x99, 0x2b, 0xf2,
-	0x0c, 0x1a, 0xcb, 0x6e, 0xea, 0x6e, 0x54, 0xb7, 0xf0, 0xea, 0x04, 0x46, 0xd2, 0xb8, 0xd6, 0x40,
-	0x4a, 0xc7, 0xc4, 0xce, 0x96, 0x12, 0x87, 0x6a, 0x09, 0x6c, 0x80, 0x82, 0xfe, 0x44, 0x48, 0xff,
-	0xe6, 0x39, 0xe8, 0x51, 0x5e, 0x19, 0x85, 0x2c, 0x1f, 0x00, 0xcd, 0x1c, 0x75, 0x0b, 0xcc, 0x7c,
-	0x4b, 0xf2, 0x54, 0xc6, 0x2e, 0x80, 0xc3, 0x21, 0x6a, 0xa6, 0xa2, 0x32, 0x0c, 0xc5, 0x4c, 0xb4,
-	0x21, 0xba, 0x22, 0x9f, 0xd4, 0x85, 0x30, 0xf3, 0xbb, 0x92, 0xf7, 0x7c, 0x6d, 0x88, 0x22, 0x9a,
-	0xa0, 0x62, 0xf0, 0x7d, 0x00, 0x12, 0x17, 0x22, 0x37, 0x2e, 0x76, 0x21, 0x82, 0x12, 0xb6, 0x10,
-	0x83, 0x42, 0x40, 0xfd, 0x23, 0x57, 0xec, 0x47, 0x42, 0x45, 0xb6, 0x5b, 0x95, 0xa7, 0xd8, 0x1d,
-	0x91, 0xa9, 0x77, 0x63, 0xcd, 0x45, 0xee, 0x51, 0xf3, 0x09, 0xc6, 0x2d, 0x07, 0x36, 0x40, 0x39,
-	0x12, 0x88, 0x64, 0xe1, 0x60, 0x8e, 0xcd, 0xaa, 0xce, 0x14, 0xa3, 0x6b, 0xfe, 0xa1, 0xfc, 0xe5,
-	0x1d, 0x95, 0x92, 0x16, 0x0d, 0xcc, 0xf1, 0xe2, 0x5f, 0x83, 0xe2, 0x48, 0xb9, 0x08, 0x4b, 0x60,
-	0xea, 0x90, 0xa8, 0xdf, 0x15, 0xb2, 0x48, 0x3c, 0xc2, 0x05, 0x70, 0xe9, 0x08, 0xb7, 0xbb, 0xe1,
-	0x35, 0xb9, 0x7a, 0xb9, 0x93, 0x7e, 0xd7, 0x58, 0xfc, 0x08, 0x14, 0x86, 0xab, 0x9b, 0x09, 0xd6,
-	0xd5, 0xa4, 0xf5, 0x84, 0x24, 0x1a, 0x12, 0x24, 0x78, 0x75, 0x1f, 0xf0, 0x3e, 0x00, 0x51, 0x15,
-	0xc5, 0xe0, 0x1d, 0x90, 0x8b, 0xff, 0x73, 0x42, 0xf4, 0x03, 0x53, 0xf2, 0x22, 0xe5, 0xac, 0xb2,
-	0x0b, 0x01, 0x12, 0xd9, 0x2e, 0xff, 0x04, 0x5c, 0xd9, 0x90, 0x95, 0x7c, 0xac, 0xd6, 0x8d, 0x4a,
-	0x1d, 0x80, 0x98, 0x55, 0x37, 0x19, 0x67, 0x93, 0x26, 0x3a, 0x8b, 0x6c, 0x44, 0xbf, 0xfc, 0x6f,
-	0x06, 0xb8, 0xf2, 0x58, 0xd6, 0xf8, 0xdf, 0x06, 0x3d, 0xbc, 0x0b, 0x40, 0xfc, 0xbf, 0x15, 0x67,
-	0xb6, 0x2f, 0xf7, 0x04, 0xe4, 0x3e, 0x66, 0x87, 0xba, 0xa1, 0xca, 0xee, 0x87, 0x82, 0xe5, 0xff,
-	0x36, 0xc0, 0xfc, 0x8f, 0x08, 0x1f, 0x73, 0xee, 0x11, 0x28, 0xc4, 0xce, 0xd9, 0x5f, 0xbf, 0xc9,
-	0x9a, 0x23, 0xb1, 0x9e, 0x7d, 0x73, 0x77, 0xff, 0xc7, 0x00, 0x97, 0xb7, 0x5d, 0x16, 0xfb, 0xcb,
-	0x42, 0x87, 0x3f, 0x06, 0xc5, 0x64, 0x02, 0x88, 0x3d, 0x7e, 0xe3, 0x05, 0x5b, 0x7f, 0xb2, 0xcf,
-	0x05, 0x9c, 0x44, 0x7c, 0x73, 0xaf, 0xc5, 0x26, 0xf1, 0xa9, 0x43, 0xa8, 0xfe, 0x49, 0x43, 0xbd,
-	0xc8, 0x5f, 0x8c, 0xe4, 0xcf, 0xde, 0xea, 0xdf, 0x2a, 0xd4, 0x8b, 0x68, 0x03, 0x03, 0x71, 0x1c,
-	0xa8, 0x7f, 0xa2, 0x90, 0xcf, 0xcb, 0xbf, 0x30, 0xc0, 0xfc, 0xc3, 0x09, 0x41, 0xfa, 0x1e, 0x98,
-	0x39, 0xef, 0xea, 0x51, 0x3e, 0x69, 0xf8, 0x37, 0x1e, 0xd1, 0x5b, 0xf7, 0x00, 0x88, 0x0f, 0x37,
-	0x58, 0x06, 0xf9, 0xdd, 0x07, 0x4f, 0x36, 0x91, 0xfd, 0x78, 0xe7, 0xc3, 0x9d, 0x07, 0x4f, 0x76,
-	0x4a, 0xa9, 0x58, 0x54, 0xaf, 0x3d, 0x7a, 0xb4, 0x89, 0x3e, 0x2e, 0x19, 0x10, 0x82, 0x82, 0x12,
-	0x6d, 0xfe, 0xed, 0xa3, 0x4d, 0xb4, 0x53, 0xdb, 0x2e, 0xa5, 0xeb, 0xff, 0x6e, 0x7c, 0xf1, 0xdc,
-	0x32, 0xbe, 0x7c, 0x6e, 0x19, 0xbf, 0x7f, 0x6e, 0xa5, 0xfe, 0xf8, 0xdc, 0x4a, 0xfd, 0xe9, 0xb9,
-	0x95, 0xfa, 0xf3, 0x73, 0x2b, 0xf5, 0x97, 0xe7, 0x96, 0xf1, 0xb3, 0x81, 0x65, 0xfc, 0x7c, 0x60,
-	0xa5, 0x7e, 0x33, 0xb0, 0x8c, 0xdf, 0x0e, 0xac, 0xd4, 0xe7, 0x03, 0x2b, 0xf5, 0xbb, 0x81, 0x95,
-	0xfa, 0x62, 0x60, 0x19, 0x5f, 0x0e, 0x2c, 0xe3, 0xf7, 0x03, 0x2b, 0xf5, 0xc7, 0x81, 0x65, 0xfc,
-	0x69, 0x60, 0xa5, 0xfe, 0x3c, 0xb0, 0x8c, 0xbf, 0x0c, 0xac, 0xd4, 0xcf, 0x4e, 0xad, 0xd4, 0xcf,
-	0x4f, 0x2d, 0xe3, 0x97, 0xa7, 0x56, 0xea, 0x57, 0xa7, 0x96, 0xf1, 0xeb, 0x53, 0x2b, 0xf5, 0x9b,
-	0x53, 0x2b, 0xf5, 0xdb, 0x53, 0xcb, 0xf8, 0xfc, 0xd4, 0x32, 0x7e, 0x77, 0x6a, 0x19, 0x3f, 0xbe,
-	0x71, 0xde, 0xaa, 0x92, 0x7b, 0xc1, 0xde, 0xde, 0x8c, 0x9c, 0x91, 0xdb, 0xff, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0x1f, 0x91, 0x75, 0x03, 0xb0, 0x25, 0x00, 0x00,
+	golang_proto.RegisterFile("lorawan-stack/api/end_device.proto", fileDescriptor_end_device_d2fa1ca7395b0195)
+}
+
+var fileDescriptor_end_device_d2fa1ca7395b0195 = []byte{
+	// 3721 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x4d, 0x70, 0x1b, 0xc7,
+	0x95, 0xc6, 0x90, 0x94, 0x08, 0x3c, 0x92, 0x20, 0xd0, 0x24, 0xa5, 0x11, 0x2d, 0x01, 0x34, 0x25,
+	0x3b, 0x94, 0x23, 0x82, 0x12, 0x65, 0x6f, 0x1c, 0x25, 0x5b, 0x32, 0x40, 0x50, 0x31, 0x2d, 0x8a,
+	0xe2, 0x36, 0x49, 0x6b, 0x1d, 0x47, 0x9e, 0x6a, 0x62, 0x9a, 0xd0, 0x98, 0x83, 0x19, 0x64, 0xba,
+	0x41, 0x82, 0xfb, 0x53, 0x95, 0x63, 0x6e, 0xc9, 0x61, 0xb7, 0x2a, 0x97, 0xad, 0x4a, 0x6d, 0xed,
+	0x56, 0xa5, 0xb6, 0xf6, 0x90, 0xa3, 0x4f, 0x5b, 0x39, 0xfa, 0xe8, 0x63, 0x2a, 0x07, 0x38, 0x02,
+	0x2f, 0x39, 0x6d, 0xa5, 0x6a, 0x2f, 0x39, 0x6e, 0xf5, 0xcf, 0xfc, 0xe0, 0x87, 0x32, 0x69, 0xaf,
+	0xf7, 0xc2, 0x9a, 0x79, 0xef, 0x7b, 0xdf, 0xf4, 0xff, 0x7b, 0x5f, 0x83, 0xb0, 0xe8, 0xfa, 0x01,
+	0x39, 0x26, 0xde, 0x32, 0xe3, 0xa4, 0x76, 0xb8, 0x42, 0x9a, 0xce, 0x0a, 0xf5, 0x6c, 0xcb, 0xa6,
+	0x47, 0x4e, 0x8d, 0x96, 0x9a, 0x81, 0xcf, 0x7d, 0x94, 0xe5, 0xdc, 0x2b, 0x69, 0x5c, 0xe9, 0xe8,
+	0xfe, 0xfc, 0x72, 0xdd, 0xe1, 0x2f, 0x5a, 0xfb, 0xa5, 0x9a, 0xdf, 0x58, 0xa9, 0xfb, 0x75, 0x7f,
+	0x45, 0xc2, 0xf6, 0x5b, 0x07, 0xf2, 0x4d, 0xbe, 0xc8, 0x27, 0x15, 0x3e, 0xff, 0x57, 0x09, 0x78,
+	0xe3, 0xd8, 0xe1, 0x87, 0xfe, 0xf1, 0x4a, 0xdd, 0x5f, 0x96, 0xce, 0xe5, 0x23, 0xe2, 0x3a, 0x36,
+	0xe1, 0x7e, 0xc0, 0x56, 0xa2, 0x47, 0x1d, 0x77, 0xbd, 0xee, 0xfb, 0x75, 0x97, 0xca, 0x36, 0x11,
+	0xcf, 0xf3, 0x39, 0xe1, 0x8e, 0xef, 0x31, 0xed, 0x2d, 0x68, 0x6f, 0xf4, 0x6d, 0xbb, 0x15, 0x48,
+	0x80, 0xf6, 0x2f, 0xf4, 0xfb, 0x0f, 0x1c, 0xea, 0xda, 0x56, 0x83, 0xb0, 0xc3, 0x3e, 0xfe, 0x08,
+	0xc1, 0x78, 0xd0, 0xaa, 0x71, 0xed, 0x2d, 0xf6, 0x7b, 0xb9, 0xd3, 0xa0, 0x8c, 0x93, 0x46, 0x53,
+	0x03, 0x6e, 0x0e, 0x8e, 0x9c, 0x63, 0x53, 0x8f, 0x3b, 0x07, 0x0e, 0x0d, 0xc2, 0x56, 0x5e, 0x1f,
+	0x04, 0x7d, 0xea, 0x3b, 0xde, 0xd9, 0xde, 0x43, 0x7a, 0x12, 0xc6, 0x16, 0x07, 0xbd, 0xe1, 0x24,
+	0xe8, 0x2e, 0x0e, 0x02, 0x1a, 0x94, 0x31, 0x52, 0xa7, 0xec, 0x55, 0x08, 0x4e, 0x6c, 0xc2, 0x89,
+	0x42, 0x2c, 0xfe, 0x62, 0x14, 0xc6, 0x77, 0x28, 0x63, 0x8e, 0xef, 0xa1, 0x67, 0x90, 0xb6, 0xe9,
+	0x91, 0x45, 0x6c, 0x3b, 0x30, 0x47, 0x16, 0x8c, 0xa5, 0xc9, 0xca, 0x0f, 0x3f, 0xef, 0x14, 0x53,
+	0x7f, 0xe8, 0x14, 0xdf, 0xae, 0xfb, 0x25, 0xfe, 0x82, 0xf2, 0x17, 0x8e, 0x57, 0x67, 0x25, 0x8f,
+	0xf2, 0x63, 0x3f, 0x38, 0x5c, 0xe9, 0x25, 0x6f, 0x1e, 0xd6, 0x57, 0xf8, 0x49, 0x93, 0xb2, 0x52,
+	0x95, 0x1e, 0x95, 0x6d, 0x3b, 0xc0, 0xe3, 0xb6, 0x7a, 0x40, 0xdf, 0x87, 0x31, 0xd1, 0x2f, 0x73,
+	0x74, 0xc1, 0x58, 0x9a, 0x58, 0x7d, 0xad, 0xd4, 0xbb, 0x9e, 0x4a, 0xfa, 0xfb, 0x8f, 0xe9, 0x09,
+	0xab, 0xa4, 0xc5, 0x17, 0xbf, 0xe8, 0x14, 0x0d, 0x2c, 0x43, 0xd0, 0xeb, 0x30, 0xe5, 0x12, 0xc6,
+	0xad, 0x03, 0xab, 0xe6, 0x71, 0xab, 0xd5, 0x34, 0xc7, 0x16, 0x8c, 0xa5, 0x29, 0x0c, 0xc2, 0xf8,
+	0x68, 0xcd, 0xe3, 0x7b, 0x4d, 0xb4, 0x04, 0x79, 0x09, 0xf1, 0x34, 0xc8, 0xf6, 0x8f, 0x3d, 0xf3,
+	0x92, 0x84, 0xc9, 0xd8, 0x2d, 0x81, 0xab, 0xfa, 0xc7, 0x5e, 0x84, 0x24, 0x49, 0xe4, 0xe5, 0x18,
+	0x59, 0x8e, 0x90, 0x25, 0x98, 0x95, 0xc8, 0x9a, 0xef, 0x1d, 0x24, 0xc1, 0xe3, 0x12, 0x9c, 0x13,
+	0xbe, 0x35, 0xdf, 0x3b, 0x88, 0xf0, 0x6b, 0x00, 0x8c, 0x93, 0x80, 0x53, 0xdb, 0x22, 0xdc, 0x4c,
+	0xcb, 0x7e, 0xce, 0x97, 0xd4, 0x12, 0x2a, 0x85, 0x4b, 0xa8, 0xb4, 0x1b, 0x2e, 0x21, 0xd5, 0xcd,
+	0x5f, 0x7e, 0x59, 0x34, 0x70, 0x46, 0xc7, 0x95, 0xf9, 0x07, 0x63, 0x69, 0x23, 0x37, 0xb2, 0xf8,
+	0xe5, 0x04, 0x4c, 0x3d, 0x29, 0xaf, 0x6d, 0x93, 0x80, 0x34, 0x28, 0xa7, 0x01, 0x43, 0x6f, 0x42,
+	0xba, 0x41, 0xda, 0x16, 0x75, 0x82, 0xa6, 0x69, 0x2c, 0x18, 0x4b, 0x23, 0x95, 0x89, 0x6e, 0xa7,
+	0x38, 0xfe, 0x84, 0xb4, 0xd7, 0x37, 0xf0, 0x36, 0x1e, 0x6f, 0x90, 0xf6, 0xba, 0x13, 0x34, 0xd1,
+	0x5b, 0x90, 0x6f, 0x35, 0x5d, 0xc7, 0x3b, 0xb4, 0xec, 0x63, 0xea, 0xba, 0x96, 0x58, 0xb1, 0x72,
+	0x22, 0xd3, 0x78, 0x5a, 0x39, 0xaa, 0xc2, 0x2e, 0x5a, 0x81, 0x4a, 0x30, 0x23, 0x3a, 0xd4, 0x8f,
+	0x1e, 0x95, 0xe8, 0x7c, 0xe8, 0x8a, 0xf1, 0xfb, 0x30, 0x43, 0xec, 0xc0, 0x12, 0x2b, 0xc7, 0x0a,
+	0x08, 0xa7, 0x96, 0xe3, 0xd9, 0xb4, 0x2d, 0x67, 0x23, 0xbb, 0x7a, 0xa3, 0x7f, 0x46, 0xab, 0x84,
+	0x13, 0x4c, 0x38, 0xdd, 0x10, 0xa0, 0xca, 0x6c, 0xb7, 0x53, 0xcc, 0x95, 0xab, 0xb8, 0xc7, 0x8a,
+	0x73, 0xc4, 0x0e, 0x7a, 0x2c, 0xe8, 0x3d, 0x40, 0xe2, 0x1b, 0xbc, 0x6d, 0x35, 0xfd, 0x63, 0x1a,
+	0xe8, 0x4f, 0xc8, 0x99, 0xac, 0xcc, 0x74, 0x3b, 0xc5, 0xe9, 0x72, 0x15, 0xef, 0xb6, 0xb7, 0x85,
+	0x4f, 0x51, 0x4c, 0x13, 0x3b, 0x48, 0x1a, 0xd0, 0x5d, 0x98, 0x14, 0x0c, 0xde, 0xbe, 0xc5, 0x03,
+	0xe2, 0x31, 0x35, 0xb7, 0x95, 0x6c, 0xb7, 0x53, 0x84, 0x72, 0x15, 0x6f, 0xed, 0xef, 0x0a, 0x2b,
+	0x06, 0x62, 0x07, 0xfa, 0x19, 0xdd, 0x87, 0x29, 0x11, 0x41, 0x6a, 0x87, 0x96, 0xeb, 0x34, 0x1c,
+	0xae, 0x66, 0xb8, 0x32, 0xdd, 0xed, 0x14, 0x27, 0xca, 0x55, 0x5c, 0xae, 0x1d, 0x6e, 0x0a, 0x33,
+	0x9e, 0x20, 0x76, 0x10, 0xbe, 0x24, 0x83, 0x6c, 0xea, 0x92, 0x13, 0x39, 0xe1, 0x3d, 0x41, 0x55,
+	0x61, 0x0e, 0x83, 0xe4, 0x0b, 0x7a, 0x1b, 0x32, 0x41, 0xfb, 0x9e, 0x0e, 0xc8, 0xc8, 0x71, 0xbb,
+	0xda, 0x3f, 0x6e, 0xb8, 0xad, 0x02, 0xd3, 0x41, 0xfb, 0x9e, 0x8a, 0x5a, 0x81, 0x59, 0x19, 0x15,
+	0x8d, 0xbb, 0x7f, 0x70, 0xc0, 0x28, 0x37, 0x41, 0x2e, 0xc4, 0xbc, 0xc0, 0xe9, 0x31, 0x7c, 0x2a,
+	0x1d, 0x68, 0x13, 0x66, 0x82, 0xf6, 0xea, 0xc0, 0x44, 0x4d, 0x9c, 0x63, 0xa2, 0x70, 0x2e, 0x68,
+	0xaf, 0xf6, 0x4e, 0xc9, 0x4d, 0x98, 0x12, 0x6c, 0x07, 0x01, 0xfd, 0x69, 0x8b, 0x7a, 0xb5, 0x13,
+	0x73, 0x72, 0xc1, 0x58, 0x1a, 0xc3, 0x93, 0x41, 0x7b, 0xf5, 0x51, 0x68, 0x43, 0x3f, 0x86, 0xab,
+	0x01, 0x15, 0xc7, 0x9a, 0x5c, 0x43, 0x56, 0x93, 0x06, 0x8e, 0x6f, 0x3b, 0x35, 0x87, 0x9f, 0x98,
+	0x53, 0xf2, 0xb3, 0x8b, 0x03, 0xfd, 0x94, 0x70, 0xb1, 0xb0, 0xd6, 0xdb, 0x4d, 0xdf, 0xa3, 0x1e,
+	0xc7, 0x73, 0x41, 0x64, 0xdb, 0x8e, 0x09, 0xd0, 0x73, 0x30, 0x35, 0x77, 0xcd, 0x6f, 0x79, 0xbc,
+	0x87, 0x3c, 0x2b, 0xc9, 0x6f, 0x0e, 0x27, 0x5f, 0x13, 0xf0, 0x88, 0xfd, 0x4a, 0x10, 0x1b, 0x93,
+	0xf4, 0x1b, 0x90, 0x15, 0x5b, 0xcb, 0x6e, 0xf1, 0x13, 0xab, 0x76, 0x52, 0x73, 0xa9, 0x39, 0x3d,
+	0x9c, 0xb4, 0x5c, 0xaf, 0x07, 0xb4, 0x4e, 0x38, 0xb5, 0xab, 0x2d, 0x7e, 0xb2, 0x26, 0xa0, 0x78,
+	0xb2, 0x41, 0xda, 0xd1, 0x1b, 0x2a, 0x43, 0xba, 0xf6, 0x82, 0x78, 0x1e, 0x75, 0x99, 0x99, 0x5b,
+	0x18, 0x5d, 0x9a, 0x58, 0x7d, 0xa3, 0x9f, 0xa4, 0x67, 0x5b, 0x97, 0xd6, 0x14, 0x1a, 0x47, 0x61,
+	0x62, 0x53, 0x36, 0x1d, 0xaf, 0x6e, 0x31, 0xd7, 0xe7, 0x89, 0x31, 0xcf, 0xcb, 0x31, 0xcf, 0x0b,
+	0xd7, 0x8e, 0xeb, 0xf3, 0x78, 0xe0, 0x9f, 0xc1, 0xb5, 0x18, 0xdf, 0x3f, 0xe3, 0xe8, 0x3c, 0x33,
+	0x3e, 0x17, 0x92, 0xf6, 0x4e, 0xfb, 0x6d, 0xc8, 0xed, 0x53, 0x52, 0xf3, 0xbd, 0x44, 0x2b, 0x66,
+	0x64, 0x2b, 0xa6, 0x95, 0x3d, 0x6a, 0xc3, 0xfc, 0x7f, 0x8c, 0xc0, 0xb8, 0xee, 0x89, 0x08, 0xd3,
+	0x07, 0x50, 0x1c, 0x66, 0xa8, 0x30, 0x65, 0x8f, 0x9b, 0xbe, 0x0c, 0x28, 0x3a, 0x7f, 0x62, 0xf0,
+	0x88, 0xea, 0x69, 0xe8, 0x89, 0xe1, 0x9b, 0x30, 0xd3, 0x70, 0xbc, 0x81, 0x3e, 0x8e, 0x9e, 0x6b,
+	0x55, 0x37, 0x1c, 0xaf, 0xb7, 0x7b, 0x82, 0x4d, 0xcc, 0xfa, 0xd7, 0x38, 0xcc, 0x70, 0x4e, 0x4c,
+	0x7a, 0xff, 0x1e, 0xa1, 0x1e, 0xd9, 0x77, 0xa9, 0xa5, 0x3a, 0x29, 0x4f, 0xac, 0x34, 0x9e, 0x54,
+	0xc6, 0x3d, 0x69, 0x7b, 0x30, 0xf6, 0xd9, 0xaf, 0x8b, 0x29, 0xf5, 0x77, 0xb1, 0x01, 0xd9, 0x75,
+	0xcf, 0xae, 0xca, 0x12, 0xab, 0x12, 0x10, 0xcf, 0x46, 0x57, 0x60, 0xc4, 0xb1, 0xe5, 0x50, 0x65,
+	0x2a, 0x97, 0xbb, 0x9d, 0xe2, 0xc8, 0x46, 0x15, 0x8f, 0x38, 0x36, 0x42, 0x30, 0xe6, 0x11, 0x7d,
+	0x88, 0x67, 0xb0, 0x7c, 0x46, 0xd7, 0x60, 0xb4, 0x15, 0xb8, 0xb2, 0xeb, 0x99, 0xca, 0x78, 0xb7,
+	0x53, 0x1c, 0xdd, 0xc3, 0x9b, 0x58, 0xd8, 0xd0, 0x2c, 0x5c, 0x72, 0xfd, 0xba, 0xcf, 0xcc, 0xb1,
+	0x85, 0xd1, 0xa5, 0x0c, 0x56, 0x2f, 0x8b, 0x76, 0xe2, 0x73, 0x4f, 0x7c, 0x9b, 0xba, 0x22, 0xa1,
+	0xec, 0x8b, 0xef, 0x5a, 0xd1, 0x47, 0x65, 0x42, 0x91, 0x6d, 0xd9, 0xa8, 0xe2, 0x71, 0xe9, 0xdc,
+	0x08, 0x9b, 0x35, 0x72, 0x66, 0xb3, 0x46, 0xe3, 0x66, 0x2d, 0xfe, 0xf3, 0x08, 0xbc, 0x16, 0x7d,
+	0xe6, 0x43, 0x1a, 0x88, 0x8c, 0xbe, 0x11, 0xd7, 0x43, 0xe8, 0xe9, 0xc0, 0x37, 0xdf, 0x4e, 0x7c,
+	0xb3, 0xfb, 0x65, 0xf1, 0x0d, 0x78, 0xfd, 0x93, 0x8f, 0xc9, 0xf2, 0xdf, 0xdd, 0x5d, 0xfe, 0xfe,
+	0xf3, 0xa5, 0x87, 0x0f, 0x3e, 0x5e, 0x7e, 0xfe, 0x30, 0x7c, 0xbd, 0xfd, 0xf7, 0xab, 0x77, 0xfe,
+	0xf1, 0xd6, 0x3f, 0x7c, 0x72, 0xab, 0xfd, 0x46, 0xdc, 0xb8, 0xa7, 0x90, 0x6e, 0x88, 0xde, 0x58,
+	0x51, 0x13, 0x25, 0xa1, 0xec, 0xe1, 0x85, 0x08, 0x25, 0xcb, 0x86, 0x2d, 0x56, 0xef, 0x0b, 0x12,
+	0xd8, 0xc7, 0x24, 0xa0, 0xd6, 0x91, 0xea, 0x80, 0xee, 0xe1, 0x74, 0x68, 0xd7, 0xfd, 0x12, 0xd0,
+	0x03, 0x27, 0x68, 0xf4, 0x40, 0xc7, 0x14, 0x34, 0xb4, 0x6b, 0xe8, 0xe2, 0x3f, 0x8d, 0x43, 0xae,
+	0x7f, 0x5c, 0xd0, 0x8f, 0x60, 0xd4, 0xb1, 0x99, 0x1c, 0x87, 0x89, 0xd5, 0xef, 0xf6, 0x2f, 0xb8,
+	0x57, 0x0c, 0x63, 0xa2, 0x3e, 0x12, 0x0c, 0xe8, 0x19, 0x4c, 0xeb, 0xc0, 0xa8, 0x1d, 0x23, 0x72,
+	0x15, 0xcf, 0x0f, 0x39, 0x7b, 0x34, 0x5d, 0x05, 0x75, 0x3b, 0xc5, 0xec, 0xa6, 0x8f, 0xc9, 0xb3,
+	0xf2, 0x96, 0xb6, 0xe1, 0xac, 0x86, 0x86, 0x2d, 0x24, 0x30, 0x13, 0x12, 0x37, 0x5f, 0x9c, 0xf4,
+	0x8c, 0xc7, 0x10, 0xf2, 0xed, 0xf7, 0x3f, 0x0a, 0xc9, 0xe7, 0xba, 0x9d, 0x62, 0x5e, 0x93, 0xc7,
+	0x66, 0x9c, 0xd7, 0xe8, 0xed, 0x17, 0x27, 0xe1, 0x27, 0x1e, 0x42, 0x3e, 0xda, 0xf9, 0x56, 0xd3,
+	0x25, 0x9e, 0x98, 0x49, 0x39, 0x8a, 0x2a, 0xdb, 0x47, 0xbb, 0x7f, 0xdb, 0x25, 0xde, 0x46, 0x15,
+	0x4f, 0x1f, 0xf4, 0x18, 0xc4, 0xf2, 0xbc, 0xdc, 0x7c, 0xe1, 0x73, 0x9f, 0x99, 0x97, 0xe4, 0x7a,
+	0xd7, 0x6f, 0x68, 0x09, 0x72, 0xac, 0xd5, 0x6c, 0xfa, 0x01, 0x67, 0x56, 0xcd, 0x25, 0x8c, 0x59,
+	0xfb, 0xb2, 0x12, 0x48, 0xe3, 0x6c, 0x68, 0x5f, 0x13, 0xe6, 0xca, 0x10, 0x64, 0x4d, 0x16, 0x00,
+	0xfd, 0xc8, 0x35, 0xd4, 0x80, 0x2b, 0x36, 0x3d, 0x20, 0x2d, 0x97, 0x5b, 0x0d, 0x52, 0xb3, 0x9a,
+	0xd1, 0x31, 0xae, 0x8b, 0xbd, 0x1b, 0xaf, 0x3c, 0xeb, 0x2b, 0x66, 0xb7, 0x53, 0x9c, 0xad, 0x2a,
+	0x82, 0x1e, 0x0f, 0x9e, 0xd5, 0xb4, 0x4f, 0x48, 0x2d, 0x51, 0xf2, 0xdd, 0x84, 0x29, 0x71, 0xde,
+	0xc5, 0x27, 0x63, 0x46, 0xe5, 0xdd, 0x86, 0x13, 0x1f, 0xbd, 0x12, 0x44, 0xda, 0x09, 0x10, 0x68,
+	0x10, 0x69, 0xc7, 0xa0, 0x05, 0x98, 0x0c, 0x28, 0xa3, 0x9c, 0xa9, 0x32, 0x56, 0x16, 0x02, 0x69,
+	0x0c, 0xca, 0x26, 0xea, 0x57, 0xf4, 0x03, 0xc8, 0xb7, 0x18, 0x65, 0xd6, 0xfd, 0x55, 0x6b, 0xdf,
+	0xd1, 0x95, 0xb6, 0xcc, 0xf3, 0xe9, 0x4a, 0xbe, 0xdb, 0x29, 0x4e, 0xed, 0x31, 0xca, 0xee, 0xaf,
+	0x56, 0x1c, 0x59, 0x6f, 0xe3, 0xa9, 0x56, 0xf2, 0x55, 0xb4, 0x21, 0x1a, 0x41, 0x91, 0x61, 0x65,
+	0xc6, 0x4f, 0xe3, 0xc9, 0xd0, 0xf8, 0x81, 0xef, 0x78, 0xe8, 0x0e, 0x20, 0xdd, 0x06, 0x99, 0xc9,
+	0x3d, 0xdf, 0xab, 0x51, 0x26, 0xd3, 0x77, 0x1a, 0xe7, 0x94, 0x47, 0xe0, 0xb6, 0xa4, 0x1d, 0x3d,
+	0x07, 0x14, 0x0e, 0xf5, 0x81, 0x1f, 0x34, 0x08, 0x97, 0xc3, 0x3c, 0x2d, 0x87, 0x79, 0x69, 0x60,
+	0x98, 0x95, 0xe0, 0xd9, 0x26, 0x27, 0xae, 0x4f, 0xec, 0x47, 0x11, 0xbe, 0x32, 0x26, 0x36, 0x0a,
+	0xce, 0x6b, 0xa6, 0xd8, 0xa1, 0xcf, 0xe0, 0xcf, 0x47, 0x61, 0xe2, 0x49, 0x79, 0x6d, 0x87, 0x72,
+	0x2e, 0x34, 0x0d, 0xba, 0x09, 0xe3, 0x2d, 0x46, 0x2d, 0x62, 0x07, 0x72, 0x57, 0xa6, 0x2b, 0xd0,
+	0xed, 0x14, 0x2f, 0xef, 0x31, 0x5a, 0xae, 0x62, 0x7c, 0xb9, 0xc5, 0x68, 0xd9, 0x0e, 0xd0, 0x1d,
+	0x10, 0xa5, 0xa3, 0xd5, 0x20, 0x41, 0xdd, 0x51, 0x1b, 0x6d, 0xaa, 0x32, 0xd5, 0xed, 0x14, 0x33,
+	0xe5, 0x2a, 0x7e, 0x22, 0x8d, 0x38, 0x43, 0xec, 0x40, 0x3d, 0xa2, 0xc7, 0x30, 0xad, 0x57, 0x9f,
+	0xac, 0x8b, 0xfc, 0x16, 0xd7, 0x02, 0xe8, 0xda, 0x80, 0x30, 0xa8, 0x6a, 0xed, 0xaa, 0xb6, 0xf7,
+	0xaf, 0x84, 0x2e, 0x98, 0x92, 0xb1, 0x95, 0x5d, 0x15, 0x19, 0x93, 0xd5, 0x22, 0xb2, 0xb1, 0x8b,
+	0x92, 0xad, 0x85, 0x64, 0x1f, 0xc3, 0x55, 0xc6, 0x09, 0x6f, 0xb1, 0xc1, 0x82, 0xed, 0xd2, 0xf9,
+	0x49, 0xe7, 0x14, 0x47, 0x7f, 0xc5, 0xf6, 0x2e, 0x98, 0x9a, 0x7c, 0xb0, 0x62, 0x53, 0x5a, 0xeb,
+	0x8a, 0xf2, 0x0f, 0x14, 0x63, 0xef, 0xe8, 0xb2, 0xda, 0xad, 0xfb, 0x81, 0xc3, 0x5f, 0x34, 0xe4,
+	0x56, 0xcc, 0x54, 0x72, 0xdd, 0x4e, 0x71, 0x52, 0x94, 0xd5, 0xa1, 0x1d, 0x8b, 0x22, 0x3f, 0x7a,
+	0x5b, 0xfc, 0xf7, 0x0c, 0xa4, 0xc5, 0x54, 0x72, 0xc2, 0x29, 0xc2, 0x80, 0x6a, 0xad, 0x20, 0xa0,
+	0xe2, 0xc3, 0xf1, 0x1e, 0x35, 0xce, 0xb3, 0x47, 0xf5, 0x8a, 0xd1, 0xe1, 0x89, 0xcd, 0x88, 0xc5,
+	0x82, 0x64, 0x4e, 0x40, 0xed, 0x24, 0xe7, 0xc8, 0x05, 0x38, 0x75, 0x78, 0x82, 0xf3, 0x5d, 0x98,
+	0x54, 0x77, 0x2c, 0xea, 0xdc, 0xd1, 0x07, 0xeb, 0x5c, 0x3f, 0x9b, 0x3c, 0x7d, 0xf0, 0x84, 0x82,
+	0xca, 0x97, 0x61, 0x47, 0xfe, 0xd8, 0xff, 0xc9, 0x91, 0xff, 0x1c, 0xe6, 0x23, 0xcd, 0xeb, 0x04,
+	0x0d, 0x6a, 0x5b, 0x51, 0x85, 0x46, 0xb8, 0x5e, 0x18, 0xaf, 0xd2, 0xb4, 0x63, 0x52, 0xcf, 0x5e,
+	0x0d, 0xb5, 0xb1, 0xa4, 0xa8, 0x6a, 0x86, 0x32, 0x47, 0xef, 0x80, 0x29, 0xe9, 0x6d, 0x7a, 0x64,
+	0xe9, 0x05, 0x12, 0x89, 0x7a, 0xb5, 0x2e, 0x66, 0x84, 0xbf, 0x4a, 0x8f, 0x76, 0xa4, 0x57, 0xab,
+	0x7b, 0x0c, 0x73, 0x71, 0x8d, 0x9b, 0x5c, 0x4b, 0xe3, 0xb2, 0xd3, 0x85, 0x81, 0x54, 0xa4, 0x0b,
+	0x5a, 0xb5, 0xb0, 0xf0, 0x4c, 0xb3, 0xe7, 0x5d, 0x2d, 0x34, 0x0a, 0xd7, 0x9b, 0xd4, 0xb3, 0x05,
+	0x2d, 0x69, 0x36, 0x5d, 0xa7, 0x26, 0x97, 0x76, 0xd4, 0x5d, 0x7d, 0xa4, 0x0f, 0x6a, 0x80, 0x18,
+	0x1b, 0xf6, 0x0b, 0xcf, 0x6b, 0xa2, 0x21, 0x3e, 0xb4, 0x0e, 0xb9, 0x9f, 0xb6, 0x68, 0x8b, 0xda,
+	0x56, 0x40, 0x59, 0xd3, 0xf7, 0x18, 0x65, 0x66, 0x46, 0x2a, 0x83, 0x61, 0x53, 0xb5, 0xe6, 0x37,
+	0x1a, 0xc4, 0xb3, 0xf1, 0xb4, 0x8a, 0xc1, 0x61, 0x88, 0xa0, 0x09, 0x5b, 0x2b, 0x4f, 0x75, 0xc6,
+	0x99, 0x09, 0x5f, 0x4d, 0xa3, 0x63, 0xb0, 0x0e, 0x41, 0x7f, 0x03, 0x48, 0xb7, 0x46, 0x1e, 0xc2,
+	0xa4, 0x56, 0xa3, 0x4d, 0x95, 0x0e, 0x86, 0x74, 0x35, 0xdc, 0x4f, 0x25, 0x71, 0x2e, 0x97, 0x25,
+	0x14, 0xeb, 0xce, 0xc4, 0x16, 0xf4, 0x04, 0x66, 0xc3, 0x96, 0x49, 0x4e, 0xdd, 0x3c, 0x99, 0x3c,
+	0x86, 0xdc, 0xf3, 0x88, 0x48, 0xdd, 0x1c, 0x8c, 0x74, 0x60, 0xc2, 0x86, 0xee, 0x0a, 0xad, 0x6b,
+	0x1d, 0x3b, 0x9e, 0xed, 0x1f, 0x33, 0x8b, 0x1c, 0x11, 0xc7, 0x15, 0x15, 0xb4, 0x4e, 0x29, 0x28,
+	0x68, 0x3f, 0x53, 0xae, 0x72, 0xe8, 0x99, 0xff, 0x37, 0x03, 0x20, 0xd1, 0x9e, 0x45, 0x18, 0x6f,
+	0xaa, 0x44, 0x20, 0x77, 0xfc, 0x64, 0x25, 0xdd, 0xfd, 0xb2, 0x38, 0xd6, 0xbe, 0xd1, 0x9c, 0xc0,
+	0xa1, 0x03, 0xfd, 0x00, 0xc6, 0xc3, 0x66, 0x8e, 0x7c, 0x65, 0x33, 0xf5, 0xfe, 0x0d, 0x23, 0xd0,
+	0x3b, 0xe7, 0xbf, 0xc8, 0x52, 0x91, 0x12, 0xae, 0x53, 0xce, 0x7f, 0x19, 0x90, 0xad, 0xd2, 0x23,
+	0x99, 0xe5, 0xb6, 0x7d, 0xd7, 0xa9, 0x9d, 0xa0, 0xbb, 0x30, 0x26, 0xaa, 0x4f, 0xd9, 0xda, 0xec,
+	0xea, 0xf5, 0x01, 0xe5, 0xa1, 0xd1, 0xa2, 0xa6, 0xc5, 0x12, 0x29, 0x2a, 0x96, 0x16, 0xa3, 0xb6,
+	0xe5, 0x3a, 0x8c, 0xeb, 0xa1, 0x52, 0x89, 0x08, 0x67, 0x85, 0x7d, 0xd3, 0x61, 0x5c, 0x8d, 0x12,
+	0xda, 0x86, 0x19, 0x99, 0x5a, 0x2d, 0xe2, 0xba, 0xfe, 0x31, 0xb5, 0xad, 0x96, 0xc7, 0x1d, 0x57,
+	0x37, 0xfd, 0xab, 0xf7, 0x71, 0x5e, 0x06, 0x97, 0x55, 0xec, 0x9e, 0x08, 0x5d, 0xfc, 0xef, 0x79,
+	0xc8, 0x44, 0xb5, 0x29, 0x7a, 0x2f, 0x59, 0xc3, 0xde, 0x3a, 0xb3, 0x86, 0x7d, 0x45, 0xf1, 0xba,
+	0x06, 0x50, 0x0b, 0x28, 0xd1, 0x97, 0x66, 0x23, 0x17, 0xb9, 0x34, 0xd3, 0x71, 0x65, 0x2e, 0x48,
+	0x5a, 0x4d, 0x3b, 0x24, 0x19, 0xbd, 0x08, 0x89, 0x8e, 0x2b, 0xf3, 0x48, 0xd0, 0x8c, 0x25, 0x74,
+	0xd6, 0x02, 0x4c, 0xd8, 0x94, 0xd5, 0x02, 0xa7, 0x29, 0x36, 0xb5, 0x3c, 0xff, 0x32, 0x38, 0x69,
+	0x42, 0x1b, 0x00, 0x84, 0xf3, 0xc0, 0xd9, 0x6f, 0x71, 0xca, 0xcc, 0xcb, 0x72, 0x4b, 0xde, 0x3e,
+	0x73, 0x20, 0x4a, 0xe5, 0x08, 0xbb, 0xee, 0xf1, 0xe0, 0x04, 0x27, 0x82, 0xd1, 0x4f, 0x60, 0x42,
+	0x1f, 0xe6, 0x96, 0x18, 0xd4, 0xf1, 0x8b, 0x0b, 0x03, 0x79, 0xc9, 0x15, 0xda, 0xab, 0x0c, 0xc3,
+	0x51, 0x88, 0x61, 0xa8, 0x02, 0x88, 0xd1, 0x40, 0x66, 0x9b, 0x66, 0xe0, 0x1f, 0x38, 0x2e, 0x15,
+	0xa5, 0x76, 0x5a, 0x66, 0x57, 0x79, 0x39, 0xb7, 0xa3, 0xbc, 0xdb, 0xca, 0xb9, 0x51, 0xc5, 0x39,
+	0xd6, 0x6b, 0xb1, 0xd1, 0xdb, 0x70, 0x45, 0xdf, 0xfb, 0x5a, 0xc2, 0x47, 0x03, 0x79, 0x4f, 0x4c,
+	0x19, 0x93, 0xa5, 0x69, 0x06, 0xcf, 0x6a, 0xef, 0x8e, 0x74, 0x96, 0x95, 0x0f, 0xfd, 0x10, 0xe6,
+	0x93, 0x27, 0x6c, 0x5f, 0x24, 0xc8, 0x48, 0x33, 0x81, 0xe8, 0x8d, 0x2e, 0xc1, 0x8c, 0x3c, 0x57,
+	0xfa, 0xc2, 0x26, 0x64, 0x58, 0x5e, 0xb8, 0x7a, 0xf1, 0x8f, 0x20, 0xe3, 0xfa, 0x8a, 0x88, 0x99,
+	0x93, 0x72, 0x3e, 0x96, 0xce, 0x9e, 0x8f, 0xcd, 0x10, 0xaa, 0xa6, 0x23, 0x0e, 0x1d, 0x2a, 0x20,
+	0xa6, 0xce, 0x2d, 0x20, 0xb2, 0x43, 0x05, 0xc4, 0x90, 0xb4, 0x3d, 0xfd, 0x6d, 0x2a, 0xb5, 0xdc,
+	0xb7, 0xad, 0xd4, 0xf2, 0x17, 0x50, 0x6a, 0x67, 0xab, 0x27, 0xf4, 0xff, 0xa2, 0x9e, 0x66, 0xce,
+	0xa3, 0x9e, 0x66, 0xcf, 0xa1, 0x9e, 0xe6, 0xce, 0xa7, 0x9e, 0xae, 0x7c, 0x5d, 0xf5, 0x74, 0xf5,
+	0xdc, 0xea, 0xc9, 0x3c, 0x43, 0x3d, 0xbd, 0x03, 0x99, 0xc0, 0xf7, 0xb9, 0x25, 0xf3, 0xd4, 0x35,
+	0x39, 0xba, 0xe6, 0xc0, 0x0d, 0xa9, 0xef, 0x73, 0x91, 0xa4, 0x70, 0x3a, 0xd0, 0x4f, 0xe8, 0x43,
+	0xb8, 0xec, 0x51, 0x2e, 0xe6, 0x75, 0x5e, 0x66, 0xce, 0x87, 0x7f, 0xe8, 0x14, 0x57, 0x2f, 0xf4,
+	0xab, 0xcf, 0x16, 0xe5, 0x1b, 0xd5, 0x6e, 0xa7, 0x78, 0x49, 0x3e, 0xe0, 0x4b, 0x1e, 0xe5, 0xf2,
+	0x96, 0x66, 0x52, 0xcc, 0x38, 0xd3, 0x3a, 0xcb, 0x7c, 0x6d, 0x78, 0xe6, 0x4c, 0x48, 0x31, 0x75,
+	0x8d, 0x9e, 0x30, 0xe0, 0x89, 0x06, 0xa9, 0x45, 0x42, 0x6d, 0x0d, 0x32, 0x92, 0x50, 0x54, 0x27,
+	0xe6, 0xf5, 0xe1, 0xfd, 0x0b, 0xab, 0x97, 0xca, 0x64, 0xb7, 0x53, 0x8c, 0xb4, 0x01, 0x4e, 0x0b,
+	0x1e, 0xa9, 0x12, 0xee, 0xc1, 0x38, 0x53, 0xb9, 0xda, 0xbc, 0x21, 0x29, 0xae, 0x9e, 0x91, 0xca,
+	0x71, 0x88, 0x43, 0xef, 0x41, 0x58, 0x51, 0x59, 0x61, 0x68, 0xe1, 0xd5, 0xa1, 0x59, 0x8d, 0x0f,
+	0x7f, 0x5e, 0xbb, 0x05, 0xd9, 0xa8, 0x00, 0x96, 0x93, 0x68, 0x16, 0x65, 0xe2, 0x9e, 0xd4, 0x65,
+	0xaf, 0x9c, 0x40, 0xf4, 0x26, 0x4c, 0xcb, 0x04, 0x1f, 0xa1, 0x98, 0xb9, 0xb0, 0x30, 0xba, 0x34,
+	0x25, 0x97, 0x8e, 0x1d, 0xc2, 0x98, 0xc0, 0x49, 0xb6, 0x78, 0x4d, 0x98, 0xaf, 0xc7, 0xbf, 0x64,
+	0x45, 0x0b, 0x02, 0x7d, 0x4f, 0xe3, 0x82, 0x4f, 0xb5, 0x1e, 0xbb, 0x6b, 0x2e, 0x4a, 0xe1, 0x2a,
+	0x65, 0xd5, 0x26, 0x61, 0x1c, 0x7f, 0x20, 0x95, 0xd8, 0x5d, 0xd5, 0x10, 0xfc, 0xa9, 0x7a, 0x1b,
+	0x0c, 0xbc, 0x67, 0xde, 0x1c, 0x1a, 0x78, 0xaf, 0x27, 0xf0, 0x1e, 0xfa, 0x04, 0x5e, 0xeb, 0x2f,
+	0xf4, 0x03, 0x5a, 0xa3, 0xce, 0x91, 0x4a, 0xd1, 0xb7, 0x2e, 0x22, 0x24, 0x22, 0x35, 0x80, 0x35,
+	0x43, 0x59, 0xec, 0xb8, 0x09, 0xf5, 0xfb, 0x90, 0x5a, 0x03, 0x6f, 0x9c, 0x71, 0xd0, 0x09, 0x88,
+	0x9a, 0x77, 0x68, 0x46, 0xcf, 0x68, 0x19, 0xd0, 0xbe, 0xbc, 0x08, 0x38, 0x11, 0x62, 0xa2, 0x46,
+	0x3d, 0x4e, 0xea, 0xd4, 0x7c, 0x73, 0xc1, 0x58, 0x1a, 0xc1, 0x79, 0xed, 0xd9, 0x8e, 0x1c, 0xe8,
+	0x3b, 0x30, 0x1d, 0x89, 0x20, 0x2d, 0xfb, 0xbf, 0xb3, 0x60, 0x2c, 0x5d, 0xc2, 0xd9, 0xd0, 0xac,
+	0xc5, 0x3e, 0x11, 0x9b, 0x54, 0x44, 0x59, 0x42, 0xc2, 0xaa, 0x8b, 0x60, 0x66, 0x2e, 0xc9, 0x1c,
+	0x34, 0x70, 0xba, 0xa9, 0x3b, 0x61, 0x7d, 0x75, 0xa1, 0x32, 0x30, 0x96, 0xc1, 0xe5, 0x2a, 0x56,
+	0x3e, 0x26, 0x76, 0xb6, 0xb4, 0xd8, 0x81, 0xb6, 0xa0, 0x2a, 0x64, 0xf5, 0x27, 0x42, 0xfa, 0xdb,
+	0xe7, 0xa0, 0xc7, 0x53, 0x2a, 0x28, 0x64, 0xf9, 0x00, 0x34, 0x73, 0x24, 0x77, 0x98, 0xf9, 0x96,
+	0xe4, 0x29, 0x0e, 0x94, 0x9f, 0x61, 0x17, 0x35, 0xd3, 0xb4, 0x0a, 0x0c, 0xcd, 0x4c, 0xe8, 0x28,
+	0x2d, 0x29, 0x86, 0xc9, 0x28, 0x66, 0x7e, 0x57, 0xf2, 0x9e, 0x4f, 0x47, 0x29, 0xa2, 0x21, 0x2e,
+	0x86, 0xde, 0x07, 0x48, 0x5c, 0x04, 0xdd, 0xb9, 0xd8, 0x45, 0x10, 0x4e, 0xc4, 0x22, 0x02, 0xd9,
+	0x66, 0xe0, 0x1f, 0x39, 0x62, 0x3f, 0xd2, 0x40, 0x9c, 0x76, 0xcb, 0x32, 0x8b, 0x3d, 0x10, 0x27,
+	0xf5, 0x76, 0xec, 0xb9, 0xc8, 0xfd, 0xf1, 0x54, 0x82, 0x71, 0xc3, 0x46, 0x55, 0xc8, 0x47, 0x06,
+	0x71, 0x58, 0xd8, 0x84, 0x13, 0xb3, 0xa4, 0x4f, 0x8a, 0xfe, 0x35, 0xbf, 0x23, 0xff, 0xe3, 0x00,
+	0xe7, 0x92, 0x11, 0x55, 0xc2, 0x09, 0xba, 0x0e, 0x99, 0x46, 0xcb, 0xe5, 0x4e, 0x8d, 0x30, 0x6e,
+	0xae, 0xc8, 0xa3, 0x3e, 0x36, 0x20, 0x9c, 0x58, 0x95, 0x4d, 0xa9, 0x24, 0xcc, 0xbb, 0xf2, 0x0b,
+	0xb7, 0xcf, 0x31, 0xd4, 0x4a, 0x7a, 0xc4, 0x0b, 0x58, 0x4b, 0x91, 0xf7, 0x21, 0x17, 0x1d, 0x39,
+	0x21, 0xe9, 0x3d, 0x49, 0x5a, 0x38, 0x4b, 0x96, 0x44, 0x4c, 0xbd, 0xa2, 0xe6, 0x31, 0xcc, 0x0d,
+	0xa9, 0xf9, 0x1c, 0xdb, 0x5c, 0x95, 0x63, 0x7d, 0xb5, 0xdb, 0x29, 0xce, 0x94, 0xfb, 0x4b, 0xbe,
+	0x8d, 0x2a, 0x9e, 0x19, 0xa8, 0x03, 0x37, 0x6c, 0xf4, 0x09, 0x5c, 0x1f, 0x42, 0x76, 0x48, 0x0f,
+	0x2d, 0x97, 0xec, 0x53, 0xd7, 0xbc, 0x2f, 0x39, 0x6f, 0x74, 0x3b, 0xc5, 0x6b, 0x03, 0x9c, 0x8f,
+	0xd7, 0x1f, 0x6f, 0x0a, 0x10, 0xbe, 0x36, 0xc0, 0xfc, 0x98, 0x1e, 0x4a, 0xd7, 0xfc, 0x5f, 0xc3,
+	0x74, 0x5f, 0x5d, 0x8e, 0x72, 0x30, 0x7a, 0x48, 0xd5, 0x0f, 0x57, 0x19, 0x2c, 0x1e, 0xd1, 0x2c,
+	0x5c, 0x3a, 0x22, 0x6e, 0x2b, 0xfc, 0x1d, 0x46, 0xbd, 0x3c, 0x18, 0x79, 0xd7, 0x98, 0xff, 0x10,
+	0xb2, 0xbd, 0x65, 0xe4, 0x90, 0xe8, 0x52, 0x32, 0x7a, 0x48, 0xb6, 0x0a, 0x09, 0x12, 0xbc, 0x5a,
+	0x31, 0xbe, 0x0f, 0x10, 0x95, 0xab, 0x0c, 0x3d, 0x80, 0x89, 0xf8, 0x5f, 0x73, 0x84, 0xf0, 0x1a,
+	0x95, 0x37, 0x75, 0x67, 0xd5, 0xb7, 0x18, 0x68, 0x14, 0xbb, 0xf8, 0x13, 0xb8, 0xb2, 0x26, 0x25,
+	0x53, 0xec, 0xd6, 0x92, 0xb6, 0x02, 0x10, 0xb3, 0x6a, 0x35, 0x77, 0x36, 0x69, 0x42, 0xc2, 0x65,
+	0x22, 0xfa, 0xc5, 0x7f, 0x31, 0xe0, 0xca, 0x9e, 0x14, 0x53, 0xdf, 0x06, 0x3d, 0x7a, 0x08, 0x10,
+	0xff, 0xf3, 0xce, 0x99, 0x3a, 0xf1, 0x91, 0x80, 0x3c, 0x21, 0xec, 0x50, 0x4b, 0xef, 0xcc, 0x41,
+	0x68, 0x58, 0xfc, 0x4f, 0x03, 0x66, 0x7e, 0x44, 0xf9, 0x40, 0xe3, 0x76, 0x21, 0x1b, 0x37, 0xce,
+	0xfa, 0xfa, 0x6a, 0x76, 0x92, 0xc6, 0x7e, 0xf6, 0xcd, 0x9b, 0xfb, 0x3f, 0x06, 0xcc, 0x09, 0x21,
+	0x1f, 0xcf, 0x7d, 0xd8, 0xe0, 0x8f, 0x60, 0x3a, 0xb9, 0x1b, 0xe2, 0x16, 0xbf, 0xf9, 0x8a, 0x8d,
+	0x3f, 0xbc, 0xcd, 0x59, 0x92, 0x44, 0x7c, 0xf3, 0x56, 0x8b, 0x4d, 0xe2, 0x07, 0x36, 0x0d, 0xf4,
+	0x6f, 0x66, 0xea, 0x45, 0xfe, 0x24, 0x29, 0xff, 0xaf, 0x42, 0xfd, 0xdf, 0x8e, 0x7a, 0x11, 0x7a,
+	0xbb, 0x29, 0xf2, 0xae, 0xfa, 0x2f, 0x1d, 0xf9, 0xbc, 0xf8, 0x0b, 0x03, 0x66, 0x76, 0x86, 0x4c,
+	0xd2, 0xf7, 0xe0, 0xf2, 0x79, 0x57, 0x8f, 0x6a, 0x93, 0x86, 0x7f, 0xe3, 0x1e, 0xbd, 0xf5, 0x08,
+	0x20, 0xae, 0x22, 0x50, 0x1e, 0xa6, 0xb6, 0x9f, 0x3e, 0x5b, 0xc7, 0xd6, 0xde, 0xd6, 0xe3, 0xad,
+	0xa7, 0xcf, 0xb6, 0x72, 0xa9, 0xd8, 0x54, 0x29, 0xef, 0xee, 0xae, 0xe3, 0x8f, 0x72, 0x06, 0x42,
+	0x90, 0x55, 0xa6, 0xf5, 0xbf, 0xdd, 0x5d, 0xc7, 0x5b, 0xe5, 0xcd, 0xdc, 0xc8, 0x5b, 0xcf, 0x60,
+	0x32, 0x79, 0x93, 0x83, 0xe6, 0x20, 0x5f, 0x5d, 0xff, 0xd0, 0xda, 0x7a, 0xba, 0xb5, 0xb6, 0x6e,
+	0x55, 0xd7, 0x1f, 0x95, 0xf7, 0x36, 0x77, 0x73, 0x29, 0x74, 0x15, 0x66, 0x62, 0xf3, 0x93, 0xa7,
+	0x5b, 0x4f, 0x77, 0x9f, 0x6e, 0x6d, 0xac, 0xe5, 0x8c, 0x5e, 0xc7, 0xde, 0xce, 0x7a, 0xd5, 0xda,
+	0xdc, 0xd8, 0xd9, 0xcd, 0x8d, 0x54, 0xfe, 0xd5, 0xf8, 0xfc, 0x65, 0xc1, 0xf8, 0xe2, 0x65, 0xc1,
+	0xf8, 0xfd, 0xcb, 0x42, 0xea, 0x8f, 0x2f, 0x0b, 0xa9, 0x3f, 0xbd, 0x2c, 0xa4, 0xfe, 0xfc, 0xb2,
+	0x90, 0xfa, 0xcb, 0xcb, 0x82, 0xf1, 0xb3, 0x6e, 0xc1, 0xf8, 0x79, 0xb7, 0x90, 0xfa, 0x4d, 0xb7,
+	0x60, 0xfc, 0xb6, 0x5b, 0x48, 0x7d, 0xd6, 0x2d, 0xa4, 0x7e, 0xd7, 0x2d, 0xa4, 0x3e, 0xef, 0x16,
+	0x8c, 0x2f, 0xba, 0x05, 0xe3, 0xf7, 0xdd, 0x42, 0xea, 0x8f, 0xdd, 0x82, 0xf1, 0xa7, 0x6e, 0x21,
+	0xf5, 0xe7, 0x6e, 0xc1, 0xf8, 0x4b, 0xb7, 0x90, 0xfa, 0xd9, 0x69, 0x21, 0xf5, 0xf3, 0xd3, 0x82,
+	0xf1, 0xcb, 0xd3, 0x42, 0xea, 0x57, 0xa7, 0x05, 0xe3, 0xd7, 0xa7, 0x85, 0xd4, 0x6f, 0x4e, 0x0b,
+	0xa9, 0xdf, 0x9e, 0x16, 0x8c, 0xcf, 0x4e, 0x0b, 0xc6, 0xef, 0x4e, 0x0b, 0xc6, 0x8f, 0xef, 0x9c,
+	0x57, 0x17, 0x70, 0xaf, 0xb9, 0xbf, 0x7f, 0x59, 0x0e, 0xf5, 0xfd, 0xff, 0x0d, 0x00, 0x00, 0xff,
+	0xff, 0x99, 0xb2, 0x94, 0xb1, 0x6a, 0x28, 0x00, 0x00,
 }
@@ -155,6 +155,14 @@ func (this *MACState_JoinAccept) Validate() error {
 	}
 	return nil
 }
+func (this *DevNoncePolicy) Validate() error {
+	if this.ResetAllowedUntil != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ResetAllowedUntil); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("ResetAllowedUntil", err)
+		}
+	}
+	return nil
+}
 
 var _regex_EndDevice_ProvisionerID = regexp.MustCompile(`^[a-z0-9](?:[-]?[a-z0-9]){2,}$|^$`)
 
@@ -254,6 +262,16 @@ func (this *EndDevice) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("ProvisioningData", err)
 		}
 	}
+	if this.DownlinkPolicy != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.DownlinkPolicy); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("DownlinkPolicy", err)
+		}
+	}
+	if this.DevNoncePolicy != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.DevNoncePolicy); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("DevNoncePolicy", err)
+		}
+	}
 	return nil
 }
 func (this *EndDevices) Validate() error {
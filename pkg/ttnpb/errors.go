@@ -50,6 +50,11 @@ func (e errorDetails) Cause() error {
 	return errorDetails{cause}
 }
 
+// ErrorDetailsToProto converts the given ErrorDetails into an ErrorDetails message.
+func ErrorDetailsToProto(e errors.ErrorDetails) *ErrorDetails {
+	return errorDetailsToProto(e)
+}
+
 func errorDetailsToProto(e errors.ErrorDetails) *ErrorDetails {
 	attributes, err := gogoproto.Struct(e.PublicAttributes())
 	if err != nil {
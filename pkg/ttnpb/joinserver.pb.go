@@ -18,7 +18,6 @@ import bytes "bytes"
 
 import (
 	context "context"
-
 	grpc "google.golang.org/grpc"
 )
 
@@ -51,7 +50,7 @@ type SessionKeyRequest struct {
 func (m *SessionKeyRequest) Reset()      { *m = SessionKeyRequest{} }
 func (*SessionKeyRequest) ProtoMessage() {}
 func (*SessionKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_joinserver_07deb919e17409ec, []int{0}
+	return fileDescriptor_joinserver_c106df0a8286e4c7, []int{0}
 }
 func (m *SessionKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -101,7 +100,7 @@ type NwkSKeysResponse struct {
 func (m *NwkSKeysResponse) Reset()      { *m = NwkSKeysResponse{} }
 func (*NwkSKeysResponse) ProtoMessage() {}
 func (*NwkSKeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_joinserver_07deb919e17409ec, []int{1}
+	return fileDescriptor_joinserver_c106df0a8286e4c7, []int{1}
 }
 func (m *NwkSKeysResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -161,7 +160,7 @@ type AppSKeyResponse struct {
 func (m *AppSKeyResponse) Reset()      { *m = AppSKeyResponse{} }
 func (*AppSKeyResponse) ProtoMessage() {}
 func (*AppSKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_joinserver_07deb919e17409ec, []int{2}
+	return fileDescriptor_joinserver_c106df0a8286e4c7, []int{2}
 }
 func (m *AppSKeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,7 +209,7 @@ type CryptoServicePayloadRequest struct {
 func (m *CryptoServicePayloadRequest) Reset()      { *m = CryptoServicePayloadRequest{} }
 func (*CryptoServicePayloadRequest) ProtoMessage() {}
 func (*CryptoServicePayloadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_joinserver_07deb919e17409ec, []int{3}
+	return fileDescriptor_joinserver_c106df0a8286e4c7, []int{3}
 }
 func (m *CryptoServicePayloadRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -276,7 +275,7 @@ type CryptoServicePayloadResponse struct {
 func (m *CryptoServicePayloadResponse) Reset()      { *m = CryptoServicePayloadResponse{} }
 func (*CryptoServicePayloadResponse) ProtoMessage() {}
 func (*CryptoServicePayloadResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_joinserver_07deb919e17409ec, []int{4}
+	return fileDescriptor_joinserver_c106df0a8286e4c7, []int{4}
 }
 func (m *CryptoServicePayloadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -323,7 +322,7 @@ type JoinAcceptMICRequest struct {
 func (m *JoinAcceptMICRequest) Reset()      { *m = JoinAcceptMICRequest{} }
 func (*JoinAcceptMICRequest) ProtoMessage() {}
 func (*JoinAcceptMICRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_joinserver_07deb919e17409ec, []int{5}
+	return fileDescriptor_joinserver_c106df0a8286e4c7, []int{5}
 }
 func (m *JoinAcceptMICRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -374,7 +373,7 @@ type DeriveSessionKeysRequest struct {
 func (m *DeriveSessionKeysRequest) Reset()      { *m = DeriveSessionKeysRequest{} }
 func (*DeriveSessionKeysRequest) ProtoMessage() {}
 func (*DeriveSessionKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_joinserver_07deb919e17409ec, []int{6}
+	return fileDescriptor_joinserver_c106df0a8286e4c7, []int{6}
 }
 func (m *DeriveSessionKeysRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -435,7 +434,7 @@ type GetRootKeysRequest struct {
 func (m *GetRootKeysRequest) Reset()      { *m = GetRootKeysRequest{} }
 func (*GetRootKeysRequest) ProtoMessage() {}
 func (*GetRootKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_joinserver_07deb919e17409ec, []int{7}
+	return fileDescriptor_joinserver_c106df0a8286e4c7, []int{7}
 }
 func (m *GetRootKeysRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -496,7 +495,7 @@ type ProvisionEndDevicesRequest struct {
 func (m *ProvisionEndDevicesRequest) Reset()      { *m = ProvisionEndDevicesRequest{} }
 func (*ProvisionEndDevicesRequest) ProtoMessage() {}
 func (*ProvisionEndDevicesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_joinserver_07deb919e17409ec, []int{8}
+	return fileDescriptor_joinserver_c106df0a8286e4c7, []int{8}
 }
 func (m *ProvisionEndDevicesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -693,7 +692,7 @@ func (m *ProvisionEndDevicesRequest_IdentifiersList) Reset() {
 }
 func (*ProvisionEndDevicesRequest_IdentifiersList) ProtoMessage() {}
 func (*ProvisionEndDevicesRequest_IdentifiersList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_joinserver_07deb919e17409ec, []int{8, 0}
+	return fileDescriptor_joinserver_c106df0a8286e4c7, []int{8, 0}
 }
 func (m *ProvisionEndDevicesRequest_IdentifiersList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -742,7 +741,7 @@ func (m *ProvisionEndDevicesRequest_IdentifiersRange) Reset() {
 }
 func (*ProvisionEndDevicesRequest_IdentifiersRange) ProtoMessage() {}
 func (*ProvisionEndDevicesRequest_IdentifiersRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_joinserver_07deb919e17409ec, []int{8, 1}
+	return fileDescriptor_joinserver_c106df0a8286e4c7, []int{8, 1}
 }
 func (m *ProvisionEndDevicesRequest_IdentifiersRange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -782,7 +781,7 @@ func (m *ProvisionEndDevicesRequest_IdentifiersFromData) Reset() {
 }
 func (*ProvisionEndDevicesRequest_IdentifiersFromData) ProtoMessage() {}
 func (*ProvisionEndDevicesRequest_IdentifiersFromData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_joinserver_07deb919e17409ec, []int{8, 2}
+	return fileDescriptor_joinserver_c106df0a8286e4c7, []int{8, 2}
 }
 func (m *ProvisionEndDevicesRequest_IdentifiersFromData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1801,6 +1800,10 @@ type JsEndDeviceRegistryClient interface {
 	// Delete deletes the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// ClearDevNonces clears the DevNonce history of the device that matches the given identifiers,
+	// so that the device can join again after it reset its DevNonce.
+	// The JoinNonce is not reset.
+	ClearDevNonces(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type jsEndDeviceRegistryClient struct {
@@ -1870,6 +1873,15 @@ func (c *jsEndDeviceRegistryClient) Delete(ctx context.Context, in *EndDeviceIde
 	return out, nil
 }
 
+func (c *jsEndDeviceRegistryClient) ClearDevNonces(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.JsEndDeviceRegistry/ClearDevNonces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JsEndDeviceRegistryServer is the server API for JsEndDeviceRegistry service.
 type JsEndDeviceRegistryServer interface {
 	// Get returns the device that matches the given identifiers.
@@ -1883,6 +1895,10 @@ type JsEndDeviceRegistryServer interface {
 	// Delete deletes the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Delete(context.Context, *EndDeviceIdentifiers) (*types.Empty, error)
+	// ClearDevNonces clears the DevNonce history of the device that matches the given identifiers,
+	// so that the device can join again after it reset its DevNonce.
+	// The JoinNonce is not reset.
+	ClearDevNonces(context.Context, *EndDeviceIdentifiers) (*types.Empty, error)
 }
 
 func RegisterJsEndDeviceRegistryServer(s *grpc.Server, srv JsEndDeviceRegistryServer) {
@@ -1964,6 +1980,24 @@ func _JsEndDeviceRegistry_Delete_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JsEndDeviceRegistry_ClearDevNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsEndDeviceRegistryServer).ClearDevNonces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.JsEndDeviceRegistry/ClearDevNonces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsEndDeviceRegistryServer).ClearDevNonces(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _JsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.JsEndDeviceRegistry",
 	HandlerType: (*JsEndDeviceRegistryServer)(nil),
@@ -1980,6 +2014,10 @@ var _JsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _JsEndDeviceRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "ClearDevNonces",
+			Handler:    _JsEndDeviceRegistry_ClearDevNonces_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -4993,117 +5031,119 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("lorawan-stack/api/joinserver.proto", fileDescriptor_joinserver_07deb919e17409ec)
+	proto.RegisterFile("lorawan-stack/api/joinserver.proto", fileDescriptor_joinserver_c106df0a8286e4c7)
 }
 func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/joinserver.proto", fileDescriptor_joinserver_07deb919e17409ec)
-}
-
-var fileDescriptor_joinserver_07deb919e17409ec = []byte{
-	// 1678 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4f, 0x6c, 0x13, 0x57,
-	0x1a, 0x9f, 0x97, 0x38, 0x4e, 0xf2, 0x12, 0x3b, 0xc9, 0x03, 0xed, 0x66, 0x4d, 0x34, 0x0e, 0x26,
-	0xac, 0xb2, 0x01, 0xdb, 0xc8, 0xec, 0x66, 0x77, 0xb3, 0x02, 0xd6, 0x8e, 0xbd, 0x89, 0x13, 0x12,
-	0x45, 0xe3, 0x65, 0xd9, 0x06, 0x12, 0x77, 0x62, 0xbf, 0x98, 0x89, 0x9d, 0x99, 0xe9, 0xbc, 0x67,
-	0xa7, 0x2e, 0x45, 0x42, 0x3d, 0x54, 0x1c, 0x2b, 0x55, 0x95, 0x7a, 0xac, 0xda, 0x1e, 0x50, 0x7b,
-	0x41, 0x9c, 0x38, 0x72, 0xe0, 0xc0, 0x91, 0xaa, 0x17, 0xd4, 0x83, 0x21, 0xe3, 0x1e, 0x50, 0x4f,
-	0x5c, 0x5a, 0xa1, 0x56, 0x6a, 0xab, 0x99, 0x79, 0xfe, 0x37, 0x76, 0x82, 0x9d, 0x06, 0xa4, 0xde,
-	0xde, 0x9f, 0xef, 0xfb, 0xbd, 0xef, 0xfb, 0x7d, 0xdf, 0x7b, 0xfe, 0x8d, 0xa1, 0x2f, 0xa7, 0x68,
-	0xe2, 0x8e, 0x28, 0xfb, 0x09, 0x15, 0x53, 0xd9, 0xa0, 0xa8, 0x4a, 0xc1, 0x2d, 0x45, 0x92, 0x09,
-	0xd6, 0x0a, 0x58, 0x0b, 0xa8, 0x9a, 0x42, 0x15, 0xe4, 0xa6, 0x54, 0x0e, 0x30, 0xbb, 0x40, 0xe1,
-	0xac, 0xc7, 0x9f, 0x91, 0xe8, 0xb5, 0xfc, 0x46, 0x20, 0xa5, 0x6c, 0x07, 0x33, 0x4a, 0x46, 0x09,
-	0x9a, 0x66, 0x1b, 0xf9, 0x4d, 0x73, 0x66, 0x4e, 0xcc, 0x91, 0xe5, 0xee, 0x99, 0xae, 0x33, 0xdf,
-	0xde, 0x91, 0x68, 0x56, 0xd9, 0x09, 0x66, 0x14, 0xbf, 0xb9, 0xe9, 0x2f, 0x88, 0x39, 0x29, 0x2d,
-	0x52, 0x45, 0x23, 0xc1, 0xea, 0x90, 0xf9, 0x8d, 0x65, 0x14, 0x25, 0x93, 0xc3, 0x66, 0x4c, 0xa2,
-	0x2c, 0x2b, 0x54, 0xa4, 0x92, 0x22, 0x13, 0xb6, 0x7b, 0x8c, 0xed, 0x56, 0xcf, 0xc6, 0xdb, 0x2a,
-	0x2d, 0xda, 0x5c, 0xab, 0x9b, 0x84, 0x6a, 0xf9, 0x14, 0x65, 0xbb, 0x2d, 0x72, 0xc6, 0x72, 0x3a,
-	0x99, 0xc6, 0x05, 0x29, 0x85, 0x99, 0xcd, 0x89, 0x66, 0x1b, 0x29, 0x8d, 0x65, 0x2a, 0x6d, 0x4a,
-	0x58, 0xab, 0xc4, 0x30, 0xd6, 0x9a, 0x3c, 0xb6, 0xeb, 0x6d, 0xde, 0xad, 0x90, 0xb8, 0xa7, 0x7b,
-	0x16, 0x17, 0x19, 0xb8, 0xef, 0x0b, 0x00, 0x47, 0x12, 0x98, 0x10, 0x49, 0x91, 0x17, 0x71, 0x51,
-	0xc0, 0x6f, 0xe5, 0x31, 0xa1, 0x68, 0x1a, 0xba, 0x89, 0xb5, 0x98, 0xcc, 0xe2, 0x62, 0x52, 0x4a,
-	0x8f, 0x82, 0x71, 0x30, 0x39, 0x18, 0x19, 0xd6, 0x4b, 0xde, 0xc1, 0x9a, 0x79, 0x3c, 0x2a, 0x0c,
-	0x92, 0xda, 0x2c, 0x8d, 0xd6, 0x60, 0x6f, 0x1a, 0x17, 0x92, 0x38, 0x2f, 0x8d, 0x76, 0x99, 0x0e,
-	0xd1, 0x87, 0x25, 0x2f, 0xf7, 0x4d, 0xc9, 0x1b, 0xca, 0x28, 0x01, 0x7a, 0x0d, 0xd3, 0x6b, 0x92,
-	0x9c, 0x21, 0x01, 0x19, 0xd3, 0x1d, 0x45, 0xcb, 0x06, 0x1b, 0x23, 0x53, 0xb3, 0x99, 0x20, 0x2d,
-	0xaa, 0x98, 0x04, 0x62, 0x97, 0xe2, 0xd3, 0x7f, 0xd5, 0x4b, 0x5e, 0x67, 0x14, 0x17, 0x62, 0x97,
-	0xe2, 0x82, 0x33, 0x8d, 0x0b, 0xb1, 0xbc, 0xe4, 0xfb, 0x0e, 0xc0, 0xe1, 0xe5, 0x9d, 0x6c, 0x62,
-	0x11, 0x17, 0x89, 0x80, 0x89, 0xaa, 0xc8, 0x04, 0xa3, 0x39, 0x38, 0xb4, 0x99, 0x94, 0x77, 0xb2,
-	0x49, 0x92, 0x94, 0x64, 0x6a, 0xc4, 0x6b, 0x06, 0x3b, 0x10, 0x3a, 0x16, 0x68, 0xec, 0xa8, 0xc0,
-	0x22, 0x2e, 0xc6, 0xe4, 0x02, 0xce, 0x29, 0x2a, 0x8e, 0x38, 0x8c, 0xc0, 0x84, 0x81, 0x4d, 0x03,
-	0x2e, 0x2e, 0xd3, 0x45, 0x5c, 0x34, 0x80, 0x88, 0x0d, 0xa8, 0xab, 0x6d, 0x20, 0x52, 0x07, 0x14,
-	0x85, 0x2e, 0x0b, 0x06, 0xcb, 0x29, 0x13, 0xa6, 0xbb, 0x5d, 0x18, 0x28, 0xef, 0x64, 0x13, 0x31,
-	0x39, 0xb5, 0x88, 0x8b, 0xbe, 0x15, 0x38, 0x14, 0x56, 0xd5, 0x84, 0x59, 0x15, 0x96, 0xea, 0x39,
-	0xd8, 0x2f, 0xaa, 0x6a, 0x92, 0x74, 0x96, 0x64, 0xaf, 0x68, 0xc1, 0xf8, 0x7e, 0xea, 0x82, 0xc7,
-	0x66, 0xb5, 0xa2, 0x4a, 0x95, 0x04, 0xd6, 0x8c, 0x2e, 0x5c, 0x11, 0x8b, 0x39, 0x45, 0x4c, 0x57,
-	0xaa, 0xfe, 0x6f, 0xd8, 0x2d, 0xa5, 0x09, 0x03, 0x9e, 0xb0, 0x03, 0xc7, 0xe4, 0x74, 0xd4, 0xec,
-	0xdd, 0x78, 0xad, 0x43, 0x23, 0x7d, 0xc6, 0x09, 0x8f, 0x4a, 0x5e, 0x20, 0x18, 0xae, 0xe8, 0x32,
-	0x1c, 0x62, 0x1e, 0xc9, 0x02, 0xd6, 0x8c, 0xbe, 0x30, 0x29, 0x74, 0x87, 0x3c, 0x76, 0xb4, 0xa5,
-	0xf0, 0xec, 0xff, 0x2c, 0x8b, 0x08, 0xd2, 0x4b, 0x5e, 0xf7, 0x45, 0x45, 0x10, 0x2f, 0x87, 0x97,
-	0xd9, 0x9a, 0xe0, 0x66, 0xa6, 0x6c, 0x8e, 0x46, 0x61, 0xaf, 0x6a, 0x05, 0x6b, 0x92, 0x39, 0x28,
-	0x54, 0xa6, 0x48, 0x84, 0x6e, 0x55, 0x53, 0x0a, 0x92, 0x61, 0x86, 0x35, 0xa3, 0x55, 0x1d, 0xe3,
-	0x60, 0xb2, 0x3f, 0x32, 0xa3, 0x97, 0xbc, 0xae, 0x95, 0xda, 0x4e, 0x3c, 0xaa, 0x3f, 0xf1, 0x9e,
-	0x84, 0xc7, 0xd7, 0xaf, 0x88, 0xfe, 0x77, 0xce, 0xf8, 0xff, 0xb9, 0x36, 0x79, 0x61, 0xe6, 0x8a,
-	0x7f, 0xed, 0x42, 0x65, 0xfa, 0x97, 0xeb, 0xa1, 0xd3, 0x37, 0x26, 0xde, 0x5d, 0x9f, 0x78, 0xfb,
-	0xa4, 0xe0, 0xaa, 0x43, 0x8c, 0xa7, 0x51, 0x14, 0x8e, 0x54, 0x17, 0x24, 0x39, 0x93, 0x4c, 0x8b,
-	0x54, 0x1c, 0xed, 0x31, 0x59, 0xfa, 0x63, 0xc0, 0x7a, 0x03, 0x02, 0x95, 0x37, 0x20, 0x90, 0x30,
-	0xdf, 0x00, 0x61, 0xb8, 0xde, 0x23, 0x2a, 0x52, 0xd1, 0xf7, 0x0f, 0x38, 0xd6, 0x9a, 0x7c, 0x56,
-	0xdc, 0xba, 0x14, 0x41, 0x43, 0x8a, 0xbe, 0x9f, 0x01, 0x3c, 0xba, 0xa0, 0x48, 0x72, 0x38, 0x95,
-	0xc2, 0x2a, 0x5d, 0x8a, 0xcf, 0x56, 0x0a, 0xb6, 0x0e, 0x87, 0x98, 0x4d, 0x52, 0xb3, 0x96, 0x58,
-	0xf1, 0x4e, 0xd9, 0xe9, 0xde, 0xa7, 0xec, 0x75, 0x35, 0x74, 0xab, 0x8d, 0x0d, 0x31, 0x05, 0x47,
-	0x8c, 0x97, 0xa6, 0x02, 0x9e, 0x34, 0x6e, 0xa7, 0x59, 0x50, 0x97, 0x30, 0x64, 0x6c, 0x30, 0xbb,
-	0xff, 0x16, 0x55, 0x8c, 0x56, 0x61, 0xbf, 0x71, 0xf5, 0x65, 0x45, 0x4e, 0x61, 0xab, 0x46, 0x91,
-	0x73, 0xec, 0xf2, 0xff, 0xad, 0xa3, 0xcb, 0x1f, 0xc5, 0x85, 0x65, 0x03, 0x44, 0xe8, 0x4b, 0xb3,
-	0x91, 0xef, 0x7b, 0x07, 0x1c, 0x8d, 0x62, 0x4d, 0x2a, 0xe0, 0xda, 0xdb, 0x43, 0x7e, 0x07, 0x5d,
-	0xbb, 0x06, 0xa1, 0xc9, 0x5f, 0x3d, 0x29, 0xe7, 0x19, 0x29, 0xd3, 0x1d, 0x91, 0x62, 0x94, 0xdf,
-	0x62, 0xa5, 0x7f, 0xab, 0x32, 0x6c, 0xa4, 0xdc, 0x71, 0xa8, 0x94, 0xa3, 0x55, 0xe8, 0x94, 0x31,
-	0x35, 0xae, 0x53, 0x8f, 0x09, 0x3c, 0x7b, 0xa0, 0x87, 0x7c, 0x19, 0xd3, 0x78, 0x54, 0x2f, 0x79,
-	0x7b, 0xcc, 0x81, 0xd0, 0x23, 0x63, 0x1a, 0x6f, 0x75, 0x65, 0x9d, 0xaf, 0xe5, 0xca, 0xf6, 0x76,
-	0x7a, 0x65, 0x7f, 0x01, 0x10, 0xcd, 0x61, 0x2a, 0x28, 0x0a, 0x3d, 0xdc, 0x8e, 0x6b, 0x66, 0xa0,
-	0xeb, 0xb5, 0x30, 0xd0, 0xdd, 0x29, 0x03, 0x0f, 0xfa, 0xa0, 0xa7, 0x1a, 0x4f, 0x35, 0xb3, 0x2a,
-	0x13, 0x6f, 0xc0, 0x21, 0x51, 0x55, 0x73, 0x52, 0xca, 0x14, 0x4d, 0xc9, 0x1a, 0x2b, 0x7f, 0xb6,
-	0xb3, 0x12, 0xae, 0x99, 0xb5, 0xe6, 0xc5, 0x2d, 0xd6, 0x5b, 0x10, 0xb4, 0xbe, 0x07, 0x45, 0x7f,
-	0x6f, 0x45, 0x91, 0x0f, 0xf2, 0xfb, 0x53, 0xd4, 0xcc, 0xcf, 0xa9, 0xbd, 0xf8, 0x19, 0x6c, 0xa6,
-	0x01, 0xad, 0x40, 0x47, 0x4e, 0x22, 0xd4, 0xbc, 0x64, 0x03, 0xa1, 0x19, 0x7b, 0x72, 0x7b, 0x33,
-	0x14, 0xa8, 0x4b, 0xf6, 0xa2, 0x44, 0xe8, 0x3c, 0x27, 0x98, 0x48, 0x28, 0x01, 0x7b, 0x34, 0x51,
-	0xce, 0x60, 0xf6, 0x3b, 0xf2, 0xaf, 0x83, 0x41, 0x0a, 0x06, 0xc4, 0x3c, 0x27, 0x58, 0x58, 0x68,
-	0x0d, 0xf6, 0x6f, 0x6a, 0xca, 0xb6, 0x95, 0x8b, 0xd3, 0x04, 0x3e, 0x7f, 0x30, 0xe0, 0xff, 0x68,
-	0xca, 0xb6, 0x91, 0xf9, 0x3c, 0x27, 0xf4, 0x6d, 0xb2, 0xb1, 0xe7, 0x2b, 0x00, 0x87, 0x6c, 0xf9,
-	0xa0, 0xab, 0xb0, 0xcf, 0x7c, 0xe2, 0x0c, 0xc9, 0x67, 0x69, 0xc4, 0xf0, 0x81, 0xe5, 0x5e, 0xaf,
-	0xf1, 0xca, 0x19, 0x7a, 0xaf, 0xd7, 0x80, 0x8c, 0xe5, 0x25, 0xf4, 0x26, 0x74, 0xd7, 0x34, 0xb3,
-	0xd9, 0x5e, 0x5d, 0xe3, 0xdd, 0x6d, 0x5f, 0xba, 0xa3, 0x46, 0x73, 0x19, 0x8a, 0xb5, 0xb6, 0x1b,
-	0x25, 0xc2, 0x20, 0xae, 0xd9, 0x12, 0xcf, 0x13, 0x00, 0x87, 0xed, 0x84, 0xbe, 0xe2, 0xa4, 0xb6,
-	0xa1, 0x8b, 0x50, 0x51, 0xa3, 0xc9, 0x46, 0xa9, 0x1c, 0xff, 0x4d, 0x52, 0x79, 0x20, 0x61, 0x40,
-	0x32, 0xbd, 0x3c, 0x40, 0x2a, 0x93, 0xbc, 0xe4, 0x21, 0xf0, 0x48, 0x8b, 0xc2, 0xbe, 0xda, 0x1c,
-	0x23, 0x2e, 0x38, 0x50, 0x2b, 0x1c, 0x09, 0x7d, 0x06, 0xa0, 0x63, 0x99, 0x2c, 0x10, 0x34, 0x07,
-	0xe1, 0xbc, 0x28, 0xa7, 0x73, 0xd8, 0xf0, 0x40, 0x4d, 0xe2, 0x75, 0xa1, 0x26, 0x2a, 0x3c, 0x63,
-	0xad, 0x37, 0x99, 0x5a, 0x12, 0xe0, 0xc0, 0x1c, 0xa6, 0x95, 0x8f, 0x01, 0x74, 0xdc, 0x6e, 0xdc,
-	0xf4, 0x4d, 0xe3, 0x19, 0xb7, 0x9b, 0xd8, 0xbf, 0x24, 0x42, 0xff, 0x87, 0x8e, 0xb0, 0x11, 0xe4,
-	0x0a, 0x84, 0x73, 0x98, 0x32, 0xf1, 0xdd, 0x0e, 0xb4, 0xb7, 0xc5, 0x6b, 0x57, 0x2f, 0xdc, 0x43,
-	0x3f, 0x38, 0xe0, 0xd1, 0x65, 0x8b, 0xcc, 0x06, 0x25, 0x86, 0xb2, 0xd0, 0x5d, 0x97, 0xf3, 0x52,
-	0x7c, 0x16, 0x75, 0x22, 0xdd, 0x3c, 0xa7, 0xdb, 0x33, 0x66, 0x9c, 0xa5, 0xa0, 0xab, 0x41, 0x46,
-	0xa2, 0x89, 0x56, 0x14, 0xdb, 0x55, 0x66, 0x87, 0x87, 0xc8, 0x70, 0x24, 0x26, 0xa7, 0x0c, 0x8b,
-	0x1a, 0xd8, 0xab, 0x4c, 0x4a, 0x85, 0x47, 0xd8, 0x79, 0x02, 0xde, 0x7a, 0x2d, 0x27, 0x5e, 0x85,
-	0x6e, 0x4b, 0x8c, 0x56, 0xbb, 0x6f, 0xd2, 0xee, 0xbf, 0x97, 0x58, 0x7d, 0x79, 0x13, 0xa2, 0x8b,
-	0xb0, 0xdf, 0x6a, 0x6c, 0xa3, 0xf7, 0x7c, 0x76, 0xf3, 0x66, 0x35, 0xe2, 0xd9, 0xef, 0x0b, 0x30,
-	0xf4, 0x00, 0xc0, 0xd1, 0xba, 0x9f, 0xde, 0xc6, 0xe6, 0x5b, 0x85, 0x2e, 0x2b, 0xd0, 0x4a, 0xab,
-	0xb7, 0x9f, 0xc7, 0xcb, 0x3a, 0x9e, 0xa5, 0x11, 0x56, 0xd5, 0x43, 0x49, 0xe3, 0x7d, 0x27, 0x3c,
-	0xb2, 0x40, 0xaa, 0xcf, 0xb8, 0x80, 0x33, 0x12, 0xa1, 0x5a, 0x11, 0xdd, 0x05, 0xb0, 0x7b, 0x0e,
-	0x53, 0x74, 0xa2, 0xc5, 0x01, 0x75, 0xd6, 0xd6, 0x09, 0x7f, 0xda, 0xf3, 0x47, 0xc3, 0x97, 0x7d,
-	0xef, 0xeb, 0x6f, 0x3f, 0xec, 0xc2, 0x28, 0x15, 0xdc, 0x22, 0xc1, 0x3a, 0x21, 0x42, 0x82, 0xd7,
-	0x1b, 0x7f, 0x7f, 0x02, 0x36, 0xb9, 0x63, 0x9b, 0xdf, 0x08, 0xb2, 0x17, 0xaf, 0xc9, 0xaf, 0x3a,
-	0xbc, 0x81, 0x7e, 0x04, 0xb0, 0x3b, 0xd1, 0x2a, 0xe8, 0x44, 0x67, 0x41, 0xdf, 0x05, 0x66, 0xd4,
-	0x5f, 0x02, 0xcf, 0x95, 0xe6, 0xb0, 0xd9, 0xdf, 0x4c, 0x1d, 0x85, 0x5c, 0xe7, 0x53, 0x0b, 0x77,
-	0x06, 0x4c, 0xad, 0xc6, 0x7d, 0xd1, 0xc3, 0x38, 0x61, 0x06, 0x4c, 0xa1, 0xcf, 0x01, 0xec, 0xaf,
-	0x4a, 0x10, 0x34, 0xd5, 0xbe, 0x3a, 0xd9, 0x8f, 0x89, 0x65, 0x93, 0x88, 0x79, 0xcf, 0x6c, 0x73,
-	0x94, 0x2f, 0x0b, 0xad, 0x2a, 0xf5, 0xfc, 0xb5, 0x20, 0xcf, 0x00, 0xf4, 0x11, 0x80, 0xce, 0x28,
-	0xce, 0x61, 0x8a, 0x51, 0x5b, 0x5a, 0xc3, 0xf3, 0x87, 0x26, 0x4d, 0x1d, 0xdb, 0x56, 0x69, 0xd1,
-	0xb7, 0x64, 0x86, 0x36, 0x37, 0x15, 0xeb, 0x3c, 0x34, 0x5b, 0x5d, 0x8c, 0xb5, 0xc8, 0xa7, 0xe0,
-	0xe1, 0x2e, 0x0f, 0x1e, 0xed, 0xf2, 0xe0, 0xf1, 0x2e, 0xcf, 0x3d, 0xdd, 0xe5, 0xb9, 0x67, 0xbb,
-	0x3c, 0xf7, 0x7c, 0x97, 0xe7, 0x5e, 0xec, 0xf2, 0xe0, 0xa6, 0xce, 0x83, 0x5b, 0x3a, 0xcf, 0xdd,
-	0xd6, 0x79, 0x70, 0x47, 0xe7, 0xb9, 0x7b, 0x3a, 0xcf, 0xdd, 0xd7, 0x79, 0xee, 0xa1, 0xce, 0x83,
-	0x47, 0x3a, 0x0f, 0x1e, 0xeb, 0x3c, 0xf7, 0x54, 0xe7, 0xc1, 0x33, 0x9d, 0xe7, 0x9e, 0xeb, 0x3c,
-	0x78, 0xa1, 0xf3, 0xdc, 0xcd, 0x32, 0xcf, 0xdd, 0x2a, 0xf3, 0xe0, 0x83, 0x32, 0xcf, 0x7d, 0x5c,
-	0xe6, 0xc1, 0x27, 0x65, 0x9e, 0xbb, 0x5d, 0xe6, 0xb9, 0x3b, 0x65, 0x1e, 0xdc, 0x2b, 0xf3, 0xe0,
-	0x7e, 0x99, 0x07, 0xab, 0xa7, 0xdb, 0x95, 0x05, 0x54, 0x56, 0x37, 0x36, 0x9c, 0x26, 0x07, 0x67,
-	0x7f, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x21, 0x35, 0x73, 0x81, 0xf6, 0x15, 0x00, 0x00,
+	golang_proto.RegisterFile("lorawan-stack/api/joinserver.proto", fileDescriptor_joinserver_c106df0a8286e4c7)
+}
+
+var fileDescriptor_joinserver_c106df0a8286e4c7 = []byte{
+	// 1712 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4f, 0x6c, 0x1b, 0x4f,
+	0x15, 0xde, 0x49, 0x9c, 0x7f, 0x93, 0xd8, 0x49, 0xa6, 0x15, 0x04, 0x37, 0x5a, 0xe7, 0xe7, 0xa6,
+	0x28, 0xa4, 0xb5, 0x5d, 0xb9, 0x10, 0x20, 0xa8, 0x2d, 0x76, 0x6c, 0x12, 0x27, 0x4d, 0x14, 0xad,
+	0x29, 0x2d, 0x69, 0x13, 0xb3, 0xb1, 0x27, 0xee, 0xc6, 0xce, 0xee, 0xb2, 0x33, 0x76, 0x6a, 0x4a,
+	0xa5, 0x8a, 0x53, 0x8f, 0x48, 0x08, 0x89, 0x23, 0x02, 0x0e, 0x15, 0x5c, 0x4a, 0x4f, 0x3d, 0xf6,
+	0xd0, 0x43, 0x8f, 0x45, 0x5c, 0x2a, 0x0e, 0x6e, 0xb3, 0xe6, 0x50, 0x71, 0xea, 0x05, 0x54, 0x81,
+	0x04, 0x68, 0x67, 0xc7, 0xff, 0xd6, 0x4e, 0x6a, 0xa7, 0x69, 0xa5, 0xdf, 0x6d, 0x66, 0xe7, 0xcd,
+	0x37, 0xef, 0x7d, 0xef, 0xbd, 0xf1, 0x37, 0x86, 0xfe, 0xbc, 0x66, 0xc8, 0xfb, 0xb2, 0x1a, 0x20,
+	0x54, 0x4e, 0xe7, 0x42, 0xb2, 0xae, 0x84, 0x76, 0x35, 0x45, 0x25, 0xd8, 0x28, 0x62, 0x23, 0xa8,
+	0x1b, 0x1a, 0xd5, 0x90, 0x87, 0x52, 0x35, 0xc8, 0xed, 0x82, 0xc5, 0x4b, 0xde, 0x40, 0x56, 0xa1,
+	0x77, 0x0a, 0xdb, 0xc1, 0xb4, 0xb6, 0x17, 0xca, 0x6a, 0x59, 0x2d, 0xc4, 0xcc, 0xb6, 0x0b, 0x3b,
+	0x6c, 0xc6, 0x26, 0x6c, 0x64, 0x6f, 0xf7, 0xce, 0x35, 0x98, 0xef, 0xed, 0x2b, 0x34, 0xa7, 0xed,
+	0x87, 0xb2, 0x5a, 0x80, 0x2d, 0x06, 0x8a, 0x72, 0x5e, 0xc9, 0xc8, 0x54, 0x33, 0x48, 0xa8, 0x36,
+	0xe4, 0xfb, 0x26, 0xb3, 0x9a, 0x96, 0xcd, 0x63, 0xe6, 0x93, 0xac, 0xaa, 0x1a, 0x95, 0xa9, 0xa2,
+	0xa9, 0x84, 0xaf, 0x9e, 0xe1, 0xab, 0xb5, 0xb3, 0xf1, 0x9e, 0x4e, 0x4b, 0x8e, 0xad, 0xb5, 0x45,
+	0x42, 0x8d, 0x42, 0x9a, 0xf2, 0xd5, 0x36, 0x31, 0x63, 0x35, 0x93, 0xca, 0xe0, 0xa2, 0x92, 0xc6,
+	0xdc, 0xe6, 0x6c, 0xab, 0x8d, 0x92, 0xc1, 0x2a, 0x55, 0x76, 0x14, 0x6c, 0x54, 0x7d, 0x98, 0x6c,
+	0x4f, 0x1e, 0x5f, 0xf5, 0xb5, 0xae, 0x56, 0x49, 0x3c, 0x74, 0x7b, 0x0e, 0x97, 0x38, 0xb8, 0xff,
+	0x8f, 0x00, 0x8e, 0x27, 0x31, 0x21, 0x8a, 0xa6, 0xae, 0xe0, 0x92, 0x84, 0x7f, 0x5a, 0xc0, 0x84,
+	0xa2, 0x39, 0xe8, 0x21, 0xf6, 0xc7, 0x54, 0x0e, 0x97, 0x52, 0x4a, 0x66, 0x02, 0x4c, 0x81, 0x99,
+	0x91, 0xe8, 0x98, 0x59, 0xf6, 0x8d, 0xd4, 0xcd, 0x13, 0x31, 0x69, 0x84, 0xd4, 0x67, 0x19, 0xb4,
+	0x09, 0x07, 0x32, 0xb8, 0x98, 0xc2, 0x05, 0x65, 0xa2, 0x87, 0x6d, 0x88, 0xbd, 0x28, 0xfb, 0x84,
+	0xbf, 0x95, 0x7d, 0xe1, 0xac, 0x16, 0xa4, 0x77, 0x30, 0xbd, 0xa3, 0xa8, 0x59, 0x12, 0x54, 0x31,
+	0xdd, 0xd7, 0x8c, 0x5c, 0xa8, 0xd9, 0x33, 0x3d, 0x97, 0x0d, 0xd1, 0x92, 0x8e, 0x49, 0x30, 0x7e,
+	0x3d, 0x31, 0xf7, 0x4d, 0xb3, 0xec, 0xeb, 0x8f, 0xe1, 0x62, 0xfc, 0x7a, 0x42, 0xea, 0xcf, 0xe0,
+	0x62, 0xbc, 0xa0, 0xf8, 0xff, 0x01, 0xe0, 0xd8, 0xda, 0x7e, 0x2e, 0xb9, 0x82, 0x4b, 0x44, 0xc2,
+	0x44, 0xd7, 0x54, 0x82, 0xd1, 0x22, 0x1c, 0xdd, 0x49, 0xa9, 0xfb, 0xb9, 0x14, 0x49, 0x29, 0x2a,
+	0xb5, 0xfc, 0x65, 0xce, 0x0e, 0x87, 0xcf, 0x04, 0x9b, 0x2b, 0x2a, 0xb8, 0x82, 0x4b, 0x71, 0xb5,
+	0x88, 0xf3, 0x9a, 0x8e, 0xa3, 0x2e, 0xcb, 0x31, 0x69, 0x78, 0xc7, 0x82, 0x4b, 0xa8, 0x74, 0x05,
+	0x97, 0x2c, 0x20, 0xe2, 0x00, 0xea, 0xe9, 0x18, 0x88, 0x34, 0x00, 0xc5, 0xa0, 0xdb, 0x86, 0xc1,
+	0x6a, 0x9a, 0xc1, 0xf4, 0x76, 0x0a, 0x03, 0xd5, 0xfd, 0x5c, 0x32, 0xae, 0xa6, 0x57, 0x70, 0xc9,
+	0xbf, 0x0e, 0x47, 0x23, 0xba, 0x9e, 0x64, 0x59, 0xe1, 0xa1, 0x5e, 0x86, 0x43, 0xb2, 0xae, 0xa7,
+	0x48, 0x77, 0x41, 0x0e, 0xc8, 0x36, 0x8c, 0xff, 0x3f, 0x3d, 0xf0, 0xcc, 0x82, 0x51, 0xd2, 0xa9,
+	0x96, 0xc4, 0x86, 0x55, 0x85, 0xeb, 0x72, 0x29, 0xaf, 0xc9, 0x99, 0x6a, 0xd6, 0xbf, 0x0f, 0x7b,
+	0x95, 0x0c, 0xe1, 0xc0, 0xd3, 0x4e, 0xe0, 0xb8, 0x9a, 0x89, 0xb1, 0xda, 0x4d, 0xd4, 0x2b, 0x34,
+	0x3a, 0x68, 0x9d, 0xf0, 0xb2, 0xec, 0x03, 0x92, 0xb5, 0x15, 0xdd, 0x80, 0xa3, 0x7c, 0x47, 0xaa,
+	0x88, 0x0d, 0xab, 0x2e, 0x18, 0x85, 0x9e, 0xb0, 0xd7, 0x89, 0xb6, 0x1a, 0x59, 0xf8, 0x91, 0x6d,
+	0x11, 0x45, 0x66, 0xd9, 0xe7, 0xb9, 0xa6, 0x49, 0xf2, 0x8d, 0xc8, 0x1a, 0xff, 0x26, 0x79, 0xb8,
+	0x29, 0x9f, 0xa3, 0x09, 0x38, 0xa0, 0xdb, 0xce, 0x32, 0x32, 0x47, 0xa4, 0xea, 0x14, 0xc9, 0xd0,
+	0xa3, 0x1b, 0x5a, 0x51, 0xb1, 0xcc, 0xb0, 0x61, 0x95, 0xaa, 0x6b, 0x0a, 0xcc, 0x0c, 0x45, 0xe7,
+	0xcd, 0xb2, 0xcf, 0xbd, 0x5e, 0x5f, 0x49, 0xc4, 0xcc, 0xd7, 0xbe, 0x73, 0xf0, 0x8b, 0xad, 0x5b,
+	0x72, 0xe0, 0x67, 0x17, 0x03, 0xdf, 0xdd, 0x9c, 0xb9, 0x3a, 0x7f, 0x2b, 0xb0, 0x79, 0xb5, 0x3a,
+	0xfd, 0xc6, 0xbd, 0xf0, 0x85, 0xfb, 0xd3, 0x3f, 0xdf, 0x9a, 0xbe, 0x7b, 0x4e, 0x72, 0x37, 0x20,
+	0x26, 0x32, 0x28, 0x06, 0xc7, 0x6b, 0x1f, 0x14, 0x35, 0x9b, 0xca, 0xc8, 0x54, 0x9e, 0xe8, 0x63,
+	0x2c, 0x7d, 0x35, 0x68, 0xdf, 0x01, 0xc1, 0xea, 0x1d, 0x10, 0x4c, 0xb2, 0x3b, 0x40, 0x1a, 0x6b,
+	0xdc, 0x11, 0x93, 0xa9, 0xec, 0xff, 0x0e, 0x9c, 0x6c, 0x4f, 0x3e, 0x4f, 0x6e, 0x43, 0x88, 0xa0,
+	0x29, 0x44, 0xff, 0x7f, 0x01, 0x3c, 0xbd, 0xac, 0x29, 0x6a, 0x24, 0x9d, 0xc6, 0x3a, 0x5d, 0x4d,
+	0x2c, 0x54, 0x13, 0xb6, 0x05, 0x47, 0xb9, 0x4d, 0xca, 0xb0, 0x3f, 0xf1, 0xe4, 0x9d, 0x77, 0xd2,
+	0x7d, 0x44, 0xda, 0x1b, 0x72, 0xe8, 0xd1, 0x9b, 0x0b, 0x62, 0x16, 0x8e, 0x5b, 0x37, 0x4d, 0x15,
+	0x3c, 0x65, 0x75, 0x27, 0x4b, 0xa8, 0x5b, 0x1a, 0xb5, 0x16, 0xb8, 0xdd, 0x0f, 0x4b, 0x3a, 0x46,
+	0x1b, 0x70, 0xc8, 0x6a, 0x7d, 0x55, 0x53, 0xd3, 0xd8, 0xce, 0x51, 0xf4, 0x32, 0x6f, 0xfe, 0x6f,
+	0x75, 0xd5, 0xfc, 0x31, 0x5c, 0x5c, 0xb3, 0x40, 0xa4, 0xc1, 0x0c, 0x1f, 0xf9, 0xff, 0xe9, 0x82,
+	0x13, 0x31, 0x6c, 0x28, 0x45, 0x5c, 0xbf, 0x7b, 0xc8, 0x97, 0xa0, 0x6a, 0x37, 0x21, 0x64, 0xfc,
+	0x35, 0x92, 0x72, 0x85, 0x93, 0x32, 0xd7, 0x15, 0x29, 0x56, 0xfa, 0x6d, 0x56, 0x86, 0x76, 0xab,
+	0xc3, 0x66, 0xca, 0x5d, 0x27, 0x4a, 0x39, 0xda, 0x80, 0xfd, 0x2a, 0xa6, 0x56, 0x3b, 0xf5, 0x31,
+	0xe0, 0x85, 0x63, 0x5d, 0xe4, 0x6b, 0x98, 0x26, 0x62, 0x66, 0xd9, 0xd7, 0xc7, 0x06, 0x52, 0x9f,
+	0x8a, 0x69, 0xa2, 0x5d, 0xcb, 0xf6, 0x1f, 0xd1, 0xb2, 0x77, 0x3b, 0x69, 0xda, 0x8e, 0x5a, 0x76,
+	0xa0, 0xdb, 0x96, 0xfd, 0x1f, 0x80, 0x68, 0x11, 0x53, 0x49, 0xd3, 0xe8, 0xc9, 0x56, 0x5c, 0x2b,
+	0x03, 0x3d, 0x9f, 0x85, 0x81, 0xde, 0x6e, 0x19, 0x78, 0x3e, 0x08, 0xbd, 0x35, 0x7f, 0x6a, 0x91,
+	0xd5, 0x98, 0xf8, 0x31, 0x1c, 0x95, 0x75, 0x3d, 0xaf, 0xa4, 0x99, 0x68, 0x4a, 0xd5, 0x59, 0xf9,
+	0xba, 0x93, 0x95, 0x48, 0xdd, 0xac, 0x3d, 0x2f, 0x1e, 0xb9, 0xd1, 0x82, 0xa0, 0xad, 0x43, 0x28,
+	0xfa, 0x76, 0x3b, 0x8a, 0xfc, 0x77, 0xcf, 0x41, 0xf1, 0x68, 0x8a, 0x9c, 0xfc, 0x9c, 0x3f, 0x8c,
+	0x9f, 0x91, 0x56, 0x1a, 0xd0, 0x3a, 0x74, 0xe5, 0x15, 0x42, 0x59, 0x93, 0x0d, 0x87, 0xe7, 0x9d,
+	0xc1, 0x1d, 0xce, 0x50, 0xb0, 0x21, 0xd8, 0x6b, 0x0a, 0xa1, 0x4b, 0x82, 0xc4, 0x90, 0x50, 0x12,
+	0xf6, 0x19, 0xb2, 0x9a, 0xc5, 0xfc, 0x77, 0xe4, 0x7b, 0xc7, 0x83, 0x94, 0x2c, 0x88, 0x25, 0x41,
+	0xb2, 0xb1, 0xd0, 0x26, 0x1c, 0xda, 0x31, 0xb4, 0x3d, 0x3b, 0x96, 0x7e, 0x06, 0x7c, 0xe5, 0x78,
+	0xc0, 0x3f, 0x30, 0xb4, 0x3d, 0x2b, 0xf2, 0x25, 0x41, 0x1a, 0xdc, 0xe1, 0x63, 0xef, 0x5f, 0x00,
+	0x1c, 0x75, 0xc4, 0x83, 0x6e, 0xc3, 0x41, 0x76, 0xc5, 0x59, 0x92, 0xcf, 0xd6, 0x88, 0x91, 0x63,
+	0xcb, 0xbd, 0x01, 0xeb, 0x96, 0xb3, 0xf4, 0xde, 0x80, 0x05, 0x19, 0x2f, 0x28, 0xe8, 0x27, 0xd0,
+	0x53, 0xd7, 0xcc, 0xac, 0xbc, 0x7a, 0xa6, 0x7a, 0x3b, 0x6e, 0xba, 0xd3, 0x56, 0x71, 0x59, 0x8a,
+	0xb5, 0xbe, 0x1a, 0x23, 0xd2, 0x08, 0xae, 0xdb, 0x12, 0xef, 0x6b, 0x00, 0xc7, 0x9c, 0x84, 0x7e,
+	0xe2, 0xa0, 0xf6, 0xa0, 0x9b, 0x50, 0xd9, 0xa0, 0xa9, 0x66, 0xa9, 0x9c, 0xf8, 0x28, 0xa9, 0x3c,
+	0x9c, 0xb4, 0x20, 0xb9, 0x5e, 0x1e, 0x26, 0xd5, 0x49, 0x41, 0xf1, 0x12, 0x78, 0xaa, 0x4d, 0x62,
+	0x3f, 0x6d, 0x8c, 0x51, 0x37, 0x1c, 0xae, 0x27, 0x8e, 0x84, 0x7f, 0x0f, 0xa0, 0x6b, 0x8d, 0x2c,
+	0x13, 0xb4, 0x08, 0xe1, 0x92, 0xac, 0x66, 0xf2, 0xd8, 0xda, 0x81, 0x5a, 0xc4, 0xeb, 0x72, 0x5d,
+	0x54, 0x78, 0x27, 0xdb, 0x2f, 0x72, 0xb5, 0x24, 0xc1, 0xe1, 0x45, 0x4c, 0xab, 0x8f, 0x01, 0xf4,
+	0x85, 0xd3, 0xb8, 0xe5, 0x4d, 0xe3, 0x9d, 0x72, 0x9a, 0x38, 0x5f, 0x12, 0xe1, 0x9b, 0xd0, 0x15,
+	0xb1, 0x9c, 0x5c, 0x87, 0x70, 0x11, 0x53, 0x2e, 0xbe, 0x3b, 0x81, 0xf6, 0xb5, 0xb9, 0xed, 0x1a,
+	0x85, 0x7b, 0xf8, 0x5f, 0x2e, 0x78, 0x7a, 0xcd, 0x26, 0xb3, 0x49, 0x89, 0xa1, 0x1c, 0xf4, 0x34,
+	0xc4, 0xbc, 0x9a, 0x58, 0x40, 0xdd, 0x48, 0x37, 0xef, 0x85, 0xce, 0x8c, 0x39, 0x67, 0x69, 0xe8,
+	0x6e, 0x92, 0x91, 0x68, 0xba, 0x1d, 0xc5, 0x4e, 0x95, 0xd9, 0xe5, 0x21, 0x2a, 0x1c, 0x8f, 0xab,
+	0x69, 0xcb, 0xa2, 0x0e, 0xf6, 0x29, 0x83, 0xd2, 0xe1, 0x29, 0x7e, 0x9e, 0x84, 0x77, 0x3f, 0xcb,
+	0x89, 0xb7, 0xa1, 0xc7, 0x16, 0xa3, 0xb5, 0xea, 0x9b, 0x71, 0xee, 0x3f, 0x4c, 0xac, 0x7e, 0xb8,
+	0x08, 0xd1, 0x35, 0x38, 0x64, 0x17, 0xb6, 0x55, 0x7b, 0x7e, 0xa7, 0x79, 0xab, 0x1a, 0xf1, 0x1e,
+	0xf5, 0x02, 0x0c, 0x3f, 0x07, 0x70, 0xa2, 0xe1, 0xa7, 0xb7, 0xb9, 0xf8, 0x36, 0xa0, 0xdb, 0x76,
+	0xb4, 0x5a, 0xea, 0x9d, 0xc7, 0xf1, 0xa1, 0x8a, 0xe7, 0x61, 0x44, 0x74, 0xfd, 0x44, 0xc2, 0xf8,
+	0xf3, 0x00, 0x3c, 0xb5, 0x4c, 0x6a, 0xd7, 0xb8, 0x84, 0xb3, 0x0a, 0xa1, 0x46, 0x09, 0x3d, 0x01,
+	0xb0, 0x77, 0x11, 0x53, 0x74, 0xb6, 0xcd, 0x01, 0x0d, 0xd6, 0xf6, 0x09, 0x5f, 0x3b, 0xf4, 0x47,
+	0xc3, 0x9f, 0xfb, 0xc5, 0x5f, 0xff, 0xfe, 0xab, 0x1e, 0x8c, 0xd2, 0xa1, 0x5d, 0x12, 0x6a, 0x10,
+	0x22, 0x24, 0x74, 0xaf, 0xf9, 0xf7, 0x27, 0xe8, 0x90, 0x3b, 0x8e, 0xf9, 0xfd, 0x10, 0xbf, 0xf1,
+	0x5a, 0xf6, 0xd5, 0x86, 0xf7, 0xd1, 0xbf, 0x01, 0xec, 0x4d, 0xb6, 0x73, 0x3a, 0xd9, 0x9d, 0xd3,
+	0x4f, 0x00, 0xf3, 0xfa, 0x4f, 0x60, 0x1e, 0xcc, 0x6e, 0x24, 0xe6, 0xc1, 0xac, 0x3f, 0xd6, 0xea,
+	0x3e, 0xff, 0xbb, 0xa9, 0x0b, 0xd7, 0xbd, 0xb7, 0x4e, 0x02, 0xa5, 0x69, 0x4f, 0x43, 0xf0, 0x7f,
+	0x00, 0x70, 0xa8, 0x26, 0x41, 0xd0, 0x6c, 0xe7, 0xea, 0xe4, 0x28, 0x26, 0xd6, 0x18, 0x11, 0x4b,
+	0xde, 0x85, 0x56, 0xcf, 0x3f, 0xe4, 0x6e, 0x4d, 0xea, 0x05, 0xb8, 0xe3, 0xf3, 0x60, 0xf6, 0x22,
+	0x40, 0xbf, 0x06, 0xb0, 0x3f, 0x86, 0xf3, 0x98, 0x62, 0xd4, 0x91, 0xd6, 0xf0, 0x7e, 0xa5, 0x45,
+	0x53, 0xc7, 0xf7, 0x74, 0x5a, 0xf2, 0xaf, 0x32, 0xd7, 0x16, 0x67, 0xe3, 0xdd, 0xbb, 0xe6, 0x60,
+	0x92, 0xd1, 0xf7, 0x08, 0x40, 0xcf, 0x42, 0x1e, 0xcb, 0x46, 0xf5, 0xc9, 0x46, 0x3e, 0xd2, 0xbf,
+	0x9b, 0xcc, 0x3f, 0x69, 0x76, 0xfd, 0x44, 0xfc, 0xb3, 0xbe, 0x05, 0xd8, 0x7b, 0x94, 0x44, 0x7f,
+	0x07, 0x5e, 0x1c, 0x88, 0xe0, 0xe5, 0x81, 0x08, 0x5e, 0x1d, 0x88, 0xc2, 0x9b, 0x03, 0x51, 0x78,
+	0x7b, 0x20, 0x0a, 0xef, 0x0e, 0x44, 0xe1, 0xfd, 0x81, 0x08, 0x1e, 0x98, 0x22, 0x78, 0x68, 0x8a,
+	0xc2, 0x23, 0x53, 0x04, 0x8f, 0x4d, 0x51, 0x78, 0x6a, 0x8a, 0xc2, 0x33, 0x53, 0x14, 0x5e, 0x98,
+	0x22, 0x78, 0x69, 0x8a, 0xe0, 0x95, 0x29, 0x0a, 0x6f, 0x4c, 0x11, 0xbc, 0x35, 0x45, 0xe1, 0x9d,
+	0x29, 0x82, 0xf7, 0xa6, 0x28, 0x3c, 0xa8, 0x88, 0xc2, 0xc3, 0x8a, 0x08, 0x7e, 0x59, 0x11, 0x85,
+	0xdf, 0x54, 0x44, 0xf0, 0xdb, 0x8a, 0x28, 0x3c, 0xaa, 0x88, 0xc2, 0xe3, 0x8a, 0x08, 0x9e, 0x56,
+	0x44, 0xf0, 0xac, 0x22, 0x82, 0x8d, 0x0b, 0x9d, 0x2a, 0x18, 0xaa, 0xea, 0xdb, 0xdb, 0xfd, 0x8c,
+	0x8e, 0x4b, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x15, 0x4d, 0x20, 0xa1, 0x16, 0x00, 0x00,
 }
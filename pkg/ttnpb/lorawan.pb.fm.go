@@ -1231,16 +1231,20 @@ func (dst *GatewayAntennaIdentifiers) SetFields(src *GatewayAntennaIdentifiers,
 }
 
 var UplinkTokenFieldPathsNested = []string{
+	"concentrator_time",
 	"ids",
 	"ids.antenna_index",
 	"ids.gateway_ids",
 	"ids.gateway_ids.eui",
 	"ids.gateway_ids.gateway_id",
+	"radio_context",
 	"timestamp",
 }
 
 var UplinkTokenFieldPathsTopLevel = []string{
+	"concentrator_time",
 	"ids",
+	"radio_context",
 	"timestamp",
 }
 
@@ -1275,6 +1279,26 @@ func (dst *UplinkToken) SetFields(src *UplinkToken, paths ...string) error {
 				var zero uint32
 				dst.Timestamp = zero
 			}
+		case "concentrator_time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'concentrator_time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ConcentratorTime = src.ConcentratorTime
+			} else {
+				var zero int64
+				dst.ConcentratorTime = zero
+			}
+		case "radio_context":
+			if len(subs) > 0 {
+				return fmt.Errorf("'radio_context' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RadioContext = src.RadioContext
+			} else {
+				var zero int64
+				dst.RadioContext = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
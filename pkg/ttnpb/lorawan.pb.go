@@ -73,7 +73,7 @@ var MType_value = map[string]int32{
 }
 
 func (MType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{0}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{0}
 }
 
 type Major int32
@@ -90,7 +90,7 @@ var Major_value = map[string]int32{
 }
 
 func (Major) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{1}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{1}
 }
 
 type MACVersion int32
@@ -119,7 +119,7 @@ var MACVersion_value = map[string]int32{
 }
 
 func (MACVersion) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{2}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{2}
 }
 
 type PHYVersion int32
@@ -154,7 +154,7 @@ var PHYVersion_value = map[string]int32{
 }
 
 func (PHYVersion) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{3}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{3}
 }
 
 type DataRateIndex int32
@@ -216,7 +216,7 @@ var DataRateIndex_value = map[string]int32{
 }
 
 func (DataRateIndex) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{4}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{4}
 }
 
 type RejoinType int32
@@ -239,7 +239,7 @@ var RejoinType_value = map[string]int32{
 }
 
 func (RejoinType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{5}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{5}
 }
 
 type CFListType int32
@@ -259,7 +259,7 @@ var CFListType_value = map[string]int32{
 }
 
 func (CFListType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{6}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{6}
 }
 
 type Class int32
@@ -282,7 +282,7 @@ var Class_value = map[string]int32{
 }
 
 func (Class) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{7}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{7}
 }
 
 type TxSchedulePriority int32
@@ -317,7 +317,7 @@ var TxSchedulePriority_value = map[string]int32{
 }
 
 func (TxSchedulePriority) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{8}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{8}
 }
 
 type MACCommandIdentifier int32
@@ -394,7 +394,7 @@ var MACCommandIdentifier_value = map[string]int32{
 }
 
 func (MACCommandIdentifier) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{9}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{9}
 }
 
 type AggregatedDutyCycle int32
@@ -456,7 +456,7 @@ var AggregatedDutyCycle_value = map[string]int32{
 }
 
 func (AggregatedDutyCycle) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{10}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{10}
 }
 
 type PingSlotPeriod int32
@@ -494,7 +494,7 @@ var PingSlotPeriod_value = map[string]int32{
 }
 
 func (PingSlotPeriod) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{11}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{11}
 }
 
 type RejoinCountExponent int32
@@ -556,7 +556,7 @@ var RejoinCountExponent_value = map[string]int32{
 }
 
 func (RejoinCountExponent) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{12}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{12}
 }
 
 type RejoinTimeExponent int32
@@ -618,7 +618,7 @@ var RejoinTimeExponent_value = map[string]int32{
 }
 
 func (RejoinTimeExponent) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{13}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{13}
 }
 
 type RejoinPeriodExponent int32
@@ -656,7 +656,7 @@ var RejoinPeriodExponent_value = map[string]int32{
 }
 
 func (RejoinPeriodExponent) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{14}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{14}
 }
 
 type DeviceEIRP int32
@@ -718,7 +718,7 @@ var DeviceEIRP_value = map[string]int32{
 }
 
 func (DeviceEIRP) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{15}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{15}
 }
 
 type ADRAckLimitExponent int32
@@ -780,7 +780,7 @@ var ADRAckLimitExponent_value = map[string]int32{
 }
 
 func (ADRAckLimitExponent) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{16}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{16}
 }
 
 type ADRAckDelayExponent int32
@@ -842,7 +842,7 @@ var ADRAckDelayExponent_value = map[string]int32{
 }
 
 func (ADRAckDelayExponent) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{17}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{17}
 }
 
 type RxDelay int32
@@ -904,7 +904,7 @@ var RxDelay_value = map[string]int32{
 }
 
 func (RxDelay) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18}
 }
 
 type Minor int32
@@ -966,7 +966,7 @@ var Minor_value = map[string]int32{
 }
 
 func (Minor) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{19}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{19}
 }
 
 type Message struct {
@@ -991,7 +991,7 @@ type Message struct {
 func (m *Message) Reset()      { *m = Message{} }
 func (*Message) ProtoMessage() {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{0}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{0}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1209,7 +1209,7 @@ type MHDR struct {
 func (m *MHDR) Reset()      { *m = MHDR{} }
 func (*MHDR) ProtoMessage() {}
 func (*MHDR) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{1}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{1}
 }
 func (m *MHDR) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1264,7 +1264,7 @@ type MACPayload struct {
 func (m *MACPayload) Reset()      { *m = MACPayload{} }
 func (*MACPayload) ProtoMessage() {}
 func (*MACPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{2}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{2}
 }
 func (m *MACPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1326,7 +1326,7 @@ type FHDR struct {
 func (m *FHDR) Reset()      { *m = FHDR{} }
 func (*FHDR) ProtoMessage() {}
 func (*FHDR) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{3}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{3}
 }
 func (m *FHDR) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1382,7 +1382,7 @@ type FCtrl struct {
 func (m *FCtrl) Reset()      { *m = FCtrl{} }
 func (*FCtrl) ProtoMessage() {}
 func (*FCtrl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{4}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{4}
 }
 func (m *FCtrl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1457,7 +1457,7 @@ type JoinRequestPayload struct {
 func (m *JoinRequestPayload) Reset()      { *m = JoinRequestPayload{} }
 func (*JoinRequestPayload) ProtoMessage() {}
 func (*JoinRequestPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{5}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{5}
 }
 func (m *JoinRequestPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1499,7 +1499,7 @@ type RejoinRequestPayload struct {
 func (m *RejoinRequestPayload) Reset()      { *m = RejoinRequestPayload{} }
 func (*RejoinRequestPayload) ProtoMessage() {}
 func (*RejoinRequestPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{6}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{6}
 }
 func (m *RejoinRequestPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1557,7 +1557,7 @@ type JoinAcceptPayload struct {
 func (m *JoinAcceptPayload) Reset()      { *m = JoinAcceptPayload{} }
 func (*JoinAcceptPayload) ProtoMessage() {}
 func (*JoinAcceptPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{7}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{7}
 }
 func (m *JoinAcceptPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1619,7 +1619,7 @@ type DLSettings struct {
 func (m *DLSettings) Reset()      { *m = DLSettings{} }
 func (*DLSettings) ProtoMessage() {}
 func (*DLSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{8}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{8}
 }
 func (m *DLSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1686,7 +1686,7 @@ type CFList struct {
 func (m *CFList) Reset()      { *m = CFList{} }
 func (*CFList) ProtoMessage() {}
 func (*CFList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{9}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{9}
 }
 func (m *CFList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1747,7 +1747,7 @@ type LoRaDataRate struct {
 func (m *LoRaDataRate) Reset()      { *m = LoRaDataRate{} }
 func (*LoRaDataRate) ProtoMessage() {}
 func (*LoRaDataRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{10}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{10}
 }
 func (m *LoRaDataRate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1800,7 +1800,7 @@ type FSKDataRate struct {
 func (m *FSKDataRate) Reset()      { *m = FSKDataRate{} }
 func (*FSKDataRate) ProtoMessage() {}
 func (*FSKDataRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{11}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{11}
 }
 func (m *FSKDataRate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1848,7 +1848,7 @@ type DataRate struct {
 func (m *DataRate) Reset()      { *m = DataRate{} }
 func (*DataRate) ProtoMessage() {}
 func (*DataRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{12}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{12}
 }
 func (m *DataRate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2026,7 +2026,7 @@ type TxSettings struct {
 func (m *TxSettings) Reset()      { *m = TxSettings{} }
 func (*TxSettings) ProtoMessage() {}
 func (*TxSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{13}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{13}
 }
 func (m *TxSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2142,7 +2142,7 @@ type GatewayAntennaIdentifiers struct {
 func (m *GatewayAntennaIdentifiers) Reset()      { *m = GatewayAntennaIdentifiers{} }
 func (*GatewayAntennaIdentifiers) ProtoMessage() {}
 func (*GatewayAntennaIdentifiers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{14}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{14}
 }
 func (m *GatewayAntennaIdentifiers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2180,15 +2180,21 @@ func (m *GatewayAntennaIdentifiers) GetAntennaIndex() uint32 {
 
 type UplinkToken struct {
 	GatewayAntennaIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
-	Timestamp                 uint32   `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
-	XXX_sizecache             int32    `json:"-"`
+	Timestamp                 uint32 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Concentrator time of the uplink message, including gateway specific session information in the upper bits.
+	// This is used by LoRa Basics Station (xtime).
+	ConcentratorTime int64 `protobuf:"varint,3,opt,name=concentrator_time,json=concentratorTime,proto3" json:"concentrator_time,omitempty"`
+	// Gateway specific radio context of the uplink message.
+	// This is used by LoRa Basics Station (rctx).
+	RadioContext         int64    `protobuf:"varint,4,opt,name=radio_context,json=radioContext,proto3" json:"radio_context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UplinkToken) Reset()      { *m = UplinkToken{} }
 func (*UplinkToken) ProtoMessage() {}
 func (*UplinkToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{15}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{15}
 }
 func (m *UplinkToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2224,6 +2230,20 @@ func (m *UplinkToken) GetTimestamp() uint32 {
 	return 0
 }
 
+func (m *UplinkToken) GetConcentratorTime() int64 {
+	if m != nil {
+		return m.ConcentratorTime
+	}
+	return 0
+}
+
+func (m *UplinkToken) GetRadioContext() int64 {
+	if m != nil {
+		return m.RadioContext
+	}
+	return 0
+}
+
 type DownlinkPath struct {
 	// Set uplink token for class A, B or C downlink to the uplink token received from the corresponding RxMetadata. Uplink tokens are opaque to the Network Server.
 	// Set fixed to force using the specified gateway antenna identifiers for downlink. This can only be used for class B or C downlinks.
@@ -2239,7 +2259,7 @@ type DownlinkPath struct {
 func (m *DownlinkPath) Reset()      { *m = DownlinkPath{} }
 func (*DownlinkPath) ProtoMessage() {}
 func (*DownlinkPath) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{16}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{16}
 }
 func (m *DownlinkPath) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2401,7 +2421,8 @@ type TxRequest struct {
 	// A priority of HIGH or higher sets the HiPriorityFlag in the DLMetadata Object.
 	Priority TxSchedulePriority `protobuf:"varint,8,opt,name=priority,proto3,enum=ttn.lorawan.v3.TxSchedulePriority" json:"priority,omitempty"`
 	// Time when the downlink message should be transmitted.
-	// This value is only valid for class C downlink; class A downlink uses uplink tokens and class B downlink is scheduled on ping slots.
+	// This value is only valid for class B and C downlink; class A downlink uses uplink tokens.
+	// For class B downlink, this is the start of the ping slot in which the downlink message should be transmitted.
 	// This requires the gateway to have GPS time sychronization.
 	// If the absolute time is not set, the first available time will be used that does not conflict or violate regional limitations.
 	AbsoluteTime *time.Time `protobuf:"bytes,9,opt,name=absolute_time,json=absoluteTime,proto3,stdtime" json:"absolute_time,omitempty"`
@@ -2416,7 +2437,7 @@ type TxRequest struct {
 func (m *TxRequest) Reset()      { *m = TxRequest{} }
 func (*TxRequest) ProtoMessage() {}
 func (*TxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{17}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{17}
 }
 func (m *TxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2557,7 +2578,7 @@ type MACCommand struct {
 func (m *MACCommand) Reset()      { *m = MACCommand{} }
 func (*MACCommand) ProtoMessage() {}
 func (*MACCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18}
 }
 func (m *MACCommand) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3580,7 +3601,7 @@ type MACCommand_ResetInd struct {
 func (m *MACCommand_ResetInd) Reset()      { *m = MACCommand_ResetInd{} }
 func (*MACCommand_ResetInd) ProtoMessage() {}
 func (*MACCommand_ResetInd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 0}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 0}
 }
 func (m *MACCommand_ResetInd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3625,7 +3646,7 @@ type MACCommand_ResetConf struct {
 func (m *MACCommand_ResetConf) Reset()      { *m = MACCommand_ResetConf{} }
 func (*MACCommand_ResetConf) ProtoMessage() {}
 func (*MACCommand_ResetConf) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 1}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 1}
 }
 func (m *MACCommand_ResetConf) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3672,7 +3693,7 @@ type MACCommand_LinkCheckAns struct {
 func (m *MACCommand_LinkCheckAns) Reset()      { *m = MACCommand_LinkCheckAns{} }
 func (*MACCommand_LinkCheckAns) ProtoMessage() {}
 func (*MACCommand_LinkCheckAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 2}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 2}
 }
 func (m *MACCommand_LinkCheckAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3728,7 +3749,7 @@ type MACCommand_LinkADRReq struct {
 func (m *MACCommand_LinkADRReq) Reset()      { *m = MACCommand_LinkADRReq{} }
 func (*MACCommand_LinkADRReq) ProtoMessage() {}
 func (*MACCommand_LinkADRReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 3}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 3}
 }
 func (m *MACCommand_LinkADRReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3803,7 +3824,7 @@ type MACCommand_LinkADRAns struct {
 func (m *MACCommand_LinkADRAns) Reset()      { *m = MACCommand_LinkADRAns{} }
 func (*MACCommand_LinkADRAns) ProtoMessage() {}
 func (*MACCommand_LinkADRAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 4}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 4}
 }
 func (m *MACCommand_LinkADRAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3862,7 +3883,7 @@ type MACCommand_DutyCycleReq struct {
 func (m *MACCommand_DutyCycleReq) Reset()      { *m = MACCommand_DutyCycleReq{} }
 func (*MACCommand_DutyCycleReq) ProtoMessage() {}
 func (*MACCommand_DutyCycleReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 5}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 5}
 }
 func (m *MACCommand_DutyCycleReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3909,7 +3930,7 @@ type MACCommand_RxParamSetupReq struct {
 func (m *MACCommand_RxParamSetupReq) Reset()      { *m = MACCommand_RxParamSetupReq{} }
 func (*MACCommand_RxParamSetupReq) ProtoMessage() {}
 func (*MACCommand_RxParamSetupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 6}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 6}
 }
 func (m *MACCommand_RxParamSetupReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3970,7 +3991,7 @@ type MACCommand_RxParamSetupAns struct {
 func (m *MACCommand_RxParamSetupAns) Reset()      { *m = MACCommand_RxParamSetupAns{} }
 func (*MACCommand_RxParamSetupAns) ProtoMessage() {}
 func (*MACCommand_RxParamSetupAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 7}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 7}
 }
 func (m *MACCommand_RxParamSetupAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4035,7 +4056,7 @@ type MACCommand_DevStatusAns struct {
 func (m *MACCommand_DevStatusAns) Reset()      { *m = MACCommand_DevStatusAns{} }
 func (*MACCommand_DevStatusAns) ProtoMessage() {}
 func (*MACCommand_DevStatusAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 8}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 8}
 }
 func (m *MACCommand_DevStatusAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4090,7 +4111,7 @@ type MACCommand_NewChannelReq struct {
 func (m *MACCommand_NewChannelReq) Reset()      { *m = MACCommand_NewChannelReq{} }
 func (*MACCommand_NewChannelReq) ProtoMessage() {}
 func (*MACCommand_NewChannelReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 9}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 9}
 }
 func (m *MACCommand_NewChannelReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4157,7 +4178,7 @@ type MACCommand_NewChannelAns struct {
 func (m *MACCommand_NewChannelAns) Reset()      { *m = MACCommand_NewChannelAns{} }
 func (*MACCommand_NewChannelAns) ProtoMessage() {}
 func (*MACCommand_NewChannelAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 10}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 10}
 }
 func (m *MACCommand_NewChannelAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4210,7 +4231,7 @@ type MACCommand_DLChannelReq struct {
 func (m *MACCommand_DLChannelReq) Reset()      { *m = MACCommand_DLChannelReq{} }
 func (*MACCommand_DLChannelReq) ProtoMessage() {}
 func (*MACCommand_DLChannelReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 11}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 11}
 }
 func (m *MACCommand_DLChannelReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4263,7 +4284,7 @@ type MACCommand_DLChannelAns struct {
 func (m *MACCommand_DLChannelAns) Reset()      { *m = MACCommand_DLChannelAns{} }
 func (*MACCommand_DLChannelAns) ProtoMessage() {}
 func (*MACCommand_DLChannelAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 12}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 12}
 }
 func (m *MACCommand_DLChannelAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4315,7 +4336,7 @@ type MACCommand_RxTimingSetupReq struct {
 func (m *MACCommand_RxTimingSetupReq) Reset()      { *m = MACCommand_RxTimingSetupReq{} }
 func (*MACCommand_RxTimingSetupReq) ProtoMessage() {}
 func (*MACCommand_RxTimingSetupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 13}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 13}
 }
 func (m *MACCommand_RxTimingSetupReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4364,7 +4385,7 @@ type MACCommand_TxParamSetupReq struct {
 func (m *MACCommand_TxParamSetupReq) Reset()      { *m = MACCommand_TxParamSetupReq{} }
 func (*MACCommand_TxParamSetupReq) ProtoMessage() {}
 func (*MACCommand_TxParamSetupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 14}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 14}
 }
 func (m *MACCommand_TxParamSetupReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4423,7 +4444,7 @@ type MACCommand_RekeyInd struct {
 func (m *MACCommand_RekeyInd) Reset()      { *m = MACCommand_RekeyInd{} }
 func (*MACCommand_RekeyInd) ProtoMessage() {}
 func (*MACCommand_RekeyInd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 15}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 15}
 }
 func (m *MACCommand_RekeyInd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4468,7 +4489,7 @@ type MACCommand_RekeyConf struct {
 func (m *MACCommand_RekeyConf) Reset()      { *m = MACCommand_RekeyConf{} }
 func (*MACCommand_RekeyConf) ProtoMessage() {}
 func (*MACCommand_RekeyConf) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 16}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 16}
 }
 func (m *MACCommand_RekeyConf) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4516,7 +4537,7 @@ type MACCommand_ADRParamSetupReq struct {
 func (m *MACCommand_ADRParamSetupReq) Reset()      { *m = MACCommand_ADRParamSetupReq{} }
 func (*MACCommand_ADRParamSetupReq) ProtoMessage() {}
 func (*MACCommand_ADRParamSetupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 17}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 17}
 }
 func (m *MACCommand_ADRParamSetupReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4568,7 +4589,7 @@ type MACCommand_DeviceTimeAns struct {
 func (m *MACCommand_DeviceTimeAns) Reset()      { *m = MACCommand_DeviceTimeAns{} }
 func (*MACCommand_DeviceTimeAns) ProtoMessage() {}
 func (*MACCommand_DeviceTimeAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 18}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 18}
 }
 func (m *MACCommand_DeviceTimeAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4617,7 +4638,7 @@ type MACCommand_ForceRejoinReq struct {
 func (m *MACCommand_ForceRejoinReq) Reset()      { *m = MACCommand_ForceRejoinReq{} }
 func (*MACCommand_ForceRejoinReq) ProtoMessage() {}
 func (*MACCommand_ForceRejoinReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 19}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 19}
 }
 func (m *MACCommand_ForceRejoinReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4686,7 +4707,7 @@ type MACCommand_RejoinParamSetupReq struct {
 func (m *MACCommand_RejoinParamSetupReq) Reset()      { *m = MACCommand_RejoinParamSetupReq{} }
 func (*MACCommand_RejoinParamSetupReq) ProtoMessage() {}
 func (*MACCommand_RejoinParamSetupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 20}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 20}
 }
 func (m *MACCommand_RejoinParamSetupReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4738,7 +4759,7 @@ type MACCommand_RejoinParamSetupAns struct {
 func (m *MACCommand_RejoinParamSetupAns) Reset()      { *m = MACCommand_RejoinParamSetupAns{} }
 func (*MACCommand_RejoinParamSetupAns) ProtoMessage() {}
 func (*MACCommand_RejoinParamSetupAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 21}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 21}
 }
 func (m *MACCommand_RejoinParamSetupAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4783,7 +4804,7 @@ type MACCommand_PingSlotInfoReq struct {
 func (m *MACCommand_PingSlotInfoReq) Reset()      { *m = MACCommand_PingSlotInfoReq{} }
 func (*MACCommand_PingSlotInfoReq) ProtoMessage() {}
 func (*MACCommand_PingSlotInfoReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 22}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 22}
 }
 func (m *MACCommand_PingSlotInfoReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4829,7 +4850,7 @@ type MACCommand_PingSlotChannelReq struct {
 func (m *MACCommand_PingSlotChannelReq) Reset()      { *m = MACCommand_PingSlotChannelReq{} }
 func (*MACCommand_PingSlotChannelReq) ProtoMessage() {}
 func (*MACCommand_PingSlotChannelReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 23}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 23}
 }
 func (m *MACCommand_PingSlotChannelReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4882,7 +4903,7 @@ type MACCommand_PingSlotChannelAns struct {
 func (m *MACCommand_PingSlotChannelAns) Reset()      { *m = MACCommand_PingSlotChannelAns{} }
 func (*MACCommand_PingSlotChannelAns) ProtoMessage() {}
 func (*MACCommand_PingSlotChannelAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 24}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 24}
 }
 func (m *MACCommand_PingSlotChannelAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4935,7 +4956,7 @@ type MACCommand_BeaconTimingAns struct {
 func (m *MACCommand_BeaconTimingAns) Reset()      { *m = MACCommand_BeaconTimingAns{} }
 func (*MACCommand_BeaconTimingAns) ProtoMessage() {}
 func (*MACCommand_BeaconTimingAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 25}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 25}
 }
 func (m *MACCommand_BeaconTimingAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4987,7 +5008,7 @@ type MACCommand_BeaconFreqReq struct {
 func (m *MACCommand_BeaconFreqReq) Reset()      { *m = MACCommand_BeaconFreqReq{} }
 func (*MACCommand_BeaconFreqReq) ProtoMessage() {}
 func (*MACCommand_BeaconFreqReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 26}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 26}
 }
 func (m *MACCommand_BeaconFreqReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5032,7 +5053,7 @@ type MACCommand_BeaconFreqAns struct {
 func (m *MACCommand_BeaconFreqAns) Reset()      { *m = MACCommand_BeaconFreqAns{} }
 func (*MACCommand_BeaconFreqAns) ProtoMessage() {}
 func (*MACCommand_BeaconFreqAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 27}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 27}
 }
 func (m *MACCommand_BeaconFreqAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5077,7 +5098,7 @@ type MACCommand_DeviceModeInd struct {
 func (m *MACCommand_DeviceModeInd) Reset()      { *m = MACCommand_DeviceModeInd{} }
 func (*MACCommand_DeviceModeInd) ProtoMessage() {}
 func (*MACCommand_DeviceModeInd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 28}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 28}
 }
 func (m *MACCommand_DeviceModeInd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5122,7 +5143,7 @@ type MACCommand_DeviceModeConf struct {
 func (m *MACCommand_DeviceModeConf) Reset()      { *m = MACCommand_DeviceModeConf{} }
 func (*MACCommand_DeviceModeConf) ProtoMessage() {}
 func (*MACCommand_DeviceModeConf) Descriptor() ([]byte, []int) {
-	return fileDescriptor_lorawan_76222860124625e1, []int{18, 29}
+	return fileDescriptor_lorawan_513af5d34a6bab39, []int{18, 29}
 }
 func (m *MACCommand_DeviceModeConf) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6095,6 +6116,12 @@ func (this *UplinkToken) Equal(that interface{}) bool {
 	if this.Timestamp != that1.Timestamp {
 		return false
 	}
+	if this.ConcentratorTime != that1.ConcentratorTime {
+		return false
+	}
+	if this.RadioContext != that1.RadioContext {
+		return false
+	}
 	return true
 }
 func (this *DownlinkPath) Equal(that interface{}) bool {
@@ -8615,6 +8642,16 @@ func (m *UplinkToken) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintLorawan(dAtA, i, uint64(m.Timestamp))
 	}
+	if m.ConcentratorTime != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintLorawan(dAtA, i, uint64(m.ConcentratorTime))
+	}
+	if m.RadioContext != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintLorawan(dAtA, i, uint64(m.RadioContext))
+	}
 	return i, nil
 }
 
@@ -10255,6 +10292,14 @@ func NewPopulatedUplinkToken(r randyLorawan, easy bool) *UplinkToken {
 	v2 := NewPopulatedGatewayAntennaIdentifiers(r, easy)
 	this.GatewayAntennaIdentifiers = *v2
 	this.Timestamp = r.Uint32()
+	this.ConcentratorTime = r.Int63()
+	if r.Intn(2) == 0 {
+		this.ConcentratorTime *= -1
+	}
+	this.RadioContext = r.Int63()
+	if r.Intn(2) == 0 {
+		this.RadioContext *= -1
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -11250,6 +11295,12 @@ func (m *UplinkToken) Size() (n int) {
 	if m.Timestamp != 0 {
 		n += 1 + sovLorawan(uint64(m.Timestamp))
 	}
+	if m.ConcentratorTime != 0 {
+		n += 1 + sovLorawan(uint64(m.ConcentratorTime))
+	}
+	if m.RadioContext != 0 {
+		n += 1 + sovLorawan(uint64(m.RadioContext))
+	}
 	return n
 }
 
@@ -12434,6 +12485,8 @@ func (this *UplinkToken) String() string {
 	s := strings.Join([]string{`&UplinkToken{`,
 		`GatewayAntennaIdentifiers:` + strings.Replace(strings.Replace(this.GatewayAntennaIdentifiers.String(), "GatewayAntennaIdentifiers", "GatewayAntennaIdentifiers", 1), `&`, ``, 1) + `,`,
 		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`ConcentratorTime:` + fmt.Sprintf("%v", this.ConcentratorTime) + `,`,
+		`RadioContext:` + fmt.Sprintf("%v", this.RadioContext) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15576,6 +15629,44 @@ func (m *UplinkToken) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratorTime", wireType)
+			}
+			m.ConcentratorTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConcentratorTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RadioContext", wireType)
+			}
+			m.RadioContext = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RadioContext |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -19831,337 +19922,340 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("lorawan-stack/api/lorawan.proto", fileDescriptor_lorawan_76222860124625e1)
+	proto.RegisterFile("lorawan-stack/api/lorawan.proto", fileDescriptor_lorawan_513af5d34a6bab39)
 }
 func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/lorawan.proto", fileDescriptor_lorawan_76222860124625e1)
-}
-
-var fileDescriptor_lorawan_76222860124625e1 = []byte{
-	// 5196 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x7b, 0x4d, 0x6c, 0x23, 0xc9,
-	0x75, 0x3f, 0x5b, 0x24, 0x45, 0xea, 0x91, 0x14, 0x7b, 0x4a, 0x9a, 0x19, 0x0d, 0xbd, 0x4b, 0xad,
-	0x35, 0xfb, 0xc7, 0x7f, 0x3c, 0xf6, 0x68, 0x24, 0x4a, 0xa3, 0xd5, 0x7a, 0xfd, 0xc5, 0x2f, 0xad,
-	0xb8, 0x23, 0x91, 0x72, 0x53, 0x9a, 0xd9, 0x31, 0x6c, 0x74, 0x5a, 0xec, 0xa6, 0xc4, 0x11, 0xd9,
-	0xcd, 0x6d, 0xb6, 0x46, 0x52, 0x0e, 0xc1, 0x02, 0xb9, 0x2c, 0x90, 0x43, 0x8c, 0x00, 0x06, 0x62,
-	0xe4, 0x60, 0x23, 0xc9, 0xc1, 0x40, 0x10, 0xc4, 0x40, 0x10, 0x64, 0x8f, 0x0e, 0x90, 0x83, 0x91,
-	0xd3, 0x06, 0xb9, 0x18, 0x0e, 0x22, 0x7b, 0x38, 0x17, 0xe7, 0x92, 0xf8, 0xb8, 0xc7, 0xe0, 0x55,
-	0x55, 0xb3, 0xab, 0xbb, 0x39, 0x92, 0x66, 0x77, 0x7d, 0x52, 0xd7, 0xaf, 0x5f, 0xbd, 0x7a, 0xf5,
-	0x3e, 0xeb, 0x55, 0x53, 0x30, 0xdf, 0xb5, 0x6c, 0xed, 0x44, 0x33, 0xef, 0x0d, 0x1c, 0xad, 0x75,
-	0x74, 0x5f, 0xeb, 0x77, 0xee, 0x73, 0x64, 0xb1, 0x6f, 0x5b, 0x8e, 0x45, 0xa6, 0x1d, 0xc7, 0x5c,
-	0x74, 0xa1, 0x67, 0x2b, 0xb9, 0x7b, 0x07, 0x1d, 0xe7, 0xf0, 0x78, 0x7f, 0xb1, 0x65, 0xf5, 0xee,
-	0x1f, 0x58, 0x07, 0xd6, 0x7d, 0x4a, 0xb6, 0x7f, 0xdc, 0xa6, 0x23, 0x3a, 0xa0, 0x4f, 0x6c, 0x7a,
-	0x6e, 0x4d, 0x20, 0xef, 0x9d, 0x74, 0x9c, 0x23, 0xeb, 0xe4, 0xfe, 0x81, 0x75, 0x8f, 0xbe, 0xbc,
-	0xf7, 0x4c, 0xeb, 0x76, 0x74, 0xcd, 0xb1, 0xec, 0xc1, 0xfd, 0xd1, 0x23, 0x9f, 0xf7, 0xda, 0x81,
-	0x65, 0x1d, 0x74, 0x0d, 0x8f, 0xfb, 0xc0, 0xb1, 0x8f, 0x5b, 0x0e, 0x7f, 0x3b, 0x1f, 0x7c, 0xeb,
-	0x74, 0x7a, 0xc6, 0xc0, 0xd1, 0x7a, 0x7d, 0x4e, 0x70, 0x3b, 0xbc, 0xad, 0x8e, 0x6e, 0x98, 0x4e,
-	0xa7, 0xdd, 0x31, 0xec, 0x01, 0x23, 0x5a, 0xf8, 0xb7, 0x28, 0x24, 0xb6, 0x8d, 0xc1, 0x40, 0x3b,
-	0x30, 0xc8, 0x0a, 0xc4, 0x7b, 0xea, 0xa1, 0x6e, 0xcf, 0x49, 0x6f, 0x48, 0x77, 0x52, 0x85, 0xd9,
-	0x45, 0xff, 0xb6, 0x17, 0xb7, 0x37, 0x2b, 0x4a, 0x29, 0xf9, 0xcb, 0xf3, 0xf9, 0xc8, 0x27, 0xe7,
-	0xf3, 0x92, 0x12, 0xeb, 0x6d, 0xea, 0x36, 0xb9, 0x05, 0xd1, 0x5e, 0xa7, 0x35, 0x37, 0xf1, 0x86,
-	0x74, 0x27, 0x5d, 0x4a, 0x0c, 0xcf, 0xe7, 0xa3, 0xdb, 0xb5, 0xb2, 0x82, 0x18, 0xd9, 0x86, 0x54,
-	0x4f, 0x6b, 0xa9, 0x7d, 0xed, 0xac, 0x6b, 0x69, 0xfa, 0x5c, 0x94, 0x72, 0xcd, 0x85, 0xb8, 0x16,
-	0xcb, 0x3b, 0x8c, 0xa2, 0x34, 0x3d, 0x3c, 0x9f, 0x07, 0x6f, 0xbc, 0x19, 0x51, 0xa0, 0xa7, 0xb5,
-	0xf8, 0x88, 0x3c, 0x82, 0xd9, 0xa7, 0x56, 0xc7, 0x54, 0x6d, 0xe3, 0x83, 0x63, 0x63, 0xe0, 0x8c,
-	0xf8, 0xc6, 0x28, 0xdf, 0x85, 0x20, 0xdf, 0xf7, 0xac, 0x8e, 0xa9, 0x30, 0x52, 0x8f, 0x1f, 0x79,
-	0x1a, 0x42, 0x49, 0x13, 0x66, 0x28, 0x5f, 0xad, 0xd5, 0x32, 0xfa, 0x1e, 0xdb, 0x38, 0x65, 0xfb,
-	0xe5, 0x71, 0x6c, 0x8b, 0x94, 0xd2, 0xe3, 0x7a, 0xed, 0x69, 0x10, 0x24, 0xdf, 0x87, 0x1b, 0xb6,
-	0x31, 0x56, 0xdc, 0x49, 0xca, 0xf7, 0xcd, 0x20, 0x5f, 0xc5, 0x78, 0x3a, 0x4e, 0xe0, 0x59, 0x7b,
-	0x0c, 0xfe, 0xf5, 0xd8, 0xc7, 0x3f, 0x9d, 0x8f, 0x94, 0xa6, 0x20, 0xc1, 0x81, 0xf7, 0x62, 0xc9,
-	0x84, 0x9c, 0x5c, 0xd0, 0x20, 0x86, 0x36, 0x22, 0x5f, 0x83, 0xc9, 0x9e, 0xea, 0x9c, 0xf5, 0x0d,
-	0x6a, 0xc9, 0xe9, 0xc2, 0xf5, 0x90, 0xce, 0x77, 0xcf, 0xfa, 0x86, 0x12, 0xef, 0xe1, 0x1f, 0xf2,
-	0x55, 0x88, 0xf7, 0xb4, 0xa7, 0x96, 0x4d, 0x6d, 0x38, 0x8e, 0x18, 0x5f, 0x2a, 0x8c, 0x66, 0xe1,
-	0xd7, 0x12, 0x08, 0x16, 0x42, 0x97, 0x69, 0x5f, 0xe4, 0x32, 0x1b, 0x01, 0x97, 0x69, 0xa3, 0xcb,
-	0xe4, 0x61, 0xb2, 0xad, 0xf6, 0x2d, 0xdb, 0xa1, 0x2b, 0x66, 0x4a, 0x89, 0xe1, 0x6f, 0xe6, 0xa3,
-	0x73, 0x1f, 0x4e, 0x28, 0xf1, 0xf6, 0x8e, 0x65, 0x3b, 0xe4, 0x3e, 0xa4, 0xda, 0x76, 0xcf, 0xe7,
-	0x37, 0x69, 0xe6, 0x1b, 0x1b, 0xca, 0x36, 0x5f, 0x59, 0x81, 0xb6, 0xdd, 0x73, 0xa5, 0xf8, 0x0e,
-	0x64, 0x75, 0xa3, 0x65, 0xe9, 0x86, 0x1e, 0x70, 0x8a, 0x9b, 0x8b, 0x2c, 0x48, 0x16, 0xdd, 0x20,
-	0x59, 0x6c, 0xd2, 0x10, 0x52, 0xa6, 0x39, 0xbd, 0x4f, 0xa1, 0x0b, 0xff, 0x21, 0x41, 0x0c, 0x25,
-	0x26, 0x8f, 0x21, 0xa9, 0x1b, 0xcf, 0x54, 0x4d, 0xe7, 0x3b, 0x4b, 0x97, 0xbe, 0x81, 0x7b, 0xf8,
-	0xf5, 0xf9, 0xfc, 0xea, 0x81, 0xb5, 0xe8, 0x1c, 0x1a, 0xce, 0x61, 0xc7, 0x3c, 0x18, 0x2c, 0x9a,
-	0x86, 0x73, 0x62, 0xd9, 0x47, 0xf7, 0xfd, 0x91, 0xd6, 0x3f, 0x3a, 0xb8, 0x8f, 0xda, 0x1f, 0x2c,
-	0x56, 0x8c, 0x67, 0x45, 0x5d, 0xb7, 0x95, 0x84, 0xce, 0x1e, 0xc8, 0x1a, 0x6e, 0xbd, 0xe5, 0xd8,
-	0x5d, 0xba, 0xf5, 0x54, 0x58, 0xd9, 0x1b, 0x65, 0xc7, 0xee, 0x0a, 0x1a, 0x8b, 0xb7, 0x11, 0x20,
-	0xaf, 0xa3, 0x9e, 0x5b, 0xa6, 0x43, 0x95, 0x91, 0x29, 0x25, 0x87, 0xbf, 0x99, 0x8f, 0xcd, 0x7d,
-	0xf8, 0x61, 0x4c, 0x89, 0xb5, 0xcb, 0xa6, 0x43, 0xae, 0x23, 0x5b, 0xab, 0xef, 0x0c, 0xe8, 0xbe,
-	0xd3, 0x4a, 0xbc, 0xdd, 0xe8, 0x3b, 0x03, 0xbe, 0xab, 0x1f, 0x4b, 0x10, 0xa7, 0x6c, 0x31, 0x56,
-	0x35, 0xbe, 0xa3, 0x24, 0x8b, 0xd5, 0x62, 0x45, 0x51, 0x10, 0x23, 0xf7, 0x20, 0xa5, 0xe9, 0xb6,
-	0xaa, 0xb5, 0x8e, 0xd0, 0x61, 0xa9, 0x74, 0xc9, 0x52, 0x66, 0x78, 0x3e, 0x3f, 0x55, 0xac, 0x28,
-	0xc5, 0xd6, 0x91, 0x62, 0x7c, 0xa0, 0x4c, 0x69, 0xba, 0xcd, 0x1e, 0x89, 0x0c, 0x51, 0xad, 0x75,
-	0x44, 0xa5, 0x49, 0x2a, 0xf8, 0x48, 0xbe, 0x04, 0x53, 0x6d, 0xb5, 0x6f, 0x98, 0x7a, 0xc7, 0x3c,
-	0xa0, 0x52, 0x24, 0x95, 0x64, 0x7b, 0x87, 0x8d, 0xc9, 0x4d, 0x48, 0xb4, 0xba, 0xda, 0x60, 0xa0,
-	0xee, 0xd3, 0xb0, 0x4a, 0x2a, 0x93, 0x74, 0x58, 0x5a, 0xf8, 0xe7, 0x09, 0x20, 0xe1, 0x40, 0x25,
-	0x7f, 0x04, 0x49, 0x1a, 0x3b, 0xc6, 0x71, 0x87, 0xeb, 0xbf, 0xca, 0xf5, 0x5f, 0x78, 0x25, 0xfd,
-	0x57, 0xf7, 0x6a, 0x6b, 0xab, 0xc3, 0xf3, 0xf9, 0x04, 0xae, 0x51, 0xdd, 0xab, 0x29, 0x09, 0x64,
-	0x5b, 0x3d, 0xee, 0x90, 0x1f, 0x00, 0xda, 0x84, 0x2e, 0xc0, 0x52, 0x57, 0xe5, 0x73, 0x2d, 0x30,
-	0x59, 0x31, 0x9e, 0x21, 0xff, 0x49, 0xdd, 0x78, 0x86, 0xec, 0xbf, 0x07, 0x53, 0xc8, 0xde, 0xb4,
-	0xcc, 0x96, 0xc1, 0x1d, 0xf8, 0x9b, 0x7c, 0x81, 0x07, 0xaf, 0xea, 0x41, 0x75, 0x64, 0xa2, 0xa0,
-	0x43, 0xd2, 0x27, 0x6e, 0xd5, 0x1f, 0x45, 0x61, 0x76, 0x5c, 0xce, 0x20, 0xef, 0x40, 0x8a, 0x67,
-	0x1e, 0x21, 0x03, 0xe4, 0xc6, 0xa7, 0x1b, 0x9a, 0x06, 0xc0, 0x1e, 0x3d, 0x93, 0xef, 0xc1, 0xa4,
-	0x69, 0x38, 0x6a, 0x47, 0xe7, 0x5a, 0x29, 0x7f, 0x26, 0xad, 0xd4, 0x0d, 0xa7, 0x56, 0x19, 0x9e,
-	0xcf, 0xc7, 0xe9, 0x83, 0x12, 0x37, 0x0d, 0xa7, 0xe6, 0x37, 0x6a, 0xf4, 0x0f, 0x6d, 0xd4, 0xd8,
-	0x1f, 0xc0, 0xa8, 0xaf, 0x03, 0x57, 0x15, 0x8d, 0x44, 0x74, 0xe4, 0x8c, 0x32, 0xc5, 0x90, 0xb2,
-	0xe9, 0x70, 0xbb, 0xfc, 0x69, 0x0c, 0xae, 0x85, 0x6a, 0x04, 0x79, 0x0d, 0xa6, 0x0c, 0xb3, 0x65,
-	0x9f, 0xf5, 0x1d, 0x43, 0x67, 0x1e, 0xad, 0x78, 0x00, 0xf9, 0x01, 0x00, 0x65, 0xcb, 0xdc, 0x85,
-	0x69, 0xfe, 0x5b, 0x5c, 0xf4, 0xb5, 0x57, 0x12, 0x1d, 0x57, 0x66, 0xfe, 0x32, 0xf5, 0xd4, 0x7d,
-	0x14, 0x8c, 0x1a, 0xfd, 0xc2, 0x8d, 0x2a, 0x66, 0xca, 0xd8, 0x17, 0x99, 0x29, 0xab, 0x90, 0xd2,
-	0xbb, 0xea, 0xc0, 0x70, 0x1c, 0x9c, 0xcf, 0xab, 0x71, 0xc8, 0x8d, 0x2b, 0x5b, 0x4d, 0x4e, 0x21,
-	0xe4, 0x4c, 0xd0, 0xbb, 0x2e, 0x4a, 0x0a, 0x90, 0xb4, 0x4f, 0x55, 0xdd, 0xe8, 0x6a, 0x67, 0xb4,
-	0xf2, 0x4e, 0x17, 0x6e, 0x86, 0x42, 0xe1, 0xb4, 0x82, 0xaf, 0x95, 0x84, 0xcd, 0x1e, 0xc8, 0x3b,
-	0x90, 0x68, 0xb5, 0xd5, 0x6e, 0x67, 0xe0, 0xcc, 0x25, 0xe8, 0xb2, 0x37, 0x82, 0x53, 0xca, 0x1b,
-	0x5b, 0x9d, 0x81, 0x53, 0x02, 0x74, 0x12, 0xf6, 0xac, 0x4c, 0xb6, 0xda, 0xf8, 0x97, 0x7b, 0xc1,
-	0xdf, 0x4b, 0x00, 0x9e, 0x6c, 0x64, 0x05, 0x32, 0xf6, 0xe9, 0xb2, 0xaa, 0xdb, 0xaa, 0xd5, 0x6e,
-	0x0f, 0x0c, 0x87, 0xba, 0x40, 0xa6, 0x94, 0x1d, 0x9e, 0xcf, 0xa7, 0x94, 0xd3, 0xe5, 0x8a, 0xd2,
-	0xa0, 0xb0, 0x92, 0xb2, 0x4f, 0x97, 0x2b, 0x36, 0x1b, 0x90, 0x6f, 0xc3, 0xa4, 0x7d, 0x5a, 0x50,
-	0x75, 0xb7, 0x30, 0xbf, 0x1e, 0xda, 0xbc, 0xe6, 0x68, 0x8a, 0xe6, 0x18, 0x35, 0x53, 0x37, 0x4e,
-	0x4b, 0x53, 0x68, 0x1b, 0xe5, 0xb4, 0x50, 0x51, 0x94, 0xb8, 0x7d, 0x5a, 0xa8, 0xd8, 0xe4, 0x36,
-	0x24, 0xac, 0xbe, 0xa3, 0x9a, 0xc6, 0x01, 0x4b, 0xd4, 0x4c, 0xde, 0x46, 0xdf, 0xa9, 0x1b, 0x07,
-	0xca, 0xa4, 0x45, 0xff, 0x72, 0x79, 0x7b, 0xc0, 0xf7, 0x41, 0x16, 0x21, 0x76, 0x51, 0xde, 0x60,
-	0x54, 0x34, 0x6f, 0x50, 0x3a, 0x42, 0x20, 0xd6, 0x66, 0x15, 0x23, 0x7a, 0x27, 0xa3, 0xd0, 0x67,
-	0x72, 0x0b, 0x92, 0xad, 0x43, 0xb5, 0xa7, 0x0d, 0x8e, 0x06, 0x73, 0xd1, 0x37, 0xa2, 0x77, 0x92,
-	0x4a, 0xa2, 0x75, 0xb8, 0x8d, 0x43, 0xbe, 0xdc, 0x63, 0x48, 0x6f, 0x59, 0x8a, 0xe6, 0x6e, 0x00,
-	0xc3, 0x63, 0x5f, 0x33, 0xf5, 0x93, 0x8e, 0xee, 0x1c, 0x32, 0xdd, 0x28, 0x1e, 0x40, 0xbe, 0x02,
-	0xf2, 0xa0, 0x6f, 0x1b, 0x1a, 0x96, 0x12, 0xb5, 0xad, 0xb5, 0x1c, 0x7e, 0x56, 0xc9, 0x28, 0xd9,
-	0x11, 0xbe, 0x41, 0xe1, 0x85, 0x3b, 0x90, 0xda, 0x68, 0x3e, 0x1c, 0xf1, 0xbd, 0x05, 0xc9, 0xfd,
-	0x8e, 0xa3, 0xda, 0x9a, 0x63, 0x70, 0xb6, 0x89, 0xfd, 0x8e, 0x83, 0xaf, 0x16, 0x7e, 0x28, 0x41,
-	0x72, 0x44, 0xf7, 0x0d, 0x88, 0xe1, 0x1e, 0xf9, 0x29, 0xe6, 0xb5, 0xe0, 0xa6, 0x45, 0x59, 0x4b,
-	0xc9, 0xe1, 0xf9, 0x7c, 0x0c, 0x91, 0xcd, 0x88, 0x42, 0x67, 0x91, 0x75, 0x88, 0xb6, 0x07, 0x47,
-	0xbc, 0xa2, 0x7f, 0x29, 0x54, 0xd1, 0x3d, 0x79, 0x58, 0xcd, 0xdd, 0x68, 0x3e, 0xdc, 0x8c, 0x28,
-	0x38, 0xa5, 0x94, 0x06, 0xe8, 0x59, 0xfa, 0x71, 0x57, 0x73, 0x3a, 0x96, 0xb9, 0xf0, 0x57, 0x31,
-	0x80, 0xdd, 0xd3, 0x91, 0xd3, 0xbc, 0x03, 0x53, 0xba, 0xe6, 0x68, 0x9e, 0xf4, 0xa9, 0xc2, 0xdc,
-	0xcb, 0x5c, 0xa0, 0x14, 0x43, 0xef, 0x57, 0x92, 0xba, 0xbb, 0xa3, 0x2a, 0x64, 0x47, 0x93, 0xd5,
-	0x0e, 0x3a, 0xc8, 0x95, 0xbc, 0x48, 0xc9, 0xe8, 0xe2, 0x90, 0xcc, 0x43, 0xaa, 0x65, 0x51, 0xbd,
-	0x53, 0x29, 0xd0, 0x8d, 0xa6, 0x14, 0x60, 0x90, 0x6b, 0xb9, 0x36, 0x3d, 0xe1, 0x9a, 0xad, 0x33,
-	0x9a, 0x00, 0x62, 0x8a, 0x07, 0xa0, 0xfe, 0x9d, 0x53, 0xb5, 0x6f, 0x9d, 0x18, 0x36, 0x8d, 0xe0,
-	0xb8, 0x92, 0x70, 0x4e, 0x77, 0x70, 0x48, 0xee, 0xc3, 0x4c, 0xc7, 0x7c, 0x66, 0xd8, 0x8e, 0xda,
-	0xb7, 0xba, 0x9a, 0xdd, 0xf9, 0x63, 0xaa, 0x03, 0x1a, 0xa3, 0x49, 0x85, 0xb0, 0x57, 0x3b, 0xc2,
-	0x1b, 0xf2, 0x0e, 0x5c, 0x3f, 0xd0, 0x1c, 0xe3, 0x44, 0x3b, 0x53, 0x5b, 0x87, 0x9a, 0x69, 0x1a,
-	0x5d, 0xbe, 0xaf, 0x84, 0xff, 0x10, 0x39, 0xc3, 0xa9, 0xca, 0x8c, 0x88, 0xed, 0xe3, 0x6d, 0x98,
-	0xd5, 0x8d, 0x67, 0x9d, 0x96, 0x11, 0x98, 0x9b, 0xf4, 0xcf, 0x25, 0x8c, 0xc8, 0x37, 0xf5, 0x6b,
-	0x00, 0x86, 0xa9, 0xed, 0x77, 0x0d, 0xb5, 0x65, 0xb7, 0xe6, 0xa6, 0xbc, 0x83, 0x51, 0x95, 0xa2,
-	0x65, 0xa5, 0x8c, 0xa9, 0x9c, 0x3e, 0xda, 0x2d, 0xd4, 0xc7, 0xa8, 0x0f, 0x9b, 0x03, 0xe6, 0xc9,
-	0x23, 0x80, 0xac, 0x42, 0x0c, 0x07, 0x73, 0x29, 0x9e, 0xcd, 0x82, 0xa7, 0xd3, 0x5d, 0x97, 0xb2,
-	0x14, 0xfb, 0xe1, 0x6f, 0xf0, 0xbc, 0x8c, 0xd4, 0x3c, 0x66, 0xfe, 0x5c, 0x82, 0x5b, 0xef, 0xb2,
-	0xad, 0x15, 0x4d, 0xc7, 0x30, 0x4d, 0xad, 0xe6, 0x75, 0x73, 0xd8, 0x6b, 0xb9, 0xda, 0xe9, 0xe8,
-	0x03, 0xee, 0x2e, 0xa1, 0x9e, 0x88, 0xcf, 0x17, 0x26, 0x8a, 0x69, 0xf3, 0xc0, 0x7d, 0x3b, 0x20,
-	0xb7, 0x21, 0xa3, 0xb1, 0x45, 0x04, 0xe7, 0xc9, 0x28, 0x69, 0x0e, 0x52, 0xcd, 0x2c, 0xd8, 0x90,
-	0xda, 0xeb, 0x77, 0x3b, 0xe6, 0xd1, 0xae, 0x75, 0x64, 0x98, 0xa4, 0x0a, 0x51, 0x6f, 0xe9, 0xaf,
-	0xbc, 0x64, 0xe9, 0xb0, 0xe8, 0x82, 0x04, 0x38, 0xdf, 0xaf, 0xc1, 0x89, 0x80, 0x06, 0x17, 0xfe,
-	0x04, 0xd2, 0x15, 0xeb, 0xc4, 0xc4, 0x55, 0x77, 0x34, 0xe7, 0x90, 0xdc, 0x86, 0xf4, 0x31, 0x95,
-	0x41, 0x75, 0x50, 0x08, 0x56, 0x5b, 0x37, 0x23, 0x4a, 0xea, 0x58, 0x90, 0xac, 0x08, 0xf1, 0x76,
-	0xe7, 0xd4, 0xd0, 0x79, 0x88, 0x5e, 0x5d, 0xb6, 0xcd, 0x88, 0xc2, 0x66, 0x96, 0x26, 0x21, 0xd6,
-	0xd7, 0x9c, 0xc3, 0x85, 0xff, 0x8c, 0xc1, 0xd4, 0xee, 0x29, 0x3f, 0x72, 0x61, 0xeb, 0x44, 0x0f,
-	0xb2, 0x2f, 0xeb, 0xb3, 0xca, 0xf8, 0x52, 0x61, 0x34, 0xa4, 0x0c, 0xd3, 0x3a, 0x17, 0x5d, 0x45,
-	0x5e, 0x03, 0x9a, 0x33, 0xc7, 0xa4, 0x1b, 0x71, 0x83, 0x4a, 0x46, 0x17, 0x46, 0x03, 0xb2, 0x0a,
-	0x53, 0xb4, 0x92, 0xd0, 0x82, 0x16, 0xbd, 0xb8, 0xa0, 0x25, 0xb1, 0x9a, 0xd0, 0x8a, 0xb6, 0x05,
-	0x33, 0x74, 0x56, 0x20, 0x23, 0xc4, 0xae, 0x92, 0x11, 0x64, 0xe4, 0xe2, 0x4b, 0x0a, 0xb7, 0x59,
-	0x35, 0xf3, 0xe2, 0x3e, 0x4e, 0xe3, 0x3e, 0x6d, 0x9f, 0x2e, 0x6f, 0x8c, 0x42, 0x9f, 0x2e, 0x59,
-	0x08, 0x2d, 0x39, 0x79, 0xc5, 0x25, 0x0b, 0x63, 0x96, 0x2c, 0x08, 0x4b, 0x26, 0xdc, 0x25, 0x0b,
-	0xde, 0x92, 0xdf, 0x82, 0x64, 0xdf, 0xee, 0x58, 0x76, 0xc7, 0x39, 0xa3, 0x81, 0x3d, 0x1d, 0x0e,
-	0x80, 0xdd, 0xd3, 0x66, 0xeb, 0xd0, 0xd0, 0x8f, 0xbb, 0xc6, 0x0e, 0xa7, 0x54, 0x46, 0x73, 0x48,
-	0x15, 0x32, 0xda, 0xfe, 0xc0, 0xea, 0x1e, 0x3b, 0x86, 0x4a, 0xc3, 0x74, 0xea, 0x8a, 0x61, 0x9a,
-	0x76, 0xa7, 0xe1, 0x0b, 0xb2, 0x02, 0x49, 0x4d, 0x7f, 0xa6, 0x99, 0x2d, 0x43, 0x9f, 0x6b, 0x5d,
-	0xdc, 0x86, 0x8e, 0x08, 0x79, 0x8c, 0xff, 0xf7, 0x12, 0xed, 0xae, 0xcb, 0x56, 0xaf, 0xa7, 0x99,
-	0x3a, 0xf9, 0x36, 0x44, 0x5b, 0x1d, 0x9d, 0x3b, 0xd7, 0x9b, 0x63, 0x2e, 0x4e, 0x38, 0xa1, 0xe7,
-	0xb1, 0xac, 0xc2, 0x94, 0x6b, 0x15, 0x05, 0x67, 0x92, 0x2f, 0x43, 0xca, 0xd6, 0x4e, 0x46, 0x4d,
-	0xf1, 0x04, 0x0f, 0x0e, 0xb0, 0xb5, 0x13, 0xf7, 0x64, 0x5a, 0x82, 0x29, 0xdb, 0x18, 0xe0, 0xf1,
-	0xd0, 0x74, 0xaf, 0x68, 0x6e, 0xbf, 0x7c, 0xa5, 0x45, 0x05, 0x69, 0x6b, 0xa6, 0xbe, 0x19, 0x51,
-	0x92, 0x36, 0x7f, 0x26, 0x55, 0x3c, 0x18, 0x23, 0x8f, 0x96, 0x65, 0xb6, 0x79, 0xeb, 0xfd, 0xe6,
-	0x65, 0x4c, 0xca, 0x96, 0xd9, 0xde, 0x8c, 0x28, 0x6c, 0x75, 0x1c, 0x90, 0x06, 0x4c, 0xd3, 0xe0,
-	0x68, 0x1d, 0x1a, 0xad, 0x23, 0x55, 0x33, 0xdd, 0x53, 0xdf, 0xff, 0xbf, 0x80, 0xd5, 0x56, 0xc7,
-	0x3c, 0x2a, 0x23, 0x7d, 0xd1, 0xc4, 0x68, 0x4d, 0x77, 0x85, 0x31, 0x79, 0x02, 0x74, 0xac, 0x62,
-	0x67, 0x8b, 0x67, 0x14, 0x76, 0xf5, 0xf2, 0xff, 0x2e, 0x61, 0x87, 0x3d, 0xb1, 0xf1, 0x01, 0xbb,
-	0x70, 0xf0, 0xc6, 0xa8, 0x36, 0x64, 0x56, 0xd4, 0x6d, 0x6c, 0x80, 0x45, 0xd6, 0x28, 0x69, 0xe2,
-	0xaa, 0xac, 0x8b, 0xe6, 0xc0, 0xc7, 0x9a, 0xc9, 0xed, 0xb2, 0x46, 0xa9, 0x1b, 0x30, 0xad, 0x1f,
-	0x3b, 0x67, 0x6a, 0xeb, 0xac, 0xd5, 0x35, 0xa8, 0xdc, 0xc9, 0x4b, 0xd5, 0x50, 0x39, 0x76, 0xce,
-	0xca, 0x48, 0xcf, 0x24, 0x4d, 0xeb, 0xc2, 0x98, 0x3c, 0x01, 0x62, 0x9f, 0xaa, 0x7d, 0xcd, 0xd6,
-	0x7a, 0x78, 0xa0, 0x3e, 0xee, 0x53, 0xa6, 0xcc, 0xb9, 0xef, 0x5e, 0x64, 0xa6, 0xd3, 0x1d, 0x9c,
-	0xd3, 0xc4, 0x29, 0x8c, 0x6f, 0xd6, 0xf6, 0x43, 0x63, 0x58, 0xa3, 0x32, 0xe0, 0x95, 0x58, 0x33,
-	0x0d, 0xf8, 0x58, 0xbb, 0x6a, 0x30, 0x9e, 0xa9, 0x03, 0x47, 0x73, 0x8e, 0x07, 0x94, 0x6d, 0xea,
-	0x72, 0x35, 0x18, 0xcf, 0x9a, 0x94, 0x9e, 0x7b, 0x83, 0x2e, 0x8c, 0x89, 0x02, 0x59, 0xd3, 0x38,
-	0x19, 0x1d, 0x00, 0x50, 0x07, 0x69, 0xca, 0xf1, 0xce, 0x05, 0x1c, 0xeb, 0xc6, 0x09, 0x3f, 0x0d,
-	0x30, 0x0d, 0x64, 0x4c, 0x11, 0x08, 0xf2, 0x44, 0x29, 0x33, 0xaf, 0xc0, 0x93, 0x89, 0x29, 0xf0,
-	0x44, 0x39, 0x35, 0x98, 0xd6, 0xbb, 0x3e, 0x31, 0xa7, 0x2f, 0xdf, 0xf8, 0x96, 0x27, 0x54, 0x49,
-	0x1e, 0x9e, 0xcf, 0xa7, 0x45, 0x84, 0xaa, 0xa2, 0x2b, 0x88, 0xed, 0x5f, 0x02, 0xa5, 0xce, 0x5e,
-	0x7d, 0x09, 0xf4, 0x60, 0xff, 0x12, 0xae, 0xb6, 0xbb, 0xc2, 0x2e, 0xbe, 0x8f, 0xf9, 0x1f, 0xd3,
-	0x28, 0x1e, 0x1e, 0x3d, 0xaf, 0x93, 0xe9, 0x3a, 0x5f, 0xbd, 0xd0, 0x35, 0x76, 0xe9, 0x24, 0xc1,
-	0xed, 0x64, 0x3b, 0x80, 0xa1, 0xdf, 0x39, 0x61, 0x97, 0xbe, 0x76, 0xa9, 0xdf, 0xed, 0x86, 0x5d,
-	0xda, 0x09, 0xb8, 0x34, 0x4d, 0x88, 0x47, 0xc6, 0x19, 0x4d, 0x88, 0xe4, 0x0a, 0x09, 0xf1, 0xc8,
-	0x38, 0x1b, 0x25, 0x44, 0xf6, 0xcc, 0x12, 0x22, 0xf2, 0xa0, 0x09, 0x71, 0xe6, 0x0a, 0x09, 0xf1,
-	0xc8, 0x38, 0xf3, 0x12, 0x22, 0x1f, 0x10, 0x1b, 0x66, 0x30, 0xbf, 0x04, 0xb7, 0x39, 0x7b, 0xa9,
-	0x0e, 0x8b, 0x15, 0xc5, 0xb7, 0xa9, 0xd2, 0xec, 0xf0, 0x7c, 0x5e, 0x0e, 0xa2, 0xa8, 0x59, 0x4d,
-	0xb7, 0xfd, 0xdb, 0x57, 0x20, 0xcb, 0x4f, 0xca, 0x58, 0x02, 0xa9, 0x6f, 0x5c, 0xbf, 0xd4, 0xa3,
-	0x2b, 0x74, 0x06, 0x56, 0x3f, 0xee, 0xd1, 0xba, 0x08, 0x90, 0x3d, 0x90, 0xdb, 0x96, 0xdd, 0xc2,
-	0x64, 0xe6, 0x5e, 0x89, 0xcf, 0xdd, 0x18, 0x7f, 0x14, 0x13, 0x98, 0x6e, 0xe0, 0x94, 0xd1, 0x15,
-	0xd7, 0x66, 0x44, 0x99, 0x6e, 0xfb, 0x10, 0x62, 0x8c, 0xee, 0xd8, 0x83, 0x1a, 0xba, 0x49, 0x99,
-	0x2f, 0x5e, 0xa8, 0x71, 0x9c, 0x18, 0x54, 0xc7, 0x8c, 0x1d, 0x86, 0x5f, 0xb2, 0x0c, 0x2a, 0x66,
-	0xee, 0x95, 0x97, 0x61, 0xea, 0x09, 0x2d, 0xc3, 0x8a, 0x15, 0xe9, 0xd3, 0x58, 0xe9, 0x5a, 0x58,
-	0x8c, 0xdb, 0x16, 0xdd, 0xc9, 0xad, 0x4b, 0x5d, 0x7a, 0x07, 0xe3, 0xa2, 0x6b, 0x39, 0x35, 0xb3,
-	0x6d, 0x71, 0x97, 0xee, 0xfb, 0x21, 0xb2, 0x0f, 0xd7, 0x3d, 0xd6, 0x62, 0x62, 0xc9, 0x51, 0xee,
-	0xf7, 0xae, 0xc0, 0xdd, 0x97, 0x4c, 0x48, 0x3f, 0x84, 0x8e, 0x5f, 0x03, 0x95, 0xf4, 0xa5, 0x57,
-	0x5d, 0x83, 0xe9, 0x28, 0xb8, 0x06, 0xaa, 0xe8, 0x7d, 0xb8, 0xb6, 0x6f, 0x68, 0x2d, 0xcb, 0x74,
-	0xf3, 0x0a, 0xf2, 0x7f, 0xed, 0x52, 0x0d, 0x95, 0xe8, 0x1c, 0x96, 0x41, 0x78, 0xb1, 0xd9, 0xf7,
-	0x43, 0xe8, 0xf5, 0x9c, 0x33, 0x1e, 0x31, 0xa9, 0x6e, 0x5e, 0xbf, 0xd4, 0xeb, 0x19, 0x5f, 0x3c,
-	0x7f, 0xf2, 0xda, 0xb0, 0x2f, 0x02, 0x41, 0x9e, 0x28, 0x6b, 0xfe, 0x15, 0x78, 0xf2, 0x48, 0xda,
-	0x17, 0x01, 0x21, 0x3a, 0x7b, 0x96, 0x4e, 0xcf, 0xd4, 0x73, 0xf3, 0x57, 0x8c, 0xce, 0x6d, 0x4b,
-	0x37, 0x58, 0x9e, 0xe2, 0xd1, 0xc9, 0x01, 0x8c, 0x4e, 0x91, 0x27, 0x4d, 0x59, 0x6f, 0x5c, 0x1a,
-	0x9d, 0x1e, 0x53, 0x9e, 0xb7, 0xa6, 0x75, 0x1f, 0x92, 0xdb, 0x80, 0xa4, 0x7b, 0x58, 0x24, 0x5f,
-	0x87, 0x4c, 0xaf, 0x63, 0x5a, 0xb6, 0xfa, 0xcc, 0xb0, 0x07, 0xd8, 0xe6, 0xbf, 0xec, 0xbb, 0x14,
-	0x12, 0x29, 0x69, 0x4a, 0xfb, 0x88, 0x91, 0xe6, 0xde, 0x85, 0xa9, 0xd1, 0x79, 0xf1, 0x73, 0x31,
-	0x7a, 0x08, 0x69, 0xf1, 0xb4, 0x48, 0x6e, 0xc0, 0x64, 0x4f, 0xb3, 0x0f, 0x3a, 0x26, 0xbf, 0x1a,
-	0xe2, 0x23, 0xec, 0x35, 0x46, 0x17, 0x0d, 0xd6, 0xb1, 0xe9, 0xb8, 0xbd, 0xaf, 0x7b, 0xaf, 0x80,
-	0x58, 0xee, 0x7f, 0x25, 0x10, 0x0e, 0x87, 0xe3, 0xae, 0x5b, 0xa4, 0xcf, 0x70, 0xdd, 0xf2, 0x26,
-	0x4c, 0xbb, 0xf7, 0x25, 0xfe, 0xbe, 0x9b, 0xdf, 0x9a, 0x30, 0xaa, 0x2f, 0x43, 0xda, 0x0d, 0xb0,
-	0x9e, 0x36, 0x38, 0xe2, 0x57, 0x6c, 0x29, 0x8e, 0x6d, 0x6b, 0x83, 0x23, 0xb2, 0x04, 0xb3, 0x22,
-	0x09, 0x1a, 0xd5, 0xb1, 0xad, 0x2e, 0xbf, 0xb4, 0x26, 0x02, 0x69, 0x99, 0xbd, 0x21, 0xb7, 0x20,
-	0x69, 0xee, 0xab, 0x8e, 0x8d, 0x6e, 0x3a, 0xc9, 0xae, 0xca, 0xcc, 0xfd, 0x5d, 0x1c, 0xbe, 0x17,
-	0x4b, 0xc6, 0xe4, 0x78, 0xee, 0x2f, 0xbc, 0x1d, 0xa3, 0xf6, 0xee, 0x80, 0xec, 0x5b, 0x41, 0x6b,
-	0x1d, 0xb1, 0x0f, 0x4b, 0xca, 0xb4, 0xc0, 0xbd, 0xd8, 0x3a, 0x22, 0xf7, 0x60, 0x26, 0xa0, 0x1b,
-	0x4a, 0x4c, 0x3f, 0x31, 0x29, 0xb2, 0x4f, 0x01, 0x48, 0xfe, 0x55, 0x56, 0xda, 0x3d, 0x1d, 0xa8,
-	0xde, 0x97, 0xa6, 0xac, 0xa8, 0x87, 0x62, 0xeb, 0x28, 0xf7, 0x04, 0xd2, 0xe2, 0xd1, 0x97, 0xd4,
-	0x60, 0xba, 0xa7, 0x9d, 0xaa, 0xde, 0xf9, 0x99, 0x9b, 0x21, 0x54, 0xc1, 0x8b, 0x07, 0x07, 0xb6,
-	0x81, 0x16, 0xd5, 0xbd, 0xf9, 0xe9, 0x9e, 0x76, 0x3a, 0x1a, 0xe5, 0xfe, 0x49, 0x82, 0x6c, 0xe0,
-	0x04, 0xfc, 0xb2, 0xa6, 0x56, 0xfa, 0x6c, 0x4d, 0xed, 0x7d, 0x98, 0xf5, 0x77, 0xe5, 0xfc, 0x72,
-	0x98, 0xd9, 0xfc, 0x9a, 0xd0, 0x77, 0xf3, 0x1b, 0xe1, 0x50, 0x17, 0x1c, 0x0d, 0x77, 0xc1, 0xb9,
-	0xbf, 0x0b, 0xc8, 0x8d, 0xc6, 0x5a, 0x85, 0x9b, 0x63, 0xe4, 0x16, 0x6c, 0x36, 0x13, 0x14, 0x0e,
-	0x2d, 0xb1, 0x06, 0x73, 0xe3, 0xe4, 0x13, 0xac, 0x37, 0x1b, 0x92, 0x11, 0xe7, 0xdd, 0x85, 0x6b,
-	0x3e, 0x31, 0x45, 0x03, 0x8a, 0xa2, 0xa2, 0x01, 0xbf, 0x03, 0x69, 0xf1, 0xd0, 0x4e, 0xe6, 0x20,
-	0xb1, 0xaf, 0x39, 0x8e, 0x61, 0x9f, 0x8d, 0x2e, 0x6c, 0xd9, 0x50, 0x08, 0xd7, 0x09, 0x7a, 0x93,
-	0xc8, 0x47, 0xb9, 0xff, 0x91, 0x20, 0xe3, 0x3b, 0xa5, 0xa3, 0x9a, 0xfc, 0xb7, 0x7c, 0x8c, 0x93,
-	0x1b, 0x34, 0x4c, 0xf9, 0xbe, 0x8b, 0xcb, 0x89, 0xe0, 0xc5, 0xe5, 0x16, 0xcc, 0xf4, 0x3a, 0x66,
-	0xc8, 0xd0, 0xd1, 0x2b, 0x19, 0xba, 0xd7, 0x31, 0xfd, 0x86, 0x46, 0x6e, 0xe8, 0x95, 0x9f, 0xe9,
-	0xfa, 0x05, 0x9d, 0x52, 0x44, 0x72, 0xef, 0x8b, 0xfb, 0x45, 0x9d, 0xdd, 0x86, 0x8c, 0x5f, 0xd7,
-	0xcc, 0xa6, 0xe9, 0xb6, 0xa0, 0x68, 0xb2, 0x00, 0x19, 0x6f, 0x7d, 0xcf, 0x82, 0x29, 0x37, 0xfe,
-	0xd0, 0x18, 0xdf, 0x05, 0x5f, 0xdb, 0xf0, 0x05, 0x28, 0x32, 0xa7, 0x82, 0xaf, 0x4d, 0x40, 0xdf,
-	0xf0, 0xb1, 0x14, 0xe4, 0xcd, 0x8a, 0x6c, 0x51, 0xe4, 0xd0, 0xbe, 0x26, 0xc2, 0xfb, 0xca, 0x15,
-	0x41, 0x0e, 0x76, 0x0c, 0xe4, 0x1e, 0xc4, 0xd9, 0x05, 0x99, 0x74, 0xf1, 0x05, 0x19, 0xa3, 0xca,
-	0xfd, 0x8b, 0x04, 0xd9, 0x40, 0x63, 0x40, 0x14, 0x96, 0x48, 0x8c, 0x8e, 0xdd, 0xf7, 0x05, 0x79,
-	0xf8, 0x0b, 0x14, 0xad, 0x7a, 0xd5, 0x9a, 0xb2, 0xc3, 0x9a, 0xa2, 0x6d, 0xed, 0x14, 0x07, 0xcc,
-	0x78, 0x98, 0x51, 0xaa, 0x1d, 0xbb, 0xcf, 0x34, 0x75, 0x17, 0xae, 0xf1, 0xbb, 0x4a, 0xfd, 0xc4,
-	0xe8, 0x76, 0xd9, 0x1d, 0x13, 0xdb, 0x53, 0x96, 0xbd, 0xa8, 0x20, 0x4e, 0x2f, 0x91, 0x16, 0x61,
-	0x66, 0x74, 0x59, 0x28, 0x50, 0xb3, 0x28, 0xba, 0xe6, 0xbe, 0x1a, 0xd1, 0xb3, 0x6a, 0xcb, 0xbb,
-	0x8f, 0xcf, 0x5d, 0x6d, 0xdd, 0xfe, 0xe3, 0xf3, 0x30, 0xfa, 0x54, 0x82, 0x50, 0xc3, 0x41, 0x3e,
-	0x80, 0x1b, 0xee, 0xaf, 0x0c, 0xba, 0x9d, 0x5e, 0xc7, 0x51, 0x8d, 0xd3, 0xbe, 0x65, 0x1a, 0xa6,
-	0xf3, 0xd2, 0x34, 0x4d, 0x7f, 0x7c, 0xb0, 0x85, 0xb4, 0x55, 0x4e, 0x5a, 0xba, 0x39, 0x3c, 0x9f,
-	0x9f, 0x19, 0xf3, 0x42, 0x99, 0x61, 0xbf, 0x4f, 0xf0, 0x81, 0xe2, 0x92, 0xd4, 0xda, 0xde, 0x92,
-	0x13, 0x17, 0x2d, 0x49, 0x1d, 0x64, 0xdc, 0x92, 0xbe, 0x17, 0xee, 0x92, 0x3e, 0x30, 0x57, 0x83,
-	0x8c, 0xaf, 0x21, 0x22, 0xeb, 0xfc, 0xda, 0x3f, 0x71, 0xe9, 0x7d, 0x22, 0xbd, 0x0b, 0xf7, 0xae,
-	0xfe, 0x73, 0xbf, 0x93, 0x60, 0xda, 0xdf, 0x07, 0x91, 0xf9, 0xf0, 0xf7, 0xfd, 0x8c, 0xef, 0x1b,
-	0xfe, 0x17, 0xf7, 0xe9, 0x07, 0x23, 0xc0, 0x36, 0x1c, 0xbb, 0x63, 0x0c, 0xd8, 0x0f, 0x4f, 0x14,
-	0xe8, 0x69, 0xa7, 0x0a, 0x43, 0xc8, 0x36, 0x64, 0xfb, 0x86, 0xdd, 0xb1, 0x74, 0x4f, 0xa5, 0xb1,
-	0xf1, 0x37, 0x95, 0xbc, 0x0b, 0xa2, 0xc4, 0x23, 0xd5, 0x4d, 0xf7, 0x7d, 0xe3, 0xdc, 0xc7, 0x12,
-	0xcc, 0x8c, 0xe9, 0xca, 0xc8, 0x77, 0x81, 0xa0, 0x1c, 0xf4, 0x28, 0x76, 0xa9, 0xbf, 0x30, 0x06,
-	0xf4, 0x88, 0x36, 0x5a, 0x08, 0x33, 0xa8, 0x0f, 0x21, 0x75, 0xb8, 0x86, 0x2c, 0x69, 0x83, 0x1b,
-	0x70, 0x87, 0x85, 0x97, 0xfc, 0x50, 0xa2, 0xd3, 0x33, 0x46, 0x0c, 0xb3, 0x3d, 0xed, 0x54, 0x04,
-	0x72, 0x9b, 0x61, 0xc9, 0xd1, 0xec, 0xcb, 0x70, 0x3d, 0xb4, 0x8c, 0x90, 0xef, 0x48, 0x80, 0x0d,
-	0x66, 0xb3, 0x1a, 0x64, 0x03, 0xfd, 0x1c, 0x59, 0x83, 0x49, 0xa6, 0x29, 0xbe, 0xe7, 0x7c, 0x50,
-	0x42, 0x77, 0x02, 0xd3, 0xaf, 0xc2, 0xa9, 0x73, 0x67, 0x40, 0xc2, 0xcd, 0x9b, 0x3f, 0x5b, 0x4b,
-	0xc1, 0xb2, 0xf7, 0xc5, 0xb8, 0x4e, 0xee, 0x30, 0xb4, 0xf4, 0x95, 0xcb, 0xd4, 0xab, 0x1d, 0x16,
-	0x73, 0x5b, 0x90, 0x0d, 0x74, 0x77, 0x64, 0x56, 0x4c, 0xfe, 0x19, 0x9e, 0xe3, 0xc3, 0xa5, 0x6c,
-	0x22, 0x5c, 0xca, 0x72, 0xf7, 0x20, 0xe3, 0xeb, 0xe9, 0x2e, 0xd6, 0x56, 0x6e, 0x55, 0x24, 0xbf,
-	0xea, 0x0e, 0x73, 0xdf, 0x70, 0xb3, 0x83, 0xdb, 0x7f, 0xbd, 0xca, 0x47, 0xa4, 0xdc, 0x37, 0x61,
-	0xda, 0xdf, 0x79, 0xbd, 0xd2, 0xf4, 0xd2, 0x14, 0x24, 0xf8, 0xc7, 0x80, 0xbb, 0x3f, 0x91, 0x20,
-	0x4e, 0x7f, 0x07, 0x48, 0x64, 0x48, 0xbf, 0xd7, 0xa8, 0xd5, 0x55, 0xa5, 0xfa, 0xdd, 0xbd, 0x6a,
-	0x73, 0x57, 0x8e, 0x90, 0x2c, 0xa4, 0x28, 0x52, 0x2c, 0x97, 0xab, 0x3b, 0xbb, 0xb2, 0x44, 0x08,
-	0x4c, 0xef, 0xd5, 0xcb, 0x8d, 0xfa, 0x46, 0x4d, 0xd9, 0xae, 0x56, 0xd4, 0xbd, 0x1d, 0x79, 0x82,
-	0xcc, 0x82, 0x2c, 0x62, 0x95, 0xc6, 0xe3, 0xba, 0x1c, 0x45, 0x66, 0x3e, 0xba, 0x18, 0xce, 0x0d,
-	0x50, 0xc5, 0x11, 0x53, 0xaa, 0xbe, 0x45, 0x27, 0x71, 0xd1, 0x1d, 0xa5, 0xb1, 0xa3, 0xd4, 0xaa,
-	0xbb, 0x45, 0xe5, 0x89, 0x9c, 0xb8, 0x7b, 0x13, 0xe2, 0xf4, 0xb7, 0x87, 0x64, 0x1a, 0x60, 0xab,
-	0xa1, 0x14, 0x1f, 0x17, 0xeb, 0xaa, 0xb2, 0x2c, 0x47, 0xee, 0xb6, 0xe8, 0x57, 0x12, 0x5e, 0x69,
-	0x70, 0xde, 0x76, 0xb1, 0xac, 0xee, 0xd5, 0x1f, 0xd6, 0x91, 0x79, 0x84, 0xa4, 0x21, 0x89, 0xc0,
-	0xa3, 0x65, 0x75, 0x49, 0x96, 0x70, 0xb2, 0x3b, 0x52, 0x97, 0xe5, 0x09, 0xdf, 0xb8, 0x20, 0x47,
-	0x05, 0xea, 0x65, 0x39, 0x96, 0x4b, 0x7e, 0xf4, 0x37, 0xf9, 0xc8, 0xcf, 0xff, 0x36, 0x1f, 0xb9,
-	0xfb, 0x63, 0x09, 0x60, 0x67, 0xf3, 0x89, 0xb0, 0xca, 0xce, 0xe6, 0x13, 0xff, 0x2a, 0x08, 0x78,
-	0xab, 0xb8, 0x23, 0xba, 0xca, 0x2c, 0xc8, 0xa3, 0x71, 0x41, 0x55, 0xaa, 0x8f, 0xd4, 0xa2, 0x1c,
-	0x1d, 0x83, 0x96, 0x98, 0x82, 0x38, 0xba, 0xcc, 0x29, 0xe3, 0x21, 0xac, 0x24, 0x4f, 0x0a, 0xb2,
-	0xfd, 0xc3, 0x04, 0x64, 0xfc, 0x47, 0xcc, 0x2c, 0xa4, 0x2a, 0xc5, 0xdd, 0xa2, 0xaa, 0x14, 0x77,
-	0xab, 0xea, 0x12, 0x33, 0xa1, 0x07, 0x2c, 0xcb, 0x92, 0x1f, 0x28, 0xc8, 0x13, 0x7e, 0x60, 0x45,
-	0x8e, 0xfa, 0x81, 0x55, 0x39, 0xe6, 0x07, 0x1e, 0xc8, 0x71, 0x3f, 0xb0, 0xc6, 0x6c, 0xe6, 0x01,
-	0x6f, 0xc9, 0x09, 0x3f, 0xb0, 0x2e, 0x27, 0xfd, 0xc0, 0xdb, 0xf2, 0x14, 0x3a, 0x88, 0x20, 0xd8,
-	0x92, 0x0c, 0x01, 0x64, 0x59, 0x4e, 0x05, 0x90, 0x82, 0x9c, 0x0e, 0x20, 0x2b, 0x72, 0x26, 0x80,
-	0xac, 0xca, 0xd3, 0x01, 0xe4, 0x81, 0x9c, 0x15, 0x34, 0xb6, 0x04, 0xe0, 0xfd, 0xe4, 0x8d, 0xa4,
-	0x20, 0x51, 0x6e, 0xd4, 0x77, 0xab, 0xef, 0xa3, 0xb3, 0xa7, 0x20, 0xd1, 0xac, 0x36, 0x9b, 0xb5,
-	0x46, 0x5d, 0x96, 0x48, 0x12, 0x62, 0x0f, 0xab, 0x4f, 0x9a, 0xf2, 0x04, 0xce, 0xf0, 0x7e, 0xec,
-	0x82, 0xdb, 0xd8, 0xa0, 0xae, 0x5a, 0x2f, 0xd7, 0xaa, 0x4d, 0x39, 0x42, 0xae, 0x41, 0xa6, 0xbc,
-	0x59, 0xac, 0xd7, 0xab, 0x5b, 0xea, 0x76, 0xb1, 0xf9, 0xb0, 0x29, 0x4b, 0x77, 0x57, 0x21, 0x4e,
-	0x83, 0x8d, 0xb2, 0xdf, 0x2a, 0x36, 0x9b, 0x6a, 0x91, 0xb1, 0x67, 0x83, 0x92, 0x2c, 0x79, 0x83,
-	0xb2, 0x3c, 0x91, 0x8b, 0xa1, 0x74, 0x77, 0xfb, 0x40, 0xc2, 0x5f, 0x25, 0x09, 0xc0, 0xe4, 0x56,
-	0xe3, 0x31, 0x8b, 0xc6, 0x04, 0x44, 0xb7, 0x1a, 0x8f, 0x65, 0x09, 0x37, 0x58, 0xaa, 0x6e, 0x35,
-	0x1e, 0xab, 0xf5, 0x86, 0xb2, 0x5d, 0xdc, 0x92, 0x27, 0x90, 0x8c, 0x3f, 0xd3, 0xc8, 0x2b, 0x96,
-	0x1a, 0x8f, 0xaa, 0xee, 0xdb, 0x18, 0x6e, 0x66, 0xb3, 0xf6, 0xee, 0xa6, 0x1c, 0xc7, 0x75, 0xf1,
-	0x89, 0x06, 0xda, 0xdd, 0xff, 0x8a, 0xc2, 0xec, 0xb8, 0x8f, 0x87, 0x24, 0x03, 0x53, 0xe5, 0x5a,
-	0x45, 0x55, 0x36, 0xf6, 0xa8, 0x0b, 0xb9, 0xc3, 0x6a, 0xb3, 0xca, 0x73, 0x00, 0x0e, 0xb7, 0x6a,
-	0xf5, 0x87, 0x6a, 0x79, 0xb3, 0x5a, 0x7e, 0x28, 0x4f, 0xd0, 0x68, 0x77, 0xb1, 0x62, 0x45, 0x91,
-	0xa3, 0x2e, 0x55, 0x65, 0x6f, 0xf7, 0x89, 0x5a, 0x7e, 0x52, 0xde, 0xaa, 0xca, 0x31, 0x72, 0x03,
-	0x08, 0x65, 0xf4, 0xbe, 0xba, 0x53, 0x54, 0x8a, 0xdb, 0x6a, 0xb3, 0xba, 0xbb, 0xb7, 0xc3, 0x9c,
-	0x9c, 0xd2, 0x56, 0x1f, 0xa9, 0xcd, 0xdd, 0xe2, 0xee, 0x5e, 0x53, 0x9e, 0x24, 0x33, 0x90, 0x45,
-	0xac, 0x5e, 0x7d, 0xac, 0x72, 0xfd, 0xca, 0x09, 0x72, 0x13, 0x66, 0x38, 0x83, 0xdd, 0xda, 0x76,
-	0xad, 0xfe, 0x2e, 0xe7, 0x90, 0x74, 0x39, 0xef, 0xfa, 0x39, 0x4f, 0x8d, 0x38, 0x6f, 0x8d, 0x98,
-	0x80, 0xb7, 0x9d, 0x87, 0xd5, 0x27, 0x72, 0xca, 0xe5, 0x59, 0xac, 0x28, 0xbe, 0xb9, 0x69, 0x57,
-	0x82, 0x4a, 0xf5, 0x51, 0xad, 0x5c, 0xc5, 0x05, 0xab, 0x72, 0x06, 0x23, 0x17, 0xc1, 0x8d, 0x86,
-	0x52, 0xae, 0xaa, 0x2c, 0x75, 0xc9, 0xd3, 0x24, 0x07, 0x37, 0x18, 0x4b, 0x9a, 0xca, 0x44, 0x36,
-	0x59, 0x57, 0xb4, 0x1d, 0x2a, 0xee, 0x56, 0x63, 0x57, 0xad, 0xd5, 0x37, 0x1a, 0xb2, 0x4c, 0x6e,
-	0xc1, 0x75, 0x3f, 0xee, 0x4a, 0x78, 0x8d, 0x5c, 0x87, 0x6b, 0xf8, 0xaa, 0x54, 0x2d, 0x96, 0x1b,
-	0x75, 0xbe, 0x55, 0x99, 0xb8, 0x02, 0x71, 0x18, 0xdd, 0x50, 0x9e, 0x09, 0x48, 0xb9, 0xdd, 0xa8,
-	0x54, 0xe5, 0x37, 0xb8, 0x47, 0xfd, 0x6a, 0x02, 0x66, 0xc6, 0xdc, 0x6f, 0xd0, 0xf8, 0x18, 0x99,
-	0x45, 0x5d, 0x96, 0x23, 0x01, 0xa4, 0xc0, 0x5c, 0x4c, 0x40, 0x56, 0x99, 0x89, 0x05, 0x64, 0x5d,
-	0x8e, 0xa2, 0xeb, 0x8b, 0x7c, 0xd6, 0xe4, 0x58, 0x00, 0x5a, 0x29, 0xc8, 0xf1, 0x00, 0xb4, 0xb6,
-	0x2a, 0x4f, 0xa2, 0x55, 0xc4, 0x89, 0x85, 0x75, 0x39, 0x11, 0xc0, 0x0a, 0x0f, 0xd6, 0xe4, 0x64,
-	0x00, 0x7b, 0xb0, 0x5c, 0x90, 0xa7, 0x70, 0xbf, 0xe2, 0xdc, 0xa5, 0xc2, 0xaa, 0x0c, 0x01, 0xb0,
-	0xb0, 0xb4, 0xba, 0x2e, 0xa7, 0x02, 0xe0, 0xea, 0xd2, 0xdb, 0x6b, 0xcc, 0xa8, 0xe2, 0x2e, 0x96,
-	0xdf, 0x2e, 0x30, 0xa3, 0xfa, 0x36, 0xb2, 0xb2, 0x8e, 0x69, 0xc4, 0x8f, 0xae, 0x14, 0xde, 0x5a,
-	0x5b, 0x97, 0xb3, 0x5c, 0xb5, 0xff, 0x28, 0xc1, 0xb4, 0xff, 0xbc, 0x85, 0xfb, 0xa4, 0xb6, 0xac,
-	0x3e, 0xaa, 0x2a, 0x4f, 0xd4, 0x65, 0x9e, 0x1b, 0x04, 0xa8, 0xd0, 0x94, 0xa5, 0x00, 0xb4, 0xda,
-	0x94, 0x27, 0x02, 0xd0, 0x7a, 0x93, 0x05, 0x8f, 0xc8, 0x6b, 0xad, 0xc9, 0xab, 0x83, 0x87, 0xad,
-	0x14, 0x9a, 0xbc, 0x3a, 0x78, 0xd8, 0xda, 0x2a, 0x0f, 0x1c, 0x71, 0x6e, 0x61, 0xbd, 0x29, 0x27,
-	0xb8, 0xd4, 0x7f, 0x16, 0x75, 0x0f, 0xa8, 0xfe, 0x73, 0xf0, 0x0c, 0x64, 0xb9, 0xeb, 0x96, 0x1b,
-	0x7b, 0xf5, 0x5d, 0x34, 0x65, 0x24, 0x04, 0xae, 0xa0, 0x5b, 0x04, 0xc1, 0xb5, 0x55, 0x56, 0xe3,
-	0xfc, 0xd3, 0x0b, 0xeb, 0xac, 0xc6, 0xf9, 0x50, 0x34, 0x69, 0x2c, 0x84, 0xa2, 0x51, 0xe3, 0xe8,
-	0xf0, 0x7e, 0x0e, 0x68, 0xd6, 0xc9, 0x10, 0x4c, 0x0d, 0x9b, 0x08, 0xc1, 0xd4, 0xb4, 0xc9, 0x10,
-	0x4c, 0x8d, 0x3b, 0x85, 0xf1, 0x17, 0xd8, 0x1c, 0x9a, 0x17, 0x42, 0x38, 0x33, 0x70, 0x2a, 0x84,
-	0xaf, 0x3d, 0x78, 0xb0, 0x82, 0x9e, 0x73, 0x13, 0x66, 0xfc, 0x7c, 0x56, 0x96, 0x97, 0xde, 0x42,
-	0xef, 0x09, 0xbe, 0x28, 0xac, 0x15, 0x96, 0x57, 0xd1, 0x81, 0x82, 0x2f, 0x1e, 0x14, 0x56, 0x0b,
-	0xeb, 0x9e, 0x0f, 0x7d, 0x32, 0x01, 0x24, 0xdc, 0x55, 0xa0, 0x3b, 0xf0, 0x59, 0x98, 0x72, 0x68,
-	0x02, 0x0e, 0x40, 0xcb, 0xcc, 0x8f, 0x44, 0xa8, 0xc0, 0xfc, 0x48, 0x84, 0x56, 0x58, 0x84, 0x8a,
-	0xd0, 0x2a, 0x8b, 0x50, 0x11, 0x7a, 0xc0, 0x22, 0x54, 0x84, 0xb0, 0x9e, 0x07, 0x20, 0xac, 0xe8,
-	0x01, 0x08, 0x6b, 0x7a, 0x00, 0x7a, 0x9b, 0x25, 0x5c, 0x9f, 0xa8, 0x58, 0xd7, 0x83, 0x18, 0x56,
-	0xf6, 0x20, 0x86, 0xb5, 0x3d, 0x88, 0x61, 0x75, 0x0f, 0x62, 0xa8, 0xd7, 0x20, 0xf6, 0x60, 0xa4,
-	0xd2, 0x7f, 0x95, 0xdc, 0x1f, 0xc3, 0xfb, 0x9b, 0x4c, 0xc1, 0x6f, 0x77, 0xaa, 0x4a, 0xad, 0x51,
-	0xa1, 0x6a, 0x0d, 0x81, 0xcb, 0x3e, 0x0f, 0xe7, 0x20, 0xaa, 0x36, 0x04, 0xa2, 0x72, 0x43, 0x20,
-	0xaa, 0x37, 0x04, 0xa2, 0x82, 0x43, 0xe0, 0x1a, 0x8b, 0x53, 0x3f, 0xf8, 0xd6, 0x28, 0x4e, 0xff,
-	0x7d, 0x02, 0xc0, 0xbb, 0x4f, 0xa2, 0x19, 0x94, 0xa5, 0x77, 0x1c, 0xaa, 0xeb, 0x72, 0x84, 0x66,
-	0x46, 0x01, 0x5a, 0x5e, 0x62, 0x75, 0xd9, 0x87, 0xa1, 0xe0, 0x41, 0x6c, 0x85, 0x25, 0x17, 0x1f,
-	0xb6, 0xca, 0x92, 0x8b, 0x0f, 0x5b, 0x63, 0xc9, 0xc5, 0x87, 0xad, 0xf3, 0xcc, 0x2d, 0x60, 0x85,
-	0x25, 0x9e, 0xb9, 0x45, 0x6c, 0x99, 0x67, 0x6e, 0x11, 0x5b, 0x65, 0xae, 0xe1, 0xc3, 0xd6, 0x98,
-	0x6b, 0xf8, 0xb0, 0xb7, 0x98, 0x6b, 0xf8, 0xb0, 0xb7, 0x99, 0x6b, 0x88, 0xd8, 0xca, 0x12, 0x73,
-	0x0d, 0x1f, 0xb6, 0xc2, 0x5c, 0xc3, 0x87, 0xad, 0x8d, 0x5c, 0xe3, 0xa3, 0x28, 0x8c, 0xbb, 0x2c,
-	0x42, 0x33, 0x60, 0xe9, 0x2f, 0x96, 0x1f, 0xaa, 0x5b, 0xb5, 0xed, 0xda, 0x2e, 0xad, 0x87, 0x21,
-	0x90, 0xe7, 0x3e, 0x3f, 0xb8, 0xca, 0x3c, 0xc3, 0x0f, 0xf2, 0xd4, 0x17, 0xe0, 0xc9, 0x53, 0x9f,
-	0x1f, 0xa5, 0xe5, 0x31, 0x84, 0xae, 0xf1, 0xcc, 0x17, 0xe0, 0x50, 0xe0, 0x99, 0x2f, 0x20, 0xd7,
-	0x03, 0x9e, 0xf9, 0xfc, 0x30, 0x2b, 0x95, 0x37, 0x80, 0x04, 0x98, 0xb0, 0x6a, 0x19, 0xc2, 0x79,
-	0xc1, 0x0c, 0xe1, 0xbc, 0x66, 0x86, 0x70, 0x5e, 0x36, 0x6f, 0x52, 0x8d, 0xfa, 0xb6, 0xc9, 0x2a,
-	0x67, 0xe8, 0x85, 0xbf, 0x78, 0x7a, 0xa6, 0xf0, 0xdd, 0x97, 0x89, 0xba, 0xac, 0x54, 0xb7, 0x8a,
-	0x4f, 0x82, 0xa6, 0x60, 0x60, 0xc0, 0x14, 0x0c, 0x0c, 0x98, 0x82, 0x81, 0x01, 0x53, 0x70, 0x9e,
-	0x01, 0x53, 0x30, 0x34, 0x68, 0x0a, 0x86, 0x06, 0x4d, 0xc1, 0x39, 0x04, 0x4d, 0xc1, 0xe5, 0x0a,
-	0x9a, 0x82, 0xc1, 0x21, 0x53, 0x70, 0x26, 0x21, 0x53, 0x70, 0x2e, 0x21, 0x53, 0xf0, 0x0d, 0x86,
-	0x4c, 0xc1, 0xf7, 0x18, 0x32, 0x85, 0xbb, 0xcd, 0x90, 0x29, 0xdc, 0x9d, 0x8a, 0xa6, 0xf8, 0xd1,
-	0x04, 0x24, 0xf8, 0x2d, 0x38, 0xb6, 0xae, 0xca, 0xfb, 0x9c, 0x0a, 0xd3, 0xa3, 0x38, 0x5e, 0x66,
-	0xad, 0xed, 0x68, 0x5c, 0x60, 0x0d, 0xf4, 0x68, 0x8c, 0x79, 0x45, 0x1c, 0x63, 0x4e, 0x11, 0xc7,
-	0x98, 0x05, 0xc5, 0x31, 0x26, 0x40, 0x71, 0x8c, 0x05, 0x46, 0x1c, 0x63, 0x75, 0x11, 0xc7, 0x58,
-	0x5a, 0xb2, 0x90, 0xf2, 0xe4, 0xc1, 0xba, 0xe2, 0x03, 0xb0, 0xa8, 0xf8, 0x00, 0xac, 0x28, 0x3e,
-	0x00, 0xcb, 0x89, 0x0f, 0x40, 0xfd, 0xf8, 0x00, 0xaf, 0x90, 0xfc, 0x64, 0x02, 0xe2, 0xf4, 0x36,
-	0x9b, 0xde, 0x2a, 0xd4, 0xea, 0x0d, 0x65, 0xd4, 0x0d, 0xa5, 0x20, 0xc1, 0x00, 0xde, 0x4c, 0x7b,
-	0x6f, 0x79, 0x33, 0xed, 0x01, 0xbc, 0x99, 0xf6, 0x00, 0xde, 0x4c, 0x7b, 0x00, 0x6f, 0xa6, 0x3d,
-	0x80, 0x37, 0xd3, 0x1e, 0xc0, 0x9b, 0x69, 0x0f, 0xe0, 0xcd, 0xb4, 0x07, 0xf0, 0x66, 0xda, 0x03,
-	0xdc, 0x66, 0x5a, 0x40, 0x78, 0x33, 0x2d, 0x20, 0xbc, 0x99, 0x16, 0x10, 0xde, 0x4c, 0x0b, 0x08,
-	0x6f, 0xa6, 0x05, 0x64, 0xa4, 0xa1, 0xd2, 0x5f, 0x4b, 0xbf, 0x7c, 0x9e, 0x97, 0x3e, 0x79, 0x9e,
-	0x97, 0x7e, 0xf5, 0x3c, 0x1f, 0xf9, 0xed, 0xf3, 0x7c, 0xe4, 0x77, 0xcf, 0xf3, 0x91, 0xdf, 0x3f,
-	0xcf, 0x47, 0x3e, 0x7d, 0x9e, 0x97, 0x3e, 0x1c, 0xe6, 0xa5, 0x8f, 0x86, 0xf9, 0xc8, 0xcf, 0x86,
-	0x79, 0xe9, 0xe7, 0xc3, 0x7c, 0xe4, 0xe3, 0x61, 0x3e, 0xf2, 0x8b, 0x61, 0x3e, 0xf2, 0xcb, 0x61,
-	0x5e, 0xfa, 0x64, 0x98, 0x97, 0x7e, 0x35, 0xcc, 0x47, 0x7e, 0x3b, 0xcc, 0x4b, 0xbf, 0x1b, 0xe6,
-	0x23, 0xbf, 0x1f, 0xe6, 0xa5, 0x4f, 0x87, 0xf9, 0xc8, 0x87, 0x2f, 0xf2, 0x91, 0x8f, 0x5e, 0xe4,
-	0xa5, 0x1f, 0xbe, 0xc8, 0x47, 0xfe, 0xf2, 0x45, 0x5e, 0xfa, 0xe9, 0x8b, 0x7c, 0xe4, 0x67, 0x2f,
-	0xf2, 0x91, 0x9f, 0xbf, 0xc8, 0x4b, 0x1f, 0xbf, 0xc8, 0x4b, 0xbf, 0x78, 0x91, 0x97, 0xbe, 0xf7,
-	0xb5, 0xab, 0xfe, 0x37, 0x91, 0x63, 0xf6, 0xf7, 0xf7, 0x27, 0xe9, 0xd5, 0xfa, 0xca, 0xff, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x9a, 0x13, 0x20, 0x07, 0xda, 0x3d, 0x00, 0x00,
+	golang_proto.RegisterFile("lorawan-stack/api/lorawan.proto", fileDescriptor_lorawan_513af5d34a6bab39)
+}
+
+var fileDescriptor_lorawan_513af5d34a6bab39 = []byte{
+	// 5238 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5b, 0x4f, 0x6c, 0x23, 0xd7,
+	0x79, 0xe7, 0x88, 0xa4, 0x48, 0x7d, 0x24, 0xc5, 0xd1, 0x93, 0x76, 0x57, 0xcb, 0xd8, 0x94, 0xa3,
+	0x75, 0xd1, 0xcd, 0x3a, 0xab, 0x95, 0x28, 0xad, 0x2c, 0xc7, 0xf9, 0xc7, 0x7f, 0xb2, 0xe8, 0x95,
+	0x48, 0x65, 0x28, 0xed, 0x7a, 0x83, 0x04, 0xd3, 0x11, 0x67, 0x28, 0xd1, 0x22, 0x67, 0xe8, 0xe1,
+	0x68, 0x45, 0xf5, 0x50, 0x18, 0xe8, 0xc5, 0x40, 0x0f, 0x0d, 0x0a, 0x04, 0x68, 0xd0, 0x43, 0x82,
+	0xb6, 0x87, 0x00, 0x45, 0xd1, 0x00, 0x45, 0x51, 0x1f, 0x53, 0x20, 0x87, 0xa0, 0x27, 0x17, 0xbd,
+	0x04, 0x29, 0xaa, 0x64, 0xb9, 0x97, 0xf4, 0xd2, 0xe6, 0xe8, 0x63, 0xf1, 0xbd, 0xf7, 0x86, 0xf3,
+	0x66, 0x46, 0xbb, 0xd2, 0xda, 0xce, 0x49, 0xf3, 0x7e, 0xf3, 0xde, 0xf7, 0xbe, 0xff, 0xdf, 0xfb,
+	0xde, 0x50, 0xb0, 0xd0, 0xb5, 0x6c, 0xed, 0x54, 0x33, 0xef, 0x0e, 0x1c, 0xad, 0x75, 0x7c, 0x4f,
+	0xeb, 0x77, 0xee, 0x71, 0x64, 0xa9, 0x6f, 0x5b, 0x8e, 0x45, 0xa6, 0x1d, 0xc7, 0x5c, 0x72, 0xa1,
+	0x27, 0xab, 0xb9, 0xbb, 0x87, 0x1d, 0xe7, 0xe8, 0xe4, 0x60, 0xa9, 0x65, 0xf5, 0xee, 0x1d, 0x5a,
+	0x87, 0xd6, 0x3d, 0x3a, 0xed, 0xe0, 0xa4, 0x4d, 0x47, 0x74, 0x40, 0x9f, 0xd8, 0xf2, 0xdc, 0xba,
+	0x30, 0xbd, 0x77, 0xda, 0x71, 0x8e, 0xad, 0xd3, 0x7b, 0x87, 0xd6, 0x5d, 0xfa, 0xf2, 0xee, 0x13,
+	0xad, 0xdb, 0xd1, 0x35, 0xc7, 0xb2, 0x07, 0xf7, 0xc6, 0x8f, 0x7c, 0xdd, 0x2b, 0x87, 0x96, 0x75,
+	0xd8, 0x35, 0x3c, 0xea, 0x03, 0xc7, 0x3e, 0x69, 0x39, 0xfc, 0xed, 0x42, 0xf0, 0xad, 0xd3, 0xe9,
+	0x19, 0x03, 0x47, 0xeb, 0xf5, 0xf9, 0x84, 0x5b, 0x61, 0xb1, 0x3a, 0xba, 0x61, 0x3a, 0x9d, 0x76,
+	0xc7, 0xb0, 0x07, 0x6c, 0xd2, 0xe2, 0xbf, 0x47, 0x21, 0xb1, 0x63, 0x0c, 0x06, 0xda, 0xa1, 0x41,
+	0x56, 0x21, 0xde, 0x53, 0x8f, 0x74, 0x7b, 0x5e, 0x7a, 0x4d, 0xba, 0x9d, 0x2a, 0xcc, 0x2d, 0xf9,
+	0xc5, 0x5e, 0xda, 0xd9, 0xaa, 0x28, 0xa5, 0xe4, 0x2f, 0xcf, 0x17, 0x22, 0x9f, 0x9c, 0x2f, 0x48,
+	0x4a, 0xac, 0xb7, 0xa5, 0xdb, 0xe4, 0x26, 0x44, 0x7b, 0x9d, 0xd6, 0xfc, 0xc4, 0x6b, 0xd2, 0xed,
+	0x74, 0x29, 0x31, 0x3a, 0x5f, 0x88, 0xee, 0xd4, 0xca, 0x0a, 0x62, 0x64, 0x07, 0x52, 0x3d, 0xad,
+	0xa5, 0xf6, 0xb5, 0xb3, 0xae, 0xa5, 0xe9, 0xf3, 0x51, 0x4a, 0x35, 0x17, 0xa2, 0x5a, 0x2c, 0xef,
+	0xb2, 0x19, 0xa5, 0xe9, 0xd1, 0xf9, 0x02, 0x78, 0xe3, 0xad, 0x88, 0x02, 0x3d, 0xad, 0xc5, 0x47,
+	0xe4, 0x21, 0xcc, 0xbd, 0x6f, 0x75, 0x4c, 0xd5, 0x36, 0x3e, 0x38, 0x31, 0x06, 0xce, 0x98, 0x6e,
+	0x8c, 0xd2, 0x5d, 0x0c, 0xd2, 0x7d, 0xd7, 0xea, 0x98, 0x0a, 0x9b, 0xea, 0xd1, 0x23, 0xef, 0x87,
+	0x50, 0xd2, 0x84, 0x59, 0x4a, 0x57, 0x6b, 0xb5, 0x8c, 0xbe, 0x47, 0x36, 0x4e, 0xc9, 0x7e, 0xf9,
+	0x22, 0xb2, 0x45, 0x3a, 0xd3, 0xa3, 0x3a, 0xf3, 0x7e, 0x10, 0x24, 0xdf, 0x83, 0xeb, 0xb6, 0x71,
+	0x21, 0xbb, 0x93, 0x94, 0xee, 0xeb, 0x41, 0xba, 0x8a, 0xf1, 0xfe, 0x45, 0x0c, 0xcf, 0xd9, 0x17,
+	0xe0, 0x5f, 0x8b, 0x7d, 0xfc, 0x93, 0x85, 0x48, 0x69, 0x0a, 0x12, 0x1c, 0x78, 0x37, 0x96, 0x4c,
+	0xc8, 0xc9, 0x45, 0x0d, 0x62, 0x68, 0x23, 0xf2, 0x55, 0x98, 0xec, 0xa9, 0xce, 0x59, 0xdf, 0xa0,
+	0x96, 0x9c, 0x2e, 0x5c, 0x0b, 0xe9, 0x7c, 0xef, 0xac, 0x6f, 0x28, 0xf1, 0x1e, 0xfe, 0x21, 0x6f,
+	0x40, 0xbc, 0xa7, 0xbd, 0x6f, 0xd9, 0xd4, 0x86, 0x17, 0x4d, 0xc6, 0x97, 0x0a, 0x9b, 0xb3, 0xf8,
+	0x6b, 0x09, 0x04, 0x0b, 0xa1, 0xcb, 0xb4, 0x5f, 0xe4, 0x32, 0x9b, 0x01, 0x97, 0x69, 0xa3, 0xcb,
+	0xe4, 0x61, 0xb2, 0xad, 0xf6, 0x2d, 0xdb, 0xa1, 0x3b, 0x66, 0x4a, 0x89, 0xd1, 0x6f, 0x16, 0xa2,
+	0xf3, 0x1f, 0x4e, 0x28, 0xf1, 0xf6, 0xae, 0x65, 0x3b, 0xe4, 0x1e, 0xa4, 0xda, 0x76, 0xcf, 0xe7,
+	0x37, 0x69, 0xe6, 0x1b, 0x9b, 0xca, 0x0e, 0xdf, 0x59, 0x81, 0xb6, 0xdd, 0x73, 0xb9, 0xf8, 0x36,
+	0x64, 0x75, 0xa3, 0x65, 0xe9, 0x86, 0x1e, 0x70, 0x8a, 0x1b, 0x4b, 0x2c, 0x48, 0x96, 0xdc, 0x20,
+	0x59, 0x6a, 0xd2, 0x10, 0x52, 0xa6, 0xf9, 0x7c, 0x9f, 0x42, 0x17, 0xff, 0x53, 0x82, 0x18, 0x72,
+	0x4c, 0x1e, 0x41, 0x52, 0x37, 0x9e, 0xa8, 0x9a, 0xce, 0x25, 0x4b, 0x97, 0xbe, 0x8e, 0x32, 0xfc,
+	0xfa, 0x7c, 0x61, 0xed, 0xd0, 0x5a, 0x72, 0x8e, 0x0c, 0xe7, 0xa8, 0x63, 0x1e, 0x0e, 0x96, 0x4c,
+	0xc3, 0x39, 0xb5, 0xec, 0xe3, 0x7b, 0xfe, 0x48, 0xeb, 0x1f, 0x1f, 0xde, 0x43, 0xed, 0x0f, 0x96,
+	0x2a, 0xc6, 0x93, 0xa2, 0xae, 0xdb, 0x4a, 0x42, 0x67, 0x0f, 0x64, 0x1d, 0x45, 0x6f, 0x39, 0x76,
+	0x97, 0x8a, 0x9e, 0x0a, 0x2b, 0x7b, 0xb3, 0xec, 0xd8, 0x5d, 0x41, 0x63, 0xf1, 0x36, 0x02, 0xe4,
+	0x55, 0xd4, 0x73, 0xcb, 0x74, 0xa8, 0x32, 0x32, 0xa5, 0xe4, 0xe8, 0x37, 0x0b, 0xb1, 0xf9, 0x0f,
+	0x3f, 0x8c, 0x29, 0xb1, 0x76, 0xd9, 0x74, 0xc8, 0x35, 0x24, 0x6b, 0xf5, 0x9d, 0x01, 0x95, 0x3b,
+	0xad, 0xc4, 0xdb, 0x8d, 0xbe, 0x33, 0xe0, 0x52, 0xfd, 0x48, 0x82, 0x38, 0x25, 0x8b, 0xb1, 0xaa,
+	0x71, 0x89, 0x92, 0x2c, 0x56, 0x8b, 0x15, 0x45, 0x41, 0x8c, 0xdc, 0x85, 0x94, 0xa6, 0xdb, 0xaa,
+	0xd6, 0x3a, 0x46, 0x87, 0xa5, 0xdc, 0x25, 0x4b, 0x99, 0xd1, 0xf9, 0xc2, 0x54, 0xb1, 0xa2, 0x14,
+	0x5b, 0xc7, 0x8a, 0xf1, 0x81, 0x32, 0xa5, 0xe9, 0x36, 0x7b, 0x24, 0x32, 0x44, 0xb5, 0xd6, 0x31,
+	0xe5, 0x26, 0xa9, 0xe0, 0x23, 0xf9, 0x12, 0x4c, 0xb5, 0xd5, 0xbe, 0x61, 0xea, 0x1d, 0xf3, 0x90,
+	0x72, 0x91, 0x54, 0x92, 0xed, 0x5d, 0x36, 0x26, 0x37, 0x20, 0xd1, 0xea, 0x6a, 0x83, 0x81, 0x7a,
+	0x40, 0xc3, 0x2a, 0xa9, 0x4c, 0xd2, 0x61, 0x69, 0xf1, 0x5f, 0x27, 0x80, 0x84, 0x03, 0x95, 0xfc,
+	0x09, 0x24, 0x69, 0xec, 0x18, 0x27, 0x1d, 0xae, 0xff, 0x2a, 0xd7, 0x7f, 0xe1, 0xa5, 0xf4, 0x5f,
+	0xdd, 0xaf, 0xad, 0xaf, 0x8d, 0xce, 0x17, 0x12, 0xb8, 0x47, 0x75, 0xbf, 0xa6, 0x24, 0x90, 0x6c,
+	0xf5, 0xa4, 0x43, 0xbe, 0x0f, 0x68, 0x13, 0xba, 0x01, 0x4b, 0x5d, 0x95, 0xcf, 0xb5, 0xc1, 0x64,
+	0xc5, 0x78, 0x82, 0xf4, 0x27, 0x75, 0xe3, 0x09, 0x92, 0xff, 0x2e, 0x4c, 0x21, 0x79, 0xd3, 0x32,
+	0x5b, 0x06, 0x77, 0xe0, 0x6f, 0xf0, 0x0d, 0xee, 0xbf, 0xac, 0x07, 0xd5, 0x91, 0x88, 0x82, 0x0e,
+	0x49, 0x9f, 0xb8, 0x55, 0x7f, 0x18, 0x85, 0xb9, 0x8b, 0x72, 0x06, 0x79, 0x1b, 0x52, 0x3c, 0xf3,
+	0x08, 0x19, 0x20, 0x77, 0x71, 0xba, 0xa1, 0x69, 0x00, 0xec, 0xf1, 0x33, 0xf9, 0x2e, 0x4c, 0x9a,
+	0x86, 0xa3, 0x76, 0x74, 0xae, 0x95, 0xf2, 0x67, 0xd2, 0x4a, 0xdd, 0x70, 0x6a, 0x95, 0xd1, 0xf9,
+	0x42, 0x9c, 0x3e, 0x28, 0x71, 0xd3, 0x70, 0x6a, 0x7e, 0xa3, 0x46, 0xff, 0xd0, 0x46, 0x8d, 0xfd,
+	0x01, 0x8c, 0xfa, 0x2a, 0x70, 0x55, 0xd1, 0x48, 0x44, 0x47, 0xce, 0x28, 0x53, 0x0c, 0x29, 0x9b,
+	0x0e, 0xb7, 0xcb, 0x9f, 0xc7, 0x60, 0x26, 0x54, 0x23, 0xc8, 0x2b, 0x30, 0x65, 0x98, 0x2d, 0xfb,
+	0xac, 0xef, 0x18, 0x3a, 0xf3, 0x68, 0xc5, 0x03, 0xc8, 0xf7, 0x01, 0x28, 0x59, 0xe6, 0x2e, 0x4c,
+	0xf3, 0xdf, 0xe4, 0xac, 0xaf, 0xbf, 0x14, 0xeb, 0xb8, 0x33, 0xf3, 0x97, 0xa9, 0xf7, 0xdd, 0x47,
+	0xc1, 0xa8, 0xd1, 0x2f, 0xdc, 0xa8, 0x62, 0xa6, 0x8c, 0x7d, 0x91, 0x99, 0xb2, 0x0a, 0x29, 0xbd,
+	0xab, 0x0e, 0x0c, 0xc7, 0xc1, 0xf5, 0xbc, 0x1a, 0x87, 0xdc, 0xb8, 0xb2, 0xdd, 0xe4, 0x33, 0x84,
+	0x9c, 0x09, 0x7a, 0xd7, 0x45, 0x49, 0x01, 0x92, 0xf6, 0x50, 0xd5, 0x8d, 0xae, 0x76, 0x46, 0x2b,
+	0xef, 0x74, 0xe1, 0x46, 0x28, 0x14, 0x86, 0x15, 0x7c, 0xad, 0x24, 0x6c, 0xf6, 0x40, 0xde, 0x86,
+	0x44, 0xab, 0xad, 0x76, 0x3b, 0x03, 0x67, 0x3e, 0x41, 0xb7, 0xbd, 0x1e, 0x5c, 0x52, 0xde, 0xdc,
+	0xee, 0x0c, 0x9c, 0x12, 0xa0, 0x93, 0xb0, 0x67, 0x65, 0xb2, 0xd5, 0xc6, 0xbf, 0xdc, 0x0b, 0xfe,
+	0x51, 0x02, 0xf0, 0x78, 0x23, 0xab, 0x90, 0xb1, 0x87, 0x2b, 0xaa, 0x6e, 0xab, 0x56, 0xbb, 0x3d,
+	0x30, 0x1c, 0xea, 0x02, 0x99, 0x52, 0x76, 0x74, 0xbe, 0x90, 0x52, 0x86, 0x2b, 0x15, 0xa5, 0x41,
+	0x61, 0x25, 0x65, 0x0f, 0x57, 0x2a, 0x36, 0x1b, 0x90, 0x6f, 0xc1, 0xa4, 0x3d, 0x2c, 0xa8, 0xba,
+	0x5b, 0x98, 0x5f, 0x0d, 0x09, 0xaf, 0x39, 0x9a, 0xa2, 0x39, 0x46, 0xcd, 0xd4, 0x8d, 0x61, 0x69,
+	0x0a, 0x6d, 0xa3, 0x0c, 0x0b, 0x15, 0x45, 0x89, 0xdb, 0xc3, 0x42, 0xc5, 0x26, 0xb7, 0x20, 0x61,
+	0xf5, 0x1d, 0xd5, 0x34, 0x0e, 0x59, 0xa2, 0x66, 0xfc, 0x36, 0xfa, 0x4e, 0xdd, 0x38, 0x54, 0x26,
+	0x2d, 0xfa, 0x97, 0xf3, 0xdb, 0x03, 0x2e, 0x07, 0x59, 0x82, 0xd8, 0x8b, 0xf2, 0x06, 0x9b, 0x45,
+	0xf3, 0x06, 0x9d, 0x47, 0x08, 0xc4, 0xda, 0xac, 0x62, 0x44, 0x6f, 0x67, 0x14, 0xfa, 0x4c, 0x6e,
+	0x42, 0xb2, 0x75, 0xa4, 0xf6, 0xb4, 0xc1, 0xf1, 0x60, 0x3e, 0xfa, 0x5a, 0xf4, 0x76, 0x52, 0x49,
+	0xb4, 0x8e, 0x76, 0x70, 0xc8, 0xb7, 0x7b, 0x04, 0xe9, 0x6d, 0x4b, 0xd1, 0x5c, 0x01, 0x30, 0x3c,
+	0x0e, 0x34, 0x53, 0x3f, 0xed, 0xe8, 0xce, 0x11, 0xd3, 0x8d, 0xe2, 0x01, 0xe4, 0x2b, 0x20, 0x0f,
+	0xfa, 0xb6, 0xa1, 0x61, 0x29, 0x51, 0xdb, 0x5a, 0xcb, 0xe1, 0x67, 0x95, 0x8c, 0x92, 0x1d, 0xe3,
+	0x9b, 0x14, 0x5e, 0xbc, 0x0d, 0xa9, 0xcd, 0xe6, 0x83, 0x31, 0xdd, 0x9b, 0x90, 0x3c, 0xe8, 0x38,
+	0xaa, 0xad, 0x39, 0x06, 0x27, 0x9b, 0x38, 0xe8, 0x38, 0xf8, 0x6a, 0xf1, 0x07, 0x12, 0x24, 0xc7,
+	0xf3, 0xbe, 0x0e, 0x31, 0x94, 0x91, 0x9f, 0x62, 0x5e, 0x09, 0x0a, 0x2d, 0xf2, 0x5a, 0x4a, 0x8e,
+	0xce, 0x17, 0x62, 0x88, 0x6c, 0x45, 0x14, 0xba, 0x8a, 0x6c, 0x40, 0xb4, 0x3d, 0x38, 0xe6, 0x15,
+	0xfd, 0x4b, 0xa1, 0x8a, 0xee, 0xf1, 0xc3, 0x6a, 0xee, 0x66, 0xf3, 0xc1, 0x56, 0x44, 0xc1, 0x25,
+	0xa5, 0x34, 0x40, 0xcf, 0xd2, 0x4f, 0xba, 0x9a, 0xd3, 0xb1, 0xcc, 0xc5, 0xbf, 0x89, 0x01, 0xec,
+	0x0d, 0xc7, 0x4e, 0xf3, 0x36, 0x4c, 0xe9, 0x9a, 0xa3, 0x79, 0xdc, 0xa7, 0x0a, 0xf3, 0xcf, 0x73,
+	0x81, 0x52, 0x0c, 0xbd, 0x5f, 0x49, 0xea, 0xae, 0x44, 0x55, 0xc8, 0x8e, 0x17, 0xab, 0x1d, 0x74,
+	0x90, 0x2b, 0x79, 0x91, 0x92, 0xd1, 0xc5, 0x21, 0x59, 0x80, 0x54, 0xcb, 0xa2, 0x7a, 0xa7, 0x5c,
+	0xa0, 0x1b, 0x4d, 0x29, 0xc0, 0x20, 0xd7, 0x72, 0x6d, 0x7a, 0xc2, 0x35, 0x5b, 0x67, 0x34, 0x01,
+	0xc4, 0x14, 0x0f, 0x40, 0xfd, 0x3b, 0x43, 0xb5, 0x6f, 0x9d, 0x1a, 0x36, 0x8d, 0xe0, 0xb8, 0x92,
+	0x70, 0x86, 0xbb, 0x38, 0x24, 0xf7, 0x60, 0xb6, 0x63, 0x3e, 0x31, 0x6c, 0x47, 0xed, 0x5b, 0x5d,
+	0xcd, 0xee, 0xfc, 0x29, 0xd5, 0x01, 0x8d, 0xd1, 0xa4, 0x42, 0xd8, 0xab, 0x5d, 0xe1, 0x0d, 0x79,
+	0x1b, 0xae, 0x1d, 0x6a, 0x8e, 0x71, 0xaa, 0x9d, 0xa9, 0xad, 0x23, 0xcd, 0x34, 0x8d, 0x2e, 0x97,
+	0x2b, 0xe1, 0x3f, 0x44, 0xce, 0xf2, 0x59, 0x65, 0x36, 0x89, 0xc9, 0xf1, 0x16, 0xcc, 0xe9, 0xc6,
+	0x93, 0x4e, 0xcb, 0x08, 0xac, 0x4d, 0xfa, 0xd7, 0x12, 0x36, 0xc9, 0xb7, 0xf4, 0xab, 0x00, 0x86,
+	0xa9, 0x1d, 0x74, 0x0d, 0xb5, 0x65, 0xb7, 0xe6, 0xa7, 0xbc, 0x83, 0x51, 0x95, 0xa2, 0x65, 0xa5,
+	0x8c, 0xa9, 0x9c, 0x3e, 0xda, 0x2d, 0xd4, 0xc7, 0xb8, 0x0f, 0x9b, 0x07, 0xe6, 0xc9, 0x63, 0x80,
+	0xac, 0x41, 0x0c, 0x07, 0xf3, 0x29, 0x9e, 0xcd, 0x82, 0xa7, 0xd3, 0x3d, 0x77, 0x66, 0x29, 0xf6,
+	0x83, 0xdf, 0xe0, 0x79, 0x19, 0x67, 0xf3, 0x98, 0xf9, 0x4b, 0x09, 0x6e, 0xbe, 0xc3, 0x44, 0x2b,
+	0x9a, 0x8e, 0x61, 0x9a, 0x5a, 0xcd, 0xeb, 0xe6, 0xb0, 0xd7, 0x72, 0xb5, 0xd3, 0xd1, 0x07, 0xdc,
+	0x5d, 0x42, 0x3d, 0x11, 0x5f, 0x2f, 0x2c, 0x14, 0xd3, 0xe6, 0xa1, 0xfb, 0x76, 0x40, 0x6e, 0x41,
+	0x46, 0x63, 0x9b, 0x08, 0xce, 0x93, 0x51, 0xd2, 0x1c, 0xa4, 0x9a, 0x59, 0xfc, 0x85, 0x04, 0xa9,
+	0xfd, 0x7e, 0xb7, 0x63, 0x1e, 0xef, 0x59, 0xc7, 0x86, 0x49, 0xaa, 0x10, 0xf5, 0xf6, 0xfe, 0xca,
+	0x73, 0xf6, 0x0e, 0xf3, 0x2e, 0xb0, 0x80, 0xeb, 0xfd, 0x2a, 0x9c, 0x08, 0xaa, 0xf0, 0x0d, 0x98,
+	0x69, 0x61, 0x55, 0x33, 0x1d, 0x1b, 0x5b, 0x65, 0x95, 0xea, 0x13, 0xfd, 0x32, 0xaa, 0xc8, 0xe2,
+	0x0b, 0x54, 0x25, 0x8a, 0x61, 0x6b, 0x7a, 0xc7, 0x52, 0x5b, 0x96, 0xe9, 0x18, 0x43, 0x87, 0x7a,
+	0x68, 0x54, 0x49, 0x53, 0xb0, 0xcc, 0xb0, 0xc5, 0x3f, 0x83, 0x74, 0xc5, 0x3a, 0x35, 0x51, 0x8e,
+	0x5d, 0xcd, 0x39, 0x22, 0xb7, 0x20, 0x7d, 0x42, 0xa5, 0x52, 0x1d, 0x14, 0x8b, 0x95, 0xeb, 0xad,
+	0x88, 0x92, 0x3a, 0x11, 0x64, 0x2d, 0x42, 0xbc, 0xdd, 0x19, 0x1a, 0x3a, 0x8f, 0xfa, 0xab, 0x4b,
+	0xbb, 0x15, 0x51, 0xd8, 0xca, 0xd2, 0x24, 0xc4, 0xfa, 0x9a, 0x73, 0xb4, 0xf8, 0x5f, 0x31, 0x98,
+	0xda, 0x1b, 0xf2, 0x53, 0x1c, 0x76, 0x63, 0xf4, 0x6c, 0xfc, 0xbc, 0xd6, 0xad, 0x8c, 0x2f, 0x15,
+	0x36, 0x87, 0x94, 0x61, 0x5a, 0xe7, 0xac, 0xab, 0x48, 0x6b, 0x40, 0xd3, 0xf0, 0x05, 0x19, 0x4c,
+	0x14, 0x50, 0xc9, 0xe8, 0xc2, 0x68, 0x40, 0xd6, 0x60, 0x8a, 0x16, 0x27, 0x5a, 0x23, 0xa3, 0x2f,
+	0xae, 0x91, 0x49, 0x2c, 0x50, 0xb4, 0x48, 0x6e, 0xc3, 0x2c, 0x5d, 0x15, 0x48, 0x32, 0xb1, 0xab,
+	0x24, 0x19, 0x19, 0xa9, 0xf8, 0xf2, 0xcc, 0x2d, 0x56, 0x20, 0xbd, 0x54, 0x12, 0xa7, 0xa9, 0x24,
+	0x6d, 0x0f, 0x57, 0x36, 0xc7, 0xd9, 0x84, 0x6e, 0x59, 0x08, 0x6d, 0x39, 0x79, 0xc5, 0x2d, 0x0b,
+	0x17, 0x6c, 0x59, 0x10, 0xb6, 0x4c, 0xb8, 0x5b, 0x16, 0xbc, 0x2d, 0xbf, 0x09, 0xc9, 0xbe, 0xdd,
+	0xb1, 0xec, 0x8e, 0x73, 0x46, 0x73, 0xc5, 0x74, 0x38, 0xa6, 0xf6, 0x86, 0xcd, 0xd6, 0x91, 0xa1,
+	0x9f, 0x74, 0x8d, 0x5d, 0x3e, 0x53, 0x19, 0xaf, 0x21, 0x55, 0xc8, 0x68, 0x07, 0x03, 0xab, 0x7b,
+	0xe2, 0x18, 0xcc, 0x53, 0xa7, 0xae, 0x18, 0xf9, 0x69, 0x77, 0x19, 0xf5, 0xe3, 0x55, 0x48, 0x6a,
+	0xfa, 0x13, 0xcd, 0x6c, 0x19, 0xfa, 0x7c, 0xeb, 0xc5, 0x9d, 0xed, 0x78, 0x22, 0x4f, 0x1b, 0xff,
+	0xb3, 0x4c, 0x1b, 0xf6, 0xb2, 0xd5, 0xeb, 0x69, 0xa6, 0x4e, 0xbe, 0x05, 0xd1, 0x56, 0x47, 0xe7,
+	0xce, 0xf5, 0xfa, 0x05, 0x77, 0x31, 0x7c, 0xa2, 0xe7, 0xb1, 0xac, 0x68, 0x95, 0x6b, 0x15, 0x05,
+	0x57, 0x92, 0x2f, 0x43, 0xca, 0xd6, 0x4e, 0xc7, 0x7d, 0xf6, 0x04, 0x0f, 0x0e, 0xb0, 0xb5, 0x53,
+	0xf7, 0xb0, 0x5b, 0x82, 0x29, 0xdb, 0x18, 0xe0, 0x89, 0xd3, 0x74, 0x6f, 0x7d, 0x6e, 0x3d, 0x7f,
+	0xa7, 0x25, 0x05, 0xe7, 0xd6, 0x4c, 0x7d, 0x2b, 0xa2, 0x24, 0x6d, 0xfe, 0x4c, 0xaa, 0x78, 0xd6,
+	0x46, 0x1a, 0x2d, 0xcb, 0x6c, 0xf3, 0x6e, 0xfe, 0xf5, 0xcb, 0x88, 0x94, 0x2d, 0xb3, 0xbd, 0x15,
+	0x51, 0xd8, 0xee, 0x38, 0x20, 0x0d, 0x98, 0xa6, 0xc1, 0xd1, 0x3a, 0x32, 0x5a, 0xc7, 0xaa, 0x66,
+	0xba, 0x07, 0xc9, 0x3f, 0x7e, 0x01, 0xa9, 0xed, 0x8e, 0x79, 0x5c, 0xc6, 0xf9, 0x45, 0x13, 0xa3,
+	0x35, 0xdd, 0x15, 0xc6, 0xe4, 0x31, 0xd0, 0xb1, 0x8a, 0xcd, 0x32, 0x1e, 0x7b, 0xd8, 0x6d, 0xce,
+	0x1f, 0x5d, 0x42, 0x0e, 0xdb, 0x6c, 0xe3, 0x03, 0x76, 0x87, 0xe1, 0x8d, 0x51, 0x6d, 0x48, 0xac,
+	0xa8, 0xdb, 0xd8, 0x53, 0x8b, 0xa4, 0x91, 0xd3, 0xc4, 0x55, 0x49, 0x17, 0xcd, 0x81, 0x8f, 0x34,
+	0xe3, 0xdb, 0x25, 0x8d, 0x5c, 0x37, 0x60, 0x5a, 0x3f, 0x71, 0xce, 0xd4, 0xd6, 0x59, 0xab, 0x6b,
+	0x50, 0xbe, 0x93, 0x97, 0xaa, 0xa1, 0x72, 0xe2, 0x9c, 0x95, 0x71, 0x3e, 0xe3, 0x34, 0xad, 0x0b,
+	0x63, 0xf2, 0x18, 0x88, 0x3d, 0x54, 0xfb, 0x9a, 0xad, 0xf5, 0xf0, 0x8c, 0x7e, 0xd2, 0xa7, 0x44,
+	0x99, 0x73, 0xdf, 0x79, 0x91, 0x99, 0x86, 0xbb, 0xb8, 0xa6, 0x89, 0x4b, 0x18, 0xdd, 0xac, 0xed,
+	0x87, 0x2e, 0x20, 0x8d, 0xca, 0x80, 0x97, 0x22, 0xcd, 0x34, 0xe0, 0x23, 0xed, 0xaa, 0xc1, 0x78,
+	0xa2, 0x0e, 0x1c, 0xcd, 0x39, 0x19, 0x50, 0xb2, 0xa9, 0xcb, 0xd5, 0x60, 0x3c, 0x69, 0xd2, 0xf9,
+	0xdc, 0x1b, 0x74, 0x61, 0x4c, 0x14, 0xc8, 0x9a, 0xc6, 0xe9, 0xf8, 0x4c, 0x81, 0x3a, 0x48, 0x53,
+	0x8a, 0xb7, 0x5f, 0x40, 0xb1, 0x6e, 0x9c, 0xf2, 0x03, 0x06, 0xd3, 0x40, 0xc6, 0x14, 0x81, 0x20,
+	0x4d, 0xe4, 0x32, 0xf3, 0x12, 0x34, 0x19, 0x9b, 0x02, 0x4d, 0xe4, 0x53, 0x83, 0x69, 0xbd, 0xeb,
+	0x63, 0x73, 0xfa, 0x72, 0xc1, 0xb7, 0x3d, 0xa6, 0x4a, 0xf2, 0xe8, 0x7c, 0x21, 0x2d, 0x22, 0x54,
+	0x15, 0x5d, 0x81, 0x6d, 0xff, 0x16, 0xc8, 0x75, 0xf6, 0xea, 0x5b, 0xa0, 0x07, 0xfb, 0xb7, 0x70,
+	0xb5, 0xdd, 0x15, 0xa4, 0xf8, 0x1e, 0xe6, 0x7f, 0x4c, 0xa3, 0x78, 0x1e, 0xf5, 0xbc, 0x4e, 0xa6,
+	0xfb, 0xbc, 0xf1, 0x42, 0xd7, 0xd8, 0xa3, 0x8b, 0x04, 0xb7, 0x93, 0xed, 0x00, 0x86, 0x7e, 0xe7,
+	0x84, 0x5d, 0x7a, 0xe6, 0x52, 0xbf, 0xdb, 0x0b, 0xbb, 0xb4, 0x13, 0x70, 0x69, 0x9a, 0x10, 0x8f,
+	0x8d, 0x33, 0x9a, 0x10, 0xc9, 0x15, 0x12, 0xe2, 0xb1, 0x71, 0x36, 0x4e, 0x88, 0xec, 0x99, 0x25,
+	0x44, 0xa4, 0x41, 0x13, 0xe2, 0xec, 0x15, 0x12, 0xe2, 0xb1, 0x71, 0xe6, 0x25, 0x44, 0x3e, 0x20,
+	0x36, 0xcc, 0x62, 0x7e, 0x09, 0x8a, 0x39, 0x77, 0xa9, 0x0e, 0x8b, 0x15, 0xc5, 0x27, 0x54, 0x69,
+	0x6e, 0x74, 0xbe, 0x20, 0x07, 0x51, 0xd4, 0xac, 0xa6, 0xdb, 0x7e, 0xf1, 0x15, 0xc8, 0xf2, 0xc3,
+	0x37, 0x96, 0x40, 0xea, 0x1b, 0xd7, 0x2e, 0xf5, 0xe8, 0x0a, 0x5d, 0x81, 0xd5, 0x8f, 0x7b, 0xb4,
+	0x2e, 0x02, 0x64, 0x1f, 0xe4, 0xb6, 0x65, 0xb7, 0x30, 0x99, 0xb9, 0xb7, 0xec, 0xf3, 0xd7, 0x2f,
+	0x3e, 0x8a, 0x09, 0x44, 0x37, 0x71, 0xc9, 0xf8, 0xd6, 0x6c, 0x2b, 0xa2, 0x4c, 0xb7, 0x7d, 0x08,
+	0x31, 0xc6, 0xd7, 0xf6, 0x41, 0x0d, 0xdd, 0xa0, 0xc4, 0x97, 0x5e, 0xa8, 0x71, 0x5c, 0x18, 0x54,
+	0xc7, 0xac, 0x1d, 0x86, 0x9f, 0xb3, 0x0d, 0x2a, 0x66, 0xfe, 0xa5, 0xb7, 0x61, 0xea, 0x09, 0x6d,
+	0xc3, 0x8a, 0x15, 0xe9, 0xd3, 0x58, 0xe9, 0x5a, 0x58, 0x8c, 0xdb, 0x16, 0x95, 0xe4, 0xe6, 0xa5,
+	0x2e, 0xbd, 0x8b, 0x71, 0xd1, 0xb5, 0x9c, 0x9a, 0xd9, 0xb6, 0xb8, 0x4b, 0xf7, 0xfd, 0x10, 0x39,
+	0x80, 0x6b, 0x1e, 0x69, 0x31, 0xb1, 0xe4, 0x28, 0xf5, 0xbb, 0x57, 0xa0, 0xee, 0x4b, 0x26, 0xa4,
+	0x1f, 0x42, 0x2f, 0xde, 0x03, 0x95, 0xf4, 0xa5, 0x97, 0xdd, 0x83, 0xe9, 0x28, 0xb8, 0x07, 0xaa,
+	0xe8, 0x3d, 0x98, 0x39, 0x30, 0xb4, 0x96, 0x65, 0xba, 0x79, 0x05, 0xe9, 0xbf, 0x72, 0xa9, 0x86,
+	0x4a, 0x74, 0x0d, 0xcb, 0x20, 0xbc, 0xd8, 0x1c, 0xf8, 0x21, 0xf4, 0x7a, 0x4e, 0x19, 0x8f, 0x98,
+	0x54, 0x37, 0xaf, 0x5e, 0xea, 0xf5, 0x8c, 0x2e, 0x9e, 0x3f, 0x79, 0x6d, 0x38, 0x10, 0x81, 0x20,
+	0x4d, 0xe4, 0x35, 0xff, 0x12, 0x34, 0x79, 0x24, 0x1d, 0x88, 0x80, 0x10, 0x9d, 0x3d, 0x4b, 0xa7,
+	0x67, 0xea, 0xf9, 0x85, 0x2b, 0x46, 0xe7, 0x8e, 0xa5, 0x1b, 0x2c, 0x4f, 0xf1, 0xe8, 0xe4, 0x00,
+	0x46, 0xa7, 0x48, 0x93, 0xa6, 0xac, 0xd7, 0x2e, 0x8d, 0x4e, 0x8f, 0x28, 0xcf, 0x5b, 0xd3, 0xba,
+	0x0f, 0xc9, 0x6d, 0x42, 0xd2, 0x3d, 0x2c, 0x92, 0xaf, 0x41, 0xa6, 0xd7, 0x31, 0x2d, 0x5b, 0x7d,
+	0x62, 0xd8, 0x83, 0x8e, 0x65, 0x3e, 0xf7, 0x53, 0x17, 0x4e, 0x52, 0xd2, 0x74, 0xee, 0x43, 0x36,
+	0x35, 0xf7, 0x0e, 0x4c, 0x8d, 0xcf, 0x8b, 0x9f, 0x8b, 0xd0, 0x03, 0x48, 0x8b, 0xa7, 0x45, 0x72,
+	0x1d, 0x26, 0x7b, 0x9a, 0x7d, 0xd8, 0x31, 0xf9, 0x6d, 0x13, 0x1f, 0x61, 0xaf, 0x31, 0xbe, 0xbb,
+	0xb0, 0x4e, 0x4c, 0xc7, 0x6d, 0xa7, 0xdd, 0xab, 0x0a, 0xc4, 0x72, 0xff, 0x27, 0x81, 0x70, 0x38,
+	0xbc, 0xe8, 0x06, 0x47, 0xfa, 0x0c, 0x37, 0x38, 0xaf, 0xc3, 0xb4, 0x7b, 0x05, 0xe3, 0x6f, 0xe5,
+	0xf9, 0x45, 0x0c, 0x9b, 0xf5, 0x65, 0x48, 0xbb, 0x01, 0xd6, 0xd3, 0x06, 0xc7, 0xfc, 0xd6, 0x2e,
+	0xc5, 0xb1, 0x1d, 0x6d, 0x70, 0x4c, 0x96, 0x61, 0x4e, 0x9c, 0x42, 0x5b, 0x6a, 0xdb, 0xea, 0xf2,
+	0x7b, 0x70, 0x22, 0x4c, 0x2d, 0xb3, 0x37, 0xe4, 0x26, 0x24, 0xcd, 0x03, 0xd5, 0xb1, 0xd1, 0x4d,
+	0x27, 0xd9, 0xed, 0x9b, 0x79, 0xb0, 0x87, 0xc3, 0x77, 0x63, 0xc9, 0x98, 0x1c, 0xcf, 0xfd, 0x95,
+	0x27, 0x31, 0x6a, 0xef, 0x36, 0xc8, 0xbe, 0x1d, 0xb4, 0xd6, 0x31, 0xfb, 0x56, 0xa5, 0x4c, 0x0b,
+	0xd4, 0x8b, 0xad, 0x63, 0x72, 0x17, 0x66, 0x03, 0xba, 0xa1, 0x93, 0xe9, 0x57, 0x2b, 0x45, 0xf6,
+	0x29, 0x00, 0xa7, 0xbf, 0xc1, 0x4a, 0xbb, 0xa7, 0x03, 0xd5, 0xfb, 0x78, 0x95, 0x15, 0xf5, 0x50,
+	0x6c, 0x1d, 0xe7, 0x1e, 0x43, 0x5a, 0x3c, 0xfa, 0x92, 0x1a, 0x4c, 0xf7, 0xb4, 0xa1, 0xea, 0x9d,
+	0x9f, 0xb9, 0x19, 0x42, 0x15, 0xbc, 0x78, 0x78, 0x68, 0x1b, 0x68, 0x51, 0xdd, 0x5b, 0x9f, 0xee,
+	0x69, 0xc3, 0xf1, 0x28, 0xf7, 0x2f, 0x12, 0x64, 0x03, 0x27, 0xe0, 0xe7, 0x35, 0xb5, 0xd2, 0x67,
+	0x6b, 0x6a, 0xef, 0xc1, 0x9c, 0xbf, 0x2b, 0xe7, 0xf7, 0xcd, 0xcc, 0xe6, 0x33, 0x42, 0xdf, 0xcd,
+	0x2f, 0x99, 0x43, 0x5d, 0x70, 0x34, 0xdc, 0x05, 0xe7, 0xfe, 0x21, 0xc0, 0x37, 0x1a, 0x6b, 0x0d,
+	0x6e, 0x5c, 0xc0, 0xb7, 0x60, 0xb3, 0xd9, 0x20, 0x73, 0x68, 0x89, 0x75, 0x98, 0xbf, 0x88, 0x3f,
+	0xc1, 0x7a, 0x73, 0x21, 0x1e, 0x71, 0xdd, 0x1d, 0x98, 0xf1, 0xb1, 0x29, 0x1a, 0x50, 0x64, 0x15,
+	0x0d, 0xf8, 0x6d, 0x48, 0x8b, 0x87, 0x76, 0x32, 0x0f, 0x89, 0x03, 0xcd, 0x71, 0x0c, 0xfb, 0x6c,
+	0x7c, 0x07, 0xcc, 0x86, 0x42, 0xb8, 0x4e, 0xd0, 0xcb, 0x49, 0x3e, 0xca, 0xfd, 0xaf, 0x04, 0x19,
+	0xdf, 0x29, 0x1d, 0xd5, 0xe4, 0xbf, 0x38, 0x64, 0x94, 0xdc, 0xa0, 0x61, 0xca, 0xf7, 0xdd, 0x85,
+	0x4e, 0x04, 0xef, 0x42, 0xb7, 0x61, 0xb6, 0xd7, 0x31, 0x43, 0x86, 0x8e, 0x5e, 0xc9, 0xd0, 0xbd,
+	0x8e, 0xe9, 0x37, 0x34, 0x52, 0x43, 0xaf, 0xfc, 0x4c, 0xd7, 0x2f, 0xe8, 0x94, 0x22, 0x92, 0x7b,
+	0x4f, 0x94, 0x17, 0x75, 0x76, 0x0b, 0x32, 0x7e, 0x5d, 0x33, 0x9b, 0xa6, 0xdb, 0x82, 0xa2, 0xc9,
+	0x22, 0x64, 0xbc, 0xfd, 0x3d, 0x0b, 0xa6, 0xdc, 0xf8, 0x43, 0x63, 0x7c, 0x07, 0x7c, 0x6d, 0xc3,
+	0x17, 0xa0, 0xc8, 0x9c, 0x0a, 0xbe, 0x36, 0x01, 0x7d, 0xc3, 0x47, 0x52, 0xe0, 0x37, 0x2b, 0x92,
+	0x45, 0x96, 0x43, 0x72, 0x4d, 0x84, 0xe5, 0xca, 0x15, 0x41, 0x0e, 0x76, 0x0c, 0xe4, 0x2e, 0xc4,
+	0xd9, 0x05, 0x99, 0xf4, 0xe2, 0x0b, 0x32, 0x36, 0x2b, 0xf7, 0x6f, 0x12, 0x64, 0x03, 0x8d, 0x01,
+	0x51, 0x58, 0x22, 0x31, 0x3a, 0x76, 0xdf, 0x17, 0xe4, 0xe1, 0x8f, 0x5a, 0xb4, 0xea, 0x55, 0x6b,
+	0xca, 0x2e, 0x6b, 0x8a, 0x76, 0xb4, 0x21, 0x0e, 0x98, 0xf1, 0x30, 0xa3, 0x54, 0x3b, 0x76, 0x9f,
+	0x69, 0xea, 0x0e, 0xcc, 0xf0, 0xbb, 0x4a, 0xfd, 0xd4, 0xe8, 0x76, 0xd9, 0x1d, 0x13, 0x93, 0x29,
+	0xcb, 0x5e, 0x54, 0x10, 0xa7, 0x97, 0x48, 0x4b, 0x30, 0x3b, 0xbe, 0x2c, 0x14, 0x66, 0xb3, 0x28,
+	0x9a, 0x71, 0x5f, 0x8d, 0xe7, 0xb3, 0x6a, 0xcb, 0xbb, 0x8f, 0xcf, 0x5d, 0x6d, 0xdd, 0xfe, 0xe3,
+	0xf3, 0x10, 0xfa, 0x54, 0x82, 0x50, 0xc3, 0x41, 0x3e, 0x80, 0xeb, 0xee, 0x0f, 0x17, 0xba, 0x9d,
+	0x5e, 0xc7, 0x51, 0x8d, 0x61, 0xdf, 0x32, 0x0d, 0xd3, 0x79, 0x6e, 0x9a, 0xa6, 0xbf, 0x67, 0xd8,
+	0xc6, 0xb9, 0x55, 0x3e, 0xb5, 0x74, 0x63, 0x74, 0xbe, 0x30, 0x7b, 0xc1, 0x0b, 0x65, 0x96, 0xfd,
+	0xe4, 0xc1, 0x07, 0x8a, 0x5b, 0x52, 0x6b, 0x7b, 0x5b, 0x4e, 0xbc, 0x68, 0x4b, 0xea, 0x20, 0x17,
+	0x6d, 0xe9, 0x7b, 0xe1, 0x6e, 0xe9, 0x03, 0x73, 0x35, 0xc8, 0xf8, 0x1a, 0x22, 0xb2, 0xc1, 0xbf,
+	0x24, 0x24, 0x2e, 0xbd, 0x4f, 0xa4, 0xb7, 0xeb, 0xde, 0xd7, 0x84, 0xdc, 0xef, 0x24, 0x98, 0xf6,
+	0xf7, 0x41, 0x64, 0x21, 0xfc, 0x93, 0x81, 0x8c, 0xef, 0x67, 0x01, 0x5f, 0xdc, 0xd7, 0x24, 0x8c,
+	0x00, 0xdb, 0x70, 0xec, 0x8e, 0x31, 0x60, 0xbf, 0x65, 0x51, 0xa0, 0xa7, 0x0d, 0x15, 0x86, 0x90,
+	0x1d, 0xc8, 0xf6, 0x0d, 0xbb, 0x63, 0xe9, 0x9e, 0x4a, 0x63, 0x17, 0xdf, 0x54, 0xf2, 0x2e, 0x88,
+	0x4e, 0x1e, 0xab, 0x6e, 0xba, 0xef, 0x1b, 0xe7, 0x3e, 0x96, 0x60, 0xf6, 0x82, 0xae, 0x8c, 0x7c,
+	0x07, 0x08, 0xf2, 0x41, 0x8f, 0x62, 0x97, 0xfa, 0x0b, 0x23, 0x40, 0x8f, 0x68, 0xe3, 0x8d, 0x30,
+	0x83, 0xfa, 0x10, 0x52, 0x87, 0x19, 0x24, 0x49, 0x1b, 0xdc, 0x80, 0x3b, 0x2c, 0x3e, 0xe7, 0xb7,
+	0x17, 0x9d, 0x9e, 0x31, 0x26, 0x98, 0xed, 0x69, 0x43, 0x11, 0xc8, 0x6d, 0x85, 0x39, 0x47, 0xb3,
+	0xaf, 0xc0, 0xb5, 0xd0, 0x36, 0x42, 0xbe, 0x23, 0x01, 0x32, 0x98, 0xcd, 0x6a, 0x90, 0x0d, 0xf4,
+	0x73, 0x64, 0x1d, 0x26, 0x99, 0xa6, 0xb8, 0xcc, 0xf9, 0x20, 0x87, 0xee, 0x02, 0xa6, 0x5f, 0x85,
+	0xcf, 0xce, 0x9d, 0x01, 0x09, 0x37, 0x6f, 0xfe, 0x6c, 0x2d, 0x05, 0xcb, 0xde, 0x17, 0xe3, 0x3a,
+	0xb9, 0xa3, 0xd0, 0xd6, 0x57, 0x2e, 0x53, 0x2f, 0x77, 0x58, 0xcc, 0x6d, 0x43, 0x36, 0xd0, 0xdd,
+	0x91, 0x39, 0x31, 0xf9, 0x67, 0x78, 0x8e, 0x0f, 0x97, 0xb2, 0x89, 0x70, 0x29, 0xcb, 0xdd, 0x85,
+	0x8c, 0xaf, 0xa7, 0x7b, 0xb1, 0xb6, 0x72, 0x6b, 0xe2, 0xf4, 0xab, 0x4a, 0x98, 0xfb, 0xba, 0x9b,
+	0x1d, 0xdc, 0xfe, 0xeb, 0x65, 0x3e, 0x22, 0xe5, 0xbe, 0x01, 0xd3, 0xfe, 0xce, 0xeb, 0xa5, 0x96,
+	0x97, 0xa6, 0x20, 0xc1, 0x3f, 0x06, 0xdc, 0xf9, 0xb1, 0x04, 0x71, 0xfa, 0xd3, 0x42, 0x22, 0x43,
+	0xfa, 0xdd, 0x46, 0xad, 0xae, 0x2a, 0xd5, 0xef, 0xec, 0x57, 0x9b, 0x7b, 0x72, 0x84, 0x64, 0x21,
+	0x45, 0x91, 0x62, 0xb9, 0x5c, 0xdd, 0xdd, 0x93, 0x25, 0x42, 0x60, 0x7a, 0xbf, 0x5e, 0x6e, 0xd4,
+	0x37, 0x6b, 0xca, 0x4e, 0xb5, 0xa2, 0xee, 0xef, 0xca, 0x13, 0x64, 0x0e, 0x64, 0x11, 0xab, 0x34,
+	0x1e, 0xd5, 0xe5, 0x28, 0x12, 0xf3, 0xcd, 0x8b, 0xe1, 0xda, 0xc0, 0xac, 0x38, 0x62, 0x4a, 0xd5,
+	0xb7, 0xe9, 0x24, 0x6e, 0xba, 0xab, 0x34, 0x76, 0x95, 0x5a, 0x75, 0xaf, 0xa8, 0x3c, 0x96, 0x13,
+	0x77, 0x6e, 0x40, 0x9c, 0xfe, 0x9c, 0x91, 0x4c, 0x03, 0x6c, 0x37, 0x94, 0xe2, 0xa3, 0x62, 0x5d,
+	0x55, 0x56, 0xe4, 0xc8, 0x9d, 0x16, 0xfd, 0x4a, 0xc2, 0x2b, 0x0d, 0xae, 0xdb, 0x29, 0x96, 0xd5,
+	0xfd, 0xfa, 0x83, 0x3a, 0x12, 0x8f, 0x90, 0x34, 0x24, 0x11, 0x78, 0xb8, 0xa2, 0x2e, 0xcb, 0x12,
+	0x2e, 0x76, 0x47, 0xea, 0x8a, 0x3c, 0xe1, 0x1b, 0x17, 0xe4, 0xa8, 0x30, 0x7b, 0x45, 0x8e, 0xe5,
+	0x92, 0x1f, 0xfd, 0x5d, 0x3e, 0xf2, 0xb3, 0xbf, 0xcf, 0x47, 0xee, 0xfc, 0x48, 0x02, 0xd8, 0xdd,
+	0x7a, 0x2c, 0xec, 0xb2, 0xbb, 0xf5, 0xd8, 0xbf, 0x0b, 0x02, 0xde, 0x2e, 0xee, 0x88, 0xee, 0x32,
+	0x07, 0xf2, 0x78, 0x5c, 0x50, 0x95, 0xea, 0x43, 0xb5, 0x28, 0x47, 0x2f, 0x40, 0x4b, 0x4c, 0x41,
+	0x1c, 0x5d, 0xe1, 0x33, 0xe3, 0x21, 0xac, 0x24, 0x4f, 0x0a, 0xbc, 0xfd, 0xd3, 0x04, 0x64, 0xfc,
+	0x47, 0xcc, 0x2c, 0xa4, 0x2a, 0xc5, 0xbd, 0xa2, 0xaa, 0x14, 0xf7, 0xaa, 0xea, 0x32, 0x33, 0xa1,
+	0x07, 0xac, 0xc8, 0x92, 0x1f, 0x28, 0xc8, 0x13, 0x7e, 0x60, 0x55, 0x8e, 0xfa, 0x81, 0x35, 0x39,
+	0xe6, 0x07, 0xee, 0xcb, 0x71, 0x3f, 0xb0, 0xce, 0x6c, 0xe6, 0x01, 0x6f, 0xca, 0x09, 0x3f, 0xb0,
+	0x21, 0x27, 0xfd, 0xc0, 0x5b, 0xf2, 0x14, 0x3a, 0x88, 0xc0, 0xd8, 0xb2, 0x0c, 0x01, 0x64, 0x45,
+	0x4e, 0x05, 0x90, 0x82, 0x9c, 0x0e, 0x20, 0xab, 0x72, 0x26, 0x80, 0xac, 0xc9, 0xd3, 0x01, 0xe4,
+	0xbe, 0x9c, 0x15, 0x34, 0xb6, 0x0c, 0xe0, 0xfd, 0x8a, 0x8e, 0xa4, 0x20, 0x51, 0x6e, 0xd4, 0xf7,
+	0xaa, 0xef, 0xa1, 0xb3, 0xa7, 0x20, 0xd1, 0xac, 0x36, 0x9b, 0xb5, 0x46, 0x5d, 0x96, 0x48, 0x12,
+	0x62, 0x0f, 0xaa, 0x8f, 0x9b, 0xf2, 0x04, 0xae, 0xf0, 0x7e, 0x3f, 0x83, 0x62, 0x6c, 0x52, 0x57,
+	0xad, 0x97, 0x6b, 0xd5, 0xa6, 0x1c, 0x21, 0x33, 0x90, 0x29, 0x6f, 0x15, 0xeb, 0xf5, 0xea, 0xb6,
+	0xba, 0x53, 0x6c, 0x3e, 0x68, 0xca, 0xd2, 0x9d, 0x35, 0x88, 0xd3, 0x60, 0xa3, 0xe4, 0xb7, 0x8b,
+	0xcd, 0xa6, 0x5a, 0x64, 0xe4, 0xd9, 0xa0, 0x24, 0x4b, 0xde, 0xa0, 0x2c, 0x4f, 0xe4, 0x62, 0xc8,
+	0xdd, 0x9d, 0x3e, 0x90, 0xf0, 0x57, 0x49, 0x02, 0x30, 0xb9, 0xdd, 0x78, 0xc4, 0xa2, 0x31, 0x01,
+	0xd1, 0xed, 0xc6, 0x23, 0x59, 0x42, 0x01, 0x4b, 0xd5, 0xed, 0xc6, 0x23, 0xb5, 0xde, 0x50, 0x76,
+	0x8a, 0xdb, 0xf2, 0x04, 0x4e, 0xe3, 0xcf, 0x34, 0xf2, 0x8a, 0xa5, 0xc6, 0xc3, 0xaa, 0xfb, 0x36,
+	0x86, 0xc2, 0x6c, 0xd5, 0xde, 0xd9, 0x92, 0xe3, 0xb8, 0x2f, 0x3e, 0xd1, 0x40, 0xbb, 0xf3, 0xdf,
+	0x51, 0x98, 0xbb, 0xe8, 0xe3, 0x21, 0xc9, 0xc0, 0x54, 0xb9, 0x56, 0x51, 0x95, 0xcd, 0x7d, 0xea,
+	0x42, 0xee, 0xb0, 0xda, 0xac, 0xf2, 0x1c, 0x80, 0xc3, 0xed, 0x5a, 0xfd, 0x81, 0x5a, 0xde, 0xaa,
+	0x96, 0x1f, 0xc8, 0x13, 0x34, 0xda, 0x5d, 0xac, 0x58, 0x51, 0xe4, 0xa8, 0x3b, 0xab, 0xb2, 0xbf,
+	0xf7, 0x58, 0x2d, 0x3f, 0x2e, 0x6f, 0x57, 0xe5, 0x18, 0xb9, 0x0e, 0x84, 0x12, 0x7a, 0x4f, 0xdd,
+	0x2d, 0x2a, 0xc5, 0x1d, 0xb5, 0x59, 0xdd, 0xdb, 0xdf, 0x65, 0x4e, 0x4e, 0xe7, 0x56, 0x1f, 0xaa,
+	0xcd, 0xbd, 0xe2, 0xde, 0x7e, 0x53, 0x9e, 0x24, 0xb3, 0x90, 0x45, 0xac, 0x5e, 0x7d, 0xa4, 0x72,
+	0xfd, 0xca, 0x09, 0x72, 0x03, 0x66, 0x39, 0x81, 0xbd, 0xda, 0x4e, 0xad, 0xfe, 0x0e, 0xa7, 0x90,
+	0x74, 0x29, 0xef, 0xf9, 0x29, 0x4f, 0x8d, 0x29, 0x6f, 0x8f, 0x89, 0x80, 0x27, 0xce, 0x83, 0xea,
+	0x63, 0x39, 0xe5, 0xd2, 0x2c, 0x56, 0x14, 0xdf, 0xda, 0xb4, 0xcb, 0x41, 0xa5, 0xfa, 0xb0, 0x56,
+	0xae, 0xe2, 0x86, 0x55, 0x39, 0x83, 0x91, 0x8b, 0xe0, 0x66, 0x43, 0x29, 0x57, 0x55, 0x96, 0xba,
+	0xe4, 0x69, 0x92, 0x83, 0xeb, 0x8c, 0x24, 0x4d, 0x65, 0x22, 0x99, 0xac, 0xcb, 0xda, 0x2e, 0x65,
+	0x77, 0xbb, 0xb1, 0xa7, 0xd6, 0xea, 0x9b, 0x0d, 0x59, 0x26, 0x37, 0xe1, 0x9a, 0x1f, 0x77, 0x39,
+	0x9c, 0x21, 0xd7, 0x60, 0x06, 0x5f, 0x95, 0xaa, 0xc5, 0x72, 0xa3, 0xce, 0x45, 0x95, 0x89, 0xcb,
+	0x10, 0x87, 0xd1, 0x0d, 0xe5, 0xd9, 0x00, 0x97, 0x3b, 0x8d, 0x4a, 0x55, 0x7e, 0x8d, 0x7b, 0xd4,
+	0xaf, 0x26, 0x60, 0xf6, 0x82, 0xfb, 0x0d, 0x1a, 0x1f, 0x63, 0xb3, 0xa8, 0x2b, 0x72, 0x24, 0x80,
+	0x14, 0x98, 0x8b, 0x09, 0xc8, 0x1a, 0x33, 0xb1, 0x80, 0x6c, 0xc8, 0x51, 0x74, 0x7d, 0x91, 0xce,
+	0xba, 0x1c, 0x0b, 0x40, 0xab, 0x05, 0x39, 0x1e, 0x80, 0xd6, 0xd7, 0xe4, 0x49, 0xb4, 0x8a, 0xb8,
+	0xb0, 0xb0, 0x21, 0x27, 0x02, 0x58, 0xe1, 0xfe, 0xba, 0x9c, 0x0c, 0x60, 0xf7, 0x57, 0x0a, 0xf2,
+	0x14, 0xca, 0x2b, 0xae, 0x5d, 0x2e, 0xac, 0xc9, 0x10, 0x00, 0x0b, 0xcb, 0x6b, 0x1b, 0x72, 0x2a,
+	0x00, 0xae, 0x2d, 0xbf, 0xb5, 0xce, 0x8c, 0x2a, 0x4a, 0xb1, 0xf2, 0x56, 0x81, 0x19, 0xd5, 0x27,
+	0xc8, 0xea, 0x06, 0xa6, 0x11, 0x3f, 0xba, 0x5a, 0x78, 0x73, 0x7d, 0x43, 0xce, 0x72, 0xd5, 0xfe,
+	0xb3, 0x04, 0xd3, 0xfe, 0xf3, 0x16, 0xca, 0x49, 0x6d, 0x59, 0x7d, 0x58, 0x55, 0x1e, 0xab, 0x2b,
+	0x3c, 0x37, 0x08, 0x50, 0xa1, 0x29, 0x4b, 0x01, 0x68, 0xad, 0x29, 0x4f, 0x04, 0xa0, 0x8d, 0x26,
+	0x0b, 0x1e, 0x91, 0xd6, 0x7a, 0x93, 0x57, 0x07, 0x0f, 0x5b, 0x2d, 0x34, 0x79, 0x75, 0xf0, 0xb0,
+	0xf5, 0x35, 0x1e, 0x38, 0xe2, 0xda, 0xc2, 0x46, 0x53, 0x4e, 0x70, 0xae, 0xff, 0x22, 0xea, 0x1e,
+	0x50, 0xfd, 0xe7, 0xe0, 0x59, 0xc8, 0x72, 0xd7, 0x2d, 0x37, 0xf6, 0xeb, 0x7b, 0x68, 0xca, 0x48,
+	0x08, 0x5c, 0x45, 0xb7, 0x08, 0x82, 0xeb, 0x6b, 0xac, 0xc6, 0xf9, 0x97, 0x17, 0x36, 0x58, 0x8d,
+	0xf3, 0xa1, 0x68, 0xd2, 0x58, 0x08, 0x45, 0xa3, 0xc6, 0xd1, 0xe1, 0xfd, 0x14, 0xd0, 0xac, 0x93,
+	0x21, 0x98, 0x1a, 0x36, 0x11, 0x82, 0xa9, 0x69, 0x93, 0x21, 0x98, 0x1a, 0x77, 0x0a, 0xe3, 0x2f,
+	0x20, 0x1c, 0x9a, 0x17, 0x42, 0x38, 0x33, 0x70, 0x2a, 0x84, 0xaf, 0xdf, 0xbf, 0xbf, 0x8a, 0x9e,
+	0x73, 0x03, 0x66, 0xfd, 0x74, 0x56, 0x57, 0x96, 0xdf, 0x44, 0xef, 0x09, 0xbe, 0x28, 0xac, 0x17,
+	0x56, 0xd6, 0xd0, 0x81, 0x82, 0x2f, 0xee, 0x17, 0xd6, 0x0a, 0x1b, 0x9e, 0x0f, 0x7d, 0x32, 0x01,
+	0x24, 0xdc, 0x55, 0xa0, 0x3b, 0xf0, 0x55, 0x98, 0x72, 0x68, 0x02, 0x0e, 0x40, 0x2b, 0xcc, 0x8f,
+	0x44, 0xa8, 0xc0, 0xfc, 0x48, 0x84, 0x56, 0x59, 0x84, 0x8a, 0xd0, 0x1a, 0x8b, 0x50, 0x11, 0xba,
+	0xcf, 0x22, 0x54, 0x84, 0xb0, 0x9e, 0x07, 0x20, 0xac, 0xe8, 0x01, 0x08, 0x6b, 0x7a, 0x00, 0x7a,
+	0x8b, 0x25, 0x5c, 0x1f, 0xab, 0x58, 0xd7, 0x83, 0x18, 0x56, 0xf6, 0x20, 0x86, 0xb5, 0x3d, 0x88,
+	0x61, 0x75, 0x0f, 0x62, 0xa8, 0xd7, 0x20, 0x76, 0x7f, 0xac, 0xd2, 0x5f, 0x48, 0xee, 0xef, 0xeb,
+	0xfd, 0x4d, 0xa6, 0xe0, 0xb7, 0xbb, 0x55, 0xa5, 0xd6, 0xa8, 0x50, 0xb5, 0x86, 0xc0, 0x15, 0x9f,
+	0x87, 0x73, 0x10, 0x55, 0x1b, 0x02, 0x51, 0xb9, 0x21, 0x10, 0xd5, 0x1b, 0x02, 0x51, 0xc1, 0x21,
+	0x70, 0x9d, 0xc5, 0xa9, 0x1f, 0x7c, 0x73, 0x1c, 0xa7, 0xff, 0x31, 0x01, 0xe0, 0xdd, 0x27, 0xd1,
+	0x0c, 0xca, 0xd2, 0x3b, 0x0e, 0xd5, 0x0d, 0x39, 0x42, 0x33, 0xa3, 0x00, 0xad, 0x2c, 0xb3, 0xba,
+	0xec, 0xc3, 0x90, 0xf1, 0x20, 0xb6, 0xca, 0x92, 0x8b, 0x0f, 0x5b, 0x63, 0xc9, 0xc5, 0x87, 0xad,
+	0xb3, 0xe4, 0xe2, 0xc3, 0x36, 0x78, 0xe6, 0x16, 0xb0, 0xc2, 0x32, 0xcf, 0xdc, 0x22, 0xb6, 0xc2,
+	0x33, 0xb7, 0x88, 0xad, 0x31, 0xd7, 0xf0, 0x61, 0xeb, 0xcc, 0x35, 0x7c, 0xd8, 0x9b, 0xcc, 0x35,
+	0x7c, 0xd8, 0x5b, 0xcc, 0x35, 0x44, 0x6c, 0x75, 0x99, 0xb9, 0x86, 0x0f, 0x5b, 0x65, 0xae, 0xe1,
+	0xc3, 0xd6, 0xc7, 0xae, 0xf1, 0x51, 0x14, 0x2e, 0xba, 0x2c, 0x42, 0x33, 0x60, 0xe9, 0x2f, 0x96,
+	0x1f, 0xa8, 0xdb, 0xb5, 0x9d, 0xda, 0x1e, 0xad, 0x87, 0x21, 0x90, 0xe7, 0x3e, 0x3f, 0xb8, 0xc6,
+	0x3c, 0xc3, 0x0f, 0xf2, 0xd4, 0x17, 0xa0, 0xc9, 0x53, 0x9f, 0x1f, 0xa5, 0xe5, 0x31, 0x84, 0xae,
+	0xf3, 0xcc, 0x17, 0xa0, 0x50, 0xe0, 0x99, 0x2f, 0xc0, 0xd7, 0x7d, 0x9e, 0xf9, 0xfc, 0x30, 0x2b,
+	0x95, 0xd7, 0x81, 0x04, 0x88, 0xb0, 0x6a, 0x19, 0xc2, 0x79, 0xc1, 0x0c, 0xe1, 0xbc, 0x66, 0x86,
+	0x70, 0x5e, 0x36, 0x6f, 0x50, 0x8d, 0xfa, 0xc4, 0x64, 0x95, 0x33, 0xf4, 0xc2, 0x5f, 0x3c, 0x3d,
+	0x53, 0xf8, 0xee, 0xcb, 0x44, 0x5d, 0x56, 0xaa, 0xdb, 0xc5, 0xc7, 0x41, 0x53, 0x30, 0x30, 0x60,
+	0x0a, 0x06, 0x06, 0x4c, 0xc1, 0xc0, 0x80, 0x29, 0x38, 0xcd, 0x80, 0x29, 0x18, 0x1a, 0x34, 0x05,
+	0x43, 0x83, 0xa6, 0xe0, 0x14, 0x82, 0xa6, 0xe0, 0x7c, 0x05, 0x4d, 0xc1, 0xe0, 0x90, 0x29, 0x38,
+	0x91, 0x90, 0x29, 0x38, 0x95, 0x90, 0x29, 0xb8, 0x80, 0x21, 0x53, 0x70, 0x19, 0x43, 0xa6, 0x70,
+	0xc5, 0x0c, 0x99, 0xc2, 0x95, 0x54, 0x34, 0xc5, 0x0f, 0x27, 0x20, 0xc1, 0x6f, 0xc1, 0xb1, 0x75,
+	0x55, 0xde, 0xe3, 0xb3, 0x30, 0x3d, 0x8a, 0xe3, 0x15, 0xd6, 0xda, 0x8e, 0xc7, 0x05, 0xd6, 0x40,
+	0x8f, 0xc7, 0x98, 0x57, 0xc4, 0x31, 0xe6, 0x14, 0x71, 0x8c, 0x59, 0x50, 0x1c, 0x63, 0x02, 0x14,
+	0xc7, 0x58, 0x60, 0xc4, 0x31, 0x56, 0x17, 0x71, 0x8c, 0xa5, 0x25, 0x0b, 0x29, 0x8f, 0x1f, 0xac,
+	0x2b, 0x3e, 0x00, 0x8b, 0x8a, 0x0f, 0xc0, 0x8a, 0xe2, 0x03, 0xb0, 0x9c, 0xf8, 0x00, 0xd4, 0x8f,
+	0x0f, 0xf0, 0x0a, 0xc9, 0x8f, 0x27, 0x20, 0x4e, 0x6f, 0xb3, 0xe9, 0xad, 0x42, 0xad, 0xde, 0x50,
+	0xc6, 0xdd, 0x50, 0x0a, 0x12, 0x0c, 0xe0, 0xcd, 0xb4, 0xf7, 0x96, 0x37, 0xd3, 0x1e, 0xc0, 0x9b,
+	0x69, 0x0f, 0xe0, 0xcd, 0xb4, 0x07, 0xf0, 0x66, 0xda, 0x03, 0x78, 0x33, 0xed, 0x01, 0xbc, 0x99,
+	0xf6, 0x00, 0xde, 0x4c, 0x7b, 0x00, 0x6f, 0xa6, 0x3d, 0xc0, 0x6d, 0xa6, 0x05, 0x84, 0x37, 0xd3,
+	0x02, 0xc2, 0x9b, 0x69, 0x01, 0xe1, 0xcd, 0xb4, 0x80, 0xf0, 0x66, 0x5a, 0x40, 0xc6, 0x1a, 0x2a,
+	0xfd, 0xad, 0xf4, 0xcb, 0xa7, 0x79, 0xe9, 0x93, 0xa7, 0x79, 0xe9, 0x57, 0x4f, 0xf3, 0x91, 0xdf,
+	0x3e, 0xcd, 0x47, 0x7e, 0xf7, 0x34, 0x1f, 0xf9, 0xfd, 0xd3, 0x7c, 0xe4, 0xd3, 0xa7, 0x79, 0xe9,
+	0xc3, 0x51, 0x5e, 0xfa, 0x68, 0x94, 0x8f, 0xfc, 0x74, 0x94, 0x97, 0x7e, 0x36, 0xca, 0x47, 0x3e,
+	0x1e, 0xe5, 0x23, 0x3f, 0x1f, 0xe5, 0x23, 0xbf, 0x1c, 0xe5, 0xa5, 0x4f, 0x46, 0x79, 0xe9, 0x57,
+	0xa3, 0x7c, 0xe4, 0xb7, 0xa3, 0xbc, 0xf4, 0xbb, 0x51, 0x3e, 0xf2, 0xfb, 0x51, 0x5e, 0xfa, 0x74,
+	0x94, 0x8f, 0x7c, 0xf8, 0x2c, 0x1f, 0xf9, 0xe8, 0x59, 0x5e, 0xfa, 0xc1, 0xb3, 0x7c, 0xe4, 0xaf,
+	0x9f, 0xe5, 0xa5, 0x9f, 0x3c, 0xcb, 0x47, 0x7e, 0xfa, 0x2c, 0x1f, 0xf9, 0xd9, 0xb3, 0xbc, 0xf4,
+	0xf1, 0xb3, 0xbc, 0xf4, 0xf3, 0x67, 0x79, 0xe9, 0xbb, 0x5f, 0xbd, 0xea, 0x3f, 0x28, 0x39, 0x66,
+	0xff, 0xe0, 0x60, 0x92, 0x5e, 0xad, 0xaf, 0xfe, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x51, 0xaa,
+	0x7d, 0x50, 0x2d, 0x3e, 0x00, 0x00,
 }
@@ -410,11 +410,96 @@ func (dst *DownlinkMessage) SetFields(src *DownlinkMessage, paths ...string) err
 
 var TxAcknowledgmentFieldPathsNested = []string{
 	"correlation_ids",
+	"downlink_message",
+	"downlink_message.correlation_ids",
+	"downlink_message.end_device_ids",
+	"downlink_message.end_device_ids.application_ids",
+	"downlink_message.end_device_ids.application_ids.application_id",
+	"downlink_message.end_device_ids.dev_addr",
+	"downlink_message.end_device_ids.dev_eui",
+	"downlink_message.end_device_ids.device_id",
+	"downlink_message.end_device_ids.join_eui",
+	"downlink_message.payload",
+	"downlink_message.payload.Payload",
+	"downlink_message.payload.Payload.join_accept_payload",
+	"downlink_message.payload.Payload.join_accept_payload.cf_list",
+	"downlink_message.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"downlink_message.payload.Payload.join_accept_payload.cf_list.freq",
+	"downlink_message.payload.Payload.join_accept_payload.cf_list.type",
+	"downlink_message.payload.Payload.join_accept_payload.dev_addr",
+	"downlink_message.payload.Payload.join_accept_payload.dl_settings",
+	"downlink_message.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"downlink_message.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"downlink_message.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"downlink_message.payload.Payload.join_accept_payload.encrypted",
+	"downlink_message.payload.Payload.join_accept_payload.join_nonce",
+	"downlink_message.payload.Payload.join_accept_payload.net_id",
+	"downlink_message.payload.Payload.join_accept_payload.rx_delay",
+	"downlink_message.payload.Payload.join_request_payload",
+	"downlink_message.payload.Payload.join_request_payload.dev_eui",
+	"downlink_message.payload.Payload.join_request_payload.dev_nonce",
+	"downlink_message.payload.Payload.join_request_payload.join_eui",
+	"downlink_message.payload.Payload.mac_payload",
+	"downlink_message.payload.Payload.mac_payload.decoded_payload",
+	"downlink_message.payload.Payload.mac_payload.f_hdr",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_opts",
+	"downlink_message.payload.Payload.mac_payload.f_port",
+	"downlink_message.payload.Payload.mac_payload.frm_payload",
+	"downlink_message.payload.Payload.rejoin_request_payload",
+	"downlink_message.payload.Payload.rejoin_request_payload.dev_eui",
+	"downlink_message.payload.Payload.rejoin_request_payload.join_eui",
+	"downlink_message.payload.Payload.rejoin_request_payload.net_id",
+	"downlink_message.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"downlink_message.payload.Payload.rejoin_request_payload.rejoin_type",
+	"downlink_message.payload.m_hdr",
+	"downlink_message.payload.m_hdr.m_type",
+	"downlink_message.payload.m_hdr.major",
+	"downlink_message.payload.mic",
+	"downlink_message.raw_payload",
+	"downlink_message.settings",
+	"downlink_message.settings.request",
+	"downlink_message.settings.request.absolute_time",
+	"downlink_message.settings.request.advanced",
+	"downlink_message.settings.request.class",
+	"downlink_message.settings.request.downlink_paths",
+	"downlink_message.settings.request.priority",
+	"downlink_message.settings.request.rx1_data_rate_index",
+	"downlink_message.settings.request.rx1_delay",
+	"downlink_message.settings.request.rx1_frequency",
+	"downlink_message.settings.request.rx2_data_rate_index",
+	"downlink_message.settings.request.rx2_frequency",
+	"downlink_message.settings.scheduled",
+	"downlink_message.settings.scheduled.coding_rate",
+	"downlink_message.settings.scheduled.data_rate",
+	"downlink_message.settings.scheduled.data_rate.modulation",
+	"downlink_message.settings.scheduled.data_rate.modulation.fsk",
+	"downlink_message.settings.scheduled.data_rate.modulation.fsk.bit_rate",
+	"downlink_message.settings.scheduled.data_rate.modulation.lora",
+	"downlink_message.settings.scheduled.data_rate.modulation.lora.bandwidth",
+	"downlink_message.settings.scheduled.data_rate.modulation.lora.spreading_factor",
+	"downlink_message.settings.scheduled.data_rate_index",
+	"downlink_message.settings.scheduled.device_channel_index",
+	"downlink_message.settings.scheduled.enable_crc",
+	"downlink_message.settings.scheduled.frequency",
+	"downlink_message.settings.scheduled.gateway_channel_index",
+	"downlink_message.settings.scheduled.invert_polarization",
+	"downlink_message.settings.scheduled.time",
+	"downlink_message.settings.scheduled.timestamp",
+	"downlink_message.settings.scheduled.tx_power",
 	"result",
 }
 
 var TxAcknowledgmentFieldPathsTopLevel = []string{
 	"correlation_ids",
+	"downlink_message",
 	"result",
 }
 
@@ -440,6 +525,27 @@ func (dst *TxAcknowledgment) SetFields(src *TxAcknowledgment, paths ...string) e
 				var zero TxAcknowledgment_Result
 				dst.Result = zero
 			}
+		case "downlink_message":
+			if len(subs) > 0 {
+				newDst := dst.DownlinkMessage
+				if newDst == nil {
+					newDst = &DownlinkMessage{}
+					dst.DownlinkMessage = newDst
+				}
+				var newSrc *DownlinkMessage
+				if src != nil {
+					newSrc = src.DownlinkMessage
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DownlinkMessage = src.DownlinkMessage
+				} else {
+					dst.DownlinkMessage = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -719,8 +825,10 @@ var ApplicationDownlinkFieldPathsNested = []string{
 	"class_b_c.absolute_time",
 	"class_b_c.gateways",
 	"confirmed",
+	"confirmed_retries",
 	"correlation_ids",
 	"decoded_payload",
+	"expires_at",
 	"f_cnt",
 	"f_port",
 	"frm_payload",
@@ -731,8 +839,10 @@ var ApplicationDownlinkFieldPathsNested = []string{
 var ApplicationDownlinkFieldPathsTopLevel = []string{
 	"class_b_c",
 	"confirmed",
+	"confirmed_retries",
 	"correlation_ids",
 	"decoded_payload",
+	"expires_at",
 	"f_cnt",
 	"f_port",
 	"frm_payload",
@@ -842,6 +952,25 @@ func (dst *ApplicationDownlink) SetFields(src *ApplicationDownlink, paths ...str
 			} else {
 				dst.CorrelationIDs = nil
 			}
+		case "confirmed_retries":
+			if len(subs) > 0 {
+				return fmt.Errorf("'confirmed_retries' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ConfirmedRetries = src.ConfirmedRetries
+			} else {
+				var zero uint32
+				dst.ConfirmedRetries = zero
+			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -917,14 +1046,55 @@ func (dst *ApplicationDownlinks) SetFields(src *ApplicationDownlinks, paths ...s
 	return nil
 }
 
+var ApplicationDownlinkPolicyFieldPathsNested = []string{
+	"expiry",
+	"max_confirmed_retries",
+}
+
+var ApplicationDownlinkPolicyFieldPathsTopLevel = []string{
+	"expiry",
+	"max_confirmed_retries",
+}
+
+func (dst *ApplicationDownlinkPolicy) SetFields(src *ApplicationDownlinkPolicy, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "max_confirmed_retries":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_confirmed_retries' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxConfirmedRetries = src.MaxConfirmedRetries
+			} else {
+				dst.MaxConfirmedRetries = nil
+			}
+		case "expiry":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expiry' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Expiry = src.Expiry
+			} else {
+				dst.Expiry = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 var ApplicationDownlinkFailedFieldPathsNested = []string{
 	"downlink",
 	"downlink.class_b_c",
 	"downlink.class_b_c.absolute_time",
 	"downlink.class_b_c.gateways",
 	"downlink.confirmed",
+	"downlink.confirmed_retries",
 	"downlink.correlation_ids",
 	"downlink.decoded_payload",
+	"downlink.expires_at",
 	"downlink.f_cnt",
 	"downlink.f_port",
 	"downlink.frm_payload",
@@ -996,6 +1166,106 @@ func (dst *ApplicationDownlinkFailed) SetFields(src *ApplicationDownlinkFailed,
 	return nil
 }
 
+var ApplicationDownlinkQueueResultFieldPathsNested = []string{
+	"correlation_ids",
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"error",
+	"error.attributes",
+	"error.cause",
+	"error.cause.attributes",
+	"error.cause.correlation_id",
+	"error.cause.message_format",
+	"error.cause.name",
+	"error.cause.namespace",
+	"error.correlation_id",
+	"error.message_format",
+	"error.name",
+	"error.namespace",
+	"operation",
+}
+
+var ApplicationDownlinkQueueResultFieldPathsTopLevel = []string{
+	"correlation_ids",
+	"end_device_ids",
+	"error",
+	"operation",
+}
+
+func (dst *ApplicationDownlinkQueueResult) SetFields(src *ApplicationDownlinkQueueResult, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				newDst := &dst.EndDeviceIdentifiers
+				var newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIdentifiers
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIdentifiers = src.EndDeviceIdentifiers
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIdentifiers = zero
+				}
+			}
+		case "operation":
+			if len(subs) > 0 {
+				return fmt.Errorf("'operation' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Operation = src.Operation
+			} else {
+				var zero ApplicationDownlinkQueueResult_Operation
+				dst.Operation = zero
+			}
+		case "correlation_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'correlation_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CorrelationIDs = src.CorrelationIDs
+			} else {
+				dst.CorrelationIDs = nil
+			}
+		case "error":
+			if len(subs) > 0 {
+				newDst := dst.Error
+				if newDst == nil {
+					newDst = &ErrorDetails{}
+					dst.Error = newDst
+				}
+				var newSrc *ErrorDetails
+				if src != nil {
+					newSrc = src.Error
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Error = src.Error
+				} else {
+					dst.Error = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 var ApplicationInvalidatedDownlinksFieldPathsNested = []string{
 	"downlinks",
 	"last_f_cnt_down",
@@ -1045,14 +1315,17 @@ var ApplicationUpFieldPathsNested = []string{
 	"end_device_ids.dev_eui",
 	"end_device_ids.device_id",
 	"end_device_ids.join_eui",
+	"received_at",
 	"up",
 	"up.downlink_ack",
 	"up.downlink_ack.class_b_c",
 	"up.downlink_ack.class_b_c.absolute_time",
 	"up.downlink_ack.class_b_c.gateways",
 	"up.downlink_ack.confirmed",
+	"up.downlink_ack.confirmed_retries",
 	"up.downlink_ack.correlation_ids",
 	"up.downlink_ack.decoded_payload",
+	"up.downlink_ack.expires_at",
 	"up.downlink_ack.f_cnt",
 	"up.downlink_ack.f_port",
 	"up.downlink_ack.frm_payload",
@@ -1064,8 +1337,10 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_failed.downlink.class_b_c.absolute_time",
 	"up.downlink_failed.downlink.class_b_c.gateways",
 	"up.downlink_failed.downlink.confirmed",
+	"up.downlink_failed.downlink.confirmed_retries",
 	"up.downlink_failed.downlink.correlation_ids",
 	"up.downlink_failed.downlink.decoded_payload",
+	"up.downlink_failed.downlink.expires_at",
 	"up.downlink_failed.downlink.f_cnt",
 	"up.downlink_failed.downlink.f_port",
 	"up.downlink_failed.downlink.frm_payload",
@@ -1088,8 +1363,10 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_nack.class_b_c.absolute_time",
 	"up.downlink_nack.class_b_c.gateways",
 	"up.downlink_nack.confirmed",
+	"up.downlink_nack.confirmed_retries",
 	"up.downlink_nack.correlation_ids",
 	"up.downlink_nack.decoded_payload",
+	"up.downlink_nack.expires_at",
 	"up.downlink_nack.f_cnt",
 	"up.downlink_nack.f_port",
 	"up.downlink_nack.frm_payload",
@@ -1103,8 +1380,10 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_queued.class_b_c.absolute_time",
 	"up.downlink_queued.class_b_c.gateways",
 	"up.downlink_queued.confirmed",
+	"up.downlink_queued.confirmed_retries",
 	"up.downlink_queued.correlation_ids",
 	"up.downlink_queued.decoded_payload",
+	"up.downlink_queued.expires_at",
 	"up.downlink_queued.f_cnt",
 	"up.downlink_queued.f_port",
 	"up.downlink_queued.frm_payload",
@@ -1115,8 +1394,10 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_sent.class_b_c.absolute_time",
 	"up.downlink_sent.class_b_c.gateways",
 	"up.downlink_sent.confirmed",
+	"up.downlink_sent.confirmed_retries",
 	"up.downlink_sent.correlation_ids",
 	"up.downlink_sent.decoded_payload",
+	"up.downlink_sent.expires_at",
 	"up.downlink_sent.f_cnt",
 	"up.downlink_sent.f_port",
 	"up.downlink_sent.frm_payload",
@@ -1168,6 +1449,7 @@ var ApplicationUpFieldPathsNested = []string{
 var ApplicationUpFieldPathsTopLevel = []string{
 	"correlation_ids",
 	"end_device_ids",
+	"received_at",
 	"up",
 }
 
@@ -1201,6 +1483,15 @@ func (dst *ApplicationUp) SetFields(src *ApplicationUp, paths ...string) error {
 			} else {
 				dst.CorrelationIDs = nil
 			}
+		case "received_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'received_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ReceivedAt = src.ReceivedAt
+			} else {
+				dst.ReceivedAt = nil
+			}
 
 		case "up":
 			if len(subs) == 0 && src == nil {
@@ -45,12 +45,15 @@ const (
 	PayloadFormatter_FORMATTER_NONE PayloadFormatter = 0
 	// Use payload formatter for the end device type from a repository.
 	PayloadFormatter_FORMATTER_REPOSITORY PayloadFormatter = 1
-	// gRPC service payload formatter. The parameter is the host:port of the service.
+	// gRPC service payload formatter. The parameter is the host:port of the service, or a grpc:// or grpcs:// URL
+	// of the service with optional timeout and parameter query parameters.
 	PayloadFormatter_FORMATTER_GRPC_SERVICE PayloadFormatter = 2
 	// Custom payload formatter that executes Javascript code. The parameter is a JavaScript filename.
 	PayloadFormatter_FORMATTER_JAVASCRIPT PayloadFormatter = 3
 	// CayenneLPP payload formatter.
 	PayloadFormatter_FORMATTER_CAYENNELPP PayloadFormatter = 4
+	// Declarative binary schema payload formatter. The parameter is the schema in YAML or JSON.
+	PayloadFormatter_FORMATTER_SCHEMA PayloadFormatter = 5
 )
 
 var PayloadFormatter_name = map[int32]string{
@@ -59,6 +62,7 @@ var PayloadFormatter_name = map[int32]string{
 	2: "FORMATTER_GRPC_SERVICE",
 	3: "FORMATTER_JAVASCRIPT",
 	4: "FORMATTER_CAYENNELPP",
+	5: "FORMATTER_SCHEMA",
 }
 var PayloadFormatter_value = map[string]int32{
 	"FORMATTER_NONE":         0,
@@ -66,10 +70,11 @@ var PayloadFormatter_value = map[string]int32{
 	"FORMATTER_GRPC_SERVICE": 2,
 	"FORMATTER_JAVASCRIPT":   3,
 	"FORMATTER_CAYENNELPP":   4,
+	"FORMATTER_SCHEMA":       5,
 }
 
 func (PayloadFormatter) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_messages_e6e0b619399f62ae, []int{0}
+	return fileDescriptor_messages_c2825e53bb84f262, []int{0}
 }
 
 type TxAcknowledgment_Result int32
@@ -110,7 +115,27 @@ var TxAcknowledgment_Result_value = map[string]int32{
 }
 
 func (TxAcknowledgment_Result) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_messages_e6e0b619399f62ae, []int{2, 0}
+	return fileDescriptor_messages_c2825e53bb84f262, []int{2, 0}
+}
+
+type ApplicationDownlinkQueueResult_Operation int32
+
+const (
+	ApplicationDownlinkQueueResult_PUSH    ApplicationDownlinkQueueResult_Operation = 0
+	ApplicationDownlinkQueueResult_REPLACE ApplicationDownlinkQueueResult_Operation = 1
+)
+
+var ApplicationDownlinkQueueResult_Operation_name = map[int32]string{
+	0: "PUSH",
+	1: "REPLACE",
+}
+var ApplicationDownlinkQueueResult_Operation_value = map[string]int32{
+	"PUSH":    0,
+	"REPLACE": 1,
+}
+
+func (ApplicationDownlinkQueueResult_Operation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_messages_c2825e53bb84f262, []int{10, 0}
 }
 
 // Uplink message from the end device to the network
@@ -130,7 +155,7 @@ type UplinkMessage struct {
 func (m *UplinkMessage) Reset()      { *m = UplinkMessage{} }
 func (*UplinkMessage) ProtoMessage() {}
 func (*UplinkMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_e6e0b619399f62ae, []int{0}
+	return fileDescriptor_messages_c2825e53bb84f262, []int{0}
 }
 func (m *UplinkMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,7 +243,7 @@ type DownlinkMessage struct {
 func (m *DownlinkMessage) Reset()      { *m = DownlinkMessage{} }
 func (*DownlinkMessage) ProtoMessage() {}
 func (*DownlinkMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_e6e0b619399f62ae, []int{1}
+	return fileDescriptor_messages_c2825e53bb84f262, []int{1}
 }
 func (m *DownlinkMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -388,16 +413,18 @@ func _DownlinkMessage_OneofSizer(msg proto.Message) (n int) {
 }
 
 type TxAcknowledgment struct {
-	CorrelationIDs       []string                `protobuf:"bytes,1,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
-	Result               TxAcknowledgment_Result `protobuf:"varint,2,opt,name=result,proto3,enum=ttn.lorawan.v3.TxAcknowledgment_Result" json:"result,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	CorrelationIDs []string                `protobuf:"bytes,1,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
+	Result         TxAcknowledgment_Result `protobuf:"varint,2,opt,name=result,proto3,enum=ttn.lorawan.v3.TxAcknowledgment_Result" json:"result,omitempty"`
+	// The acknowledged downlink message. Set by the Gateway Server.
+	DownlinkMessage      *DownlinkMessage `protobuf:"bytes,3,opt,name=downlink_message,json=downlinkMessage,proto3" json:"downlink_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *TxAcknowledgment) Reset()      { *m = TxAcknowledgment{} }
 func (*TxAcknowledgment) ProtoMessage() {}
 func (*TxAcknowledgment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_e6e0b619399f62ae, []int{2}
+	return fileDescriptor_messages_c2825e53bb84f262, []int{2}
 }
 func (m *TxAcknowledgment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -440,6 +467,13 @@ func (m *TxAcknowledgment) GetResult() TxAcknowledgment_Result {
 	return TxAcknowledgment_SUCCESS
 }
 
+func (m *TxAcknowledgment) GetDownlinkMessage() *DownlinkMessage {
+	if m != nil {
+		return m.DownlinkMessage
+	}
+	return nil
+}
+
 type ApplicationUplink struct {
 	// Join Server issued identifier for the session keys used by this uplink.
 	SessionKeyID         []byte        `protobuf:"bytes,1,opt,name=session_key_id,json=sessionKeyId,proto3" json:"session_key_id,omitempty"`
@@ -456,7 +490,7 @@ type ApplicationUplink struct {
 func (m *ApplicationUplink) Reset()      { *m = ApplicationUplink{} }
 func (*ApplicationUplink) ProtoMessage() {}
 func (*ApplicationUplink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_e6e0b619399f62ae, []int{3}
+	return fileDescriptor_messages_c2825e53bb84f262, []int{3}
 }
 func (m *ApplicationUplink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -545,7 +579,7 @@ type ApplicationLocation struct {
 func (m *ApplicationLocation) Reset()      { *m = ApplicationLocation{} }
 func (*ApplicationLocation) ProtoMessage() {}
 func (*ApplicationLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_e6e0b619399f62ae, []int{4}
+	return fileDescriptor_messages_c2825e53bb84f262, []int{4}
 }
 func (m *ApplicationLocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -605,7 +639,7 @@ type ApplicationJoinAccept struct {
 func (m *ApplicationJoinAccept) Reset()      { *m = ApplicationJoinAccept{} }
 func (*ApplicationJoinAccept) ProtoMessage() {}
 func (*ApplicationJoinAccept) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_e6e0b619399f62ae, []int{5}
+	return fileDescriptor_messages_c2825e53bb84f262, []int{5}
 }
 func (m *ApplicationJoinAccept) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -675,16 +709,22 @@ type ApplicationDownlink struct {
 	// If not set, this downlink message may be transmitted in class A, B and C.
 	ClassBC *ApplicationDownlink_ClassBC `protobuf:"bytes,7,opt,name=class_b_c,json=classBC,proto3" json:"class_b_c,omitempty"`
 	// Priority for scheduling the downlink message.
-	Priority             TxSchedulePriority `protobuf:"varint,8,opt,name=priority,proto3,enum=ttn.lorawan.v3.TxSchedulePriority" json:"priority,omitempty"`
-	CorrelationIDs       []string           `protobuf:"bytes,9,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Priority       TxSchedulePriority `protobuf:"varint,8,opt,name=priority,proto3,enum=ttn.lorawan.v3.TxSchedulePriority" json:"priority,omitempty"`
+	CorrelationIDs []string           `protobuf:"bytes,9,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
+	// Number of times this confirmed downlink message has been retried after it was not acknowledged by the end device.
+	// Set by the Application Server.
+	ConfirmedRetries uint32 `protobuf:"varint,10,opt,name=confirmed_retries,json=confirmedRetries,proto3" json:"confirmed_retries,omitempty"`
+	// Time after which the downlink message expires and is dropped from the downlink queue.
+	// If null, the Application Server sets the expiry based on the downlink policy of the end device.
+	ExpiresAt            *time.Time `protobuf:"bytes,11,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *ApplicationDownlink) Reset()      { *m = ApplicationDownlink{} }
 func (*ApplicationDownlink) ProtoMessage() {}
 func (*ApplicationDownlink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_e6e0b619399f62ae, []int{6}
+	return fileDescriptor_messages_c2825e53bb84f262, []int{6}
 }
 func (m *ApplicationDownlink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -776,6 +816,20 @@ func (m *ApplicationDownlink) GetCorrelationIDs() []string {
 	return nil
 }
 
+func (m *ApplicationDownlink) GetConfirmedRetries() uint32 {
+	if m != nil {
+		return m.ConfirmedRetries
+	}
+	return 0
+}
+
+func (m *ApplicationDownlink) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
 type ApplicationDownlink_ClassBC struct {
 	// Possible gateway identifiers and antenna index to use for this downlink message.
 	// The Network Server selects one of these gateways for downlink, based on connectivity, signal quality, channel utilization and an available slot.
@@ -794,7 +848,7 @@ type ApplicationDownlink_ClassBC struct {
 func (m *ApplicationDownlink_ClassBC) Reset()      { *m = ApplicationDownlink_ClassBC{} }
 func (*ApplicationDownlink_ClassBC) ProtoMessage() {}
 func (*ApplicationDownlink_ClassBC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_e6e0b619399f62ae, []int{6, 0}
+	return fileDescriptor_messages_c2825e53bb84f262, []int{6, 0}
 }
 func (m *ApplicationDownlink_ClassBC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -846,7 +900,7 @@ type ApplicationDownlinks struct {
 func (m *ApplicationDownlinks) Reset()      { *m = ApplicationDownlinks{} }
 func (*ApplicationDownlinks) ProtoMessage() {}
 func (*ApplicationDownlinks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_e6e0b619399f62ae, []int{7}
+	return fileDescriptor_messages_c2825e53bb84f262, []int{7}
 }
 func (m *ApplicationDownlinks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -882,6 +936,65 @@ func (m *ApplicationDownlinks) GetDownlinks() []*ApplicationDownlink {
 	return nil
 }
 
+// ApplicationDownlinkPolicy is the policy the Application Server applies to downlink messages.
+// Fields that are not set are taken from the policy of the application link, or from the Application Server default policy.
+type ApplicationDownlinkPolicy struct {
+	// Maximum number of retries of a confirmed downlink message that is not acknowledged by the end device.
+	// The downlink message is dropped when the retries are exhausted.
+	MaxConfirmedRetries *types.UInt32Value `protobuf:"bytes,1,opt,name=max_confirmed_retries,json=maxConfirmedRetries,proto3" json:"max_confirmed_retries,omitempty"`
+	// Time after which queued downlink messages expire and are dropped from the downlink queue.
+	// If zero, queued downlink messages do not expire.
+	Expiry               *time.Duration `protobuf:"bytes,2,opt,name=expiry,proto3,stdduration" json:"expiry,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ApplicationDownlinkPolicy) Reset()      { *m = ApplicationDownlinkPolicy{} }
+func (*ApplicationDownlinkPolicy) ProtoMessage() {}
+func (*ApplicationDownlinkPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_messages_c2825e53bb84f262, []int{8}
+}
+func (m *ApplicationDownlinkPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationDownlinkPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationDownlinkPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ApplicationDownlinkPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDownlinkPolicy.Merge(dst, src)
+}
+func (m *ApplicationDownlinkPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationDownlinkPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDownlinkPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDownlinkPolicy proto.InternalMessageInfo
+
+func (m *ApplicationDownlinkPolicy) GetMaxConfirmedRetries() *types.UInt32Value {
+	if m != nil {
+		return m.MaxConfirmedRetries
+	}
+	return nil
+}
+
+func (m *ApplicationDownlinkPolicy) GetExpiry() *time.Duration {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
 type ApplicationDownlinkFailed struct {
 	ApplicationDownlink  `protobuf:"bytes,1,opt,name=downlink,proto3,embedded=downlink" json:"downlink"`
 	Error                ErrorDetails `protobuf:"bytes,2,opt,name=error,proto3" json:"error"`
@@ -892,7 +1005,7 @@ type ApplicationDownlinkFailed struct {
 func (m *ApplicationDownlinkFailed) Reset()      { *m = ApplicationDownlinkFailed{} }
 func (*ApplicationDownlinkFailed) ProtoMessage() {}
 func (*ApplicationDownlinkFailed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_e6e0b619399f62ae, []int{8}
+	return fileDescriptor_messages_c2825e53bb84f262, []int{9}
 }
 func (m *ApplicationDownlinkFailed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -928,6 +1041,71 @@ func (m *ApplicationDownlinkFailed) GetError() ErrorDetails {
 	return ErrorDetails{}
 }
 
+// ApplicationDownlinkQueueResult is the result of a downlink queue operation that is requested through a frontend.
+type ApplicationDownlinkQueueResult struct {
+	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
+	Operation            ApplicationDownlinkQueueResult_Operation `protobuf:"varint,2,opt,name=operation,proto3,enum=ttn.lorawan.v3.ApplicationDownlinkQueueResult_Operation" json:"operation,omitempty"`
+	// Correlation IDs of the downlink messages of the operation.
+	CorrelationIDs []string `protobuf:"bytes,3,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
+	// Error details if the operation failed. If not set, the operation succeeded.
+	Error                *ErrorDetails `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ApplicationDownlinkQueueResult) Reset()      { *m = ApplicationDownlinkQueueResult{} }
+func (*ApplicationDownlinkQueueResult) ProtoMessage() {}
+func (*ApplicationDownlinkQueueResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_messages_c2825e53bb84f262, []int{10}
+}
+func (m *ApplicationDownlinkQueueResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationDownlinkQueueResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationDownlinkQueueResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ApplicationDownlinkQueueResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDownlinkQueueResult.Merge(dst, src)
+}
+func (m *ApplicationDownlinkQueueResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationDownlinkQueueResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDownlinkQueueResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDownlinkQueueResult proto.InternalMessageInfo
+
+func (m *ApplicationDownlinkQueueResult) GetOperation() ApplicationDownlinkQueueResult_Operation {
+	if m != nil {
+		return m.Operation
+	}
+	return ApplicationDownlinkQueueResult_PUSH
+}
+
+func (m *ApplicationDownlinkQueueResult) GetCorrelationIDs() []string {
+	if m != nil {
+		return m.CorrelationIDs
+	}
+	return nil
+}
+
+func (m *ApplicationDownlinkQueueResult) GetError() *ErrorDetails {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
 type ApplicationInvalidatedDownlinks struct {
 	Downlinks            []*ApplicationDownlink `protobuf:"bytes,1,rep,name=downlinks,proto3" json:"downlinks,omitempty"`
 	LastFCntDown         uint32                 `protobuf:"varint,2,opt,name=last_f_cnt_down,json=lastFCntDown,proto3" json:"last_f_cnt_down,omitempty"`
@@ -938,7 +1116,7 @@ type ApplicationInvalidatedDownlinks struct {
 func (m *ApplicationInvalidatedDownlinks) Reset()      { *m = ApplicationInvalidatedDownlinks{} }
 func (*ApplicationInvalidatedDownlinks) ProtoMessage() {}
 func (*ApplicationInvalidatedDownlinks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_e6e0b619399f62ae, []int{9}
+	return fileDescriptor_messages_c2825e53bb84f262, []int{11}
 }
 func (m *ApplicationInvalidatedDownlinks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -994,15 +1172,18 @@ type ApplicationUp struct {
 	//	*ApplicationUp_DownlinkQueued
 	//	*ApplicationUp_DownlinkQueueInvalidated
 	//	*ApplicationUp_LocationSolved
-	Up                   isApplicationUp_Up `protobuf_oneof:"up"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Up isApplicationUp_Up `protobuf_oneof:"up"`
+	// Server time when the Application Server stored the message.
+	// This is only set on messages retrieved from storage.
+	ReceivedAt           *time.Time `protobuf:"bytes,12,opt,name=received_at,json=receivedAt,proto3,stdtime" json:"received_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *ApplicationUp) Reset()      { *m = ApplicationUp{} }
 func (*ApplicationUp) ProtoMessage() {}
 func (*ApplicationUp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_e6e0b619399f62ae, []int{10}
+	return fileDescriptor_messages_c2825e53bb84f262, []int{12}
 }
 func (m *ApplicationUp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1153,6 +1334,13 @@ func (m *ApplicationUp) GetLocationSolved() *ApplicationLocation {
 	return nil
 }
 
+func (m *ApplicationUp) GetReceivedAt() *time.Time {
+	if m != nil {
+		return m.ReceivedAt
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ApplicationUp) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ApplicationUp_OneofMarshaler, _ApplicationUp_OneofUnmarshaler, _ApplicationUp_OneofSizer, []interface{}{
@@ -1376,7 +1564,7 @@ type MessagePayloadFormatters struct {
 func (m *MessagePayloadFormatters) Reset()      { *m = MessagePayloadFormatters{} }
 func (*MessagePayloadFormatters) ProtoMessage() {}
 func (*MessagePayloadFormatters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_e6e0b619399f62ae, []int{11}
+	return fileDescriptor_messages_c2825e53bb84f262, []int{13}
 }
 func (m *MessagePayloadFormatters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1443,7 +1631,7 @@ type DownlinkQueueRequest struct {
 func (m *DownlinkQueueRequest) Reset()      { *m = DownlinkQueueRequest{} }
 func (*DownlinkQueueRequest) ProtoMessage() {}
 func (*DownlinkQueueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_messages_e6e0b619399f62ae, []int{12}
+	return fileDescriptor_messages_c2825e53bb84f262, []int{14}
 }
 func (m *DownlinkQueueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1500,8 +1688,12 @@ func init() {
 	golang_proto.RegisterType((*ApplicationDownlink_ClassBC)(nil), "ttn.lorawan.v3.ApplicationDownlink.ClassBC")
 	proto.RegisterType((*ApplicationDownlinks)(nil), "ttn.lorawan.v3.ApplicationDownlinks")
 	golang_proto.RegisterType((*ApplicationDownlinks)(nil), "ttn.lorawan.v3.ApplicationDownlinks")
+	proto.RegisterType((*ApplicationDownlinkPolicy)(nil), "ttn.lorawan.v3.ApplicationDownlinkPolicy")
+	golang_proto.RegisterType((*ApplicationDownlinkPolicy)(nil), "ttn.lorawan.v3.ApplicationDownlinkPolicy")
 	proto.RegisterType((*ApplicationDownlinkFailed)(nil), "ttn.lorawan.v3.ApplicationDownlinkFailed")
 	golang_proto.RegisterType((*ApplicationDownlinkFailed)(nil), "ttn.lorawan.v3.ApplicationDownlinkFailed")
+	proto.RegisterType((*ApplicationDownlinkQueueResult)(nil), "ttn.lorawan.v3.ApplicationDownlinkQueueResult")
+	golang_proto.RegisterType((*ApplicationDownlinkQueueResult)(nil), "ttn.lorawan.v3.ApplicationDownlinkQueueResult")
 	proto.RegisterType((*ApplicationInvalidatedDownlinks)(nil), "ttn.lorawan.v3.ApplicationInvalidatedDownlinks")
 	golang_proto.RegisterType((*ApplicationInvalidatedDownlinks)(nil), "ttn.lorawan.v3.ApplicationInvalidatedDownlinks")
 	proto.RegisterType((*ApplicationUp)(nil), "ttn.lorawan.v3.ApplicationUp")
@@ -1514,6 +1706,8 @@ func init() {
 	golang_proto.RegisterEnum("ttn.lorawan.v3.PayloadFormatter", PayloadFormatter_name, PayloadFormatter_value)
 	proto.RegisterEnum("ttn.lorawan.v3.TxAcknowledgment_Result", TxAcknowledgment_Result_name, TxAcknowledgment_Result_value)
 	golang_proto.RegisterEnum("ttn.lorawan.v3.TxAcknowledgment_Result", TxAcknowledgment_Result_name, TxAcknowledgment_Result_value)
+	proto.RegisterEnum("ttn.lorawan.v3.ApplicationDownlinkQueueResult_Operation", ApplicationDownlinkQueueResult_Operation_name, ApplicationDownlinkQueueResult_Operation_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.ApplicationDownlinkQueueResult_Operation", ApplicationDownlinkQueueResult_Operation_name, ApplicationDownlinkQueueResult_Operation_value)
 }
 func (x PayloadFormatter) String() string {
 	s, ok := PayloadFormatter_name[int32(x)]
@@ -1529,6 +1723,13 @@ func (x TxAcknowledgment_Result) String() string {
 	}
 	return strconv.Itoa(int(x))
 }
+func (x ApplicationDownlinkQueueResult_Operation) String() string {
+	s, ok := ApplicationDownlinkQueueResult_Operation_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
 func (this *UplinkMessage) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1703,6 +1904,9 @@ func (this *TxAcknowledgment) Equal(that interface{}) bool {
 	if this.Result != that1.Result {
 		return false
 	}
+	if !this.DownlinkMessage.Equal(that1.DownlinkMessage) {
+		return false
+	}
 	return true
 }
 func (this *ApplicationUplink) Equal(that interface{}) bool {
@@ -1876,6 +2080,16 @@ func (this *ApplicationDownlink) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.ConfirmedRetries != that1.ConfirmedRetries {
+		return false
+	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
 	return true
 }
 func (this *ApplicationDownlink_ClassBC) Equal(that interface{}) bool {
@@ -1943,6 +2157,39 @@ func (this *ApplicationDownlinks) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ApplicationDownlinkPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationDownlinkPolicy)
+	if !ok {
+		that2, ok := that.(ApplicationDownlinkPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MaxConfirmedRetries.Equal(that1.MaxConfirmedRetries) {
+		return false
+	}
+	if this.Expiry != nil && that1.Expiry != nil {
+		if *this.Expiry != *that1.Expiry {
+			return false
+		}
+	} else if this.Expiry != nil {
+		return false
+	} else if that1.Expiry != nil {
+		return false
+	}
+	return true
+}
 func (this *ApplicationDownlinkFailed) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1970,6 +2217,44 @@ func (this *ApplicationDownlinkFailed) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ApplicationDownlinkQueueResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationDownlinkQueueResult)
+	if !ok {
+		that2, ok := that.(ApplicationDownlinkQueueResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+		return false
+	}
+	if this.Operation != that1.Operation {
+		return false
+	}
+	if len(this.CorrelationIDs) != len(that1.CorrelationIDs) {
+		return false
+	}
+	for i := range this.CorrelationIDs {
+		if this.CorrelationIDs[i] != that1.CorrelationIDs[i] {
+			return false
+		}
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	return true
+}
 func (this *ApplicationInvalidatedDownlinks) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2041,6 +2326,13 @@ func (this *ApplicationUp) Equal(that interface{}) bool {
 	} else if !this.Up.Equal(that1.Up) {
 		return false
 	}
+	if that1.ReceivedAt == nil {
+		if this.ReceivedAt != nil {
+			return false
+		}
+	} else if !this.ReceivedAt.Equal(*that1.ReceivedAt) {
+		return false
+	}
 	return true
 }
 func (this *ApplicationUp_UplinkMessage) Equal(that interface{}) bool {
@@ -2530,6 +2822,16 @@ func (m *TxAcknowledgment) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.Result))
 	}
+	if m.DownlinkMessage != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.DownlinkMessage.Size()))
+		n9, err := m.DownlinkMessage.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
 	return i, nil
 }
 
@@ -2574,11 +2876,11 @@ func (m *ApplicationUplink) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.DecodedPayload.Size()))
-		n9, err := m.DecodedPayload.MarshalTo(dAtA[i:])
+		n10, err := m.DecodedPayload.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n10
 	}
 	if len(m.RxMetadata) > 0 {
 		for _, msg := range m.RxMetadata {
@@ -2595,11 +2897,11 @@ func (m *ApplicationUplink) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x3a
 	i++
 	i = encodeVarintMessages(dAtA, i, uint64(m.Settings.Size()))
-	n10, err := m.Settings.MarshalTo(dAtA[i:])
+	n11, err := m.Settings.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n10
+	i += n11
 	return i, nil
 }
 
@@ -2627,11 +2929,11 @@ func (m *ApplicationLocation) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintMessages(dAtA, i, uint64(m.Location.Size()))
-	n11, err := m.Location.MarshalTo(dAtA[i:])
+	n12, err := m.Location.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n11
+	i += n12
 	if len(m.Attributes) > 0 {
 		for k := range m.Attributes {
 			dAtA[i] = 0x1a
@@ -2677,11 +2979,11 @@ func (m *ApplicationJoinAccept) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.AppSKey.Size()))
-		n12, err := m.AppSKey.MarshalTo(dAtA[i:])
+		n13, err := m.AppSKey.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n13
 	}
 	if len(m.InvalidatedDownlinks) > 0 {
 		for _, msg := range m.InvalidatedDownlinks {
@@ -2749,11 +3051,11 @@ func (m *ApplicationDownlink) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.DecodedPayload.Size()))
-		n13, err := m.DecodedPayload.MarshalTo(dAtA[i:])
+		n14, err := m.DecodedPayload.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n14
 	}
 	if m.Confirmed {
 		dAtA[i] = 0x30
@@ -2769,11 +3071,11 @@ func (m *ApplicationDownlink) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.ClassBC.Size()))
-		n14, err := m.ClassBC.MarshalTo(dAtA[i:])
+		n15, err := m.ClassBC.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n15
 	}
 	if m.Priority != 0 {
 		dAtA[i] = 0x40
@@ -2795,6 +3097,21 @@ func (m *ApplicationDownlink) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if m.ConfirmedRetries != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.ConfirmedRetries))
+	}
+	if m.ExpiresAt != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)))
+		n16, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
 	return i, nil
 }
 
@@ -2829,11 +3146,11 @@ func (m *ApplicationDownlink_ClassBC) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.AbsoluteTime)))
-		n15, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AbsoluteTime, dAtA[i:])
+		n17, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AbsoluteTime, dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n17
 	}
 	return i, nil
 }
@@ -2868,6 +3185,44 @@ func (m *ApplicationDownlinks) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ApplicationDownlinkPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationDownlinkPolicy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MaxConfirmedRetries != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.MaxConfirmedRetries.Size()))
+		n18, err := m.MaxConfirmedRetries.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if m.Expiry != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Expiry)))
+		n19, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.Expiry, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	return i, nil
+}
+
 func (m *ApplicationDownlinkFailed) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2886,23 +3241,23 @@ func (m *ApplicationDownlinkFailed) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMessages(dAtA, i, uint64(m.ApplicationDownlink.Size()))
-	n16, err := m.ApplicationDownlink.MarshalTo(dAtA[i:])
+	n20, err := m.ApplicationDownlink.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
+	i += n20
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintMessages(dAtA, i, uint64(m.Error.Size()))
-	n17, err := m.Error.MarshalTo(dAtA[i:])
+	n21, err := m.Error.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n21
 	return i, nil
 }
 
-func (m *ApplicationInvalidatedDownlinks) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationDownlinkQueueResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2912,32 +3267,88 @@ func (m *ApplicationInvalidatedDownlinks) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationInvalidatedDownlinks) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationDownlinkQueueResult) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Downlinks) > 0 {
-		for _, msg := range m.Downlinks {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintMessages(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMessages(dAtA, i, uint64(m.EndDeviceIdentifiers.Size()))
+	n22, err := m.EndDeviceIdentifiers.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.LastFCntDown != 0 {
+	i += n22
+	if m.Operation != 0 {
 		dAtA[i] = 0x10
 		i++
-		i = encodeVarintMessages(dAtA, i, uint64(m.LastFCntDown))
+		i = encodeVarintMessages(dAtA, i, uint64(m.Operation))
 	}
-	return i, nil
-}
-
-func (m *ApplicationUp) Marshal() (dAtA []byte, err error) {
+	if len(m.CorrelationIDs) > 0 {
+		for _, s := range m.CorrelationIDs {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Error != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Error.Size()))
+		n23, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	return i, nil
+}
+
+func (m *ApplicationInvalidatedDownlinks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationInvalidatedDownlinks) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Downlinks) > 0 {
+		for _, msg := range m.Downlinks {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintMessages(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.LastFCntDown != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.LastFCntDown))
+	}
+	return i, nil
+}
+
+func (m *ApplicationUp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2955,11 +3366,11 @@ func (m *ApplicationUp) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMessages(dAtA, i, uint64(m.EndDeviceIdentifiers.Size()))
-	n18, err := m.EndDeviceIdentifiers.MarshalTo(dAtA[i:])
+	n24, err := m.EndDeviceIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n24
 	if len(m.CorrelationIDs) > 0 {
 		for _, s := range m.CorrelationIDs {
 			dAtA[i] = 0x12
@@ -2976,11 +3387,21 @@ func (m *ApplicationUp) MarshalTo(dAtA []byte) (int, error) {
 		}
 	}
 	if m.Up != nil {
-		nn19, err := m.Up.MarshalTo(dAtA[i:])
+		nn25, err := m.Up.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn25
+	}
+	if m.ReceivedAt != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReceivedAt)))
+		n26, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ReceivedAt, dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn19
+		i += n26
 	}
 	return i, nil
 }
@@ -2991,11 +3412,11 @@ func (m *ApplicationUp_UplinkMessage) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.UplinkMessage.Size()))
-		n20, err := m.UplinkMessage.MarshalTo(dAtA[i:])
+		n27, err := m.UplinkMessage.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n27
 	}
 	return i, nil
 }
@@ -3005,11 +3426,11 @@ func (m *ApplicationUp_JoinAccept) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.JoinAccept.Size()))
-		n21, err := m.JoinAccept.MarshalTo(dAtA[i:])
+		n28, err := m.JoinAccept.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n28
 	}
 	return i, nil
 }
@@ -3019,11 +3440,11 @@ func (m *ApplicationUp_DownlinkAck) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.DownlinkAck.Size()))
-		n22, err := m.DownlinkAck.MarshalTo(dAtA[i:])
+		n29, err := m.DownlinkAck.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n29
 	}
 	return i, nil
 }
@@ -3033,11 +3454,11 @@ func (m *ApplicationUp_DownlinkNack) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.DownlinkNack.Size()))
-		n23, err := m.DownlinkNack.MarshalTo(dAtA[i:])
+		n30, err := m.DownlinkNack.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n30
 	}
 	return i, nil
 }
@@ -3047,11 +3468,11 @@ func (m *ApplicationUp_DownlinkSent) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.DownlinkSent.Size()))
-		n24, err := m.DownlinkSent.MarshalTo(dAtA[i:])
+		n31, err := m.DownlinkSent.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n31
 	}
 	return i, nil
 }
@@ -3061,11 +3482,11 @@ func (m *ApplicationUp_DownlinkFailed) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.DownlinkFailed.Size()))
-		n25, err := m.DownlinkFailed.MarshalTo(dAtA[i:])
+		n32, err := m.DownlinkFailed.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n32
 	}
 	return i, nil
 }
@@ -3075,11 +3496,11 @@ func (m *ApplicationUp_DownlinkQueued) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.DownlinkQueued.Size()))
-		n26, err := m.DownlinkQueued.MarshalTo(dAtA[i:])
+		n33, err := m.DownlinkQueued.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n33
 	}
 	return i, nil
 }
@@ -3089,11 +3510,11 @@ func (m *ApplicationUp_DownlinkQueueInvalidated) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x52
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.DownlinkQueueInvalidated.Size()))
-		n27, err := m.DownlinkQueueInvalidated.MarshalTo(dAtA[i:])
+		n34, err := m.DownlinkQueueInvalidated.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n34
 	}
 	return i, nil
 }
@@ -3103,11 +3524,11 @@ func (m *ApplicationUp_LocationSolved) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.LocationSolved.Size()))
-		n28, err := m.LocationSolved.MarshalTo(dAtA[i:])
+		n35, err := m.LocationSolved.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n35
 	}
 	return i, nil
 }
@@ -3169,11 +3590,11 @@ func (m *DownlinkQueueRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMessages(dAtA, i, uint64(m.EndDeviceIdentifiers.Size()))
-	n29, err := m.EndDeviceIdentifiers.MarshalTo(dAtA[i:])
+	n36, err := m.EndDeviceIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n29
+	i += n36
 	if len(m.Downlinks) > 0 {
 		for _, msg := range m.Downlinks {
 			dAtA[i] = 0x12
@@ -3206,6 +3627,9 @@ func NewPopulatedTxAcknowledgment(r randyMessages, easy bool) *TxAcknowledgment
 		this.CorrelationIDs[i] = randStringMessages(r)
 	}
 	this.Result = TxAcknowledgment_Result([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8}[r.Intn(9)])
+	if r.Intn(10) != 0 {
+		this.DownlinkMessage = NewPopulatedDownlinkMessage(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3313,6 +3737,19 @@ func NewPopulatedApplicationDownlinks(r randyMessages, easy bool) *ApplicationDo
 	return this
 }
 
+func NewPopulatedApplicationDownlinkPolicy(r randyMessages, easy bool) *ApplicationDownlinkPolicy {
+	this := &ApplicationDownlinkPolicy{}
+	if r.Intn(10) != 0 {
+		this.MaxConfirmedRetries = types.NewPopulatedUInt32Value(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Expiry = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedApplicationDownlinkFailed(r randyMessages, easy bool) *ApplicationDownlinkFailed {
 	this := &ApplicationDownlinkFailed{}
 	v12 := NewPopulatedApplicationDownlink(r, easy)
@@ -3324,12 +3761,30 @@ func NewPopulatedApplicationDownlinkFailed(r randyMessages, easy bool) *Applicat
 	return this
 }
 
+func NewPopulatedApplicationDownlinkQueueResult(r randyMessages, easy bool) *ApplicationDownlinkQueueResult {
+	this := &ApplicationDownlinkQueueResult{}
+	v14 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v14
+	this.Operation = ApplicationDownlinkQueueResult_Operation([]int32{0, 1}[r.Intn(2)])
+	v15 := r.Intn(10)
+	this.CorrelationIDs = make([]string, v15)
+	for i := 0; i < v15; i++ {
+		this.CorrelationIDs[i] = randStringMessages(r)
+	}
+	if r.Intn(10) == 0 {
+		this.Error = NewPopulatedErrorDetails(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedApplicationInvalidatedDownlinks(r randyMessages, easy bool) *ApplicationInvalidatedDownlinks {
 	this := &ApplicationInvalidatedDownlinks{}
 	if r.Intn(10) != 0 {
-		v14 := r.Intn(5)
-		this.Downlinks = make([]*ApplicationDownlink, v14)
-		for i := 0; i < v14; i++ {
+		v16 := r.Intn(5)
+		this.Downlinks = make([]*ApplicationDownlink, v16)
+		for i := 0; i < v16; i++ {
 			this.Downlinks[i] = NewPopulatedApplicationDownlink(r, easy)
 		}
 	}
@@ -3341,11 +3796,11 @@ func NewPopulatedApplicationInvalidatedDownlinks(r randyMessages, easy bool) *Ap
 
 func NewPopulatedApplicationUp(r randyMessages, easy bool) *ApplicationUp {
 	this := &ApplicationUp{}
-	v15 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v15
-	v16 := r.Intn(10)
-	this.CorrelationIDs = make([]string, v16)
-	for i := 0; i < v16; i++ {
+	v17 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v17
+	v18 := r.Intn(10)
+	this.CorrelationIDs = make([]string, v18)
+	for i := 0; i < v18; i++ {
 		this.CorrelationIDs[i] = randStringMessages(r)
 	}
 	oneofNumber_Up := []int32{3, 4, 5, 6, 7, 8, 9, 10, 11}[r.Intn(9)]
@@ -3369,6 +3824,9 @@ func NewPopulatedApplicationUp(r randyMessages, easy bool) *ApplicationUp {
 	case 11:
 		this.Up = NewPopulatedApplicationUp_LocationSolved(r, easy)
 	}
+	if r.Intn(10) != 0 {
+		this.ReceivedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3421,9 +3879,9 @@ func NewPopulatedApplicationUp_LocationSolved(r randyMessages, easy bool) *Appli
 }
 func NewPopulatedMessagePayloadFormatters(r randyMessages, easy bool) *MessagePayloadFormatters {
 	this := &MessagePayloadFormatters{}
-	this.UpFormatter = PayloadFormatter([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	this.UpFormatter = PayloadFormatter([]int32{0, 1, 2, 3, 4, 5}[r.Intn(6)])
 	this.UpFormatterParameter = randStringMessages(r)
-	this.DownFormatter = PayloadFormatter([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	this.DownFormatter = PayloadFormatter([]int32{0, 1, 2, 3, 4, 5}[r.Intn(6)])
 	this.DownFormatterParameter = randStringMessages(r)
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -3432,12 +3890,12 @@ func NewPopulatedMessagePayloadFormatters(r randyMessages, easy bool) *MessagePa
 
 func NewPopulatedDownlinkQueueRequest(r randyMessages, easy bool) *DownlinkQueueRequest {
 	this := &DownlinkQueueRequest{}
-	v17 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v17
+	v19 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v19
 	if r.Intn(10) != 0 {
-		v18 := r.Intn(5)
-		this.Downlinks = make([]*ApplicationDownlink, v18)
-		for i := 0; i < v18; i++ {
+		v20 := r.Intn(5)
+		this.Downlinks = make([]*ApplicationDownlink, v20)
+		for i := 0; i < v20; i++ {
 			this.Downlinks[i] = NewPopulatedApplicationDownlink(r, easy)
 		}
 	}
@@ -3465,9 +3923,9 @@ func randUTF8RuneMessages(r randyMessages) rune {
 	return rune(ru + 61)
 }
 func randStringMessages(r randyMessages) string {
-	v19 := r.Intn(100)
-	tmps := make([]rune, v19)
-	for i := 0; i < v19; i++ {
+	v21 := r.Intn(100)
+	tmps := make([]rune, v21)
+	for i := 0; i < v21; i++ {
 		tmps[i] = randUTF8RuneMessages(r)
 	}
 	return string(tmps)
@@ -3489,11 +3947,11 @@ func randFieldMessages(dAtA []byte, r randyMessages, fieldNumber int, wire int)
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateMessages(dAtA, uint64(key))
-		v20 := r.Int63()
+		v22 := r.Int63()
 		if r.Intn(2) == 0 {
-			v20 *= -1
+			v22 *= -1
 		}
-		dAtA = encodeVarintPopulateMessages(dAtA, uint64(v20))
+		dAtA = encodeVarintPopulateMessages(dAtA, uint64(v22))
 	case 1:
 		dAtA = encodeVarintPopulateMessages(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3620,6 +4078,10 @@ func (m *TxAcknowledgment) Size() (n int) {
 	if m.Result != 0 {
 		n += 1 + sovMessages(uint64(m.Result))
 	}
+	if m.DownlinkMessage != nil {
+		l = m.DownlinkMessage.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
 	return n
 }
 
@@ -3747,6 +4209,13 @@ func (m *ApplicationDownlink) Size() (n int) {
 			n += 1 + l + sovMessages(uint64(l))
 		}
 	}
+	if m.ConfirmedRetries != 0 {
+		n += 1 + sovMessages(uint64(m.ConfirmedRetries))
+	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovMessages(uint64(l))
+	}
 	return n
 }
 
@@ -3784,6 +4253,23 @@ func (m *ApplicationDownlinks) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationDownlinkPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxConfirmedRetries != nil {
+		l = m.MaxConfirmedRetries.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Expiry != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.Expiry)
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
 func (m *ApplicationDownlinkFailed) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3797,6 +4283,30 @@ func (m *ApplicationDownlinkFailed) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationDownlinkQueueResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovMessages(uint64(l))
+	if m.Operation != 0 {
+		n += 1 + sovMessages(uint64(m.Operation))
+	}
+	if len(m.CorrelationIDs) > 0 {
+		for _, s := range m.CorrelationIDs {
+			l = len(s)
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
 func (m *ApplicationInvalidatedDownlinks) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3832,6 +4342,10 @@ func (m *ApplicationUp) Size() (n int) {
 	if m.Up != nil {
 		n += m.Up.Size()
 	}
+	if m.ReceivedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReceivedAt)
+		n += 1 + l + sovMessages(uint64(l))
+	}
 	return n
 }
 
@@ -4052,6 +4566,7 @@ func (this *TxAcknowledgment) String() string {
 	s := strings.Join([]string{`&TxAcknowledgment{`,
 		`CorrelationIDs:` + fmt.Sprintf("%v", this.CorrelationIDs) + `,`,
 		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`DownlinkMessage:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkMessage), "DownlinkMessage", "DownlinkMessage", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4121,6 +4636,8 @@ func (this *ApplicationDownlink) String() string {
 		`ClassBC:` + strings.Replace(fmt.Sprintf("%v", this.ClassBC), "ApplicationDownlink_ClassBC", "ApplicationDownlink_ClassBC", 1) + `,`,
 		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
 		`CorrelationIDs:` + fmt.Sprintf("%v", this.CorrelationIDs) + `,`,
+		`ConfirmedRetries:` + fmt.Sprintf("%v", this.ConfirmedRetries) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4146,6 +4663,17 @@ func (this *ApplicationDownlinks) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationDownlinkPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationDownlinkPolicy{`,
+		`MaxConfirmedRetries:` + strings.Replace(fmt.Sprintf("%v", this.MaxConfirmedRetries), "UInt32Value", "types.UInt32Value", 1) + `,`,
+		`Expiry:` + strings.Replace(fmt.Sprintf("%v", this.Expiry), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationDownlinkFailed) String() string {
 	if this == nil {
 		return "nil"
@@ -4157,6 +4685,19 @@ func (this *ApplicationDownlinkFailed) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationDownlinkQueueResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationDownlinkQueueResult{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(this.EndDeviceIdentifiers.String(), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Operation:` + fmt.Sprintf("%v", this.Operation) + `,`,
+		`CorrelationIDs:` + fmt.Sprintf("%v", this.CorrelationIDs) + `,`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "ErrorDetails", "ErrorDetails", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationInvalidatedDownlinks) String() string {
 	if this == nil {
 		return "nil"
@@ -4176,6 +4717,7 @@ func (this *ApplicationUp) String() string {
 		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(this.EndDeviceIdentifiers.String(), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
 		`CorrelationIDs:` + fmt.Sprintf("%v", this.CorrelationIDs) + `,`,
 		`Up:` + fmt.Sprintf("%v", this.Up) + `,`,
+		`ReceivedAt:` + strings.Replace(fmt.Sprintf("%v", this.ReceivedAt), "Timestamp", "types.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4853,6 +5395,39 @@ func (m *TxAcknowledgment) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkMessage == nil {
+				m.DownlinkMessage = &DownlinkMessage{}
+			}
+			if err := m.DownlinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -5773,6 +6348,58 @@ func (m *ApplicationDownlink) Unmarshal(dAtA []byte) error {
 			}
 			m.CorrelationIDs = append(m.CorrelationIDs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmedRetries", wireType)
+			}
+			m.ConfirmedRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfirmedRetries |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -5989,7 +6616,7 @@ func (m *ApplicationDownlinks) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationDownlinkFailed) Unmarshal(dAtA []byte) error {
+func (m *ApplicationDownlinkPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6012,15 +6639,15 @@ func (m *ApplicationDownlinkFailed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationDownlinkFailed: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationDownlinkPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationDownlinkFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationDownlinkPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationDownlink", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConfirmedRetries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6044,13 +6671,16 @@ func (m *ApplicationDownlinkFailed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationDownlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MaxConfirmedRetries == nil {
+				m.MaxConfirmedRetries = &types.UInt32Value{}
+			}
+			if err := m.MaxConfirmedRetries.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6074,7 +6704,10 @@ func (m *ApplicationDownlinkFailed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Expiry == nil {
+				m.Expiry = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6099,7 +6732,7 @@ func (m *ApplicationDownlinkFailed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationInvalidatedDownlinks) Unmarshal(dAtA []byte) error {
+func (m *ApplicationDownlinkFailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6122,15 +6755,15 @@ func (m *ApplicationInvalidatedDownlinks) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationInvalidatedDownlinks: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationDownlinkFailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationInvalidatedDownlinks: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationDownlinkFailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Downlinks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationDownlink", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6154,16 +6787,15 @@ func (m *ApplicationInvalidatedDownlinks) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Downlinks = append(m.Downlinks, &ApplicationDownlink{})
-			if err := m.Downlinks[len(m.Downlinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationDownlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastFCntDown", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			m.LastFCntDown = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -6173,13 +6805,285 @@ func (m *ApplicationInvalidatedDownlinks) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastFCntDown |= (uint32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationDownlinkQueueResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationDownlinkQueueResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationDownlinkQueueResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			m.Operation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operation |= (ApplicationDownlinkQueueResult_Operation(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrelationIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorrelationIDs = append(m.CorrelationIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &ErrorDetails{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationInvalidatedDownlinks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationInvalidatedDownlinks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationInvalidatedDownlinks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downlinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Downlinks = append(m.Downlinks, &ApplicationDownlink{})
+			if err := m.Downlinks[len(m.Downlinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFCntDown", wireType)
+			}
+			m.LastFCntDown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFCntDown |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
 			if err != nil {
 				return err
@@ -6575,6 +7479,39 @@ func (m *ApplicationUp) Unmarshal(dAtA []byte) error {
 			}
 			m.Up = &ApplicationUp_LocationSolved{v}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReceivedAt == nil {
+				m.ReceivedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ReceivedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -6959,129 +7896,143 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("lorawan-stack/api/messages.proto", fileDescriptor_messages_e6e0b619399f62ae)
+	proto.RegisterFile("lorawan-stack/api/messages.proto", fileDescriptor_messages_c2825e53bb84f262)
 }
 func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/messages.proto", fileDescriptor_messages_e6e0b619399f62ae)
-}
-
-var fileDescriptor_messages_e6e0b619399f62ae = []byte{
-	// 1857 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xde, 0xa5, 0x28, 0x91, 0x7c, 0xa4, 0xa8, 0xcd, 0x44, 0x71, 0x19, 0xd5, 0x5d, 0xaa, 0x4c,
-	0x83, 0x38, 0x6d, 0x4d, 0xa2, 0x76, 0x9b, 0x1a, 0x4e, 0xff, 0x28, 0x6a, 0x25, 0xd1, 0x92, 0x49,
-	0x66, 0x48, 0x27, 0x76, 0x51, 0x60, 0xb1, 0xda, 0x1d, 0xd2, 0x1b, 0x92, 0xbb, 0x9b, 0xdd, 0xa1,
-	0x64, 0xde, 0x02, 0x14, 0x45, 0x03, 0xe4, 0xe2, 0x5b, 0x73, 0x2a, 0x8c, 0x9e, 0x72, 0x6b, 0x7a,
-	0xf3, 0x31, 0x47, 0x1f, 0x7d, 0xcc, 0x49, 0x8d, 0x28, 0x14, 0xf0, 0xd1, 0xc7, 0x1c, 0x8b, 0x9d,
-	0x9d, 0x25, 0x97, 0x14, 0xa1, 0x50, 0x29, 0x72, 0xe9, 0x49, 0x9c, 0x79, 0xef, 0xfb, 0xde, 0x9b,
-	0x37, 0xef, 0xbd, 0x79, 0x2b, 0xd8, 0xec, 0xd9, 0xae, 0x76, 0xac, 0x59, 0xd7, 0x3d, 0xaa, 0xe9,
-	0xdd, 0x92, 0xe6, 0x98, 0xa5, 0x3e, 0xf1, 0x3c, 0xad, 0x43, 0xbc, 0xa2, 0xe3, 0xda, 0xd4, 0x46,
-	0x59, 0x4a, 0xad, 0x22, 0xd7, 0x2a, 0x1e, 0xdd, 0xdc, 0xb8, 0xde, 0x31, 0xe9, 0xc3, 0xc1, 0x61,
-	0x51, 0xb7, 0xfb, 0xa5, 0x8e, 0xdd, 0xb1, 0x4b, 0x4c, 0xed, 0x70, 0xd0, 0x66, 0x2b, 0xb6, 0x60,
-	0xbf, 0x02, 0xf8, 0xc6, 0x3b, 0x11, 0xf5, 0xfe, 0xb1, 0x49, 0xbb, 0xf6, 0x71, 0xa9, 0x63, 0x5f,
-	0x67, 0xc2, 0xeb, 0x47, 0x5a, 0xcf, 0x34, 0x34, 0x6a, 0xbb, 0x5e, 0x69, 0xfc, 0x93, 0xe3, 0xae,
-	0x76, 0x6c, 0xbb, 0xd3, 0x23, 0x13, 0x76, 0x8f, 0xba, 0x03, 0x9d, 0x72, 0x69, 0x7e, 0x56, 0x4a,
-	0xcd, 0x3e, 0xf1, 0xa8, 0xd6, 0x77, 0xb8, 0xc2, 0x8f, 0xce, 0x9f, 0x8b, 0xb8, 0xee, 0x98, 0xfd,
-	0x8d, 0xf3, 0x62, 0xd3, 0x20, 0x16, 0x35, 0xdb, 0x26, 0x71, 0xbd, 0xd0, 0x85, 0xf3, 0x4a, 0x5d,
-	0x32, 0x0c, 0xa5, 0xf9, 0xf3, 0xd2, 0x30, 0x4a, 0x81, 0xc2, 0xdc, 0xd0, 0x52, 0xcd, 0xd0, 0xa8,
-	0x16, 0x68, 0x14, 0x5e, 0xc4, 0x60, 0xf5, 0x9e, 0xd3, 0x33, 0xad, 0xee, 0xdd, 0x20, 0xe6, 0x28,
-	0x0f, 0x69, 0x57, 0x3b, 0x56, 0x1d, 0x6d, 0xd8, 0xb3, 0x35, 0x23, 0x27, 0x6e, 0x8a, 0xd7, 0x32,
-	0x18, 0x5c, 0xed, 0xb8, 0x11, 0xec, 0xa0, 0x5f, 0x40, 0x22, 0x14, 0xc6, 0x36, 0xc5, 0x6b, 0xe9,
-	0x1b, 0x3f, 0x28, 0x4e, 0xdf, 0x4f, 0x91, 0x53, 0xe1, 0x50, 0x0f, 0xfd, 0x06, 0x92, 0x1e, 0xa1,
-	0xd4, 0xb4, 0x3a, 0x5e, 0x2e, 0xce, 0x30, 0x1b, 0xb3, 0x98, 0xd6, 0xa3, 0x26, 0xd7, 0xd8, 0x8a,
-	0x3f, 0x3b, 0xc9, 0x0b, 0x78, 0x8c, 0x40, 0xef, 0x42, 0xda, 0x7d, 0xa4, 0x86, 0x8e, 0xe7, 0x96,
-	0x37, 0x97, 0xe6, 0x11, 0xe0, 0x47, 0x77, 0xb9, 0x06, 0x06, 0x77, 0xfc, 0x1b, 0x29, 0x90, 0x76,
-	0x89, 0x4e, 0xcc, 0x23, 0x62, 0xa8, 0x1a, 0xcd, 0xad, 0x70, 0xeb, 0xc1, 0xe5, 0x15, 0xc3, 0xcb,
-	0x2b, 0xb6, 0xc2, 0xcb, 0xdb, 0x4a, 0xfa, 0xd6, 0x1f, 0xff, 0x3b, 0x2f, 0x62, 0x08, 0x81, 0x65,
-	0x8a, 0xde, 0x85, 0x35, 0xdd, 0x76, 0x5d, 0xd2, 0xd3, 0xa8, 0x69, 0x5b, 0xaa, 0x69, 0x78, 0xb9,
-	0xc4, 0xe6, 0xd2, 0xb5, 0xd4, 0x16, 0x1a, 0x9d, 0xe4, 0xb3, 0x95, 0x89, 0xa8, 0xba, 0xed, 0xe1,
-	0x6c, 0x44, 0xb5, 0x6a, 0x78, 0xb7, 0xe3, 0x4f, 0x9f, 0xe4, 0x85, 0xc2, 0x5f, 0x96, 0x60, 0x6d,
-	0xdb, 0x3e, 0xb6, 0xbe, 0xef, 0x60, 0xff, 0x09, 0xb2, 0xc4, 0x32, 0x54, 0x83, 0x1c, 0x99, 0x3a,
-	0x61, 0x9e, 0x2e, 0x31, 0xe4, 0x4f, 0x66, 0x91, 0x8a, 0x65, 0x6c, 0x33, 0xa5, 0xea, 0x24, 0xef,
-	0xb6, 0xa4, 0xd1, 0x49, 0x3e, 0x33, 0x91, 0x6c, 0x7b, 0x38, 0x43, 0x26, 0x7a, 0x1e, 0xfa, 0x15,
-	0x24, 0x5c, 0xf2, 0xd1, 0x80, 0x78, 0x94, 0xdf, 0xe4, 0xeb, 0xe7, 0x6f, 0x12, 0x07, 0x0a, 0x7b,
-	0x02, 0x0e, 0x75, 0xd1, 0x6d, 0x48, 0x79, 0xfa, 0x43, 0x62, 0x0c, 0x7a, 0xc4, 0xc8, 0x2d, 0x7f,
-	0x5b, 0x0a, 0xec, 0x09, 0x78, 0xa2, 0x3e, 0x2f, 0xf6, 0x2b, 0x97, 0x8b, 0xfd, 0x16, 0x4c, 0x12,
-	0xb0, 0xf0, 0xaf, 0x18, 0x48, 0xad, 0x47, 0x65, 0xbd, 0x6b, 0xd9, 0xc7, 0x3d, 0x62, 0x74, 0xfa,
-	0xc4, 0x9a, 0x7b, 0xbf, 0xe2, 0xa2, 0x36, 0xd0, 0xef, 0x61, 0xc5, 0x25, 0xde, 0xa0, 0x47, 0xd9,
-	0x1d, 0x65, 0x6f, 0xbc, 0x75, 0xfe, 0x64, 0xd3, 0xe6, 0x8a, 0x98, 0xa9, 0x63, 0x0e, 0x2b, 0xfc,
-	0x5d, 0x84, 0x95, 0x60, 0x0b, 0xa5, 0x21, 0xd1, 0xbc, 0x57, 0xa9, 0x28, 0xcd, 0xa6, 0x24, 0xa0,
-	0x57, 0x60, 0xf5, 0x5e, 0x6d, 0xbf, 0x56, 0xff, 0xa0, 0xa6, 0x2a, 0x18, 0xd7, 0xb1, 0x24, 0xa2,
-	0x0c, 0x24, 0x5b, 0xf5, 0xba, 0x7a, 0x50, 0x6e, 0x29, 0x52, 0x0c, 0xad, 0x42, 0xca, 0x5f, 0x29,
-	0x65, 0x7c, 0xf0, 0x40, 0x5a, 0x42, 0xeb, 0x20, 0x55, 0xea, 0x07, 0x07, 0xd5, 0x66, 0xb5, 0x5e,
-	0x53, 0x1b, 0xe5, 0xca, 0xbe, 0xd2, 0x92, 0xe2, 0xd3, 0xbb, 0x5b, 0x4a, 0xb9, 0x52, 0xaf, 0x49,
-	0xcb, 0xbe, 0xa1, 0xd6, 0x7d, 0x75, 0x07, 0x2b, 0xef, 0x49, 0x2b, 0x8c, 0xf5, 0xbe, 0xda, 0xa8,
-	0x7f, 0xa0, 0x60, 0x29, 0x81, 0x24, 0xc8, 0xec, 0x36, 0x9a, 0xea, 0xbd, 0xda, 0x41, 0xbd, 0xb2,
-	0xaf, 0x6c, 0x4b, 0xc9, 0xc2, 0x7f, 0x62, 0xf0, 0x4a, 0xd9, 0x71, 0x7a, 0xa6, 0xce, 0x0e, 0x1d,
-	0x74, 0x0c, 0xf4, 0x0e, 0x64, 0x3d, 0xe2, 0x79, 0x7e, 0xc0, 0xba, 0x64, 0xa8, 0x9a, 0x3c, 0x81,
-	0x83, 0x1c, 0x6a, 0x06, 0x92, 0x7d, 0x32, 0xac, 0x6e, 0xe3, 0x8c, 0x37, 0x59, 0x19, 0xe8, 0x35,
-	0x58, 0x69, 0xab, 0x8e, 0xed, 0x06, 0xf1, 0x5a, 0xc5, 0xcb, 0xed, 0x86, 0xed, 0x52, 0xf4, 0x2a,
-	0x2c, 0xb7, 0x55, 0xdd, 0xa2, 0x2c, 0x5f, 0x57, 0x71, 0xbc, 0x5d, 0xb1, 0x28, 0x2a, 0x41, 0xba,
-	0xed, 0xf6, 0xc7, 0x15, 0x12, 0x67, 0x06, 0xb2, 0xa3, 0x93, 0x3c, 0xec, 0xe0, 0xbb, 0xbc, 0x4a,
-	0x30, 0xb4, 0xdd, 0x7e, 0x58, 0x31, 0x7f, 0x80, 0x35, 0x83, 0xe8, 0xb6, 0x41, 0x8c, 0x31, 0x68,
-	0x99, 0x57, 0xce, 0x6c, 0xd1, 0x37, 0x59, 0x3f, 0xc7, 0x59, 0xae, 0x1f, 0x32, 0xcc, 0xf4, 0x9b,
-	0x95, 0x4b, 0xf5, 0x9b, 0x68, 0xab, 0x4b, 0x5c, 0xb6, 0xd5, 0x15, 0xfe, 0x1c, 0x83, 0x57, 0x23,
-	0x71, 0x3e, 0xb0, 0x83, 0xbf, 0x28, 0x07, 0x09, 0x8f, 0xb8, 0x7e, 0x0d, 0xb2, 0x10, 0xa7, 0x70,
-	0xb8, 0x44, 0xbf, 0x83, 0x64, 0x8f, 0x6b, 0xf1, 0x0e, 0x91, 0x9b, 0xb5, 0x17, 0xb2, 0x04, 0xad,
-	0xed, 0xf9, 0x49, 0x5e, 0xc4, 0x63, 0x0c, 0x6a, 0x02, 0x68, 0x94, 0xba, 0xe6, 0xe1, 0x80, 0x12,
-	0xbf, 0x53, 0xf8, 0x67, 0xbd, 0x39, 0xcb, 0x30, 0xc7, 0xa5, 0x62, 0x79, 0x8c, 0x52, 0x2c, 0xea,
-	0x0e, 0x71, 0x84, 0x66, 0xe3, 0xb7, 0xb0, 0x36, 0x23, 0x46, 0x12, 0x2c, 0x75, 0xc9, 0x90, 0x7b,
-	0xef, 0xff, 0x44, 0xeb, 0xb0, 0x7c, 0xa4, 0xf5, 0x06, 0x84, 0xb9, 0x9d, 0xc2, 0xc1, 0xe2, 0x76,
-	0xec, 0x96, 0x58, 0xf8, 0x34, 0x06, 0xaf, 0x45, 0x4c, 0xde, 0xb1, 0x4d, 0xab, 0xac, 0xeb, 0xc4,
-	0xa1, 0xdf, 0x39, 0xe3, 0x7e, 0x0d, 0x29, 0xcd, 0x71, 0x54, 0xcf, 0x47, 0xf1, 0x30, 0xfd, 0x70,
-	0xf6, 0x90, 0xfb, 0x64, 0xa8, 0x58, 0x47, 0xa4, 0x67, 0x3b, 0x04, 0x27, 0x34, 0xc7, 0x69, 0xee,
-	0x93, 0x21, 0xba, 0x0f, 0xaf, 0x99, 0x16, 0x1f, 0x0c, 0x88, 0xa1, 0x1a, 0xbc, 0x7f, 0x87, 0x91,
-	0x7a, 0xe3, 0x82, 0x48, 0x85, 0xbd, 0x1e, 0xaf, 0x47, 0x18, 0xc2, 0x4d, 0x0f, 0xbd, 0x05, 0x6b,
-	0x0e, 0xb1, 0x0c, 0xd3, 0xea, 0xa8, 0xdc, 0x55, 0x96, 0xdc, 0x49, 0x9c, 0xe5, 0xdb, 0xfc, 0x38,
-	0x85, 0x97, 0xf1, 0xa9, 0x9c, 0x08, 0x19, 0xfe, 0x5f, 0xab, 0xef, 0x2a, 0xa4, 0x74, 0xdb, 0x6a,
-	0x9b, 0x6e, 0x9f, 0x18, 0xec, 0xb9, 0x4e, 0xe2, 0xc9, 0x06, 0xda, 0x85, 0x94, 0xde, 0xd3, 0x3c,
-	0x4f, 0x3d, 0x54, 0x75, 0x5e, 0x5f, 0x3f, 0x5b, 0xe0, 0x0e, 0x8a, 0x15, 0x1f, 0xb4, 0x55, 0xc1,
-	0x09, 0x3d, 0xf8, 0xe1, 0xd7, 0x8d, 0xe3, 0x9a, 0xb6, 0x6b, 0xd2, 0x61, 0x2e, 0xc9, 0xba, 0x76,
-	0x61, 0x4e, 0x9d, 0xf2, 0x37, 0xa8, 0xc1, 0x35, 0xf1, 0x18, 0x33, 0xef, 0xc1, 0x48, 0x2d, 0xfa,
-	0x60, 0x6c, 0xfc, 0x4d, 0x84, 0x04, 0xf7, 0x08, 0x29, 0x90, 0xec, 0x68, 0x94, 0x1c, 0x6b, 0xc3,
-	0x60, 0xa4, 0x48, 0xdf, 0x78, 0x7b, 0xd6, 0x91, 0xdd, 0x40, 0x5e, 0xb6, 0x28, 0xb1, 0x2c, 0x2d,
-	0xf2, 0x5a, 0xe3, 0x31, 0x14, 0x29, 0xb0, 0xaa, 0x1d, 0x7a, 0x76, 0x6f, 0x40, 0x89, 0xea, 0x4f,
-	0xa2, 0xec, 0x50, 0x17, 0x4f, 0x3a, 0x71, 0x36, 0xe5, 0x64, 0x42, 0x98, 0x2f, 0xe0, 0xa3, 0xca,
-	0x03, 0x58, 0x9f, 0x13, 0x44, 0x0f, 0x95, 0x21, 0x35, 0xa9, 0x00, 0x71, 0xf1, 0x0a, 0x98, 0xa0,
-	0x0a, 0x4f, 0x44, 0x78, 0x7d, 0x8e, 0xca, 0x8e, 0x66, 0xfa, 0x4f, 0x7d, 0x15, 0x92, 0xa1, 0x2a,
-	0xcb, 0xe6, 0xc5, 0xf8, 0xa3, 0x8d, 0x2d, 0x84, 0xa3, 0x5b, 0xb0, 0xcc, 0xc6, 0x6d, 0x5e, 0xee,
-	0x57, 0xcf, 0x4d, 0x3f, 0xbe, 0x70, 0x9b, 0x50, 0xcd, 0xec, 0x85, 0x7d, 0x38, 0x00, 0x14, 0x3e,
-	0x15, 0x21, 0x1f, 0xb1, 0x52, 0x9d, 0x57, 0xbd, 0xff, 0x7b, 0x24, 0xd0, 0x9b, 0xb0, 0xd6, 0xd3,
-	0x3c, 0xaa, 0xb2, 0xaa, 0x63, 0x9d, 0x85, 0x17, 0x64, 0xc6, 0xdf, 0xde, 0xa9, 0x58, 0xd4, 0x47,
-	0x15, 0xfe, 0x9a, 0x80, 0xd5, 0xa9, 0xa7, 0x17, 0xb5, 0xce, 0x0d, 0x78, 0xe2, 0x25, 0x06, 0xbc,
-	0x49, 0xac, 0xa6, 0x07, 0xbb, 0x39, 0x09, 0x1d, 0x5b, 0x78, 0x02, 0xba, 0x03, 0xd9, 0x01, 0x9b,
-	0x09, 0x54, 0xfe, 0xe9, 0xc6, 0x67, 0xce, 0x1f, 0x5f, 0x10, 0x93, 0x60, 0x88, 0xd8, 0x13, 0xf0,
-	0xea, 0x60, 0xea, 0x03, 0x64, 0x0f, 0xd2, 0x1f, 0xda, 0xa6, 0xa5, 0x6a, 0xac, 0xe5, 0xf3, 0x29,
-	0xf3, 0xcd, 0x0b, 0x88, 0x26, 0xef, 0xc3, 0x9e, 0x80, 0xe1, 0xc3, 0xc9, 0x6b, 0xb1, 0x07, 0x99,
-	0x30, 0xdc, 0xaa, 0xa6, 0x77, 0x79, 0x27, 0x5a, 0xe4, 0x9e, 0xf6, 0x04, 0x9c, 0x0e, 0xa1, 0x65,
-	0xbd, 0x8b, 0xee, 0xc0, 0xea, 0x98, 0xc9, 0xf2, 0xa9, 0x56, 0x2e, 0x43, 0x35, 0xf6, 0xa2, 0xa6,
-	0xcd, 0x70, 0x79, 0xc4, 0xa2, 0xbc, 0x8d, 0x5d, 0x96, 0xab, 0xe9, 0x8f, 0xad, 0x2d, 0x58, 0x1b,
-	0x73, 0xb5, 0x59, 0x09, 0xf1, 0xba, 0x7f, 0x7b, 0x01, 0xb6, 0xa0, 0xe6, 0xf6, 0x04, 0x9c, 0x35,
-	0xa6, 0xab, 0xb0, 0x16, 0x61, 0xfd, 0x68, 0x40, 0x06, 0xc4, 0xc8, 0xa5, 0x2e, 0xe3, 0xe3, 0x98,
-	0xef, 0x3d, 0x06, 0x46, 0x36, 0x6c, 0x4c, 0xf3, 0xa9, 0x91, 0x17, 0x31, 0x07, 0x8c, 0xba, 0x74,
-	0x01, 0xf5, 0xbc, 0x0a, 0xdc, 0x13, 0x70, 0x6e, 0xca, 0x4c, 0x44, 0xc9, 0x3f, 0x40, 0x38, 0xe0,
-	0xa8, 0x9e, 0xdd, 0x3b, 0x22, 0x46, 0x2e, 0xfd, 0xad, 0x07, 0x08, 0x27, 0x1b, 0xff, 0x00, 0x21,
-	0xba, 0xc9, 0xc0, 0x5b, 0x71, 0x88, 0x0d, 0x9c, 0xc2, 0x67, 0x31, 0xc8, 0xf1, 0x24, 0xe5, 0x8f,
-	0xd5, 0x8e, 0xed, 0xf6, 0x35, 0x4a, 0x89, 0xeb, 0xa1, 0x0a, 0x64, 0x06, 0x8e, 0xda, 0x0e, 0x37,
-	0x58, 0x49, 0x66, 0x6f, 0x6c, 0xce, 0xda, 0x9b, 0x05, 0xe2, 0xf4, 0xc0, 0x19, 0x2f, 0xd0, 0x2f,
-	0xe1, 0x4a, 0x94, 0x44, 0x75, 0x34, 0x57, 0xeb, 0x13, 0x9f, 0x2e, 0x98, 0x91, 0xd6, 0x23, 0xca,
-	0x8d, 0x50, 0x86, 0x76, 0x81, 0x05, 0x3c, 0x62, 0x7c, 0x69, 0x41, 0xe3, 0x2c, 0x11, 0x27, 0xe6,
-	0x6f, 0x41, 0x6e, 0x9a, 0x28, 0xe2, 0x40, 0x9c, 0x39, 0x70, 0x65, 0x0a, 0x30, 0x76, 0xa1, 0xf0,
-	0x4f, 0x11, 0xd6, 0xb7, 0xa3, 0xb7, 0xc1, 0x3f, 0x01, 0xbf, 0xa7, 0x5e, 0x35, 0xd5, 0x7d, 0x63,
-	0xdf, 0xa5, 0xfb, 0xfe, 0xf4, 0xb1, 0x08, 0xd2, 0x6c, 0x3c, 0x10, 0x82, 0xec, 0x4e, 0x1d, 0xdf,
-	0x2d, 0xb7, 0x5a, 0x0a, 0x56, 0x6b, 0xf5, 0x9a, 0x22, 0x09, 0x28, 0x07, 0xeb, 0x93, 0x3d, 0xac,
-	0x34, 0xea, 0xcd, 0x6a, 0xab, 0x8e, 0x1f, 0x48, 0x22, 0xda, 0x80, 0x2b, 0x13, 0xc9, 0x2e, 0x6e,
-	0x54, 0xd4, 0xa6, 0x82, 0xdf, 0xaf, 0x56, 0xfc, 0x0f, 0xb3, 0x29, 0xd4, 0x9d, 0xf2, 0xfb, 0xe5,
-	0x66, 0x05, 0x57, 0x1b, 0x2d, 0x69, 0x69, 0x5a, 0x52, 0x29, 0x3f, 0x50, 0x6a, 0x35, 0xe5, 0xa0,
-	0xd1, 0x90, 0xe2, 0x5b, 0xff, 0x10, 0x9f, 0x9d, 0xca, 0xe2, 0xf3, 0x53, 0x59, 0xfc, 0xea, 0x54,
-	0x16, 0xbe, 0x3e, 0x95, 0x85, 0x17, 0xa7, 0xb2, 0xf0, 0xf2, 0x54, 0x16, 0xbe, 0x39, 0x95, 0xc5,
-	0x8f, 0x47, 0xb2, 0xf8, 0xc9, 0x48, 0x16, 0x3e, 0x1f, 0xc9, 0xe2, 0x17, 0x23, 0x59, 0x78, 0x3a,
-	0x92, 0x85, 0x2f, 0x47, 0xb2, 0xf0, 0x6c, 0x24, 0x8b, 0xcf, 0x47, 0xb2, 0xf8, 0xd5, 0x48, 0x16,
-	0xbe, 0x1e, 0xc9, 0xe2, 0x8b, 0x91, 0x2c, 0xbc, 0x1c, 0xc9, 0xe2, 0x37, 0x23, 0x59, 0xf8, 0xf8,
-	0x4c, 0x16, 0x3e, 0x39, 0x93, 0xc5, 0xc7, 0x67, 0xb2, 0xf0, 0xd9, 0x99, 0x2c, 0x3e, 0x39, 0x93,
-	0x85, 0xcf, 0xcf, 0x64, 0xe1, 0x8b, 0x33, 0x59, 0x7c, 0x7a, 0x26, 0x8b, 0x5f, 0x9e, 0xc9, 0xe2,
-	0x1f, 0x7f, 0xde, 0xb1, 0x8b, 0xf4, 0x21, 0xa1, 0x0f, 0xfd, 0x2f, 0x90, 0xa2, 0x45, 0xe8, 0xb1,
-	0xed, 0x76, 0x4b, 0xd3, 0xff, 0x39, 0x72, 0xba, 0x9d, 0x12, 0xa5, 0x96, 0x73, 0x78, 0xb8, 0xc2,
-	0x06, 0x89, 0x9b, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x02, 0xcf, 0xed, 0xb1, 0xb6, 0x13, 0x00,
-	0x00,
+	golang_proto.RegisterFile("lorawan-stack/api/messages.proto", fileDescriptor_messages_c2825e53bb84f262)
+}
+
+var fileDescriptor_messages_c2825e53bb84f262 = []byte{
+	// 2089 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4f, 0x6c, 0xdb, 0xd6,
+	0x19, 0x27, 0x65, 0xd9, 0x92, 0x3e, 0xc9, 0x32, 0xf3, 0xe2, 0x64, 0x8a, 0x97, 0x51, 0x9e, 0xb2,
+	0xa2, 0xe9, 0xba, 0xc8, 0x98, 0xb3, 0xb5, 0x41, 0xba, 0xad, 0x93, 0x65, 0x3a, 0x52, 0xec, 0x48,
+	0xea, 0x93, 0x9c, 0x26, 0xc3, 0x00, 0x82, 0x16, 0x9f, 0x14, 0x56, 0x12, 0xc9, 0x92, 0x4f, 0xb6,
+	0x75, 0x2b, 0x30, 0x0c, 0x28, 0xd0, 0x4b, 0x6f, 0xcb, 0x69, 0x08, 0x76, 0x28, 0x7a, 0x5b, 0x8f,
+	0x39, 0xf6, 0x18, 0xec, 0x94, 0x63, 0x4f, 0x5e, 0x2d, 0x63, 0x40, 0x8e, 0x3d, 0xf6, 0x32, 0x60,
+	0x20, 0xf9, 0x48, 0xea, 0xdf, 0x12, 0xb9, 0x43, 0x2e, 0x3b, 0x49, 0x7c, 0xdf, 0xf7, 0xfb, 0xbd,
+	0xef, 0x7d, 0xdf, 0xfb, 0xfe, 0x90, 0xb0, 0xde, 0x35, 0x2c, 0xe5, 0x48, 0xd1, 0x6f, 0xd8, 0x54,
+	0x69, 0x76, 0x36, 0x14, 0x53, 0xdb, 0xe8, 0x11, 0xdb, 0x56, 0xda, 0xc4, 0xce, 0x9b, 0x96, 0x41,
+	0x0d, 0x94, 0xa6, 0x54, 0xcf, 0x33, 0xad, 0xfc, 0xe1, 0xcd, 0xb5, 0x1b, 0x6d, 0x8d, 0x3e, 0xea,
+	0x1f, 0xe4, 0x9b, 0x46, 0x6f, 0xa3, 0x6d, 0xb4, 0x8d, 0x0d, 0x57, 0xed, 0xa0, 0xdf, 0x72, 0x9f,
+	0xdc, 0x07, 0xf7, 0x9f, 0x07, 0x5f, 0x7b, 0x67, 0x44, 0xbd, 0x77, 0xa4, 0xd1, 0x8e, 0x71, 0xb4,
+	0xd1, 0x36, 0x6e, 0xb8, 0xc2, 0x1b, 0x87, 0x4a, 0x57, 0x53, 0x15, 0x6a, 0x58, 0xf6, 0x46, 0xf0,
+	0x97, 0xe1, 0xc4, 0xb6, 0x61, 0xb4, 0xbb, 0x24, 0x64, 0x57, 0xfb, 0x96, 0x42, 0x35, 0x43, 0x67,
+	0xf2, 0xab, 0x93, 0x72, 0x9b, 0x5a, 0xfd, 0x26, 0x65, 0xd2, 0xec, 0xa4, 0x94, 0x6a, 0x3d, 0x62,
+	0x53, 0xa5, 0x67, 0xfe, 0x37, 0xfa, 0x23, 0x4b, 0x31, 0x4d, 0x62, 0xb1, 0x53, 0xaf, 0xfd, 0x64,
+	0xda, 0x2f, 0xc4, 0xb2, 0x02, 0xeb, 0xae, 0x4d, 0x8b, 0x35, 0x95, 0xe8, 0x54, 0x6b, 0x69, 0x21,
+	0xc7, 0xd5, 0x69, 0xa5, 0x0e, 0x19, 0xf8, 0xd2, 0xec, 0xb4, 0xd4, 0xf7, 0xb2, 0xa7, 0x30, 0x33,
+	0x34, 0x54, 0x51, 0x15, 0xaa, 0x78, 0x1a, 0xb9, 0x17, 0x11, 0x58, 0xde, 0x37, 0xbb, 0x9a, 0xde,
+	0xb9, 0xe7, 0xc5, 0x0c, 0x65, 0x21, 0x69, 0x29, 0x47, 0xb2, 0xa9, 0x0c, 0xba, 0x86, 0xa2, 0x66,
+	0xf8, 0x75, 0xfe, 0x7a, 0x0a, 0x83, 0xa5, 0x1c, 0xd5, 0xbc, 0x15, 0xf4, 0x4b, 0x88, 0xf9, 0xc2,
+	0xc8, 0x3a, 0x7f, 0x3d, 0xb9, 0xf9, 0xa3, 0xfc, 0x78, 0x7c, 0xf3, 0x8c, 0x0a, 0xfb, 0x7a, 0xe8,
+	0x37, 0x10, 0xb7, 0x09, 0xa5, 0x9a, 0xde, 0xb6, 0x33, 0x51, 0x17, 0xb3, 0x36, 0x89, 0x69, 0x1c,
+	0xd7, 0x99, 0xc6, 0x56, 0xf4, 0xd9, 0x49, 0x96, 0xc3, 0x01, 0x02, 0xbd, 0x07, 0x49, 0xeb, 0x58,
+	0xf6, 0x0d, 0xcf, 0x2c, 0xae, 0x2f, 0xcc, 0x22, 0xc0, 0xc7, 0xf7, 0x98, 0x06, 0x06, 0x2b, 0xf8,
+	0x8f, 0x24, 0x48, 0x5a, 0xa4, 0x49, 0xb4, 0x43, 0xa2, 0xca, 0x0a, 0xcd, 0x2c, 0xb1, 0xdd, 0xbd,
+	0xd8, 0xe5, 0xfd, 0xd8, 0xe5, 0x1b, 0x7e, 0x70, 0xb7, 0xe2, 0xce, 0xee, 0x9f, 0xff, 0x33, 0xcb,
+	0x63, 0xf0, 0x81, 0x05, 0x8a, 0xde, 0x83, 0x95, 0xa6, 0x61, 0x59, 0xa4, 0xeb, 0x5e, 0x20, 0x59,
+	0x53, 0xed, 0x4c, 0x6c, 0x7d, 0xe1, 0x7a, 0x62, 0x0b, 0x0d, 0x4f, 0xb2, 0xe9, 0x62, 0x28, 0x2a,
+	0x6f, 0xdb, 0x38, 0x3d, 0xa2, 0x5a, 0x56, 0xed, 0xdb, 0xd1, 0xa7, 0x4f, 0xb2, 0x5c, 0xee, 0xcf,
+	0x0b, 0xb0, 0xb2, 0x6d, 0x1c, 0xe9, 0xaf, 0xdb, 0xd9, 0x7f, 0x84, 0x34, 0xd1, 0x55, 0x59, 0x25,
+	0x87, 0x5a, 0x93, 0xb8, 0x96, 0x2e, 0xb8, 0xc8, 0x9f, 0x4d, 0x22, 0x25, 0x5d, 0xdd, 0x76, 0x95,
+	0xca, 0xe1, 0xbd, 0xdb, 0x12, 0x86, 0x27, 0xd9, 0x54, 0x28, 0xd9, 0xb6, 0x71, 0x8a, 0x84, 0x7a,
+	0x36, 0xfa, 0x35, 0xc4, 0x2c, 0xf2, 0x71, 0x9f, 0xd8, 0x94, 0x45, 0xf2, 0xca, 0x74, 0x24, 0xb1,
+	0xa7, 0x50, 0xe2, 0xb0, 0xaf, 0x8b, 0x6e, 0x43, 0xc2, 0x6e, 0x3e, 0x22, 0x6a, 0xbf, 0x4b, 0xd4,
+	0xcc, 0xe2, 0xab, 0xae, 0x40, 0x89, 0xc3, 0xa1, 0xfa, 0x2c, 0xdf, 0x2f, 0x9d, 0xcf, 0xf7, 0x5b,
+	0x10, 0x5e, 0xc0, 0xdc, 0xbf, 0x23, 0x20, 0x34, 0x8e, 0x0b, 0xcd, 0x8e, 0x6e, 0x1c, 0x75, 0x89,
+	0xda, 0xee, 0x11, 0x7d, 0x66, 0x7c, 0xf9, 0x79, 0xf7, 0x40, 0xef, 0xc3, 0x92, 0x45, 0xec, 0x7e,
+	0x97, 0xba, 0x31, 0x4a, 0x6f, 0xbe, 0x39, 0x7d, 0xb2, 0xf1, 0xed, 0xf2, 0xd8, 0x55, 0xc7, 0x0c,
+	0x86, 0xee, 0x82, 0xa0, 0xb2, 0x9b, 0x21, 0xb3, 0xda, 0xc9, 0x82, 0x96, 0x9d, 0xa4, 0x9a, 0xb8,
+	0x41, 0x78, 0x45, 0x1d, 0x5f, 0xc8, 0xfd, 0x95, 0x87, 0x25, 0x8f, 0x1e, 0x25, 0x21, 0x56, 0xdf,
+	0x2f, 0x16, 0xa5, 0x7a, 0x5d, 0xe0, 0xd0, 0x05, 0x58, 0xde, 0xaf, 0xec, 0x56, 0xaa, 0x1f, 0x56,
+	0x64, 0x09, 0xe3, 0x2a, 0x16, 0x78, 0x94, 0x82, 0x78, 0xa3, 0x5a, 0x95, 0xf7, 0x0a, 0x0d, 0x49,
+	0x88, 0xa0, 0x65, 0x48, 0x38, 0x4f, 0x52, 0x01, 0xef, 0x3d, 0x14, 0x16, 0xd0, 0x2a, 0x08, 0xc5,
+	0xea, 0xde, 0x5e, 0xb9, 0x5e, 0xae, 0x56, 0xe4, 0x5a, 0xa1, 0xb8, 0x2b, 0x35, 0x84, 0xe8, 0xf8,
+	0xea, 0x96, 0x54, 0x28, 0x56, 0x2b, 0xc2, 0xa2, 0xb3, 0x51, 0xe3, 0x81, 0xbc, 0x83, 0xa5, 0x0f,
+	0x84, 0x25, 0x97, 0xf5, 0x81, 0x5c, 0xab, 0x7e, 0x28, 0x61, 0x21, 0x86, 0x04, 0x48, 0xdd, 0xa9,
+	0xd5, 0xe5, 0xfd, 0xca, 0x5e, 0xb5, 0xb8, 0x2b, 0x6d, 0x0b, 0xf1, 0xdc, 0xbf, 0x22, 0x70, 0xa1,
+	0x60, 0x9a, 0x5d, 0xad, 0xe9, 0x3a, 0xd0, 0xab, 0x3e, 0xe8, 0x1d, 0x48, 0xdb, 0xc4, 0xb6, 0x1d,
+	0xe7, 0x77, 0xc8, 0x40, 0xd6, 0x58, 0x32, 0x78, 0xf7, 0xb1, 0xee, 0x49, 0x76, 0xc9, 0xa0, 0xbc,
+	0x8d, 0x53, 0x76, 0xf8, 0xa4, 0xa2, 0x4b, 0xb0, 0xd4, 0x92, 0x4d, 0xc3, 0xf2, 0x7c, 0xbf, 0x8c,
+	0x17, 0x5b, 0x35, 0xc3, 0xa2, 0xe8, 0x22, 0x2c, 0xb6, 0xe4, 0xa6, 0x4e, 0x5d, 0x37, 0x2e, 0xe3,
+	0x68, 0xab, 0xa8, 0x53, 0xb4, 0x01, 0xc9, 0x96, 0xd5, 0x0b, 0xb2, 0x2d, 0xea, 0x6e, 0x90, 0x1e,
+	0x9e, 0x64, 0x61, 0x07, 0xdf, 0x63, 0x19, 0x87, 0xa1, 0x65, 0xf5, 0xfc, 0xec, 0xfb, 0x3d, 0xac,
+	0xa8, 0xa4, 0x69, 0xa8, 0x44, 0x0d, 0x40, 0x8b, 0x2c, 0x0b, 0x27, 0x0b, 0x48, 0xdd, 0xed, 0x1d,
+	0x38, 0xcd, 0xf4, 0x7d, 0x86, 0x89, 0xda, 0xb5, 0x74, 0xae, 0xda, 0x35, 0x5a, 0x36, 0x63, 0xe7,
+	0x2d, 0x9b, 0xb9, 0x3f, 0x45, 0xe0, 0xe2, 0x88, 0x9f, 0xf7, 0x0c, 0xef, 0x17, 0x65, 0x20, 0x66,
+	0x13, 0xcb, 0xc9, 0x67, 0xd7, 0xc5, 0x09, 0xec, 0x3f, 0xa2, 0xdf, 0x41, 0xbc, 0xcb, 0xb4, 0x58,
+	0xb5, 0xc9, 0x4c, 0xee, 0xe7, 0xb3, 0x78, 0x65, 0xf2, 0xf9, 0x49, 0x96, 0xc7, 0x01, 0x06, 0xd5,
+	0x01, 0x14, 0x4a, 0x2d, 0xed, 0xa0, 0x4f, 0x89, 0x53, 0x75, 0x9c, 0xb3, 0xde, 0x9c, 0x64, 0x98,
+	0x61, 0x52, 0xbe, 0x10, 0xa0, 0x24, 0x9d, 0x5a, 0x03, 0x3c, 0x42, 0xb3, 0xf6, 0x5b, 0x58, 0x99,
+	0x10, 0x23, 0x01, 0x16, 0x3a, 0x64, 0xc0, 0xac, 0x77, 0xfe, 0xa2, 0x55, 0x58, 0x3c, 0x54, 0xba,
+	0x7d, 0xe2, 0x9a, 0x9d, 0xc0, 0xde, 0xc3, 0xed, 0xc8, 0x2d, 0x3e, 0xf7, 0x59, 0x04, 0x2e, 0x8d,
+	0x6c, 0x79, 0xd7, 0xd0, 0xf4, 0x42, 0xb3, 0x49, 0x4c, 0xfa, 0x83, 0x6f, 0xdc, 0xbb, 0x90, 0x50,
+	0x4c, 0x53, 0xb6, 0x1d, 0x14, 0x73, 0xd3, 0x8f, 0x27, 0x0f, 0xb9, 0x4b, 0x06, 0x92, 0x7e, 0x48,
+	0xba, 0x86, 0x49, 0x70, 0x4c, 0x31, 0xcd, 0xfa, 0x2e, 0x19, 0xa0, 0x07, 0x70, 0x49, 0xd3, 0xd9,
+	0x90, 0x42, 0x54, 0xd9, 0x4f, 0x5c, 0xdf, 0x53, 0xd7, 0x5e, 0xe2, 0x29, 0x3f, 0xeb, 0xf1, 0xea,
+	0x08, 0x83, 0xbf, 0x68, 0xa3, 0x37, 0x61, 0xc5, 0x24, 0xba, 0xaa, 0xe9, 0x6d, 0x99, 0x99, 0xea,
+	0x5e, 0xee, 0x38, 0x4e, 0xb3, 0x65, 0x76, 0x9c, 0xdc, 0xc9, 0xe2, 0xd8, 0x9d, 0xf0, 0x19, 0xfe,
+	0x5f, 0xb3, 0xef, 0x2a, 0x24, 0x9a, 0x86, 0xde, 0xd2, 0xac, 0x1e, 0x51, 0xdd, 0xd6, 0x1f, 0xc7,
+	0xe1, 0x02, 0xba, 0x03, 0x89, 0x66, 0x57, 0xb1, 0x6d, 0xf9, 0x40, 0x6e, 0xb2, 0xfc, 0x7a, 0x7b,
+	0x8e, 0x18, 0xe4, 0x8b, 0x0e, 0x68, 0xab, 0x88, 0x63, 0x4d, 0xef, 0x8f, 0x93, 0x37, 0xa6, 0xa5,
+	0x19, 0x96, 0x46, 0x07, 0x99, 0xb8, 0xdb, 0x01, 0x72, 0x33, 0xf2, 0x94, 0xf5, 0xb3, 0x1a, 0xd3,
+	0xc4, 0x01, 0x66, 0x56, 0xf3, 0x49, 0xcc, 0xdd, 0x7c, 0xde, 0x86, 0x0b, 0xc1, 0x91, 0x64, 0x8b,
+	0x50, 0x4b, 0x23, 0x76, 0x06, 0x5c, 0xbf, 0x0b, 0x81, 0x00, 0x7b, 0xeb, 0xe8, 0x7d, 0x00, 0x72,
+	0x6c, 0x6a, 0x16, 0xb1, 0x9d, 0x61, 0x28, 0xf9, 0xca, 0x61, 0x28, 0xea, 0x0e, 0x42, 0x09, 0x86,
+	0x29, 0xd0, 0xb5, 0xbf, 0xf0, 0x10, 0x63, 0xe7, 0x47, 0x12, 0xc4, 0xdb, 0x0a, 0x25, 0x47, 0xca,
+	0xc0, 0x1b, 0x86, 0x92, 0x9b, 0x6f, 0x4d, 0x1e, 0xfb, 0x8e, 0x27, 0x2f, 0xe8, 0x94, 0xe8, 0xba,
+	0x32, 0x32, 0x67, 0xe0, 0x00, 0x8a, 0x24, 0x58, 0x56, 0x0e, 0x6c, 0xa3, 0xdb, 0xa7, 0x44, 0x76,
+	0x66, 0x6c, 0xd7, 0x85, 0xf3, 0x98, 0x95, 0xf2, 0x61, 0x8e, 0x80, 0x0d, 0x59, 0x0f, 0x61, 0x75,
+	0x46, 0xc8, 0x6c, 0x54, 0x80, 0x44, 0x98, 0x6f, 0xfc, 0xfc, 0xf9, 0x16, 0xa2, 0x72, 0x5f, 0xf0,
+	0x70, 0x65, 0x86, 0x4a, 0xcd, 0xe8, 0x6a, 0xcd, 0x01, 0xaa, 0xc1, 0xa5, 0x9e, 0x72, 0x2c, 0x4f,
+	0x87, 0x82, 0x77, 0x4f, 0x73, 0x75, 0xea, 0x34, 0xfb, 0x65, 0x9d, 0xde, 0xdc, 0xbc, 0xef, 0x14,
+	0x2a, 0x7c, 0xb1, 0xa7, 0x1c, 0x17, 0x27, 0x63, 0xf5, 0x2e, 0x2c, 0xb9, 0x7e, 0xf7, 0x8b, 0xcc,
+	0x95, 0x29, 0x8a, 0x6d, 0xf6, 0x3e, 0xb3, 0x15, 0x7d, 0xec, 0xf8, 0x83, 0xa9, 0xe7, 0x9e, 0xcc,
+	0x36, 0x74, 0x47, 0xd1, 0x9c, 0x69, 0xaa, 0x0c, 0x71, 0xff, 0x4c, 0xcc, 0xb6, 0x79, 0x1c, 0x31,
+	0x5a, 0xef, 0x7d, 0x38, 0xba, 0x05, 0x8b, 0xee, 0x1b, 0x0d, 0x33, 0xf0, 0xea, 0xd4, 0x80, 0xe9,
+	0x08, 0xb7, 0x09, 0x55, 0xb4, 0xae, 0xdf, 0x9e, 0x3c, 0x40, 0xee, 0x2c, 0x02, 0xe2, 0x8c, 0x5d,
+	0x3e, 0xe8, 0x93, 0x3e, 0x61, 0xc3, 0x4b, 0x63, 0x6a, 0x8c, 0xe5, 0xcf, 0x31, 0xc6, 0x86, 0xe6,
+	0x8e, 0x8f, 0xaf, 0xf7, 0x21, 0x61, 0x98, 0xc4, 0x0a, 0x7b, 0x5c, 0x7a, 0xf3, 0xd6, 0x1c, 0xc7,
+	0x1f, 0x31, 0x2c, 0x5f, 0xf5, 0xf1, 0x38, 0xa4, 0x9a, 0x95, 0xc2, 0x0b, 0x73, 0xa7, 0xf0, 0xa6,
+	0xef, 0xc7, 0xe8, 0xab, 0xfd, 0xe8, 0x7b, 0x30, 0x07, 0x89, 0xc0, 0x10, 0x14, 0x87, 0x68, 0x6d,
+	0xbf, 0x5e, 0x12, 0x38, 0x67, 0x12, 0xc3, 0x52, 0x6d, 0xaf, 0x50, 0x94, 0x04, 0x3e, 0xf7, 0x19,
+	0x0f, 0xd9, 0x91, 0xc3, 0x94, 0x67, 0xb5, 0x8e, 0xff, 0x3d, 0x31, 0xd0, 0x1b, 0xb0, 0xd2, 0x55,
+	0x6c, 0x2a, 0xbb, 0x25, 0xdf, 0x6d, 0x6b, 0xac, 0x1b, 0xa4, 0x9c, 0xe5, 0x9d, 0xa2, 0x4e, 0x1d,
+	0x54, 0xee, 0x1f, 0x31, 0x58, 0x1e, 0x9b, 0xfb, 0x5e, 0x53, 0x88, 0x67, 0x84, 0x22, 0x32, 0x77,
+	0x28, 0xee, 0x42, 0xba, 0x6f, 0xce, 0x98, 0xc3, 0x7f, 0xfa, 0x12, 0x9f, 0x78, 0x13, 0x6c, 0x89,
+	0xc3, 0xcb, 0xfd, 0xb1, 0x37, 0xe9, 0x12, 0x24, 0x3f, 0x32, 0x34, 0x5d, 0x56, 0xdc, 0x79, 0x83,
+	0x05, 0xf7, 0x8d, 0x97, 0x10, 0x85, 0xc3, 0x49, 0x89, 0xc3, 0xf0, 0x51, 0x38, 0xaa, 0x94, 0x20,
+	0x15, 0xbc, 0x1f, 0x28, 0xcd, 0x0e, 0x6b, 0x83, 0xf3, 0xc4, 0xa9, 0xc4, 0xe1, 0xa4, 0x0f, 0x2d,
+	0x34, 0x3b, 0xe8, 0x2e, 0x2c, 0x07, 0x4c, 0xba, 0x43, 0xb5, 0x74, 0x1e, 0xaa, 0xc0, 0x8a, 0x8a,
+	0x32, 0xc1, 0x65, 0x13, 0x9d, 0xb2, 0x1e, 0x7a, 0x5e, 0xae, 0xba, 0xf3, 0xfe, 0xd5, 0x80, 0xe0,
+	0x45, 0x46, 0x6e, 0xb9, 0x85, 0x8a, 0xb5, 0x81, 0xb7, 0xe6, 0x60, 0xf3, 0x2a, 0x5b, 0x89, 0xc3,
+	0x69, 0x75, 0xbc, 0xd6, 0x55, 0x46, 0x58, 0x3f, 0x76, 0x52, 0x58, 0xcd, 0x24, 0xce, 0x63, 0x63,
+	0xc0, 0xe7, 0xe6, 0xbf, 0x8a, 0x0c, 0x58, 0x1b, 0xe7, 0x93, 0x47, 0xc6, 0x31, 0xb7, 0xe9, 0x26,
+	0x37, 0x37, 0x5e, 0x42, 0x3d, 0x2b, 0x03, 0x4b, 0x1c, 0xce, 0x8c, 0x6d, 0x33, 0xa2, 0xe4, 0x1c,
+	0xc0, 0x9f, 0xae, 0x65, 0xdb, 0xe8, 0x1e, 0x12, 0x95, 0x35, 0xed, 0x6b, 0x73, 0x8c, 0xd5, 0xce,
+	0x01, 0x7c, 0x74, 0xdd, 0x05, 0xa3, 0xc2, 0xf8, 0xd7, 0x90, 0xd4, 0x9c, 0x9d, 0x76, 0xe4, 0x4b,
+	0xc8, 0x56, 0x14, 0x22, 0x7d, 0x33, 0xf7, 0x38, 0x02, 0x19, 0x76, 0xcf, 0xd9, 0xb0, 0xb5, 0x63,
+	0x58, 0x3d, 0x85, 0x52, 0x62, 0xd9, 0xa8, 0x08, 0xa9, 0xbe, 0x29, 0xb7, 0xfc, 0x05, 0x37, 0xab,
+	0xd3, 0x9b, 0xeb, 0x93, 0x26, 0x4f, 0x02, 0x71, 0xb2, 0x6f, 0x06, 0x0f, 0xe8, 0x57, 0x70, 0x79,
+	0x94, 0x44, 0x36, 0x15, 0x4b, 0xe9, 0x11, 0x87, 0xce, 0x9b, 0xf1, 0x57, 0x47, 0x94, 0x6b, 0xbe,
+	0x0c, 0xdd, 0x01, 0x37, 0x66, 0x23, 0x9b, 0x2f, 0xcc, 0xb9, 0xb9, 0x7b, 0x97, 0xc3, 0xed, 0x6f,
+	0x41, 0x66, 0x9c, 0x68, 0xc4, 0x80, 0xa8, 0x6b, 0xc0, 0xe5, 0x31, 0x40, 0x60, 0x42, 0xee, 0xef,
+	0x3c, 0xac, 0x4e, 0xf4, 0x0d, 0xef, 0x1b, 0xc8, 0xeb, 0x29, 0x77, 0x63, 0x05, 0x3c, 0xf2, 0x43,
+	0x0a, 0xf8, 0xcf, 0xbf, 0xe0, 0x41, 0x98, 0xf4, 0x07, 0x42, 0x90, 0xde, 0xa9, 0xe2, 0x7b, 0x85,
+	0x46, 0x43, 0xc2, 0x72, 0xa5, 0x5a, 0x91, 0x04, 0x0e, 0x65, 0x60, 0x35, 0x5c, 0xc3, 0x52, 0xad,
+	0x5a, 0x2f, 0x37, 0xaa, 0xf8, 0xa1, 0xc0, 0xa3, 0x35, 0xb8, 0x1c, 0x4a, 0xee, 0xe0, 0x5a, 0x51,
+	0xae, 0x4b, 0xf8, 0x7e, 0xb9, 0x28, 0x09, 0x91, 0x71, 0xd4, 0xdd, 0xc2, 0xfd, 0x42, 0xbd, 0x88,
+	0xcb, 0xb5, 0x86, 0xb0, 0x30, 0x2e, 0x29, 0x16, 0x1e, 0x4a, 0x95, 0x8a, 0xb4, 0x57, 0xab, 0x79,
+	0xdf, 0x19, 0x42, 0x49, 0xbd, 0x58, 0x92, 0xee, 0x15, 0x84, 0xc5, 0xad, 0xbf, 0xf1, 0xcf, 0x4e,
+	0x45, 0xfe, 0xf9, 0xa9, 0xc8, 0x7f, 0x73, 0x2a, 0x72, 0xdf, 0x9e, 0x8a, 0xdc, 0x8b, 0x53, 0x91,
+	0xfb, 0xee, 0x54, 0xe4, 0xbe, 0x3f, 0x15, 0xf9, 0x4f, 0x86, 0x22, 0xff, 0xe9, 0x50, 0xe4, 0xbe,
+	0x1c, 0x8a, 0xfc, 0x57, 0x43, 0x91, 0x7b, 0x3a, 0x14, 0xb9, 0xaf, 0x87, 0x22, 0xf7, 0x6c, 0x28,
+	0xf2, 0xcf, 0x87, 0x22, 0xff, 0xcd, 0x50, 0xe4, 0xbe, 0x1d, 0x8a, 0xfc, 0x8b, 0xa1, 0xc8, 0x7d,
+	0x37, 0x14, 0xf9, 0xef, 0x87, 0x22, 0xf7, 0xc9, 0x99, 0xc8, 0x7d, 0x7a, 0x26, 0xf2, 0x9f, 0x9f,
+	0x89, 0xdc, 0xe3, 0x33, 0x91, 0x7f, 0x72, 0x26, 0x72, 0x5f, 0x9e, 0x89, 0xdc, 0x57, 0x67, 0x22,
+	0xff, 0xf4, 0x4c, 0xe4, 0xbf, 0x3e, 0x13, 0xf9, 0x3f, 0xfc, 0xa2, 0x6d, 0xe4, 0xe9, 0x23, 0x42,
+	0x1f, 0x39, 0xef, 0xd5, 0x79, 0x9d, 0xd0, 0x23, 0xc3, 0xea, 0x6c, 0x8c, 0x7f, 0x5b, 0x35, 0x3b,
+	0xed, 0x0d, 0x4a, 0x75, 0xf3, 0xe0, 0x60, 0xc9, 0x4d, 0xa3, 0x9b, 0xff, 0x09, 0x00, 0x00, 0xff,
+	0xff, 0x9c, 0xe9, 0x4f, 0x8a, 0x18, 0x17, 0x00, 0x00,
 }
@@ -8,8 +8,10 @@ import proto "github.com/gogo/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
+import _ "github.com/golang/protobuf/ptypes/duration"
 import _ "github.com/golang/protobuf/ptypes/struct"
 import _ "github.com/golang/protobuf/ptypes/timestamp"
+import _ "github.com/golang/protobuf/ptypes/wrappers"
 import _ "github.com/mwitkow/go-proto-validators"
 
 import time "time"
@@ -69,6 +71,11 @@ func (this *DownlinkMessage) Validate() error {
 	return nil
 }
 func (this *TxAcknowledgment) Validate() error {
+	if this.DownlinkMessage != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.DownlinkMessage); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("DownlinkMessage", err)
+		}
+	}
 	return nil
 }
 func (this *ApplicationUplink) Validate() error {
@@ -122,6 +129,11 @@ func (this *ApplicationDownlink) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("ClassBC", err)
 		}
 	}
+	if this.ExpiresAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ExpiresAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("ExpiresAt", err)
+		}
+	}
 	return nil
 }
 func (this *ApplicationDownlink_ClassBC) Validate() error {
@@ -149,6 +161,19 @@ func (this *ApplicationDownlinks) Validate() error {
 	}
 	return nil
 }
+func (this *ApplicationDownlinkPolicy) Validate() error {
+	if this.MaxConfirmedRetries != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.MaxConfirmedRetries); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("MaxConfirmedRetries", err)
+		}
+	}
+	if this.Expiry != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Expiry); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Expiry", err)
+		}
+	}
+	return nil
+}
 func (this *ApplicationDownlinkFailed) Validate() error {
 	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.ApplicationDownlink)); err != nil {
 		return github_com_mwitkow_go_proto_validators.FieldError("ApplicationDownlink", err)
@@ -158,6 +183,17 @@ func (this *ApplicationDownlinkFailed) Validate() error {
 	}
 	return nil
 }
+func (this *ApplicationDownlinkQueueResult) Validate() error {
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.EndDeviceIdentifiers)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("EndDeviceIdentifiers", err)
+	}
+	if this.Error != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Error); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Error", err)
+		}
+	}
+	return nil
+}
 func (this *ApplicationInvalidatedDownlinks) Validate() error {
 	for _, item := range this.Downlinks {
 		if item != nil {
@@ -235,6 +271,11 @@ func (this *ApplicationUp) Validate() error {
 			}
 		}
 	}
+	if this.ReceivedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ReceivedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("ReceivedAt", err)
+		}
+	}
 	return nil
 }
 func (this *MessagePayloadFormatters) Validate() error {
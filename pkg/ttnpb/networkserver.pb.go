@@ -13,7 +13,6 @@ import _ "google.golang.org/genproto/googleapis/api/annotations"
 
 import (
 	context "context"
-
 	grpc "google.golang.org/grpc"
 )
 
@@ -42,6 +41,8 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type GsNsClient interface {
 	HandleUplink(ctx context.Context, in *UplinkMessage, opts ...grpc.CallOption) (*types.Empty, error)
+	// HandleTxAcknowledgment handles the acknowledgment of the transmission of a downlink message, that was scheduled by the Network Server.
+	HandleTxAcknowledgment(ctx context.Context, in *TxAcknowledgment, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type gsNsClient struct {
@@ -61,9 +62,20 @@ func (c *gsNsClient) HandleUplink(ctx context.Context, in *UplinkMessage, opts .
 	return out, nil
 }
 
+func (c *gsNsClient) HandleTxAcknowledgment(ctx context.Context, in *TxAcknowledgment, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GsNs/HandleTxAcknowledgment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GsNsServer is the server API for GsNs service.
 type GsNsServer interface {
 	HandleUplink(context.Context, *UplinkMessage) (*types.Empty, error)
+	// HandleTxAcknowledgment handles the acknowledgment of the transmission of a downlink message, that was scheduled by the Network Server.
+	HandleTxAcknowledgment(context.Context, *TxAcknowledgment) (*types.Empty, error)
 }
 
 func RegisterGsNsServer(s *grpc.Server, srv GsNsServer) {
@@ -88,6 +100,24 @@ func _GsNs_HandleUplink_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GsNs_HandleTxAcknowledgment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxAcknowledgment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsNsServer).HandleTxAcknowledgment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.GsNs/HandleTxAcknowledgment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsNsServer).HandleTxAcknowledgment(ctx, req.(*TxAcknowledgment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _GsNs_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.GsNs",
 	HandlerType: (*GsNsServer)(nil),
@@ -96,6 +126,10 @@ var _GsNs_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HandleUplink",
 			Handler:    _GsNs_HandleUplink_Handler,
 		},
+		{
+			MethodName: "HandleTxAcknowledgment",
+			Handler:    _GsNs_HandleTxAcknowledgment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/networkserver.proto",
@@ -438,52 +472,53 @@ var _NsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("lorawan-stack/api/networkserver.proto", fileDescriptor_networkserver_9c56cf1de73aa617)
+	proto.RegisterFile("lorawan-stack/api/networkserver.proto", fileDescriptor_networkserver_870eb1d10a7df9fd)
 }
 func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/networkserver.proto", fileDescriptor_networkserver_9c56cf1de73aa617)
-}
-
-var fileDescriptor_networkserver_9c56cf1de73aa617 = []byte{
-	// 627 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x31, 0x48, 0x1c, 0x4f,
-	0x14, 0xc6, 0x67, 0x54, 0x2c, 0x96, 0x3f, 0xff, 0x90, 0x4d, 0x08, 0xe4, 0x92, 0x3c, 0xc2, 0x69,
-	0x20, 0x48, 0xdc, 0x0d, 0xda, 0xd9, 0x19, 0xee, 0xb8, 0x08, 0x2a, 0x51, 0x63, 0x63, 0x0a, 0xd9,
-	0xbb, 0x7b, 0xee, 0x0d, 0xb7, 0xce, 0x6c, 0x76, 0xe6, 0x14, 0x09, 0x82, 0xa4, 0xb2, 0x0c, 0x84,
-	0x40, 0xca, 0x90, 0x4a, 0x48, 0x23, 0x56, 0x96, 0x96, 0x96, 0x42, 0x1a, 0xab, 0xc4, 0x9d, 0x4d,
-	0x61, 0x69, 0x29, 0xa9, 0xc2, 0xed, 0xae, 0xde, 0x79, 0xeb, 0x85, 0x1c, 0x49, 0xb7, 0x33, 0xef,
-	0xfb, 0xbe, 0xf9, 0xbd, 0x99, 0xd9, 0x31, 0x1e, 0x79, 0x22, 0x70, 0xd6, 0x1d, 0x3e, 0x2a, 0x95,
-	0x53, 0xa9, 0xdb, 0x8e, 0xcf, 0x6c, 0x8e, 0x6a, 0x5d, 0x04, 0x75, 0x89, 0xc1, 0x1a, 0x06, 0x96,
-	0x1f, 0x08, 0x25, 0xcc, 0xff, 0x95, 0xe2, 0x56, 0x2a, 0xb5, 0xd6, 0xc6, 0x73, 0xa3, 0x2e, 0x53,
-	0xb5, 0x46, 0xd9, 0xaa, 0x88, 0x55, 0xdb, 0x15, 0xae, 0xb0, 0x63, 0x59, 0xb9, 0xb1, 0x12, 0x8f,
-	0xe2, 0x41, 0xfc, 0x95, 0xd8, 0x73, 0xf7, 0x5d, 0x21, 0x5c, 0x0f, 0xe3, 0x78, 0x87, 0x73, 0xa1,
-	0x1c, 0xc5, 0x04, 0x97, 0x69, 0xf5, 0x5e, 0x5a, 0xbd, 0xcc, 0xc0, 0x55, 0x5f, 0x6d, 0xa4, 0xc5,
-	0x7c, 0x16, 0x10, 0x79, 0x75, 0xb9, 0x8a, 0x6b, 0xac, 0x82, 0xa9, 0x66, 0x28, 0xab, 0x61, 0x55,
-	0xe4, 0x8a, 0xad, 0x30, 0x0c, 0x2e, 0x56, 0x79, 0x98, 0x15, 0xad, 0xa2, 0x94, 0x8e, 0x8b, 0xa9,
-	0x62, 0x6c, 0xc6, 0x18, 0x28, 0xc9, 0x59, 0x69, 0x16, 0x8d, 0xff, 0x9e, 0x3b, 0xbc, 0xea, 0xe1,
-	0xa2, 0xef, 0x31, 0x5e, 0x37, 0x1f, 0x58, 0x57, 0xbb, 0xb7, 0x92, 0xf9, 0x99, 0xc4, 0x9d, 0xbb,
-	0x63, 0x25, 0xfc, 0xd6, 0x05, 0xbf, 0x55, 0x6c, 0xf2, 0x8f, 0x7d, 0xeb, 0x33, 0x06, 0x26, 0x9b,
-	0x79, 0xd3, 0xc6, 0x8d, 0x69, 0xc6, 0xeb, 0x93, 0xbe, 0xef, 0xb1, 0x4a, 0xdc, 0xb9, 0xd9, 0xc5,
-	0x93, 0xcb, 0x2c, 0xd5, 0x66, 0x5a, 0xf4, 0x1f, 0xd3, 0xa7, 0xd4, 0x7c, 0x69, 0xdc, 0x2e, 0x88,
-	0x75, 0xde, 0x24, 0x98, 0x6b, 0x60, 0x03, 0xe7, 0xd1, 0xf7, 0x9c, 0x0a, 0x9a, 0xc3, 0x9d, 0xd6,
-	0x0e, 0xd5, 0xeb, 0x06, 0x4a, 0xd5, 0x0d, 0xd6, 0x9c, 0x33, 0x6e, 0x5e, 0xd1, 0xbf, 0x68, 0xc8,
-	0xda, 0x5f, 0x46, 0x2e, 0x77, 0x44, 0x4e, 0x33, 0xa9, 0xb2, 0x91, 0x45, 0x5e, 0x2d, 0xc4, 0x67,
-	0x39, 0xd5, 0x3a, 0xb1, 0xdc, 0xf0, 0x6f, 0xb6, 0xe1, 0x22, 0x53, 0x8e, 0x7d, 0x1f, 0x30, 0x6e,
-	0xcd, 0xca, 0xcb, 0x80, 0x79, 0x74, 0x99, 0x54, 0xc1, 0x86, 0xb9, 0x47, 0x8d, 0xfe, 0x12, 0x2a,
-	0x73, 0xa8, 0x33, 0xa5, 0x84, 0xaa, 0x4d, 0x9d, 0xd0, 0xdf, 0xed, 0x0a, 0x94, 0xaf, 0xbf, 0xfd,
-	0xfa, 0xe3, 0x7d, 0x1f, 0x9a, 0x15, 0x9b, 0x4b, 0xdb, 0x69, 0x11, 0x48, 0xfb, 0x4d, 0xeb, 0x0a,
-	0x2e, 0xb3, 0xaa, 0xb4, 0xda, 0x8a, 0xd7, 0x8c, 0x37, 0xed, 0x44, 0x9a, 0xf5, 0x5d, 0x7e, 0x6e,
-	0x9a, 0x3f, 0xa9, 0xd1, 0xbf, 0x70, 0x1d, 0xf4, 0x42, 0x6f, 0xd0, 0x7b, 0x34, 0xa6, 0xfe, 0x42,
-	0x73, 0xaf, 0xb2, 0xd8, 0xe9, 0x5f, 0xd3, 0x13, 0x72, 0x9b, 0xa7, 0x85, 0x3b, 0x41, 0x47, 0x96,
-	0xa6, 0xf2, 0x85, 0x7f, 0xb1, 0xc2, 0x04, 0x1d, 0x31, 0x3f, 0x50, 0x63, 0xb0, 0x80, 0x1e, 0x2a,
-	0xfc, 0xc3, 0x0b, 0xd2, 0xe5, 0xce, 0xe5, 0x67, 0xe2, 0xe6, 0x4b, 0x23, 0xc5, 0x2c, 0x59, 0x8f,
-	0x0d, 0x37, 0xe7, 0x9e, 0x7d, 0xa6, 0x87, 0x21, 0xd0, 0xa3, 0x10, 0xe8, 0x71, 0x08, 0xe4, 0x24,
-	0x04, 0x72, 0x1a, 0x02, 0x39, 0x0b, 0x81, 0x9c, 0x87, 0x40, 0xb7, 0x34, 0xd0, 0x6d, 0x0d, 0x64,
-	0x47, 0x03, 0xdd, 0xd5, 0x40, 0xf6, 0x35, 0x90, 0x03, 0x0d, 0xe4, 0x50, 0x03, 0x3d, 0xd2, 0x40,
-	0x8f, 0x35, 0x90, 0x13, 0x0d, 0xf4, 0x54, 0x03, 0x39, 0xd3, 0x40, 0xcf, 0x35, 0x90, 0xad, 0x08,
-	0xc8, 0x76, 0x04, 0xf4, 0x5d, 0x04, 0xe4, 0x63, 0x04, 0xf4, 0x53, 0x04, 0x64, 0x27, 0x02, 0xb2,
-	0x1b, 0x01, 0xdd, 0x8f, 0x80, 0x1e, 0x44, 0x40, 0x97, 0x9e, 0xb8, 0xc2, 0x52, 0x35, 0x54, 0x35,
-	0xc6, 0x5d, 0x69, 0xa5, 0xef, 0xb1, 0x7d, 0xf5, 0xfd, 0xf2, 0xeb, 0xae, 0xad, 0x14, 0xf7, 0xcb,
-	0xe5, 0xc1, 0x78, 0x0f, 0xc6, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x8a, 0xf3, 0xf0, 0xbc, 0xcb,
-	0x05, 0x00, 0x00,
+	golang_proto.RegisterFile("lorawan-stack/api/networkserver.proto", fileDescriptor_networkserver_870eb1d10a7df9fd)
+}
+
+var fileDescriptor_networkserver_870eb1d10a7df9fd = []byte{
+	// 653 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x3f, 0x48, 0x1c, 0x41,
+	0x14, 0xc6, 0x67, 0x54, 0x2c, 0x96, 0x90, 0x90, 0x49, 0x10, 0x72, 0x49, 0x1e, 0x72, 0x1a, 0x08,
+	0x12, 0x77, 0x83, 0x76, 0x76, 0x86, 0x3b, 0x2e, 0x82, 0x4a, 0xfc, 0xd7, 0x98, 0x42, 0xf6, 0xee,
+	0x9e, 0x7b, 0xc3, 0xed, 0xcd, 0x6c, 0x6e, 0xe6, 0xbc, 0x48, 0x10, 0x24, 0x95, 0x65, 0x20, 0x04,
+	0x52, 0x26, 0xa9, 0x84, 0x34, 0x62, 0x65, 0x69, 0x69, 0x29, 0xa4, 0xb1, 0x4a, 0xbc, 0xdd, 0x14,
+	0x96, 0x96, 0x92, 0x2a, 0xdc, 0xee, 0xea, 0x9d, 0xb7, 0x5e, 0x88, 0x24, 0xdd, 0xce, 0xbc, 0xef,
+	0x7d, 0xf3, 0xfb, 0x86, 0xb7, 0x63, 0x3c, 0x72, 0x65, 0xd5, 0xae, 0xdb, 0x62, 0x54, 0x69, 0xbb,
+	0x50, 0xb6, 0x6c, 0x8f, 0x5b, 0x02, 0x75, 0x5d, 0x56, 0xcb, 0x0a, 0xab, 0x6b, 0x58, 0x35, 0xbd,
+	0xaa, 0xd4, 0x92, 0xdd, 0xd4, 0x5a, 0x98, 0xb1, 0xd4, 0x5c, 0x1b, 0x4f, 0x8d, 0x3a, 0x5c, 0x97,
+	0x6a, 0x79, 0xb3, 0x20, 0x2b, 0x96, 0x23, 0x1d, 0x69, 0x85, 0xb2, 0x7c, 0x6d, 0x35, 0x5c, 0x85,
+	0x8b, 0xf0, 0x2b, 0x6a, 0x4f, 0x3d, 0x70, 0xa4, 0x74, 0x5c, 0x0c, 0xed, 0x6d, 0x21, 0xa4, 0xb6,
+	0x35, 0x97, 0x42, 0xc5, 0xd5, 0xfb, 0x71, 0xf5, 0xc2, 0x03, 0x2b, 0x9e, 0x5e, 0x8f, 0x8b, 0xe9,
+	0x24, 0x20, 0x8a, 0xe2, 0x4a, 0x11, 0xd7, 0x78, 0x01, 0x63, 0xcd, 0x50, 0x52, 0xc3, 0x8b, 0x28,
+	0x34, 0x5f, 0xe5, 0x58, 0x3d, 0x3f, 0x65, 0x30, 0x29, 0xaa, 0xa0, 0x52, 0xb6, 0x83, 0xb1, 0x62,
+	0xec, 0x33, 0x35, 0xfa, 0x72, 0x6a, 0x56, 0xb1, 0xac, 0x71, 0xe3, 0xb9, 0x2d, 0x8a, 0x2e, 0x2e,
+	0x79, 0x2e, 0x17, 0x65, 0xf6, 0xd0, 0xbc, 0x1c, 0xdf, 0x8c, 0xf6, 0x67, 0xa2, 0xf6, 0xd4, 0x80,
+	0x19, 0x05, 0x30, 0xcf, 0x03, 0x98, 0xd9, 0x66, 0x00, 0x36, 0x6f, 0x0c, 0x44, 0x36, 0x8b, 0xaf,
+	0x27, 0x0b, 0x65, 0x21, 0xeb, 0x2e, 0x16, 0x9d, 0x0a, 0x0a, 0xcd, 0x06, 0x3b, 0x0d, 0x3b, 0x15,
+	0xdd, 0x3c, 0xc7, 0xbe, 0xf7, 0x18, 0x7d, 0x93, 0x4d, 0xc6, 0x69, 0xe3, 0xd6, 0x34, 0x17, 0xe5,
+	0x49, 0xcf, 0x73, 0x79, 0x21, 0xbc, 0x4e, 0xd6, 0xa5, 0x27, 0x95, 0xc0, 0x6f, 0x6b, 0x5a, 0xf2,
+	0x1e, 0xd3, 0xa7, 0x94, 0x2d, 0x1a, 0x77, 0x33, 0xb2, 0x2e, 0x9a, 0xa9, 0xe6, 0x6a, 0x58, 0xc3,
+	0x79, 0xf4, 0x5c, 0xbb, 0x80, 0x6c, 0xb8, 0xb3, 0xb5, 0x43, 0xf5, 0xaa, 0x86, 0xaa, 0x2b, 0x2c,
+	0x9b, 0x33, 0x6e, 0x5f, 0xd2, 0xbf, 0xa8, 0xa9, 0xd2, 0x3f, 0x5a, 0xae, 0x74, 0x58, 0x4e, 0x73,
+	0xa5, 0x93, 0x96, 0x59, 0x51, 0xcc, 0x84, 0x03, 0x32, 0xd5, 0x1a, 0x83, 0xd4, 0xf0, 0x1f, 0xae,
+	0xe1, 0xdc, 0x53, 0x8d, 0xfd, 0xe8, 0x33, 0xee, 0xcc, 0xaa, 0x0b, 0x83, 0x79, 0x74, 0xb8, 0xd2,
+	0xd5, 0x75, 0xb6, 0x4b, 0x8d, 0xde, 0x1c, 0x6a, 0x36, 0xd4, 0xe9, 0x92, 0x43, 0xdd, 0xa6, 0x8e,
+	0xe8, 0xef, 0x75, 0x05, 0x4a, 0x97, 0xdf, 0x7e, 0xfb, 0xf9, 0xbe, 0x07, 0x59, 0xc1, 0x12, 0xca,
+	0xb2, 0x5b, 0x04, 0xca, 0x7a, 0xd3, 0x9a, 0xeb, 0x15, 0x5e, 0x54, 0x66, 0x5b, 0xf1, 0x8a, 0xf5,
+	0x86, 0x15, 0x49, 0x93, 0x7d, 0x17, 0x9f, 0x1b, 0xec, 0x17, 0x35, 0x7a, 0x17, 0xae, 0x82, 0x5e,
+	0xb8, 0x1e, 0xf4, 0x2e, 0x0d, 0xa9, 0xbf, 0xd2, 0xd4, 0xcb, 0x24, 0x76, 0xfc, 0x2b, 0x5e, 0x0b,
+	0xb9, 0xad, 0xa7, 0x85, 0x3b, 0x41, 0x47, 0x96, 0xa7, 0xd2, 0x99, 0xff, 0x71, 0xc2, 0x04, 0x1d,
+	0x61, 0x1f, 0xa8, 0xd1, 0x9f, 0x41, 0x17, 0x35, 0xfe, 0xe5, 0x80, 0x74, 0x99, 0xb9, 0xf4, 0x4c,
+	0x18, 0x3e, 0x37, 0x92, 0x4d, 0x92, 0x5d, 0x33, 0x70, 0x73, 0xef, 0xd9, 0x17, 0x7a, 0xd0, 0x00,
+	0x7a, 0xd8, 0x00, 0x7a, 0xd4, 0x00, 0x72, 0xdc, 0x00, 0x72, 0xd2, 0x00, 0x72, 0xda, 0x00, 0x72,
+	0xd6, 0x00, 0xba, 0xe9, 0x03, 0xdd, 0xf2, 0x81, 0x6c, 0xfb, 0x40, 0x77, 0x7c, 0x20, 0x7b, 0x3e,
+	0x90, 0x7d, 0x1f, 0xc8, 0x81, 0x0f, 0xf4, 0xd0, 0x07, 0x7a, 0xe4, 0x03, 0x39, 0xf6, 0x81, 0x9e,
+	0xf8, 0x40, 0x4e, 0x7d, 0xa0, 0x67, 0x3e, 0x90, 0xcd, 0x00, 0xc8, 0x56, 0x00, 0xf4, 0x5d, 0x00,
+	0xe4, 0x63, 0x00, 0xf4, 0x53, 0x00, 0x64, 0x3b, 0x00, 0xb2, 0x13, 0x00, 0xdd, 0x0b, 0x80, 0xee,
+	0x07, 0x40, 0x97, 0x9f, 0x38, 0xd2, 0xd4, 0x25, 0xd4, 0x25, 0x2e, 0x1c, 0x65, 0xc6, 0x8f, 0xbc,
+	0x75, 0xf9, 0x51, 0xf4, 0xca, 0x8e, 0xa5, 0xb5, 0xf0, 0xf2, 0xf9, 0xfe, 0xf0, 0x0e, 0xc6, 0x7f,
+	0x07, 0x00, 0x00, 0xff, 0xff, 0x57, 0xb7, 0x21, 0x38, 0x20, 0x06, 0x00, 0x00,
 }
@@ -396,6 +396,137 @@ func (dst *OAuthAuthorizationCode) SetFields(src *OAuthAuthorizationCode, paths
 	return nil
 }
 
+var OAuthDeviceAuthorizationFieldPathsNested = []string{
+	"authorized",
+	"client_ids",
+	"client_ids.client_id",
+	"created_at",
+	"device_code",
+	"expires_at",
+	"rights",
+	"user_code",
+	"user_ids",
+	"user_ids.email",
+	"user_ids.user_id",
+}
+
+var OAuthDeviceAuthorizationFieldPathsTopLevel = []string{
+	"authorized",
+	"client_ids",
+	"created_at",
+	"device_code",
+	"expires_at",
+	"rights",
+	"user_code",
+	"user_ids",
+}
+
+func (dst *OAuthDeviceAuthorization) SetFields(src *OAuthDeviceAuthorization, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "client_ids":
+			if len(subs) > 0 {
+				newDst := &dst.ClientIDs
+				var newSrc *ClientIdentifiers
+				if src != nil {
+					newSrc = &src.ClientIDs
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ClientIDs = src.ClientIDs
+				} else {
+					var zero ClientIdentifiers
+					dst.ClientIDs = zero
+				}
+			}
+		case "user_ids":
+			if len(subs) > 0 {
+				newDst := &dst.UserIDs
+				var newSrc *UserIdentifiers
+				if src != nil {
+					newSrc = &src.UserIDs
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UserIDs = src.UserIDs
+				} else {
+					var zero UserIdentifiers
+					dst.UserIDs = zero
+				}
+			}
+		case "rights":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rights' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Rights = src.Rights
+			} else {
+				dst.Rights = nil
+			}
+		case "device_code":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_code' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceCode = src.DeviceCode
+			} else {
+				var zero string
+				dst.DeviceCode = zero
+			}
+		case "user_code":
+			if len(subs) > 0 {
+				return fmt.Errorf("'user_code' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UserCode = src.UserCode
+			} else {
+				var zero string
+				dst.UserCode = zero
+			}
+		case "authorized":
+			if len(subs) > 0 {
+				return fmt.Errorf("'authorized' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Authorized = src.Authorized
+			} else {
+				var zero bool
+				dst.Authorized = zero
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				var zero time.Time
+				dst.CreatedAt = zero
+			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				var zero time.Time
+				dst.ExpiresAt = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 var OAuthAccessTokenIdentifiersFieldPathsNested = []string{
 	"client_ids",
 	"client_ids.client_id",
@@ -42,7 +42,7 @@ type OAuthClientAuthorizationIdentifiers struct {
 func (m *OAuthClientAuthorizationIdentifiers) Reset()      { *m = OAuthClientAuthorizationIdentifiers{} }
 func (*OAuthClientAuthorizationIdentifiers) ProtoMessage() {}
 func (*OAuthClientAuthorizationIdentifiers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_oauth_c8a6878985e94c8c, []int{0}
+	return fileDescriptor_oauth_30886a0a379befae, []int{0}
 }
 func (m *OAuthClientAuthorizationIdentifiers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -98,7 +98,7 @@ type OAuthClientAuthorization struct {
 func (m *OAuthClientAuthorization) Reset()      { *m = OAuthClientAuthorization{} }
 func (*OAuthClientAuthorization) ProtoMessage() {}
 func (*OAuthClientAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_oauth_c8a6878985e94c8c, []int{1}
+	return fileDescriptor_oauth_30886a0a379befae, []int{1}
 }
 func (m *OAuthClientAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -171,7 +171,7 @@ type OAuthClientAuthorizations struct {
 func (m *OAuthClientAuthorizations) Reset()      { *m = OAuthClientAuthorizations{} }
 func (*OAuthClientAuthorizations) ProtoMessage() {}
 func (*OAuthClientAuthorizations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_oauth_c8a6878985e94c8c, []int{2}
+	return fileDescriptor_oauth_30886a0a379befae, []int{2}
 }
 func (m *OAuthClientAuthorizations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -223,7 +223,7 @@ type ListOAuthClientAuthorizationsRequest struct {
 func (m *ListOAuthClientAuthorizationsRequest) Reset()      { *m = ListOAuthClientAuthorizationsRequest{} }
 func (*ListOAuthClientAuthorizationsRequest) ProtoMessage() {}
 func (*ListOAuthClientAuthorizationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_oauth_c8a6878985e94c8c, []int{3}
+	return fileDescriptor_oauth_30886a0a379befae, []int{3}
 }
 func (m *ListOAuthClientAuthorizationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,7 +289,7 @@ type OAuthAuthorizationCode struct {
 func (m *OAuthAuthorizationCode) Reset()      { *m = OAuthAuthorizationCode{} }
 func (*OAuthAuthorizationCode) ProtoMessage() {}
 func (*OAuthAuthorizationCode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_oauth_c8a6878985e94c8c, []int{4}
+	return fileDescriptor_oauth_30886a0a379befae, []int{4}
 }
 func (m *OAuthAuthorizationCode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -374,6 +374,110 @@ func (m *OAuthAuthorizationCode) GetExpiresAt() time.Time {
 	return time.Time{}
 }
 
+type OAuthDeviceAuthorization struct {
+	ClientIDs ClientIdentifiers `protobuf:"bytes,1,opt,name=client_ids,json=clientIds,proto3" json:"client_ids"`
+	// The user that approved or denied the device authorization.
+	// This is empty while the device authorization is pending.
+	UserIDs    UserIdentifiers `protobuf:"bytes,2,opt,name=user_ids,json=userIds,proto3" json:"user_ids"`
+	Rights     []Right         `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	DeviceCode string          `protobuf:"bytes,4,opt,name=device_code,json=deviceCode,proto3" json:"device_code,omitempty"`
+	UserCode   string          `protobuf:"bytes,5,opt,name=user_code,json=userCode,proto3" json:"user_code,omitempty"`
+	// Whether the user approved the device authorization.
+	Authorized           bool      `protobuf:"varint,6,opt,name=authorized,proto3" json:"authorized,omitempty"`
+	CreatedAt            time.Time `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	ExpiresAt            time.Time `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *OAuthDeviceAuthorization) Reset()      { *m = OAuthDeviceAuthorization{} }
+func (*OAuthDeviceAuthorization) ProtoMessage() {}
+func (*OAuthDeviceAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_oauth_30886a0a379befae, []int{5}
+}
+func (m *OAuthDeviceAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OAuthDeviceAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OAuthDeviceAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *OAuthDeviceAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OAuthDeviceAuthorization.Merge(dst, src)
+}
+func (m *OAuthDeviceAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *OAuthDeviceAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_OAuthDeviceAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OAuthDeviceAuthorization proto.InternalMessageInfo
+
+func (m *OAuthDeviceAuthorization) GetClientIDs() ClientIdentifiers {
+	if m != nil {
+		return m.ClientIDs
+	}
+	return ClientIdentifiers{}
+}
+
+func (m *OAuthDeviceAuthorization) GetUserIDs() UserIdentifiers {
+	if m != nil {
+		return m.UserIDs
+	}
+	return UserIdentifiers{}
+}
+
+func (m *OAuthDeviceAuthorization) GetRights() []Right {
+	if m != nil {
+		return m.Rights
+	}
+	return nil
+}
+
+func (m *OAuthDeviceAuthorization) GetDeviceCode() string {
+	if m != nil {
+		return m.DeviceCode
+	}
+	return ""
+}
+
+func (m *OAuthDeviceAuthorization) GetUserCode() string {
+	if m != nil {
+		return m.UserCode
+	}
+	return ""
+}
+
+func (m *OAuthDeviceAuthorization) GetAuthorized() bool {
+	if m != nil {
+		return m.Authorized
+	}
+	return false
+}
+
+func (m *OAuthDeviceAuthorization) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *OAuthDeviceAuthorization) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
 type OAuthAccessTokenIdentifiers struct {
 	UserIDs              UserIdentifiers   `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3" json:"user_ids"`
 	ClientIDs            ClientIdentifiers `protobuf:"bytes,2,opt,name=client_ids,json=clientIds,proto3" json:"client_ids"`
@@ -385,7 +489,7 @@ type OAuthAccessTokenIdentifiers struct {
 func (m *OAuthAccessTokenIdentifiers) Reset()      { *m = OAuthAccessTokenIdentifiers{} }
 func (*OAuthAccessTokenIdentifiers) ProtoMessage() {}
 func (*OAuthAccessTokenIdentifiers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_oauth_c8a6878985e94c8c, []int{5}
+	return fileDescriptor_oauth_30886a0a379befae, []int{6}
 }
 func (m *OAuthAccessTokenIdentifiers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -451,7 +555,7 @@ type OAuthAccessToken struct {
 func (m *OAuthAccessToken) Reset()      { *m = OAuthAccessToken{} }
 func (*OAuthAccessToken) ProtoMessage() {}
 func (*OAuthAccessToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_oauth_c8a6878985e94c8c, []int{6}
+	return fileDescriptor_oauth_30886a0a379befae, []int{7}
 }
 func (m *OAuthAccessToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -545,7 +649,7 @@ type OAuthAccessTokens struct {
 func (m *OAuthAccessTokens) Reset()      { *m = OAuthAccessTokens{} }
 func (*OAuthAccessTokens) ProtoMessage() {}
 func (*OAuthAccessTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_oauth_c8a6878985e94c8c, []int{7}
+	return fileDescriptor_oauth_30886a0a379befae, []int{8}
 }
 func (m *OAuthAccessTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -598,7 +702,7 @@ type ListOAuthAccessTokensRequest struct {
 func (m *ListOAuthAccessTokensRequest) Reset()      { *m = ListOAuthAccessTokensRequest{} }
 func (*ListOAuthAccessTokensRequest) ProtoMessage() {}
 func (*ListOAuthAccessTokensRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_oauth_c8a6878985e94c8c, []int{8}
+	return fileDescriptor_oauth_30886a0a379befae, []int{9}
 }
 func (m *ListOAuthAccessTokensRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -673,6 +777,8 @@ func init() {
 	golang_proto.RegisterType((*ListOAuthClientAuthorizationsRequest)(nil), "ttn.lorawan.v3.ListOAuthClientAuthorizationsRequest")
 	proto.RegisterType((*OAuthAuthorizationCode)(nil), "ttn.lorawan.v3.OAuthAuthorizationCode")
 	golang_proto.RegisterType((*OAuthAuthorizationCode)(nil), "ttn.lorawan.v3.OAuthAuthorizationCode")
+	proto.RegisterType((*OAuthDeviceAuthorization)(nil), "ttn.lorawan.v3.OAuthDeviceAuthorization")
+	golang_proto.RegisterType((*OAuthDeviceAuthorization)(nil), "ttn.lorawan.v3.OAuthDeviceAuthorization")
 	proto.RegisterType((*OAuthAccessTokenIdentifiers)(nil), "ttn.lorawan.v3.OAuthAccessTokenIdentifiers")
 	golang_proto.RegisterType((*OAuthAccessTokenIdentifiers)(nil), "ttn.lorawan.v3.OAuthAccessTokenIdentifiers")
 	proto.RegisterType((*OAuthAccessToken)(nil), "ttn.lorawan.v3.OAuthAccessToken")
@@ -862,6 +968,56 @@ func (this *OAuthAuthorizationCode) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *OAuthDeviceAuthorization) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OAuthDeviceAuthorization)
+	if !ok {
+		that2, ok := that.(OAuthDeviceAuthorization)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ClientIDs.Equal(&that1.ClientIDs) {
+		return false
+	}
+	if !this.UserIDs.Equal(&that1.UserIDs) {
+		return false
+	}
+	if len(this.Rights) != len(that1.Rights) {
+		return false
+	}
+	for i := range this.Rights {
+		if this.Rights[i] != that1.Rights[i] {
+			return false
+		}
+	}
+	if this.DeviceCode != that1.DeviceCode {
+		return false
+	}
+	if this.UserCode != that1.UserCode {
+		return false
+	}
+	if this.Authorized != that1.Authorized {
+		return false
+	}
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
+	}
+	if !this.ExpiresAt.Equal(that1.ExpiresAt) {
+		return false
+	}
+	return true
+}
 func (this *OAuthAccessTokenIdentifiers) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1265,6 +1421,95 @@ func (m *OAuthAuthorizationCode) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *OAuthDeviceAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OAuthDeviceAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintOauth(dAtA, i, uint64(m.ClientIDs.Size()))
+	n16, err := m.ClientIDs.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n16
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintOauth(dAtA, i, uint64(m.UserIDs.Size()))
+	n17, err := m.UserIDs.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n17
+	if len(m.Rights) > 0 {
+		dAtA19 := make([]byte, len(m.Rights)*10)
+		var j18 int
+		for _, num := range m.Rights {
+			for num >= 1<<7 {
+				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j18++
+			}
+			dAtA19[j18] = uint8(num)
+			j18++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintOauth(dAtA, i, uint64(j18))
+		i += copy(dAtA[i:], dAtA19[:j18])
+	}
+	if len(m.DeviceCode) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintOauth(dAtA, i, uint64(len(m.DeviceCode)))
+		i += copy(dAtA[i:], m.DeviceCode)
+	}
+	if len(m.UserCode) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintOauth(dAtA, i, uint64(len(m.UserCode)))
+		i += copy(dAtA[i:], m.UserCode)
+	}
+	if m.Authorized {
+		dAtA[i] = 0x30
+		i++
+		if m.Authorized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintOauth(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)))
+	n20, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n20
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintOauth(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt)))
+	n21, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n21
+	return i, nil
+}
+
 func (m *OAuthAccessTokenIdentifiers) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1283,19 +1528,19 @@ func (m *OAuthAccessTokenIdentifiers) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintOauth(dAtA, i, uint64(m.UserIDs.Size()))
-	n16, err := m.UserIDs.MarshalTo(dAtA[i:])
+	n22, err := m.UserIDs.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
+	i += n22
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintOauth(dAtA, i, uint64(m.ClientIDs.Size()))
-	n17, err := m.ClientIDs.MarshalTo(dAtA[i:])
+	n23, err := m.ClientIDs.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n23
 	if len(m.ID) > 0 {
 		dAtA[i] = 0x1a
 		i++
@@ -1323,19 +1568,19 @@ func (m *OAuthAccessToken) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintOauth(dAtA, i, uint64(m.UserIDs.Size()))
-	n18, err := m.UserIDs.MarshalTo(dAtA[i:])
+	n24, err := m.UserIDs.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n24
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintOauth(dAtA, i, uint64(m.ClientIDs.Size()))
-	n19, err := m.ClientIDs.MarshalTo(dAtA[i:])
+	n25, err := m.ClientIDs.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n19
+	i += n25
 	if len(m.ID) > 0 {
 		dAtA[i] = 0x1a
 		i++
@@ -1355,38 +1600,38 @@ func (m *OAuthAccessToken) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], m.RefreshToken)
 	}
 	if len(m.Rights) > 0 {
-		dAtA21 := make([]byte, len(m.Rights)*10)
-		var j20 int
+		dAtA27 := make([]byte, len(m.Rights)*10)
+		var j26 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA21[j20] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA27[j26] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j20++
+				j26++
 			}
-			dAtA21[j20] = uint8(num)
-			j20++
+			dAtA27[j26] = uint8(num)
+			j26++
 		}
 		dAtA[i] = 0x32
 		i++
-		i = encodeVarintOauth(dAtA, i, uint64(j20))
-		i += copy(dAtA[i:], dAtA21[:j20])
+		i = encodeVarintOauth(dAtA, i, uint64(j26))
+		i += copy(dAtA[i:], dAtA27[:j26])
 	}
 	dAtA[i] = 0x3a
 	i++
 	i = encodeVarintOauth(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)))
-	n22, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i:])
+	n28, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n22
+	i += n28
 	dAtA[i] = 0x42
 	i++
 	i = encodeVarintOauth(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt)))
-	n23, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i:])
+	n29, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n23
+	i += n29
 	return i, nil
 }
 
@@ -1438,19 +1683,19 @@ func (m *ListOAuthAccessTokensRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintOauth(dAtA, i, uint64(m.UserIDs.Size()))
-	n24, err := m.UserIDs.MarshalTo(dAtA[i:])
+	n30, err := m.UserIDs.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n24
+	i += n30
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintOauth(dAtA, i, uint64(m.ClientIDs.Size()))
-	n25, err := m.ClientIDs.MarshalTo(dAtA[i:])
+	n31, err := m.ClientIDs.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n25
+	i += n31
 	if len(m.Order) > 0 {
 		dAtA[i] = 0x1a
 		i++
@@ -1559,12 +1804,35 @@ func NewPopulatedOAuthAuthorizationCode(r randyOauth, easy bool) *OAuthAuthoriza
 	return this
 }
 
+func NewPopulatedOAuthDeviceAuthorization(r randyOauth, easy bool) *OAuthDeviceAuthorization {
+	this := &OAuthDeviceAuthorization{}
+	v15 := NewPopulatedClientIdentifiers(r, easy)
+	this.ClientIDs = *v15
+	v16 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIDs = *v16
+	v17 := r.Intn(10)
+	this.Rights = make([]Right, v17)
+	for i := 0; i < v17; i++ {
+		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(56)])
+	}
+	this.DeviceCode = randStringOauth(r)
+	this.UserCode = randStringOauth(r)
+	this.Authorized = bool(r.Intn(2) == 0)
+	v18 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v18
+	v19 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.ExpiresAt = *v19
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedOAuthAccessTokenIdentifiers(r randyOauth, easy bool) *OAuthAccessTokenIdentifiers {
 	this := &OAuthAccessTokenIdentifiers{}
-	v15 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIDs = *v15
-	v16 := NewPopulatedClientIdentifiers(r, easy)
-	this.ClientIDs = *v16
+	v20 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIDs = *v20
+	v21 := NewPopulatedClientIdentifiers(r, easy)
+	this.ClientIDs = *v21
 	this.ID = randStringOauth(r)
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -1573,22 +1841,22 @@ func NewPopulatedOAuthAccessTokenIdentifiers(r randyOauth, easy bool) *OAuthAcce
 
 func NewPopulatedOAuthAccessToken(r randyOauth, easy bool) *OAuthAccessToken {
 	this := &OAuthAccessToken{}
-	v17 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIDs = *v17
-	v18 := NewPopulatedClientIdentifiers(r, easy)
-	this.ClientIDs = *v18
+	v22 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIDs = *v22
+	v23 := NewPopulatedClientIdentifiers(r, easy)
+	this.ClientIDs = *v23
 	this.ID = randStringOauth(r)
 	this.AccessToken = randStringOauth(r)
 	this.RefreshToken = randStringOauth(r)
-	v19 := r.Intn(10)
-	this.Rights = make([]Right, v19)
-	for i := 0; i < v19; i++ {
+	v24 := r.Intn(10)
+	this.Rights = make([]Right, v24)
+	for i := 0; i < v24; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(56)])
 	}
-	v20 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.CreatedAt = *v20
-	v21 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.ExpiresAt = *v21
+	v25 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v25
+	v26 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.ExpiresAt = *v26
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1597,9 +1865,9 @@ func NewPopulatedOAuthAccessToken(r randyOauth, easy bool) *OAuthAccessToken {
 func NewPopulatedOAuthAccessTokens(r randyOauth, easy bool) *OAuthAccessTokens {
 	this := &OAuthAccessTokens{}
 	if r.Intn(10) != 0 {
-		v22 := r.Intn(5)
-		this.Tokens = make([]*OAuthAccessToken, v22)
-		for i := 0; i < v22; i++ {
+		v27 := r.Intn(5)
+		this.Tokens = make([]*OAuthAccessToken, v27)
+		for i := 0; i < v27; i++ {
 			this.Tokens[i] = NewPopulatedOAuthAccessToken(r, easy)
 		}
 	}
@@ -1610,10 +1878,10 @@ func NewPopulatedOAuthAccessTokens(r randyOauth, easy bool) *OAuthAccessTokens {
 
 func NewPopulatedListOAuthAccessTokensRequest(r randyOauth, easy bool) *ListOAuthAccessTokensRequest {
 	this := &ListOAuthAccessTokensRequest{}
-	v23 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIDs = *v23
-	v24 := NewPopulatedClientIdentifiers(r, easy)
-	this.ClientIDs = *v24
+	v28 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIDs = *v28
+	v29 := NewPopulatedClientIdentifiers(r, easy)
+	this.ClientIDs = *v29
 	this.Order = randStringOauth(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
@@ -1641,9 +1909,9 @@ func randUTF8RuneOauth(r randyOauth) rune {
 	return rune(ru + 61)
 }
 func randStringOauth(r randyOauth) string {
-	v25 := r.Intn(100)
-	tmps := make([]rune, v25)
-	for i := 0; i < v25; i++ {
+	v30 := r.Intn(100)
+	tmps := make([]rune, v30)
+	for i := 0; i < v30; i++ {
 		tmps[i] = randUTF8RuneOauth(r)
 	}
 	return string(tmps)
@@ -1665,11 +1933,11 @@ func randFieldOauth(dAtA []byte, r randyOauth, fieldNumber int, wire int) []byte
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateOauth(dAtA, uint64(key))
-		v26 := r.Int63()
+		v31 := r.Int63()
 		if r.Intn(2) == 0 {
-			v26 *= -1
+			v31 *= -1
 		}
-		dAtA = encodeVarintPopulateOauth(dAtA, uint64(v26))
+		dAtA = encodeVarintPopulateOauth(dAtA, uint64(v31))
 	case 1:
 		dAtA = encodeVarintPopulateOauth(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -1803,6 +2071,41 @@ func (m *OAuthAuthorizationCode) Size() (n int) {
 	return n
 }
 
+func (m *OAuthDeviceAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ClientIDs.Size()
+	n += 1 + l + sovOauth(uint64(l))
+	l = m.UserIDs.Size()
+	n += 1 + l + sovOauth(uint64(l))
+	if len(m.Rights) > 0 {
+		l = 0
+		for _, e := range m.Rights {
+			l += sovOauth(uint64(e))
+		}
+		n += 1 + sovOauth(uint64(l)) + l
+	}
+	l = len(m.DeviceCode)
+	if l > 0 {
+		n += 1 + l + sovOauth(uint64(l))
+	}
+	l = len(m.UserCode)
+	if l > 0 {
+		n += 1 + l + sovOauth(uint64(l))
+	}
+	if m.Authorized {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovOauth(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovOauth(uint64(l))
+	return n
+}
+
 func (m *OAuthAccessTokenIdentifiers) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1972,6 +2275,23 @@ func (this *OAuthAuthorizationCode) String() string {
 	}, "")
 	return s
 }
+func (this *OAuthDeviceAuthorization) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OAuthDeviceAuthorization{`,
+		`ClientIDs:` + strings.Replace(strings.Replace(this.ClientIDs.String(), "ClientIdentifiers", "ClientIdentifiers", 1), `&`, ``, 1) + `,`,
+		`UserIDs:` + strings.Replace(strings.Replace(this.UserIDs.String(), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`DeviceCode:` + fmt.Sprintf("%v", this.DeviceCode) + `,`,
+		`UserCode:` + fmt.Sprintf("%v", this.UserCode) + `,`,
+		`Authorized:` + fmt.Sprintf("%v", this.Authorized) + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(this.CreatedAt.String(), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ExpiresAt:` + strings.Replace(strings.Replace(this.ExpiresAt.String(), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *OAuthAccessTokenIdentifiers) String() string {
 	if this == nil {
 		return "nil"
@@ -2930,6 +3250,320 @@ func (m *OAuthAuthorizationCode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *OAuthDeviceAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOauth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OAuthDeviceAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OAuthDeviceAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOauth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClientIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOauth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UserIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v Right
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOauth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (Right(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Rights = append(m.Rights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOauth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthOauth
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Rights) == 0 {
+					m.Rights = make([]Right, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Right
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOauth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (Right(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Rights = append(m.Rights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOauth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOauth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Authorized = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOauth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOauth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOauth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOauth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *OAuthAccessTokenIdentifiers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3756,63 +4390,68 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("lorawan-stack/api/oauth.proto", fileDescriptor_oauth_c8a6878985e94c8c)
+	proto.RegisterFile("lorawan-stack/api/oauth.proto", fileDescriptor_oauth_30886a0a379befae)
 }
 func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/oauth.proto", fileDescriptor_oauth_c8a6878985e94c8c)
-}
-
-var fileDescriptor_oauth_c8a6878985e94c8c = []byte{
-	// 816 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x3d, 0x70, 0x1b, 0x45,
-	0x18, 0xdd, 0x95, 0x6c, 0xd9, 0x5a, 0xd9, 0x0e, 0xb9, 0x81, 0x8c, 0x30, 0xb0, 0xa7, 0x9c, 0x29,
-	0x54, 0xe0, 0xd3, 0x8c, 0xd3, 0xd0, 0x5a, 0x76, 0xe3, 0x01, 0x06, 0x66, 0x89, 0x1b, 0x1a, 0xcf,
-	0xf9, 0x6e, 0x7d, 0xda, 0xb1, 0x74, 0x7b, 0xec, 0xee, 0x11, 0x86, 0xca, 0x65, 0xca, 0x94, 0x94,
-	0x0c, 0x55, 0x0a, 0x8a, 0x94, 0x29, 0xd3, 0xe1, 0x86, 0x19, 0x53, 0x91, 0x4a, 0x44, 0x7b, 0x4d,
-	0xca, 0x34, 0xcc, 0xa4, 0x64, 0x6e, 0x6f, 0x15, 0xfd, 0xc4, 0x2a, 0x44, 0x43, 0x92, 0x6e, 0x7f,
-	0xde, 0xf7, 0xf6, 0x7b, 0xf7, 0x3d, 0xbd, 0x11, 0xfa, 0xa4, 0xcf, 0x45, 0x70, 0x2f, 0x48, 0x76,
-	0xa5, 0x0a, 0xc2, 0xf3, 0x4e, 0x90, 0xb2, 0x0e, 0x0f, 0x32, 0xd5, 0xf3, 0x53, 0xc1, 0x15, 0x77,
-	0xb6, 0x94, 0x4a, 0x7c, 0x0b, 0xf1, 0x7f, 0xb8, 0xb3, 0xbd, 0x1b, 0x33, 0xd5, 0xcb, 0x4e, 0xfd,
-	0x90, 0x0f, 0x3a, 0x31, 0x8f, 0x79, 0xc7, 0xc0, 0x4e, 0xb3, 0x33, 0xb3, 0x33, 0x1b, 0xb3, 0x2a,
-	0xcb, 0xb7, 0xdd, 0x98, 0xf3, 0xb8, 0x4f, 0x27, 0x28, 0xc5, 0x06, 0x54, 0xaa, 0x60, 0x90, 0x5a,
-	0xc0, 0xce, 0xeb, 0xcf, 0xb3, 0x88, 0x26, 0x8a, 0x9d, 0x31, 0x2a, 0xa4, 0x05, 0xe1, 0xd7, 0x41,
-	0x82, 0xc5, 0x3d, 0x65, 0xef, 0xbd, 0xdf, 0x21, 0xda, 0xf9, 0x7a, 0x3f, 0x53, 0xbd, 0x83, 0x3e,
-	0xa3, 0x89, 0x2a, 0x56, 0x5c, 0xb0, 0x9f, 0x02, 0xc5, 0x78, 0x72, 0x34, 0x61, 0x73, 0xbe, 0x40,
-	0xeb, 0x99, 0xa4, 0xe2, 0x84, 0x45, 0xb2, 0x09, 0x5b, 0xb0, 0xdd, 0xd8, 0x73, 0xfd, 0x59, 0x7d,
-	0xfe, 0xb1, 0xa4, 0x62, 0xaa, 0xa4, 0x7b, 0xe3, 0x72, 0xe8, 0x02, 0x3d, 0x74, 0xd7, 0xcc, 0xc5,
-	0xa1, 0x24, 0x6b, 0x99, 0x41, 0x48, 0xe7, 0x5b, 0x84, 0x42, 0xf3, 0x9c, 0xa1, 0xab, 0x18, 0xba,
-	0xdb, 0xf3, 0x74, 0x65, 0x43, 0xd3, 0x84, 0x37, 0x2d, 0x61, 0xdd, 0x5e, 0x1d, 0x4a, 0x52, 0x0f,
-	0x2d, 0x4a, 0x7a, 0xff, 0x54, 0x50, 0x73, 0x91, 0x92, 0x37, 0xbf, 0x7d, 0x67, 0x17, 0xd5, 0xca,
-	0xc1, 0x34, 0xab, 0xad, 0x6a, 0x7b, 0x6b, 0xef, 0x83, 0x79, 0x42, 0x52, 0xdc, 0x12, 0x0b, 0x72,
-	0x0e, 0x10, 0x0a, 0x05, 0x0d, 0x14, 0x8d, 0x4e, 0x02, 0xd5, 0x5c, 0x31, 0x3d, 0x6c, 0xfb, 0xa5,
-	0x65, 0xfc, 0xb1, 0x65, 0xfc, 0xbb, 0x63, 0xcb, 0x74, 0xd7, 0x8b, 0xc7, 0x1f, 0xfc, 0xed, 0x42,
-	0x52, 0xb7, 0x75, 0xfb, 0xaa, 0x20, 0xc9, 0xd2, 0x68, 0x4c, 0xb2, 0xba, 0x0c, 0x89, 0xad, 0xdb,
-	0x57, 0xde, 0x00, 0x7d, 0xb8, 0xe8, 0xb3, 0x4b, 0xe7, 0x1b, 0xb4, 0x15, 0xcc, 0x9c, 0x34, 0x61,
-	0xab, 0xda, 0x6e, 0xec, 0xb5, 0xe7, 0xd5, 0x2d, 0xa2, 0x20, 0x73, 0xf5, 0xde, 0x6f, 0x10, 0x7d,
-	0xfa, 0x25, 0x93, 0x6a, 0xe1, 0x9b, 0x84, 0x7e, 0x9f, 0x51, 0xa9, 0x9c, 0xc3, 0xe5, 0x47, 0x6e,
-	0xf4, 0x5d, 0x0d, 0x5d, 0x38, 0x99, 0xf5, 0xfb, 0x68, 0x95, 0x8b, 0x88, 0x0a, 0x33, 0xe6, 0x3a,
-	0x29, 0x37, 0xc5, 0x69, 0x9f, 0x0d, 0x98, 0x6a, 0x56, 0x5b, 0xb0, 0xbd, 0x49, 0xca, 0x8d, 0xe3,
-	0xa0, 0x95, 0x34, 0x88, 0xa9, 0x99, 0xc6, 0x26, 0x31, 0x6b, 0xef, 0xcf, 0x2a, 0xba, 0x65, 0x5a,
-	0x9d, 0x69, 0xf2, 0x80, 0x47, 0xf4, 0xdd, 0xf3, 0xa4, 0x83, 0x56, 0x42, 0x1e, 0x95, 0xfa, 0xeb,
-	0xc4, 0xac, 0x9d, 0x3d, 0xb4, 0x21, 0x68, 0xc4, 0x04, 0x0d, 0xd5, 0x49, 0x26, 0x98, 0x31, 0x59,
-	0xbd, 0x7b, 0x43, 0x0f, 0xdd, 0x06, 0xb1, 0xe7, 0xc7, 0xe4, 0x88, 0x34, 0xc6, 0xa0, 0x63, 0xc1,
-	0x8a, 0xaf, 0x2b, 0x55, 0xa0, 0x68, 0xb3, 0x56, 0x7e, 0x73, 0xb3, 0x99, 0x73, 0xfc, 0xda, 0x7f,
-	0x76, 0x3c, 0xfd, 0x31, 0x65, 0x82, 0xca, 0x82, 0x64, 0x7d, 0x19, 0x12, 0x5b, 0xb7, 0xaf, 0xbc,
-	0xbf, 0x20, 0xfa, 0xa8, 0x9c, 0x69, 0x18, 0x52, 0x29, 0xef, 0xf2, 0x73, 0xfa, 0x76, 0x65, 0xa5,
-	0x73, 0x0b, 0x55, 0x58, 0x64, 0xcc, 0x5b, 0xef, 0xd6, 0xf4, 0xd0, 0xad, 0x1c, 0x1d, 0x92, 0x0a,
-	0x8b, 0xbc, 0x3f, 0xaa, 0xe8, 0xbd, 0x79, 0x65, 0x6f, 0xaf, 0x1c, 0xe7, 0x36, 0xda, 0x08, 0x8c,
-	0x90, 0x13, 0x55, 0x28, 0xb1, 0xc6, 0x6c, 0x04, 0x53, 0xe2, 0x76, 0xd0, 0xa6, 0xa0, 0x67, 0x82,
-	0xca, 0x9e, 0xc5, 0x18, 0x83, 0x92, 0x0d, 0x7b, 0x58, 0x82, 0x26, 0xbf, 0x83, 0xda, 0xf2, 0xd9,
-	0xfc, 0x7f, 0x3a, 0xf5, 0x2b, 0x74, 0x73, 0x7e, 0x9c, 0xd2, 0xf9, 0x1c, 0xd5, 0x8c, 0xd4, 0x71,
-	0x16, 0xb7, 0xae, 0xcd, 0xe2, 0xa9, 0x12, 0x62, 0xf1, 0xde, 0x45, 0x05, 0x7d, 0xfc, 0x2a, 0x7b,
-	0xa7, 0x39, 0xc7, 0x99, 0xfb, 0xe6, 0x5b, 0xe5, 0x55, 0x9e, 0x57, 0xaf, 0xcd, 0xf3, 0x95, 0xeb,
-	0xf2, 0x7c, 0x75, 0x92, 0xe7, 0xdd, 0x5f, 0xe1, 0xe5, 0x08, 0xc3, 0xab, 0x11, 0x86, 0x4f, 0x47,
-	0x18, 0x3c, 0x1b, 0x61, 0xf0, 0x7c, 0x84, 0xc1, 0x8b, 0x11, 0x06, 0x2f, 0x47, 0x18, 0x5e, 0x68,
-	0x0c, 0xef, 0x6b, 0x0c, 0x1e, 0x6a, 0x0c, 0x1f, 0x69, 0x0c, 0x1e, 0x6b, 0x0c, 0x9e, 0x68, 0x0c,
-	0x2e, 0x35, 0x86, 0x57, 0x1a, 0xc3, 0xa7, 0x1a, 0x83, 0x67, 0x1a, 0xc3, 0xe7, 0x1a, 0x83, 0x17,
-	0x1a, 0xc3, 0x97, 0x1a, 0x83, 0x8b, 0x1c, 0x83, 0xfb, 0x39, 0x86, 0x0f, 0x72, 0x0c, 0x7e, 0xce,
-	0x31, 0xfc, 0x25, 0xc7, 0xe0, 0x61, 0x8e, 0xc1, 0xa3, 0x1c, 0xc3, 0xc7, 0x39, 0x86, 0x4f, 0x72,
-	0x0c, 0xbf, 0xfb, 0x2c, 0xe6, 0xbe, 0xea, 0x51, 0xd5, 0x63, 0x49, 0x2c, 0xfd, 0x84, 0xaa, 0x7b,
-	0x5c, 0x9c, 0x77, 0x66, 0xff, 0xdb, 0xa5, 0xe7, 0x71, 0x47, 0xa9, 0x24, 0x3d, 0x3d, 0xad, 0x19,
-	0x7f, 0xdc, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0xb2, 0xf7, 0xe9, 0x80, 0xa1, 0x0a, 0x00, 0x00,
+	golang_proto.RegisterFile("lorawan-stack/api/oauth.proto", fileDescriptor_oauth_30886a0a379befae)
+}
+
+var fileDescriptor_oauth_30886a0a379befae = []byte{
+	// 886 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x31, 0x6c, 0x1b, 0x37,
+	0x14, 0x25, 0x25, 0x5b, 0xb6, 0x28, 0xdb, 0x69, 0x0e, 0x6d, 0xa0, 0x3a, 0x2d, 0x4f, 0x91, 0x3b,
+	0x68, 0xa8, 0x4f, 0x80, 0xb3, 0x74, 0xb5, 0xac, 0xc5, 0x68, 0x8b, 0x16, 0x6c, 0xbc, 0x74, 0x11,
+	0xce, 0x77, 0xf4, 0x89, 0xb0, 0x25, 0x5e, 0x49, 0x5e, 0x52, 0x74, 0xf2, 0x98, 0x31, 0x63, 0xc7,
+	0xa2, 0x53, 0x86, 0x0e, 0x19, 0x33, 0x66, 0xab, 0x97, 0x02, 0x2e, 0x3a, 0x34, 0x93, 0x1a, 0xf1,
+	0x96, 0x8c, 0x59, 0x0a, 0x64, 0x2c, 0x8e, 0x47, 0x45, 0x27, 0xc5, 0x1e, 0xd4, 0x16, 0xa8, 0xdd,
+	0x8d, 0xe4, 0x7f, 0xff, 0xf1, 0xff, 0x7b, 0xef, 0xee, 0x1f, 0xfa, 0xf0, 0x84, 0x0b, 0xff, 0x81,
+	0x3f, 0xdc, 0x96, 0xca, 0x0f, 0x8e, 0xdb, 0x7e, 0xcc, 0xda, 0xdc, 0x4f, 0x54, 0xdf, 0x8b, 0x05,
+	0x57, 0xdc, 0xd9, 0x50, 0x6a, 0xe8, 0x59, 0x88, 0x77, 0xff, 0xee, 0xe6, 0x76, 0xc4, 0x54, 0x3f,
+	0x39, 0xf4, 0x02, 0x3e, 0x68, 0x47, 0x3c, 0xe2, 0x6d, 0x03, 0x3b, 0x4c, 0x8e, 0xcc, 0xce, 0x6c,
+	0xcc, 0x2a, 0x4f, 0xdf, 0x74, 0x23, 0xce, 0xa3, 0x13, 0x3a, 0x45, 0x29, 0x36, 0xa0, 0x52, 0xf9,
+	0x83, 0xd8, 0x02, 0xb6, 0xde, 0xbe, 0x9e, 0x85, 0x74, 0xa8, 0xd8, 0x11, 0xa3, 0x42, 0x5a, 0x10,
+	0x7e, 0x1b, 0x24, 0x58, 0xd4, 0x57, 0x36, 0xde, 0xfc, 0x19, 0xa2, 0xad, 0x2f, 0x76, 0x13, 0xd5,
+	0xdf, 0x3b, 0x61, 0x74, 0xa8, 0xb2, 0x15, 0x17, 0xec, 0x3b, 0x5f, 0x31, 0x3e, 0xdc, 0x9f, 0xb2,
+	0x39, 0x9f, 0xa2, 0xd5, 0x44, 0x52, 0xd1, 0x63, 0xa1, 0xac, 0xc3, 0x06, 0x6c, 0xd5, 0x76, 0x5c,
+	0x6f, 0xb6, 0x3f, 0xef, 0x40, 0x52, 0x51, 0x48, 0xe9, 0xdc, 0x38, 0x1b, 0xb9, 0x40, 0x8f, 0xdc,
+	0x15, 0x13, 0xe8, 0x4a, 0xb2, 0x92, 0x18, 0x84, 0x74, 0xbe, 0x42, 0x28, 0x30, 0xd7, 0x19, 0xba,
+	0x92, 0xa1, 0xbb, 0x33, 0x4f, 0x97, 0x17, 0x54, 0x24, 0xbc, 0x69, 0x09, 0xab, 0x36, 0xd4, 0x95,
+	0xa4, 0x1a, 0x58, 0x94, 0x6c, 0xfe, 0x59, 0x42, 0xf5, 0xcb, 0x3a, 0xb9, 0xfa, 0xe5, 0x3b, 0xdb,
+	0xa8, 0x92, 0x0b, 0x53, 0x2f, 0x37, 0xca, 0xad, 0x8d, 0x9d, 0xf7, 0xe6, 0x09, 0x49, 0x16, 0x25,
+	0x16, 0xe4, 0xec, 0x21, 0x14, 0x08, 0xea, 0x2b, 0x1a, 0xf6, 0x7c, 0x55, 0x5f, 0x32, 0x35, 0x6c,
+	0x7a, 0xb9, 0x65, 0xbc, 0x89, 0x65, 0xbc, 0x7b, 0x13, 0xcb, 0x74, 0x56, 0xb3, 0xcb, 0x1f, 0xfd,
+	0xe1, 0x42, 0x52, 0xb5, 0x79, 0xbb, 0x2a, 0x23, 0x49, 0xe2, 0x70, 0x42, 0xb2, 0xbc, 0x08, 0x89,
+	0xcd, 0xdb, 0x55, 0xcd, 0x01, 0x7a, 0xff, 0xb2, 0xc7, 0x2e, 0x9d, 0x2f, 0xd1, 0x86, 0x3f, 0x73,
+	0x52, 0x87, 0x8d, 0x72, 0xab, 0xb6, 0xd3, 0x9a, 0xef, 0xee, 0x32, 0x0a, 0x32, 0x97, 0xdf, 0xfc,
+	0x09, 0xa2, 0x8f, 0x3e, 0x63, 0x52, 0x5d, 0x7a, 0x27, 0xa1, 0xdf, 0x24, 0x54, 0x2a, 0xa7, 0xbb,
+	0xb8, 0xe4, 0xa6, 0xbf, 0xf3, 0x91, 0x0b, 0xa7, 0x5a, 0xbf, 0x8b, 0x96, 0xb9, 0x08, 0xa9, 0x30,
+	0x32, 0x57, 0x49, 0xbe, 0xc9, 0x4e, 0x4f, 0xd8, 0x80, 0xa9, 0x7a, 0xb9, 0x01, 0x5b, 0xeb, 0x24,
+	0xdf, 0x38, 0x0e, 0x5a, 0x8a, 0xfd, 0x88, 0x1a, 0x35, 0xd6, 0x89, 0x59, 0x37, 0x7f, 0x2d, 0xa3,
+	0x5b, 0xa6, 0xd4, 0x99, 0x22, 0xf7, 0x78, 0x48, 0xff, 0x7f, 0x9e, 0x74, 0xd0, 0x52, 0xc0, 0xc3,
+	0xbc, 0xff, 0x2a, 0x31, 0x6b, 0x67, 0x07, 0xad, 0x09, 0x1a, 0x32, 0x41, 0x03, 0xd5, 0x4b, 0x04,
+	0x33, 0x26, 0xab, 0x76, 0x6e, 0xe8, 0x91, 0x5b, 0x23, 0xf6, 0xfc, 0x80, 0xec, 0x93, 0xda, 0x04,
+	0x74, 0x20, 0x58, 0xf6, 0x74, 0xa5, 0xf2, 0x15, 0xad, 0x57, 0xf2, 0x67, 0x6e, 0x36, 0x73, 0x8e,
+	0x5f, 0xf9, 0xdb, 0x8e, 0xa7, 0xdf, 0xc6, 0x4c, 0x50, 0x99, 0x91, 0xac, 0x2e, 0x42, 0x62, 0xf3,
+	0x76, 0x55, 0xf3, 0xb7, 0xb2, 0xfd, 0xd2, 0x74, 0xe9, 0x7d, 0x16, 0xd0, 0xd9, 0x2f, 0xcd, 0xac,
+	0x10, 0xf0, 0xdf, 0x11, 0xa2, 0x68, 0x95, 0xd2, 0x3f, 0xb5, 0xca, 0x82, 0xaa, 0xba, 0xa8, 0x16,
+	0x9a, 0x3e, 0x7b, 0x05, 0x71, 0x51, 0x7e, 0x64, 0x7c, 0x7c, 0x1b, 0x55, 0x4d, 0x71, 0x26, 0x6c,
+	0xf4, 0x25, 0xa6, 0x5a, 0x13, 0xc4, 0x08, 0x4d, 0x5e, 0x60, 0x1a, 0x1a, 0x41, 0x57, 0x49, 0xe1,
+	0xe4, 0x0a, 0xa9, 0xfa, 0x3b, 0x44, 0xb7, 0xf3, 0x37, 0x35, 0x08, 0xa8, 0x94, 0xf7, 0xf8, 0x31,
+	0xbd, 0x5e, 0x13, 0xd0, 0xb9, 0x85, 0x4a, 0x2c, 0x34, 0x9f, 0xa4, 0x6a, 0xa7, 0xa2, 0x47, 0x6e,
+	0x69, 0xbf, 0x4b, 0x4a, 0x2c, 0x6c, 0xfe, 0x52, 0x46, 0xef, 0xcc, 0x77, 0x76, 0x7d, 0xdb, 0x71,
+	0xee, 0xa0, 0x35, 0xdf, 0x34, 0xd2, 0x53, 0x59, 0x27, 0xd6, 0x91, 0x35, 0xbf, 0xd0, 0xdc, 0x16,
+	0x5a, 0x17, 0xf4, 0x48, 0x50, 0xd9, 0xb7, 0x98, 0xdc, 0x96, 0x6b, 0xf6, 0x30, 0x07, 0x4d, 0xdf,
+	0x83, 0xca, 0xe2, 0x13, 0xf7, 0xbf, 0x74, 0xea, 0xe7, 0xe8, 0xe6, 0xbc, 0x9c, 0xd2, 0xf9, 0x04,
+	0x55, 0x4c, 0xab, 0x93, 0x09, 0xdb, 0xb8, 0x70, 0xc2, 0x16, 0x52, 0x88, 0xc5, 0x37, 0x4f, 0x4b,
+	0xe8, 0x83, 0x37, 0x13, 0xb5, 0xc8, 0x39, 0x99, 0xa4, 0x57, 0xdf, 0x2a, 0x6f, 0xa6, 0x74, 0xf9,
+	0xc2, 0x29, 0xbd, 0x74, 0xd1, 0x94, 0x5e, 0x9e, 0x4e, 0xe9, 0xce, 0x8f, 0xf0, 0x6c, 0x8c, 0xe1,
+	0xf9, 0x18, 0xc3, 0xe7, 0x63, 0x0c, 0x5e, 0x8c, 0x31, 0x78, 0x39, 0xc6, 0xe0, 0xd5, 0x18, 0x83,
+	0xd7, 0x63, 0x0c, 0x4f, 0x35, 0x86, 0x0f, 0x35, 0x06, 0x8f, 0x35, 0x86, 0x4f, 0x34, 0x06, 0x4f,
+	0x35, 0x06, 0xcf, 0x34, 0x06, 0x67, 0x1a, 0xc3, 0x73, 0x8d, 0xe1, 0x73, 0x8d, 0xc1, 0x0b, 0x8d,
+	0xe1, 0x4b, 0x8d, 0xc1, 0x2b, 0x8d, 0xe1, 0x6b, 0x8d, 0xc1, 0x69, 0x8a, 0xc1, 0xc3, 0x14, 0xc3,
+	0x47, 0x29, 0x06, 0xdf, 0xa7, 0x18, 0xfe, 0x90, 0x62, 0xf0, 0x38, 0xc5, 0xe0, 0x49, 0x8a, 0xe1,
+	0xd3, 0x14, 0xc3, 0x67, 0x29, 0x86, 0x5f, 0x7f, 0x1c, 0x71, 0x4f, 0xf5, 0xa9, 0xea, 0xb3, 0x61,
+	0x24, 0xbd, 0x21, 0x55, 0x0f, 0xb8, 0x38, 0x6e, 0xcf, 0xfe, 0xb1, 0xc7, 0xc7, 0x51, 0x5b, 0xa9,
+	0x61, 0x7c, 0x78, 0x58, 0x31, 0xfe, 0xb8, 0xfb, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x89, 0xfb,
+	0x1c, 0x87, 0x77, 0x0c, 0x00, 0x00,
 }
@@ -73,6 +73,21 @@ func (this *OAuthAuthorizationCode) Validate() error {
 	}
 	return nil
 }
+func (this *OAuthDeviceAuthorization) Validate() error {
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.ClientIDs)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("ClientIDs", err)
+	}
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.UserIDs)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("UserIDs", err)
+	}
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.CreatedAt)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("CreatedAt", err)
+	}
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.ExpiresAt)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("ExpiresAt", err)
+	}
+	return nil
+}
 func (this *OAuthAccessTokenIdentifiers) Validate() error {
 	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.UserIDs)); err != nil {
 		return github_com_mwitkow_go_proto_validators.FieldError("UserIDs", err)
@@ -0,0 +1,97 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lru implements a cache that evicts the least recently used entries.
+package lru
+
+import "container/list"
+
+// Cache is a cache with a maximum number of entries, that evicts the least recently used entry when it is full.
+// Cache is not safe for concurrent use.
+type Cache struct {
+	size    int
+	onEvict func(key string, value interface{})
+	entries *list.List
+	items   map[string]*list.Element
+}
+
+type entry struct {
+	key   string
+	value interface{}
+}
+
+// New returns a new cache with at most size entries. If size is zero or negative, the number of entries is not
+// limited. The optional onEvict func is called with the entries that are evicted or removed.
+func New(size int, onEvict func(key string, value interface{})) *Cache {
+	return &Cache{
+		size:    size,
+		onEvict: onEvict,
+		entries: list.New(),
+		items:   make(map[string]*list.Element),
+	}
+}
+
+// Get returns the value of the given key and marks it as recently used.
+func (c *Cache) Get(key string) (interface{}, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.entries.MoveToFront(e)
+	return e.Value.(*entry).value, true
+}
+
+// Add sets the value of the given key and marks it as recently used.
+// If the cache is full, the least recently used entry is evicted.
+func (c *Cache) Add(key string, value interface{}) {
+	if e, ok := c.items[key]; ok {
+		e.Value.(*entry).value = value
+		c.entries.MoveToFront(e)
+		return
+	}
+	c.items[key] = c.entries.PushFront(&entry{key: key, value: value})
+	if c.size > 0 && c.entries.Len() > c.size {
+		c.remove(c.entries.Back())
+	}
+}
+
+// Remove removes the given key. It returns whether the key was present.
+func (c *Cache) Remove(key string) bool {
+	e, ok := c.items[key]
+	if ok {
+		c.remove(e)
+	}
+	return ok
+}
+
+// Purge removes all entries.
+func (c *Cache) Purge() {
+	for c.entries.Len() > 0 {
+		c.remove(c.entries.Back())
+	}
+}
+
+// Len returns the number of entries.
+func (c *Cache) Len() int {
+	return c.entries.Len()
+}
+
+func (c *Cache) remove(e *list.Element) {
+	c.entries.Remove(e)
+	ent := e.Value.(*entry)
+	delete(c.items, ent.key)
+	if c.onEvict != nil {
+		c.onEvict(ent.key, ent.value)
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lru_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/util/lru"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestCache(t *testing.T) {
+	a := assertions.New(t)
+
+	var evicted []string
+	c := lru.New(2, func(key string, value interface{}) {
+		evicted = append(evicted, key)
+	})
+
+	c.Add("a", 1)
+	c.Add("b", 2)
+	a.So(c.Len(), should.Equal, 2)
+
+	// Using a marks b as least recently used.
+	v, ok := c.Get("a")
+	a.So(ok, should.BeTrue)
+	a.So(v, should.Equal, 1)
+
+	c.Add("c", 3)
+	a.So(evicted, should.Resemble, []string{"b"})
+	a.So(c.Len(), should.Equal, 2)
+	_, ok = c.Get("b")
+	a.So(ok, should.BeFalse)
+
+	// Updating a value does not evict.
+	c.Add("a", 4)
+	v, ok = c.Get("a")
+	a.So(ok, should.BeTrue)
+	a.So(v, should.Equal, 4)
+	a.So(evicted, should.Resemble, []string{"b"})
+
+	a.So(c.Remove("c"), should.BeTrue)
+	a.So(c.Remove("c"), should.BeFalse)
+	a.So(evicted, should.Resemble, []string{"b", "c"})
+
+	c.Add("d", 5)
+	c.Purge()
+	a.So(c.Len(), should.Equal, 0)
+	a.So(evicted, should.Resemble, []string{"b", "c", "a", "d"})
+}
+
+func TestCacheUnlimited(t *testing.T) {
+	a := assertions.New(t)
+	c := lru.New(0, nil)
+	for _, key := range []string{"a", "b", "c", "d"} {
+		c.Add(key, key)
+	}
+	a.So(c.Len(), should.Equal, 4)
+}
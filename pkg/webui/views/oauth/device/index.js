@@ -0,0 +1,172 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import React, { PureComponent, Fragment } from 'react'
+import Query from 'query-string'
+import { defineMessages } from 'react-intl'
+
+import api from '../../../api'
+import sharedMessages from '../../../lib/shared-messages'
+
+import ErrorMessage from '../../../lib/components/error-message'
+import Modal from '../../../components/modal'
+import Icon from '../../../components/icon'
+import Input from '../../../components/input'
+import Message from '../../../lib/components/message'
+import IntlHelmet from '../../../lib/components/intl-helmet'
+import { withEnv } from '../../../lib/components/env'
+import getCookieValue from '../../../lib/cookie'
+
+import authorizeStyle from '../authorize/authorize.styl'
+import style from './device.styl'
+
+const m = defineMessages({
+  connectDevice: 'Connect a Device',
+  enterCode: 'Enter the code that is displayed on your device',
+  next: 'Next',
+  modalTitle: 'Request for Permission',
+  modalSubtitle: '{clientName} is requesting permissions to do the following on your device:',
+  loginInfo: 'You are logged in as {userId}.',
+  codeInfo: 'Make sure that your device displays the code {userCode}',
+  authorize: 'Authorize',
+  authorized: 'Your device is now connected. You can close this window and return to your device.',
+  denied: 'The request of your device was denied. You can close this window.',
+})
+
+@withEnv
+export default class Device extends PureComponent {
+
+  async handleLogout () {
+    await api.oauth.logout()
+    window.location = '/oauth/login'
+  }
+
+  render () {
+    const { env: { page_data = {}}, location } = this.props
+    const { client, user, user_code: userCode, authorized, error } = page_data
+
+    if (error) {
+      return <ErrorMessage content={error} />
+    }
+
+    if (authorized !== undefined) {
+      return (
+        <Fragment>
+          <IntlHelmet>
+            <title><Message content={m.connectDevice} /></title>
+          </IntlHelmet>
+          <Modal
+            title={m.connectDevice}
+            message={authorized ? m.authorized : m.denied}
+            approval={false}
+            logo
+          />
+        </Fragment>
+      )
+    }
+
+    if (!client) {
+      const { user_code } = Query.parse(location.search)
+
+      return (
+        <Fragment>
+          <IntlHelmet>
+            <title><Message content={m.connectDevice} /></title>
+          </IntlHelmet>
+          <Modal
+            title={m.connectDevice}
+            subtitle={m.enterCode}
+            buttonMessage={m.next}
+            method="GET"
+            approval={false}
+            logo
+          >
+            <Input
+              className={style.userCode}
+              name="user_code"
+              defaultValue={user_code}
+              placeholder="XXXX-XXXX"
+              autoComplete="off"
+              autoFocus
+            />
+          </Modal>
+        </Fragment>
+      )
+    }
+
+    const clientName = capitalize(client.ids.client_id)
+
+    const bottomLine = (
+      <div>
+        <span>
+          <Message
+            className={authorizeStyle.loginInfo}
+            content={m.loginInfo}
+            values={{ userId: user.ids.user_id }}
+          />
+          <Message
+            content={sharedMessages.logout}
+            component="a"
+            href="#"
+            onClick={this.handleLogout}
+          />
+        </span>
+        <Message content={m.codeInfo} values={{ userCode }} />
+      </div>
+    )
+
+    return (
+      <Fragment>
+        <IntlHelmet>
+          <title><Message content={m.authorize} /></title>
+        </IntlHelmet>
+        <Modal
+          title={m.modalTitle}
+          subtitle={{ ...m.modalSubtitle, values: { clientName }}}
+          bottomLine={bottomLine}
+          buttonMessage={m.authorize}
+          method="POST"
+          formName="authorize"
+          approval
+          logo
+        >
+          <Fragment>
+            <input type="hidden" name="csrf" value={getCookieValue('_csrf')} />
+            <input type="hidden" name="user_code" value={userCode} />
+            <div className={authorizeStyle.left}>
+              <ul>
+                { client.rights.map(right => (
+                  <li key={right}>
+                    <Icon icon="check" className={authorizeStyle.icon} />
+                    <Message content={{ id: `enum:${right}` }} />
+                  </li>
+                )
+                )}
+              </ul>
+            </div>
+            <div className={authorizeStyle.right}>
+              <h3>{clientName}</h3>
+              <p>{client.description}</p>
+            </div>
+          </Fragment>
+        </Modal>
+      </Fragment>
+    )
+  }
+}
+
+// Capitalize the client_id until we have a display name field.
+function capitalize (string) {
+  return string.charAt(0).toUpperCase() + string.slice(1)
+}